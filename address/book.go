@@ -190,10 +190,10 @@ func NewBook(cfg BookConfig) *Book {
 	}
 }
 
-// queryAssetInfo attempts to locate asset genesis information by querying
+// QueryAssetInfo attempts to locate asset genesis information by querying
 // geneses already known to this node. If asset issuance was not previously
 // verified, we then query universes in our federation for issuance proofs.
-func (b *Book) queryAssetInfo(ctx context.Context,
+func (b *Book) QueryAssetInfo(ctx context.Context,
 	id asset.ID) (*asset.AssetGroup, error) {
 
 	// Check if we know of this asset ID already.
@@ -256,7 +256,7 @@ func (b *Book) NewAddress(ctx context.Context, assetID asset.ID, amount uint64,
 
 	// Before we proceed and make new keys, make sure that we actually know
 	// of this asset ID, or can import it.
-	if _, err := b.queryAssetInfo(ctx, assetID); err != nil {
+	if _, err := b.QueryAssetInfo(ctx, assetID); err != nil {
 		return nil, fmt.Errorf("unable to make address for unknown "+
 			"asset %x: %w", assetID[:], err)
 	}
@@ -294,7 +294,7 @@ func (b *Book) NewAddressWithKeys(ctx context.Context, assetID asset.ID,
 	// Before we proceed, we'll make sure that the asset group is known to
 	// the local store. Otherwise, we can't make an address as we haven't
 	// bootstrapped it.
-	assetGroup, err := b.queryAssetInfo(ctx, assetID)
+	assetGroup, err := b.QueryAssetInfo(ctx, assetID)
 	if err != nil {
 		return nil, err
 	}
@@ -390,6 +390,18 @@ func (b *Book) NextInternalKey(ctx context.Context,
 func (b *Book) NextScriptKey(ctx context.Context,
 	family keychain.KeyFamily) (asset.ScriptKey, error) {
 
+	return b.NextTapscriptScriptKey(ctx, family, nil)
+}
+
+// NextTapscriptScriptKey derives the next raw key, tweaks it with the given
+// tapscript tree root and then inserts the resulting script key into the
+// database to make sure it is identified as a local key later on when
+// importing proofs. If the tapscript root is empty, a BIP-0086 script key is
+// created instead.
+func (b *Book) NextTapscriptScriptKey(ctx context.Context,
+	family keychain.KeyFamily, tapscriptRoot []byte) (asset.ScriptKey,
+	error) {
+
 	keyDesc, err := b.cfg.KeyRing.DeriveNextKey(ctx, family)
 	if err != nil {
 		return asset.ScriptKey{}, fmt.Errorf("error deriving next "+
@@ -397,6 +409,10 @@ func (b *Book) NextScriptKey(ctx context.Context,
 	}
 
 	scriptKey := asset.NewScriptKeyBip86(keyDesc)
+	if len(tapscriptRoot) > 0 {
+		scriptKey = asset.NewScriptKeyTapscript(keyDesc, tapscriptRoot)
+	}
+
 	if err := b.cfg.Store.InsertScriptKey(ctx, scriptKey); err != nil {
 		return asset.ScriptKey{}, err
 	}
@@ -451,6 +451,13 @@ const (
 	// output key, allowing the ability for an asset to indirectly commit to
 	// multiple spending conditions.
 	ScriptV0 ScriptVersion = 0
+
+	// ScriptV1 is identical to ScriptV0, except that the virtual
+	// transaction used to spend an asset of this version commits to the
+	// lock time and relative lock time of the new asset being created
+	// instead of those of the spent asset. This allows asset scripts to
+	// use OP_CHECKLOCKTIMEVERIFY and OP_CHECKSEQUENCEVERIFY.
+	ScriptV1 ScriptVersion = 1
 )
 
 // AssetGroup holds information about an asset group, including the genesis
@@ -798,6 +805,33 @@ func NewScriptKeyBip86(rawKey keychain.KeyDescriptor) ScriptKey {
 	}
 }
 
+// NewScriptKeyTapscript constructs a ScriptKey that commits to the tapscript
+// tree with the given root hash. The resulting script key can either be spent
+// through the key path by signing with the raw key tweaked by the tapscript
+// root, or through the script path by revealing one of the tree's leaves.
+func NewScriptKeyTapscript(rawKey keychain.KeyDescriptor,
+	tapscriptRoot []byte) ScriptKey {
+
+	tweakedPubKey := txscript.ComputeTaprootOutputKey(
+		rawKey.PubKey, tapscriptRoot,
+	)
+
+	// Since we'll never query lnd for a tweaked key, it doesn't matter if
+	// we lose the parity information here. And this will only ever be
+	// serialized on chain in a 32-bit representation as well.
+	tweakedPubKey, _ = schnorr.ParsePubKey(
+		schnorr.SerializePubKey(tweakedPubKey),
+	)
+
+	return ScriptKey{
+		PubKey: tweakedPubKey,
+		TweakedScriptKey: &TweakedScriptKey{
+			RawKey: rawKey,
+			Tweak:  tapscriptRoot,
+		},
+	}
+}
+
 // DeriveGroupKey derives an asset's group key based on an internal public
 // key descriptor, the original group asset genesis, and the asset's genesis.
 func DeriveGroupKey(genSigner GenesisSigner, genBuilder GenesisTxBuilder,
@@ -1208,9 +1242,10 @@ func (a *Asset) Copy() *Asset {
 
 	if a.GroupKey != nil {
 		assetCopy.GroupKey = &GroupKey{
-			RawKey:      a.GroupKey.RawKey,
-			GroupPubKey: a.GroupKey.GroupPubKey,
-			Witness:     a.GroupKey.Witness,
+			RawKey:        a.GroupKey.RawKey,
+			GroupPubKey:   a.GroupKey.GroupPubKey,
+			TapscriptRoot: a.GroupKey.TapscriptRoot,
+			Witness:       a.GroupKey.Witness,
 		}
 	}
 
@@ -191,7 +191,7 @@ func (m *MockGroupTxBuilder) BuildGenesisTx(newAsset *Asset) (*wire.MsgTx,
 		return nil, nil, fmt.Errorf("cannot tweak group key: %w", err)
 	}
 	populatedVirtualTx := VirtualTxWithInput(
-		virtualTx, newAsset, 0, nil,
+		virtualTx, newAsset, newAsset, 0, nil,
 	)
 
 	return populatedVirtualTx, prevOut, nil
@@ -53,14 +53,23 @@ func VirtualTxInPrevOut(root mssmt.Node) *wire.OutPoint {
 //
 // This is used to further bind a given witness to the "true" input it spends.
 // We'll use the index of the serialized input to bind the prev index, which
-// represents the "leaf index" of the virtual input MS-SMT.
-func VirtualTxWithInput(virtualTx *wire.MsgTx, input *Asset,
+// represents the "leaf index" of the virtual input MS-SMT. Inputs with script
+// version ScriptV0 commit to their own lock time and relative lock time, while
+// inputs with script version ScriptV1 commit to those of the new asset being
+// created.
+func VirtualTxWithInput(virtualTx *wire.MsgTx, input, newAsset *Asset,
 	idx uint32, witness wire.TxWitness) *wire.MsgTx {
 
+	lockTime, relativeLockTime := input.LockTime, input.RelativeLockTime
+	if input.ScriptVersion == ScriptV1 {
+		lockTime = newAsset.LockTime
+		relativeLockTime = newAsset.RelativeLockTime
+	}
+
 	txCopy := virtualTx.Copy()
-	txCopy.LockTime = uint32(input.LockTime)
+	txCopy.LockTime = uint32(lockTime)
 	txCopy.TxIn[zeroIndex].PreviousOutPoint.Index = idx
-	txCopy.TxIn[zeroIndex].Sequence = uint32(input.RelativeLockTime)
+	txCopy.TxIn[zeroIndex].Sequence = uint32(relativeLockTime)
 	txCopy.TxIn[zeroIndex].Witness = witness
 	return txCopy
 }
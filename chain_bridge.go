@@ -3,6 +3,8 @@ package taprootassets
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
@@ -12,6 +14,10 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
+// medianTimeBlocks is the number of blocks, including the block itself, that
+// are used to calculate the median time past of a block as defined in BIP-113.
+const medianTimeBlocks = 11
+
 // LndRpcChainBridge is an implementation of the tapgarden.ChainBridge
 // interface backed by an active remote lnd node.
 type LndRpcChainBridge struct {
@@ -126,6 +132,37 @@ func (l *LndRpcChainBridge) VerifyBlock(ctx context.Context,
 	return err
 }
 
+// MedianTime returns the median time past of the block at the given height
+// as defined in BIP-113, which is the median of the timestamps of that block
+// and the 10 blocks preceding it.
+func (l *LndRpcChainBridge) MedianTime(ctx context.Context,
+	blockHeight uint32) (time.Time, error) {
+
+	timestamps := make([]int64, 0, medianTimeBlocks)
+	for i := uint32(0); i < medianTimeBlocks && i <= blockHeight; i++ {
+		hash, err := l.GetBlockHash(ctx, int64(blockHeight-i))
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		// We only have access to full blocks, which is fine as the
+		// median time past is only needed to verify time based lock
+		// times.
+		block, err := l.GetBlock(ctx, hash)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		timestamps = append(timestamps, block.Header.Timestamp.Unix())
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i] < timestamps[j]
+	})
+
+	return time.Unix(timestamps[len(timestamps)/2], 0), nil
+}
+
 // CurrentHeight return the current height of the main chain.
 func (l *LndRpcChainBridge) CurrentHeight(ctx context.Context) (uint32, error) {
 	info, err := l.lnd.Client.GetInfo(ctx)
@@ -29,6 +29,7 @@ var addrCommands = []cli.Command{
 const (
 	groupKeyName         = "group_key"
 	amtName              = "amt"
+	displayAmtName       = "display_amt"
 	assetVersionName     = "asset_version"
 	proofCourierAddrName = "proof_courier_addr"
 )
@@ -48,6 +49,12 @@ var newAddrCommand = cli.Command{
 			Name:  amtName,
 			Usage: "the amt of the asset to receive",
 		},
+		cli.StringFlag{
+			Name: displayAmtName,
+			Usage: "the amt of the asset to receive as a decimal " +
+				"number, using the decimal display of the " +
+				"asset; can be used instead of amt",
+		},
 		cli.Uint64Flag{
 			Name:  assetVersionName,
 			Usage: "the asset version of the asset to receive",
@@ -88,6 +95,7 @@ func newAddr(ctx *cli.Context) error {
 	addr, err := client.NewAddr(ctxc, &taprpc.NewAddrRequest{
 		AssetId:          assetID,
 		Amt:              ctx.Uint64(amtName),
+		DisplayAmt:       ctx.String(displayAmtName),
 		AssetVersion:     assetVersion,
 		ProofCourierAddr: ctx.String(proofCourierAddrName),
 	})
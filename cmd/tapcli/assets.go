@@ -1,11 +1,15 @@
 package main
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"math"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	taprootassets "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/tapcfg"
 	"github.com/lightninglabs/taproot-assets/taprpc"
@@ -27,6 +31,9 @@ var assetsCommands = []cli.Command{
 			listAssetBalancesCommand,
 			sendAssetsCommand,
 			burnAssetsCommand,
+			bumpTransferFeeCommand,
+			abandonTransferCommand,
+			consolidateAssetsCommand,
 			listTransfersCommand,
 			fetchMetaCommand,
 		},
@@ -52,6 +59,29 @@ var (
 	feeRateName                  = "fee_rate"
 	assetAmountName              = "amount"
 	burnOverrideConfirmationName = "override_confirmation_destroy_assets"
+	anchorTxidName               = "anchor_txid"
+	cpfpName                     = "cpfp"
+	skipDoubleSpendName          = "skip_double_spend"
+	coinSelectStrategyName       = "coin_select_strategy"
+	maxInputsName                = "max_inputs"
+	confTargetName               = "conf_target"
+	genesisInputName             = "genesis_input"
+	externalPsbtName             = "external_psbt"
+	dryRunName                   = "dry_run"
+	signedPsbtName               = "signed_psbt"
+	finalizeHeightName           = "finalize_height"
+	finalizeAfterName            = "finalize_after"
+	recurringMintIDName          = "id"
+	intervalBlocksName           = "interval_blocks"
+	intervalName                 = "interval"
+	startHeightName              = "start_height"
+	maxEpochsName                = "max_epochs"
+	batchNameName                = "batch_name"
+	removeGroupAnchorName        = "remove_group_anchor"
+	externalGroupKeyName         = "external_group_key"
+	groupTapscriptRootName       = "group_tapscript_root"
+	groupWitnessName             = "witness"
+	decimalDisplayName           = "decimal_display"
 )
 
 var mintAssetCommand = cli.Command{
@@ -70,13 +100,20 @@ var mintAssetCommand = cli.Command{
 			Usage: "the name/tag of the asset",
 		},
 		cli.Uint64Flag{
-			Name:  assetSupplyName,
-			Usage: "the target supply of the minted asset",
+			Name: assetSupplyName,
+			Usage: "the target supply of the minted asset in " +
+				"asset units",
 		},
 		cli.Uint64Flag{
 			Name:  assetVersionName,
 			Usage: "the version of the asset to mint",
 		},
+		cli.Uint64Flag{
+			Name: decimalDisplayName,
+			Usage: "the number of decimal places amounts of the " +
+				"asset are displayed with; committed to in " +
+				"the JSON asset meta",
+		},
 		cli.StringFlag{
 			Name:  assetMetaBytesName,
 			Usage: "the raw metadata associated with the asset",
@@ -87,8 +124,10 @@ var mintAssetCommand = cli.Command{
 				"and used as the asset meta",
 		},
 		cli.IntFlag{
-			Name:  assetMetaTypeName,
-			Usage: "the type of the meta data for the asset",
+			Name: assetMetaTypeName,
+			Usage: "the type of the meta data for the asset; 0 " +
+				"for opaque data, 1 for a JSON object that " +
+				"follows the asset meta schema",
 		},
 		cli.BoolFlag{
 			Name: assetEmissionName,
@@ -105,6 +144,25 @@ var mintAssetCommand = cli.Command{
 			Usage: "the other asset in this batch that the new " +
 				"asset be grouped with",
 		},
+		cli.StringFlag{
+			Name: externalGroupKeyName,
+			Usage: "the raw group key of the asset if it is held " +
+				"externally; the group witness must then be " +
+				"added with 'assets mint witness' once the " +
+				"batch is finalized",
+		},
+		cli.StringFlag{
+			Name: groupTapscriptRootName,
+			Usage: "the optional tapscript root the new " +
+				"group key commits to, requires an external " +
+				"group key",
+		},
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "the name of the pending batch to add the " +
+				"asset to; a new batch is created if none " +
+				"with that name exists",
+		},
 		cli.BoolFlag{
 			Name: shortResponseName,
 			Usage: "if true, then the current assets within the " +
@@ -118,6 +176,13 @@ var mintAssetCommand = cli.Command{
 		listBatchesCommand,
 		finalizeBatchCommand,
 		cancelBatchCommand,
+		bumpBatchFeeCommand,
+		publishSignedBatchCommand,
+		addGroupWitnessesCommand,
+		scheduleBatchCommand,
+		recurringMintCommand,
+		updateSeedlingCommand,
+		removeSeedlingCommand,
 	},
 }
 
@@ -135,33 +200,15 @@ func parseAssetType(ctx *cli.Context) (taprpc.AssetType, error) {
 	}
 }
 
-func mintAsset(ctx *cli.Context) error {
-	switch {
-	case ctx.String(assetTagName) == "":
-		fallthrough
-	case ctx.Int64(assetSupplyName) == 0:
-		return cli.ShowSubcommandHelp(ctx)
-	}
-
-	var (
-		groupKey    []byte
-		err         error
-		groupKeyStr = ctx.String(assetGroupKeyName)
-	)
-
-	if len(groupKeyStr) != 0 {
-		groupKey, err = hex.DecodeString(groupKeyStr)
-		if err != nil {
-			return fmt.Errorf("invalid group key")
-		}
-	}
-
+// parseAssetMeta parses the asset meta from either the meta bytes or the meta
+// file path flag. If neither is set, nil is returned.
+func parseAssetMeta(ctx *cli.Context) (*taprpc.AssetMeta, error) {
 	// Both the meta bytes and the meta path can be set.
 	var assetMeta *taprpc.AssetMeta
 	switch {
 	case ctx.String(assetMetaBytesName) != "" &&
 		ctx.String(assetMetaFilePathName) != "":
-		return fmt.Errorf("meta bytes or meta file path cannot " +
+		return nil, fmt.Errorf("meta bytes or meta file path cannot " +
 			"be both set")
 
 	case ctx.String(assetMetaBytesName) != "":
@@ -176,7 +223,8 @@ func mintAsset(ctx *cli.Context) error {
 		)
 		metaFileBytes, err := os.ReadFile(metaPath)
 		if err != nil {
-			return fmt.Errorf("unable to read meta file: %w", err)
+			return nil, fmt.Errorf("unable to read meta file: %w",
+				err)
 		}
 
 		assetMeta = &taprpc.AssetMeta{
@@ -185,14 +233,58 @@ func mintAsset(ctx *cli.Context) error {
 		}
 	}
 
+	return assetMeta, nil
+}
+
+func mintAsset(ctx *cli.Context) error {
+	switch {
+	case ctx.String(assetTagName) == "":
+		fallthrough
+	case ctx.Int64(assetSupplyName) == 0:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	var (
+		groupKey    []byte
+		err         error
+		groupKeyStr = ctx.String(assetGroupKeyName)
+	)
+
+	if len(groupKeyStr) != 0 {
+		groupKey, err = hex.DecodeString(groupKeyStr)
+		if err != nil {
+			return fmt.Errorf("invalid group key")
+		}
+	}
+
+	externalGroupKey, err := hex.DecodeString(
+		ctx.String(externalGroupKeyName),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid external group key")
+	}
+
+	groupTapscriptRoot, err := hex.DecodeString(
+		ctx.String(groupTapscriptRootName),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid group tapscript root")
+	}
+
+	assetMeta, err := parseAssetMeta(ctx)
+	if err != nil {
+		return err
+	}
+
 	assetType, err := parseAssetType(ctx)
 	if err != nil {
 		return err
 	}
 
 	var (
-		amount        = ctx.Uint64(assetSupplyName)
-		isCollectible = assetType == taprpc.AssetType_COLLECTIBLE
+		amount         = ctx.Uint64(assetSupplyName)
+		isCollectible  = assetType == taprpc.AssetType_COLLECTIBLE
+		decimalDisplay = uint32(ctx.Uint64(decimalDisplayName))
 	)
 	switch {
 	// If the user did not specify the supply, we can silently assume they
@@ -206,112 +298,780 @@ func mintAsset(ctx *cli.Context) error {
 	case isCollectible && amount != 1:
 		return fmt.Errorf("supply must be 1 for collectibles")
 
-	// Check that the amount is greater than 0 for normal assets. This is
-	// also checked in the RPC server, but we can avoid the round trip.
-	case !isCollectible && amount == 0:
-		return fmt.Errorf("supply must be set for normal assets")
+	// Check that the amount is greater than 0 for normal assets. This is
+	// also checked in the RPC server, but we can avoid the round trip.
+	case !isCollectible && amount == 0:
+		return fmt.Errorf("supply must be set for normal assets")
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.MintAsset(ctxc, &mintrpc.MintAssetRequest{
+		Asset: &mintrpc.MintAsset{
+			AssetType:   assetType,
+			Name:        ctx.String(assetTagName),
+			AssetMeta:   assetMeta,
+			Amount:      amount,
+			GroupKey:    groupKey,
+			GroupAnchor: ctx.String(assetGroupAnchorName),
+			AssetVersion: taprpc.AssetVersion(
+				ctx.Uint64(assetVersionName),
+			),
+			ExternalGroupKey:   externalGroupKey,
+			GroupTapscriptRoot: groupTapscriptRoot,
+			DecimalDisplay:     decimalDisplay,
+		},
+		EnableEmission: ctx.Bool(assetEmissionName),
+		ShortResponse:  ctx.Bool(shortResponseName),
+		BatchName:      ctx.String(batchNameName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to mint asset: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var finalizeBatchCommand = cli.Command{
+	Name:        "finalize",
+	ShortName:   "f",
+	Usage:       "finalize a batch",
+	Description: "Attempt to finalize a pending batch.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response " +
+				"in order to avoid printing a large amount " +
+				"of data in case of large batches",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/kw to use for the" +
+				"minting transaction",
+		},
+		cli.Uint64Flag{
+			Name: confTargetName,
+			Usage: "if set, the confirmation target used to " +
+				"estimate the fee rate of the minting " +
+				"transaction",
+		},
+		cli.StringSliceFlag{
+			Name: genesisInputName,
+			Usage: "if set, a wallet UTXO (txid:vout) to fund " +
+				"the minting transaction with; can be " +
+				"specified multiple times, the first one " +
+				"becomes the genesis point of the batch",
+		},
+		cli.StringFlag{
+			Name: externalPsbtName,
+			Usage: "if set, the base64 encoded minting " +
+				"transaction funded by an external wallet; " +
+				"the returned batch_psbt then needs to be " +
+				"signed externally and published with the " +
+				"publish command",
+		},
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "the name of the pending batch to finalize; " +
+				"the default batch is finalized if not set",
+		},
+		cli.BoolFlag{
+			Name: dryRunName,
+			Usage: "if true, the batch isn't finalized; instead, " +
+				"a preview of its assets, commitment root, " +
+				"fee and draft proofs is returned and the " +
+				"batch stays pending; the finalized batch " +
+				"uses other keys, so its asset IDs and " +
+				"proofs differ from the preview",
+		},
+	},
+	Action: finalizeBatch,
+}
+
+func parseFeeRate(ctx *cli.Context) (uint32, error) {
+	if ctx.IsSet(feeRateName) {
+		feeRate := ctx.Uint64(feeRateName)
+		if feeRate > math.MaxUint32 {
+			return 0, fmt.Errorf("fee rate exceeds 2^32")
+		}
+
+		return uint32(feeRate), nil
+	}
+
+	return uint32(0), nil
+}
+
+func finalizeBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	confTarget := ctx.Uint64(confTargetName)
+	if confTarget > math.MaxUint32 {
+		return fmt.Errorf("conf target exceeds 2^32")
+	}
+
+	var externalPsbt []byte
+	if ctx.IsSet(externalPsbtName) {
+		externalPsbt, err = base64.StdEncoding.DecodeString(
+			ctx.String(externalPsbtName),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid external psbt: %w", err)
+		}
+	}
+
+	resp, err := client.FinalizeBatch(ctxc, &mintrpc.FinalizeBatchRequest{
+		ShortResponse:       ctx.Bool(shortResponseName),
+		FeeRate:             feeRate,
+		ConfTarget:          uint32(confTarget),
+		GenesisInputs:       ctx.StringSlice(genesisInputName),
+		ExternalGenesisPsbt: externalPsbt,
+		BatchName:           ctx.String(batchNameName),
+		DryRun:              ctx.Bool(dryRunName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to finalize batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var cancelBatchCommand = cli.Command{
+	Name:        "cancel",
+	ShortName:   "c",
+	Usage:       "cancel a batch",
+	Description: "Attempt to cancel a pending batch.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "the name of the batch to cancel; the default " +
+				"batch is cancelled if not set",
+		},
+	},
+	Action: cancelBatch,
+}
+
+func cancelBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CancelBatch(ctxc, &mintrpc.CancelBatchRequest{
+		BatchName: ctx.String(batchNameName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to cancel batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var bumpBatchFeeCommand = cli.Command{
+	Name:      "bumpfee",
+	ShortName: "bf",
+	Usage:     "bump the fee of a broadcast batch",
+	Description: `
+	Attempt to replace the unconfirmed genesis transaction of a broadcast
+	batch with a transaction that pays a higher fee rate. The replacement
+	spends the same genesis input, so the IDs of the minted assets stay the
+	same.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the batch key of the batch to bump the fee of",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "the fee rate in sat/kw to use for the " +
+				"replacement minting transaction",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response " +
+				"in order to avoid printing a large amount " +
+				"of data in case of large batches",
+		},
+	},
+	Action: bumpBatchFee,
+}
+
+func bumpBatchFee(ctx *cli.Context) error {
+	switch {
+	case ctx.String(batchKeyName) == "":
+		return fmt.Errorf("batch key must be set")
+
+	case !ctx.IsSet(feeRateName):
+		return fmt.Errorf("fee rate must be set")
+	}
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key")
+	}
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.BumpBatchFee(ctxc, &mintrpc.BumpBatchFeeRequest{
+		Batch: &mintrpc.BumpBatchFeeRequest_BatchKey{
+			BatchKey: batchKey,
+		},
+		FeeRate:       feeRate,
+		ShortResponse: ctx.Bool(shortResponseName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to bump batch fee: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var publishSignedBatchCommand = cli.Command{
+	Name:      "publish",
+	ShortName: "p",
+	Usage:     "publish the signed minting transaction of a batch",
+	Description: `
+	Publish the minting transaction of a batch that was finalized with an
+	externally funded minting transaction, after it was signed by the
+	external wallet.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the batch key of the batch to publish",
+		},
+		cli.StringFlag{
+			Name:  signedPsbtName,
+			Usage: "the base64 encoded signed minting transaction",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response " +
+				"in order to avoid printing a large amount " +
+				"of data in case of large batches",
+		},
+	},
+	Action: publishSignedBatch,
+}
+
+func publishSignedBatch(ctx *cli.Context) error {
+	switch {
+	case ctx.String(batchKeyName) == "":
+		return fmt.Errorf("batch key must be set")
+
+	case ctx.String(signedPsbtName) == "":
+		return fmt.Errorf("signed psbt must be set")
+	}
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key")
+	}
+
+	signedPsbt, err := base64.StdEncoding.DecodeString(
+		ctx.String(signedPsbtName),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid signed psbt: %w", err)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.PublishSignedBatch(
+		ctxc, &mintrpc.PublishSignedBatchRequest{
+			Batch: &mintrpc.PublishSignedBatchRequest_BatchKey{
+				BatchKey: batchKey,
+			},
+			SignedPsbt:    signedPsbt,
+			ShortResponse: ctx.Bool(shortResponseName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to publish signed batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var addGroupWitnessesCommand = cli.Command{
+	Name:      "witness",
+	ShortName: "w",
+	Usage:     "add externally signed group witnesses to a batch",
+	Description: `
+	Add the group witnesses of the assets in a finalized batch that were
+	minted with an external group key, after the unsigned virtual
+	transactions returned by 'assets mint finalize' were signed.
+
+	Each witness is specified as <asset_name>=<hex_elem>[,<hex_elem>...],
+	with the hex encoded elements of the witness stack in order.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the batch key of the batch to add witnesses to",
+		},
+		cli.StringSliceFlag{
+			Name: groupWitnessName,
+			Usage: "the group witness of an asset, can be " +
+				"specified multiple times",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response " +
+				"in order to avoid printing a large amount " +
+				"of data in case of large batches",
+		},
+	},
+	Action: addGroupWitnesses,
+}
+
+// parseGroupWitness parses a group witness of the form
+// <asset_name>=<hex_elem>[,<hex_elem>...].
+func parseGroupWitness(witnessStr string) (*mintrpc.GroupWitness, error) {
+	sep := strings.LastIndex(witnessStr, "=")
+	if sep <= 0 || sep == len(witnessStr)-1 {
+		return nil, fmt.Errorf("invalid group witness %q", witnessStr)
+	}
+
+	groupWitness := &mintrpc.GroupWitness{
+		AssetName: witnessStr[:sep],
+	}
+	for _, elemStr := range strings.Split(witnessStr[sep+1:], ",") {
+		elem, err := hex.DecodeString(elemStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group witness for "+
+				"asset %v: %w", groupWitness.AssetName, err)
+		}
+
+		groupWitness.Witness = append(groupWitness.Witness, elem)
+	}
+
+	return groupWitness, nil
+}
+
+func addGroupWitnesses(ctx *cli.Context) error {
+	switch {
+	case ctx.String(batchKeyName) == "":
+		fallthrough
+	case len(ctx.StringSlice(groupWitnessName)) == 0:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key")
+	}
+
+	witnessStrs := ctx.StringSlice(groupWitnessName)
+	groupWitnesses := make([]*mintrpc.GroupWitness, 0, len(witnessStrs))
+	for _, witnessStr := range witnessStrs {
+		groupWitness, err := parseGroupWitness(witnessStr)
+		if err != nil {
+			return err
+		}
+
+		groupWitnesses = append(groupWitnesses, groupWitness)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AddGroupWitnesses(
+		ctxc, &mintrpc.AddGroupWitnessesRequest{
+			Batch: &mintrpc.AddGroupWitnessesRequest_BatchKey{
+				BatchKey: batchKey,
+			},
+			GroupWitnesses: groupWitnesses,
+			ShortResponse:  ctx.Bool(shortResponseName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to add group witnesses: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var scheduleBatchCommand = cli.Command{
+	Name:      "schedule",
+	ShortName: "s",
+	Usage:     "schedule the pending batch to be finalized",
+	Description: `
+	Schedule a pending batch to be finalized automatically once the given
+	block height is reached, or after the given duration has passed. If
+	neither is set, an existing schedule is removed from the pending batch.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: finalizeHeightName,
+			Usage: "the block height at which the batch " +
+				"should be finalized",
+		},
+		cli.StringFlag{
+			Name: finalizeAfterName,
+			Usage: "a duration (30m, 2h, etc) after which the " +
+				"batch should be finalized",
+		},
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "the name of the pending batch to schedule; " +
+				"the default batch is scheduled if not set",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response " +
+				"in order to avoid printing a large amount " +
+				"of data in case of large batches",
+		},
+	},
+	Action: scheduleBatch,
+}
+
+func scheduleBatch(ctx *cli.Context) error {
+	if ctx.IsSet(finalizeHeightName) && ctx.IsSet(finalizeAfterName) {
+		return fmt.Errorf("only one of %v and %v can be set",
+			finalizeHeightName, finalizeAfterName)
+	}
+
+	req := &mintrpc.ScheduleBatchRequest{
+		FinalizeHeight: uint32(ctx.Uint64(finalizeHeightName)),
+		ShortResponse:  ctx.Bool(shortResponseName),
+		BatchName:      ctx.String(batchNameName),
+	}
+	if ctx.IsSet(finalizeAfterName) {
+		delay, err := time.ParseDuration(ctx.String(finalizeAfterName))
+		if err != nil {
+			return fmt.Errorf("unable to parse duration: %w", err)
+		}
+
+		req.FinalizeTime = time.Now().Add(delay).Unix()
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ScheduleBatch(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to schedule batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var updateSeedlingCommand = cli.Command{
+	Name:      "update",
+	ShortName: "u",
+	Usage:     "update an asset in a pending batch",
+	Description: `
+	Change the supply, meta or group anchor of an asset in a pending batch
+	before the batch is frozen. Any value that isn't set is left unchanged.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetTagName,
+			Usage: "the name/tag of the asset to update",
+		},
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "the name of the pending batch the asset is " +
+				"in; the default batch is used if not set",
+		},
+		cli.Uint64Flag{
+			Name:  assetSupplyName,
+			Usage: "the new target supply of the asset",
+		},
+		cli.StringFlag{
+			Name:  assetMetaBytesName,
+			Usage: "the new raw metadata associated with the asset",
+		},
+		cli.StringFlag{
+			Name: assetMetaFilePathName,
+			Usage: "a path to a file on disk that should be read " +
+				"and used as the new asset meta",
+		},
+		cli.IntFlag{
+			Name: assetMetaTypeName,
+			Usage: "the type of the new meta data for the asset; " +
+				"0 for opaque data, 1 for a JSON object that " +
+				"follows the asset meta schema",
+		},
+		cli.StringFlag{
+			Name: assetGroupAnchorName,
+			Usage: "the other asset in the batch that the asset " +
+				"should be grouped with",
+		},
+		cli.BoolFlag{
+			Name: removeGroupAnchorName,
+			Usage: "if true, the asset is no longer grouped with " +
+				"its group anchor",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response " +
+				"in order to avoid printing a large amount " +
+				"of data in case of large batches",
+		},
+	},
+	Action: updateSeedling,
+}
+
+func updateSeedling(ctx *cli.Context) error {
+	if ctx.String(assetTagName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	assetMeta, err := parseAssetMeta(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.UpdateSeedling(ctxc, &mintrpc.UpdateSeedlingRequest{
+		BatchName:         ctx.String(batchNameName),
+		Name:              ctx.String(assetTagName),
+		Amount:            ctx.Uint64(assetSupplyName),
+		AssetMeta:         assetMeta,
+		GroupAnchor:       ctx.String(assetGroupAnchorName),
+		RemoveGroupAnchor: ctx.Bool(removeGroupAnchorName),
+		ShortResponse:     ctx.Bool(shortResponseName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update asset: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var removeSeedlingCommand = cli.Command{
+	Name:  "remove",
+	Usage: "remove an asset from a pending batch",
+	Description: "Remove an asset from a pending batch before the batch " +
+		"is frozen.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetTagName,
+			Usage: "the name/tag of the asset to remove",
+		},
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "the name of the pending batch the asset is " +
+				"in; the default batch is used if not set",
+		},
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response " +
+				"in order to avoid printing a large amount " +
+				"of data in case of large batches",
+		},
+	},
+	Action: removeSeedling,
+}
+
+func removeSeedling(ctx *cli.Context) error {
+	if ctx.String(assetTagName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
 	}
 
 	ctxc := getContext()
 	client, cleanUp := getMintClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.MintAsset(ctxc, &mintrpc.MintAssetRequest{
-		Asset: &mintrpc.MintAsset{
-			AssetType:   assetType,
-			Name:        ctx.String(assetTagName),
-			AssetMeta:   assetMeta,
-			Amount:      amount,
-			GroupKey:    groupKey,
-			GroupAnchor: ctx.String(assetGroupAnchorName),
-			AssetVersion: taprpc.AssetVersion(
-				ctx.Uint64(assetVersionName),
-			),
-		},
-		EnableEmission: ctx.Bool(assetEmissionName),
-		ShortResponse:  ctx.Bool(shortResponseName),
+	resp, err := client.RemoveSeedling(ctxc, &mintrpc.RemoveSeedlingRequest{
+		BatchName:     ctx.String(batchNameName),
+		Name:          ctx.String(assetTagName),
+		ShortResponse: ctx.Bool(shortResponseName),
 	})
 	if err != nil {
-		return fmt.Errorf("unable to mint asset: %w", err)
+		return fmt.Errorf("unable to remove asset: %w", err)
 	}
 
 	printRespJSON(resp)
 	return nil
 }
 
-var finalizeBatchCommand = cli.Command{
-	Name:        "finalize",
-	ShortName:   "f",
-	Usage:       "finalize a batch",
-	Description: "Attempt to finalize a pending batch.",
+var recurringMintCommand = cli.Command{
+	Name:      "recurring",
+	ShortName: "r",
+	Usage:     "manage recurring mints",
+	Description: `
+	Manage recurring mints that mint new units of an existing asset group
+	once per epoch. Active recurring mints are listed by the batches
+	command.
+	`,
+	Subcommands: []cli.Command{
+		addRecurringMintCommand,
+		cancelRecurringMintCommand,
+	},
+}
+
+var addRecurringMintCommand = cli.Command{
+	Name:      "add",
+	ShortName: "a",
+	Usage:     "add a new recurring mint",
+	Description: `
+	Add a recurring mint that mints the given amount of new units into an
+	existing asset group once per epoch. An epoch is either a number of
+	blocks or a duration. At the end of each epoch, the new units are added
+	to the pending batch, which is then finalized.
+	`,
 	Flags: []cli.Flag{
-		cli.BoolFlag{
-			Name: shortResponseName,
-			Usage: "if true, then the current assets within the " +
-				"batch will not be returned in the response " +
-				"in order to avoid printing a large amount " +
-				"of data in case of large batches",
+		cli.StringFlag{
+			Name:  assetTagName,
+			Usage: "the name of the asset minted in each epoch",
 		},
 		cli.Uint64Flag{
-			Name: feeRateName,
-			Usage: "if set, the fee rate in sat/kw to use for the" +
-				"minting transaction",
+			Name:  assetAmountName,
+			Usage: "the number of units minted in each epoch",
+		},
+		cli.StringFlag{
+			Name: assetGroupKeyName,
+			Usage: "the group key of the group to mint the new " +
+				"units into",
+		},
+		cli.Uint64Flag{
+			Name:  intervalBlocksName,
+			Usage: "the length of an epoch in blocks",
+		},
+		cli.StringFlag{
+			Name: intervalName,
+			Usage: "the length of an epoch as a duration (1h, " +
+				"24h, etc)",
+		},
+		cli.Uint64Flag{
+			Name: startHeightName,
+			Usage: "the block height at which units are " +
+				"minted for the first time, defaults to the " +
+				"end of the first epoch",
+		},
+		cli.Uint64Flag{
+			Name: maxEpochsName,
+			Usage: "the total number of epochs to mint new " +
+				"units in, if zero the recurring mint " +
+				"repeats until cancelled",
 		},
 	},
-	Action: finalizeBatch,
+	Action: addRecurringMint,
 }
 
-func parseFeeRate(ctx *cli.Context) (uint32, error) {
-	if ctx.IsSet(feeRateName) {
-		feeRate := ctx.Uint64(feeRateName)
-		if feeRate > math.MaxUint32 {
-			return 0, fmt.Errorf("fee rate exceeds 2^32")
-		}
+func addRecurringMint(ctx *cli.Context) error {
+	switch {
+	case ctx.String(assetTagName) == "":
+		return fmt.Errorf("asset name must be set")
 
-		return uint32(feeRate), nil
+	case ctx.String(assetGroupKeyName) == "":
+		return fmt.Errorf("group key must be set")
+
+	case ctx.IsSet(intervalBlocksName) == ctx.IsSet(intervalName):
+		return fmt.Errorf("exactly one of %v and %v must be set",
+			intervalBlocksName, intervalName)
 	}
 
-	return uint32(0), nil
-}
+	groupKey, err := hex.DecodeString(ctx.String(assetGroupKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid group key")
+	}
+
+	req := &mintrpc.AddRecurringMintRequest{
+		Name:           ctx.String(assetTagName),
+		Amount:         ctx.Uint64(assetAmountName),
+		GroupKey:       groupKey,
+		IntervalBlocks: uint32(ctx.Uint64(intervalBlocksName)),
+		StartHeight:    uint32(ctx.Uint64(startHeightName)),
+		MaxEpochs:      uint32(ctx.Uint64(maxEpochsName)),
+	}
+	if ctx.IsSet(intervalName) {
+		interval, err := time.ParseDuration(ctx.String(intervalName))
+		if err != nil {
+			return fmt.Errorf("unable to parse interval: %w", err)
+		}
+		if interval < time.Second {
+			return fmt.Errorf("interval must be at least one " +
+				"second")
+		}
+
+		req.IntervalSeconds = uint64(interval / time.Second)
+	}
 
-func finalizeBatch(ctx *cli.Context) error {
 	ctxc := getContext()
 	client, cleanUp := getMintClient(ctx)
 	defer cleanUp()
 
-	feeRate, err := parseFeeRate(ctx)
-	if err != nil {
-		return err
-	}
-
-	resp, err := client.FinalizeBatch(ctxc, &mintrpc.FinalizeBatchRequest{
-		ShortResponse: ctx.Bool(shortResponseName),
-		FeeRate:       feeRate,
-	})
+	resp, err := client.AddRecurringMint(ctxc, req)
 	if err != nil {
-		return fmt.Errorf("unable to finalize batch: %w", err)
+		return fmt.Errorf("unable to add recurring mint: %w", err)
 	}
 
 	printRespJSON(resp)
 	return nil
 }
 
-var cancelBatchCommand = cli.Command{
+var cancelRecurringMintCommand = cli.Command{
 	Name:        "cancel",
 	ShortName:   "c",
-	Usage:       "cancel a batch",
-	Description: "Attempt to cancel a pending batch.",
-	Action:      cancelBatch,
+	Usage:       "cancel a recurring mint",
+	Description: "Cancel a recurring mint, so no further units are minted",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  recurringMintIDName,
+			Usage: "the ID of the recurring mint to cancel",
+		},
+	},
+	Action: cancelRecurringMint,
 }
 
-func cancelBatch(ctx *cli.Context) error {
+func cancelRecurringMint(ctx *cli.Context) error {
+	if !ctx.IsSet(recurringMintIDName) {
+		return fmt.Errorf("recurring mint ID must be set")
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getMintClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.CancelBatch(ctxc, &mintrpc.CancelBatchRequest{})
+	resp, err := client.CancelRecurringMint(
+		ctxc, &mintrpc.CancelRecurringMintRequest{
+			Id: ctx.Uint64(recurringMintIDName),
+		},
+	)
 	if err != nil {
-		return fmt.Errorf("unable to cancel batch: %w", err)
+		return fmt.Errorf("unable to cancel recurring mint: %w", err)
 	}
 
 	printRespJSON(resp)
@@ -520,13 +1280,21 @@ var sendAssetsCommand = cli.Command{
 		cli.StringSliceFlag{
 			Name: addrName,
 			Usage: "addr to send to; can be specified multiple " +
-				"times to send to multiple addresses at once",
+				"times to send to multiple addresses (also " +
+				"of different assets) at once",
 		},
 		cli.Uint64Flag{
 			Name: feeRateName,
 			Usage: "if set, the fee rate in sat/kw to use for the" +
 				"anchor transaction",
 		},
+		cli.StringFlag{
+			Name: coinSelectStrategyName,
+			Usage: "if set, the strategy used to select the " +
+				"asset inputs, must be one of: max-amount, " +
+				"min-amount, exact-match, oldest, " +
+				"single-anchor",
+		},
 		// TODO(roasbeef): add arg for file name to write sender proof
 		// blob
 	},
@@ -548,9 +1316,15 @@ func sendAssets(ctx *cli.Context) error {
 		return err
 	}
 
+	strategy, err := parseCoinSelectStrategy(ctx)
+	if err != nil {
+		return err
+	}
+
 	resp, err := client.SendAsset(ctxc, &taprpc.SendAssetRequest{
-		TapAddrs: addrs,
-		FeeRate:  feeRate,
+		TapAddrs:           addrs,
+		FeeRate:            feeRate,
+		CoinSelectStrategy: strategy,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to send assets: %w", err)
@@ -560,6 +1334,31 @@ func sendAssets(ctx *cli.Context) error {
 	return nil
 }
 
+// parseCoinSelectStrategy parses the coin selection strategy flag. If the flag
+// isn't set, the daemon's default strategy is used.
+func parseCoinSelectStrategy(ctx *cli.Context) (taprpc.CoinSelectStrategy,
+	error) {
+
+	name := ctx.String(coinSelectStrategyName)
+	if name == "" {
+		return taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_DEFAULT,
+			nil
+	}
+
+	// The strategy names map to the RPC enum values, e.g. "max-amount"
+	// maps to COIN_SELECT_STRATEGY_MAX_AMOUNT.
+	enumName := "COIN_SELECT_STRATEGY_" + strings.ToUpper(
+		strings.ReplaceAll(name, "-", "_"),
+	)
+	strategy, ok := taprpc.CoinSelectStrategy_value[enumName]
+	if !ok {
+		return 0, fmt.Errorf("unknown coin selection strategy: %v",
+			name)
+	}
+
+	return taprpc.CoinSelectStrategy(strategy), nil
+}
+
 var burnAssetsCommand = cli.Command{
 	Name:  "burn",
 	Usage: "burn a number of asset units",
@@ -660,6 +1459,247 @@ func burnAssets(ctx *cli.Context) error {
 	return nil
 }
 
+var consolidateAssetsCommand = cli.Command{
+	Name:  "consolidate",
+	Usage: "merge many asset UTXOs into a single one",
+	Description: `
+	Merge many UTXOs of the same asset into a single output to this node,
+	smallest UTXOs first. Any other assets that reside in the spent anchor
+	outputs are carried over. Only assets with the same asset ID can be
+	merged, so if a group key is given, the UTXOs of each asset ID of the
+	group are merged into a separate output, all in the same transfer.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the asset to consolidate",
+		},
+		cli.StringFlag{
+			Name:  assetGroupKeyName,
+			Usage: "the group key of the assets to consolidate",
+		},
+		cli.Uint64Flag{
+			Name: maxInputsName,
+			Usage: "the maximum number of asset UTXOs to merge; " +
+				"defaults to 100 if not set",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/kw to use " +
+				"for the anchor transaction",
+		},
+	},
+	Action: consolidateAssets,
+}
+
+func consolidateAssets(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || ctx.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	req := &taprpc.ConsolidateAssetsRequest{}
+	switch {
+	case ctx.IsSet(assetIDName) && ctx.IsSet(assetGroupKeyName):
+		return fmt.Errorf("only one of asset ID or group key can be " +
+			"specified")
+
+	case ctx.IsSet(assetIDName):
+		assetIDBytes, err := hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("invalid asset ID")
+		}
+
+		req.Asset = &taprpc.ConsolidateAssetsRequest_AssetId{
+			AssetId: assetIDBytes,
+		}
+
+	case ctx.IsSet(assetGroupKeyName):
+		groupKeyBytes, err := hex.DecodeString(
+			ctx.String(assetGroupKeyName),
+		)
+		if err != nil {
+			return fmt.Errorf("invalid group key")
+		}
+
+		req.Asset = &taprpc.ConsolidateAssetsRequest_GroupKey{
+			GroupKey: groupKeyBytes,
+		}
+
+	default:
+		return fmt.Errorf("asset ID or group key must be specified")
+	}
+
+	maxInputs := ctx.Uint64(maxInputsName)
+	if maxInputs > math.MaxUint32 {
+		return fmt.Errorf("max inputs exceeds 2^32")
+	}
+	req.MaxInputs = uint32(maxInputs)
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+	req.FeeRate = feeRate
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ConsolidateAssets(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to consolidate assets: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var bumpTransferFeeCommand = cli.Command{
+	Name:  "bumpfee",
+	Usage: "bump the fee of a pending asset transfer",
+	Description: `
+	Bump the on-chain fee of a pending asset transfer whose anchor
+	transaction hasn't confirmed yet.
+
+	By default, the anchor transaction is replaced by a transaction that
+	pays the given fee rate (RBF). The additional fee is deducted from the
+	BTC change output. If --cpfp is set, a child transaction spending the
+	BTC change output is published instead, paying enough fees for both
+	transactions to reach the given fee rate.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  anchorTxidName,
+			Usage: "the txid of the current anchor transaction",
+		},
+		cli.Uint64Flag{
+			Name:  feeRateName,
+			Usage: "the target fee rate in sat/kw",
+		},
+		cli.BoolFlag{
+			Name: cpfpName,
+			Usage: "if set, the fee is bumped by spending the " +
+				"change output instead of replacing the " +
+				"anchor transaction",
+		},
+	},
+	Action: bumpTransferFee,
+}
+
+func bumpTransferFee(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || ctx.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	anchorTxHash, err := chainhash.NewHashFromStr(
+		ctx.String(anchorTxidName),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid anchor txid: %w", err)
+	}
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+	if feeRate == 0 {
+		return fmt.Errorf("fee rate must be specified")
+	}
+
+	method := taprpc.FeeBumpMethod_FEE_BUMP_METHOD_RBF
+	if ctx.Bool(cpfpName) {
+		method = taprpc.FeeBumpMethod_FEE_BUMP_METHOD_CPFP
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.BumpTransferFee(
+		ctxc, &taprpc.BumpTransferFeeRequest{
+			AnchorTxHash: anchorTxHash[:],
+			Method:       method,
+			FeeRate:      feeRate,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to bump transfer fee: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var abandonTransferCommand = cli.Command{
+	Name:  "abandon",
+	Usage: "abandon a pending asset transfer",
+	Description: `
+	Abandon a pending asset transfer whose anchor transaction hasn't
+	confirmed yet. The transfer is removed and the input assets become
+	spendable again.
+
+	By default, the BTC inputs of the anchor transaction are double spent
+	back to the wallet with the given fee rate, which must be high enough
+	to replace the anchor transaction. If --skip_double_spend is set, the
+	inputs are only released. This is only safe if the anchor transaction
+	was never broadcast or was evicted from the mempool.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  anchorTxidName,
+			Usage: "the txid of the current anchor transaction",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "the fee rate in sat/kw of the transaction " +
+				"double spending the anchor transaction",
+		},
+		cli.BoolFlag{
+			Name: skipDoubleSpendName,
+			Usage: "if set, the inputs of the anchor transaction " +
+				"are only released instead of being double " +
+				"spent",
+		},
+	},
+	Action: abandonTransfer,
+}
+
+func abandonTransfer(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || ctx.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	anchorTxHash, err := chainhash.NewHashFromStr(
+		ctx.String(anchorTxidName),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid anchor txid: %w", err)
+	}
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AbandonTransfer(
+		ctxc, &taprpc.AbandonTransferRequest{
+			AnchorTxHash:    anchorTxHash[:],
+			FeeRate:         feeRate,
+			SkipDoubleSpend: ctx.Bool(skipDoubleSpendName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to abandon transfer: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listTransfersCommand = cli.Command{
 	Name:      "transfers",
 	ShortName: "t",
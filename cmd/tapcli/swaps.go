@@ -0,0 +1,418 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	wrpc "github.com/lightninglabs/taproot-assets/taprpc/assetwalletrpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/urfave/cli"
+)
+
+var swapCommands = []cli.Command{
+	{
+		Name:      "swaps",
+		ShortName: "sw",
+		Usage:     "Create and sign atomic asset swaps.",
+		Category:  "Swaps",
+		Description: `
+	An atomic swap exchanges assets (or assets and BTC) between two parties
+	in a single anchor transaction. Each party funds and signs its own
+	virtual PSBT and hands it to the counterparty, along with the proofs of
+	its inputs. One party then creates the anchor transaction, the other
+	party signs it and either party can finalize and publish it.
+	`,
+		Subcommands: []cli.Command{
+			createSwapCommand,
+			signSwapCommand,
+			finalizeSwapCommand,
+		},
+	},
+}
+
+const (
+	localVPsbtFileName = "local_vpsbt_file"
+
+	remoteVPsbtFileName = "remote_vpsbt_file"
+
+	remoteProofFileName = "remote_proof_file"
+
+	expectedAddrName = "expected_addr"
+
+	expectedBtcOutputName = "expected_btc_output"
+
+	changeOutputName = "change_output"
+
+	remoteChangeOutputName = "remote_change_output"
+
+	btcOutputName = "btc_output"
+
+	anchorPsbtName = "anchor_psbt"
+)
+
+// swapTermsFlags are the flags describing the terms of a swap from the point
+// of view of the local party, which are shared by all swap commands.
+var swapTermsFlags = []cli.Flag{
+	cli.StringFlag{
+		Name: localVPsbtFileName,
+		Usage: "the path to the signed virtual PSBT of the local " +
+			"party; can be omitted if the local party only pays " +
+			"BTC in the swap",
+	},
+	cli.StringSliceFlag{
+		Name: remoteVPsbtFileName,
+		Usage: "the path to a signed virtual PSBT of the " +
+			"counterparty; can be specified multiple times",
+	},
+	cli.StringSliceFlag{
+		Name: remoteProofFileName,
+		Usage: "the path to the proof file of an input of the " +
+			"counterparty's virtual PSBTs, in the order of the " +
+			"virtual PSBTs and their inputs; can be specified " +
+			"multiple times",
+	},
+	cli.StringSliceFlag{
+		Name: expectedAddrName,
+		Usage: "a Taproot Asset address given to the counterparty " +
+			"that must be paid; can be specified multiple times",
+	},
+	cli.StringSliceFlag{
+		Name: expectedBtcOutputName,
+		Usage: "a BTC output the anchor transaction must pay to the " +
+			"local party, as address:amount_sat[:internal_key]; " +
+			"the internal key is required for P2TR addresses; " +
+			"can be specified multiple times",
+	},
+	cli.StringFlag{
+		Name: changeOutputName,
+		Usage: "the output of the local party the BTC of its " +
+			"anchor inputs is returned to, as " +
+			"address[:internal_key]; required when signing the " +
+			"anchor transaction created by the counterparty",
+	},
+	cli.StringFlag{
+		Name: remoteChangeOutputName,
+		Usage: "the output of the counterparty the BTC of its " +
+			"anchor inputs is returned to, as " +
+			"address[:internal_key]; required when creating " +
+			"the anchor transaction if the counterparty gives " +
+			"assets",
+	},
+}
+
+// parseSwapBtcOutput parses a BTC output of the form
+// address[:amount_sat][:internal_key]. The amount is only expected if
+// withAmount is true.
+func parseSwapBtcOutput(output string,
+	withAmount bool) (*wrpc.SwapBtcOutput, error) {
+
+	parts := strings.Split(output, ":")
+
+	minParts, maxParts := 1, 2
+	if withAmount {
+		minParts, maxParts = 2, 3
+	}
+	if len(parts) < minParts || len(parts) > maxParts {
+		return nil, fmt.Errorf("invalid BTC output %q", output)
+	}
+
+	btcOutput := &wrpc.SwapBtcOutput{
+		Address: parts[0],
+	}
+	parts = parts[1:]
+
+	if withAmount {
+		amount, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount of BTC output "+
+				"%q: %w", output, err)
+		}
+
+		btcOutput.AmountSat = amount
+		parts = parts[1:]
+	}
+
+	if len(parts) > 0 {
+		internalKey, err := hex.DecodeString(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid internal key of BTC "+
+				"output %q: %w", output, err)
+		}
+
+		btcOutput.InternalKey = internalKey
+	}
+
+	return btcOutput, nil
+}
+
+// parseSwapBtcOutputs parses all BTC outputs of the given slice flag.
+func parseSwapBtcOutputs(ctx *cli.Context, flagName string,
+	withAmount bool) ([]*wrpc.SwapBtcOutput, error) {
+
+	var outputs []*wrpc.SwapBtcOutput
+	for _, output := range ctx.StringSlice(flagName) {
+		btcOutput, err := parseSwapBtcOutput(output, withAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs = append(outputs, btcOutput)
+	}
+
+	return outputs, nil
+}
+
+// readSwapFiles reads all files of the given slice flag.
+func readSwapFiles(ctx *cli.Context, flagName string) ([][]byte, error) {
+	var contents [][]byte
+	for _, fileName := range ctx.StringSlice(flagName) {
+		filePath := lncfg.CleanAndExpandPath(fileName)
+		content, err := readFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %v: %w",
+				filePath, err)
+		}
+
+		contents = append(contents, content)
+	}
+
+	return contents, nil
+}
+
+// parseSwapTerms parses the swap terms from the shared swap flags.
+func parseSwapTerms(ctx *cli.Context) (*wrpc.SwapTerms, error) {
+	terms := &wrpc.SwapTerms{
+		ExpectedAddrs: ctx.StringSlice(expectedAddrName),
+	}
+
+	if ctx.IsSet(localVPsbtFileName) {
+		filePath := lncfg.CleanAndExpandPath(
+			ctx.String(localVPsbtFileName),
+		)
+		localVPsbt, err := readFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read local virtual "+
+				"PSBT: %w", err)
+		}
+
+		terms.LocalVirtualPsbt = localVPsbt
+	}
+
+	var err error
+	terms.RemoteVirtualPsbts, err = readSwapFiles(ctx, remoteVPsbtFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	terms.RemoteInputProofs, err = readSwapFiles(ctx, remoteProofFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	terms.ExpectedBtcOutputs, err = parseSwapBtcOutputs(
+		ctx, expectedBtcOutputName, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if ctx.IsSet(changeOutputName) {
+		terms.ChangeOutput, err = parseSwapBtcOutput(
+			ctx.String(changeOutputName), false,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if ctx.IsSet(remoteChangeOutputName) {
+		terms.RemoteChangeOutput, err = parseSwapBtcOutput(
+			ctx.String(remoteChangeOutputName), false,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return terms, nil
+}
+
+// parseAnchorPsbt parses the hex encoded anchor PSBT of a swap.
+func parseAnchorPsbt(ctx *cli.Context) ([]byte, error) {
+	anchorPsbt, err := hex.DecodeString(ctx.String(anchorPsbtName))
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor PSBT: %w", err)
+	}
+
+	return anchorPsbt, nil
+}
+
+var createSwapCommand = cli.Command{
+	Name:  "create",
+	Usage: "create and partially sign the anchor transaction of a swap",
+	Description: `
+	Create the anchor transaction of an atomic swap that commits to the
+	signed virtual PSBTs of both parties. The virtual PSBTs of the
+	counterparty are verified against the expected addresses first. The
+	anchor transaction is funded by the local wallet, which pays the chain
+	fees and any BTC outputs. All inputs except the ones spent by the
+	counterparty are signed.
+
+	The returned anchor PSBT must be handed to the counterparty to be
+	signed with the 'swaps sign' command.
+	`,
+	Flags: append([]cli.Flag{
+		cli.StringSliceFlag{
+			Name: btcOutputName,
+			Usage: "a BTC output the local party pays to the " +
+				"counterparty, as " +
+				"address:amount_sat[:internal_key]; can be " +
+				"specified multiple times",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/kw to use for " +
+				"the anchor transaction",
+		},
+	}, swapTermsFlags...),
+	Action: createSwap,
+}
+
+func createSwap(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || ctx.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	terms, err := parseSwapTerms(ctx)
+	if err != nil {
+		return err
+	}
+
+	btcOutputs, err := parseSwapBtcOutputs(ctx, btcOutputName, true)
+	if err != nil {
+		return err
+	}
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.CreateSwapPsbt(ctxc, &wrpc.CreateSwapPsbtRequest{
+		Terms:      terms,
+		BtcOutputs: btcOutputs,
+		FeeRate:    feeRate,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create swap: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var signSwapCommand = cli.Command{
+	Name:  "sign",
+	Usage: "verify and sign the anchor transaction of a swap",
+	Description: `
+	Verify the anchor transaction of an atomic swap created by the
+	counterparty and, if it commits to all virtual PSBTs, returns the BTC
+	of the local anchor inputs to the change output and pays the expected
+	addresses and BTC outputs, sign the anchor inputs of the local virtual
+	PSBT.
+	`,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name: anchorPsbtName,
+			Usage: "the hex encoded anchor PSBT created by the " +
+				"counterparty",
+		},
+	}, swapTermsFlags...),
+	Action: signSwap,
+}
+
+func signSwap(ctx *cli.Context) error {
+	if ctx.String(anchorPsbtName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	terms, err := parseSwapTerms(ctx)
+	if err != nil {
+		return err
+	}
+
+	anchorPsbt, err := parseAnchorPsbt(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.SignSwapPsbt(ctxc, &wrpc.SignSwapPsbtRequest{
+		Terms:      terms,
+		AnchorPsbt: anchorPsbt,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to sign swap: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var finalizeSwapCommand = cli.Command{
+	Name:  "finalize",
+	Usage: "finalize and publish the anchor transaction of a swap",
+	Description: `
+	Verify and finalize the fully signed anchor transaction of an atomic
+	swap and broadcast it. The transfer of the local virtual PSBT (if any)
+	is logged and its proofs are created once the anchor transaction
+	confirms.
+	`,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  anchorPsbtName,
+			Usage: "the hex encoded fully signed anchor PSBT",
+		},
+	}, swapTermsFlags...),
+	Action: finalizeSwap,
+}
+
+func finalizeSwap(ctx *cli.Context) error {
+	if ctx.String(anchorPsbtName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	terms, err := parseSwapTerms(ctx)
+	if err != nil {
+		return err
+	}
+
+	anchorPsbt, err := parseAnchorPsbt(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.PublishSwapPsbt(ctxc, &wrpc.PublishSwapPsbtRequest{
+		Terms:      terms,
+		AnchorPsbt: anchorPsbt,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to finalize swap: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
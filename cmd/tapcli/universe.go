@@ -1,9 +1,14 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 
 	tap "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -42,8 +47,11 @@ var universeCommands = []cli.Command{
 			universeDeleteRootCommand,
 			universeLeavesCommand,
 			universeKeysCommand,
+			universeBranchCommand,
 			universeProofCommand,
 			universeSyncCommand,
+			universeExportCommand,
+			universeImportCommand,
 			universeFederationCommand,
 			universeInfoCommand,
 			universeStatsCommand,
@@ -300,6 +308,81 @@ func universeKeys(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	depthName   = "depth"
+	pathKeyName = "path_key"
+)
+
+var universeBranchCommand = cli.Command{
+	Name:      "branch",
+	ShortName: "b",
+	Usage:     "return a node of the tree of a Universe",
+	Description: `
+	Query for the node at the given path of the MS-SMT tree of a given asset
+	universe. The path is made up of the first depth bits of the path key,
+	where a depth of 0 refers to the root of the tree. If the sub-tree below
+	the node only contains a single leaf, then the key of that leaf is
+	returned as well.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to query for",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to query for",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof to show the branch for, " +
+				"either 'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+		cli.UintFlag{
+			Name:  depthName,
+			Usage: "the depth of the node to query for",
+		},
+		cli.StringFlag{
+			Name: pathKeyName,
+			Usage: "the 32-byte hex encoded key that identifies " +
+				"the path to the node, defaults to all zeros",
+		},
+	},
+	Action: universeBranch,
+}
+
+func universeBranch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	pathKey := make([]byte, 32)
+	if ctx.IsSet(pathKeyName) {
+		pathKey, err = hex.DecodeString(ctx.String(pathKeyName))
+		if err != nil {
+			return fmt.Errorf("invalid path key: %w", err)
+		}
+	}
+
+	resp, err := client.QueryBranch(ctxc, &unirpc.BranchQuery{
+		Id:    universeID,
+		Depth: uint32(ctx.Uint(depthName)),
+		Key:   pathKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeLeavesCommand = cli.Command{
 	Name:      "leaves",
 	ShortName: "l",
@@ -388,12 +471,16 @@ var universeProofCommand = cli.Command{
 	on chain. Proofs are namespaced based on a top level assetID/groupKey,
 	so that must be specified for each command.
 
-	Two sub-commands are available: proof querying (query) and proof
-	insertion (insert).
+	Four sub-commands are available: proof querying (query), querying a
+	proof along with the on-chain commitment of the multiverse root it is
+	part of (committed), proof insertion (insert) and announcing a proof to
+	be relayed to the federation (announce).
 	`,
 	Subcommands: []cli.Command{
 		universeProofQueryCommand,
+		universeProofCommittedCommand,
 		universeProofInsertInsert,
+		universeProofAnnounceCommand,
 	},
 }
 
@@ -457,6 +544,58 @@ func universeProofQuery(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	blockHeightName = "block_height"
+)
+
+var universeProofCommittedCommand = cli.Command{
+	Name:  "committed",
+	Usage: "query for an issuance proof and its on-chain commitment",
+	Description: `
+	Attempt to query the target universe for a given issuance proof, along
+	with the on-chain commitment of the issuance multiverse root the proof
+	is part of. The latest commitment that confirmed at or below the given
+	block height is used, or the latest commitment if no height is given.
+	`,
+	Flags: append(universeProofArgs, cli.UintFlag{
+		Name: blockHeightName,
+		Usage: "the block height to select the commitment for, " +
+			"defaults to the latest commitment",
+	}),
+	Action: universeProofCommitted,
+}
+
+func universeProofCommitted(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	assetKey, err := parseAssetKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+	resp, err := client.QueryCommittedProof(
+		ctxc, &unirpc.CommittedProofQuery{
+			Key: &unirpc.UniverseKey{
+				Id:      universeID,
+				LeafKey: assetKey,
+			},
+			BlockHeight: uint32(ctx.Uint(blockHeightName)),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeProofInsertInsert = cli.Command{
 	Name:  "insert",
 	Usage: "insert a new universe proof",
@@ -471,56 +610,49 @@ var universeProofInsertInsert = cli.Command{
 	Action: universeProofInsert,
 }
 
-func universeProofInsert(ctx *cli.Context) error {
-	switch {
-	case ctx.String(proofPathName) == "":
-		return cli.ShowSubcommandHelp(ctx)
-	}
-
+// parseAssetProof parses the universe key and the last proof of the given
+// proof file into an asset proof that can be inserted into a universe.
+func parseAssetProof(ctx *cli.Context) (*unirpc.AssetProof, error) {
 	assetKey, err := parseAssetKey(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	universeID, err := parseUniverseID(ctx, true)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	filePath := lncfg.CleanAndExpandPath(ctx.String(proofPathName))
 	rawFile, err := readFile(filePath)
 	if err != nil {
-		return fmt.Errorf("unable to read proof file: %w", err)
+		return nil, fmt.Errorf("unable to read proof file: %w", err)
 	}
 
-	ctxc := getContext()
-	client, cleanUp := getUniverseClient(ctx)
-	defer cleanUp()
-
 	// The input should be the raw state transition proof, so we'll
 	// partially parse the proof so we can hand the raw proof bytes
 	// (without the checksum) to the server.
 	var proofFile proof.File
 	if err := proofFile.Decode(bytes.NewReader(rawFile)); err != nil {
-		return fmt.Errorf("unable to decode proof file: %w", err)
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
 	}
 
 	assetProof, err := proofFile.LastProof()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	rpcAsset, err := taprpc.MarshalAsset(
-		ctxc, &assetProof.Asset, false, true, nil,
+		getContext(), &assetProof.Asset, false, true, nil,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	rawProof, err := proofFile.RawLastProof()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	req := &unirpc.AssetProof{
+	return &unirpc.AssetProof{
 		Key: &unirpc.UniverseKey{
 			Id:      universeID,
 			LeafKey: assetKey,
@@ -529,7 +661,24 @@ func universeProofInsert(ctx *cli.Context) error {
 			Asset: rpcAsset,
 			Proof: rawProof,
 		},
+	}, nil
+}
+
+func universeProofInsert(ctx *cli.Context) error {
+	switch {
+	case ctx.String(proofPathName) == "":
+		return cli.ShowSubcommandHelp(ctx)
 	}
+
+	req, err := parseAssetProof(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
 	resp, err := client.InsertProof(ctxc, req)
 	if err != nil {
 		return err
@@ -539,6 +688,60 @@ func universeProofInsert(ctx *cli.Context) error {
 	return nil
 }
 
+const hopsLeftName = "hops_left"
+
+var universeProofAnnounceCommand = cli.Command{
+	Name:  "announce",
+	Usage: "announce a new universe proof to be relayed to the federation",
+	Description: `
+	Announce a new proof to the target universe. If the proof isn't known
+	to the universe yet, it's inserted like with the insert command, then
+	relayed to the members of the universe's federation as long as the
+	announcement has hops left. The proof can be accepted either via a file
+	argument (proof_file), via stdin, or via a hex encoded string.
+	`,
+	Flags: append(universeProofArgs,
+		cli.StringFlag{
+			Name: proofPathName,
+		},
+		cli.UintFlag{
+			Name: hopsLeftName,
+			Usage: "the number of times the announcement may be " +
+				"relayed, capped by the universe to its own " +
+				"configured number of hops",
+			Value: universe.DefaultGossipHops,
+		},
+	),
+	Action: universeProofAnnounce,
+}
+
+func universeProofAnnounce(ctx *cli.Context) error {
+	switch {
+	case ctx.String(proofPathName) == "":
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	assetProof, err := parseAssetProof(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.AnnounceProof(ctxc, &unirpc.ProofAnnouncement{
+		Proof:    assetProof,
+		HopsLeft: uint32(ctx.Uint(hopsLeftName)),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var (
 	universeHostName = "universe_host"
 )
@@ -616,6 +819,221 @@ func universeSync(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	snapshotFileName = "snapshot_file"
+)
+
+var universeExportCommand = cli.Command{
+	Name:  "export",
+	Usage: "export a snapshot of the local universes to a file",
+	Description: `
+	Export a self-verifying snapshot of the local Universe server to a file.
+	The snapshot contains all leaves of the selected universes, their proofs
+	and the MS-SMT roots they commit to. If no asset ID or group key is
+	specified, all universes are exported.
+
+	The snapshot file can be imported into another Universe server with
+	the 'universe import' command, which is much faster than syncing the
+	universes leaf by leaf.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  snapshotFileName,
+			Usage: "the path of the snapshot file to create",
+		},
+		cli.StringFlag{
+			Name: assetIDName,
+			Usage: "(optional) the asset ID of the universe to " +
+				"export",
+		},
+		cli.StringFlag{
+			Name: groupKeyName,
+			Usage: "(optional) the group key of the universe to " +
+				"export",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof of the universe to export, " +
+				"either 'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+	},
+	Action: universeExport,
+}
+
+func universeExport(ctx *cli.Context) error {
+	if ctx.String(snapshotFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	req := &unirpc.ExportSnapshotRequest{}
+	if ctx.IsSet(assetIDName) || ctx.IsSet(groupKeyName) {
+		universeID, err := parseUniverseID(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		req.Ids = append(req.Ids, universeID)
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.ExportSnapshot(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	// Make sure all parent directories of the given path exist as well.
+	filePath := lncfg.CleanAndExpandPath(ctx.String(snapshotFileName))
+	err = os.MkdirAll(filepath.Dir(filePath), defaultDirPerms)
+	if err != nil {
+		return fmt.Errorf("unable to create directory %v: %w",
+			filepath.Dir(filePath), err)
+	}
+
+	file, err := os.OpenFile(
+		filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, defaultFilePerms,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create snapshot file: %w", err)
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	if err := universe.WriteSnapshotHeader(w); err != nil {
+		return err
+	}
+
+	// We write each universe snapshot to the file as soon as it's
+	// received, so we never need to hold the whole snapshot in memory.
+	for {
+		snapshot, err := stream.Recv()
+		switch {
+		case errors.Is(err, io.EOF):
+			if err := w.Flush(); err != nil {
+				return err
+			}
+
+			return file.Sync()
+
+		case err != nil:
+			return err
+		}
+
+		err = universe.WriteSnapshotRecord(w, snapshot.Snapshot)
+		if err != nil {
+			return fmt.Errorf("unable to write snapshot: %w", err)
+		}
+
+		// Only print the summary of the exported universe, once its
+		// last chunk was written.
+		lastLeaf := snapshot.ChunkOffset + snapshot.NumChunkLeaves
+		if lastLeaf != snapshot.NumLeaves {
+			continue
+		}
+
+		snapshot.Snapshot = nil
+		snapshot.ChunkOffset = 0
+		snapshot.NumChunkLeaves = 0
+		printRespJSON(snapshot)
+	}
+}
+
+var universeImportCommand = cli.Command{
+	Name:  "import",
+	Usage: "import a snapshot file into the local universes",
+	Description: `
+	Import a snapshot file created with the 'universe export' command into
+	the local Universe server. The leaves of each universe in the snapshot
+	are verified against the MS-SMT root embedded in the snapshot, and only
+	leaves that aren't known yet are inserted.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  snapshotFileName,
+			Usage: "the path of the snapshot file to import",
+		},
+	},
+	Action: universeImport,
+}
+
+func universeImport(ctx *cli.Context) error {
+	if ctx.String(snapshotFileName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(snapshotFileName))
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to open snapshot file: %w", err)
+	}
+	defer file.Close()
+
+	r := bufio.NewReader(file)
+	if err := universe.ReadSnapshotHeader(r); err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	// Each record of the file is a chunk of the snapshot of a universe.
+	// The chunks of a universe are consecutive records, and are all sent
+	// over a single import stream, which is closed after the last chunk.
+	var stream unirpc.Universe_ImportSnapshotClient
+	for {
+		record, err := universe.ReadSnapshotRecord(r)
+		switch {
+		case errors.Is(err, io.EOF) && stream != nil:
+			return fmt.Errorf("snapshot file ends with an " +
+				"incomplete universe snapshot")
+
+		case errors.Is(err, io.EOF):
+			return nil
+
+		case err != nil:
+			return fmt.Errorf("unable to read snapshot: %w", err)
+		}
+
+		var chunk universe.SnapshotChunk
+		err = chunk.Decode(bytes.NewReader(record))
+		if err != nil {
+			return fmt.Errorf("unable to decode snapshot: %w", err)
+		}
+
+		if stream == nil {
+			stream, err = client.ImportSnapshot(ctxc)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = stream.Send(&unirpc.ImportSnapshotRequest{
+			Snapshot: record,
+		})
+
+		// If the server aborted the import, the reason is only
+		// returned when receiving the response.
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if err == nil && !chunk.IsLast() {
+			continue
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			return err
+		}
+		stream = nil
+
+		printRespJSON(resp)
+	}
+}
+
 var universeFederationCommand = cli.Command{
 	Name:      "federation",
 	ShortName: "f",
@@ -658,12 +1076,17 @@ func universeFederationList(ctx *cli.Context) error {
 	return nil
 }
 
+const discoverPeersName = "discover_peers"
+
 var universeFederationAddCommand = cli.Command{
 	Name:      "add",
 	ShortName: "a",
 	Description: `
 	Add a new server to the Federation. Newly added servers will be synced
 	automatically, will also be used to push out newly validated proofs.
+	If the discover_peers flag is set, then the Federation members of the
+	new server, and in turn their Federation members, will be added as
+	well.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -672,6 +1095,11 @@ var universeFederationAddCommand = cli.Command{
 				"testnet.mydomain.com:10029. The default port " +
 				"(10029) will be used if none is provided",
 		},
+		cli.BoolFlag{
+			Name: discoverPeersName,
+			Usage: "if set, the federation members of the server " +
+				"will be discovered and added as well",
+		},
 	},
 	Action: universeFederationAdd,
 }
@@ -693,6 +1121,7 @@ func universeFederationAdd(ctx *cli.Context) error {
 					Host: ctx.String(universeHostName),
 				},
 			},
+			DiscoverPeers: ctx.Bool(discoverPeersName),
 		},
 	)
 	if err != nil {
@@ -1204,12 +1633,14 @@ var universeStatsCommand = cli.Command{
 	Description: `
 	Query for a set of aggregate statistics related to the local Universe
 	server.  The 'universe stats asset' sub-command can be used to query
-	for stats for a given asset, asset name, or type.
+	for stats for a given asset, asset name, or type. The 'universe stats
+	subscribe' sub-command can be used to stream new Universe events.
 	`,
 	Action: universeStatsSummaryCommand,
 	Subcommands: []cli.Command{
 		universeAssetStatsCommand,
 		universeEventStatsCommand,
+		universeSubscribeEventsCommand,
 	},
 }
 
@@ -1387,3 +1818,103 @@ func universeEventStatsQueryCommand(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+const (
+	deliverExistingName = "deliver_existing"
+
+	resumeCursorName = "resume_cursor"
+)
+
+var universeSubscribeEventsCommand = cli.Command{
+	Name:      "subscribe",
+	ShortName: "sub",
+	Usage:     "stream new Universe events",
+	Description: `
+	Subscribe to the new leaves, root changes and sync events of the local
+	Universe server. The events can optionally be filtered by asset ID,
+	group key and proof type.
+
+	Each event carries a cursor. To resume a subscription without missing
+	any events, pass the cursor of the last event received along with
+	the --deliver_existing flag.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "(optional) the asset ID to stream events for",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "(optional) the group key to stream events for",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "(optional) the type of proof to stream " +
+				"events for, either 'issuance' or 'transfer'",
+		},
+		cli.BoolFlag{
+			Name: deliverExistingName,
+			Usage: "deliver the events that were logged before " +
+				"the subscription first",
+		},
+		cli.Uint64Flag{
+			Name: resumeCursorName,
+			Usage: "(optional) the cursor of the last event " +
+				"received, only later events are delivered",
+		},
+	},
+	Action: universeSubscribeEvents,
+}
+
+func universeSubscribeEvents(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	req := &unirpc.SubscribeUniverseEventsRequest{
+		DeliverExisting: ctx.Bool(deliverExistingName),
+		ResumeCursor:    ctx.Uint64(resumeCursorName),
+	}
+
+	if ctx.IsSet(assetIDName) {
+		assetID, err := hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return fmt.Errorf("invalid asset ID: %w", err)
+		}
+		req.AssetId = assetID
+	}
+
+	if ctx.IsSet(groupKeyName) {
+		groupKey, err := hex.DecodeString(ctx.String(groupKeyName))
+		if err != nil {
+			return fmt.Errorf("invalid group key: %w", err)
+		}
+		req.GroupKey = groupKey
+	}
+
+	if ctx.IsSet(proofTypeName) {
+		rpcProofType, err := parseProofType(ctx)
+		if err != nil {
+			return err
+		}
+		req.ProofType = *rpcProofType
+	}
+
+	stream, err := client.SubscribeUniverseEvents(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		printRespJSON(event)
+	}
+}
@@ -73,6 +73,16 @@ type SplitLocator struct {
 
 	// AssetVersion is the version that the asset split should use.
 	AssetVersion asset.Version
+
+	// ScriptVersion is the script version that the asset split should use.
+	ScriptVersion asset.ScriptVersion
+
+	// LockTime is the absolute lock time the asset split should commit to.
+	LockTime uint64
+
+	// RelativeLockTime is the relative lock time the asset split should
+	// commit to.
+	RelativeLockTime uint64
 }
 
 // Hash computes the hash of a SplitLocator, encumbering its `OutputIndex`,
@@ -197,6 +207,9 @@ func NewSplitCommitment(ctx context.Context, inputs []SplitCommitmentInput,
 		assetSplit := inputs[0].Asset.Copy()
 		assetSplit.Amount = locator.Amount
 		assetSplit.Version = locator.AssetVersion
+		assetSplit.ScriptVersion = locator.ScriptVersion
+		assetSplit.LockTime = locator.LockTime
+		assetSplit.RelativeLockTime = locator.RelativeLockTime
 
 		scriptKey, err := btcec.ParsePubKey(locator.ScriptKey[:])
 		if err != nil {
@@ -50,6 +50,8 @@ type RPCConfig struct {
 
 	AllowPublicStats bool
 
+	AllowPublicFederationList bool
+
 	LetsEncryptDir string
 
 	LetsEncryptListen string
@@ -114,6 +116,10 @@ type Config struct {
 
 	CoinSelect *tapfreighter.CoinSelect
 
+	// DefaultCoinSelectStrategy is the strategy used to select asset coins
+	// if a send request doesn't specify one.
+	DefaultCoinSelectStrategy tapfreighter.MultiCommitmentSelectStrategy
+
 	ChainPorter tapfreighter.Porter
 
 	UniverseArchive *universe.Archive
@@ -122,8 +128,24 @@ type Config struct {
 
 	UniverseFederation *universe.FederationEnvoy
 
+	// UniverseGossiper is used to announce new proofs to the federation
+	// and to relay the announcements of other servers.
+	UniverseGossiper *universe.Gossiper
+
 	UniverseStats universe.Telemetry
 
+	// UniverseEvents is used to log the changes to the local Universe and
+	// to notify subscribers about them.
+	UniverseEvents *universe.EventNotifier
+
+	// UniverseSnapshotter is used to export and import snapshots of the
+	// local universes.
+	UniverseSnapshotter *universe.Snapshotter
+
+	// UniverseCanonical is used to anchor the issuance multiverse root in
+	// the chain. This is nil if root commitments are disabled.
+	UniverseCanonical *universe.CanonicalUniverse
+
 	// UniversePublicAccess is flag which, If true, and the Universe server
 	// is on a public interface, valid proof from remote parties will be
 	// accepted, and proofs will be queryable by remote parties.
@@ -145,6 +167,11 @@ type Config struct {
 	// hooked up to.
 	LogWriter *build.RotatingLogWriter
 
+	// WatchOnly is a flag which, if true, indicates that the daemon never
+	// signs any transactions itself, as all signing is done by an external
+	// signer.
+	WatchOnly bool
+
 	*RPCConfig
 
 	*DatabaseConfig
@@ -487,6 +487,49 @@ func VerifyProofBlob(t *testing.T, tapClient taprpc.TaprootAssetsClient,
 		return err
 	}
 
+	// The median time past is the median of the timestamps of a block and
+	// the 10 blocks preceding it (BIP-113).
+	medianTimeSource := func(height uint32) (time.Time, error) {
+		var timestamps []int64
+		for i := uint32(0); i < 11 && i <= height; i++ {
+			blockHashResp, err := chainClient.GetBlockHash(
+				ctxb, &chainrpc.GetBlockHashRequest{
+					BlockHeight: int64(height - i),
+				},
+			)
+			if err != nil {
+				return time.Time{}, err
+			}
+
+			blockResp, err := chainClient.GetBlock(
+				ctxb, &chainrpc.GetBlockRequest{
+					BlockHash: blockHashResp.BlockHash,
+				},
+			)
+			if err != nil {
+				return time.Time{}, err
+			}
+
+			var header wire.BlockHeader
+			err = header.Deserialize(
+				bytes.NewReader(blockResp.RawBlock),
+			)
+			if err != nil {
+				return time.Time{}, err
+			}
+
+			timestamps = append(
+				timestamps, header.Timestamp.Unix(),
+			)
+		}
+
+		sort.Slice(timestamps, func(i, j int) bool {
+			return timestamps[i] < timestamps[j]
+		})
+
+		return time.Unix(timestamps[len(timestamps)/2], 0), nil
+	}
+
 	groupVerifier := func(groupKey *btcec.PublicKey) error {
 		assetGroupKey := hex.EncodeToString(
 			groupKey.SerializeCompressed(),
@@ -507,7 +550,9 @@ func VerifyProofBlob(t *testing.T, tapClient taprpc.TaprootAssetsClient,
 		return nil
 	}
 
-	snapshot, err := f.Verify(ctxt, headerVerifier, groupVerifier)
+	snapshot, err := f.Verify(
+		ctxt, headerVerifier, medianTimeSource, groupVerifier,
+	)
 	require.NoError(t, err)
 
 	return f, snapshot
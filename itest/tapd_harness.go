@@ -132,6 +132,10 @@ func newTapdHarness(t *testing.T, ht *harnessTest, cfg tapdConfig,
 	// also used within some tests for transferring proofs.
 	tapCfg.RpcConf.AllowPublicUniProofCourier = true
 
+	// Allow the universe servers of the tests to discover each other's
+	// federation members.
+	tapCfg.RpcConf.AllowPublicFederationList = true
+
 	// Decide which DB backend to use.
 	switch *dbbackend {
 	case tapcfg.DatabaseBackendSqlite:
@@ -366,6 +366,67 @@ func (t *CompactedTree) Get(ctx context.Context, key [hashSize]byte) (
 	return leaf, nil
 }
 
+// NodeAtPath returns the node at the given path within the MS-SMT. If the
+// subtree of the node only contains a single leaf, a compacted leaf at the
+// depth of the path is returned, which exposes the key of that leaf. If the
+// subtree is empty, the empty node at the depth of the path is returned.
+// Otherwise, the branch node at the path is returned.
+func (t *CompactedTree) NodeAtPath(ctx context.Context, path NodePath) (Node,
+	error) {
+
+	if path.Depth < 0 || path.Depth > MaxTreeLevels {
+		return nil, fmt.Errorf("invalid path depth %d", path.Depth)
+	}
+
+	var node Node
+	err := t.store.View(ctx, func(tx TreeStoreViewTx) error {
+		current, err := tx.RootNode()
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < path.Depth; i++ {
+			left, right, err := tx.GetChildren(
+				i, current.NodeHash(),
+			)
+			if err != nil {
+				return err
+			}
+			next, _ := stepOrder(i, &path.Key, left, right)
+
+			// The subtree of a compacted leaf only contains that
+			// leaf, so the node at the path is either the subtree
+			// of the leaf at the depth of the path, or empty.
+			if compLeaf, ok := next.(*CompactedLeafNode); ok {
+				node = EmptyTree[path.Depth]
+				if path.Contains(compLeaf.key) {
+					node = NewCompactedLeafNode(
+						path.Depth, &compLeaf.key,
+						compLeaf.LeafNode,
+					)
+				}
+
+				return nil
+			}
+
+			if IsEqualNode(next, EmptyTree[i+1]) {
+				node = EmptyTree[path.Depth]
+				return nil
+			}
+
+			current = next
+		}
+
+		node = current
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
+
 // MerkleProof generates a merkle proof for the leaf node found at the given key
 // within the MS-SMT. If a leaf node does not exist at the given key, then the
 // proof should be considered a non-inclusion proof. This is noted by the
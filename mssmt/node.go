@@ -68,6 +68,16 @@ func NewLeafNode(value []byte, sum uint64) *LeafNode {
 	}
 }
 
+// NewComputedLeafNode constructs a leaf node from the hash and sum of a leaf,
+// without its value. This allows the root of a tree to be computed without
+// holding the values of all its leaves in memory.
+func NewComputedLeafNode(nodeHash NodeHash, sum uint64) *LeafNode {
+	return &LeafNode{
+		nodeHash: &nodeHash,
+		sum:      sum,
+	}
+}
+
 // NodeHash returns the unique identifier for a MS-SMT node. It represents the
 // hash of the leaf committing to its internal data.
 func (n *LeafNode) NodeHash() NodeHash {
@@ -89,7 +99,13 @@ func (n *LeafNode) NodeSum() uint64 {
 
 // IsEmpty returns whether this is an empty leaf.
 func (n *LeafNode) IsEmpty() bool {
-	return len(n.Value) == 0 && n.sum == 0
+	if len(n.Value) != 0 || n.sum != 0 {
+		return false
+	}
+
+	// A computed leaf doesn't carry its value, so it's only empty if its
+	// hash is the one of the empty leaf.
+	return n.nodeHash == nil || *n.nodeHash == EmptyLeafNode.NodeHash()
 }
 
 // Copy returns a deep copy of the leaf node.
@@ -97,6 +97,68 @@ func bitIndex(idx uint8, key *[hashSize]byte) byte {
 	return (byteVal >> (idx % 8)) & 1
 }
 
+// NodePath is the path from the root of a MS-SMT to one of its nodes. The path
+// is made up of the first Depth bits of Key, using the same bit order as the
+// keys of the leaves, where a zero bit steps to the left child and a one bit
+// steps to the right child. The node at a path is the root of the subtree of
+// all leaves whose keys start with the bits of the path.
+type NodePath struct {
+	// Depth is the number of bits of the path, which is zero for the root
+	// and MaxTreeLevels for a leaf.
+	Depth int
+
+	// Key holds the bits of the path. All bits after the first Depth bits
+	// are zero.
+	Key [hashSize]byte
+}
+
+// NewNodePath creates the path of the given depth to the leaf with the given
+// key, which is the path of the subtree at that depth the leaf belongs to.
+func NewNodePath(depth int, key [hashSize]byte) (NodePath, error) {
+	if depth < 0 || depth > MaxTreeLevels {
+		return NodePath{}, fmt.Errorf("invalid path depth %d", depth)
+	}
+
+	path := NodePath{
+		Depth: depth,
+	}
+	for i := 0; i < depth; i++ {
+		if bitIndex(uint8(i), &key) == 1 {
+			path.Key[i/8] |= 1 << (i % 8)
+		}
+	}
+
+	return path, nil
+}
+
+// Child returns the path to the left child of the node at the path if bit is
+// zero, and the path to the right child otherwise.
+//
+// NOTE: The path must not be the path to a leaf.
+func (p NodePath) Child(bit byte) NodePath {
+	child := NodePath{
+		Depth: p.Depth + 1,
+		Key:   p.Key,
+	}
+	if bit != 0 {
+		child.Key[p.Depth/8] |= 1 << (p.Depth % 8)
+	}
+
+	return child
+}
+
+// Contains returns true if the leaf with the given key is in the subtree of
+// the node at the path.
+func (p NodePath) Contains(key [hashSize]byte) bool {
+	for i := 0; i < p.Depth; i++ {
+		if bitIndex(uint8(i), &key) != bitIndex(uint8(i), &p.Key) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // iterFunc is a type alias for closures to be invoked at every iteration of
 // walking through a tree.
 type iterFunc = func(height int, current, sibling, parent Node) error
@@ -537,6 +537,43 @@ func testHistoryIndependence(t *testing.T, makeStore makeTestTreeStoreFunc) {
 	require.Equal(t, tree1Root.NodeSum(), smol1Root.NodeSum())
 }
 
+// TestComputedLeaves asserts that a tree built from computed leaves, which only
+// carry the hash and sum of a leaf, results in the same root as a tree built
+// from the full leaves.
+func TestComputedLeaves(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leaves := randTree(100)
+
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	computedTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	for _, item := range leaves {
+		_, err := tree.Insert(ctx, item.key, item.leaf)
+		require.NoError(t, err)
+
+		computedLeaf := mssmt.NewComputedLeafNode(
+			item.leaf.NodeHash(), item.leaf.NodeSum(),
+		)
+		require.False(t, computedLeaf.IsEmpty())
+
+		_, err = computedTree.Insert(ctx, item.key, computedLeaf)
+		require.NoError(t, err)
+	}
+
+	root, err := tree.Root(ctx)
+	require.NoError(t, err)
+	computedRoot, err := computedTree.Root(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(root, computedRoot))
+
+	// A computed leaf with the hash of the empty leaf is still empty.
+	emptyLeaf := mssmt.NewComputedLeafNode(
+		mssmt.EmptyLeafNode.NodeHash(), 0,
+	)
+	require.True(t, emptyLeaf.IsEmpty())
+}
+
 // TestDeletion asserts that deleting all inserted leaves of a tree results in
 // an empty tree.
 func TestDeletion(t *testing.T) {
@@ -804,6 +841,92 @@ func TestMerkleProof(t *testing.T) {
 	}
 }
 
+// TestNodeAtPath tests that bisecting a compacted tree with the nodes at the
+// paths below its root finds all its leaves, and that the nodes at the paths
+// are consistent with the branches above them.
+func TestNodeAtPath(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	leaves := randTree(100)
+
+	for storeName, makeStore := range genTestStores(t) {
+		storeName, makeStore := storeName, makeStore
+		t.Run(storeName, func(t *testing.T) {
+			t.Parallel()
+
+			store, err := makeStore()
+			require.NoError(t, err)
+
+			tree := mssmt.NewCompactedTree(store)
+
+			// An empty tree only has the empty root.
+			root, err := tree.NodeAtPath(ctx, mssmt.NodePath{})
+			require.NoError(t, err)
+			require.True(t, mssmt.IsEqualNode(
+				root, mssmt.EmptyTree[0],
+			))
+
+			testInsertion(t, leaves, tree)
+
+			foundLeaves := make(map[[hashSize]byte]mssmt.Node)
+			var bisect func(path mssmt.NodePath) mssmt.Node
+			bisect = func(path mssmt.NodePath) mssmt.Node {
+				node, err := tree.NodeAtPath(ctx, path)
+				require.NoError(t, err)
+
+				emptyNode := mssmt.EmptyTree[path.Depth]
+				if mssmt.IsEqualNode(node, emptyNode) {
+					return node
+				}
+
+				leaf, ok := node.(*mssmt.CompactedLeafNode)
+				if ok {
+					require.True(
+						t, path.Contains(leaf.Key()),
+					)
+					foundLeaves[leaf.Key()] = leaf.LeafNode
+					return node
+				}
+
+				left := bisect(path.Child(0))
+				right := bisect(path.Child(1))
+				branch := mssmt.NewBranch(left, right)
+				require.True(t, mssmt.IsEqualNode(node, branch))
+
+				return node
+			}
+
+			root, err = tree.Root(ctx)
+			require.NoError(t, err)
+			require.True(t, mssmt.IsEqualNode(
+				root, bisect(mssmt.NodePath{}),
+			))
+
+			require.Len(t, foundLeaves, len(leaves))
+			for _, item := range leaves {
+				require.True(t, mssmt.IsEqualNode(
+					item.leaf, foundLeaves[item.key],
+				))
+
+				// The node at the full path of a leaf is the
+				// leaf itself.
+				leafPath, err := mssmt.NewNodePath(
+					mssmt.MaxTreeLevels, item.key,
+				)
+				require.NoError(t, err)
+				require.Equal(t, item.key, leafPath.Key)
+
+				node, err := tree.NodeAtPath(ctx, leafPath)
+				require.NoError(t, err)
+				require.True(t, mssmt.IsEqualNode(
+					item.leaf, node,
+				))
+			}
+		})
+	}
+}
+
 // TestBIPTestVectors tests that the BIP test vectors are passing.
 func TestBIPTestVectors(t *testing.T) {
 	t.Parallel()
@@ -76,6 +76,18 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/taprpc.TaprootAssets/BumpTransferFee": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/AbandonTransfer": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/taprpc.TaprootAssets/ConsolidateAssets": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/taprpc.TaprootAssets/FetchAssetMeta": {{
 			Entity: "assets",
 			Action: "read",
@@ -100,6 +112,14 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/assetwalletrpc.AssetWallet/CommitVirtualPsbts": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/PublishAndLogTransfer": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/assetwalletrpc.AssetWallet/NextInternalKey": {{
 			Entity: "assets",
 			Action: "write",
@@ -120,6 +140,34 @@ var (
 			Entity: "assets",
 			Action: "write",
 		}},
+		"/assetwalletrpc.AssetWallet/CreateMuSig2Session": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/RegisterMuSig2Nonces": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/SignMuSig2VirtualPsbt": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/CombineMuSig2VirtualPsbt": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/CreateSwapPsbt": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/SignSwapPsbt": {{
+			Entity: "assets",
+			Action: "write",
+		}},
+		"/assetwalletrpc.AssetWallet/PublishSwapPsbt": {{
+			Entity: "assets",
+			Action: "write",
+		}},
 		"/mintrpc.Mint/MintAsset": {{
 			Entity: "mint",
 			Action: "write",
@@ -136,6 +184,38 @@ var (
 			Entity: "mint",
 			Action: "read",
 		}},
+		"/mintrpc.Mint/BumpBatchFee": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/PublishSignedBatch": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/ScheduleBatch": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/AddRecurringMint": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/CancelRecurringMint": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/UpdateSeedling": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/RemoveSeedling": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/AddGroupWitnesses": {{
+			Entity: "mint",
+			Action: "write",
+		}},
 		"/universerpc.Universe/AssetRoots": {{
 			Entity: "universe",
 			Action: "read",
@@ -152,6 +232,14 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/QueryBranch": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/QueryCommittedProof": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/AssetLeaves": {{
 			Entity: "universe",
 			Action: "read",
@@ -164,6 +252,10 @@ var (
 			Entity: "universe",
 			Action: "write",
 		}},
+		"/universerpc.Universe/AnnounceProof": {{
+			Entity: "universe",
+			Action: "write",
+		}},
 		"/universerpc.Universe/SyncUniverse": {{
 			Entity: "universe",
 			Action: "write",
@@ -192,6 +284,18 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/SubscribeUniverseEvents": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/ExportSnapshot": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/ImportSnapshot": {{
+			Entity: "universe",
+			Action: "write",
+		}},
 		"/universerpc.Universe/SetFederationSyncConfig": {{
 			Entity: "universe",
 			Action: "write",
@@ -216,6 +320,7 @@ var (
 		"/universerpc.Universe/AssetRoots":      {},
 		"/universerpc.Universe/QueryAssetRoots": {},
 		"/universerpc.Universe/AssetLeafKeys":   {},
+		"/universerpc.Universe/QueryBranch":     {},
 		"/universerpc.Universe/AssetLeaves":     {},
 		"/universerpc.Universe/Info":            {},
 	}
@@ -224,7 +329,8 @@ var (
 // MacaroonWhitelist returns the set of RPC endpoints that don't require
 // macaroon authentication.
 func MacaroonWhitelist(allowPublicUniProofCourier bool,
-	allowPublicStats bool) map[string]struct{} {
+	allowPublicStats bool,
+	allowPublicFederationList bool) map[string]struct{} {
 
 	// Make a copy of the default whitelist.
 	whitelist := make(map[string]struct{})
@@ -236,7 +342,20 @@ func MacaroonWhitelist(allowPublicUniProofCourier bool,
 	// the whitelist.
 	if allowPublicUniProofCourier {
 		whitelist["/universerpc.Universe/QueryProof"] = struct{}{}
+		whitelist["/universerpc.Universe/QueryCommittedProof"] =
+			struct{}{}
 		whitelist["/universerpc.Universe/InsertProof"] = struct{}{}
+
+		// The federation gossip needs to announce proofs to remote
+		// servers.
+		whitelist["/universerpc.Universe/AnnounceProof"] = struct{}{}
+	}
+
+	// Conditionally allow remote servers to discover our federation
+	// members.
+	if allowPublicFederationList {
+		whitelist["/universerpc.Universe/ListFederationServers"] =
+			struct{}{}
 	}
 
 	// Conditionally add public stats RPC endpoints to the whitelist.
@@ -244,6 +363,8 @@ func MacaroonWhitelist(allowPublicUniProofCourier bool,
 		whitelist["/universerpc.Universe/QueryAssetStats"] = struct{}{}
 		whitelist["/universerpc.Universe/UniverseStats"] = struct{}{}
 		whitelist["/universerpc.Universe/QueryEvents"] = struct{}{}
+		whitelist["/universerpc.Universe/SubscribeUniverseEvents"] =
+			struct{}{}
 	}
 
 	return whitelist
@@ -19,15 +19,16 @@ import (
 )
 
 func genTaprootKeySpend(t testing.TB, privKey btcec.PrivateKey,
-	virtualTx *wire.MsgTx, input *asset.Asset, idx uint32) wire.TxWitness {
+	virtualTx *wire.MsgTx, input, newAsset *asset.Asset,
+	idx uint32) wire.TxWitness {
 
 	t.Helper()
 
 	virtualTxCopy := asset.VirtualTxWithInput(
-		virtualTx, input, idx, nil,
+		virtualTx, input, newAsset, idx, nil,
 	)
 	sigHash, err := tapscript.InputKeySpendSigHash(
-		virtualTxCopy, input, idx, txscript.SigHashDefault,
+		virtualTxCopy, input, newAsset, idx, txscript.SigHashDefault,
 	)
 	require.NoError(t, err)
 
@@ -182,6 +183,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 				Header:       *blockHeader,
 				Transactions: []*wire.MsgTx{chainTx},
 			},
+			BlockHeight:      genesisProof.BlockHeight + 1,
 			Tx:               chainTx,
 			TxIndex:          0,
 			OutputIndex:      0,
@@ -206,7 +208,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 	// Append the new transition to the genesis blob.
 	transitionBlob, transitionProof, err := AppendTransition(
 		genesisBlob, transitionParams, MockHeaderVerifier,
-		MockGroupVerifier,
+		MockMedianTimeSource, MockGroupVerifier,
 	)
 	require.NoError(t, err)
 	require.Greater(t, len(transitionBlob), len(genesisBlob))
@@ -375,6 +377,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 				Header:       *splitBlockHeader,
 				Transactions: []*wire.MsgTx{splitTx},
 			},
+			BlockHeight:      genesisProof.BlockHeight + 1,
 			Tx:               splitTx,
 			TxIndex:          0,
 			OutputIndex:      0,
@@ -399,7 +402,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 
 	split1Blob, split1Proof, err := AppendTransition(
 		transitionBlob, split1Params, MockHeaderVerifier,
-		MockGroupVerifier,
+		MockMedianTimeSource, MockGroupVerifier,
 	)
 	require.NoError(t, err)
 	require.Greater(t, len(split1Blob), len(transitionBlob))
@@ -414,6 +417,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 				Header:       *splitBlockHeader,
 				Transactions: []*wire.MsgTx{splitTx},
 			},
+			BlockHeight:      genesisProof.BlockHeight + 1,
 			Tx:               splitTx,
 			TxIndex:          0,
 			OutputIndex:      1,
@@ -441,7 +445,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 
 	split2Blob, split2Proof, err := AppendTransition(
 		transitionBlob, split2Params, MockHeaderVerifier,
-		MockGroupVerifier,
+		MockMedianTimeSource, MockGroupVerifier,
 	)
 	require.NoError(t, err)
 	require.Greater(t, len(split2Blob), len(transitionBlob))
@@ -457,6 +461,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 				Header:       *splitBlockHeader,
 				Transactions: []*wire.MsgTx{splitTx},
 			},
+			BlockHeight:      genesisProof.BlockHeight + 1,
 			Tx:               splitTx,
 			TxIndex:          0,
 			OutputIndex:      2,
@@ -484,7 +489,7 @@ func runAppendTransitionTest(t *testing.T, assetType asset.Type, amt uint64,
 
 	split3Blob, split3Proof, err := AppendTransition(
 		transitionBlob, split3Params, MockHeaderVerifier,
-		MockGroupVerifier,
+		MockMedianTimeSource, MockGroupVerifier,
 	)
 	require.NoError(t, err)
 	require.Greater(t, len(split3Blob), len(transitionBlob))
@@ -521,7 +526,7 @@ func signAssetTransfer(t testing.TB, prevProof *Proof, newAsset *asset.Asset,
 	virtualTx, _, err := tapscript.VirtualTx(newAsset, inputs)
 	require.NoError(t, err)
 	newWitness := genTaprootKeySpend(
-		t, *senderPrivKey, virtualTx, &prevProof.Asset, 0,
+		t, *senderPrivKey, virtualTx, &prevProof.Asset, newAsset, 0,
 	)
 	require.NoError(t, err)
 	newAsset.PrevWitnesses[0].TxWitness = newWitness
@@ -544,7 +549,8 @@ func verifyBlob(t testing.TB, blob Blob) *AssetSnapshot {
 	require.NoError(t, f.Decode(bytes.NewReader(blob)))
 
 	finalSnapshot, err := f.Verify(
-		context.Background(), MockHeaderVerifier, MockGroupVerifier,
+		context.Background(), MockHeaderVerifier, MockMedianTimeSource,
+		MockGroupVerifier,
 	)
 	require.NoError(t, err)
 
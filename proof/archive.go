@@ -118,8 +118,8 @@ type Archiver interface {
 	// already be present, and we just update (replace) it with the new
 	// proof.
 	ImportProofs(ctx context.Context, headerVerifier HeaderVerifier,
-		groupVerifier GroupVerifier, replace bool,
-		proofs ...*AnnotatedProof) error
+		medianTime MedianTimeSource, groupVerifier GroupVerifier,
+		replace bool, proofs ...*AnnotatedProof) error
 }
 
 // NotifyArchiver is an Archiver that also allows callers to subscribe to
@@ -279,7 +279,7 @@ func (f *FileArchiver) FetchProofs(_ context.Context,
 //
 // NOTE: This implements the Archiver interface.
 func (f *FileArchiver) ImportProofs(_ context.Context,
-	_ HeaderVerifier, _ GroupVerifier, replace bool,
+	_ HeaderVerifier, _ MedianTimeSource, _ GroupVerifier, replace bool,
 	proofs ...*AnnotatedProof) error {
 
 	for _, proof := range proofs {
@@ -420,8 +420,9 @@ func (m *MultiArchiver) FetchProofs(ctx context.Context,
 // outpoint of the first state transition will be used as the Genesis point.
 // The final resting place of the asset will be used as the script key itself.
 func (m *MultiArchiver) ImportProofs(ctx context.Context,
-	headerVerifier HeaderVerifier, groupVerifier GroupVerifier,
-	replace bool, proofs ...*AnnotatedProof) error {
+	headerVerifier HeaderVerifier, medianTime MedianTimeSource,
+	groupVerifier GroupVerifier, replace bool,
+	proofs ...*AnnotatedProof) error {
 
 	// Before we import the proofs into the archive, we want to make sure
 	// that they're all valid. Along the way, we may augment the locator
@@ -430,7 +431,7 @@ func (m *MultiArchiver) ImportProofs(ctx context.Context,
 		// First, we'll decode and then also verify the proof.
 		finalStateTransition, err := m.proofVerifier.Verify(
 			c, bytes.NewReader(proof.Blob), headerVerifier,
-			groupVerifier,
+			medianTime, groupVerifier,
 		)
 		if err != nil {
 			return fmt.Errorf("unable to verify proof: %w", err)
@@ -470,7 +471,8 @@ func (m *MultiArchiver) ImportProofs(ctx context.Context,
 	// to import each proof our archive backends.
 	for _, archive := range m.backends {
 		err := archive.ImportProofs(
-			ctx, headerVerifier, groupVerifier, replace, proofs...,
+			ctx, headerVerifier, medianTime, groupVerifier, replace,
+			proofs...,
 		)
 		if err != nil {
 			return err
@@ -537,7 +539,8 @@ var _ NotifyArchiver = (*MultiArchiver)(nil)
 // assets of the same ID. This is useful when we want to update the proof with a
 // new one after a re-org.
 func ReplaceProofInBlob(ctx context.Context, p *Proof, archive Archiver,
-	headerVerifier HeaderVerifier, groupVerifier GroupVerifier) error {
+	headerVerifier HeaderVerifier, medianTime MedianTimeSource,
+	groupVerifier GroupVerifier) error {
 
 	// This is a bit of a hacky part. If we have a chain of transactions
 	// that were re-organized, we can't verify the whole chain until all of
@@ -615,7 +618,8 @@ func ReplaceProofInBlob(ctx context.Context, p *Proof, archive Archiver,
 			Blob:    buf.Bytes(),
 		}
 		err = archive.ImportProofs(
-			ctx, headerVerifier, groupVerifier, true, directProof,
+			ctx, headerVerifier, medianTime, groupVerifier, true,
+			directProof,
 		)
 		if err != nil {
 			return fmt.Errorf("unable to import updated proof: %w",
@@ -114,8 +114,8 @@ func TestFileArchiver(t *testing.T) {
 				require.NoError(
 					t, archive.ImportProofs(
 						ctx, MockHeaderVerifier,
-						MockGroupVerifier, false,
-						proof,
+						MockMedianTimeSource,
+						MockGroupVerifier, false, proof,
 					),
 				)
 			}
@@ -3,8 +3,11 @@ package proof
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"net/url"
 
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightningnetwork/lnd/tlv"
@@ -18,6 +21,10 @@ const (
 	// bytes without any specific interpretation.
 	MetaOpaque MetaType = 0
 
+	// MetaJson signals that the meta data is a JSON object that follows
+	// the JsonMeta schema.
+	MetaJson MetaType = 1
+
 	// MetaDataMaxSizeBytes is the maximum length of the meta data. We limit
 	// this to 1MiB for now. This should be of sufficient size to commit to
 	// any JSON data or even medium resolution images. If there is need to
@@ -27,6 +34,10 @@ const (
 	// the universe and needs to be validated by all senders and receivers
 	// of the asset.
 	MetaDataMaxSizeBytes = 1024 * 1024
+
+	// MaxDecimalDisplay is the maximum number of decimal places the
+	// amounts of an asset can be displayed with.
+	MaxDecimalDisplay = 12
 )
 
 var (
@@ -35,8 +46,77 @@ var (
 
 	// ErrMetaDataTooLarge signals that the meta data is too large.
 	ErrMetaDataTooLarge = errors.New("meta data too large")
+
+	// ErrInvalidJsonMeta signals that the meta data of the JSON type
+	// doesn't follow the JsonMeta schema.
+	ErrInvalidJsonMeta = errors.New("invalid JSON meta data")
 )
 
+// JsonMeta is the schema of the meta data of the MetaJson type. All fields are
+// optional, and fields not defined by the schema are allowed, so issuers can
+// commit to additional data.
+type JsonMeta struct {
+	// Name is the human-readable name of the asset.
+	Name string `json:"name,omitempty"`
+
+	// Description is a human-readable description of the asset.
+	Description string `json:"description,omitempty"`
+
+	// DecimalDisplay is the number of decimal places the amounts of the
+	// asset should be displayed with. An amount of 1234 units with a
+	// decimal display of 2 is displayed as 12.34.
+	DecimalDisplay uint32 `json:"decimal_display,omitempty"`
+
+	// IconURL is the absolute URL of the icon of the asset.
+	IconURL string `json:"icon_url,omitempty"`
+
+	// IssuerURL is the absolute URL of the website of the issuer.
+	IssuerURL string `json:"issuer_url,omitempty"`
+}
+
+// DecodeJsonMeta decodes the given meta data of the MetaJson type, and
+// validates that it follows the JsonMeta schema.
+func DecodeJsonMeta(data []byte) (*JsonMeta, error) {
+	// The schema only describes a JSON object, so we reject any other
+	// valid JSON values like arrays or strings.
+	data = bytes.TrimSpace(data)
+	if !json.Valid(data) || len(data) == 0 || data[0] != '{' {
+		return nil, fmt.Errorf("%w: data must be a JSON object",
+			ErrInvalidJsonMeta)
+	}
+
+	var jsonMeta JsonMeta
+	if err := json.Unmarshal(data, &jsonMeta); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJsonMeta, err)
+	}
+
+	if jsonMeta.DecimalDisplay > MaxDecimalDisplay {
+		return nil, fmt.Errorf("%w: decimal display cannot exceed %d",
+			ErrInvalidJsonMeta, MaxDecimalDisplay)
+	}
+
+	urls := []struct {
+		name  string
+		value string
+	}{
+		{"icon_url", jsonMeta.IconURL},
+		{"issuer_url", jsonMeta.IssuerURL},
+	}
+	for _, u := range urls {
+		if u.value == "" {
+			continue
+		}
+
+		parsedURL, err := url.Parse(u.value)
+		if err != nil || !parsedURL.IsAbs() {
+			return nil, fmt.Errorf("%w: %s must be an absolute URL",
+				ErrInvalidJsonMeta, u.name)
+		}
+	}
+
+	return &jsonMeta, nil
+}
+
 // MetaReveal is an optional TLV type that can be added to the proof of a
 // genesis asset to reveal pre-image to the metadata hash. If present, then the
 // following equality must hold for the genesis proof to be valid:
@@ -66,9 +146,45 @@ func (m *MetaReveal) Validate() error {
 		return ErrMetaDataTooLarge
 	}
 
+	// Structured meta data must follow its schema.
+	if m.Type == MetaJson {
+		if _, err := DecodeJsonMeta(m.Data); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// JsonMeta decodes the meta data of the MetaJson type, and validates that it
+// follows the JsonMeta schema.
+func (m *MetaReveal) JsonMeta() (*JsonMeta, error) {
+	if m.Type != MetaJson {
+		return nil, fmt.Errorf("meta type %d is not JSON", m.Type)
+	}
+
+	return DecodeJsonMeta(m.Data)
+}
+
+// NewJsonMetaReveal creates a meta reveal of the MetaJson type that commits to
+// the given JSON meta.
+func NewJsonMetaReveal(jsonMeta *JsonMeta) (*MetaReveal, error) {
+	data, err := json.Marshal(jsonMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	metaReveal := &MetaReveal{
+		Type: MetaJson,
+		Data: data,
+	}
+	if err := metaReveal.Validate(); err != nil {
+		return nil, err
+	}
+
+	return metaReveal, nil
+}
+
 // MetaHash returns the computed meta hash based on the TLV serialization of
 // the meta data itself.
 func (m *MetaReveal) MetaHash() [asset.MetaHashLen]byte {
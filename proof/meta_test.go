@@ -38,6 +38,27 @@ func TestValidateMetaReveal(t *testing.T) {
 			Data: make([]byte, MetaDataMaxSizeBytes+1),
 		},
 		expectedErr: ErrMetaDataTooLarge,
+	}, {
+		name: "valid JSON reveal",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`{"name": "foo", "decimal_display": 2}`),
+		},
+		expectedErr: nil,
+	}, {
+		name: "JSON reveal not an object",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`["foo"]`),
+		},
+		expectedErr: ErrInvalidJsonMeta,
+	}, {
+		name: "JSON reveal with invalid field type",
+		reveal: &MetaReveal{
+			Type: MetaJson,
+			Data: []byte(`{"decimal_display": "2"}`),
+		},
+		expectedErr: ErrInvalidJsonMeta,
 	}}
 
 	for _, tc := range testCases {
@@ -57,3 +78,68 @@ func TestValidateMetaReveal(t *testing.T) {
 		})
 	}
 }
+
+// TestDecodeJsonMeta tests that JSON meta data is decoded and validated
+// against the JsonMeta schema.
+func TestDecodeJsonMeta(t *testing.T) {
+	t.Parallel()
+
+	// Fields that aren't part of the schema are allowed.
+	jsonMeta, err := DecodeJsonMeta([]byte(`{
+		"name": "Test Coin",
+		"description": "a coin for testing",
+		"decimal_display": 8,
+		"icon_url": "https://example.com/icon.png",
+		"issuer_url": "https://example.com",
+		"ticker": "TST"
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, &JsonMeta{
+		Name:           "Test Coin",
+		Description:    "a coin for testing",
+		DecimalDisplay: 8,
+		IconURL:        "https://example.com/icon.png",
+		IssuerURL:      "https://example.com",
+	}, jsonMeta)
+
+	// All fields are optional.
+	jsonMeta, err = DecodeJsonMeta([]byte(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, &JsonMeta{}, jsonMeta)
+
+	invalidMetas := []string{
+		``,
+		`{"name": "foo"`,
+		`"foo"`,
+		`{"name": 1}`,
+		`{"decimal_display": -1}`,
+		`{"decimal_display": 13}`,
+		`{"icon_url": "icon.png"}`,
+		`{"issuer_url": "example.com"}`,
+	}
+	for _, invalidMeta := range invalidMetas {
+		_, err := DecodeJsonMeta([]byte(invalidMeta))
+		require.ErrorIs(t, err, ErrInvalidJsonMeta, invalidMeta)
+	}
+
+	// Only meta data of the JSON type is decoded.
+	opaqueMeta := &MetaReveal{
+		Type: MetaOpaque,
+		Data: []byte(`{}`),
+	}
+	_, err = opaqueMeta.JsonMeta()
+	require.ErrorContains(t, err, "not JSON")
+
+	// A meta reveal created from a JSON meta decodes to the same meta.
+	metaReveal, err := NewJsonMetaReveal(&JsonMeta{DecimalDisplay: 2})
+	require.NoError(t, err)
+	require.Equal(t, MetaJson, metaReveal.Type)
+	require.Equal(t, `{"decimal_display":2}`, string(metaReveal.Data))
+
+	jsonMeta, err = metaReveal.JsonMeta()
+	require.NoError(t, err)
+	require.EqualValues(t, 2, jsonMeta.DecimalDisplay)
+
+	_, err = NewJsonMetaReveal(&JsonMeta{DecimalDisplay: 13})
+	require.ErrorIs(t, err, ErrInvalidJsonMeta)
+}
@@ -161,7 +161,11 @@ func NewMintingBlobs(params *MintParams, headerVerifier HeaderVerifier,
 	for key := range proofs {
 		proof := proofs[key]
 
-		_, err := proof.Verify(ctx, nil, headerVerifier, groupVerifier)
+		// Minting proofs don't have any inputs, so there are no lock
+		// times to verify that would require a median time source.
+		_, err := proof.Verify(
+			ctx, nil, headerVerifier, nil, groupVerifier,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("invalid proof file generated: "+
 				"%w", err)
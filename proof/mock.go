@@ -28,7 +28,7 @@ func NewMockVerifier(t *testing.T) *MockVerifier {
 }
 
 func (m *MockVerifier) Verify(_ context.Context, _ io.Reader,
-	headerVerifier HeaderVerifier,
+	headerVerifier HeaderVerifier, medianTime MedianTimeSource,
 	groupVerifier GroupVerifier) (*AssetSnapshot, error) {
 
 	return &AssetSnapshot{
@@ -51,6 +51,16 @@ func MockHeaderVerifier(header wire.BlockHeader, height uint32) error {
 	return nil
 }
 
+// MockMedianTimeSource is a mock median time source which returns the UNIX
+// epoch for all blocks.
+//
+// Looking up the median time past of a block requires chain data, which is not
+// available in unit tests. This function is useful for unit tests which don't
+// use time based lock times.
+func MockMedianTimeSource(blockHeight uint32) (time.Time, error) {
+	return time.Unix(0, 0), nil
+}
+
 // MockGroupVerifier is a mock verifier which approves of all group keys.
 //
 // Group key verification usually involves having imported the group anchor
@@ -105,6 +105,19 @@ var (
 	// invalid.
 	ErrProofInvalid = errors.New("proof is invalid")
 
+	// ErrLockTimeNotReached is an error returned if an asset commits to an
+	// absolute lock time that isn't satisfied by the block that confirmed
+	// the anchor transaction.
+	ErrLockTimeNotReached = errors.New("asset lock time not reached")
+
+	// ErrRelativeLockTimeNotReached is an error returned if an asset
+	// commits to a relative lock time that isn't satisfied by the block
+	// that confirmed the anchor transaction, measured from the block that
+	// confirmed the anchor transaction of one of the inputs.
+	ErrRelativeLockTimeNotReached = errors.New(
+		"asset relative lock time not reached",
+	)
+
 	// RegtestTestVectorName is the name of the test vector file that is
 	// generated/updated by an actual integration test run on regtest. It is
 	// exported here, so we can use it in the integration tests.
@@ -599,7 +599,7 @@ func TestGenesisProofVerification(t *testing.T) {
 			)
 			_, err := genesisProof.Verify(
 				context.Background(), nil, MockHeaderVerifier,
-				MockGroupVerifier,
+				MockMedianTimeSource, MockGroupVerifier,
 			)
 			require.ErrorIs(t, err, tc.expectedErr)
 
@@ -660,7 +660,8 @@ func TestProofBlockHeaderVerification(t *testing.T) {
 	// Verify that the original proof block header is as expected and
 	// therefore an error is not returned.
 	_, err := proof.Verify(
-		context.Background(), nil, headerVerifier, MockGroupVerifier,
+		context.Background(), nil, headerVerifier,
+		MockMedianTimeSource, MockGroupVerifier,
 	)
 	require.NoError(t, err)
 
@@ -668,7 +669,8 @@ func TestProofBlockHeaderVerification(t *testing.T) {
 	// propagates the correct error.
 	proof.BlockHeader.Nonce += 1
 	_, actualErr := proof.Verify(
-		context.Background(), nil, headerVerifier, MockGroupVerifier,
+		context.Background(), nil, headerVerifier,
+		MockMedianTimeSource, MockGroupVerifier,
 	)
 	require.ErrorIs(t, actualErr, errHeaderVerifier)
 
@@ -679,7 +681,8 @@ func TestProofBlockHeaderVerification(t *testing.T) {
 	// propagates the correct error.
 	proof.BlockHeight += 1
 	_, actualErr = proof.Verify(
-		context.Background(), nil, headerVerifier, MockGroupVerifier,
+		context.Background(), nil, headerVerifier,
+		MockMedianTimeSource, MockGroupVerifier,
 	)
 	require.ErrorIs(t, actualErr, errHeaderVerifier)
 }
@@ -700,7 +703,8 @@ func TestProofFileVerification(t *testing.T) {
 	require.NoError(t, err)
 
 	_, err = f.Verify(
-		context.Background(), MockHeaderVerifier, MockGroupVerifier,
+		context.Background(), MockHeaderVerifier, MockMedianTimeSource,
+		MockGroupVerifier,
 	)
 	require.NoError(t, err)
 
@@ -708,7 +712,8 @@ func TestProofFileVerification(t *testing.T) {
 	f.Version = Version(212)
 
 	lastAsset, err := f.Verify(
-		context.Background(), MockHeaderVerifier, MockGroupVerifier,
+		context.Background(), MockHeaderVerifier, MockMedianTimeSource,
+		MockGroupVerifier,
 	)
 	require.Nil(t, lastAsset)
 	require.ErrorIs(t, err, ErrUnknownVersion)
@@ -755,7 +760,7 @@ func TestProofVerification(t *testing.T) {
 
 	lastAsset, err := p.Verify(
 		context.Background(), nil, MockHeaderVerifier,
-		MockGroupVerifier,
+		MockMedianTimeSource, MockGroupVerifier,
 	)
 	require.Nil(t, lastAsset)
 	require.ErrorIs(t, err, ErrUnknownVersion)
@@ -778,12 +783,157 @@ func TestOwnershipProofVerification(t *testing.T) {
 
 	snapshot, err := p.Verify(
 		context.Background(), nil, MockHeaderVerifier,
-		MockGroupVerifier,
+		MockMedianTimeSource, MockGroupVerifier,
 	)
 	require.NoError(t, err)
 	require.NotNil(t, snapshot)
 }
 
+// TestProofLockTimeVerification tests that the absolute and relative lock
+// times of an asset are verified against the block that confirmed the anchor
+// transaction if they are committed to by a ScriptV1 input.
+func TestProofLockTimeVerification(t *testing.T) {
+	t.Parallel()
+
+	const (
+		blockHeight = 800_000
+		inputHeight = blockHeight - 10
+	)
+	var (
+		blockTime  = time.Unix(1_700_000_000, 0)
+		inputTime  = blockTime.Add(-time.Hour)
+		headerTime = blockTime.Add(time.Hour)
+		v1Input    = &AssetSnapshot{
+			Asset: &asset.Asset{
+				ScriptVersion: asset.ScriptV1,
+			},
+			AnchorBlockHeight: inputHeight,
+		}
+		v0Input = &AssetSnapshot{
+			Asset: &asset.Asset{
+				ScriptVersion: asset.ScriptV0,
+			},
+			AnchorBlockHeight: inputHeight,
+		}
+		unknownInput = &AssetSnapshot{
+			Asset: &asset.Asset{
+				ScriptVersion: asset.ScriptV1,
+			},
+		}
+		secondsUnit = int64(1) << wire.SequenceLockTimeGranularity
+	)
+
+	// The time based lock times are checked against the median time past
+	// of the block preceding the respective anchor block.
+	medianTime := func(height uint32) (time.Time, error) {
+		switch height {
+		case blockHeight - 1:
+			return blockTime, nil
+		case inputHeight - 1:
+			return inputTime, nil
+		default:
+			return time.Time{}, fmt.Errorf("unknown height %d",
+				height)
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		lockTime         uint64
+		relativeLockTime uint64
+		inputs           []*AssetSnapshot
+		err              error
+	}{{
+		name:   "no lock times",
+		inputs: []*AssetSnapshot{v1Input},
+	}, {
+		name:     "height lock time reached",
+		lockTime: blockHeight - 1,
+		inputs:   []*AssetSnapshot{v1Input},
+	}, {
+		name:     "height lock time not reached",
+		lockTime: blockHeight,
+		inputs:   []*AssetSnapshot{v1Input},
+		err:      ErrLockTimeNotReached,
+	}, {
+		name:     "time lock time reached",
+		lockTime: uint64(blockTime.Unix() - 1),
+		inputs:   []*AssetSnapshot{v1Input},
+	}, {
+		name:     "time lock time not reached",
+		lockTime: uint64(blockTime.Unix()),
+		inputs:   []*AssetSnapshot{v1Input},
+		err:      ErrLockTimeNotReached,
+	}, {
+		name:     "time lock time uses median time past",
+		lockTime: uint64(headerTime.Unix() - 1),
+		inputs:   []*AssetSnapshot{v1Input},
+		err:      ErrLockTimeNotReached,
+	}, {
+		name:             "relative height lock time reached",
+		relativeLockTime: blockHeight - inputHeight,
+		inputs:           []*AssetSnapshot{v1Input},
+	}, {
+		name:             "relative height lock time not reached",
+		relativeLockTime: blockHeight - inputHeight + 1,
+		inputs:           []*AssetSnapshot{v1Input},
+		err:              ErrRelativeLockTimeNotReached,
+	}, {
+		name: "relative time lock time reached",
+		relativeLockTime: wire.SequenceLockTimeIsSeconds |
+			uint64(int64(time.Hour.Seconds())/secondsUnit),
+		inputs: []*AssetSnapshot{v1Input},
+	}, {
+		name: "relative time lock time not reached",
+		relativeLockTime: wire.SequenceLockTimeIsSeconds |
+			uint64(int64(time.Hour.Seconds())/secondsUnit+1),
+		inputs: []*AssetSnapshot{v1Input},
+		err:    ErrRelativeLockTimeNotReached,
+	}, {
+		name: "relative lock time disabled",
+		relativeLockTime: wire.SequenceLockTimeDisabled |
+			(blockHeight - inputHeight + 1),
+		inputs: []*AssetSnapshot{v1Input},
+	}, {
+		name:             "relative lock time unknown input anchor",
+		relativeLockTime: blockHeight - inputHeight,
+		inputs:           []*AssetSnapshot{v1Input, unknownInput},
+		err:              ErrRelativeLockTimeNotReached,
+	}, {
+		name:             "lock times not committed to by v0 inputs",
+		lockTime:         blockHeight,
+		relativeLockTime: blockHeight - inputHeight + 1,
+		inputs:           []*AssetSnapshot{v0Input},
+	}, {
+		name:             "lock times committed to by mixed inputs",
+		relativeLockTime: blockHeight - inputHeight + 1,
+		inputs:           []*AssetSnapshot{v0Input, v1Input},
+		err:              ErrRelativeLockTimeNotReached,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			p := &Proof{
+				BlockHeight: blockHeight,
+				BlockHeader: wire.BlockHeader{
+					Timestamp: headerTime,
+				},
+			}
+			newAsset := &asset.Asset{
+				LockTime:         testCase.lockTime,
+				RelativeLockTime: testCase.relativeLockTime,
+			}
+
+			err := p.verifyLockTimes(
+				newAsset, testCase.inputs, medianTime,
+			)
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
+
 // TestProofReplacement ensures that proofs can be replaced in a proof file.
 func TestProofReplacement(t *testing.T) {
 	// We create a file with 1k proofs.
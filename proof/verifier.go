@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 	"runtime"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/txscript"
@@ -13,6 +15,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/vm"
 	"golang.org/x/sync/errgroup"
@@ -24,7 +27,7 @@ type Verifier interface {
 	// error if the proof file is valid. A valid file should return an
 	// AssetSnapshot of the final state transition of the file.
 	Verify(c context.Context, blobReader io.Reader,
-		headerVerifier HeaderVerifier,
+		headerVerifier HeaderVerifier, medianTime MedianTimeSource,
 		groupVerifier GroupVerifier) (*AssetSnapshot, error)
 }
 
@@ -37,7 +40,7 @@ type BaseVerifier struct {
 // error if the proof file is valid. A valid file should return an
 // AssetSnapshot of the final state transition of the file.
 func (b *BaseVerifier) Verify(ctx context.Context, blobReader io.Reader,
-	headerVerifier HeaderVerifier,
+	headerVerifier HeaderVerifier, medianTime MedianTimeSource,
 	groupVerifier GroupVerifier) (*AssetSnapshot, error) {
 
 	var proofFile File
@@ -46,7 +49,7 @@ func (b *BaseVerifier) Verify(ctx context.Context, blobReader io.Reader,
 		return nil, fmt.Errorf("unable to parse proof: %w", err)
 	}
 
-	return proofFile.Verify(ctx, headerVerifier, groupVerifier)
+	return proofFile.Verify(ctx, headerVerifier, medianTime, groupVerifier)
 }
 
 // verifyTaprootProof attempts to verify a TaprootProof for inclusion or
@@ -166,7 +169,8 @@ func (p *Proof) verifyExclusionProofs() error {
 // state transition represents an asset split.
 func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 	prev *AssetSnapshot, headerVerifier HeaderVerifier,
-	groupVerifier GroupVerifier) (bool, error) {
+	medianTime MedianTimeSource, groupVerifier GroupVerifier) (bool,
+	error) {
 
 	// Determine whether we have an asset split based on the resulting
 	// asset's witness. If so, extract the root asset from the split asset.
@@ -184,8 +188,12 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 	}
 
 	// Gather the set of asset inputs leading to the state transition.
-	var prevAssets commitment.InputSet
+	var (
+		prevAssets commitment.InputSet
+		inputs     []*AssetSnapshot
+	)
 	if prev != nil {
+		inputs = append(inputs, prev)
 		prevAssets = commitment.InputSet{
 			asset.PrevID{
 				OutPoint: p.PrevOut,
@@ -211,7 +219,7 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 
 		errGroup.Go(func() error {
 			result, err := inputProof.Verify(
-				ctx, headerVerifier, groupVerifier,
+				ctx, headerVerifier, medianTime, groupVerifier,
 			)
 			if err != nil {
 				return err
@@ -227,6 +235,7 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 				),
 			}
 			prevAssets[prevID] = result.Asset
+			inputs = append(inputs, result)
 
 			return nil
 		})
@@ -235,6 +244,14 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 		return false, fmt.Errorf("inputs invalid: %w", err)
 	}
 
+	// Before running the VM, we make sure that the lock times the new
+	// asset commits to are satisfied by the block that confirmed the
+	// anchor transaction.
+	err := p.verifyLockTimes(newAsset, inputs, medianTime)
+	if err != nil {
+		return false, err
+	}
+
 	// Spawn a new VM instance to verify the asset's state transition.
 	var splitAssets []*commitment.SplitAsset
 	if splitAsset != nil {
@@ -247,6 +264,156 @@ func (p *Proof) verifyAssetStateTransition(ctx context.Context,
 	return splitAsset != nil, engine.Execute()
 }
 
+// verifyLockTimes verifies that the absolute and relative lock times the given
+// asset commits to are satisfied by the block that confirmed the proof's anchor
+// transaction. Only the witnesses of inputs with script version ScriptV1 commit
+// to the lock times of the new asset, so they are only enforced if at least
+// one such input is spent. The semantics follow those of Bitcoin transactions:
+// lock times below 500 million are interpreted as block heights, everything
+// else as a UNIX timestamp that is compared against the median time past of
+// the block preceding the anchor block (BIP-113). The relative lock time is
+// interpreted as a BIP-68 encoded sequence and is checked against the anchor
+// block of each of the inputs.
+func (p *Proof) verifyLockTimes(newAsset *asset.Asset,
+	inputs []*AssetSnapshot, medianTime MedianTimeSource) error {
+
+	commitsToLockTimes := fn.Any(inputs, func(input *AssetSnapshot) bool {
+		return input.Asset.ScriptVersion == asset.ScriptV1
+	})
+	if !commitsToLockTimes {
+		return nil
+	}
+
+	if newAsset.LockTime > math.MaxUint32 {
+		return fmt.Errorf("%w: lock time %d out of range",
+			ErrLockTimeNotReached, newAsset.LockTime)
+	}
+	if newAsset.RelativeLockTime > math.MaxUint32 {
+		return fmt.Errorf("%w: relative lock time %d out of range",
+			ErrRelativeLockTimeNotReached, newAsset.RelativeLockTime)
+	}
+
+	var (
+		lockTime     = uint32(newAsset.LockTime)
+		sequence     = uint32(newAsset.RelativeLockTime)
+		relativeLock = sequence&wire.SequenceLockTimeDisabled == 0
+	)
+	if lockTime == 0 && !relativeLock {
+		return nil
+	}
+
+	// We can't verify any lock times against a proof that doesn't know
+	// the height of the block it was confirmed in.
+	if p.BlockHeight == 0 {
+		return fmt.Errorf("%w: unknown anchor block height",
+			ErrLockTimeNotReached)
+	}
+
+	// The median time past of the block preceding the anchor block is
+	// only needed for time based lock times, so we fetch it lazily.
+	var (
+		blockTime      int64
+		blockTimeKnown bool
+	)
+	fetchBlockTime := func() error {
+		if blockTimeKnown {
+			return nil
+		}
+
+		mtp, err := fetchMedianTime(medianTime, p.BlockHeight-1)
+		if err != nil {
+			return err
+		}
+		blockTime, blockTimeKnown = mtp, true
+
+		return nil
+	}
+
+	if lockTime != 0 {
+		limit := int64(p.BlockHeight)
+		if lockTime >= txscript.LockTimeThreshold {
+			if err := fetchBlockTime(); err != nil {
+				return err
+			}
+			limit = blockTime
+		}
+
+		if int64(lockTime) >= limit {
+			return fmt.Errorf("%w: lock time %d, block height %d, "+
+				"median time past %d", ErrLockTimeNotReached,
+				lockTime, p.BlockHeight, blockTime)
+		}
+	}
+
+	if !relativeLock {
+		return nil
+	}
+
+	lockValue := sequence & wire.SequenceLockTimeMask
+	isSeconds := sequence&wire.SequenceLockTimeIsSeconds != 0
+	for _, input := range inputs {
+		// Without the height of the block that confirmed the input, we
+		// can't verify the relative lock time against it.
+		if input.AnchorBlockHeight == 0 {
+			return fmt.Errorf("%w: unknown anchor block height of "+
+				"input %v", ErrRelativeLockTimeNotReached,
+				input.OutPoint)
+		}
+
+		if isSeconds {
+			if err := fetchBlockTime(); err != nil {
+				return err
+			}
+
+			inputTime, err := fetchMedianTime(
+				medianTime, input.AnchorBlockHeight-1,
+			)
+			if err != nil {
+				return err
+			}
+
+			minTime := inputTime + int64(lockValue)<<
+				wire.SequenceLockTimeGranularity
+			if blockTime < minTime {
+				return fmt.Errorf("%w: median time past %d, "+
+					"min time %d",
+					ErrRelativeLockTimeNotReached,
+					blockTime, minTime)
+			}
+
+			continue
+		}
+
+		minHeight := input.AnchorBlockHeight + lockValue
+		if p.BlockHeight < minHeight {
+			return fmt.Errorf("%w: block height %d, min height %d",
+				ErrRelativeLockTimeNotReached, p.BlockHeight,
+				minHeight)
+		}
+	}
+
+	return nil
+}
+
+// fetchMedianTime returns the median time past of the block at the given
+// height as a UNIX timestamp.
+func fetchMedianTime(medianTime MedianTimeSource,
+	blockHeight uint32) (int64, error) {
+
+	if medianTime == nil {
+		return 0, fmt.Errorf("no median time source available to " +
+			"verify time based lock time")
+	}
+
+	mtp, err := medianTime(blockHeight)
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch median time past of "+
+			"block %d: %w", blockHeight, err)
+	}
+
+	return mtp.Unix(), nil
+}
+
 // verifyChallengeWitness verifies the challenge witness by constructing a
 // well-defined 1-in-1-out packet and verifying the witness is valid for that
 // virtual transaction.
@@ -367,6 +534,11 @@ func (p *Proof) verifyGroupKeyReveal() error {
 // block header is invalid (usually: not present on chain).
 type HeaderVerifier func(blockHeader wire.BlockHeader, blockHeight uint32) error
 
+// MedianTimeSource is a callback function which returns the median time past
+// of the block at the given height as defined in BIP-113, which is the median
+// of the timestamps of that block and the 10 blocks preceding it.
+type MedianTimeSource func(blockHeight uint32) (time.Time, error)
+
 // GroupVerifier is a callback function which returns an error if the given
 // group key has not been imported by the tapd daemon. This can occur if the
 // issuance proof for the group anchor has not been imported or synced.
@@ -390,7 +562,7 @@ type GroupAnchorVerifier func(gen *asset.Genesis,
 //  5. A set of asset inputs with valid witnesses are included that satisfy the
 //     resulting state transition.
 func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
-	headerVerifier HeaderVerifier,
+	headerVerifier HeaderVerifier, medianTime MedianTimeSource,
 	groupVerifier GroupVerifier) (*AssetSnapshot, error) {
 
 	// 0. Check only for the proof version.
@@ -515,7 +687,7 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 
 	default:
 		splitAsset, err = p.verifyAssetStateTransition(
-			ctx, prev, headerVerifier, groupVerifier,
+			ctx, prev, headerVerifier, medianTime, groupVerifier,
 		)
 	}
 	if err != nil {
@@ -555,7 +727,7 @@ func (p *Proof) Verify(ctx context.Context, prev *AssetSnapshot,
 //
 // TODO(roasbeef): pass in the expected genesis point here?
 func (f *File) Verify(ctx context.Context, headerVerifier HeaderVerifier,
-	groupVerifier GroupVerifier) (
+	medianTime MedianTimeSource, groupVerifier GroupVerifier) (
 
 	*AssetSnapshot, error) {
 
@@ -585,7 +757,7 @@ func (f *File) Verify(ctx context.Context, headerVerifier HeaderVerifier,
 		}
 
 		result, err := decodedProof.Verify(
-			ctx, prev, headerVerifier, groupVerifier,
+			ctx, prev, headerVerifier, medianTime, groupVerifier,
 		)
 		if err != nil {
 			return nil, err
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"net/http"
 	"strconv"
@@ -17,8 +18,11 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -74,6 +78,10 @@ const (
 	// AssetBurnConfirmationText is the text that needs to be set on the
 	// RPC to confirm an asset burn.
 	AssetBurnConfirmationText = "assets will be destroyed"
+
+	// defaultMaxConsolidationInputs is the default maximum number of asset
+	// UTXOs merged by a single consolidation.
+	defaultMaxConsolidationInputs = 100
 )
 
 type (
@@ -88,6 +96,18 @@ type (
 	// subReceiveEventNtfnsStream is a type alias for the asset receive
 	// event notification stream.
 	subReceiveEventNtfnsStream = taprpc.TaprootAssets_SubscribeReceiveAssetEventNtfnsServer
+
+	// subUniverseEventsStream is a type alias for the universe event
+	// notification stream.
+	subUniverseEventsStream = unirpc.Universe_SubscribeUniverseEventsServer
+
+	// exportSnapshotStream is a type alias for the universe snapshot
+	// export stream.
+	exportSnapshotStream = unirpc.Universe_ExportSnapshotServer
+
+	// importSnapshotStream is a type alias for the universe snapshot
+	// import stream.
+	importSnapshotStream = unirpc.Universe_ImportSnapshotServer
 )
 
 // Size returns the size of the cacheable timestamp. Since we scale the cache by
@@ -345,6 +365,13 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 func (r *rpcServer) MintAsset(ctx context.Context,
 	req *mintrpc.MintAssetRequest) (*mintrpc.MintAssetResponse, error) {
 
+	// Minting requires the daemon to sign the genesis transaction and any
+	// group witnesses, which isn't possible in watch-only mode.
+	if r.cfg.WatchOnly {
+		return nil, fmt.Errorf("minting assets is not supported in "+
+			"watch-only mode: %w", tapfreighter.ErrWatchOnly)
+	}
+
 	if req.Asset == nil {
 		return nil, fmt.Errorf("asset cannot be nil")
 	}
@@ -378,11 +405,13 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		AssetName:      req.Asset.Name,
 		Amount:         req.Asset.Amount,
 		EnableEmission: req.EnableEmission,
+		BatchName:      req.BatchName,
 	}
 
 	rpcsLog.Infof("[MintAsset]: version=%v, type=%v, name=%v, amt=%v, "+
-		"issuance=%v", seedling.AssetVersion, seedling.AssetType,
-		seedling.AssetName, seedling.Amount, seedling.EnableEmission)
+		"issuance=%v, batch=%q", seedling.AssetVersion,
+		seedling.AssetType, seedling.AssetName, seedling.Amount,
+		seedling.EnableEmission, seedling.BatchName)
 
 	// If a group key is provided, parse the provided group public key
 	// before creating the asset seedling.
@@ -418,26 +447,32 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		seedling.GroupAnchor = &req.Asset.GroupAnchor
 	}
 
-	if req.Asset.AssetMeta != nil {
-		// Ensure that the meta field is within bounds.
-		switch {
-		case req.Asset.AssetMeta.Type < 0:
-			return nil, fmt.Errorf("meta type cannot be negative")
-
-		case req.Asset.AssetMeta.Type > math.MaxUint8:
-			return nil, fmt.Errorf("meta type is too large: %v, "+
-				"max is: %v", req.Asset.AssetMeta.Type,
-				math.MaxUint8)
+	// If the group key is held externally, the minter will wait for the
+	// group witness of the asset to be added once the batch is frozen.
+	if len(req.Asset.ExternalGroupKey) != 0 {
+		seedling.ExternalGroupKey, err = btcec.ParsePubKey(
+			req.Asset.ExternalGroupKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid external group key: %w",
+				err)
 		}
+	}
+	seedling.GroupTapscriptRoot = req.Asset.GroupTapscriptRoot
 
-		seedling.Meta = &proof.MetaReveal{
-			Type: proof.MetaType(req.Asset.AssetMeta.Type),
-			Data: req.Asset.AssetMeta.Data,
+	if req.Asset.AssetMeta != nil {
+		seedling.Meta, err = unmarshalAssetMeta(req.Asset.AssetMeta)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		// If the asset meta field was specified, then the data inside
-		// must be valid. Let's check that now.
-		if err := seedling.Meta.Validate(); err != nil {
+	// The decimal display is committed to in the JSON meta of the asset.
+	if req.Asset.DecimalDisplay != 0 {
+		seedling.Meta, err = metaWithDecimalDisplay(
+			seedling.Meta, req.Asset.DecimalDisplay,
+		)
+		if err != nil {
 			return nil, err
 		}
 	}
@@ -472,6 +507,65 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 	}
 }
 
+// unmarshalAssetMeta parses and validates the meta of an asset to be minted.
+func unmarshalAssetMeta(rpcMeta *taprpc.AssetMeta) (*proof.MetaReveal, error) {
+	// Ensure that the meta field is within bounds.
+	switch {
+	case rpcMeta.Type < 0:
+		return nil, fmt.Errorf("meta type cannot be negative")
+
+	case rpcMeta.Type > math.MaxUint8:
+		return nil, fmt.Errorf("meta type is too large: %v, max is: %v",
+			rpcMeta.Type, math.MaxUint8)
+	}
+
+	meta := &proof.MetaReveal{
+		Type: proof.MetaType(rpcMeta.Type),
+		Data: rpcMeta.Data,
+	}
+
+	// If the asset meta field was specified, then the data inside must be
+	// valid. Let's check that now.
+	if err := meta.Validate(); err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
+
+// metaWithDecimalDisplay returns a meta reveal that commits to the given
+// decimal display value. If no meta is given, a JSON meta with just the decimal
+// display is created. Otherwise, the given meta must be a JSON meta that
+// already commits to the same value, as we don't rewrite the data of the
+// issuer.
+func metaWithDecimalDisplay(meta *proof.MetaReveal,
+	decimalDisplay uint32) (*proof.MetaReveal, error) {
+
+	if meta == nil {
+		return proof.NewJsonMetaReveal(&proof.JsonMeta{
+			DecimalDisplay: decimalDisplay,
+		})
+	}
+
+	if meta.Type != proof.MetaJson {
+		return nil, fmt.Errorf("decimal display requires a JSON " +
+			"asset meta")
+	}
+
+	jsonMeta, err := meta.JsonMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	if jsonMeta.DecimalDisplay != decimalDisplay {
+		return nil, fmt.Errorf("decimal display %d doesn't match "+
+			"decimal display %d of asset meta", decimalDisplay,
+			jsonMeta.DecimalDisplay)
+	}
+
+	return meta, nil
+}
+
 // checkFeeRateSanity ensures that the provided fee rate is above the same
 // minimum fee used as a floor in the fee estimator.
 func checkFeeRateSanity(rpcFeeRate uint32) (*chainfee.SatPerKWeight, error) {
@@ -494,7 +588,7 @@ func checkFeeRateSanity(rpcFeeRate uint32) (*chainfee.SatPerKWeight, error) {
 	return feeRate, nil
 }
 
-// FinalizeBatch attempts to finalize the current pending batch.
+// FinalizeBatch attempts to finalize the pending batch with the given name.
 func (r *rpcServer) FinalizeBatch(_ context.Context,
 	req *mintrpc.FinalizeBatchRequest) (*mintrpc.FinalizeBatchResponse,
 	error) {
@@ -504,7 +598,92 @@ func (r *rpcServer) FinalizeBatch(_ context.Context,
 		return nil, err
 	}
 
-	batch, err := r.cfg.AssetMinter.FinalizeBatch(feeRate)
+	switch {
+	case feeRate != nil && req.ConfTarget != 0:
+		return nil, fmt.Errorf("cannot specify both fee_rate and " +
+			"conf_target")
+
+	// The fee estimator of lnd doesn't accept a confirmation target of
+	// one block.
+	case req.ConfTarget == 1:
+		return nil, fmt.Errorf("conf_target must be greater than 1")
+	}
+
+	genesisInputs := make([]wire.OutPoint, 0, len(req.GenesisInputs))
+	for _, genesisInput := range req.GenesisInputs {
+		outPoint, err := UnmarshalOutpoint(genesisInput)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis input: %w", err)
+		}
+
+		isDuplicate := fn.Any(
+			genesisInputs, func(o wire.OutPoint) bool {
+				return o == *outPoint
+			},
+		)
+		if isDuplicate {
+			return nil, fmt.Errorf("duplicate genesis input %v",
+				outPoint)
+		}
+
+		genesisInputs = append(genesisInputs, *outPoint)
+	}
+
+	var externalGenesisPkt *psbt.Packet
+	if len(req.ExternalGenesisPsbt) > 0 {
+		if feeRate != nil || req.ConfTarget != 0 ||
+			len(genesisInputs) > 0 {
+
+			return nil, fmt.Errorf("cannot specify funding " +
+				"options for externally funded minting " +
+				"transaction")
+		}
+
+		externalGenesisPkt, err = psbt.NewFromRawBytes(
+			bytes.NewReader(req.ExternalGenesisPsbt), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding external "+
+				"genesis psbt: %w", err)
+		}
+	}
+
+	params := tapgarden.FinalizeParams{
+		BatchName:           req.BatchName,
+		FeeRate:             feeRate,
+		ConfTarget:          req.ConfTarget,
+		GenesisInputs:       genesisInputs,
+		ExternalGenesisPsbt: externalGenesisPkt,
+	}
+
+	// For a dry run, the batch stays pending, and we only return the
+	// preview of what it would create.
+	if req.DryRun {
+		preview, err := r.cfg.AssetMinter.PreviewBatch(params)
+		if err != nil {
+			return nil, fmt.Errorf("unable to preview batch: %w",
+				err)
+		}
+
+		rpcBatch, err := marshalMintingBatch(
+			preview.Batch, req.ShortResponse,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcPreview, err := marshalBatchPreview(preview)
+		if err != nil {
+			return nil, err
+		}
+
+		return &mintrpc.FinalizeBatchResponse{
+			Batch:   rpcBatch,
+			Preview: rpcPreview,
+		}, nil
+	}
+
+	batch, err := r.cfg.AssetMinter.FinalizeBatch(params)
 	if err != nil {
 		return nil, fmt.Errorf("unable to finalize batch: %w", err)
 	}
@@ -524,12 +703,12 @@ func (r *rpcServer) FinalizeBatch(_ context.Context,
 	}, nil
 }
 
-// CancelBatch attempts to cancel the current pending batch.
+// CancelBatch attempts to cancel the pending batch with the given name.
 func (r *rpcServer) CancelBatch(_ context.Context,
-	_ *mintrpc.CancelBatchRequest) (*mintrpc.CancelBatchResponse,
+	req *mintrpc.CancelBatchRequest) (*mintrpc.CancelBatchResponse,
 	error) {
 
-	batchKey, err := r.cfg.AssetMinter.CancelBatch()
+	batchKey, err := r.cfg.AssetMinter.CancelBatch(req.BatchName)
 	if err != nil {
 		return nil, fmt.Errorf("unable to cancel batch: %w", err)
 	}
@@ -593,3785 +772,6153 @@ func (r *rpcServer) ListBatches(_ context.Context,
 		return nil, err
 	}
 
+	// The recurring mints aren't tied to a specific batch, so we only list
+	// them if no batch was selected.
+	var rpcRecurringMints []*mintrpc.RecurringMint
+	if batchKey == nil {
+		recurringMints, err := r.cfg.AssetMinter.ListRecurringMints()
+		if err != nil {
+			return nil, fmt.Errorf("unable to list recurring "+
+				"mints: %w", err)
+		}
+
+		rpcRecurringMints = fn.Map(
+			recurringMints, marshalRecurringMint,
+		)
+	}
+
 	return &mintrpc.ListBatchResponse{
-		Batches: rpcBatches,
+		Batches:        rpcBatches,
+		RecurringMints: rpcRecurringMints,
 	}, nil
 }
 
-// checkBalanceOverflow ensures that the new asset amount will not overflow
-// the max allowed asset (or asset group) balance.
-func (r *rpcServer) checkBalanceOverflow(ctx context.Context,
-	assetID *asset.ID, groupPubKey *btcec.PublicKey,
-	newAmount uint64) error {
+// BumpBatchFee attempts to replace the broadcast genesis transaction of a
+// batch with one that pays a higher fee rate.
+func (r *rpcServer) BumpBatchFee(_ context.Context,
+	req *mintrpc.BumpBatchFeeRequest) (*mintrpc.BumpBatchFeeResponse,
+	error) {
 
-	if assetID != nil && groupPubKey != nil {
-		return fmt.Errorf("asset ID and group public key cannot both " +
-			"be set")
+	batchKey, err := unmarshalBatchKey(
+		req.GetBatchKey(), req.GetBatchKeyStr(),
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	if assetID == nil && groupPubKey == nil {
-		return fmt.Errorf("asset ID and group public key cannot both " +
-			"be nil")
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate == nil {
+		return nil, fmt.Errorf("fee rate must be set")
 	}
 
-	var balance uint64
-
-	switch {
-	case assetID != nil:
-		// Retrieve the current asset balance.
-		balances, err := r.cfg.AssetStore.QueryBalancesByAsset(
-			ctx, assetID,
-		)
-		if err != nil {
-			return fmt.Errorf("unable to query asset balance: %w",
-				err)
-		}
-
-		// There should only be one balance entry per asset.
-		for _, balanceEntry := range balances {
-			balance = balanceEntry.Balance
-			break
-		}
-
-	case groupPubKey != nil:
-		// Retrieve the current balance of the group.
-		balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
-			ctx, groupPubKey,
-		)
-		if err != nil {
-			return fmt.Errorf("unable to query group balance: %w",
-				err)
-		}
-
-		// There should only be one balance entry per group.
-		for _, balanceEntry := range balances {
-			balance = balanceEntry.Balance
-			break
-		}
+	batch, err := r.cfg.AssetMinter.BumpBatchFee(batchKey, *feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to bump batch fee: %w", err)
 	}
 
-	// Check for overflow.
-	err := mssmt.CheckSumOverflowUint64(balance, newAmount)
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
 	if err != nil {
-		return fmt.Errorf("new asset amount would overflow "+
-			"asset balance: %w", err)
+		return nil, err
 	}
 
-	return nil
+	return &mintrpc.BumpBatchFeeResponse{
+		Batch: rpcBatch,
+	}, nil
 }
 
-// ListAssets lists the set of assets owned by the target daemon.
-func (r *rpcServer) ListAssets(ctx context.Context,
-	req *taprpc.ListAssetRequest) (*taprpc.ListAssetResponse, error) {
+// PublishSignedBatch publishes the externally signed minting transaction of an
+// externally funded batch.
+func (r *rpcServer) PublishSignedBatch(_ context.Context,
+	req *mintrpc.PublishSignedBatchRequest) (
+	*mintrpc.PublishSignedBatchResponse, error) {
 
-	switch {
-	case req.IncludeSpent && req.IncludeLeased:
-		return nil, fmt.Errorf("cannot specify both include_spent " +
-			"and include_leased")
+	batchKey, err := unmarshalBatchKey(
+		req.GetBatchKey(), req.GetBatchKeyStr(),
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	rpcAssets, err := r.fetchRpcAssets(
-		ctx, req.WithWitness, req.IncludeSpent, req.IncludeLeased,
+	if len(req.SignedPsbt) == 0 {
+		return nil, fmt.Errorf("signed psbt must be set")
+	}
+	signedPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.SignedPsbt), false,
 	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding signed psbt: %w", err)
+	}
+
+	batch, err := r.cfg.AssetMinter.PublishSignedBatch(batchKey, signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish signed batch: %w",
+			err)
+	}
+
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
 	if err != nil {
 		return nil, err
 	}
 
-	return &taprpc.ListAssetResponse{
-		Assets: rpcAssets,
+	return &mintrpc.PublishSignedBatchResponse{
+		Batch: rpcBatch,
 	}, nil
 }
 
-func (r *rpcServer) fetchRpcAssets(ctx context.Context, withWitness,
-	includeSpent, includeLeased bool) ([]*taprpc.Asset, error) {
+// AddGroupWitnesses adds the externally signed group witnesses of the assets in
+// a frozen batch.
+func (r *rpcServer) AddGroupWitnesses(_ context.Context,
+	req *mintrpc.AddGroupWitnessesRequest) (
+	*mintrpc.AddGroupWitnessesResponse, error) {
 
-	assets, err := r.cfg.AssetStore.FetchAllAssets(
-		ctx, includeSpent, includeLeased, nil,
+	batchKey, err := unmarshalBatchKey(
+		req.GetBatchKey(), req.GetBatchKeyStr(),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+		return nil, err
 	}
 
-	rpcAssets := make([]*taprpc.Asset, len(assets))
-	for i, a := range assets {
-		rpcAssets[i], err = r.marshalChainAsset(ctx, a, withWitness)
-		if err != nil {
-			return nil, fmt.Errorf("unable to marshal asset: %w",
-				err)
-		}
+	if len(req.GroupWitnesses) == 0 {
+		return nil, fmt.Errorf("group witnesses must be set")
 	}
 
-	return rpcAssets, nil
-}
+	witnesses := make(map[string]wire.TxWitness, len(req.GroupWitnesses))
+	for _, rpcWitness := range req.GroupWitnesses {
+		if _, ok := witnesses[rpcWitness.AssetName]; ok {
+			return nil, fmt.Errorf("duplicate group witness for "+
+				"asset %v", rpcWitness.AssetName)
+		}
 
-func (r *rpcServer) marshalChainAsset(ctx context.Context, a *tapdb.ChainAsset,
-	withWitness bool) (*taprpc.Asset, error) {
+		witnesses[rpcWitness.AssetName] = rpcWitness.Witness
+	}
 
-	rpcAsset, err := taprpc.MarshalAsset(
-		ctx, a.Asset, a.IsSpent, withWitness, r.cfg.AddrBook,
-	)
+	batch, err := r.cfg.AssetMinter.AddGroupWitnesses(batchKey, witnesses)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add group witnesses: %w",
+			err)
+	}
+
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
 	if err != nil {
 		return nil, err
 	}
 
-	var anchorTxBytes []byte
-	if a.AnchorTx != nil {
-		var anchorTxBuf bytes.Buffer
-		err := a.AnchorTx.Serialize(&anchorTxBuf)
-		if err != nil {
-			return nil, fmt.Errorf("unable to serialize anchor "+
-				"tx: %w", err)
-		}
-		anchorTxBytes = anchorTxBuf.Bytes()
+	return &mintrpc.AddGroupWitnessesResponse{
+		Batch: rpcBatch,
+	}, nil
+}
+
+// ScheduleBatch schedules the pending batch with the given name to be
+// finalized once the given block height or time is reached.
+func (r *rpcServer) ScheduleBatch(_ context.Context,
+	req *mintrpc.ScheduleBatchRequest) (*mintrpc.ScheduleBatchResponse,
+	error) {
+
+	if req.FinalizeTime < 0 {
+		return nil, fmt.Errorf("finalize time must not be negative")
 	}
 
-	rpcAsset.ChainAnchor = &taprpc.AnchorInfo{
-		AnchorTx:         anchorTxBytes,
-		AnchorTxid:       a.AnchorTxid.String(),
-		AnchorBlockHash:  a.AnchorBlockHash.String(),
-		AnchorOutpoint:   a.AnchorOutpoint.String(),
-		InternalKey:      a.AnchorInternalKey.SerializeCompressed(),
-		MerkleRoot:       a.AnchorMerkleRoot,
-		TapscriptSibling: a.AnchorTapscriptSibling,
-		BlockHeight:      a.AnchorBlockHeight,
+	schedule := tapgarden.BatchSchedule{
+		FinalizeHeight: req.FinalizeHeight,
+	}
+	if req.FinalizeTime != 0 {
+		schedule.FinalizeTime = time.Unix(req.FinalizeTime, 0)
 	}
 
-	if a.AnchorLeaseOwner != [32]byte{} {
-		rpcAsset.LeaseOwner = a.AnchorLeaseOwner[:]
-		rpcAsset.LeaseExpiry = a.AnchorLeaseExpiry.UTC().Unix()
+	batch, err := r.cfg.AssetMinter.ScheduleBatch(req.BatchName, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("unable to schedule batch: %w", err)
 	}
 
-	return rpcAsset, nil
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.ScheduleBatchResponse{
+		Batch: rpcBatch,
+	}, nil
 }
 
-func (r *rpcServer) listBalancesByAsset(ctx context.Context,
-	assetID *asset.ID) (*taprpc.ListBalancesResponse, error) {
+// AddRecurringMint adds a recurring mint that mints new units of an existing
+// asset group once per epoch.
+func (r *rpcServer) AddRecurringMint(_ context.Context,
+	req *mintrpc.AddRecurringMintRequest) (
+	*mintrpc.AddRecurringMintResponse, error) {
 
-	balances, err := r.cfg.AssetStore.QueryBalancesByAsset(ctx, assetID)
+	groupKey, err := btcec.ParsePubKey(req.GroupKey)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list balances: %w", err)
+		return nil, fmt.Errorf("invalid group key: %w", err)
 	}
 
-	resp := &taprpc.ListBalancesResponse{
-		AssetBalances: make(map[string]*taprpc.AssetBalance, len(balances)),
+	if req.IntervalSeconds > math.MaxInt64/uint64(time.Second) {
+		return nil, fmt.Errorf("interval seconds too large")
+	}
+	if req.StartTime < 0 {
+		return nil, fmt.Errorf("start time must not be negative")
 	}
 
-	for _, balance := range balances {
-		balance := balance
+	mint := tapgarden.RecurringMint{
+		AssetName:      req.Name,
+		Amount:         req.Amount,
+		GroupKey:       groupKey,
+		IntervalBlocks: req.IntervalBlocks,
+		Interval: time.Duration(req.IntervalSeconds) *
+			time.Second,
+		MaxEpochs: req.MaxEpochs,
+	}
 
-		assetIDStr := hex.EncodeToString(balance.ID[:])
+	// The start of the recurring mint only applies to its kind of epoch.
+	switch {
+	case req.IntervalBlocks != 0:
+		mint.NextHeight = req.StartHeight
 
-		resp.AssetBalances[assetIDStr] = &taprpc.AssetBalance{
-			AssetGenesis: &taprpc.GenesisInfo{
-				Version:      int32(balance.Version),
-				GenesisPoint: balance.GenesisPoint.String(),
-				AssetType:    taprpc.AssetType(balance.Type),
-				Name:         balance.Tag,
-				MetaHash:     balance.MetaHash[:],
-				AssetId:      balance.ID[:],
-			},
-			Balance: balance.Balance,
-		}
+	case req.StartTime != 0:
+		mint.NextTime = time.Unix(req.StartTime, 0)
 	}
 
-	return resp, nil
+	newMint, err := r.cfg.AssetMinter.AddRecurringMint(mint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add recurring mint: %w", err)
+	}
+
+	return &mintrpc.AddRecurringMintResponse{
+		RecurringMint: marshalRecurringMint(newMint),
+	}, nil
 }
 
-func (r *rpcServer) listBalancesByGroupKey(ctx context.Context,
-	groupKey *btcec.PublicKey) (*taprpc.ListBalancesResponse, error) {
+// CancelRecurringMint cancels a recurring mint, so no further units are minted
+// for it.
+func (r *rpcServer) CancelRecurringMint(_ context.Context,
+	req *mintrpc.CancelRecurringMintRequest) (
+	*mintrpc.CancelRecurringMintResponse, error) {
 
-	balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
-		ctx, groupKey,
-	)
+	err := r.cfg.AssetMinter.CancelRecurringMint(int64(req.Id))
 	if err != nil {
-		return nil, fmt.Errorf("unable to list balances: %w", err)
+		return nil, fmt.Errorf("unable to cancel recurring mint: %w",
+			err)
 	}
 
-	resp := &taprpc.ListBalancesResponse{
-		AssetGroupBalances: make(
-			map[string]*taprpc.AssetGroupBalance, len(balances),
-		),
-	}
+	return &mintrpc.CancelRecurringMintResponse{}, nil
+}
 
-	for _, balance := range balances {
-		balance := balance
+// UpdateSeedling changes the amount, meta or group anchor of an asset in a
+// pending batch.
+func (r *rpcServer) UpdateSeedling(ctx context.Context,
+	req *mintrpc.UpdateSeedlingRequest) (*mintrpc.UpdateSeedlingResponse,
+	error) {
 
-		var groupKey []byte
-		if balance.GroupKey != nil {
-			groupKey = balance.GroupKey.SerializeCompressed()
-		}
+	if req.GroupAnchor != "" && req.RemoveGroupAnchor {
+		return nil, fmt.Errorf("cannot set and remove the group " +
+			"anchor at the same time")
+	}
 
-		groupKeyString := hex.EncodeToString(groupKey)
-		resp.AssetGroupBalances[groupKeyString] = &taprpc.AssetGroupBalance{
-			GroupKey: groupKey,
-			Balance:  balance.Balance,
+	change := tapgarden.SeedlingChange{
+		BatchName: req.BatchName,
+		AssetName: req.Name,
+	}
+	if req.Amount != 0 {
+		// Just like for a new seedling, the new amount of a seedling
+		// that mints more units of an existing group must not overflow
+		// the balance of the group.
+		err := r.checkSeedlingBalanceOverflow(
+			ctx, req.BatchName, req.Name, req.Amount,
+		)
+		if err != nil {
+			return nil, err
 		}
+
+		change.Amount = &req.Amount
 	}
+	if req.AssetMeta != nil {
+		meta, err := unmarshalAssetMeta(req.AssetMeta)
+		if err != nil {
+			return nil, err
+		}
 
-	return resp, nil
-}
+		change.Meta = meta
+	}
+	switch {
+	case req.GroupAnchor != "":
+		change.GroupAnchor = &req.GroupAnchor
 
-// ListUtxos lists the UTXOs managed by the target daemon, and the assets they
-// hold.
-func (r *rpcServer) ListUtxos(ctx context.Context,
-	req *taprpc.ListUtxosRequest) (*taprpc.ListUtxosResponse, error) {
+	case req.RemoveGroupAnchor:
+		noAnchor := ""
+		change.GroupAnchor = &noAnchor
+	}
 
-	rpcAssets, err := r.fetchRpcAssets(ctx, false, false, req.IncludeLeased)
+	batch, err := r.cfg.AssetMinter.UpdateSeedling(change)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to update seedling: %w", err)
 	}
 
-	managedUtxos, err := r.cfg.AssetStore.FetchManagedUTXOs(ctx)
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
 	if err != nil {
 		return nil, err
 	}
 
-	utxos := make(map[string]*taprpc.ManagedUtxo)
-	for _, u := range managedUtxos {
-		utxos[u.OutPoint.String()] = &taprpc.ManagedUtxo{
-			OutPoint:         u.OutPoint.String(),
-			AmtSat:           int64(u.OutputValue),
-			InternalKey:      u.InternalKey.PubKey.SerializeCompressed(),
-			TaprootAssetRoot: u.TaprootAssetRoot,
-			MerkleRoot:       u.MerkleRoot,
-		}
-	}
+	return &mintrpc.UpdateSeedlingResponse{
+		PendingBatch: rpcBatch,
+	}, nil
+}
 
-	// Populate the assets managed by each UTXO.
-	for _, a := range rpcAssets {
-		op := a.ChainAnchor.AnchorOutpoint
-		utxo, ok := utxos[op]
-		if !ok {
-			return nil, fmt.Errorf("unable to find utxo %s for "+
-				"asset_id=%x", op, a.AssetGenesis.AssetId)
-		}
+// checkSeedlingBalanceOverflow checks that the given new amount of a seedling
+// in a pending batch doesn't overflow the balance of the existing asset group
+// the seedling mints more units of, if any.
+func (r *rpcServer) checkSeedlingBalanceOverflow(ctx context.Context,
+	batchName, assetName string, newAmount uint64) error {
 
-		utxo.Assets = append(utxo.Assets, a)
-		utxos[op] = utxo
+	batch, err := r.cfg.AssetMinter.PendingBatch(batchName)
+	if err != nil {
+		return fmt.Errorf("unable to fetch pending batch: %w", err)
 	}
 
-	// As a final pass, we'll prune out any UTXOs that don't have any
-	// assets, as these may be in the DB just for record keeping.
-	for _, utxo := range utxos {
-		if len(utxo.Assets) == 0 {
-			delete(utxos, utxo.OutPoint)
-		}
+	// If the batch or seedling doesn't exist, the minter will return the
+	// proper error when updating it.
+	if batch == nil {
+		return nil
+	}
+	seedling, ok := batch.Seedlings[assetName]
+	if !ok || !seedling.HasGroupKey() {
+		return nil
 	}
 
-	return &taprpc.ListUtxosResponse{
-		ManagedUtxos: utxos,
-	}, nil
+	return r.checkBalanceOverflow(
+		ctx, nil, &seedling.GroupInfo.GroupPubKey, newAmount,
+	)
 }
 
-// ListGroups lists known groups and the assets held in each group.
-func (r *rpcServer) ListGroups(ctx context.Context,
-	_ *taprpc.ListGroupsRequest) (*taprpc.ListGroupsResponse, error) {
+// RemoveSeedling removes an asset from a pending batch.
+func (r *rpcServer) RemoveSeedling(_ context.Context,
+	req *mintrpc.RemoveSeedlingRequest) (*mintrpc.RemoveSeedlingResponse,
+	error) {
 
-	readableAssets, err := r.cfg.AssetStore.FetchGroupedAssets(ctx)
+	batch, err := r.cfg.AssetMinter.RemoveSeedling(
+		req.BatchName, req.Name,
+	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to remove seedling: %w", err)
 	}
 
-	groupsWithAssets := make(map[string]*taprpc.GroupedAssets)
-
-	// Populate the map of group keys to assets in that group.
-	for _, a := range readableAssets {
-		groupKey := hex.EncodeToString(a.GroupKey.SerializeCompressed())
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
+	if err != nil {
+		return nil, err
+	}
 
-		assetVersion, err := taprpc.MarshalAssetVersion(
-			a.Version,
-		)
-		if err != nil {
-			return nil, err
-		}
+	return &mintrpc.RemoveSeedlingResponse{
+		PendingBatch: rpcBatch,
+	}, nil
+}
 
-		asset := &taprpc.AssetHumanReadable{
-			Id:               a.ID[:],
-			Version:          assetVersion,
-			Amount:           a.Amount,
-			LockTime:         int32(a.LockTime),
-			RelativeLockTime: int32(a.RelativeLockTime),
-			Tag:              a.Tag,
-			MetaHash:         a.MetaHash[:],
-			Type:             taprpc.AssetType(a.Type),
-		}
+// marshalRecurringMint marshals a recurring mint into its RPC counterpart.
+func marshalRecurringMint(
+	mint *tapgarden.RecurringMint) *mintrpc.RecurringMint {
 
-		_, ok := groupsWithAssets[groupKey]
-		if !ok {
-			groupsWithAssets[groupKey] = &taprpc.GroupedAssets{
-				Assets: []*taprpc.AssetHumanReadable{},
-			}
-		}
+	rpcMint := &mintrpc.RecurringMint{
+		Id:              uint64(mint.ID),
+		Name:            mint.AssetName,
+		Amount:          mint.Amount,
+		GroupKey:        mint.GroupKey.SerializeCompressed(),
+		IntervalBlocks:  mint.IntervalBlocks,
+		IntervalSeconds: uint64(mint.Interval / time.Second),
+		MaxEpochs:       mint.MaxEpochs,
+		EpochsMinted:    mint.EpochsMinted,
+	}
 
-		groupsWithAssets[groupKey].Assets = append(
-			groupsWithAssets[groupKey].Assets, asset,
-		)
+	if mint.IntervalBlocks != 0 {
+		rpcMint.NextHeight = mint.NextHeight
+	} else {
+		rpcMint.NextTime = mint.NextTime.Unix()
 	}
 
-	return &taprpc.ListGroupsResponse{Groups: groupsWithAssets}, nil
+	return rpcMint
 }
 
-// ListBalances lists the asset balances owned by the daemon.
-func (r *rpcServer) ListBalances(ctx context.Context,
-	req *taprpc.ListBalancesRequest) (*taprpc.ListBalancesResponse, error) {
-
-	switch groupBy := req.GroupBy.(type) {
-	case *taprpc.ListBalancesRequest_AssetId:
-		if !groupBy.AssetId {
-			return nil, fmt.Errorf("invalid group_by")
-		}
-
-		var assetID *asset.ID
-		if len(req.AssetFilter) != 0 {
-			assetID = &asset.ID{}
-			if len(req.AssetFilter) != len(assetID) {
-				return nil, fmt.Errorf("invalid asset filter")
-			}
+// unmarshalBatchKey parses the key of a batch that is given either as raw bytes
+// or as a hex encoded string.
+func unmarshalBatchKey(batchKey []byte,
+	batchKeyStr string) (*btcec.PublicKey, error) {
 
-			copy(assetID[:], req.AssetFilter)
+	switch {
+	case len(batchKey) > 0:
+		key, err := btcec.ParsePubKey(batchKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch key: %w", err)
 		}
 
-		return r.listBalancesByAsset(ctx, assetID)
+		return key, nil
 
-	case *taprpc.ListBalancesRequest_GroupKey:
-		if !groupBy.GroupKey {
-			return nil, fmt.Errorf("invalid group_by")
+	case len(batchKeyStr) > 0:
+		batchKeyBytes, err := hex.DecodeString(batchKeyStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch key string: %w",
+				err)
 		}
 
-		var groupKey *btcec.PublicKey
-		if len(req.GroupKeyFilter) != 0 {
-			var err error
-			groupKey, err = btcec.ParsePubKey(req.GroupKeyFilter)
-			if err != nil {
-				return nil, fmt.Errorf("invalid group key "+
-					"filter: %v", err)
-			}
+		key, err := btcec.ParsePubKey(batchKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch key: %w", err)
 		}
 
-		return r.listBalancesByGroupKey(ctx, groupKey)
+		return key, nil
 
 	default:
-		return nil, fmt.Errorf("invalid group_by")
+		return nil, fmt.Errorf("batch key must be set")
 	}
 }
 
-// ListTransfers lists all asset transfers managed by this deamon.
-func (r *rpcServer) ListTransfers(ctx context.Context,
-	_ *taprpc.ListTransfersRequest) (*taprpc.ListTransfersResponse,
-	error) {
+// checkBalanceOverflow ensures that the new asset amount will not overflow
+// the max allowed asset (or asset group) balance.
+func (r *rpcServer) checkBalanceOverflow(ctx context.Context,
+	assetID *asset.ID, groupPubKey *btcec.PublicKey,
+	newAmount uint64) error {
 
-	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, false)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query parcels: %w", err)
+	if assetID != nil && groupPubKey != nil {
+		return fmt.Errorf("asset ID and group public key cannot both " +
+			"be set")
 	}
 
-	resp := &taprpc.ListTransfersResponse{
-		Transfers: make([]*taprpc.AssetTransfer, len(parcels)),
+	if assetID == nil && groupPubKey == nil {
+		return fmt.Errorf("asset ID and group public key cannot both " +
+			"be nil")
 	}
 
-	for idx := range parcels {
-		resp.Transfers[idx], err = marshalOutboundParcel(parcels[idx])
+	var balance uint64
+
+	switch {
+	case assetID != nil:
+		// Retrieve the current asset balance.
+		balances, err := r.cfg.AssetStore.QueryBalancesByAsset(
+			ctx, assetID,
+		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal parcel: %w",
+			return fmt.Errorf("unable to query asset balance: %w",
 				err)
 		}
-	}
 
-	return resp, nil
-}
+		// There should only be one balance entry per asset.
+		for _, balanceEntry := range balances {
+			balance = balanceEntry.Balance
+			break
+		}
 
-// QueryAddrs queries the set of Taproot Asset addresses stored in the database.
-func (r *rpcServer) QueryAddrs(ctx context.Context,
-	req *taprpc.QueryAddrRequest) (*taprpc.QueryAddrResponse, error) {
+	case groupPubKey != nil:
+		// Retrieve the current balance of the group.
+		balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
+			ctx, groupPubKey,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to query group balance: %w",
+				err)
+		}
 
-	query := address.QueryParams{
-		Limit:  req.Limit,
-		Offset: req.Offset,
+		// There should only be one balance entry per group.
+		for _, balanceEntry := range balances {
+			balance = balanceEntry.Balance
+			break
+		}
 	}
 
-	// The unix time of 0 (1970-01-01) is not the same as an empty Time
-	// struct (0000-00-00). For our query to succeed, we need to set the
-	// time values the way the address book expects them.
-	if req.CreatedBefore > 0 {
-		query.CreatedBefore = time.Unix(req.CreatedBefore, 0)
-	}
-	if req.CreatedAfter > 0 {
-		query.CreatedAfter = time.Unix(req.CreatedAfter, 0)
+	// Check for overflow.
+	err := mssmt.CheckSumOverflowUint64(balance, newAmount)
+	if err != nil {
+		return fmt.Errorf("new asset amount would overflow "+
+			"asset balance: %w", err)
 	}
 
-	rpcsLog.Debugf("[QueryAddrs]: addr query params: %v",
-		spew.Sdump(query))
-
-	dbAddrs, err := r.cfg.AddrBook.ListAddrs(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("unable to query addrs: %w", err)
-	}
-
-	// TODO(roasbeef): just stop storing the hrp in the addr?
-	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	return nil
+}
 
-	addrs := make([]*taprpc.Addr, len(dbAddrs))
-	for i, dbAddr := range dbAddrs {
-		dbAddr.ChainParams = &tapParams
+// ListAssets lists the set of assets owned by the target daemon.
+func (r *rpcServer) ListAssets(ctx context.Context,
+	req *taprpc.ListAssetRequest) (*taprpc.ListAssetResponse, error) {
 
-		addrs[i], err = marshalAddr(dbAddr.Tap, r.cfg.TapAddrBook)
-		if err != nil {
-			return nil, fmt.Errorf("unable to marshal addr: %w",
-				err)
-		}
+	switch {
+	case req.IncludeSpent && req.IncludeLeased:
+		return nil, fmt.Errorf("cannot specify both include_spent " +
+			"and include_leased")
 	}
 
-	rpcsLog.Debugf("[QueryAddrs]: returning %v addrs", len(addrs))
+	rpcAssets, err := r.fetchRpcAssets(
+		ctx, req.WithWitness, req.IncludeSpent, req.IncludeLeased,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	return &taprpc.QueryAddrResponse{
-		Addrs: addrs,
+	return &taprpc.ListAssetResponse{
+		Assets: rpcAssets,
 	}, nil
 }
 
-// NewAddr makes a new address from the set of request params.
-func (r *rpcServer) NewAddr(ctx context.Context,
-	req *taprpc.NewAddrRequest) (*taprpc.Addr, error) {
+func (r *rpcServer) fetchRpcAssets(ctx context.Context, withWitness,
+	includeSpent, includeLeased bool) ([]*taprpc.Asset, error) {
 
-	var err error
+	assets, err := r.cfg.AssetStore.FetchAllAssets(
+		ctx, includeSpent, includeLeased, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read chain assets: %w", err)
+	}
 
-	// Parse the proof courier address if one was provided, otherwise use
-	// the default specified in the config.
-	courierAddr := r.cfg.DefaultProofCourierAddr
-	if req.ProofCourierAddr != "" {
-		addr, err := proof.ParseCourierAddrString(
-			req.ProofCourierAddr,
+	decimalDisplays := make(map[asset.ID]uint32)
+	rpcAssets := make([]*taprpc.Asset, len(assets))
+	for i, a := range assets {
+		rpcAssets[i], err = r.marshalChainAsset(ctx, a, withWitness)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal asset: %w",
+				err)
+		}
+
+		decimalDisplay, err := r.fetchDecimalDisplay(
+			ctx, a.ID(), decimalDisplays,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("invalid proof courier "+
-				"address: %w", err)
+			return nil, err
 		}
 
-		// At this point, we do not intend on creating a proof courier
-		// service instance. We are only interested in parsing and
-		// validating the address. We therefore convert the address into
-		// an url.URL type for storage in the address book.
-		courierAddr = addr.Url()
+		rpcAssets[i].DecimalDisplay = decimalDisplay
+		rpcAssets[i].DisplayAmount = taprpc.FormatDecimalAmount(
+			a.Amount, decimalDisplay,
+		)
 	}
 
-	// Check that the proof courier address is set. This should never
-	// happen, but we check anyway to avoid panics (possibly caused by
-	// future erroneous config changes).
-	if courierAddr == nil {
-		return nil, fmt.Errorf("no proof courier address provided")
+	return rpcAssets, nil
+}
+
+// fetchDecimalDisplay returns the decimal display value of the asset with the
+// given ID, using the given cache to avoid looking up the same asset multiple
+// times. Assets without a decimal display value are displayed in asset units,
+// so zero is returned for them.
+func (r *rpcServer) fetchDecimalDisplay(ctx context.Context, assetID asset.ID,
+	cache map[asset.ID]uint32) (uint32, error) {
+
+	if decimalDisplay, ok := cache[assetID]; ok {
+		return decimalDisplay, nil
 	}
-	proofCourierAddr := *courierAddr
 
-	if len(req.AssetId) != 32 {
-		return nil, fmt.Errorf("invalid asset id length")
+	decimalDisplay, err := r.cfg.AssetStore.FetchDecimalDisplay(
+		ctx, assetID,
+	)
+	switch {
+	case errors.Is(err, tapdb.ErrDecimalDisplayNotFound):
+		decimalDisplay = 0
+
+	case err != nil:
+		return 0, fmt.Errorf("unable to fetch decimal display: %w",
+			err)
 	}
 
-	var assetID asset.ID
-	copy(assetID[:], req.AssetId)
+	cache[assetID] = decimalDisplay
 
-	rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, amt=%v",
-		assetID[:], req.Amt)
+	return decimalDisplay, nil
+}
 
-	err = r.checkBalanceOverflow(ctx, &assetID, nil, req.Amt)
-	if err != nil {
-		return nil, err
-	}
+func (r *rpcServer) marshalChainAsset(ctx context.Context, a *tapdb.ChainAsset,
+	withWitness bool) (*taprpc.Asset, error) {
 
-	// Was there a tapscript sibling preimage specified?
-	tapscriptSibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
-		req.TapscriptSibling,
+	rpcAsset, err := taprpc.MarshalAsset(
+		ctx, a.Asset, a.IsSpent, withWitness, r.cfg.AddrBook,
 	)
-	if err != nil {
-		return nil, fmt.Errorf("invalid tapscript sibling: %w", err)
-	}
-
-	assetVersion, err := taprpc.UnmarshalAssetVersion(req.AssetVersion)
 	if err != nil {
 		return nil, err
 	}
 
-	var addr *address.AddrWithKeyInfo
-	switch {
-	// No key was specified, we'll let the address book derive them.
-	case req.ScriptKey == nil && req.InternalKey == nil:
-		// Now that we have all the params, we'll try to add a new
-		// address to the addr book.
-		addr, err = r.cfg.AddrBook.NewAddress(
-			ctx, assetID, req.Amt, tapscriptSibling,
-			proofCourierAddr,
-			address.WithAssetVersion(assetVersion),
-		)
+	var anchorTxBytes []byte
+	if a.AnchorTx != nil {
+		var anchorTxBuf bytes.Buffer
+		err := a.AnchorTx.Serialize(&anchorTxBuf)
 		if err != nil {
-			return nil, fmt.Errorf("unable to make new addr: %w",
-				err)
+			return nil, fmt.Errorf("unable to serialize anchor "+
+				"tx: %w", err)
 		}
+		anchorTxBytes = anchorTxBuf.Bytes()
+	}
 
-	// Only the script key was specified.
-	case req.ScriptKey != nil && req.InternalKey == nil:
-		return nil, fmt.Errorf("internal key must also be specified " +
-			"if script key is specified")
+	rpcAsset.ChainAnchor = &taprpc.AnchorInfo{
+		AnchorTx:         anchorTxBytes,
+		AnchorTxid:       a.AnchorTxid.String(),
+		AnchorBlockHash:  a.AnchorBlockHash.String(),
+		AnchorOutpoint:   a.AnchorOutpoint.String(),
+		InternalKey:      a.AnchorInternalKey.SerializeCompressed(),
+		MerkleRoot:       a.AnchorMerkleRoot,
+		TapscriptSibling: a.AnchorTapscriptSibling,
+		BlockHeight:      a.AnchorBlockHeight,
+	}
 
-	// Only the internal key was specified.
-	case req.ScriptKey == nil && req.InternalKey != nil:
-		return nil, fmt.Errorf("script key must also be specified " +
-			"if internal key is specified")
+	if a.AnchorLeaseOwner != [32]byte{} {
+		rpcAsset.LeaseOwner = a.AnchorLeaseOwner[:]
+		rpcAsset.LeaseExpiry = a.AnchorLeaseExpiry.UTC().Unix()
+	}
 
-	// Both the script and internal keys were specified.
-	default:
-		scriptKey, err := UnmarshalScriptKey(req.ScriptKey)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode script key: "+
-				"%w", err)
-		}
+	return rpcAsset, nil
+}
 
-		rpcsLog.Debugf("Decoded script key %x (internal %x, tweak %x)",
-			scriptKey.PubKey.SerializeCompressed(),
-			scriptKey.RawKey.PubKey.SerializeCompressed(),
-			scriptKey.Tweak[:])
+func (r *rpcServer) listBalancesByAsset(ctx context.Context,
+	assetID *asset.ID) (*taprpc.ListBalancesResponse, error) {
 
-		internalKey, err := UnmarshalKeyDescriptor(req.InternalKey)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode internal "+
-				"key: %w", err)
-		}
+	balances, err := r.cfg.AssetStore.QueryBalancesByAsset(ctx, assetID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list balances: %w", err)
+	}
 
-		// Now that we have all the params, we'll try to add a new
-		// address to the addr book.
-		addr, err = r.cfg.AddrBook.NewAddressWithKeys(
-			ctx, assetID, req.Amt, *scriptKey, internalKey,
-			tapscriptSibling, proofCourierAddr,
-			address.WithAssetVersion(assetVersion),
+	resp := &taprpc.ListBalancesResponse{
+		AssetBalances: make(map[string]*taprpc.AssetBalance, len(balances)),
+	}
+
+	decimalDisplays := make(map[asset.ID]uint32)
+	for _, balance := range balances {
+		balance := balance
+
+		assetIDStr := hex.EncodeToString(balance.ID[:])
+
+		decimalDisplay, err := r.fetchDecimalDisplay(
+			ctx, balance.ID, decimalDisplays,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to make new addr: %w",
-				err)
+			return nil, err
 		}
-	}
 
-	// With our addr obtained, we'll marshal it as an RPC message then send
-	// off the response.
-	rpcAddr, err := marshalAddr(addr.Tap, r.cfg.TapAddrBook)
-	if err != nil {
-		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+		resp.AssetBalances[assetIDStr] = &taprpc.AssetBalance{
+			AssetGenesis: &taprpc.GenesisInfo{
+				Version:      int32(balance.Version),
+				GenesisPoint: balance.GenesisPoint.String(),
+				AssetType:    taprpc.AssetType(balance.Type),
+				Name:         balance.Tag,
+				MetaHash:     balance.MetaHash[:],
+				AssetId:      balance.ID[:],
+			},
+			Balance:        balance.Balance,
+			DecimalDisplay: decimalDisplay,
+			DisplayBalance: taprpc.FormatDecimalAmount(
+				balance.Balance, decimalDisplay,
+			),
+		}
 	}
 
-	return rpcAddr, nil
+	return resp, nil
 }
 
-// DecodeAddr decode a Taproot Asset address into a partial asset message that
-// represents the asset it wants to receive.
-func (r *rpcServer) DecodeAddr(_ context.Context,
-	req *taprpc.DecodeAddrRequest) (*taprpc.Addr, error) {
-
-	if len(req.Addr) == 0 {
-		return nil, fmt.Errorf("must specify an addr")
-	}
-
-	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+func (r *rpcServer) listBalancesByGroupKey(ctx context.Context,
+	groupKey *btcec.PublicKey) (*taprpc.ListBalancesResponse, error) {
 
-	addr, err := address.DecodeAddress(req.Addr, &tapParams)
+	balances, err := r.cfg.AssetStore.QueryAssetBalancesByGroup(
+		ctx, groupKey,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to decode addr: %w", err)
+		return nil, fmt.Errorf("unable to list balances: %w", err)
 	}
 
-	rpcAddr, err := marshalAddr(addr, r.cfg.TapAddrBook)
-	if err != nil {
-		return nil, fmt.Errorf("unable to marshal addr: %w", err)
+	resp := &taprpc.ListBalancesResponse{
+		AssetGroupBalances: make(
+			map[string]*taprpc.AssetGroupBalance, len(balances),
+		),
 	}
 
-	return rpcAddr, nil
-}
+	for _, balance := range balances {
+		balance := balance
 
-// VerifyProof attempts to verify a given proof file that claims to be anchored
-// at the specified genesis point.
-func (r *rpcServer) VerifyProof(ctx context.Context,
-	req *taprpc.ProofFile) (*taprpc.VerifyProofResponse, error) {
+		var groupKey []byte
+		if balance.GroupKey != nil {
+			groupKey = balance.GroupKey.SerializeCompressed()
+		}
 
-	if !proof.IsProofFile(req.RawProofFile) {
-		return nil, fmt.Errorf("invalid raw proof, expect file, not " +
-			"single encoded mint or transition proof")
+		groupKeyString := hex.EncodeToString(groupKey)
+		resp.AssetGroupBalances[groupKeyString] = &taprpc.AssetGroupBalance{
+			GroupKey: groupKey,
+			Balance:  balance.Balance,
+		}
 	}
 
-	if err := proof.CheckMaxFileSize(req.RawProofFile); err != nil {
-		return nil, fmt.Errorf("invalid proof file: %w", err)
-	}
+	return resp, nil
+}
 
-	var proofFile proof.File
-	err := proofFile.Decode(bytes.NewReader(req.RawProofFile))
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode proof file: %w", err)
-	}
+// ListUtxos lists the UTXOs managed by the target daemon, and the assets they
+// hold.
+func (r *rpcServer) ListUtxos(ctx context.Context,
+	req *taprpc.ListUtxosRequest) (*taprpc.ListUtxosResponse, error) {
 
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
-	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
-	_, err = proofFile.Verify(ctx, headerVerifier, groupVerifier)
+	rpcAssets, err := r.fetchRpcAssets(ctx, false, false, req.IncludeLeased)
 	if err != nil {
-		// We don't want to fail the RPC request because of a proof
-		// verification error, but we do want to log it for easier
-		// debugging.
-		rpcsLog.Errorf("Proof verification failed with err: %v", err)
+		return nil, err
 	}
-	valid := err == nil
 
-	p, err := proofFile.LastProof()
+	managedUtxos, err := r.cfg.AssetStore.FetchManagedUTXOs(ctx)
 	if err != nil {
 		return nil, err
 	}
-	decodedProof, err := r.marshalProof(ctx, p, false, false)
-	if err != nil {
-		return nil, fmt.Errorf("unable to marshal proof: %w", err)
+
+	utxos := make(map[string]*taprpc.ManagedUtxo)
+	for _, u := range managedUtxos {
+		utxos[u.OutPoint.String()] = &taprpc.ManagedUtxo{
+			OutPoint:         u.OutPoint.String(),
+			AmtSat:           int64(u.OutputValue),
+			InternalKey:      u.InternalKey.PubKey.SerializeCompressed(),
+			TaprootAssetRoot: u.TaprootAssetRoot,
+			MerkleRoot:       u.MerkleRoot,
+		}
 	}
 
-	decodedProof.ProofAtDepth = 0
-	decodedProof.NumberOfProofs = uint32(proofFile.NumProofs())
+	// Populate the assets managed by each UTXO.
+	for _, a := range rpcAssets {
+		op := a.ChainAnchor.AnchorOutpoint
+		utxo, ok := utxos[op]
+		if !ok {
+			return nil, fmt.Errorf("unable to find utxo %s for "+
+				"asset_id=%x", op, a.AssetGenesis.AssetId)
+		}
 
-	return &taprpc.VerifyProofResponse{
-		Valid:        valid,
-		DecodedProof: decodedProof,
+		utxo.Assets = append(utxo.Assets, a)
+		utxos[op] = utxo
+	}
+
+	// As a final pass, we'll prune out any UTXOs that don't have any
+	// assets, as these may be in the DB just for record keeping.
+	for _, utxo := range utxos {
+		if len(utxo.Assets) == 0 {
+			delete(utxos, utxo.OutPoint)
+		}
+	}
+
+	return &taprpc.ListUtxosResponse{
+		ManagedUtxos: utxos,
 	}, nil
 }
 
-// DecodeProof attempts to decode a given proof file that claims to be anchored
-// at the specified genesis point.
-func (r *rpcServer) DecodeProof(ctx context.Context,
-	req *taprpc.DecodeProofRequest) (*taprpc.DecodeProofResponse, error) {
+// ListGroups lists known groups and the assets held in each group.
+func (r *rpcServer) ListGroups(ctx context.Context,
+	_ *taprpc.ListGroupsRequest) (*taprpc.ListGroupsResponse, error) {
 
-	var (
-		proofReader = bytes.NewReader(req.RawProof)
-		rpcProof    *taprpc.DecodedProof
-	)
-	switch {
-	case proof.IsSingleProof(req.RawProof):
-		var p proof.Proof
-		err := p.Decode(proofReader)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode proof: %w",
-				err)
-		}
+	readableAssets, err := r.cfg.AssetStore.FetchGroupedAssets(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		rpcProof, err = r.marshalProof(
-			ctx, &p, req.WithPrevWitnesses, req.WithMetaReveal,
+	groupsWithAssets := make(map[string]*taprpc.GroupedAssets)
+
+	// Populate the map of group keys to assets in that group.
+	for _, a := range readableAssets {
+		groupKey := hex.EncodeToString(a.GroupKey.SerializeCompressed())
+
+		assetVersion, err := taprpc.MarshalAssetVersion(
+			a.Version,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal proof: %w",
-				err)
+			return nil, err
 		}
 
-		rpcProof.NumberOfProofs = 1
+		asset := &taprpc.AssetHumanReadable{
+			Id:               a.ID[:],
+			Version:          assetVersion,
+			Amount:           a.Amount,
+			LockTime:         int32(a.LockTime),
+			RelativeLockTime: int32(a.RelativeLockTime),
+			Tag:              a.Tag,
+			MetaHash:         a.MetaHash[:],
+			Type:             taprpc.AssetType(a.Type),
+		}
 
-	case proof.IsProofFile(req.RawProof):
-		if err := proof.CheckMaxFileSize(req.RawProof); err != nil {
-			return nil, fmt.Errorf("invalid proof file: %w", err)
+		_, ok := groupsWithAssets[groupKey]
+		if !ok {
+			groupsWithAssets[groupKey] = &taprpc.GroupedAssets{
+				Assets: []*taprpc.AssetHumanReadable{},
+			}
 		}
 
-		var proofFile proof.File
-		if err := proofFile.Decode(proofReader); err != nil {
-			return nil, fmt.Errorf("unable to decode proof file: "+
-				"%w", err)
+		groupsWithAssets[groupKey].Assets = append(
+			groupsWithAssets[groupKey].Assets, asset,
+		)
+	}
+
+	return &taprpc.ListGroupsResponse{Groups: groupsWithAssets}, nil
+}
+
+// ListBalances lists the asset balances owned by the daemon.
+func (r *rpcServer) ListBalances(ctx context.Context,
+	req *taprpc.ListBalancesRequest) (*taprpc.ListBalancesResponse, error) {
+
+	switch groupBy := req.GroupBy.(type) {
+	case *taprpc.ListBalancesRequest_AssetId:
+		if !groupBy.AssetId {
+			return nil, fmt.Errorf("invalid group_by")
 		}
 
-		latestProofIndex := uint32(proofFile.NumProofs() - 1)
-		if req.ProofAtDepth > latestProofIndex {
-			return nil, fmt.Errorf("invalid depth %d is greater "+
-				"than latest proof index of %d",
-				req.ProofAtDepth, latestProofIndex)
+		var assetID *asset.ID
+		if len(req.AssetFilter) != 0 {
+			assetID = &asset.ID{}
+			if len(req.AssetFilter) != len(assetID) {
+				return nil, fmt.Errorf("invalid asset filter")
+			}
+
+			copy(assetID[:], req.AssetFilter)
 		}
 
-		// Default to latest proof.
-		index := latestProofIndex - req.ProofAtDepth
-		p, err := proofFile.ProofAt(index)
-		if err != nil {
-			return nil, err
+		return r.listBalancesByAsset(ctx, assetID)
+
+	case *taprpc.ListBalancesRequest_GroupKey:
+		if !groupBy.GroupKey {
+			return nil, fmt.Errorf("invalid group_by")
 		}
 
-		rpcProof, err = r.marshalProof(
-			ctx, p, req.WithPrevWitnesses,
-			req.WithMetaReveal,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to marshal proof: %w",
-				err)
+		var groupKey *btcec.PublicKey
+		if len(req.GroupKeyFilter) != 0 {
+			var err error
+			groupKey, err = btcec.ParsePubKey(req.GroupKeyFilter)
+			if err != nil {
+				return nil, fmt.Errorf("invalid group key "+
+					"filter: %v", err)
+			}
 		}
 
-		rpcProof.ProofAtDepth = req.ProofAtDepth
-		rpcProof.NumberOfProofs = uint32(proofFile.NumProofs())
+		return r.listBalancesByGroupKey(ctx, groupKey)
 
 	default:
-		return nil, fmt.Errorf("invalid raw proof, could not " +
-			"identify decoding format")
+		return nil, fmt.Errorf("invalid group_by")
 	}
-
-	return &taprpc.DecodeProofResponse{
-		DecodedProof: rpcProof,
-	}, nil
 }
 
-// marshalProof turns a transition proof into an RPC DecodedProof.
-func (r *rpcServer) marshalProof(ctx context.Context, p *proof.Proof,
-	withPrevWitnesses, withMetaReveal bool) (*taprpc.DecodedProof, error) {
+// ListTransfers lists all asset transfers managed by this deamon.
+func (r *rpcServer) ListTransfers(ctx context.Context,
+	_ *taprpc.ListTransfersRequest) (*taprpc.ListTransfersResponse,
+	error) {
 
-	var (
-		rpcMeta        *taprpc.AssetMeta
-		rpcGenesis     = p.GenesisReveal
-		rpcGroupKey    = p.GroupKeyReveal
-		anchorOutpoint = wire.OutPoint{
-			Hash:  p.AnchorTx.TxHash(),
-			Index: p.InclusionProof.OutputIndex,
-		}
-		txMerkleProof  = p.TxMerkleProof
-		inclusionProof = p.InclusionProof
-		splitRootProof = p.SplitRootProof
-	)
+	parcels, err := r.cfg.AssetStore.QueryParcels(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query parcels: %w", err)
+	}
 
-	var txMerkleProofBuf bytes.Buffer
-	if err := txMerkleProof.Encode(&txMerkleProofBuf); err != nil {
-		return nil, fmt.Errorf("unable to encode serialized Bitcoin "+
-			"merkle proof: %w", err)
+	resp := &taprpc.ListTransfersResponse{
+		Transfers: make([]*taprpc.AssetTransfer, len(parcels)),
 	}
 
-	var inclusionProofBuf bytes.Buffer
-	if err := inclusionProof.Encode(&inclusionProofBuf); err != nil {
-		return nil, fmt.Errorf("unable to encode inclusion proof: %w",
-			err)
+	decimalDisplays := make(map[asset.ID]uint32)
+	for idx := range parcels {
+		resp.Transfers[idx], err = marshalOutboundParcel(parcels[idx])
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal parcel: %w",
+				err)
+		}
+
+		err = r.addTransferDisplayAmounts(
+			ctx, resp.Transfers[idx], decimalDisplays,
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if inclusionProof.CommitmentProof == nil {
-		return nil, fmt.Errorf("inclusion proof is missing " +
-			"commitment proof")
+	return resp, nil
+}
+
+// addTransferDisplayAmounts sets the display amounts of the outputs of the
+// given transfer. All inputs of a transfer must be of the same asset to know
+// which asset the outputs carry, otherwise no display amounts are set.
+func (r *rpcServer) addTransferDisplayAmounts(ctx context.Context,
+	transfer *taprpc.AssetTransfer, cache map[asset.ID]uint32) error {
+
+	if len(transfer.Inputs) == 0 {
+		return nil
 	}
-	tsSibling, tsHash, err := commitment.MaybeEncodeTapscriptPreimage(
-		inclusionProof.CommitmentProof.TapSiblingPreimage,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
-			err)
+
+	var assetID asset.ID
+	copy(assetID[:], transfer.Inputs[0].AssetId)
+	for _, input := range transfer.Inputs {
+		if !bytes.Equal(input.AssetId, assetID[:]) {
+			return nil
+		}
 	}
 
-	tapProof, err := inclusionProof.CommitmentProof.DeriveByAssetInclusion(
-		&p.Asset,
-	)
+	decimalDisplay, err := r.fetchDecimalDisplay(ctx, assetID, cache)
 	if err != nil {
-		return nil, fmt.Errorf("error deriving inclusion proof: %w",
-			err)
+		return err
 	}
-	merkleRoot := tapProof.TapscriptRoot(tsHash)
 
-	var exclusionProofs [][]byte
-	for _, exclusionProof := range p.ExclusionProofs {
-		var exclusionProofBuf bytes.Buffer
-		err := exclusionProof.Encode(&exclusionProofBuf)
-		if err != nil {
-			return nil, fmt.Errorf("unable to encode exclusion "+
-				"proofs: %w", err)
+	for _, output := range transfer.Outputs {
+		// Outputs that only carry passive assets don't have an asset
+		// of the transfer.
+		passiveOnly := taprpc.OutputType_OUTPUT_TYPE_PASSIVE_ASSETS_ONLY
+		if output.OutputType == passiveOnly {
+			continue
 		}
-		exclusionProofs = append(
-			exclusionProofs, exclusionProofBuf.Bytes(),
-		)
-	}
 
-	var splitRootProofBuf bytes.Buffer
-	if splitRootProof != nil {
-		err := splitRootProof.Encode(&splitRootProofBuf)
-		if err != nil {
-			return nil, fmt.Errorf("unable to encode split root "+
-				"proof: %w", err)
-		}
+		output.DisplayAmount = taprpc.FormatDecimalAmount(
+			output.Amount, decimalDisplay,
+		)
 	}
 
-	rpcAsset, err := r.marshalChainAsset(ctx, &tapdb.ChainAsset{
-		Asset:                  &p.Asset,
-		AnchorTx:               &p.AnchorTx,
-		AnchorTxid:             p.AnchorTx.TxHash(),
-		AnchorBlockHash:        p.BlockHeader.BlockHash(),
-		AnchorBlockHeight:      p.BlockHeight,
-		AnchorOutpoint:         anchorOutpoint,
-		AnchorInternalKey:      p.InclusionProof.InternalKey,
-		AnchorMerkleRoot:       merkleRoot[:],
-		AnchorTapscriptSibling: tsSibling,
-	}, withPrevWitnesses)
-	if err != nil {
-		return nil, err
-	}
+	return nil
+}
 
-	if withMetaReveal {
-		metaHash := rpcAsset.AssetGenesis.MetaHash
-		if len(metaHash) == 0 {
-			return nil, fmt.Errorf("asset does not contain meta " +
-				"data")
-		}
+// QueryAddrs queries the set of Taproot Asset addresses stored in the database.
+func (r *rpcServer) QueryAddrs(ctx context.Context,
+	req *taprpc.QueryAddrRequest) (*taprpc.QueryAddrResponse, error) {
 
-		rpcMeta, err = r.FetchAssetMeta(
-			ctx, &taprpc.FetchAssetMetaRequest{
-				Asset: &taprpc.FetchAssetMetaRequest_MetaHash{
-					MetaHash: metaHash,
-				},
-			},
-		)
-		if err != nil {
-			return nil, err
-		}
+	query := address.QueryParams{
+		Limit:  req.Limit,
+		Offset: req.Offset,
 	}
 
-	decodedAssetID := p.Asset.ID()
-	var genesisReveal *taprpc.GenesisReveal
-	if rpcGenesis != nil {
-		genesisReveal = &taprpc.GenesisReveal{
-			GenesisBaseReveal: &taprpc.GenesisInfo{
-				GenesisPoint: rpcGenesis.FirstPrevOut.String(),
-				Name:         rpcGenesis.Tag,
-				MetaHash:     rpcGenesis.MetaHash[:],
-				AssetId:      decodedAssetID[:],
-				OutputIndex:  rpcGenesis.OutputIndex,
-				AssetType:    taprpc.AssetType(p.Asset.Type),
-			},
-		}
+	// The unix time of 0 (1970-01-01) is not the same as an empty Time
+	// struct (0000-00-00). For our query to succeed, we need to set the
+	// time values the way the address book expects them.
+	if req.CreatedBefore > 0 {
+		query.CreatedBefore = time.Unix(req.CreatedBefore, 0)
 	}
-
-	var GroupKeyReveal taprpc.GroupKeyReveal
-	if rpcGroupKey != nil {
-		GroupKeyReveal = taprpc.GroupKeyReveal{
-			RawGroupKey:   rpcGroupKey.RawKey[:],
-			TapscriptRoot: rpcGroupKey.TapscriptRoot,
-		}
+	if req.CreatedAfter > 0 {
+		query.CreatedAfter = time.Unix(req.CreatedAfter, 0)
 	}
 
-	return &taprpc.DecodedProof{
-		Asset:               rpcAsset,
-		MetaReveal:          rpcMeta,
-		TxMerkleProof:       txMerkleProofBuf.Bytes(),
-		InclusionProof:      inclusionProofBuf.Bytes(),
-		ExclusionProofs:     exclusionProofs,
-		SplitRootProof:      splitRootProofBuf.Bytes(),
-		NumAdditionalInputs: uint32(len(p.AdditionalInputs)),
-		ChallengeWitness:    p.ChallengeWitness,
-		IsBurn:              p.Asset.IsBurn(),
-		GenesisReveal:       genesisReveal,
-		GroupKeyReveal:      &GroupKeyReveal,
-	}, nil
-}
-
-// ExportProof exports the latest raw proof file anchored at the specified
-// script_key.
-func (r *rpcServer) ExportProof(ctx context.Context,
-	req *taprpc.ExportProofRequest) (*taprpc.ProofFile, error) {
-
-	if len(req.ScriptKey) == 0 {
-		return nil, fmt.Errorf("a valid script key must be specified")
-	}
+	rpcsLog.Debugf("[QueryAddrs]: addr query params: %v",
+		spew.Sdump(query))
 
-	scriptKey, err := parseUserKey(req.ScriptKey)
+	dbAddrs, err := r.cfg.AddrBook.ListAddrs(ctx, query)
 	if err != nil {
-		return nil, fmt.Errorf("invalid script key: %w", err)
+		return nil, fmt.Errorf("unable to query addrs: %w", err)
 	}
 
-	if len(req.AssetId) != 32 {
-		return nil, fmt.Errorf("asset ID must be 32 bytes")
-	}
+	// TODO(roasbeef): just stop storing the hrp in the addr?
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
 
-	var assetID asset.ID
-	copy(assetID[:], req.AssetId)
+	addrs := make([]*taprpc.Addr, len(dbAddrs))
+	for i, dbAddr := range dbAddrs {
+		dbAddr.ChainParams = &tapParams
 
-	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
-		AssetID:   &assetID,
-		ScriptKey: *scriptKey,
-	})
-	if err != nil {
-		return nil, err
+		addrs[i], err = marshalAddr(dbAddr.Tap, r.cfg.TapAddrBook)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal addr: %w",
+				err)
+		}
 	}
 
-	return &taprpc.ProofFile{
-		RawProofFile: proofBlob,
+	rpcsLog.Debugf("[QueryAddrs]: returning %v addrs", len(addrs))
+
+	return &taprpc.QueryAddrResponse{
+		Addrs: addrs,
 	}, nil
 }
 
-// ImportProof attempts to import a proof file into the daemon. If successful, a
-// new asset will be inserted on disk, spendable using the specified target
-// script key, and internal key.
-func (r *rpcServer) ImportProof(ctx context.Context,
-	req *tapdevrpc.ImportProofRequest) (*tapdevrpc.ImportProofResponse,
-	error) {
+// NewAddr makes a new address from the set of request params.
+func (r *rpcServer) NewAddr(ctx context.Context,
+	req *taprpc.NewAddrRequest) (*taprpc.Addr, error) {
 
-	// We'll perform some basic input validation before we move forward.
-	if len(req.ProofFile) == 0 {
-		return nil, fmt.Errorf("proof file must be specified")
-	}
+	var err error
 
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
-	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	// Parse the proof courier address if one was provided, otherwise use
+	// the default specified in the config.
+	courierAddr := r.cfg.DefaultProofCourierAddr
+	if req.ProofCourierAddr != "" {
+		addr, err := proof.ParseCourierAddrString(
+			req.ProofCourierAddr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof courier "+
+				"address: %w", err)
+		}
 
-	// Now that we know the proof file is at least present, we'll attempt
-	// to import it into the main archive.
-	err := r.cfg.ProofArchive.ImportProofs(
-		ctx, headerVerifier, groupVerifier, false,
-		&proof.AnnotatedProof{Blob: req.ProofFile},
-	)
-	if err != nil {
-		return nil, err
+		// At this point, we do not intend on creating a proof courier
+		// service instance. We are only interested in parsing and
+		// validating the address. We therefore convert the address into
+		// an url.URL type for storage in the address book.
+		courierAddr = addr.Url()
 	}
 
-	return &tapdevrpc.ImportProofResponse{}, nil
-}
+	// Check that the proof courier address is set. This should never
+	// happen, but we check anyway to avoid panics (possibly caused by
+	// future erroneous config changes).
+	if courierAddr == nil {
+		return nil, fmt.Errorf("no proof courier address provided")
+	}
+	proofCourierAddr := *courierAddr
 
-// AddrReceives lists all receives for incoming asset transfers for addresses
-// that were created previously.
-func (r *rpcServer) AddrReceives(ctx context.Context,
-	req *taprpc.AddrReceivesRequest) (*taprpc.AddrReceivesResponse,
-	error) {
+	if len(req.AssetId) != 32 {
+		return nil, fmt.Errorf("invalid asset id length")
+	}
 
-	var sqlQuery address.EventQueryParams
+	var assetID asset.ID
+	copy(assetID[:], req.AssetId)
 
-	if len(req.FilterAddr) > 0 {
-		tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	// A display amount is converted to asset units with the decimal
+	// display of the asset, which requires the asset to be known.
+	amt := req.Amt
+	if req.DisplayAmt != "" {
+		if amt != 0 {
+			return nil, fmt.Errorf("cannot specify both amt and " +
+				"display_amt")
+		}
 
-		addr, err := address.DecodeAddress(req.FilterAddr, &tapParams)
+		_, err := r.cfg.AddrBook.QueryAssetInfo(ctx, assetID)
 		if err != nil {
-			return nil, fmt.Errorf("unable to decode addr: %w", err)
+			return nil, fmt.Errorf("unable to find asset %x: %w",
+				assetID[:], err)
 		}
 
-		// Now that we've decoded the address, we'll check to make sure
-		// that we can fetch the genesis for this address. Otherwise,
-		// that means we don't know anything about what it should look
-		// like on chain (the genesis is required to derive the taproot
-		// output key).
-		assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
-			ctx, addr.AssetID,
+		decimalDisplays := make(map[asset.ID]uint32)
+		decimalDisplay, err := r.fetchDecimalDisplay(
+			ctx, assetID, decimalDisplays,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unknown asset=%x: %w",
-				addr.AssetID[:], err)
+			return nil, err
 		}
 
-		rpcsLog.Tracef("Listing receives for group: %v",
-			spew.Sdump(assetGroup))
-
-		addr.AttachGenesis(*assetGroup.Genesis)
-
-		taprootOutputKey, err := addr.TaprootOutputKey()
+		amt, err = taprpc.ParseDecimalAmount(
+			req.DisplayAmt, decimalDisplay,
+		)
 		if err != nil {
-			return nil, fmt.Errorf("error deriving Taproot key: %w",
-				err)
+			return nil, err
 		}
-
-		sqlQuery.AddrTaprootOutputKey = schnorr.SerializePubKey(
-			taprootOutputKey,
-		)
 	}
 
-	if req.FilterStatus != taprpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN {
-		status, err := unmarshalAddrEventStatus(req.FilterStatus)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing status: %w", err)
-		}
+	rpcsLog.Infof("[NewAddr]: making new addr: asset_id=%x, amt=%v",
+		assetID[:], amt)
 
-		sqlQuery.StatusFrom = &status
-		sqlQuery.StatusTo = &status
+	err = r.checkBalanceOverflow(ctx, &assetID, nil, amt)
+	if err != nil {
+		return nil, err
 	}
 
-	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
+	// Was there a tapscript sibling preimage specified?
+	tapscriptSibling, _, err := commitment.MaybeDecodeTapscriptPreimage(
+		req.TapscriptSibling,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("error querying events: %w", err)
+		return nil, fmt.Errorf("invalid tapscript sibling: %w", err)
 	}
 
-	resp := &taprpc.AddrReceivesResponse{
-		Events: make([]*taprpc.AddrEvent, len(events)),
+	assetVersion, err := taprpc.UnmarshalAssetVersion(req.AssetVersion)
+	if err != nil {
+		return nil, err
 	}
 
-	for idx, event := range events {
-		resp.Events[idx], err = marshalAddrEvent(
-			event, r.cfg.TapAddrBook,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling event: %w",
-				err)
-		}
-	}
-
-	return resp, nil
-}
-
-// FundVirtualPsbt selects inputs from the available asset commitments to fund
-// a virtual transaction matching the template.
-func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
-	req *wrpc.FundVirtualPsbtRequest) (*wrpc.FundVirtualPsbtResponse,
-	error) {
-
-	var fundedVPkt *tapfreighter.FundedVPacket
+	var addr *address.AddrWithKeyInfo
 	switch {
-	case req.GetPsbt() != nil:
-		vPkt, err := tappsbt.NewFromRawBytes(
-			bytes.NewReader(req.GetPsbt()), false,
+	// No key was specified, we'll let the address book derive them.
+	case req.ScriptKey == nil && req.InternalKey == nil:
+		// Now that we have all the params, we'll try to add a new
+		// address to the addr book.
+		addr, err = r.cfg.AddrBook.NewAddress(
+			ctx, assetID, amt, tapscriptSibling,
+			proofCourierAddr,
+			address.WithAssetVersion(assetVersion),
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to decode psbt: %w", err)
+			return nil, fmt.Errorf("unable to make new addr: %w",
+				err)
 		}
 
-		// Extract the recipient information from the packet. This
-		// basically assembles the asset ID we want to send to and the
-		// sum of all output amounts.
-		desc, err := tapscript.DescribeRecipients(
-			ctx, vPkt, r.cfg.TapAddrBook,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to describe packet "+
-				"recipients: %w", err)
-		}
+	// Only the script key was specified.
+	case req.ScriptKey != nil && req.InternalKey == nil:
+		return nil, fmt.Errorf("internal key must also be specified " +
+			"if script key is specified")
 
-		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(
-			ctx, desc, vPkt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("error funding packet: %w", err)
-		}
+	// Only the internal key was specified.
+	case req.ScriptKey == nil && req.InternalKey != nil:
+		return nil, fmt.Errorf("script key must also be specified " +
+			"if internal key is specified")
 
-	case req.GetRaw() != nil:
-		raw := req.GetRaw()
-		if len(raw.Inputs) > 0 {
-			return nil, fmt.Errorf("template inputs not yet " +
-				"supported")
-		}
-		if len(raw.Recipients) > 1 {
-			return nil, fmt.Errorf("only one recipient supported")
+	// Both the script and internal keys were specified.
+	default:
+		scriptKey, err := UnmarshalScriptKey(req.ScriptKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode script key: "+
+				"%w", err)
 		}
 
-		var (
-			tapParams = address.ParamsForChain(
-				r.cfg.ChainParams.Name,
-			)
-			addr *address.Tap
-			err  error
-		)
-		for a := range raw.Recipients {
-			addr, err = address.DecodeAddress(a, &tapParams)
-			if err != nil {
-				return nil, fmt.Errorf("unable to decode "+
-					"addr: %w", err)
-			}
-		}
+		rpcsLog.Debugf("Decoded script key %x (internal %x, tweak %x)",
+			scriptKey.PubKey.SerializeCompressed(),
+			scriptKey.RawKey.PubKey.SerializeCompressed(),
+			scriptKey.Tweak[:])
 
-		if addr == nil {
-			return nil, fmt.Errorf("no recipients specified")
+		internalKey, err := UnmarshalKeyDescriptor(req.InternalKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode internal "+
+				"key: %w", err)
 		}
 
-		fundedVPkt, _, err = r.cfg.AssetWallet.FundAddressSend(
-			ctx, addr,
+		// Now that we have all the params, we'll try to add a new
+		// address to the addr book.
+		addr, err = r.cfg.AddrBook.NewAddressWithKeys(
+			ctx, assetID, amt, *scriptKey, internalKey,
+			tapscriptSibling, proofCourierAddr,
+			address.WithAssetVersion(assetVersion),
 		)
 		if err != nil {
-			return nil, fmt.Errorf("error funding address send: "+
-				"%w", err)
+			return nil, fmt.Errorf("unable to make new addr: %w",
+				err)
 		}
-
-	default:
-		return nil, fmt.Errorf("either PSBT or raw template must be " +
-			"specified")
 	}
 
-	var b bytes.Buffer
-	if err := fundedVPkt.VPacket.Serialize(&b); err != nil {
-		return nil, fmt.Errorf("error serializing packet: %w", err)
+	// With our addr obtained, we'll marshal it as an RPC message then send
+	// off the response.
+	rpcAddr, err := marshalAddr(addr.Tap, r.cfg.TapAddrBook)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal addr: %w", err)
 	}
 
-	return &wrpc.FundVirtualPsbtResponse{
-		FundedPsbt:        b.Bytes(),
-		ChangeOutputIndex: 0,
-	}, nil
+	return rpcAddr, nil
 }
 
-// SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
-// commitments of the inputs and outputs.
-func (r *rpcServer) SignVirtualPsbt(_ context.Context,
-	req *wrpc.SignVirtualPsbtRequest) (*wrpc.SignVirtualPsbtResponse,
-	error) {
+// DecodeAddr decode a Taproot Asset address into a partial asset message that
+// represents the asset it wants to receive.
+func (r *rpcServer) DecodeAddr(_ context.Context,
+	req *taprpc.DecodeAddrRequest) (*taprpc.Addr, error) {
 
-	if req.FundedPsbt == nil {
-		return nil, fmt.Errorf("request cannot be nil")
+	if len(req.Addr) == 0 {
+		return nil, fmt.Errorf("must specify an addr")
 	}
 
-	vPkt, err := tappsbt.NewFromRawBytes(
-		bytes.NewReader(req.FundedPsbt), false,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding packet: %w", err)
-	}
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
 
-	signedInputs, err := r.cfg.AssetWallet.SignVirtualPacket(vPkt)
+	addr, err := address.DecodeAddress(req.Addr, &tapParams)
 	if err != nil {
-		return nil, fmt.Errorf("error signing packet: %w", err)
+		return nil, fmt.Errorf("unable to decode addr: %w", err)
 	}
 
-	var b bytes.Buffer
-	if err := vPkt.Serialize(&b); err != nil {
-		return nil, fmt.Errorf("error serializing packet: %w", err)
+	rpcAddr, err := marshalAddr(addr, r.cfg.TapAddrBook)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal addr: %w", err)
 	}
 
-	return &wrpc.SignVirtualPsbtResponse{
-		SignedPsbt:   b.Bytes(),
-		SignedInputs: signedInputs,
-	}, nil
+	return rpcAddr, nil
 }
 
-// AnchorVirtualPsbts merges and then commits multiple virtual transactions in
-// a single BTC level anchor transaction.
-//
-// TODO(guggero): Actually implement accepting and merging multiple
-// transactions.
-func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
-	req *wrpc.AnchorVirtualPsbtsRequest) (*taprpc.SendAssetResponse,
-	error) {
+// VerifyProof attempts to verify a given proof file that claims to be anchored
+// at the specified genesis point.
+func (r *rpcServer) VerifyProof(ctx context.Context,
+	req *taprpc.ProofFile) (*taprpc.VerifyProofResponse, error) {
 
-	if len(req.VirtualPsbts) == 0 {
-		return nil, fmt.Errorf("no virtual PSBTs specified")
+	if !proof.IsProofFile(req.RawProofFile) {
+		return nil, fmt.Errorf("invalid raw proof, expect file, not " +
+			"single encoded mint or transition proof")
 	}
 
-	if len(req.VirtualPsbts) > 1 {
-		return nil, fmt.Errorf("only one virtual PSBT supported")
+	if err := proof.CheckMaxFileSize(req.RawProofFile); err != nil {
+		return nil, fmt.Errorf("invalid proof file: %w", err)
 	}
 
-	vPacket, err := tappsbt.NewFromRawBytes(
-		bytes.NewReader(req.VirtualPsbts[0]), false,
-	)
+	var proofFile proof.File
+	err := proofFile.Decode(bytes.NewReader(req.RawProofFile))
 	if err != nil {
-		return nil, fmt.Errorf("error decoding packet: %w", err)
-	}
-
-	if len(vPacket.Inputs) != 1 {
-		return nil, fmt.Errorf("only one input is currently supported")
+		return nil, fmt.Errorf("unable to decode proof file: %w", err)
 	}
 
-	inputAsset := vPacket.Inputs[0].Asset()
-	prevID := vPacket.Inputs[0].PrevID
-	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
-		ctx, inputAsset.ID(), prevID.OutPoint, inputAsset.GroupKey,
-		&inputAsset.ScriptKey, true,
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	medianTimeSource := tapgarden.GenMedianTimeSource(
+		ctx, r.cfg.ChainBridge,
+	)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	_, err = proofFile.Verify(
+		ctx, headerVerifier, medianTimeSource, groupVerifier,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching input commitment: %w",
-			err)
+		// We don't want to fail the RPC request because of a proof
+		// verification error, but we do want to log it for easier
+		// debugging.
+		rpcsLog.Errorf("Proof verification failed with err: %v", err)
 	}
+	valid := err == nil
 
-	rpcsLog.Debugf("Selected commitment for anchor point %v, requesting "+
-		"delivery", inputCommitment.AnchorPoint)
-
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewPreSignedParcel(
-			vPacket, tappsbt.InputCommitments{
-				0: inputCommitment.Commitment,
-			},
-		),
-	)
+	p, err := proofFile.LastProof()
 	if err != nil {
-		return nil, fmt.Errorf("error requesting delivery: %w", err)
+		return nil, err
 	}
-
-	parcel, err := marshalOutboundParcel(resp)
+	decodedProof, err := r.marshalProof(ctx, p, false, false)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
+		return nil, fmt.Errorf("unable to marshal proof: %w", err)
 	}
 
-	return &taprpc.SendAssetResponse{
-		Transfer: parcel,
+	decodedProof.ProofAtDepth = 0
+	decodedProof.NumberOfProofs = uint32(proofFile.NumProofs())
+
+	return &taprpc.VerifyProofResponse{
+		Valid:        valid,
+		DecodedProof: decodedProof,
 	}, nil
 }
 
-// NextInternalKey derives the next internal key for the given key family and
-// stores it as an internal key in the database to make sure it is identified
-// as a local key later on when importing proofs. While an internal key can
-// also be used as the internal key of a script key, it is recommended to use
-// the NextScriptKey RPC instead, to make sure the tweaked Taproot output key
-// is also recognized as a local key.
-func (r *rpcServer) NextInternalKey(ctx context.Context,
-	req *wrpc.NextInternalKeyRequest) (*wrpc.NextInternalKeyResponse,
-	error) {
+// DecodeProof attempts to decode a given proof file that claims to be anchored
+// at the specified genesis point.
+func (r *rpcServer) DecodeProof(ctx context.Context,
+	req *taprpc.DecodeProofRequest) (*taprpc.DecodeProofResponse, error) {
 
-	// Due to how we detect local keys, we need to make sure that the key
-	// family is not zero.
-	if req.KeyFamily == 0 {
-		return nil, fmt.Errorf("key family must be set to a non-zero " +
-			"value")
-	}
+	var (
+		proofReader = bytes.NewReader(req.RawProof)
+		rpcProof    *taprpc.DecodedProof
+	)
+	switch {
+	case proof.IsSingleProof(req.RawProof):
+		var p proof.Proof
+		err := p.Decode(proofReader)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode proof: %w",
+				err)
+		}
 
-	keyDesc, err := r.cfg.AddrBook.NextInternalKey(ctx, keychain.KeyFamily(
-		req.KeyFamily,
-	))
-	if err != nil {
-		return nil, fmt.Errorf("error inserting internal key: %w", err)
-	}
+		rpcProof, err = r.marshalProof(
+			ctx, &p, req.WithPrevWitnesses, req.WithMetaReveal,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal proof: %w",
+				err)
+		}
 
-	return &wrpc.NextInternalKeyResponse{
-		InternalKey: marshalKeyDescriptor(keyDesc),
-	}, nil
-}
+		rpcProof.NumberOfProofs = 1
 
-// NextScriptKey derives the next script key (and its corresponding internal
-// key) and stores them both in the database to make sure they are identified
-// as local keys later on when importing proofs.
-func (r *rpcServer) NextScriptKey(ctx context.Context,
-	req *wrpc.NextScriptKeyRequest) (*wrpc.NextScriptKeyResponse,
-	error) {
+	case proof.IsProofFile(req.RawProof):
+		if err := proof.CheckMaxFileSize(req.RawProof); err != nil {
+			return nil, fmt.Errorf("invalid proof file: %w", err)
+		}
 
-	// Due to how we detect local keys, we need to make sure that the key
-	// family is not zero.
-	if req.KeyFamily == 0 {
-		return nil, fmt.Errorf("key family must be set to a non-zero " +
-			"value")
-	}
+		var proofFile proof.File
+		if err := proofFile.Decode(proofReader); err != nil {
+			return nil, fmt.Errorf("unable to decode proof file: "+
+				"%w", err)
+		}
 
-	scriptKey, err := r.cfg.AddrBook.NextScriptKey(ctx, keychain.KeyFamily(
-		req.KeyFamily,
-	))
-	if err != nil {
-		return nil, fmt.Errorf("error inserting internal key: %w", err)
+		latestProofIndex := uint32(proofFile.NumProofs() - 1)
+		if req.ProofAtDepth > latestProofIndex {
+			return nil, fmt.Errorf("invalid depth %d is greater "+
+				"than latest proof index of %d",
+				req.ProofAtDepth, latestProofIndex)
+		}
+
+		// Default to latest proof.
+		index := latestProofIndex - req.ProofAtDepth
+		p, err := proofFile.ProofAt(index)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcProof, err = r.marshalProof(
+			ctx, p, req.WithPrevWitnesses,
+			req.WithMetaReveal,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal proof: %w",
+				err)
+		}
+
+		rpcProof.ProofAtDepth = req.ProofAtDepth
+		rpcProof.NumberOfProofs = uint32(proofFile.NumProofs())
+
+	default:
+		return nil, fmt.Errorf("invalid raw proof, could not " +
+			"identify decoding format")
 	}
 
-	return &wrpc.NextScriptKeyResponse{
-		ScriptKey: marshalScriptKey(scriptKey),
+	return &taprpc.DecodeProofResponse{
+		DecodedProof: rpcProof,
 	}, nil
 }
 
-// marshalAddr turns an address into its RPC counterpart.
-func marshalAddr(addr *address.Tap,
-	db address.Storage) (*taprpc.Addr, error) {
-
-	addrStr, err := addr.EncodeAddress()
-	if err != nil {
-		return nil, fmt.Errorf("unable to encode addr: %w", err)
-	}
+// marshalProof turns a transition proof into an RPC DecodedProof.
+func (r *rpcServer) marshalProof(ctx context.Context, p *proof.Proof,
+	withPrevWitnesses, withMetaReveal bool) (*taprpc.DecodedProof, error) {
 
-	// We can only derive the taproot output if we already know the genesis
-	// for this asset, as that's required to make the template asset that
-	// will be committed to in the tapscript tree.
-	var taprootOutputKey []byte
-	assetGroup, err := db.QueryAssetGroup(
-		context.Background(), addr.AssetID,
+	var (
+		rpcMeta        *taprpc.AssetMeta
+		rpcGenesis     = p.GenesisReveal
+		rpcGroupKey    = p.GroupKeyReveal
+		anchorOutpoint = wire.OutPoint{
+			Hash:  p.AnchorTx.TxHash(),
+			Index: p.InclusionProof.OutputIndex,
+		}
+		txMerkleProof  = p.TxMerkleProof
+		inclusionProof = p.InclusionProof
+		splitRootProof = p.SplitRootProof
 	)
-	if err == nil {
-		addr.AttachGenesis(*assetGroup.Genesis)
 
-		outputKey, err := addr.TaprootOutputKey()
-		if err != nil {
-			return nil, fmt.Errorf("error deriving Taproot "+
-				"output key: %w", err)
-		}
+	var txMerkleProofBuf bytes.Buffer
+	if err := txMerkleProof.Encode(&txMerkleProofBuf); err != nil {
+		return nil, fmt.Errorf("unable to encode serialized Bitcoin "+
+			"merkle proof: %w", err)
+	}
 
-		taprootOutputKey = schnorr.SerializePubKey(outputKey)
+	var inclusionProofBuf bytes.Buffer
+	if err := inclusionProof.Encode(&inclusionProofBuf); err != nil {
+		return nil, fmt.Errorf("unable to encode inclusion proof: %w",
+			err)
 	}
 
-	siblingBytes, _, err := commitment.MaybeEncodeTapscriptPreimage(
-		addr.TapscriptSibling,
+	if inclusionProof.CommitmentProof == nil {
+		return nil, fmt.Errorf("inclusion proof is missing " +
+			"commitment proof")
+	}
+	tsSibling, tsHash, err := commitment.MaybeEncodeTapscriptPreimage(
+		inclusionProof.CommitmentProof.TapSiblingPreimage,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
 			err)
 	}
 
-	assetVersion, err := taprpc.MarshalAssetVersion(addr.AssetVersion)
+	tapProof, err := inclusionProof.CommitmentProof.DeriveByAssetInclusion(
+		&p.Asset,
+	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error deriving inclusion proof: %w",
+			err)
 	}
+	merkleRoot := tapProof.TapscriptRoot(tsHash)
 
-	id := addr.AssetID
-	rpcAddr := &taprpc.Addr{
-		AssetVersion:     assetVersion,
-		Encoded:          addrStr,
-		AssetId:          id[:],
-		Amount:           addr.Amount,
-		ScriptKey:        addr.ScriptKey.SerializeCompressed(),
-		InternalKey:      addr.InternalKey.SerializeCompressed(),
-		TapscriptSibling: siblingBytes,
-		TaprootOutputKey: taprootOutputKey,
-		AssetType:        taprpc.AssetType(addr.AssetType()),
-		ProofCourierAddr: addr.ProofCourierAddr.String(),
+	var exclusionProofs [][]byte
+	for _, exclusionProof := range p.ExclusionProofs {
+		var exclusionProofBuf bytes.Buffer
+		err := exclusionProof.Encode(&exclusionProofBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode exclusion "+
+				"proofs: %w", err)
+		}
+		exclusionProofs = append(
+			exclusionProofs, exclusionProofBuf.Bytes(),
+		)
 	}
 
-	if addr.GroupKey != nil {
-		rpcAddr.GroupKey = addr.GroupKey.SerializeCompressed()
+	var splitRootProofBuf bytes.Buffer
+	if splitRootProof != nil {
+		err := splitRootProof.Encode(&splitRootProofBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode split root "+
+				"proof: %w", err)
+		}
 	}
 
-	return rpcAddr, nil
-}
+	rpcAsset, err := r.marshalChainAsset(ctx, &tapdb.ChainAsset{
+		Asset:                  &p.Asset,
+		AnchorTx:               &p.AnchorTx,
+		AnchorTxid:             p.AnchorTx.TxHash(),
+		AnchorBlockHash:        p.BlockHeader.BlockHash(),
+		AnchorBlockHeight:      p.BlockHeight,
+		AnchorOutpoint:         anchorOutpoint,
+		AnchorInternalKey:      p.InclusionProof.InternalKey,
+		AnchorMerkleRoot:       merkleRoot[:],
+		AnchorTapscriptSibling: tsSibling,
+	}, withPrevWitnesses)
+	if err != nil {
+		return nil, err
+	}
 
-// marshalAddrEvent turns an address event into its RPC counterpart.
-func marshalAddrEvent(event *address.Event,
-	db address.Storage) (*taprpc.AddrEvent, error) {
+	if withMetaReveal {
+		metaHash := rpcAsset.AssetGenesis.MetaHash
+		if len(metaHash) == 0 {
+			return nil, fmt.Errorf("asset does not contain meta " +
+				"data")
+		}
 
-	rpcAddr, err := marshalAddr(event.Addr.Tap, db)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling addr: %w", err)
+		rpcMeta, err = r.FetchAssetMeta(
+			ctx, &taprpc.FetchAssetMetaRequest{
+				Asset: &taprpc.FetchAssetMetaRequest_MetaHash{
+					MetaHash: metaHash,
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	rpcStatus, err := marshalAddrEventStatus(event.Status)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling status: %w", err)
+	decodedAssetID := p.Asset.ID()
+	var genesisReveal *taprpc.GenesisReveal
+	if rpcGenesis != nil {
+		genesisReveal = &taprpc.GenesisReveal{
+			GenesisBaseReveal: &taprpc.GenesisInfo{
+				GenesisPoint: rpcGenesis.FirstPrevOut.String(),
+				Name:         rpcGenesis.Tag,
+				MetaHash:     rpcGenesis.MetaHash[:],
+				AssetId:      decodedAssetID[:],
+				OutputIndex:  rpcGenesis.OutputIndex,
+				AssetType:    taprpc.AssetType(p.Asset.Type),
+			},
+		}
 	}
 
-	return &taprpc.AddrEvent{
-		CreationTimeUnixSeconds: uint64(event.CreationTime.Unix()),
-		Addr:                    rpcAddr,
-		Status:                  rpcStatus,
-		Outpoint:                event.Outpoint.String(),
-		UtxoAmtSat:              uint64(event.Amt),
-		ConfirmationHeight:      event.ConfirmationHeight,
-		HasProof:                event.HasProof,
+	var GroupKeyReveal taprpc.GroupKeyReveal
+	if rpcGroupKey != nil {
+		GroupKeyReveal = taprpc.GroupKeyReveal{
+			RawGroupKey:   rpcGroupKey.RawKey[:],
+			TapscriptRoot: rpcGroupKey.TapscriptRoot,
+		}
+	}
+
+	return &taprpc.DecodedProof{
+		Asset:               rpcAsset,
+		MetaReveal:          rpcMeta,
+		TxMerkleProof:       txMerkleProofBuf.Bytes(),
+		InclusionProof:      inclusionProofBuf.Bytes(),
+		ExclusionProofs:     exclusionProofs,
+		SplitRootProof:      splitRootProofBuf.Bytes(),
+		NumAdditionalInputs: uint32(len(p.AdditionalInputs)),
+		ChallengeWitness:    p.ChallengeWitness,
+		IsBurn:              p.Asset.IsBurn(),
+		GenesisReveal:       genesisReveal,
+		GroupKeyReveal:      &GroupKeyReveal,
 	}, nil
 }
 
-// unmarshalAddrEventStatus parses the RPC address event status into the native
-// counterpart.
-func unmarshalAddrEventStatus(
-	rpcStatus taprpc.AddrEventStatus) (address.Status, error) {
-
-	switch rpcStatus {
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED:
-		return address.StatusTransactionDetected, nil
-
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED:
-		return address.StatusTransactionConfirmed, nil
-
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED:
-		return address.StatusProofReceived, nil
-
-	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED:
-		return address.StatusCompleted, nil
+// ExportProof exports the latest raw proof file anchored at the specified
+// script_key.
+func (r *rpcServer) ExportProof(ctx context.Context,
+	req *taprpc.ExportProofRequest) (*taprpc.ProofFile, error) {
 
-	default:
-		return 0, fmt.Errorf("unknown address event status <%d>",
-			rpcStatus)
+	if len(req.ScriptKey) == 0 {
+		return nil, fmt.Errorf("a valid script key must be specified")
 	}
-}
-
-// marshalAddrEventStatus turns the address event status into the RPC
-// counterpart.
-func marshalAddrEventStatus(status address.Status) (taprpc.AddrEventStatus,
-	error) {
-
-	switch status {
-	case address.StatusTransactionDetected:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED,
-			nil
-
-	case address.StatusTransactionConfirmed:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED,
-			nil
-
-	case address.StatusProofReceived:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED,
-			nil
 
-	case address.StatusCompleted:
-		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED, nil
+	scriptKey, err := parseUserKey(req.ScriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
 
-	default:
-		return 0, fmt.Errorf("unknown address event status <%d>",
-			status)
+	if len(req.AssetId) != 32 {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
 	}
-}
 
-// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt to
-// complete an asset send. The method returns information w.r.t the on chain
-// send, as well as the proof file information the receiver needs to fully
-// receive the asset.
-func (r *rpcServer) SendAsset(_ context.Context,
-	req *taprpc.SendAssetRequest) (*taprpc.SendAssetResponse, error) {
+	var assetID asset.ID
+	copy(assetID[:], req.AssetId)
 
-	if len(req.TapAddrs) == 0 {
-		return nil, fmt.Errorf("at least one addr is required")
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	var (
-		tapParams = address.ParamsForChain(r.cfg.ChainParams.Name)
-		tapAddrs  = make([]*address.Tap, len(req.TapAddrs))
-		err       error
-	)
-	for idx := range req.TapAddrs {
-		if req.TapAddrs[idx] == "" {
-			return nil, fmt.Errorf("addr %d must be specified", idx)
-		}
+	return &taprpc.ProofFile{
+		RawProofFile: proofBlob,
+	}, nil
+}
 
-		tapAddrs[idx], err = address.DecodeAddress(
-			req.TapAddrs[idx], &tapParams,
-		)
-		if err != nil {
-			return nil, err
-		}
+// ImportProof attempts to import a proof file into the daemon. If successful, a
+// new asset will be inserted on disk, spendable using the specified target
+// script key, and internal key.
+func (r *rpcServer) ImportProof(ctx context.Context,
+	req *tapdevrpc.ImportProofRequest) (*tapdevrpc.ImportProofResponse,
+	error) {
 
-		// Ensure all addrs are of the same asset ID. Within a single
-		// transfer (=a single virtual packet), we expect only to have
-		// inputs and outputs of the same asset ID. Multiple assets can
-		// be moved in a single BTC level anchor output, but the
-		// expectation is that they would be in separate virtual
-		// packets, one for each asset ID. They would then be merged
-		// into the same anchor output in the wallet's
-		// AnchorVirtualTransactions call.
-		//
-		// TODO(guggero): Support creating multiple virtual packets, one
-		// for each asset ID when the user wants to send multiple asset
-		// IDs at the same time without going through the PSBT flow.
-		//
-		// TODO(guggero): Revisit after we have a way to send fungible
-		// assets with different IDs to an address (non-interactive).
-		if idx > 0 {
-			if tapAddrs[idx].AssetID != tapAddrs[0].AssetID {
-				return nil, fmt.Errorf("all addrs must be of "+
-					"the same asset ID %v",
-					tapAddrs[0].AssetID)
-			}
-		}
+	// We'll perform some basic input validation before we move forward.
+	if len(req.ProofFile) == 0 {
+		return nil, fmt.Errorf("proof file must be specified")
 	}
 
-	feeRate, err := checkFeeRateSanity(req.FeeRate)
-	if err != nil {
-		return nil, err
-	}
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	medianTimeSource := tapgarden.GenMedianTimeSource(
+		ctx, r.cfg.ChainBridge,
+	)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewAddressParcel(feeRate, tapAddrs...),
+	// Now that we know the proof file is at least present, we'll attempt
+	// to import it into the main archive.
+	err := r.cfg.ProofArchive.ImportProofs(
+		ctx, headerVerifier, medianTimeSource, groupVerifier, false,
+		&proof.AnnotatedProof{Blob: req.ProofFile},
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	parcel, err := marshalOutboundParcel(resp)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
-	}
-
-	return &taprpc.SendAssetResponse{
-		Transfer: parcel,
-	}, nil
+	return &tapdevrpc.ImportProofResponse{}, nil
 }
 
-// BurnAsset burns the given number of units of a given asset by sending them
-// to a provably un-spendable script key. Burning means irrevocably destroying
-// a certain number of assets, reducing the total supply of the asset. Because
-// burning is such a destructive and non-reversible operation, some specific
-// values need to be set in the request to avoid accidental burns.
-func (r *rpcServer) BurnAsset(ctx context.Context,
-	in *taprpc.BurnAssetRequest) (*taprpc.BurnAssetResponse, error) {
+// AddrReceives lists all receives for incoming asset transfers for addresses
+// that were created previously.
+func (r *rpcServer) AddrReceives(ctx context.Context,
+	req *taprpc.AddrReceivesRequest) (*taprpc.AddrReceivesResponse,
+	error) {
 
-	var assetID asset.ID
-	switch {
-	case len(in.GetAssetId()) > 0:
-		copy(assetID[:], in.GetAssetId())
+	var sqlQuery address.EventQueryParams
 
-	case len(in.GetAssetIdStr()) > 0:
-		assetIDBytes, err := hex.DecodeString(in.GetAssetIdStr())
+	if len(req.FilterAddr) > 0 {
+		tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+
+		addr, err := address.DecodeAddress(req.FilterAddr, &tapParams)
 		if err != nil {
-			return nil, fmt.Errorf("error decoding asset ID: %w",
-				err)
+			return nil, fmt.Errorf("unable to decode addr: %w", err)
 		}
 
-		copy(assetID[:], assetIDBytes)
+		// Now that we've decoded the address, we'll check to make sure
+		// that we can fetch the genesis for this address. Otherwise,
+		// that means we don't know anything about what it should look
+		// like on chain (the genesis is required to derive the taproot
+		// output key).
+		assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
+			ctx, addr.AssetID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unknown asset=%x: %w",
+				addr.AssetID[:], err)
+		}
 
-	default:
-		return nil, fmt.Errorf("asset ID must be specified")
-	}
+		rpcsLog.Tracef("Listing receives for group: %v",
+			spew.Sdump(assetGroup))
 
-	if in.AmountToBurn == 0 {
-		return nil, fmt.Errorf("amount to burn must be specified")
-	}
-	if in.ConfirmationText != AssetBurnConfirmationText {
-		return nil, fmt.Errorf("invalid confirmation text, please " +
-			"read API doc and confirm safety measure to avoid " +
-			"accidental asset burns")
-	}
+		addr.AttachGenesis(*assetGroup.Genesis)
 
-	var groupKey *btcec.PublicKey
-	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(ctx, assetID)
-	if err == nil && assetGroup.GroupKey != nil {
-		groupKey = &assetGroup.GroupPubKey
-	}
+		taprootOutputKey, err := addr.TaprootOutputKey()
+		if err != nil {
+			return nil, fmt.Errorf("error deriving Taproot key: %w",
+				err)
+		}
 
-	fundResp, err := r.cfg.AssetWallet.FundBurn(
-		ctx, &tapscript.FundingDescriptor{
-			ID:       assetID,
-			GroupKey: groupKey,
-			Amount:   in.AmountToBurn,
-		},
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error funding burn: %w", err)
+		sqlQuery.AddrTaprootOutputKey = schnorr.SerializePubKey(
+			taprootOutputKey,
+		)
 	}
 
-	// Now we can sign the packet and send it to the chain.
-	_, err = r.cfg.AssetWallet.SignVirtualPacket(fundResp.VPacket)
-	if err != nil {
-		return nil, fmt.Errorf("error signing packet: %w", err)
-	}
+	if req.FilterStatus != taprpc.AddrEventStatus_ADDR_EVENT_STATUS_UNKNOWN {
+		status, err := unmarshalAddrEventStatus(req.FilterStatus)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing status: %w", err)
+		}
 
-	resp, err := r.cfg.ChainPorter.RequestShipment(
-		tapfreighter.NewPreSignedParcel(
-			fundResp.VPacket, fundResp.InputCommitments,
-		),
-	)
-	if err != nil {
-		return nil, err
+		sqlQuery.StatusFrom = &status
+		sqlQuery.StatusTo = &status
 	}
 
-	parcel, err := marshalOutboundParcel(resp)
+	events, err := r.cfg.AddrBook.QueryEvents(ctx, sqlQuery)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
-			err)
+		return nil, fmt.Errorf("error querying events: %w", err)
 	}
 
-	var burnProof *taprpc.DecodedProof
-	for idx := range resp.Outputs {
-		vOut := fundResp.VPacket.Outputs[idx]
-		tOut := resp.Outputs[idx]
-		if vOut.Asset.IsBurn() {
-			var p proof.Proof
-			err = p.Decode(bytes.NewReader(tOut.ProofSuffix))
-			if err != nil {
-				return nil, fmt.Errorf("error decoding "+
-					"burn proof: %w", err)
-			}
+	resp := &taprpc.AddrReceivesResponse{
+		Events: make([]*taprpc.AddrEvent, len(events)),
+	}
 
-			burnProof, err = r.marshalProof(ctx, &p, true, false)
-			if err != nil {
-				return nil, fmt.Errorf("error decoding "+
-					"burn proof: %w", err)
-			}
+	for idx, event := range events {
+		resp.Events[idx], err = marshalAddrEvent(
+			event, r.cfg.TapAddrBook,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling event: %w",
+				err)
 		}
 	}
 
-	return &taprpc.BurnAssetResponse{
-		BurnTransfer: parcel,
-		BurnProof:    burnProof,
-	}, nil
+	return resp, nil
 }
 
-// marshalOutboundParcel turns a pending parcel into its RPC counterpart.
-func marshalOutboundParcel(
-	parcel *tapfreighter.OutboundParcel) (*taprpc.AssetTransfer,
+// FundVirtualPsbt selects inputs from the available asset commitments to fund
+// a virtual transaction matching the template.
+func (r *rpcServer) FundVirtualPsbt(ctx context.Context,
+	req *wrpc.FundVirtualPsbtRequest) (*wrpc.FundVirtualPsbtResponse,
 	error) {
 
-	rpcInputs := make([]*taprpc.TransferInput, len(parcel.Inputs))
-	for idx := range parcel.Inputs {
-		in := parcel.Inputs[idx]
-		rpcInputs[idx] = &taprpc.TransferInput{
-			AnchorPoint: in.OutPoint.String(),
-			AssetId:     in.ID[:],
-			ScriptKey:   in.ScriptKey[:],
-			Amount:      in.Amount,
-		}
+	strategy, err := r.unmarshalCoinSelectStrategy(req.CoinSelectStrategy)
+	if err != nil {
+		return nil, err
 	}
 
-	rpcOutputs := make(
-		[]*taprpc.TransferOutput, len(parcel.Outputs),
-	)
-	for idx := range parcel.Outputs {
-		out := parcel.Outputs[idx]
-
-		internalPubKey := out.Anchor.InternalKey.PubKey
-		internalKeyBytes := internalPubKey.SerializeCompressed()
-		rpcAnchor := &taprpc.TransferOutputAnchor{
-			Outpoint:         out.Anchor.OutPoint.String(),
-			Value:            int64(out.Anchor.Value),
-			InternalKey:      internalKeyBytes,
-			TaprootAssetRoot: out.Anchor.TaprootAssetRoot[:],
-			MerkleRoot:       out.Anchor.MerkleRoot[:],
-			TapscriptSibling: out.Anchor.TapscriptSibling,
-			NumPassiveAssets: out.Anchor.NumPassiveAssets,
-		}
-		scriptPubKey := out.ScriptKey.PubKey
-
-		var splitCommitRoot []byte
-		if out.SplitCommitmentRoot != nil {
-			hash := out.SplitCommitmentRoot.NodeHash()
-			if hash != mssmt.ZeroNodeHash {
-				splitCommitRoot = hash[:]
-			}
+	var fundedVPkt *tapfreighter.FundedVPacket
+	switch {
+	case req.GetPsbt() != nil:
+		vPkt, err := tappsbt.NewFromRawBytes(
+			bytes.NewReader(req.GetPsbt()), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode psbt: %w", err)
 		}
 
-		rpcOutType, err := marshalOutputType(out.Type)
+		// Extract the recipient information from the packet. This
+		// basically assembles the asset ID we want to send to and the
+		// sum of all output amounts.
+		desc, err := tapscript.DescribeRecipients(
+			ctx, vPkt, r.cfg.TapAddrBook,
+		)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("unable to describe packet "+
+				"recipients: %w", err)
 		}
 
-		assetVersion, err := taprpc.MarshalAssetVersion(
-			out.AssetVersion,
+		fundedVPkt, err = r.cfg.AssetWallet.FundPacket(
+			ctx, desc, vPkt, strategy,
 		)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("error funding packet: %w", err)
 		}
 
-		rpcOutputs[idx] = &taprpc.TransferOutput{
-			Anchor:              rpcAnchor,
-			ScriptKey:           scriptPubKey.SerializeCompressed(),
-			ScriptKeyIsLocal:    out.ScriptKeyLocal,
-			Amount:              out.Amount,
-			NewProofBlob:        out.ProofSuffix,
-			SplitCommitRootHash: splitCommitRoot,
-			OutputType:          rpcOutType,
-			AssetVersion:        assetVersion,
+	case req.GetRaw() != nil:
+		raw := req.GetRaw()
+		if len(raw.Inputs) > 0 {
+			return nil, fmt.Errorf("template inputs not yet " +
+				"supported")
+		}
+		if len(raw.Recipients) > 1 {
+			return nil, fmt.Errorf("only one recipient supported")
 		}
-	}
 
-	anchorTxHash := parcel.AnchorTx.TxHash()
-	return &taprpc.AssetTransfer{
-		TransferTimestamp:  parcel.TransferTime.Unix(),
-		AnchorTxHash:       anchorTxHash[:],
-		AnchorTxHeightHint: parcel.AnchorTxHeightHint,
-		AnchorTxChainFees:  parcel.ChainFees,
-		Inputs:             rpcInputs,
-		Outputs:            rpcOutputs,
-	}, nil
-}
+		var (
+			tapParams = address.ParamsForChain(
+				r.cfg.ChainParams.Name,
+			)
+			addr *address.Tap
+			err  error
+		)
+		for a := range raw.Recipients {
+			addr, err = address.DecodeAddress(a, &tapParams)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode "+
+					"addr: %w", err)
+			}
+		}
 
-// marshalOutputType turns the transfer output type into the RPC counterpart.
-func marshalOutputType(outputType tappsbt.VOutputType) (taprpc.OutputType,
-	error) {
+		if addr == nil {
+			return nil, fmt.Errorf("no recipients specified")
+		}
 
-	switch outputType {
-	case tappsbt.TypeSimple:
-		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE, nil
+		fundedVPkt, _, err = r.cfg.AssetWallet.FundAddressSend(
+			ctx, strategy, addr,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error funding address send: "+
+				"%w", err)
+		}
 
-	case tappsbt.TypeSplitRoot:
-		return taprpc.OutputType_OUTPUT_TYPE_SPLIT_ROOT, nil
+	default:
+		return nil, fmt.Errorf("either PSBT or raw template must be " +
+			"specified")
+	}
 
-	case tappsbt.TypePassiveAssetsOnly:
-		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_ASSETS_ONLY, nil
+	var b bytes.Buffer
+	if err := fundedVPkt.VPacket.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
+	}
 
-	case tappsbt.TypePassiveSplitRoot:
-		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_SPLIT_ROOT, nil
+	// An external signer also needs to sign the virtual transactions that
+	// re-anchor the passive assets of the inputs, so we return them too.
+	passiveAssets, err := r.cfg.AssetWallet.CreatePassiveAssets(
+		fundedVPkt.VPacket, fundedVPkt.InputCommitments,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating passive assets: %w",
+			err)
+	}
 
-	case tappsbt.TypeSimplePassiveAssets:
-		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE_PASSIVE_ASSETS, nil
+	passivePsbts := make([][]byte, len(passiveAssets))
+	for idx, passiveAsset := range passiveAssets {
+		var passiveBuf bytes.Buffer
+		err := passiveAsset.VPacket.Serialize(&passiveBuf)
+		if err != nil {
+			return nil, fmt.Errorf("error serializing passive "+
+				"packet: %w", err)
+		}
 
-	default:
-		return 0, fmt.Errorf("unknown output type: %d", outputType)
+		passivePsbts[idx] = passiveBuf.Bytes()
 	}
+
+	return &wrpc.FundVirtualPsbtResponse{
+		FundedPsbt:        b.Bytes(),
+		ChangeOutputIndex: 0,
+		PassiveAssetPsbts: passivePsbts,
+	}, nil
 }
 
-// SubscribeSendAssetEventNtfns registers a subscription to the event
-// notification stream which relates to the asset sending process.
-func (r *rpcServer) SubscribeSendAssetEventNtfns(
-	_ *taprpc.SubscribeSendAssetEventNtfnsRequest,
-	ntfnStream subSendEventNtfnsStream) error {
+// SignVirtualPsbt signs the inputs of a virtual transaction and prepares the
+// commitments of the inputs and outputs.
+func (r *rpcServer) SignVirtualPsbt(_ context.Context,
+	req *wrpc.SignVirtualPsbtRequest) (*wrpc.SignVirtualPsbtResponse,
+	error) {
 
-	// Create a new event subscriber and pass a copy to the chain porter.
-	// We will then read events from the subscriber.
-	eventSubscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
-	defer eventSubscriber.Stop()
+	if req.FundedPsbt == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
 
-	// Register the subscriber with the ChainPorter.
-	err := r.cfg.ChainPorter.RegisterSubscriber(
-		eventSubscriber, false, false,
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to register chain porter event"+
-			"notifications subscription: %w", err)
+		return nil, fmt.Errorf("error decoding packet: %w", err)
 	}
 
-	// Loop and read from the event subscription and forward to the RPC
-	// stream.
-	for {
-		select {
-		// Handle receiving a new event from the ChainPorter.
-		// The event will be mapped to the RPC event type and
-		// sent over the stream.
-		case event := <-eventSubscriber.NewItemCreated.ChanOut():
+	// Apply any script path spend instructions to the inputs before we
+	// hand the packet to the wallet for signing.
+	for _, spendPath := range req.ScriptSpendPaths {
+		idx := spendPath.InputIndex
+		if int(idx) >= len(vPkt.Inputs) {
+			return nil, fmt.Errorf("invalid input index %d for "+
+				"script spend path", idx)
+		}
 
-			rpcEvent, err := marshallSendAssetEvent(event)
-			if err != nil {
-				return fmt.Errorf("failed to marshall asset "+
-					"send event into RPC event: %w", err)
-			}
+		leaf, err := unmarshalTapLeaf(spendPath.Leaf)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing tap leaf for "+
+				"input %d: %w", idx, err)
+		}
 
-			err = ntfnStream.Send(rpcEvent)
-			if err != nil {
-				return fmt.Errorf("failed to RPC stream send "+
-					"event: %w", err)
-			}
+		controlBlock, err := txscript.ParseControlBlock(
+			spendPath.ControlBlock,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing control block "+
+				"for input %d: %w", idx, err)
+		}
+		if controlBlock.LeafVersion != leaf.LeafVersion {
+			return nil, fmt.Errorf("control block leaf version "+
+				"doesn't match leaf for input %d", idx)
+		}
 
-		// Handle the case where the RPC stream is closed by the
-		// client.
-		case <-ntfnStream.Context().Done():
-			// Don't return an error if a normal context
-			// cancellation has occurred.
-			isCanceledContext := errors.Is(
-				ntfnStream.Context().Err(), context.Canceled,
-			)
-			if isCanceledContext {
-				return nil
-			}
+		vPkt.Inputs[idx].SetScriptSpendPath(
+			leaf, spendPath.ControlBlock, spendPath.SignLeaf,
+			spendPath.WitnessStack, spendPath.SigDepth,
+		)
+	}
 
-			return ntfnStream.Context().Err()
+	signedInputs, err := r.cfg.AssetWallet.SignVirtualPacket(vPkt)
+	if err != nil {
+		return nil, fmt.Errorf("error signing packet: %w", err)
+	}
 
-		// Handle the case where the RPC server is shutting down.
-		case <-r.quit:
-			return nil
-		}
+	var b bytes.Buffer
+	if err := vPkt.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
 	}
+
+	return &wrpc.SignVirtualPsbtResponse{
+		SignedPsbt:   b.Bytes(),
+		SignedInputs: signedInputs,
+	}, nil
 }
 
-// SubscribeReceiveAssetEventNtfns registers a subscription to the event
-// notification stream which relates to the asset receiving process.
-func (r *rpcServer) SubscribeReceiveAssetEventNtfns(
-	_ *taprpc.SubscribeReceiveAssetEventNtfnsRequest,
-	ntfnStream subReceiveEventNtfnsStream) error {
+// AnchorVirtualPsbts merges and then commits multiple virtual transactions in
+// a single BTC level anchor transaction.
+//
+// TODO(guggero): Actually implement accepting and merging multiple
+// transactions.
+func (r *rpcServer) AnchorVirtualPsbts(ctx context.Context,
+	req *wrpc.AnchorVirtualPsbtsRequest) (*taprpc.SendAssetResponse,
+	error) {
 
-	// Create a new event subscriber and pass a copy to the component(s)
-	// which are involved in the asset receive process. We will then read
-	// events from the subscriber.
-	eventSubscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
-	defer eventSubscriber.Stop()
+	if len(req.VirtualPsbts) == 0 {
+		return nil, fmt.Errorf("no virtual PSBTs specified")
+	}
 
-	// Register the subscriber with the AssetCustodian.
-	err := r.cfg.AssetCustodian.RegisterSubscriber(
-		eventSubscriber, false, false,
+	if len(req.VirtualPsbts) > 1 {
+		return nil, fmt.Errorf("only one virtual PSBT supported")
+	}
+
+	vPacket, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.VirtualPsbts[0]), false,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to register custodian event"+
-			"notifications subscription: %w", err)
+		return nil, fmt.Errorf("error decoding packet: %w", err)
 	}
 
-	// Loop and read from the event subscription and forward to the RPC
-	// stream.
-	for {
-		select {
-		// Handle receiving a new event. The event will be mapped to the
-		// RPC event type and sent over the stream.
+	if len(vPacket.Inputs) != 1 {
+		return nil, fmt.Errorf("only one input is currently supported")
+	}
+
+	inputAsset := vPacket.Inputs[0].Asset()
+	prevID := vPacket.Inputs[0].PrevID
+	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
+		ctx, inputAsset.ID(), prevID.OutPoint, inputAsset.GroupKey,
+		&inputAsset.ScriptKey, true,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching input commitment: %w",
+			err)
+	}
+
+	rpcsLog.Debugf("Selected commitment for anchor point %v, requesting "+
+		"delivery", inputCommitment.AnchorPoint)
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreSignedParcel(
+			vPacket, tappsbt.InputCommitments{
+				0: inputCommitment.Commitment,
+			}, nil,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting delivery: %w", err)
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// CommitVirtualPsbts creates and funds the BTC level anchor transaction that
+// commits to the given signed virtual transactions (both active and passive),
+// without signing it. This is used in watch-only mode, where the anchor
+// transaction is signed by an external signer and then published with
+// PublishAndLogTransfer.
+func (r *rpcServer) CommitVirtualPsbts(ctx context.Context,
+	req *wrpc.CommitVirtualPsbtsRequest) (*wrpc.CommitVirtualPsbtsResponse,
+	error) {
+
+	params, err := r.unmarshalAnchorVTxnsParams(
+		ctx, req.VirtualPsbts, req.PassiveAssetPsbts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate != nil {
+		params.FeeRate = *feeRate
+	} else {
+		params.FeeRate, err = r.cfg.ChainBridge.EstimateFee(
+			ctx, tapscript.SendConfTarget,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to estimate fee: %w",
+				err)
+		}
+	}
+
+	anchorPkt, err := r.cfg.AssetWallet.CommitVirtualTransactions(
+		ctx, params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error committing virtual "+
+			"transactions: %w", err)
+	}
+
+	var b bytes.Buffer
+	if err := anchorPkt.Pkt.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing anchor PSBT: %w",
+			err)
+	}
+
+	return &wrpc.CommitVirtualPsbtsResponse{
+		AnchorPsbt:        b.Bytes(),
+		ChangeOutputIndex: anchorPkt.ChangeOutputIndex,
+	}, nil
+}
+
+// PublishAndLogTransfer verifies that the given externally signed anchor
+// transaction commits to the given signed virtual transactions, then finalizes
+// and broadcasts it and logs the transfer. The proofs of the transfer are
+// created once the anchor transaction confirms.
+func (r *rpcServer) PublishAndLogTransfer(ctx context.Context,
+	req *wrpc.PublishAndLogTransferRequest) (*taprpc.SendAssetResponse,
+	error) {
+
+	if len(req.AnchorPsbt) == 0 {
+		return nil, fmt.Errorf("anchor PSBT must be specified")
+	}
+
+	anchorPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.AnchorPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding anchor PSBT: %w", err)
+	}
+
+	params, err := r.unmarshalAnchorVTxnsParams(
+		ctx, req.VirtualPsbts, req.PassiveAssetPsbts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorTx, err := r.cfg.AssetWallet.FinalizeAnchorTransaction(
+		ctx, anchorPkt, req.ChangeOutputIndex, params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error finalizing anchor TX: %w", err)
+	}
+
+	passiveAssets := make(
+		[]*tapfreighter.PassiveAssetReAnchor,
+		len(params.PassiveAssetsVPkts),
+	)
+	for idx, passivePkt := range params.PassiveAssetsVPkts {
+		passiveAssets[idx], err = tapfreighter.NewPassiveAssetReAnchor(
+			passivePkt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid passive asset packet "+
+				"%d: %w", idx, err)
+		}
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreAnchoredParcel(
+			params.VPkts, params.InputCommitments, passiveAssets,
+			nil, anchorTx,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting delivery: %w", err)
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// unmarshalAnchorVTxnsParams decodes the given signed active and passive
+// virtual transactions and fetches the input commitments of the active ones.
+func (r *rpcServer) unmarshalAnchorVTxnsParams(ctx context.Context,
+	rawVPkts, rawPassiveVPkts [][]byte) (*tapfreighter.AnchorVTxnsParams,
+	error) {
+
+	if len(rawVPkts) == 0 {
+		return nil, fmt.Errorf("no virtual PSBTs specified")
+	}
+
+	params := &tapfreighter.AnchorVTxnsParams{}
+	for idx := range rawVPkts {
+		vPkt, err := tappsbt.NewFromRawBytes(
+			bytes.NewReader(rawVPkts[idx]), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding packet %d: %w",
+				idx, err)
+		}
+
+		inputCommitments, err := r.fetchInputCommitments(ctx, vPkt)
+		if err != nil {
+			return nil, err
+		}
+
+		params.VPkts = append(params.VPkts, vPkt)
+		params.InputCommitments = append(
+			params.InputCommitments, inputCommitments,
+		)
+	}
+
+	for idx := range rawPassiveVPkts {
+		vPkt, err := tappsbt.NewFromRawBytes(
+			bytes.NewReader(rawPassiveVPkts[idx]), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding passive packet "+
+				"%d: %w", idx, err)
+		}
+		if len(vPkt.Outputs) != 1 {
+			return nil, fmt.Errorf("passive packet %d must have "+
+				"exactly one output", idx)
+		}
+
+		params.PassiveAssetsVPkts = append(
+			params.PassiveAssetsVPkts, vPkt,
+		)
+	}
+
+	return params, nil
+}
+
+// fetchInputCommitments fetches the Taproot Asset commitments of the anchor
+// outputs spent by the inputs of the given virtual packet, without any
+// tombstones or burns, as those are never re-anchored.
+func (r *rpcServer) fetchInputCommitments(ctx context.Context,
+	vPkt *tappsbt.VPacket) (tappsbt.InputCommitments, error) {
+
+	inputCommitments := make(tappsbt.InputCommitments, len(vPkt.Inputs))
+	for idx := range vPkt.Inputs {
+		inputAsset := vPkt.Inputs[idx].Asset()
+		if inputAsset == nil {
+			return nil, fmt.Errorf("input %d has no asset", idx)
+		}
+
+		prevID := vPkt.Inputs[idx].PrevID
+		inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
+			ctx, inputAsset.ID(), prevID.OutPoint,
+			inputAsset.GroupKey, &inputAsset.ScriptKey, true,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching input "+
+				"commitment: %w", err)
+		}
+
+		inputCommitments[idx] = inputCommitment.Commitment
+	}
+
+	return tapfreighter.PruneInputCommitments(inputCommitments)
+}
+
+// NextInternalKey derives the next internal key for the given key family and
+// stores it as an internal key in the database to make sure it is identified
+// as a local key later on when importing proofs. While an internal key can
+// also be used as the internal key of a script key, it is recommended to use
+// the NextScriptKey RPC instead, to make sure the tweaked Taproot output key
+// is also recognized as a local key.
+func (r *rpcServer) NextInternalKey(ctx context.Context,
+	req *wrpc.NextInternalKeyRequest) (*wrpc.NextInternalKeyResponse,
+	error) {
+
+	// Due to how we detect local keys, we need to make sure that the key
+	// family is not zero.
+	if req.KeyFamily == 0 {
+		return nil, fmt.Errorf("key family must be set to a non-zero " +
+			"value")
+	}
+
+	keyDesc, err := r.cfg.AddrBook.NextInternalKey(ctx, keychain.KeyFamily(
+		req.KeyFamily,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("error inserting internal key: %w", err)
+	}
+
+	return &wrpc.NextInternalKeyResponse{
+		InternalKey: marshalKeyDescriptor(keyDesc),
+	}, nil
+}
+
+// NextScriptKey derives the next script key (and its corresponding internal
+// key) and stores them both in the database to make sure they are identified
+// as local keys later on when importing proofs.
+func (r *rpcServer) NextScriptKey(ctx context.Context,
+	req *wrpc.NextScriptKeyRequest) (*wrpc.NextScriptKeyResponse,
+	error) {
+
+	// Due to how we detect local keys, we need to make sure that the key
+	// family is not zero.
+	if req.KeyFamily == 0 {
+		return nil, fmt.Errorf("key family must be set to a non-zero " +
+			"value")
+	}
+
+	// If the caller wants the script key to commit to a tapscript tree, we
+	// assemble the tree from the given leaves first.
+	var (
+		tapscriptTree *txscript.IndexedTapScriptTree
+		tapscriptRoot []byte
+	)
+	if len(req.TapscriptLeaves) > 0 {
+		leaves := make([]txscript.TapLeaf, len(req.TapscriptLeaves))
+		for idx := range req.TapscriptLeaves {
+			leaf, err := unmarshalTapLeaf(req.TapscriptLeaves[idx])
+			if err != nil {
+				return nil, fmt.Errorf("error parsing tap "+
+					"leaf %d: %w", idx, err)
+			}
+
+			leaves[idx] = leaf
+		}
+
+		tapscriptTree = txscript.AssembleTaprootScriptTree(leaves...)
+		rootHash := tapscriptTree.RootNode.TapHash()
+		tapscriptRoot = rootHash[:]
+	}
+
+	scriptKey, err := r.cfg.AddrBook.NextTapscriptScriptKey(
+		ctx, keychain.KeyFamily(req.KeyFamily), tapscriptRoot,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error inserting internal key: %w", err)
+	}
+
+	resp := &wrpc.NextScriptKeyResponse{
+		ScriptKey: marshalScriptKey(scriptKey),
+	}
+
+	// For each leaf we also return the control block that is required to
+	// spend the script key through that leaf later on.
+	if tapscriptTree != nil {
+		rawKey := scriptKey.RawKey.PubKey
+		for idx := range tapscriptTree.LeafMerkleProofs {
+			leafProof := tapscriptTree.LeafMerkleProofs[idx]
+			controlBlock := leafProof.ToControlBlock(rawKey)
+			controlBlockBytes, err := controlBlock.ToBytes()
+			if err != nil {
+				return nil, fmt.Errorf("error serializing "+
+					"control block: %w", err)
+			}
+
+			leafHash := leafProof.TapHash()
+			resp.LeafSpendInfo = append(
+				resp.LeafSpendInfo, &wrpc.TapLeafSpendInfo{
+					Leaf:         req.TapscriptLeaves[idx],
+					LeafHash:     leafHash[:],
+					ControlBlock: controlBlockBytes,
+				},
+			)
+		}
+	}
+
+	return resp, nil
+}
+
+// unmarshalTapLeaf parses a tapscript leaf from its RPC counterpart.
+func unmarshalTapLeaf(rpcLeaf *wrpc.TapLeaf) (txscript.TapLeaf, error) {
+	if rpcLeaf == nil {
+		return txscript.TapLeaf{}, fmt.Errorf("tap leaf cannot be nil")
+	}
+
+	if len(rpcLeaf.Script) == 0 {
+		return txscript.TapLeaf{}, fmt.Errorf("tap leaf script cannot " +
+			"be empty")
+	}
+
+	leafVersion := txscript.BaseLeafVersion
+	if rpcLeaf.LeafVersion != 0 {
+		if rpcLeaf.LeafVersion > math.MaxUint8 {
+			return txscript.TapLeaf{}, fmt.Errorf("invalid leaf "+
+				"version %d", rpcLeaf.LeafVersion)
+		}
+
+		leafVersion = txscript.TapscriptLeafVersion(
+			rpcLeaf.LeafVersion,
+		)
+	}
+
+	return txscript.NewTapLeaf(leafVersion, rpcLeaf.Script), nil
+}
+
+// marshalAddr turns an address into its RPC counterpart.
+func marshalAddr(addr *address.Tap,
+	db address.Storage) (*taprpc.Addr, error) {
+
+	addrStr, err := addr.EncodeAddress()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode addr: %w", err)
+	}
+
+	// We can only derive the taproot output if we already know the genesis
+	// for this asset, as that's required to make the template asset that
+	// will be committed to in the tapscript tree.
+	var taprootOutputKey []byte
+	assetGroup, err := db.QueryAssetGroup(
+		context.Background(), addr.AssetID,
+	)
+	if err == nil {
+		addr.AttachGenesis(*assetGroup.Genesis)
+
+		outputKey, err := addr.TaprootOutputKey()
+		if err != nil {
+			return nil, fmt.Errorf("error deriving Taproot "+
+				"output key: %w", err)
+		}
+
+		taprootOutputKey = schnorr.SerializePubKey(outputKey)
+	}
+
+	siblingBytes, _, err := commitment.MaybeEncodeTapscriptPreimage(
+		addr.TapscriptSibling,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding tapscript sibling: %w",
+			err)
+	}
+
+	assetVersion, err := taprpc.MarshalAssetVersion(addr.AssetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	id := addr.AssetID
+	rpcAddr := &taprpc.Addr{
+		AssetVersion:     assetVersion,
+		Encoded:          addrStr,
+		AssetId:          id[:],
+		Amount:           addr.Amount,
+		ScriptKey:        addr.ScriptKey.SerializeCompressed(),
+		InternalKey:      addr.InternalKey.SerializeCompressed(),
+		TapscriptSibling: siblingBytes,
+		TaprootOutputKey: taprootOutputKey,
+		AssetType:        taprpc.AssetType(addr.AssetType()),
+		ProofCourierAddr: addr.ProofCourierAddr.String(),
+	}
+
+	if addr.GroupKey != nil {
+		rpcAddr.GroupKey = addr.GroupKey.SerializeCompressed()
+	}
+
+	return rpcAddr, nil
+}
+
+// marshalAddrEvent turns an address event into its RPC counterpart.
+func marshalAddrEvent(event *address.Event,
+	db address.Storage) (*taprpc.AddrEvent, error) {
+
+	rpcAddr, err := marshalAddr(event.Addr.Tap, db)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling addr: %w", err)
+	}
+
+	rpcStatus, err := marshalAddrEventStatus(event.Status)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling status: %w", err)
+	}
+
+	return &taprpc.AddrEvent{
+		CreationTimeUnixSeconds: uint64(event.CreationTime.Unix()),
+		Addr:                    rpcAddr,
+		Status:                  rpcStatus,
+		Outpoint:                event.Outpoint.String(),
+		UtxoAmtSat:              uint64(event.Amt),
+		ConfirmationHeight:      event.ConfirmationHeight,
+		HasProof:                event.HasProof,
+	}, nil
+}
+
+// unmarshalAddrEventStatus parses the RPC address event status into the native
+// counterpart.
+func unmarshalAddrEventStatus(
+	rpcStatus taprpc.AddrEventStatus) (address.Status, error) {
+
+	switch rpcStatus {
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED:
+		return address.StatusTransactionDetected, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED:
+		return address.StatusTransactionConfirmed, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED:
+		return address.StatusProofReceived, nil
+
+	case taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED:
+		return address.StatusCompleted, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address event status <%d>",
+			rpcStatus)
+	}
+}
+
+// marshalAddrEventStatus turns the address event status into the RPC
+// counterpart.
+func marshalAddrEventStatus(status address.Status) (taprpc.AddrEventStatus,
+	error) {
+
+	switch status {
+	case address.StatusTransactionDetected:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_DETECTED,
+			nil
+
+	case address.StatusTransactionConfirmed:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_TRANSACTION_CONFIRMED,
+			nil
+
+	case address.StatusProofReceived:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_PROOF_RECEIVED,
+			nil
+
+	case address.StatusCompleted:
+		return taprpc.AddrEventStatus_ADDR_EVENT_STATUS_COMPLETED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown address event status <%d>",
+			status)
+	}
+}
+
+// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt to
+// complete an asset send. The method returns information w.r.t the on chain
+// send, as well as the proof file information the receiver needs to fully
+// receive the asset.
+func (r *rpcServer) SendAsset(ctx context.Context,
+	req *taprpc.SendAssetRequest) (*taprpc.SendAssetResponse, error) {
+
+	if len(req.TapAddrs) == 0 {
+		return nil, fmt.Errorf("at least one addr is required")
+	}
+
+	var (
+		tapParams = address.ParamsForChain(r.cfg.ChainParams.Name)
+		tapAddrs  = make([]*address.Tap, len(req.TapAddrs))
+		err       error
+	)
+	for idx := range req.TapAddrs {
+		if req.TapAddrs[idx] == "" {
+			return nil, fmt.Errorf("addr %d must be specified", idx)
+		}
+
+		tapAddrs[idx], err = address.DecodeAddress(
+			req.TapAddrs[idx], &tapParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy, err := r.unmarshalCoinSelectStrategy(req.CoinSelectStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewAddressParcel(feeRate, strategy, tapAddrs...),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	decimalDisplays := make(map[asset.ID]uint32)
+	err = r.addTransferDisplayAmounts(ctx, parcel, decimalDisplays)
+	if err != nil {
+		return nil, err
+	}
+
+	return &taprpc.SendAssetResponse{
+		Transfer: parcel,
+	}, nil
+}
+
+// BurnAsset burns the given number of units of a given asset by sending them
+// to a provably un-spendable script key. Burning means irrevocably destroying
+// a certain number of assets, reducing the total supply of the asset. Because
+// burning is such a destructive and non-reversible operation, some specific
+// values need to be set in the request to avoid accidental burns.
+func (r *rpcServer) BurnAsset(ctx context.Context,
+	in *taprpc.BurnAssetRequest) (*taprpc.BurnAssetResponse, error) {
+
+	var assetID asset.ID
+	switch {
+	case len(in.GetAssetId()) > 0:
+		copy(assetID[:], in.GetAssetId())
+
+	case len(in.GetAssetIdStr()) > 0:
+		assetIDBytes, err := hex.DecodeString(in.GetAssetIdStr())
+		if err != nil {
+			return nil, fmt.Errorf("error decoding asset ID: %w",
+				err)
+		}
+
+		copy(assetID[:], assetIDBytes)
+
+	default:
+		return nil, fmt.Errorf("asset ID must be specified")
+	}
+
+	if in.AmountToBurn == 0 {
+		return nil, fmt.Errorf("amount to burn must be specified")
+	}
+	if in.ConfirmationText != AssetBurnConfirmationText {
+		return nil, fmt.Errorf("invalid confirmation text, please " +
+			"read API doc and confirm safety measure to avoid " +
+			"accidental asset burns")
+	}
+
+	var groupKey *btcec.PublicKey
+	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(ctx, assetID)
+	if err == nil && assetGroup.GroupKey != nil {
+		groupKey = &assetGroup.GroupPubKey
+	}
+
+	fundResp, err := r.cfg.AssetWallet.FundBurn(
+		ctx, &tapscript.FundingDescriptor{
+			ID:       assetID,
+			GroupKey: groupKey,
+			Amount:   in.AmountToBurn,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error funding burn: %w", err)
+	}
+
+	// Now we can sign the packet and send it to the chain.
+	_, err = r.cfg.AssetWallet.SignVirtualPacket(fundResp.VPacket)
+	if err != nil {
+		return nil, fmt.Errorf("error signing packet: %w", err)
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreSignedParcel(
+			fundResp.VPacket, fundResp.InputCommitments, nil,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	var burnProof *taprpc.DecodedProof
+	for idx := range resp.Outputs {
+		vOut := fundResp.VPacket.Outputs[idx]
+		tOut := resp.Outputs[idx]
+		if vOut.Asset.IsBurn() {
+			var p proof.Proof
+			err = p.Decode(bytes.NewReader(tOut.ProofSuffix))
+			if err != nil {
+				return nil, fmt.Errorf("error decoding "+
+					"burn proof: %w", err)
+			}
+
+			burnProof, err = r.marshalProof(ctx, &p, true, false)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding "+
+					"burn proof: %w", err)
+			}
+		}
+	}
+
+	return &taprpc.BurnAssetResponse{
+		BurnTransfer: parcel,
+		BurnProof:    burnProof,
+	}, nil
+}
+
+// BumpTransferFee bumps the on-chain fee of a pending asset transfer whose
+// anchor transaction hasn't confirmed yet, either by replacing the anchor
+// transaction (RBF) or by spending its change output (CPFP).
+func (r *rpcServer) BumpTransferFee(_ context.Context,
+	req *taprpc.BumpTransferFeeRequest) (*taprpc.BumpTransferFeeResponse,
+	error) {
+
+	anchorTxHash, err := chainhash.NewHash(req.AnchorTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor tx hash: %w", err)
+	}
+
+	var method tapfreighter.FeeBumpMethod
+	switch req.Method {
+	case taprpc.FeeBumpMethod_FEE_BUMP_METHOD_RBF:
+		method = tapfreighter.FeeBumpRBF
+
+	case taprpc.FeeBumpMethod_FEE_BUMP_METHOD_CPFP:
+		method = tapfreighter.FeeBumpCPFP
+
+	default:
+		return nil, fmt.Errorf("unknown fee bump method: %v",
+			req.Method)
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate == nil {
+		return nil, fmt.Errorf("fee rate must be specified")
+	}
+
+	result, err := r.cfg.ChainPorter.BumpTransferFee(
+		*anchorTxHash, method, *feeRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to bump transfer fee: %w", err)
+	}
+
+	parcel, err := marshalOutboundParcel(result.Parcel)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	resp := &taprpc.BumpTransferFeeResponse{
+		Transfer: parcel,
+	}
+	if result.ChildTx != nil {
+		childTxHash := result.ChildTx.TxHash()
+		resp.ChildTxHash = childTxHash[:]
+	}
+
+	return resp, nil
+}
+
+// AbandonTransfer abandons a pending asset transfer whose anchor transaction
+// hasn't confirmed yet, making the input assets spendable again.
+func (r *rpcServer) AbandonTransfer(_ context.Context,
+	req *taprpc.AbandonTransferRequest) (*taprpc.AbandonTransferResponse,
+	error) {
+
+	anchorTxHash, err := chainhash.NewHash(req.AnchorTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor tx hash: %w", err)
+	}
+
+	var feeRate chainfee.SatPerKWeight
+	if !req.SkipDoubleSpend {
+		rate, err := checkFeeRateSanity(req.FeeRate)
+		if err != nil {
+			return nil, err
+		}
+		if rate == nil {
+			return nil, fmt.Errorf("fee rate must be specified " +
+				"to double spend the anchor transaction")
+		}
+
+		feeRate = *rate
+	}
+
+	result, err := r.cfg.ChainPorter.AbandonTransfer(
+		*anchorTxHash, feeRate, req.SkipDoubleSpend,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to abandon transfer: %w", err)
+	}
+
+	parcel, err := marshalOutboundParcel(result.Parcel)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	resp := &taprpc.AbandonTransferResponse{
+		Transfer: parcel,
+	}
+	if result.DoubleSpendTx != nil {
+		doubleSpendTxHash := result.DoubleSpendTx.TxHash()
+		resp.DoubleSpendTxHash = doubleSpendTxHash[:]
+	}
+
+	return resp, nil
+}
+
+// ConsolidateAssets merges many asset UTXOs of the same asset into a single
+// output to the node itself, smallest UTXOs first. If a group key is given, the
+// UTXOs of each asset ID of the group are merged into one output per asset ID,
+// all in the same transfer. Any passive assets of the spent anchor outputs are
+// carried over.
+func (r *rpcServer) ConsolidateAssets(ctx context.Context,
+	req *taprpc.ConsolidateAssetsRequest) (
+	*taprpc.ConsolidateAssetsResponse, error) {
+
+	var constraints tapfreighter.CommitmentConstraints
+	switch {
+	case len(req.GetAssetId()) > 0:
+		var assetID asset.ID
+		if len(req.GetAssetId()) != len(assetID) {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+		copy(assetID[:], req.GetAssetId())
+		constraints.AssetID = &assetID
+
+	case len(req.GetAssetIdStr()) > 0:
+		assetIDBytes, err := hex.DecodeString(req.GetAssetIdStr())
+		if err != nil {
+			return nil, fmt.Errorf("error decoding asset ID: %w",
+				err)
+		}
+
+		var assetID asset.ID
+		if len(assetIDBytes) != len(assetID) {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+		copy(assetID[:], assetIDBytes)
+		constraints.AssetID = &assetID
+
+	case len(req.GetGroupKey()) > 0:
+		groupKey, err := parseUserKey(req.GetGroupKey())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing group key: %w",
+				err)
+		}
+		constraints.GroupKey = groupKey
+
+	default:
+		return nil, fmt.Errorf("asset ID or group key must be " +
+			"specified")
+	}
+
+	maxInputs := int(req.MaxInputs)
+	if maxInputs == 0 {
+		maxInputs = defaultMaxConsolidationInputs
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	fundedVPkts, err := r.cfg.AssetWallet.FundConsolidation(
+		ctx, constraints, maxInputs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error funding consolidation: %w", err)
+	}
+
+	// Now we can sign the packets, one for each merged asset ID, and send
+	// them to the chain in a single anchor transaction.
+	var (
+		vPkts            []*tappsbt.VPacket
+		inputCommitments []tappsbt.InputCommitments
+	)
+	for _, fundedVPkt := range fundedVPkts {
+		_, err = r.cfg.AssetWallet.SignVirtualPacket(fundedVPkt.VPacket)
+		if err != nil {
+			return nil, fmt.Errorf("error signing packet: %w", err)
+		}
+
+		vPkts = append(vPkts, fundedVPkt.VPacket)
+		inputCommitments = append(
+			inputCommitments, fundedVPkt.InputCommitments,
+		)
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreSignedBatchParcel(
+			vPkts, inputCommitments, feeRate,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
+	}
+
+	return &taprpc.ConsolidateAssetsResponse{
+		ConsolidationTransfer: parcel,
+	}, nil
+}
+
+// unmarshalCoinSelectStrategy maps the RPC coin selection strategy to its
+// native counterpart. The default value maps to the strategy configured for
+// the daemon.
+func (r *rpcServer) unmarshalCoinSelectStrategy(
+	strategy taprpc.CoinSelectStrategy) (
+	tapfreighter.MultiCommitmentSelectStrategy, error) {
+
+	switch strategy {
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_DEFAULT:
+		return r.cfg.DefaultCoinSelectStrategy, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_MAX_AMOUNT:
+		return tapfreighter.PreferMaxAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_MIN_AMOUNT:
+		return tapfreighter.PreferMinAmount, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_EXACT_MATCH:
+		return tapfreighter.PreferExactMatch, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_OLDEST:
+		return tapfreighter.PreferOldest, nil
+
+	case taprpc.CoinSelectStrategy_COIN_SELECT_STRATEGY_SINGLE_ANCHOR:
+		return tapfreighter.AvoidAnchorMixing, nil
+
+	default:
+		return 0, fmt.Errorf("unknown coin selection strategy: %v",
+			strategy)
+	}
+}
+
+// marshalOutboundParcel turns a pending parcel into its RPC counterpart.
+func marshalOutboundParcel(
+	parcel *tapfreighter.OutboundParcel) (*taprpc.AssetTransfer,
+	error) {
+
+	rpcInputs := make([]*taprpc.TransferInput, len(parcel.Inputs))
+	for idx := range parcel.Inputs {
+		in := parcel.Inputs[idx]
+		rpcInputs[idx] = &taprpc.TransferInput{
+			AnchorPoint: in.OutPoint.String(),
+			AssetId:     in.ID[:],
+			ScriptKey:   in.ScriptKey[:],
+			Amount:      in.Amount,
+		}
+	}
+
+	rpcOutputs := make(
+		[]*taprpc.TransferOutput, len(parcel.Outputs),
+	)
+	for idx := range parcel.Outputs {
+		out := parcel.Outputs[idx]
+
+		internalPubKey := out.Anchor.InternalKey.PubKey
+		internalKeyBytes := internalPubKey.SerializeCompressed()
+		rpcAnchor := &taprpc.TransferOutputAnchor{
+			Outpoint:         out.Anchor.OutPoint.String(),
+			Value:            int64(out.Anchor.Value),
+			InternalKey:      internalKeyBytes,
+			TaprootAssetRoot: out.Anchor.TaprootAssetRoot[:],
+			MerkleRoot:       out.Anchor.MerkleRoot[:],
+			TapscriptSibling: out.Anchor.TapscriptSibling,
+			NumPassiveAssets: out.Anchor.NumPassiveAssets,
+		}
+		scriptPubKey := out.ScriptKey.PubKey
+
+		var splitCommitRoot []byte
+		if out.SplitCommitmentRoot != nil {
+			hash := out.SplitCommitmentRoot.NodeHash()
+			if hash != mssmt.ZeroNodeHash {
+				splitCommitRoot = hash[:]
+			}
+		}
+
+		rpcOutType, err := marshalOutputType(out.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		assetVersion, err := taprpc.MarshalAssetVersion(
+			out.AssetVersion,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcOutputs[idx] = &taprpc.TransferOutput{
+			Anchor:              rpcAnchor,
+			ScriptKey:           scriptPubKey.SerializeCompressed(),
+			ScriptKeyIsLocal:    out.ScriptKeyLocal,
+			Amount:              out.Amount,
+			NewProofBlob:        out.ProofSuffix,
+			SplitCommitRootHash: splitCommitRoot,
+			OutputType:          rpcOutType,
+			AssetVersion:        assetVersion,
+		}
+	}
+
+	replacedTxHashes := make(
+		[][]byte, len(parcel.ReplacedAnchorTxHashes),
+	)
+	for idx := range parcel.ReplacedAnchorTxHashes {
+		replacedTxHashes[idx] = fn.ByteSlice(
+			parcel.ReplacedAnchorTxHashes[idx],
+		)
+	}
+
+	anchorTxHash := parcel.AnchorTx.TxHash()
+	return &taprpc.AssetTransfer{
+		TransferTimestamp:      parcel.TransferTime.Unix(),
+		AnchorTxHash:           anchorTxHash[:],
+		AnchorTxHeightHint:     parcel.AnchorTxHeightHint,
+		AnchorTxChainFees:      parcel.ChainFees,
+		Inputs:                 rpcInputs,
+		Outputs:                rpcOutputs,
+		ReplacedAnchorTxHashes: replacedTxHashes,
+	}, nil
+}
+
+// marshalOutputType turns the transfer output type into the RPC counterpart.
+func marshalOutputType(outputType tappsbt.VOutputType) (taprpc.OutputType,
+	error) {
+
+	switch outputType {
+	case tappsbt.TypeSimple:
+		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE, nil
+
+	case tappsbt.TypeSplitRoot:
+		return taprpc.OutputType_OUTPUT_TYPE_SPLIT_ROOT, nil
+
+	case tappsbt.TypePassiveAssetsOnly:
+		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_ASSETS_ONLY, nil
+
+	case tappsbt.TypePassiveSplitRoot:
+		return taprpc.OutputType_OUTPUT_TYPE_PASSIVE_SPLIT_ROOT, nil
+
+	case tappsbt.TypeSimplePassiveAssets:
+		return taprpc.OutputType_OUTPUT_TYPE_SIMPLE_PASSIVE_ASSETS, nil
+
+	default:
+		return 0, fmt.Errorf("unknown output type: %d", outputType)
+	}
+}
+
+// SubscribeSendAssetEventNtfns registers a subscription to the event
+// notification stream which relates to the asset sending process.
+func (r *rpcServer) SubscribeSendAssetEventNtfns(
+	_ *taprpc.SubscribeSendAssetEventNtfnsRequest,
+	ntfnStream subSendEventNtfnsStream) error {
+
+	// Create a new event subscriber and pass a copy to the chain porter.
+	// We will then read events from the subscriber.
+	eventSubscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	defer eventSubscriber.Stop()
+
+	// Register the subscriber with the ChainPorter.
+	err := r.cfg.ChainPorter.RegisterSubscriber(
+		eventSubscriber, false, false,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register chain porter event"+
+			"notifications subscription: %w", err)
+	}
+
+	// Loop and read from the event subscription and forward to the RPC
+	// stream.
+	for {
+		select {
+		// Handle receiving a new event from the ChainPorter.
+		// The event will be mapped to the RPC event type and
+		// sent over the stream.
+		case event := <-eventSubscriber.NewItemCreated.ChanOut():
+
+			rpcEvent, err := marshallSendAssetEvent(event)
+			if err != nil {
+				return fmt.Errorf("failed to marshall asset "+
+					"send event into RPC event: %w", err)
+			}
+
+			err = ntfnStream.Send(rpcEvent)
+			if err != nil {
+				return fmt.Errorf("failed to RPC stream send "+
+					"event: %w", err)
+			}
+
+		// Handle the case where the RPC stream is closed by the
+		// client.
+		case <-ntfnStream.Context().Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			isCanceledContext := errors.Is(
+				ntfnStream.Context().Err(), context.Canceled,
+			)
+			if isCanceledContext {
+				return nil
+			}
+
+			return ntfnStream.Context().Err()
+
+		// Handle the case where the RPC server is shutting down.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// SubscribeReceiveAssetEventNtfns registers a subscription to the event
+// notification stream which relates to the asset receiving process.
+func (r *rpcServer) SubscribeReceiveAssetEventNtfns(
+	_ *taprpc.SubscribeReceiveAssetEventNtfnsRequest,
+	ntfnStream subReceiveEventNtfnsStream) error {
+
+	// Create a new event subscriber and pass a copy to the component(s)
+	// which are involved in the asset receive process. We will then read
+	// events from the subscriber.
+	eventSubscriber := fn.NewEventReceiver[fn.Event](fn.DefaultQueueSize)
+	defer eventSubscriber.Stop()
+
+	// Register the subscriber with the AssetCustodian.
+	err := r.cfg.AssetCustodian.RegisterSubscriber(
+		eventSubscriber, false, false,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register custodian event"+
+			"notifications subscription: %w", err)
+	}
+
+	// Loop and read from the event subscription and forward to the RPC
+	// stream.
+	for {
+		select {
+		// Handle receiving a new event. The event will be mapped to the
+		// RPC event type and sent over the stream.
 		case event := <-eventSubscriber.NewItemCreated.ChanOut():
 
-			rpcEvent, err := marshallReceiveAssetEvent(event)
-			if err != nil {
-				return fmt.Errorf("failed to marshall "+
-					"asset receive event into RPC event: "+
-					"%w", err)
-			}
+			rpcEvent, err := marshallReceiveAssetEvent(event)
+			if err != nil {
+				return fmt.Errorf("failed to marshall "+
+					"asset receive event into RPC event: "+
+					"%w", err)
+			}
+
+			err = ntfnStream.Send(rpcEvent)
+			if err != nil {
+				return fmt.Errorf("failed to RPC stream "+
+					"asset receive event: %w", err)
+			}
+
+		// Handle the case where the RPC stream is closed by the
+		// client.
+		case <-ntfnStream.Context().Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			isCanceledContext := errors.Is(
+				ntfnStream.Context().Err(), context.Canceled,
+			)
+			if isCanceledContext {
+				return nil
+			}
+
+			return ntfnStream.Context().Err()
+
+		// Handle the case where the RPC server is shutting down.
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallReceiveAssetEvent maps an asset receive event to its RPC counterpart.
+func marshallReceiveAssetEvent(
+	eventInterface fn.Event) (*taprpc.ReceiveAssetEvent, error) {
+
+	switch event := eventInterface.(type) {
+	case *proof.BackoffWaitEvent:
+		// Map the transfer type to the RPC counterpart. We only
+		// support the "receive" transfer type for asset receive events.
+		var transferTypeRpc taprpc.ProofTransferType
+		switch event.TransferType {
+		case proof.ReceiveTransferType:
+			transferTypeRpc = taprpc.ProofTransferType_PROOF_TRANSFER_TYPE_RECEIVE
+		default:
+			return nil, fmt.Errorf("unexpected transfer type: %v",
+				event.TransferType)
+		}
+
+		eventRpc := taprpc.ReceiveAssetEvent_ProofTransferBackoffWaitEvent{
+			ProofTransferBackoffWaitEvent: &taprpc.ProofTransferBackoffWaitEvent{
+				Timestamp:    event.Timestamp().UnixMicro(),
+				Backoff:      event.Backoff.Microseconds(),
+				TriesCounter: event.TriesCounter,
+				TransferType: transferTypeRpc,
+			},
+		}
+		return &taprpc.ReceiveAssetEvent{
+			Event: &eventRpc,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown event type: %T", eventInterface)
+	}
+}
+
+// marshallSendAssetEvent maps an asset send event to its RPC counterpart.
+func marshallSendAssetEvent(
+	eventInterface fn.Event) (*taprpc.SendAssetEvent, error) {
+
+	switch event := eventInterface.(type) {
+	case *tapfreighter.ExecuteSendStateEvent:
+		eventRpc := &taprpc.SendAssetEvent_ExecuteSendStateEvent{
+			ExecuteSendStateEvent: &taprpc.ExecuteSendStateEvent{
+				Timestamp: event.Timestamp().UnixMicro(),
+				SendState: event.SendState.String(),
+			},
+		}
+		return &taprpc.SendAssetEvent{
+			Event: eventRpc,
+		}, nil
+
+	case *proof.BackoffWaitEvent:
+		// Map the transfer type to the RPC counterpart. We only
+		// support the send transfer type for asset send events.
+		var transferTypeRpc taprpc.ProofTransferType
+		switch event.TransferType {
+		case proof.SendTransferType:
+			transferTypeRpc = taprpc.ProofTransferType_PROOF_TRANSFER_TYPE_SEND
+		default:
+			return nil, fmt.Errorf("unexpected transfer type: %v",
+				event.TransferType)
+		}
+
+		eventRpc := taprpc.SendAssetEvent_ProofTransferBackoffWaitEvent{
+			ProofTransferBackoffWaitEvent: &taprpc.ProofTransferBackoffWaitEvent{
+				Timestamp:    event.Timestamp().UnixMicro(),
+				Backoff:      event.Backoff.Microseconds(),
+				TriesCounter: event.TriesCounter,
+				TransferType: transferTypeRpc,
+			},
+		}
+		return &taprpc.SendAssetEvent{
+			Event: &eventRpc,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown event type: %T", eventInterface)
+	}
+}
+
+// marshalMintingBatch marshals a minting batch into the RPC counterpart.
+func marshalMintingBatch(batch *tapgarden.MintingBatch,
+	skipSeedlings bool) (*mintrpc.MintingBatch, error) {
+
+	rpcBatchState, err := marshalBatchState(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcBatch := &mintrpc.MintingBatch{
+		BatchKey:       batch.BatchKey.PubKey.SerializeCompressed(),
+		State:          rpcBatchState,
+		FinalizeHeight: batch.Schedule.FinalizeHeight,
+		BatchName:      batch.Name,
+	}
+	if !batch.Schedule.FinalizeTime.IsZero() {
+		rpcBatch.FinalizeTime = batch.Schedule.FinalizeTime.Unix()
+	}
+
+	// If we have the genesis packet available (funded+signed), then we'll
+	// display the txid as well.
+	if batch.GenesisPacket != nil {
+		batchTx, err := psbt.Extract(batch.GenesisPacket.Pkt)
+		if err == nil {
+			rpcBatch.BatchTxid = batchTx.TxHash().String()
+		} else {
+			rpcsLog.Errorf("unable to extract batch tx: %v", err)
+		}
+
+		var psbtBuf bytes.Buffer
+		err = batch.GenesisPacket.Pkt.Serialize(&psbtBuf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize batch "+
+				"psbt: %w", err)
+		}
+		rpcBatch.BatchPsbt = psbtBuf.Bytes()
+	}
+
+	// The unsigned group witnesses are always included, as the batch can't
+	// proceed without them.
+	for _, unsignedWitness := range batch.UnsignedGroupWitnesses {
+		rpcWitness, err := marshalUnsignedGroupWitness(unsignedWitness)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcBatch.UnsignedGroupWitnesses = append(
+			rpcBatch.UnsignedGroupWitnesses, rpcWitness,
+		)
+	}
+
+	// If we don't need to include the seedlings, we can return here.
+	if skipSeedlings {
+		return rpcBatch, nil
+	}
+
+	// When we have sprouts, then they represent the same assets as the
+	// seedlings but in a more "grown up" state. So in that case we only
+	// marshal the sprouts.
+	switch {
+	// We have sprouts, ignore seedlings.
+	case batch.RootAssetCommitment != nil &&
+		len(batch.RootAssetCommitment.CommittedAssets()) > 0:
+
+		rpcBatch.Assets = marshalSprouts(
+			batch.RootAssetCommitment.CommittedAssets(),
+			batch.AssetMetas,
+		)
+
+	// No sprouts, so we marshal the seedlings.
+	case len(batch.Seedlings) > 0:
+		rpcBatch.Assets, err = marshalSeedlings(batch.Seedlings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rpcBatch, nil
+}
+
+// marshalBatchPreview marshals the preview of a batch into the RPC counterpart.
+func marshalBatchPreview(
+	preview *tapgarden.BatchPreview) (*mintrpc.BatchPreview, error) {
+
+	genesisPkt := preview.GenesisPacket
+	var psbtBuf bytes.Buffer
+	if err := genesisPkt.Pkt.Serialize(&psbtBuf); err != nil {
+		return nil, fmt.Errorf("unable to serialize genesis psbt: %w",
+			err)
+	}
+
+	rpcPreview := &mintrpc.BatchPreview{
+		AnchorOutputIndex: preview.AnchorOutputIndex,
+		ChainFees:         genesisPkt.ChainFees,
+		GenesisPsbt:       psbtBuf.Bytes(),
+	}
+
+	if preview.RootAssetCommitment != nil {
+		rootHash := preview.RootAssetCommitment.TreeRoot.NodeHash()
+		rpcPreview.TapCommitmentRoot = rootHash[:]
+
+		genesisTx := genesisPkt.Pkt.UnsignedTx
+		anchorOutput := genesisTx.TxOut[preview.AnchorOutputIndex]
+		rpcPreview.AnchorOutputScript = anchorOutput.PkScript
+	}
+
+	for _, newAsset := range preview.Assets {
+		assetID := newAsset.ID()
+		scriptKey := newAsset.ScriptKey.PubKey
+		rpcAsset := &mintrpc.PreviewAsset{
+			AssetName: newAsset.Tag,
+			AssetId:   assetID[:],
+			ScriptKey: scriptKey.SerializeCompressed(),
+		}
+
+		groupKey := newAsset.GroupKey
+		if groupKey != nil {
+			tweakedKey := groupKey.GroupPubKey.SerializeCompressed()
+			rpcAsset.TweakedGroupKey = tweakedKey
+		}
+
+		draftProof, ok := preview.DraftProofs[asset.ToSerialized(
+			scriptKey,
+		)]
+		if ok {
+			proofBlob, err := proof.EncodeAsProofFile(draftProof)
+			if err != nil {
+				return nil, fmt.Errorf("unable to encode "+
+					"draft proof: %w", err)
+			}
+
+			rpcAsset.DraftGenesisProof = proofBlob
+		}
+
+		rpcPreview.Assets = append(rpcPreview.Assets, rpcAsset)
+	}
+
+	for _, unsignedWitness := range preview.UnsignedGroupWitnesses {
+		rpcWitness, err := marshalUnsignedGroupWitness(unsignedWitness)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcPreview.UnsignedGroupWitnesses = append(
+			rpcPreview.UnsignedGroupWitnesses, rpcWitness,
+		)
+	}
+
+	return rpcPreview, nil
+}
+
+// marshalUnsignedGroupWitness marshals an unsigned group witness into the RPC
+// counterpart.
+func marshalUnsignedGroupWitness(unsigned *tapgarden.UnsignedGroupWitness) (
+	*mintrpc.UnsignedGroupWitness, error) {
+
+	var txBuf bytes.Buffer
+	if err := unsigned.VirtualTx.Serialize(&txBuf); err != nil {
+		return nil, fmt.Errorf("unable to serialize virtual tx: %w",
+			err)
+	}
+
+	groupKey := unsigned.GroupKey
+	return &mintrpc.UnsignedGroupWitness{
+		AssetName:       unsigned.AssetName,
+		AssetId:         fn.ByteSlice(unsigned.AssetID),
+		RawGroupKey:     groupKey.RawKey.PubKey.SerializeCompressed(),
+		TweakedGroupKey: groupKey.GroupPubKey.SerializeCompressed(),
+		SingleTweak:     unsigned.SingleTweak,
+		TapscriptRoot:   groupKey.TapscriptRoot,
+		VirtualTx:       txBuf.Bytes(),
+		PrevOutValue:    unsigned.PrevOut.Value,
+		PrevOutScript:   unsigned.PrevOut.PkScript,
+	}, nil
+}
+
+// marshalSeedlings marshals the seedlings into the RPC counterpart.
+func marshalSeedlings(
+	seedlings map[string]*tapgarden.Seedling) ([]*mintrpc.MintAsset, error) {
+
+	rpcAssets := make([]*mintrpc.MintAsset, 0, len(seedlings))
+	for _, seedling := range seedlings {
+		var groupKeyBytes []byte
+		if seedling.HasGroupKey() {
+			groupKey := seedling.GroupInfo.GroupKey
+			groupPubKey := groupKey.GroupPubKey
+			groupKeyBytes = groupPubKey.SerializeCompressed()
+		}
+
+		var groupAnchor string
+		if seedling.GroupAnchor != nil {
+			groupAnchor = *seedling.GroupAnchor
+		}
+
+		var externalKeyBytes []byte
+		if seedling.ExternalGroupKey != nil {
+			externalKey := seedling.ExternalGroupKey
+			externalKeyBytes = externalKey.SerializeCompressed()
+		}
+
+		var seedlingMeta *taprpc.AssetMeta
+		if seedling.Meta != nil {
+			seedlingMeta = marshalAssetMeta(seedling.Meta)
+		}
+
+		assetVersion, err := taprpc.MarshalAssetVersion(
+			seedling.AssetVersion,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
+			AssetType:    taprpc.AssetType(seedling.AssetType),
+			AssetVersion: assetVersion,
+			Name:         seedling.AssetName,
+			AssetMeta:    seedlingMeta,
+			Amount:       seedling.Amount,
+			GroupKey:     groupKeyBytes,
+			GroupAnchor:  groupAnchor,
+
+			ExternalGroupKey:   externalKeyBytes,
+			GroupTapscriptRoot: seedling.GroupTapscriptRoot,
+		})
+	}
+
+	return rpcAssets, nil
+}
+
+// marshalSprouts marshals the sprouts into the RPC counterpart.
+func marshalSprouts(sprouts []*asset.Asset,
+	metas tapgarden.AssetMetas) []*mintrpc.MintAsset {
+
+	rpcAssets := make([]*mintrpc.MintAsset, 0, len(sprouts))
+	for _, sprout := range sprouts {
+		scriptKey := asset.ToSerialized(sprout.ScriptKey.PubKey)
+
+		var assetMeta *taprpc.AssetMeta
+		if metas != nil {
+			if m, ok := metas[scriptKey]; ok && m != nil {
+				assetMeta = marshalAssetMeta(m)
+			}
+		}
+
+		var groupKeyBytes []byte
+		if sprout.GroupKey != nil {
+			gpk := sprout.GroupKey.GroupPubKey
+			groupKeyBytes = gpk.SerializeCompressed()
+		}
+
+		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
+			AssetType: taprpc.AssetType(sprout.Type),
+			Name:      sprout.Tag,
+			AssetMeta: assetMeta,
+			Amount:    sprout.Amount,
+			GroupKey:  groupKeyBytes,
+		})
+	}
+
+	return rpcAssets
+}
+
+// marshalBatchState converts the batch state field into its RPC counterpart.
+func marshalBatchState(batch *tapgarden.MintingBatch) (mintrpc.BatchState,
+	error) {
+
+	currentBatchState := batch.State()
+
+	switch currentBatchState {
+	case tapgarden.BatchStatePending:
+		return mintrpc.BatchState_BATCH_STATE_PEDNING, nil
+
+	case tapgarden.BatchStateFrozen:
+		return mintrpc.BatchState_BATCH_STATE_FROZEN, nil
+
+	case tapgarden.BatchStateCommitted:
+		return mintrpc.BatchState_BATCH_STATE_COMMITTED, nil
+
+	case tapgarden.BatchStateBroadcast:
+		return mintrpc.BatchState_BATCH_STATE_BROADCAST, nil
+
+	case tapgarden.BatchStateConfirmed:
+		return mintrpc.BatchState_BATCH_STATE_CONFIRMED, nil
+
+	case tapgarden.BatchStateFinalized:
+		return mintrpc.BatchState_BATCH_STATE_FINALIZED, nil
+
+	case tapgarden.BatchStateSeedlingCancelled:
+		return mintrpc.BatchState_BATCH_STATE_SEEDLING_CANCELLED, nil
+
+	case tapgarden.BatchStateSproutCancelled:
+		return mintrpc.BatchState_BATCH_STATE_SPROUT_CANCELLED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown batch state: %v",
+			currentBatchState.String())
+	}
+}
+
+// UnmarshalScriptKey parses the RPC script key into the native counterpart.
+func UnmarshalScriptKey(rpcKey *taprpc.ScriptKey) (*asset.ScriptKey, error) {
+	var (
+		scriptKey asset.ScriptKey
+		err       error
+	)
+
+	// The script public key is a Taproot key, so 32-byte x-only.
+	scriptKey.PubKey, err = schnorr.ParsePubKey(rpcKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key descriptor is optional for script keys that are completely
+	// independent of the backing wallet.
+	if rpcKey.KeyDesc != nil {
+		keyDesc, err := UnmarshalKeyDescriptor(rpcKey.KeyDesc)
+		if err != nil {
+			return nil, err
+		}
+		scriptKey.TweakedScriptKey = &asset.TweakedScriptKey{
+			RawKey: keyDesc,
+
+			// The tweak is optional, if it's empty it means the key
+			// is derived using BIP-0086.
+			Tweak: rpcKey.TapTweak,
+		}
+	}
+
+	return &scriptKey, nil
+}
+
+// marshalScriptKey marshals the native script key into the RPC counterpart.
+func marshalScriptKey(scriptKey asset.ScriptKey) *taprpc.ScriptKey {
+	rpcScriptKey := &taprpc.ScriptKey{
+		PubKey: schnorr.SerializePubKey(scriptKey.PubKey),
+	}
+
+	if scriptKey.TweakedScriptKey != nil {
+		rpcScriptKey.KeyDesc = marshalKeyDescriptor(
+			scriptKey.TweakedScriptKey.RawKey,
+		)
+		rpcScriptKey.TapTweak = scriptKey.TweakedScriptKey.Tweak
+	}
+
+	return rpcScriptKey
+}
+
+// parseUserKey parses a user-provided script or group key, which can be in
+// either the Schnorr or Compressed format.
+func parseUserKey(scriptKey []byte) (*btcec.PublicKey, error) {
+	switch len(scriptKey) {
+	case schnorr.PubKeyBytesLen:
+		return schnorr.ParsePubKey(scriptKey)
+
+	// Truncate the key and then parse as a Schnorr key.
+	case btcec.PubKeyBytesLenCompressed:
+		return schnorr.ParsePubKey(scriptKey[1:])
+
+	default:
+		return nil, fmt.Errorf("unknown script key length: %v",
+			len(scriptKey))
+	}
+}
+
+// marshalKeyDescriptor marshals the native key descriptor into the RPC
+// counterpart.
+func marshalKeyDescriptor(desc keychain.KeyDescriptor) *taprpc.KeyDescriptor {
+	return &taprpc.KeyDescriptor{
+		RawKeyBytes: desc.PubKey.SerializeCompressed(),
+		KeyLoc: &taprpc.KeyLocator{
+			KeyFamily: int32(desc.KeyLocator.Family),
+			KeyIndex:  int32(desc.KeyLocator.Index),
+		},
+	}
+}
+
+// UnmarshalKeyDescriptor parses the RPC key descriptor into the native
+// counterpart.
+func UnmarshalKeyDescriptor(
+	rpcDesc *taprpc.KeyDescriptor) (keychain.KeyDescriptor, error) {
+
+	var (
+		desc keychain.KeyDescriptor
+		err  error
+	)
+
+	// The public key of a key descriptor is mandatory. It is enough to
+	// locate the corresponding private key in the backing wallet. But to
+	// speed things up (and for additional context), the locator should
+	// still be provided if available.
+	desc.PubKey, err = btcec.ParsePubKey(rpcDesc.RawKeyBytes)
+	if err != nil {
+		return desc, err
+	}
+
+	if rpcDesc.KeyLoc != nil {
+		desc.KeyLocator = keychain.KeyLocator{
+			Family: keychain.KeyFamily(rpcDesc.KeyLoc.KeyFamily),
+			Index:  uint32(rpcDesc.KeyLoc.KeyIndex),
+		}
+	}
+
+	return desc, nil
+}
+
+// FetchAssetMeta allows a caller to fetch the reveal meta data for an asset
+// either by the asset ID for that asset, or a meta hash.
+func (r *rpcServer) FetchAssetMeta(ctx context.Context,
+	req *taprpc.FetchAssetMetaRequest) (*taprpc.AssetMeta, error) {
+
+	var (
+		assetMeta *proof.MetaReveal
+		err       error
+	)
+
+	switch {
+	case req.GetAssetId() != nil:
+		if len(req.GetAssetId()) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], req.GetAssetId())
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
+			ctx, assetID,
+		)
+
+	case req.GetAssetIdStr() != "":
+		if len(req.GetAssetIdStr()) != hex.EncodedLen(sha256.Size) {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetIDBytes []byte
+		assetIDBytes, err = hex.DecodeString(req.GetAssetIdStr())
+		if err != nil {
+			return nil, fmt.Errorf("error hex decoding asset ID: "+
+				"%w", err)
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], assetIDBytes)
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
+			ctx, assetID,
+		)
+
+	case req.GetMetaHash() != nil:
+		if len(req.GetMetaHash()) != sha256.Size {
+			return nil, fmt.Errorf("meta hash must be 32 bytes")
+		}
+
+		var metaHash [asset.MetaHashLen]byte
+		copy(metaHash[:], req.GetMetaHash())
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
+			ctx, metaHash,
+		)
+
+	case req.GetMetaHashStr() != "":
+		if len(req.GetMetaHashStr()) != hex.EncodedLen(sha256.Size) {
+			return nil, fmt.Errorf("meta hash must be 32 bytes")
+		}
+
+		var metaHashBytes []byte
+		metaHashBytes, err = hex.DecodeString(req.GetMetaHashStr())
+		if err != nil {
+			return nil, fmt.Errorf("error hex decoding meta hash: "+
+				"%w", err)
+		}
+
+		var metaHash [asset.MetaHashLen]byte
+		copy(metaHash[:], metaHashBytes)
+
+		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
+			ctx, metaHash,
+		)
+
+	default:
+		return nil, fmt.Errorf("either asset ID or meta hash must " +
+			"be set")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch asset "+
+			"meta: %w", err)
+	}
+
+	return marshalAssetMeta(assetMeta), nil
+}
+
+// marshalAssetMeta marshals the meta reveal of an asset into the RPC
+// counterpart, decoding the meta data if it's structured.
+func marshalAssetMeta(meta *proof.MetaReveal) *taprpc.AssetMeta {
+	metaHash := meta.MetaHash()
+	rpcMeta := &taprpc.AssetMeta{
+		Data:     meta.Data,
+		Type:     taprpc.AssetMetaType(meta.Type),
+		MetaHash: metaHash[:],
+	}
+
+	if meta.Type != proof.MetaJson {
+		return rpcMeta
+	}
+
+	// Meta data that doesn't follow the schema, e.g. because the asset
+	// wasn't minted by tapd, is still returned, just not decoded.
+	jsonMeta, err := meta.JsonMeta()
+	if err != nil {
+		rpcsLog.Debugf("Unable to decode JSON meta with hash %x: %v",
+			metaHash[:], err)
+
+		return rpcMeta
+	}
+
+	rpcMeta.JsonMeta = &taprpc.JsonAssetMeta{
+		Name:           jsonMeta.Name,
+		Description:    jsonMeta.Description,
+		DecimalDisplay: jsonMeta.DecimalDisplay,
+		IconUrl:        jsonMeta.IconURL,
+		IssuerUrl:      jsonMeta.IssuerURL,
+	}
+
+	return rpcMeta
+}
+
+// MarshalUniProofType marshals the universe proof type into the RPC
+// counterpart.
+func MarshalUniProofType(
+	proofType universe.ProofType) (unirpc.ProofType, error) {
+
+	switch proofType {
+	case universe.ProofTypeUnspecified:
+		return unirpc.ProofType_PROOF_TYPE_UNSPECIFIED, nil
+	case universe.ProofTypeIssuance:
+		return unirpc.ProofType_PROOF_TYPE_ISSUANCE, nil
+	case universe.ProofTypeTransfer:
+		return unirpc.ProofType_PROOF_TYPE_TRANSFER, nil
+
+	default:
+		return 0, fmt.Errorf("unknown universe proof type: %v",
+			proofType)
+	}
+}
+
+// MarshalUniID marshals the universe ID into the RPC counterpart.
+func MarshalUniID(id universe.Identifier) (*unirpc.ID, error) {
+	var uniID unirpc.ID
+
+	if id.GroupKey != nil {
+		uniID.Id = &unirpc.ID_GroupKey{
+			GroupKey: schnorr.SerializePubKey(id.GroupKey),
+		}
+	} else {
+		uniID.Id = &unirpc.ID_AssetId{
+			AssetId: id.AssetID[:],
+		}
+	}
+
+	proofTypeRpc, err := MarshalUniProofType(id.ProofType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal proof type: %w", err)
+	}
+	uniID.ProofType = proofTypeRpc
+
+	return &uniID, nil
+}
+
+// marshalMssmtNode marshals a MS-SMT node into the RPC counterpart.
+func marshalMssmtNode(node mssmt.Node) *unirpc.MerkleSumNode {
+	nodeHash := node.NodeHash()
+
+	return &unirpc.MerkleSumNode{
+		RootHash: nodeHash[:],
+		RootSum:  int64(node.NodeSum()),
+	}
+}
+
+// marshallUniverseRoot marshals the universe root into the RPC counterpart.
+func marshalUniverseRoot(node universe.Root) (*unirpc.UniverseRoot, error) {
+	// There was no old base root, so we'll just return a blank root.
+	if node.Node == nil {
+		return &unirpc.UniverseRoot{}, nil
+	}
+	mssmtRoot := marshalMssmtNode(node.Node)
+
+	rpcGroupedAssets := make(map[string]uint64, len(node.GroupedAssets))
+	for assetID, amount := range node.GroupedAssets {
+		rpcGroupedAssets[assetID.String()] = amount
+	}
+
+	uniID, err := MarshalUniID(node.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.UniverseRoot{
+		Id:               uniID,
+		MssmtRoot:        mssmtRoot,
+		AssetName:        node.AssetName,
+		AmountsByAssetId: rpcGroupedAssets,
+	}, nil
+}
+
+// AssetRoots queries for the known Universe roots associated with each known
+// asset. These roots represent the supply/audit state for each known asset.
+func (r *rpcServer) AssetRoots(ctx context.Context,
+	req *unirpc.AssetRootRequest) (*unirpc.AssetRootResponse, error) {
+
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	// First, we'll retrieve the full set of known asset Universe roots.
+	assetRoots, err := r.cfg.UniverseArchive.RootNodes(
+		ctx, universe.RootNodesQuery{
+			WithAmountsById: req.WithAmountsById,
+			SortDirection:   universe.SortDirection(req.Direction),
+			Offset:          req.Offset,
+			Limit:           req.Limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &unirpc.AssetRootResponse{
+		UniverseRoots: make(map[string]*unirpc.UniverseRoot),
+	}
+
+	// Retrieve config for use in filtering asset roots based on sync export
+	// settings.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// For each universe root, marshal it into the RPC form, taking care to
+	// specify the proper universe ID.
+	for _, assetRoot := range assetRoots {
+		idStr := assetRoot.ID.String()
+
+		// Skip this asset if it's not configured for sync export.
+		if !syncConfigs.IsSyncExportEnabled(assetRoot.ID) {
+			continue
+		}
+
+		resp.UniverseRoots[idStr], err = marshalUniverseRoot(assetRoot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// UnmarshalUniProofType parses the RPC universe proof type into the native
+// counterpart.
+func UnmarshalUniProofType(rpcType unirpc.ProofType) (universe.ProofType,
+	error) {
+
+	switch rpcType {
+	case unirpc.ProofType_PROOF_TYPE_UNSPECIFIED:
+		return universe.ProofTypeUnspecified, nil
+
+	case unirpc.ProofType_PROOF_TYPE_ISSUANCE:
+		return universe.ProofTypeIssuance, nil
+
+	case unirpc.ProofType_PROOF_TYPE_TRANSFER:
+		return universe.ProofTypeTransfer, nil
+
+	default:
+		return 0, fmt.Errorf("unknown universe proof type: %v", rpcType)
+	}
+}
+
+// unmarshalAssetSyncConfig parses the RPC asset sync config into the native
+// counterpart.
+func unmarshalAssetSyncConfig(
+	config *unirpc.AssetFederationSyncConfig) (*universe.FedUniSyncConfig,
+	error) {
+
+	if config == nil {
+		return nil, fmt.Errorf("empty universe sync config")
+	}
+
+	// Parse the universe ID from the RPC form.
+	uniID, err := UnmarshalUniID(config.Id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse universe id: %w",
+			err)
+	}
+
+	return &universe.FedUniSyncConfig{
+		UniverseID:      uniID,
+		AllowSyncInsert: config.AllowSyncInsert,
+		AllowSyncExport: config.AllowSyncExport,
+	}, nil
+}
+
+// UnmarshalUniID parses the RPC universe ID into the native counterpart.
+func UnmarshalUniID(rpcID *unirpc.ID) (universe.Identifier, error) {
+	if rpcID == nil {
+		return universe.Identifier{}, fmt.Errorf("missing universe id")
+	}
+
+	// Unmarshal the proof type.
+	proofType, err := UnmarshalUniProofType(rpcID.ProofType)
+	if err != nil {
+		return universe.Identifier{}, fmt.Errorf("unable to unmarshal "+
+			"proof type: %w", err)
+	}
+	switch {
+	case rpcID.GetAssetId() != nil:
+		var assetID asset.ID
+		copy(assetID[:], rpcID.GetAssetId())
+
+		return universe.Identifier{
+			AssetID:   assetID,
+			ProofType: proofType,
+		}, nil
 
-			err = ntfnStream.Send(rpcEvent)
-			if err != nil {
-				return fmt.Errorf("failed to RPC stream "+
-					"asset receive event: %w", err)
-			}
+	case rpcID.GetAssetIdStr() != "":
+		assetIDBytes, err := hex.DecodeString(rpcID.GetAssetIdStr())
+		if err != nil {
+			return universe.Identifier{}, err
+		}
 
-		// Handle the case where the RPC stream is closed by the
-		// client.
-		case <-ntfnStream.Context().Done():
-			// Don't return an error if a normal context
-			// cancellation has occurred.
-			isCanceledContext := errors.Is(
-				ntfnStream.Context().Err(), context.Canceled,
-			)
-			if isCanceledContext {
-				return nil
-			}
+		// TODO(roasbeef): reuse with above
 
-			return ntfnStream.Context().Err()
+		var assetID asset.ID
+		copy(assetID[:], assetIDBytes)
 
-		// Handle the case where the RPC server is shutting down.
-		case <-r.quit:
-			return nil
+		return universe.Identifier{
+			AssetID:   assetID,
+			ProofType: proofType,
+		}, nil
+
+	case rpcID.GetGroupKey() != nil:
+		groupKey, err := parseUserKey(rpcID.GetGroupKey())
+		if err != nil {
+			return universe.Identifier{}, err
 		}
-	}
-}
 
-// marshallReceiveAssetEvent maps an asset receive event to its RPC counterpart.
-func marshallReceiveAssetEvent(
-	eventInterface fn.Event) (*taprpc.ReceiveAssetEvent, error) {
+		return universe.Identifier{
+			GroupKey:  groupKey,
+			ProofType: proofType,
+		}, nil
 
-	switch event := eventInterface.(type) {
-	case *proof.BackoffWaitEvent:
-		// Map the transfer type to the RPC counterpart. We only
-		// support the "receive" transfer type for asset receive events.
-		var transferTypeRpc taprpc.ProofTransferType
-		switch event.TransferType {
-		case proof.ReceiveTransferType:
-			transferTypeRpc = taprpc.ProofTransferType_PROOF_TRANSFER_TYPE_RECEIVE
-		default:
-			return nil, fmt.Errorf("unexpected transfer type: %v",
-				event.TransferType)
+	case rpcID.GetGroupKeyStr() != "":
+		groupKeyBytes, err := hex.DecodeString(rpcID.GetGroupKeyStr())
+		if err != nil {
+			return universe.Identifier{}, err
 		}
 
-		eventRpc := taprpc.ReceiveAssetEvent_ProofTransferBackoffWaitEvent{
-			ProofTransferBackoffWaitEvent: &taprpc.ProofTransferBackoffWaitEvent{
-				Timestamp:    event.Timestamp().UnixMicro(),
-				Backoff:      event.Backoff.Microseconds(),
-				TriesCounter: event.TriesCounter,
-				TransferType: transferTypeRpc,
-			},
+		// TODO(roasbeef): reuse with above
+
+		groupKey, err := parseUserKey(groupKeyBytes)
+		if err != nil {
+			return universe.Identifier{}, err
 		}
-		return &taprpc.ReceiveAssetEvent{
-			Event: &eventRpc,
+
+		return universe.Identifier{
+			GroupKey:  groupKey,
+			ProofType: proofType,
 		}, nil
 
 	default:
-		return nil, fmt.Errorf("unknown event type: %T", eventInterface)
+		return universe.Identifier{}, fmt.Errorf("no id set")
 	}
 }
 
-// marshallSendAssetEvent maps an asset send event to its RPC counterpart.
-func marshallSendAssetEvent(
-	eventInterface fn.Event) (*taprpc.SendAssetEvent, error) {
+// QueryAssetRoots attempts to locate the current Universe root for a specific
+// asset. This asset can be identified by its asset ID or group key.
+func (r *rpcServer) QueryAssetRoots(ctx context.Context,
+	req *unirpc.AssetRootQuery) (*unirpc.QueryRootResponse, error) {
 
-	switch event := eventInterface.(type) {
-	case *tapfreighter.ExecuteSendStateEvent:
-		eventRpc := &taprpc.SendAssetEvent_ExecuteSendStateEvent{
-			ExecuteSendStateEvent: &taprpc.ExecuteSendStateEvent{
-				Timestamp: event.Timestamp().UnixMicro(),
-				SendState: event.SendState.String(),
-			},
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attempt to retrieve the issuance universe root.
+	rpcsLog.Debugf("Querying for asset (group) issuance universe root "+
+		"for %v", spew.Sdump(universeID))
+
+	universeID.ProofType = universe.ProofTypeIssuance
+
+	// Ensure proof export is enabled for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !syncConfigs.IsSyncExportEnabled(universeID) {
+		return nil, fmt.Errorf("proof export is disabled for the " +
+			"given universe")
+	}
+
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	// Query for both a issaunce and transfer universe root.
+	assetRoots, err := r.queryAssetProofRoots(ctx, universeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// If no roots were found and the universe ID had no group key, the
+	// asset may be a grouped asset.
+	mayBeGrouped := assetRoots.IssuanceRoot.Id == nil &&
+		assetRoots.TransferRoot.Id == nil && universeID.GroupKey == nil
+
+	// We already found some universe roots, or the original
+	// request was for an asset group. Return the roots we have.
+	if !mayBeGrouped {
+		return assetRoots, nil
+	}
+
+	// Query for a matching asset group, and fetch the matching universe
+	// roots if we find a group.
+	groupedAssetID := universeID.AssetID
+	rpcsLog.Debugf("No roots found for asset %v, checking if this "+
+		"asset is part of a group", groupedAssetID.String())
+
+	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
+		ctx, groupedAssetID,
+	)
+
+	switch {
+	// No asset info was found; we will return empty universe roots.
+	case errors.Is(err, address.ErrAssetGroupUnknown):
+		return assetRoots, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("asset group lookup failed: %w",
+			err)
+
+	// We found the correct group for this asset; fetch the universe
+	// roots for the group.
+	case assetGroup.GroupKey != nil:
+		foundGroupKey := &assetGroup.GroupPubKey
+		groupUniID := universe.Identifier{
+			GroupKey:  foundGroupKey,
+			ProofType: universe.ProofTypeIssuance,
 		}
-		return &taprpc.SendAssetEvent{
-			Event: eventRpc,
-		}, nil
 
-	case *proof.BackoffWaitEvent:
-		// Map the transfer type to the RPC counterpart. We only
-		// support the send transfer type for asset send events.
-		var transferTypeRpc taprpc.ProofTransferType
-		switch event.TransferType {
-		case proof.SendTransferType:
-			transferTypeRpc = taprpc.ProofTransferType_PROOF_TRANSFER_TYPE_SEND
-		default:
-			return nil, fmt.Errorf("unexpected transfer type: %v",
-				event.TransferType)
+		rpcsLog.Debugf("Found group %x for asset %v",
+			foundGroupKey.SerializeCompressed(),
+			groupedAssetID.String())
+
+		assetRoots, err := r.queryAssetProofRoots(ctx, groupUniID)
+		if err != nil {
+			return nil, err
 		}
 
-		eventRpc := taprpc.SendAssetEvent_ProofTransferBackoffWaitEvent{
-			ProofTransferBackoffWaitEvent: &taprpc.ProofTransferBackoffWaitEvent{
-				Timestamp:    event.Timestamp().UnixMicro(),
-				Backoff:      event.Backoff.Microseconds(),
-				TriesCounter: event.TriesCounter,
-				TransferType: transferTypeRpc,
-			},
+		return assetRoots, nil
+
+	// The asset has no group; we will return empty universe roots.
+	default:
+		return assetRoots, nil
+	}
+}
+
+// queryAssetProofRoots attempts to locate the current Universe root for a
+// specific asset, for both proof types. The asset can be identified by its
+// asset ID or group key.
+func (r *rpcServer) queryAssetProofRoots(ctx context.Context,
+	id universe.Identifier) (*unirpc.QueryRootResponse, error) {
+
+	var issuanceRootRPC, transferRootRPC *unirpc.UniverseRoot
+	uniID := id
+
+	issuanceRoot, issuanceErr := r.cfg.UniverseArchive.RootNode(ctx, uniID)
+	if issuanceErr != nil {
+		// Do not return at this point if the error only indicates that
+		// the root wasn't found. We'll try to find the transfer root
+		// below.
+		if !errors.Is(issuanceErr, universe.ErrNoUniverseRoot) {
+			return nil, issuanceErr
 		}
-		return &taprpc.SendAssetEvent{
-			Event: &eventRpc,
-		}, nil
+	}
+
+	issuanceRootRPC, err := marshalUniverseRoot(issuanceRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attempt to retrieve the transfer universe root.
+	rpcsLog.Debugf("Querying for asset (group) transfer universe root "+
+		"for %v", spew.Sdump(uniID))
+
+	uniID.ProofType = universe.ProofTypeTransfer
+
+	transferRoot, transferErr := r.cfg.UniverseArchive.RootNode(ctx, uniID)
+	if transferErr != nil {
+		// Do not return at this point if the error only indicates that
+		// the root wasn't found. We may have found the issuance root
+		// above.
+		if !errors.Is(transferErr, universe.ErrNoUniverseRoot) {
+			return nil, transferErr
+		}
+	}
 
-	default:
-		return nil, fmt.Errorf("unknown event type: %T", eventInterface)
+	transferRootRPC, err = marshalUniverseRoot(transferRoot)
+	if err != nil {
+		return nil, err
 	}
+
+	return &unirpc.QueryRootResponse{
+		IssuanceRoot: issuanceRootRPC,
+		TransferRoot: transferRootRPC,
+	}, nil
 }
 
-// marshalMintingBatch marshals a minting batch into the RPC counterpart.
-func marshalMintingBatch(batch *tapgarden.MintingBatch,
-	skipSeedlings bool) (*mintrpc.MintingBatch, error) {
+// DeleteAssetRoot attempts to locate the current Universe root for a specific
+// asset, and deletes the associated Universe tree if found.
+func (r *rpcServer) DeleteAssetRoot(ctx context.Context,
+	req *unirpc.DeleteRootQuery) (*unirpc.DeleteRootResponse, error) {
 
-	rpcBatchState, err := marshalBatchState(batch)
+	universeID, err := UnmarshalUniID(req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcBatch := &mintrpc.MintingBatch{
-		BatchKey: batch.BatchKey.PubKey.SerializeCompressed(),
-		State:    rpcBatchState,
-	}
+	rpcsLog.Debugf("Deleting asset root for %v", spew.Sdump(universeID))
 
-	// If we have the genesis packet available (funded+signed), then we'll
-	// display the txid as well.
-	if batch.GenesisPacket != nil {
-		batchTx, err := psbt.Extract(batch.GenesisPacket.Pkt)
-		if err == nil {
-			rpcBatch.BatchTxid = batchTx.TxHash().String()
-		} else {
-			rpcsLog.Errorf("unable to extract batch tx: %v", err)
+	// If the universe proof type is unspecified, we'll delete both the
+	// issuance and transfer roots.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		universeID.ProofType = universe.ProofTypeIssuance
+		_, err := r.cfg.UniverseArchive.DeleteRoot(ctx, universeID)
+		if err != nil {
+			return nil, err
 		}
+
+		universeID.ProofType = universe.ProofTypeTransfer
+		_, err = r.cfg.UniverseArchive.DeleteRoot(ctx, universeID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &unirpc.DeleteRootResponse{}, nil
 	}
 
-	// If we don't need to include the seedlings, we can return here.
-	if skipSeedlings {
-		return rpcBatch, nil
+	// At this point the universe proof type was specified, so we'll only
+	// delete the root for that proof type.
+	_, err = r.cfg.UniverseArchive.DeleteRoot(ctx, universeID)
+	if err != nil {
+		return nil, err
 	}
 
-	// When we have sprouts, then they represent the same assets as the
-	// seedlings but in a more "grown up" state. So in that case we only
-	// marshal the sprouts.
-	switch {
-	// We have sprouts, ignore seedlings.
-	case batch.RootAssetCommitment != nil &&
-		len(batch.RootAssetCommitment.CommittedAssets()) > 0:
+	return &unirpc.DeleteRootResponse{}, nil
+}
 
-		rpcBatch.Assets = marshalSprouts(
-			batch.RootAssetCommitment.CommittedAssets(),
-			batch.AssetMetas,
-		)
+func marshalLeafKey(leafKey universe.LeafKey) *unirpc.AssetKey {
+	return &unirpc.AssetKey{
+		Outpoint: &unirpc.AssetKey_OpStr{
+			OpStr: leafKey.OutPoint.String(),
+		},
+		ScriptKey: &unirpc.AssetKey_ScriptKeyBytes{
+			ScriptKeyBytes: schnorr.SerializePubKey(
+				leafKey.ScriptKey.PubKey,
+			),
+		},
+	}
+}
 
-	// No sprouts, so we marshal the seedlings.
-	case len(batch.Seedlings) > 0:
-		rpcBatch.Assets, err = marshalSeedlings(batch.Seedlings)
-		if err != nil {
-			return nil, err
-		}
+// AssetLeafKeys queries for the set of Universe keys associated with a given
+// asset_id or group_key. Each key takes the form: (outpoint, script_key),
+// where outpoint is an outpoint in the Bitcoin blockchain that anchors a valid
+// Taproot Asset commitment, and script_key is the script_key of the asset
+// within the Taproot Asset commitment for the given asset_id or group_key.
+func (r *rpcServer) AssetLeafKeys(ctx context.Context,
+	req *unirpc.AssetLeafKeysRequest) (*unirpc.AssetLeafKeyResponse, error) {
+
+	if req == nil {
+		return nil, fmt.Errorf("request must be set")
 	}
 
-	return rpcBatch, nil
-}
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
 
-// marshalSeedlings marshals the seedlings into the RPC counterpart.
-func marshalSeedlings(
-	seedlings map[string]*tapgarden.Seedling) ([]*mintrpc.MintAsset, error) {
+	// If the proof type wasn't speciifed, then we'll return an error as we
+	// don't know which keys to actually fetch.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		return nil, fmt.Errorf("proof type must be specified")
+	}
 
-	rpcAssets := make([]*mintrpc.MintAsset, 0, len(seedlings))
-	for _, seedling := range seedlings {
-		var groupKeyBytes []byte
-		if seedling.HasGroupKey() {
-			groupKey := seedling.GroupInfo.GroupKey
-			groupPubKey := groupKey.GroupPubKey
-			groupKeyBytes = groupPubKey.SerializeCompressed()
-		}
+	if req.Limit > universe.MaxPageSize || req.Limit < 0 {
+		return nil, fmt.Errorf("invalid request limit")
+	}
 
-		var groupAnchor string
-		if seedling.GroupAnchor != nil {
-			groupAnchor = *seedling.GroupAnchor
-		}
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 
-		var seedlingMeta *taprpc.AssetMeta
-		if seedling.Meta != nil {
-			seedlingMeta = &taprpc.AssetMeta{
-				MetaHash: fn.ByteSlice(
-					seedling.Meta.MetaHash(),
-				),
-				Data: seedling.Meta.Data,
-				Type: taprpc.AssetMetaType(seedling.Meta.Type),
-			}
-		}
+	leafKeys, err := r.cfg.UniverseArchive.UniverseLeafKeys(
+		ctx, universe.UniverseLeafKeysQuery{
+			Id:            universeID,
+			SortDirection: universe.SortDirection(req.Direction),
+			Offset:        req.Offset,
+			Limit:         req.Limit,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		assetVersion, err := taprpc.MarshalAssetVersion(
-			seedling.AssetVersion,
-		)
-		if err != nil {
-			return nil, err
-		}
+	resp := &unirpc.AssetLeafKeyResponse{
+		AssetKeys: make([]*unirpc.AssetKey, len(leafKeys)),
+	}
 
-		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
-			AssetType:    taprpc.AssetType(seedling.AssetType),
-			AssetVersion: assetVersion,
-			Name:         seedling.AssetName,
-			AssetMeta:    seedlingMeta,
-			Amount:       seedling.Amount,
-			GroupKey:     groupKeyBytes,
-			GroupAnchor:  groupAnchor,
-		})
+	for i, leafKey := range leafKeys {
+		resp.AssetKeys[i] = marshalLeafKey(leafKey)
 	}
 
-	return rpcAssets, nil
+	return resp, nil
 }
 
-// marshalSprouts marshals the sprouts into the RPC counterpart.
-func marshalSprouts(sprouts []*asset.Asset,
-	metas tapgarden.AssetMetas) []*mintrpc.MintAsset {
+// QueryBranch queries for the node at the given path of the Universe MS-SMT
+// tree for a given asset_id or group_key.
+func (r *rpcServer) QueryBranch(ctx context.Context,
+	req *unirpc.BranchQuery) (*unirpc.BranchResponse, error) {
 
-	rpcAssets := make([]*mintrpc.MintAsset, 0, len(sprouts))
-	for _, sprout := range sprouts {
-		scriptKey := asset.ToSerialized(sprout.ScriptKey.PubKey)
+	if req == nil {
+		return nil, fmt.Errorf("request must be set")
+	}
 
-		var assetMeta *taprpc.AssetMeta
-		if metas != nil {
-			if m, ok := metas[scriptKey]; ok && m != nil {
-				assetMeta = &taprpc.AssetMeta{
-					MetaHash: fn.ByteSlice(m.MetaHash()),
-					Data:     m.Data,
-					Type:     taprpc.AssetMetaType(m.Type),
-				}
-			}
-		}
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
 
-		var groupKeyBytes []byte
-		if sprout.GroupKey != nil {
-			gpk := sprout.GroupKey.GroupPubKey
-			groupKeyBytes = gpk.SerializeCompressed()
-		}
+	// If the proof type wasn't specified, then we'll return an error as we
+	// don't know which tree to query.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		return nil, fmt.Errorf("proof type must be specified")
+	}
 
-		rpcAssets = append(rpcAssets, &mintrpc.MintAsset{
-			AssetType: taprpc.AssetType(sprout.Type),
-			Name:      sprout.Tag,
-			AssetMeta: assetMeta,
-			Amount:    sprout.Amount,
-			GroupKey:  groupKeyBytes,
-		})
+	if req.Depth > mssmt.MaxTreeLevels {
+		return nil, fmt.Errorf("depth must be at most %d",
+			mssmt.MaxTreeLevels)
 	}
 
-	return rpcAssets
-}
+	if len(req.Key) != sha256.Size {
+		return nil, fmt.Errorf("key must be %d bytes", sha256.Size)
+	}
 
-// marshalBatchState converts the batch state field into its RPC counterpart.
-func marshalBatchState(batch *tapgarden.MintingBatch) (mintrpc.BatchState,
-	error) {
+	var key [sha256.Size]byte
+	copy(key[:], req.Key)
 
-	currentBatchState := batch.State()
+	path, err := mssmt.NewNodePath(int(req.Depth), key)
+	if err != nil {
+		return nil, err
+	}
 
-	switch currentBatchState {
-	case tapgarden.BatchStatePending:
-		return mintrpc.BatchState_BATCH_STATE_PEDNING, nil
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 
-	case tapgarden.BatchStateFrozen:
-		return mintrpc.BatchState_BATCH_STATE_FROZEN, nil
+	branch, err := r.cfg.UniverseArchive.UniverseBranch(
+		ctx, universeID, path,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	case tapgarden.BatchStateCommitted:
-		return mintrpc.BatchState_BATCH_STATE_COMMITTED, nil
+	resp := &unirpc.BranchResponse{
+		Node:  marshalMssmtNode(branch.Node),
+		Depth: uint32(branch.Path.Depth),
+		Key:   fn.ByteSlice(branch.Path.Key),
+	}
+	if branch.LeafKey != nil {
+		resp.LeafKey = marshalLeafKey(*branch.LeafKey)
+	}
 
-	case tapgarden.BatchStateBroadcast:
-		return mintrpc.BatchState_BATCH_STATE_BROADCAST, nil
+	return resp, nil
+}
 
-	case tapgarden.BatchStateConfirmed:
-		return mintrpc.BatchState_BATCH_STATE_CONFIRMED, nil
+func marshalAssetLeaf(ctx context.Context, keys taprpc.KeyLookup,
+	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
 
-	case tapgarden.BatchStateFinalized:
-		return mintrpc.BatchState_BATCH_STATE_FINALIZED, nil
+	rpcAsset, err := taprpc.MarshalAsset(
+		ctx, assetLeaf.Asset, false, true, keys,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.AssetLeaf{
+		Asset: rpcAsset,
+		Proof: assetLeaf.RawProof,
+	}, nil
+}
+
+// marshalAssetLeaf marshals an asset leaf into the RPC form.
+func (r *rpcServer) marshalAssetLeaf(ctx context.Context,
+	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
 
-	case tapgarden.BatchStateSeedlingCancelled:
-		return mintrpc.BatchState_BATCH_STATE_SEEDLING_CANCELLED, nil
+	return marshalAssetLeaf(ctx, r.cfg.AddrBook, assetLeaf)
+}
 
-	case tapgarden.BatchStateSproutCancelled:
-		return mintrpc.BatchState_BATCH_STATE_SPROUT_CANCELLED, nil
+// AssetLeaves queries for the set of asset leaves (the values in the Universe
+// MS-SMT tree) for a given asset_id or group_key. These represents either
+// asset issuance events (they have a genesis witness) or asset transfers that
+// took place on chain. The leaves contain a normal Taproot asset proof, as well
+// as details for the asset.
+func (r *rpcServer) AssetLeaves(ctx context.Context,
+	req *unirpc.ID) (*unirpc.AssetLeafResponse, error) {
 
-	default:
-		return 0, fmt.Errorf("unknown batch state: %v",
-			currentBatchState.String())
+	universeID, err := UnmarshalUniID(req)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// UnmarshalScriptKey parses the RPC script key into the native counterpart.
-func UnmarshalScriptKey(rpcKey *taprpc.ScriptKey) (*asset.ScriptKey, error) {
-	var (
-		scriptKey asset.ScriptKey
-		err       error
-	)
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 
-	// The script public key is a Taproot key, so 32-byte x-only.
-	scriptKey.PubKey, err = schnorr.ParsePubKey(rpcKey.PubKey)
+	assetLeaves, err := r.cfg.UniverseArchive.MintingLeaves(ctx, universeID)
 	if err != nil {
 		return nil, err
 	}
 
-	// The key descriptor is optional for script keys that are completely
-	// independent of the backing wallet.
-	if rpcKey.KeyDesc != nil {
-		keyDesc, err := UnmarshalKeyDescriptor(rpcKey.KeyDesc)
+	resp := &unirpc.AssetLeafResponse{
+		Leaves: make([]*unirpc.AssetLeaf, len(assetLeaves)),
+	}
+	for i, assetLeaf := range assetLeaves {
+		assetLeaf := assetLeaf
+
+		resp.Leaves[i], err = r.marshalAssetLeaf(ctx, &assetLeaf)
 		if err != nil {
 			return nil, err
 		}
-		scriptKey.TweakedScriptKey = &asset.TweakedScriptKey{
-			RawKey: keyDesc,
-
-			// The tweak is optional, if it's empty it means the key
-			// is derived using BIP-0086.
-			Tweak: rpcKey.TapTweak,
-		}
 	}
 
-	return &scriptKey, nil
+	return resp, nil
 }
 
-// marshalScriptKey marshals the native script key into the RPC counterpart.
-func marshalScriptKey(scriptKey asset.ScriptKey) *taprpc.ScriptKey {
-	rpcScriptKey := &taprpc.ScriptKey{
-		PubKey: schnorr.SerializePubKey(scriptKey.PubKey),
+// UnmarshalOutpoint un-marshals an outpoint from a string received via RPC.
+func UnmarshalOutpoint(outpoint string) (*wire.OutPoint, error) {
+	parts := strings.Split(outpoint, ":")
+	if len(parts) != 2 {
+		return nil, errors.New("outpoint should be of form txid:index")
 	}
 
-	if scriptKey.TweakedScriptKey != nil {
-		rpcScriptKey.KeyDesc = marshalKeyDescriptor(
-			scriptKey.TweakedScriptKey.RawKey,
-		)
-		rpcScriptKey.TapTweak = scriptKey.TweakedScriptKey.Tweak
+	txidStr := parts[0]
+	if hex.DecodedLen(len(txidStr)) != chainhash.HashSize {
+		return nil, fmt.Errorf("invalid hex-encoded txid %v", txidStr)
 	}
 
-	return rpcScriptKey
-}
-
-// parseUserKey parses a user-provided script or group key, which can be in
-// either the Schnorr or Compressed format.
-func parseUserKey(scriptKey []byte) (*btcec.PublicKey, error) {
-	switch len(scriptKey) {
-	case schnorr.PubKeyBytesLen:
-		return schnorr.ParsePubKey(scriptKey)
-
-	// Truncate the key and then parse as a Schnorr key.
-	case btcec.PubKeyBytesLenCompressed:
-		return schnorr.ParsePubKey(scriptKey[1:])
-
-	default:
-		return nil, fmt.Errorf("unknown script key length: %v",
-			len(scriptKey))
+	txid, err := chainhash.NewHashFromStr(txidStr)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// marshalKeyDescriptor marshals the native key descriptor into the RPC
-// counterpart.
-func marshalKeyDescriptor(desc keychain.KeyDescriptor) *taprpc.KeyDescriptor {
-	return &taprpc.KeyDescriptor{
-		RawKeyBytes: desc.PubKey.SerializeCompressed(),
-		KeyLoc: &taprpc.KeyLocator{
-			KeyFamily: int32(desc.KeyLocator.Family),
-			KeyIndex:  int32(desc.KeyLocator.Index),
-		},
+	outputIndex, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid output index: %v", err)
 	}
-}
 
-// UnmarshalKeyDescriptor parses the RPC key descriptor into the native
-// counterpart.
-func UnmarshalKeyDescriptor(
-	rpcDesc *taprpc.KeyDescriptor) (keychain.KeyDescriptor, error) {
+	return &wire.OutPoint{
+		Hash:  *txid,
+		Index: uint32(outputIndex),
+	}, nil
+}
 
+// unmarshalLeafKey un-marshals a leaf key from the RPC form.
+func unmarshalLeafKey(key *unirpc.AssetKey) (universe.LeafKey, error) {
 	var (
-		desc keychain.KeyDescriptor
-		err  error
+		leafKey universe.LeafKey
+		err     error
 	)
 
-	// The public key of a key descriptor is mandatory. It is enough to
-	// locate the corresponding private key in the backing wallet. But to
-	// speed things up (and for additional context), the locator should
-	// still be provided if available.
-	desc.PubKey, err = btcec.ParsePubKey(rpcDesc.RawKeyBytes)
-	if err != nil {
-		return desc, err
-	}
+	switch {
+	case key.GetScriptKeyBytes() != nil:
+		pubKey, err := parseUserKey(key.GetScriptKeyBytes())
+		if err != nil {
+			return leafKey, err
+		}
 
-	if rpcDesc.KeyLoc != nil {
-		desc.KeyLocator = keychain.KeyLocator{
-			Family: keychain.KeyFamily(rpcDesc.KeyLoc.KeyFamily),
-			Index:  uint32(rpcDesc.KeyLoc.KeyIndex),
+		leafKey.ScriptKey = &asset.ScriptKey{
+			PubKey: pubKey,
 		}
-	}
 
-	return desc, nil
-}
+	case key.GetScriptKeyStr() != "":
+		scriptKeyBytes, sErr := hex.DecodeString(key.GetScriptKeyStr())
+		if sErr != nil {
+			return leafKey, err
+		}
 
-// FetchAssetMeta allows a caller to fetch the reveal meta data for an asset
-// either by the asset ID for that asset, or a meta hash.
-func (r *rpcServer) FetchAssetMeta(ctx context.Context,
-	req *taprpc.FetchAssetMetaRequest) (*taprpc.AssetMeta, error) {
+		pubKey, err := parseUserKey(scriptKeyBytes)
+		if err != nil {
+			return leafKey, err
+		}
 
-	var (
-		assetMeta *proof.MetaReveal
-		err       error
-	)
+		leafKey.ScriptKey = &asset.ScriptKey{
+			PubKey: pubKey,
+		}
+	default:
+		// TODO(roasbeef): can actually allow not to be, then would
+		// fetch all for the given outpoint
+		return leafKey, fmt.Errorf("script key must be set")
+	}
 
 	switch {
-	case req.GetAssetId() != nil:
-		if len(req.GetAssetId()) != sha256.Size {
-			return nil, fmt.Errorf("asset ID must be 32 bytes")
+	case key.GetOpStr() != "":
+		// Parse a bitcoin outpoint in the form txid:index into a
+		// wire.OutPoint struct.
+		outpointStr := key.GetOpStr()
+		outpoint, err := UnmarshalOutpoint(outpointStr)
+		if err != nil {
+			return leafKey, err
 		}
 
-		var assetID asset.ID
-		copy(assetID[:], req.GetAssetId())
+		leafKey.OutPoint = *outpoint
 
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
-			ctx, assetID,
-		)
+	case key.GetOutpoint() != nil:
+		op := key.GetOp()
 
-	case req.GetAssetIdStr() != "":
-		if len(req.GetAssetIdStr()) != hex.EncodedLen(sha256.Size) {
-			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		hash, err := chainhash.NewHashFromStr(op.HashStr)
+		if err != nil {
+			return leafKey, err
 		}
 
-		var assetIDBytes []byte
-		assetIDBytes, err = hex.DecodeString(req.GetAssetIdStr())
-		if err != nil {
-			return nil, fmt.Errorf("error hex decoding asset ID: "+
-				"%w", err)
+		leafKey.OutPoint = wire.OutPoint{
+			Hash:  *hash,
+			Index: uint32(op.Index),
 		}
 
-		var assetID asset.ID
-		copy(assetID[:], assetIDBytes)
+	default:
+		return leafKey, fmt.Errorf("outpoint not set: %v", err)
+	}
 
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaForAsset(
-			ctx, assetID,
-		)
+	return leafKey, nil
+}
 
-	case req.GetMetaHash() != nil:
-		if len(req.GetMetaHash()) != sha256.Size {
-			return nil, fmt.Errorf("meta hash must be 32 bytes")
-		}
+// marshalMssmtProof marshals a MS-SMT proof into the RPC form.
+func marshalMssmtProof(proof *mssmt.Proof) ([]byte, error) {
+	compressedProof := proof.Compress()
 
-		var metaHash [asset.MetaHashLen]byte
-		copy(metaHash[:], req.GetMetaHash())
+	var b bytes.Buffer
+	if err := compressedProof.Encode(&b); err != nil {
+		return nil, err
+	}
 
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
-			ctx, metaHash,
-		)
+	return b.Bytes(), nil
+}
 
-	case req.GetMetaHashStr() != "":
-		if len(req.GetMetaHashStr()) != hex.EncodedLen(sha256.Size) {
-			return nil, fmt.Errorf("meta hash must be 32 bytes")
-		}
+// marshalUniverseProofLeaf marshals a universe proof leaf into the RPC form.
+func (r *rpcServer) marshalUniverseProofLeaf(ctx context.Context,
+	req *unirpc.UniverseKey,
+	proof *universe.Proof) (*unirpc.AssetProofResponse, error) {
 
-		var metaHashBytes []byte
-		metaHashBytes, err = hex.DecodeString(req.GetMetaHashStr())
-		if err != nil {
-			return nil, fmt.Errorf("error hex decoding meta hash: "+
-				"%w", err)
-		}
+	uniProof, err := marshalMssmtProof(proof.UniverseInclusionProof)
+	if err != nil {
+		return nil, err
+	}
+
+	assetLeaf, err := r.marshalAssetLeaf(ctx, proof.Leaf)
+	if err != nil {
+		return nil, err
+	}
+
+	uniRoot, err := marshalUniverseRoot(universe.Root{
+		Node: proof.UniverseRoot,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		var metaHash [asset.MetaHashLen]byte
-		copy(metaHash[:], metaHashBytes)
+	uniRoot.AssetName = assetLeaf.Asset.AssetGenesis.Name
+	uniRoot.Id = req.Id
 
-		assetMeta, err = r.cfg.AssetStore.FetchAssetMetaByHash(
-			ctx, metaHash,
-		)
+	// Marshal multiverse specific fields.
+	multiverseRoot := marshalMssmtNode(proof.MultiverseRoot)
 
-	default:
-		return nil, fmt.Errorf("either asset ID or meta hash must " +
-			"be set")
-	}
+	multiverseProof, err := marshalMssmtProof(
+		proof.MultiverseInclusionProof,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to fetch asset "+
-			"meta: %w", err)
+		return nil, err
 	}
 
-	metaHash := assetMeta.MetaHash()
-	return &taprpc.AssetMeta{
-		Data:     assetMeta.Data,
-		Type:     taprpc.AssetMetaType(assetMeta.Type),
-		MetaHash: metaHash[:],
+	return &unirpc.AssetProofResponse{
+		Req:                      req,
+		UniverseRoot:             uniRoot,
+		UniverseInclusionProof:   uniProof,
+		AssetLeaf:                assetLeaf,
+		MultiverseRoot:           multiverseRoot,
+		MultiverseInclusionProof: multiverseProof,
 	}, nil
 }
 
-// MarshalUniProofType marshals the universe proof type into the RPC
-// counterpart.
-func MarshalUniProofType(
-	proofType universe.ProofType) (unirpc.ProofType, error) {
+// QueryProof attempts to query for an issuance or transfer proof for a given
+// asset based on its UniverseKey. A UniverseKey is composed of the Universe ID
+// (asset_id/group_key) and also a leaf key (outpoint || script_key). If found,
+// the target universe proof leaf is returned in addition to inclusion proofs
+// for the Universe and Multiverse MS-SMTs. This allows a caller to verify the
+// known Universe root, Multiverse root, and transition or issuance proof for
+// the target asset.
+func (r *rpcServer) QueryProof(ctx context.Context,
+	req *unirpc.UniverseKey) (*unirpc.AssetProofResponse, error) {
 
-	switch proofType {
-	case universe.ProofTypeUnspecified:
-		return unirpc.ProofType_PROOF_TYPE_UNSPECIFIED, nil
-	case universe.ProofTypeIssuance:
-		return unirpc.ProofType_PROOF_TYPE_ISSUANCE, nil
-	case universe.ProofTypeTransfer:
-		return unirpc.ProofType_PROOF_TYPE_TRANSFER, nil
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	leafKey, err := unmarshalLeafKey(req.LeafKey)
+	if err != nil {
+		return nil, err
+	}
 
-	default:
-		return 0, fmt.Errorf("unknown universe proof type: %v",
-			proofType)
+	rpcsLog.Tracef("[QueryProof]: fetching proof at (universeID=%v, "+
+		"leafKey=%x)", universeID, leafKey.UniverseKey())
+
+	// Retrieve proof export config for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// MarshalUniID marshals the universe ID into the RPC counterpart.
-func MarshalUniID(id universe.Identifier) (*unirpc.ID, error) {
-	var uniID unirpc.ID
+	var candidateIDs []universe.Identifier
 
-	if id.GroupKey != nil {
-		uniID.Id = &unirpc.ID_GroupKey{
-			GroupKey: schnorr.SerializePubKey(id.GroupKey),
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		// If the proof type is unspecified, then we'll attempt to
+		// retrieve both the issuance and transfer proofs. We gather the
+		// corresponding universe IDs into a candidate set.
+		universeID.ProofType = universe.ProofTypeIssuance
+		if syncConfigs.IsSyncExportEnabled(universeID) {
+			candidateIDs = append(candidateIDs, universeID)
+		}
+
+		universeID.ProofType = universe.ProofTypeTransfer
+		if syncConfigs.IsSyncExportEnabled(universeID) {
+			candidateIDs = append(candidateIDs, universeID)
 		}
 	} else {
-		uniID.Id = &unirpc.ID_AssetId{
-			AssetId: id.AssetID[:],
+		// Otherwise, we'll only attempt to retrieve the proof for the
+		// specified proof type. But first we'll check that proof export
+		// is enabled for the given universe.
+		if !syncConfigs.IsSyncExportEnabled(universeID) {
+			return nil, fmt.Errorf("proof export is disabled for " +
+				"the given universe")
 		}
+
+		candidateIDs = append(candidateIDs, universeID)
 	}
 
-	proofTypeRpc, err := MarshalUniProofType(id.ProofType)
-	if err != nil {
-		return nil, fmt.Errorf("unable to marshal proof type: %w", err)
+	// If no candidate IDs were applicable then our config must have
+	// disabled proof export for the given universe.
+	if len(candidateIDs) == 0 {
+		return nil, fmt.Errorf("proof export is disabled for the " +
+			"given universe")
 	}
-	uniID.ProofType = proofTypeRpc
 
-	return &uniID, nil
-}
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
 
-// marshalMssmtNode marshals a MS-SMT node into the RPC counterpart.
-func marshalMssmtNode(node mssmt.Node) *unirpc.MerkleSumNode {
-	nodeHash := node.NodeHash()
+	// Attempt to retrieve the proof given the candidate set of universe
+	// IDs.
+	var proofs []*universe.Proof
+	for i := range candidateIDs {
+		candidateID := candidateIDs[i]
 
-	return &unirpc.MerkleSumNode{
-		RootHash: nodeHash[:],
-		RootSum:  int64(node.NodeSum()),
+		proofs, err = r.cfg.UniverseArchive.FetchProofLeaf(
+			ctx, candidateID, leafKey,
+		)
+		if err != nil {
+			if errors.Is(err, universe.ErrNoUniverseProofFound) {
+				continue
+			}
+
+			rpcsLog.Debugf("[QueryProof]: error querying for "+
+				"proof at (universeID=%v, leafKey=%x)",
+				universeID, leafKey.UniverseKey())
+			return nil, err
+		}
+
+		// At this point we've found a proof, so we'll break out of the
+		// loop. We don't need to attempt to retrieve a proof for any
+		// other candidate IDs.
+		break
+	}
+
+	if len(proofs) == 0 {
+		return nil, universe.ErrNoUniverseProofFound
 	}
+
+	// TODO(roasbeef): query may return multiple proofs, if allow key to
+	// not be fully specified
+	proof := proofs[0]
+
+	rpcsLog.Tracef("[QueryProof]: found proof at (universeID=%v, "+
+		"leafKey=%x)", universeID, leafKey.UniverseKey())
+
+	return r.marshalUniverseProofLeaf(ctx, req, proof)
 }
 
-// marshallUniverseRoot marshals the universe root into the RPC counterpart.
-func marshalUniverseRoot(node universe.Root) (*unirpc.UniverseRoot, error) {
-	// There was no old base root, so we'll just return a blank root.
-	if node.Node == nil {
-		return &unirpc.UniverseRoot{}, nil
+// marshalUniverseCommitment marshals an on-chain commitment of the multiverse
+// root into the RPC form.
+func marshalUniverseCommitment(
+	c *universe.Commitment) (*unirpc.UniverseCommitment, error) {
+
+	var headerBuf bytes.Buffer
+	if err := c.BlockHeader.Serialize(&headerBuf); err != nil {
+		return nil, err
 	}
-	mssmtRoot := marshalMssmtNode(node.Node)
 
-	rpcGroupedAssets := make(map[string]uint64, len(node.GroupedAssets))
-	for assetID, amount := range node.GroupedAssets {
-		rpcGroupedAssets[assetID.String()] = amount
+	var proofBuf bytes.Buffer
+	if err := c.MerkleProof.Encode(&proofBuf); err != nil {
+		return nil, err
 	}
 
-	uniID, err := MarshalUniID(node.ID)
-	if err != nil {
+	var txBuf bytes.Buffer
+	if err := c.AnchorTx.Serialize(&txBuf); err != nil {
 		return nil, err
 	}
 
-	return &unirpc.UniverseRoot{
-		Id:               uniID,
-		MssmtRoot:        mssmtRoot,
-		AssetName:        node.AssetName,
-		AmountsByAssetId: rpcGroupedAssets,
+	return &unirpc.UniverseCommitment{
+		BlockHeight:    c.BlockHeight,
+		BlockHeader:    headerBuf.Bytes(),
+		MerkleProof:    proofBuf.Bytes(),
+		AnchorTx:       txBuf.Bytes(),
+		OutputIndex:    c.OutputIndex,
+		InternalKey:    c.InternalKey.SerializeCompressed(),
+		MultiverseRoot: marshalMssmtNode(c.UniverseRoot),
 	}, nil
 }
 
-// AssetRoots queries for the known Universe roots associated with each known
-// asset. These roots represent the supply/audit state for each known asset.
-func (r *rpcServer) AssetRoots(ctx context.Context,
-	req *unirpc.AssetRootRequest) (*unirpc.AssetRootResponse, error) {
+// QueryCommittedProof queries for the issuance proof of an asset based on its
+// UniverseKey, along with the on-chain commitment of the issuance multiverse
+// root the proof is part of.
+func (r *rpcServer) QueryCommittedProof(ctx context.Context,
+	req *unirpc.CommittedProofQuery) (*unirpc.CommittedProofResponse,
+	error) {
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
-		return nil, err
+	if r.cfg.UniverseCanonical == nil {
+		return nil, fmt.Errorf("universe root commitments are not " +
+			"enabled")
 	}
 
-	// First, we'll retrieve the full set of known asset Universe roots.
-	assetRoots, err := r.cfg.UniverseArchive.RootNodes(
-		ctx, universe.RootNodesQuery{
-			WithAmountsById: req.WithAmountsById,
-			SortDirection:   universe.SortDirection(req.Direction),
-			Offset:          req.Offset,
-			Limit:           req.Limit,
-		},
-	)
+	if req.Key == nil {
+		return nil, fmt.Errorf("universe key must be set")
+	}
+
+	universeID, err := UnmarshalUniID(req.Key.Id)
+	if err != nil {
+		return nil, err
+	}
+	leafKey, err := unmarshalLeafKey(req.Key.LeafKey)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &unirpc.AssetRootResponse{
-		UniverseRoots: make(map[string]*unirpc.UniverseRoot),
+	// Only issuance proofs are committed to in the chain.
+	switch universeID.ProofType {
+	case universe.ProofTypeUnspecified:
+		universeID.ProofType = universe.ProofTypeIssuance
+
+	case universe.ProofTypeTransfer:
+		return nil, fmt.Errorf("only issuance proofs are committed " +
+			"to in the chain")
 	}
 
-	// Retrieve config for use in filtering asset roots based on sync export
-	// settings.
 	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
 	if err != nil {
 		return nil, err
 	}
-
-	// For each universe root, marshal it into the RPC form, taking care to
-	// specify the proper universe ID.
-	for _, assetRoot := range assetRoots {
-		idStr := assetRoot.ID.String()
-
-		// Skip this asset if it's not configured for sync export.
-		if !syncConfigs.IsSyncExportEnabled(assetRoot.ID) {
-			continue
-		}
-
-		resp.UniverseRoots[idStr], err = marshalUniverseRoot(assetRoot)
-		if err != nil {
-			return nil, err
-		}
+	if !syncConfigs.IsSyncExportEnabled(universeID) {
+		return nil, fmt.Errorf("proof export is disabled for the " +
+			"given universe")
 	}
 
-	return resp, nil
-}
-
-// UnmarshalUniProofType parses the RPC universe proof type into the native
-// counterpart.
-func UnmarshalUniProofType(rpcType unirpc.ProofType) (universe.ProofType,
-	error) {
-
-	switch rpcType {
-	case unirpc.ProofType_PROOF_TYPE_UNSPECIFIED:
-		return universe.ProofTypeUnspecified, nil
-
-	case unirpc.ProofType_PROOF_TYPE_ISSUANCE:
-		return universe.ProofTypeIssuance, nil
-
-	case unirpc.ProofType_PROOF_TYPE_TRANSFER:
-		return universe.ProofTypeTransfer, nil
-
-	default:
-		return 0, fmt.Errorf("unknown universe proof type: %v", rpcType)
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
 	}
-}
 
-// unmarshalAssetSyncConfig parses the RPC asset sync config into the native
-// counterpart.
-func unmarshalAssetSyncConfig(
-	config *unirpc.AssetFederationSyncConfig) (*universe.FedUniSyncConfig,
-	error) {
+	committedProof, err := r.cfg.UniverseCanonical.Query(
+		ctx, universeID, leafKey, req.BlockHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-	if config == nil {
-		return nil, fmt.Errorf("empty universe sync config")
+	rpcProof, err := r.marshalUniverseProofLeaf(
+		ctx, req.Key, committedProof.TaprootAssetProof,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	// Parse the universe ID from the RPC form.
-	uniID, err := UnmarshalUniID(config.Id)
+	rpcCommitment, err := marshalUniverseCommitment(
+		committedProof.ChainProof,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse universe id: %w",
-			err)
+		return nil, err
 	}
 
-	return &universe.FedUniSyncConfig{
-		UniverseID:      uniID,
-		AllowSyncInsert: config.AllowSyncInsert,
-		AllowSyncExport: config.AllowSyncExport,
+	return &unirpc.CommittedProofResponse{
+		Proof:      rpcProof,
+		Commitment: rpcCommitment,
 	}, nil
 }
 
-// UnmarshalUniID parses the RPC universe ID into the native counterpart.
-func UnmarshalUniID(rpcID *unirpc.ID) (universe.Identifier, error) {
-	if rpcID == nil {
-		return universe.Identifier{}, fmt.Errorf("missing universe id")
-	}
-
-	// Unmarshal the proof type.
-	proofType, err := UnmarshalUniProofType(rpcID.ProofType)
-	if err != nil {
-		return universe.Identifier{}, fmt.Errorf("unable to unmarshal "+
-			"proof type: %w", err)
+// unmarshalAssetLeaf unmarshals an asset leaf from the RPC form.
+func unmarshalAssetLeaf(leaf *unirpc.AssetLeaf) (*universe.Leaf, error) {
+	// We'll just pull the asset details from the serialized issuance proof
+	// itself.
+	var assetProof proof.Proof
+	if err := assetProof.Decode(
+		bytes.NewReader(leaf.Proof),
+	); err != nil {
+		return nil, err
 	}
-	switch {
-	case rpcID.GetAssetId() != nil:
-		var assetID asset.ID
-		copy(assetID[:], rpcID.GetAssetId())
 
-		return universe.Identifier{
-			AssetID:   assetID,
-			ProofType: proofType,
-		}, nil
+	// TODO(roasbeef): double check posted file format everywhere
+	//  * raw proof, or within file?
 
-	case rpcID.GetAssetIdStr() != "":
-		assetIDBytes, err := hex.DecodeString(rpcID.GetAssetIdStr())
-		if err != nil {
-			return universe.Identifier{}, err
-		}
+	return &universe.Leaf{
+		GenesisWithGroup: universe.GenesisWithGroup{
+			Genesis:  assetProof.Asset.Genesis,
+			GroupKey: assetProof.Asset.GroupKey,
+		},
+		RawProof: leaf.Proof,
+		Asset:    &assetProof.Asset,
+		Amt:      assetProof.Asset.Amount,
+	}, nil
+}
 
-		// TODO(roasbeef): reuse with above
+// unmarshalProofInsert parses a proof that is to be inserted into the local
+// Universe, and makes sure remote parties are allowed to insert it.
+func (r *rpcServer) unmarshalProofInsert(ctx context.Context,
+	req *unirpc.AssetProof) (universe.Identifier, universe.LeafKey,
+	*universe.Leaf, error) {
 
-		var assetID asset.ID
-		copy(assetID[:], assetIDBytes)
+	var (
+		universeID universe.Identifier
+		leafKey    universe.LeafKey
+	)
 
-		return universe.Identifier{
-			AssetID:   assetID,
-			ProofType: proofType,
-		}, nil
+	if req == nil || req.Key == nil {
+		return universeID, leafKey, nil, fmt.Errorf("key cannot be " +
+			"nil")
+	}
 
-	case rpcID.GetGroupKey() != nil:
-		groupKey, err := parseUserKey(rpcID.GetGroupKey())
-		if err != nil {
-			return universe.Identifier{}, err
-		}
+	universeID, err := UnmarshalUniID(req.Key.Id)
+	if err != nil {
+		return universeID, leafKey, nil, err
+	}
+	leafKey, err = unmarshalLeafKey(req.Key.LeafKey)
+	if err != nil {
+		return universeID, leafKey, nil, err
+	}
 
-		return universe.Identifier{
-			GroupKey:  groupKey,
-			ProofType: proofType,
-		}, nil
+	assetLeaf, err := unmarshalAssetLeaf(req.AssetLeaf)
+	if err != nil {
+		return universeID, leafKey, nil, err
+	}
 
-	case rpcID.GetGroupKeyStr() != "":
-		groupKeyBytes, err := hex.DecodeString(rpcID.GetGroupKeyStr())
+	// If universe proof type unspecified, set based on the provided asset
+	// proof.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		universeID.ProofType, err = universe.NewProofTypeFromAsset(
+			assetLeaf.Asset,
+		)
 		if err != nil {
-			return universe.Identifier{}, err
+			return universeID, leafKey, nil, err
 		}
+	}
 
-		// TODO(roasbeef): reuse with above
+	// Ensure that the new proof is of the correct type for the target
+	// universe.
+	err = universe.ValidateProofUniverseType(assetLeaf.Asset, universeID)
+	if err != nil {
+		return universeID, leafKey, nil, err
+	}
 
-		groupKey, err := parseUserKey(groupKeyBytes)
-		if err != nil {
-			return universe.Identifier{}, err
-		}
+	// Ensure proof insert is enabled for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return universeID, leafKey, nil, err
+	}
 
-		return universe.Identifier{
-			GroupKey:  groupKey,
-			ProofType: proofType,
-		}, nil
+	if !syncConfigs.IsSyncInsertEnabled(universeID) {
+		return universeID, leafKey, nil, fmt.Errorf("proof insert is " +
+			"disabled for the given universe")
+	}
 
-	default:
-		return universe.Identifier{}, fmt.Errorf("no id set")
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return universeID, leafKey, nil, err
 	}
+
+	return universeID, leafKey, assetLeaf, nil
 }
 
-// QueryAssetRoots attempts to locate the current Universe root for a specific
-// asset. This asset can be identified by its asset ID or group key.
-func (r *rpcServer) QueryAssetRoots(ctx context.Context,
-	req *unirpc.AssetRootQuery) (*unirpc.QueryRootResponse, error) {
+// InsertProof attempts to insert a new issuance or transfer proof into the
+// Universe tree specified by the UniverseKey. If valid, then the proof is
+// inserted into the database, with a new Universe root returned for the updated
+// asset_id/group_key.
+func (r *rpcServer) InsertProof(ctx context.Context,
+	req *unirpc.AssetProof) (*unirpc.AssetProofResponse, error) {
 
-	universeID, err := UnmarshalUniID(req.Id)
+	universeID, leafKey, assetLeaf, err := r.unmarshalProofInsert(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
-	// Attempt to retrieve the issuance universe root.
-	rpcsLog.Debugf("Querying for asset (group) issuance universe root "+
-		"for %v", spew.Sdump(universeID))
-
-	universeID.ProofType = universe.ProofTypeIssuance
+	rpcsLog.Debugf("[InsertProof]: inserting proof at "+
+		"(universeID=%v, leafKey=%x)", universeID,
+		leafKey.UniverseKey())
 
-	// Ensure proof export is enabled for the given universe.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	newUniverseState, err := r.cfg.UniverseArchive.UpsertProofLeaf(
+		ctx, universeID, leafKey, assetLeaf,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if !syncConfigs.IsSyncExportEnabled(universeID) {
-		return nil, fmt.Errorf("proof export is disabled for the " +
-			"given universe")
-	}
+	universeRootHash := newUniverseState.UniverseRoot.NodeHash()
+	rpcsLog.Debugf("[InsertProof]: proof inserted, new universe root: %x",
+		universeRootHash[:])
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
+	return r.marshalUniverseProofLeaf(ctx, req.Key, newUniverseState)
+}
+
+// AnnounceProof announces a new issuance or transfer proof to the local
+// Universe server. If the proof isn't known yet, it's inserted into the target
+// Universe tree and relayed to the federation, if the announcement has any
+// hops left.
+func (r *rpcServer) AnnounceProof(ctx context.Context,
+	req *unirpc.ProofAnnouncement) (*unirpc.ProofAnnouncementResponse,
+	error) {
+
+	universeID, leafKey, assetLeaf, err := r.unmarshalProofInsert(
+		ctx, req.Proof,
+	)
+	if err != nil {
 		return nil, err
 	}
 
-	// Query for both a issaunce and transfer universe root.
-	assetRoots, err := r.queryAssetProofRoots(ctx, universeID)
+	rpcsLog.Debugf("[AnnounceProof]: handling proof announcement for "+
+		"(universeID=%v, leafKey=%x, hopsLeft=%v)", universeID,
+		leafKey.UniverseKey(), req.HopsLeft)
+
+	known, err := r.cfg.UniverseGossiper.HandleAnnouncement(
+		ctx, &universe.ProofAnnouncement{
+			ID:       universeID,
+			Key:      leafKey,
+			Leaf:     assetLeaf,
+			HopsLeft: req.HopsLeft,
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	// If no roots were found and the universe ID had no group key, the
-	// asset may be a grouped asset.
-	mayBeGrouped := assetRoots.IssuanceRoot.Id == nil &&
-		assetRoots.TransferRoot.Id == nil && universeID.GroupKey == nil
+	return &unirpc.ProofAnnouncementResponse{
+		AlreadyKnown: known,
+	}, nil
+}
 
-	// We already found some universe roots, or the original
-	// request was for an asset group. Return the roots we have.
-	if !mayBeGrouped {
-		return assetRoots, nil
-	}
+// Info returns a set of information about the current state of the Universe.
+func (r *rpcServer) Info(ctx context.Context,
+	_ *unirpc.InfoRequest) (*unirpc.InfoResponse, error) {
 
-	// Query for a matching asset group, and fetch the matching universe
-	// roots if we find a group.
-	groupedAssetID := universeID.AssetID
-	rpcsLog.Debugf("No roots found for asset %v, checking if this "+
-		"asset is part of a group", groupedAssetID.String())
+	return &unirpc.InfoResponse{
+		RuntimeId: r.cfg.RuntimeID,
+	}, nil
+}
 
-	assetGroup, err := r.cfg.TapAddrBook.QueryAssetGroup(
-		ctx, groupedAssetID,
-	)
+// unmarshalUniverseSyncType maps an RPC universe sync type into a concrete
+// type.
+func unmarshalUniverseSyncType(req unirpc.UniverseSyncMode) (
+	universe.SyncType, error) {
 
-	switch {
-	// No asset info was found; we will return empty universe roots.
-	case errors.Is(err, address.ErrAssetGroupUnknown):
-		return assetRoots, nil
+	switch req {
+	case unirpc.UniverseSyncMode_SYNC_FULL:
+		return universe.SyncFull, nil
 
-	case err != nil:
-		return nil, fmt.Errorf("asset group lookup failed: %w",
-			err)
+	case unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY:
+		return universe.SyncIssuance, nil
 
-	// We found the correct group for this asset; fetch the universe
-	// roots for the group.
-	case assetGroup.GroupKey != nil:
-		foundGroupKey := &assetGroup.GroupPubKey
-		groupUniID := universe.Identifier{
-			GroupKey:  foundGroupKey,
-			ProofType: universe.ProofTypeIssuance,
+	default:
+		return 0, fmt.Errorf("unknown sync type: %v", req)
+	}
+}
+
+// unmarshalSyncTargets maps an RPC sync target into a concrete type.
+func unmarshalSyncTargets(targets []*unirpc.SyncTarget) ([]universe.Identifier, error) {
+	uniIDs := make([]universe.Identifier, 0, len(targets))
+	for _, target := range targets {
+		uniID, err := UnmarshalUniID(target.Id)
+		if err != nil {
+			return nil, err
 		}
+		uniIDs = append(uniIDs, uniID)
+	}
 
-		rpcsLog.Debugf("Found group %x for asset %v",
-			foundGroupKey.SerializeCompressed(),
-			groupedAssetID.String())
+	return uniIDs, nil
+}
 
-		assetRoots, err := r.queryAssetProofRoots(ctx, groupUniID)
+// marshalUniverseDiff marshals a universe diff into the RPC form.
+func (r *rpcServer) marshalUniverseDiff(ctx context.Context,
+	uniDiff []universe.AssetSyncDiff) (*unirpc.SyncResponse, error) {
+
+	resp := &unirpc.SyncResponse{
+		SyncedUniverses: make([]*unirpc.SyncedUniverse, 0, len(uniDiff)),
+	}
+
+	err := fn.ForEachErr(uniDiff, func(diff universe.AssetSyncDiff) error {
+		oldUniRoot, err := marshalUniverseRoot(diff.OldUniverseRoot)
+		if err != nil {
+			return fmt.Errorf("unable to marshal old uni "+
+				"root: %w", err)
+		}
+		newUniRoot, err := marshalUniverseRoot(diff.NewUniverseRoot)
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("unable to marshal new unit "+
+				"root: %w", err)
 		}
 
-		return assetRoots, nil
+		leaves := make([]*unirpc.AssetLeaf, len(diff.NewLeafProofs))
+		for i, leaf := range diff.NewLeafProofs {
+			leaves[i], err = r.marshalAssetLeaf(ctx, leaf)
+			if err != nil {
+				return err
+			}
+		}
 
-	// The asset has no group; we will return empty universe roots.
-	default:
-		return assetRoots, nil
+		resp.SyncedUniverses = append(
+			resp.SyncedUniverses, &unirpc.SyncedUniverse{
+				OldAssetRoot:   oldUniRoot,
+				NewAssetRoot:   newUniRoot,
+				NewAssetLeaves: leaves,
+			},
+		)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+
+	return resp, nil
 }
 
-// queryAssetProofRoots attempts to locate the current Universe root for a
-// specific asset, for both proof types. The asset can be identified by its
-// asset ID or group key.
-func (r *rpcServer) queryAssetProofRoots(ctx context.Context,
-	id universe.Identifier) (*unirpc.QueryRootResponse, error) {
+// SyncUniverse takes host information for a remote Universe server, then
+// attempts to synchronize either only the set of specified asset_ids, or all
+// assets if none are specified. The sync process will attempt to query for the
+// latest known root for each asset, performing tree based reconciliation to
+// arrive at a new shared root.
+func (r *rpcServer) SyncUniverse(ctx context.Context,
+	req *unirpc.SyncRequest) (*unirpc.SyncResponse, error) {
 
-	var issuanceRootRPC, transferRootRPC *unirpc.UniverseRoot
-	uniID := id
+	// TODO(roasbeef): have another layer, only allow single outstanding
+	// sync request per host?
 
-	issuanceRoot, issuanceErr := r.cfg.UniverseArchive.RootNode(ctx, uniID)
-	if issuanceErr != nil {
-		// Do not return at this point if the error only indicates that
-		// the root wasn't found. We'll try to find the transfer root
-		// below.
-		if !errors.Is(issuanceErr, universe.ErrNoUniverseRoot) {
-			return nil, issuanceErr
-		}
+	syncMode, err := unmarshalUniverseSyncType(req.SyncMode)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sync type: %w", err)
 	}
-
-	issuanceRootRPC, err := marshalUniverseRoot(issuanceRoot)
+	syncTargets, err := unmarshalSyncTargets(req.SyncTargets)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to parse sync targets: %w", err)
 	}
 
-	// Attempt to retrieve the transfer universe root.
-	rpcsLog.Debugf("Querying for asset (group) transfer universe root "+
-		"for %v", spew.Sdump(uniID))
+	uniAddr := universe.NewServerAddrFromStr(req.UniverseHost)
 
-	uniID.ProofType = universe.ProofTypeTransfer
+	// Obtain the general and universe specific federation sync configs.
+	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
+	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query federation sync "+
+			"config(s): %w", err)
+	}
 
-	transferRoot, transferErr := r.cfg.UniverseArchive.RootNode(ctx, uniID)
-	if transferErr != nil {
-		// Do not return at this point if the error only indicates that
-		// the root wasn't found. We may have found the issuance root
-		// above.
-		if !errors.Is(transferErr, universe.ErrNoUniverseRoot) {
-			return nil, transferErr
-		}
+	syncConfigs := universe.SyncConfigs{
+		GlobalSyncConfigs: globalConfigs,
+		UniSyncConfigs:    uniSyncConfigs,
 	}
 
-	transferRootRPC, err = marshalUniverseRoot(transferRoot)
+	// TODO(roasbeef): add layer of indirection in front of?
+	//  * just interface interaction
+	// TODO(ffranr): Sync via the FederationEnvoy rather than syncer.
+	universeDiff, err := r.cfg.UniverseSyncer.SyncUniverse(
+		ctx, uniAddr, syncMode, syncConfigs, syncTargets...,
+	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to sync universe: %w", err)
 	}
 
-	return &unirpc.QueryRootResponse{
-		IssuanceRoot: issuanceRootRPC,
-		TransferRoot: transferRootRPC,
-	}, nil
+	return r.marshalUniverseDiff(ctx, universeDiff)
 }
 
-// DeleteAssetRoot attempts to locate the current Universe root for a specific
-// asset, and deletes the associated Universe tree if found.
-func (r *rpcServer) DeleteAssetRoot(ctx context.Context,
-	req *unirpc.DeleteRootQuery) (*unirpc.DeleteRootResponse, error) {
+func marshalUniverseServer(server universe.ServerAddr,
+) *unirpc.UniverseFederationServer {
 
-	universeID, err := UnmarshalUniID(req.Id)
+	return &unirpc.UniverseFederationServer{
+		Host: server.HostStr(),
+		Id:   int32(server.ID),
+	}
+}
+
+// ListFederationServers lists the set of servers that make up the federation
+// of the local Universe server. This servers are used to push out new proofs,
+// and also periodically call sync new proofs from the remote server.
+func (r *rpcServer) ListFederationServers(ctx context.Context,
+	_ *unirpc.ListFederationServersRequest,
+) (*unirpc.ListFederationServersResponse, error) {
+
+	uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcsLog.Debugf("Deleting asset root for %v", spew.Sdump(universeID))
+	return &unirpc.ListFederationServersResponse{
+		Servers: fn.Map(uniServers, marshalUniverseServer),
+	}, nil
+}
 
-	// If the universe proof type is unspecified, we'll delete both the
-	// issuance and transfer roots.
-	if universeID.ProofType == universe.ProofTypeUnspecified {
-		universeID.ProofType = universe.ProofTypeIssuance
-		_, err := r.cfg.UniverseArchive.DeleteRoot(ctx, universeID)
-		if err != nil {
-			return nil, err
-		}
+func unmarshalUniverseServer(
+	server *unirpc.UniverseFederationServer) universe.ServerAddr {
 
-		universeID.ProofType = universe.ProofTypeTransfer
-		_, err = r.cfg.UniverseArchive.DeleteRoot(ctx, universeID)
+	return universe.NewServerAddr(int64(server.Id), server.Host)
+}
+
+// AddFederationServer adds a new server to the federation of the local
+// Universe server. Once a server is added, this call can also optionally be
+// used to trigger a sync of the remote server.
+func (r *rpcServer) AddFederationServer(ctx context.Context,
+	req *unirpc.AddFederationServerRequest,
+) (*unirpc.AddFederationServerResponse, error) {
+
+	serversToAdd := fn.Map(req.Servers, unmarshalUniverseServer)
+
+	for idx := range serversToAdd {
+		server := serversToAdd[idx]
+
+		// Before we add the server as a federation member, we check
+		// that we can actually connect to it and that it isn't
+		// ourselves.
+		err := CheckFederationServer(
+			r.cfg.RuntimeID, universe.DefaultTimeout, server,
+		)
 		if err != nil {
 			return nil, err
 		}
-
-		return &unirpc.DeleteRootResponse{}, nil
 	}
 
-	// At this point the universe proof type was specified, so we'll only
-	// delete the root for that proof type.
-	_, err = r.cfg.UniverseArchive.DeleteRoot(ctx, universeID)
+	err := r.cfg.UniverseFederation.AddServer(serversToAdd...)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.DeleteRootResponse{}, nil
-}
+	if !req.DiscoverPeers {
+		return &unirpc.AddFederationServerResponse{}, nil
+	}
 
-func marshalLeafKey(leafKey universe.LeafKey) *unirpc.AssetKey {
-	return &unirpc.AssetKey{
-		Outpoint: &unirpc.AssetKey_OpStr{
-			OpStr: leafKey.OutPoint.String(),
-		},
-		ScriptKey: &unirpc.AssetKey_ScriptKeyBytes{
-			ScriptKeyBytes: schnorr.SerializePubKey(
-				leafKey.ScriptKey.PubKey,
-			),
-		},
+	// We'll also add the servers we can discover through the federation
+	// of the servers we just added.
+	discovered, err := r.cfg.UniverseFederation.DiscoverServers(
+		serversToAdd...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover federation "+
+			"servers: %w", err)
 	}
+
+	return &unirpc.AddFederationServerResponse{
+		DiscoveredServers: fn.Map(discovered, marshalUniverseServer),
+	}, nil
 }
 
-// AssetLeafKeys queries for the set of Universe keys associated with a given
-// asset_id or group_key. Each key takes the form: (outpoint, script_key),
-// where outpoint is an outpoint in the Bitcoin blockchain that anchors a valid
-// Taproot Asset commitment, and script_key is the script_key of the asset
-// within the Taproot Asset commitment for the given asset_id or group_key.
-func (r *rpcServer) AssetLeafKeys(ctx context.Context,
-	req *unirpc.AssetLeafKeysRequest) (*unirpc.AssetLeafKeyResponse, error) {
+// DeleteFederationServer removes a server from the federation of the local
+// Universe server.
+func (r *rpcServer) DeleteFederationServer(ctx context.Context,
+	req *unirpc.DeleteFederationServerRequest,
+) (*unirpc.DeleteFederationServerResponse, error) {
 
-	if req == nil {
-		return nil, fmt.Errorf("request must be set")
-	}
+	serversToDel := fn.Map(req.Servers, unmarshalUniverseServer)
 
-	universeID, err := UnmarshalUniID(req.Id)
+	err := r.cfg.FederationDB.RemoveServers(ctx, serversToDel...)
 	if err != nil {
 		return nil, err
 	}
 
-	// If the proof type wasn't speciifed, then we'll return an error as we
-	// don't know which keys to actually fetch.
-	if universeID.ProofType == universe.ProofTypeUnspecified {
-		return nil, fmt.Errorf("proof type must be specified")
-	}
-
-	if req.Limit > universe.MaxPageSize || req.Limit < 0 {
-		return nil, fmt.Errorf("invalid request limit")
-	}
+	return &unirpc.DeleteFederationServerResponse{}, nil
+}
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
-		return nil, err
-	}
+// SetFederationSyncConfig sets the configuration of the universe federation
+// sync.
+func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
+	req *unirpc.SetFederationSyncConfigRequest) (
+	*unirpc.SetFederationSyncConfigResponse, error) {
 
-	leafKeys, err := r.cfg.UniverseArchive.UniverseLeafKeys(
-		ctx, universe.UniverseLeafKeysQuery{
-			Id:            universeID,
-			SortDirection: universe.SortDirection(req.Direction),
-			Offset:        req.Offset,
-			Limit:         req.Limit,
-		},
+	// Unmarshal global sync configs.
+	globalSyncConfig := make(
+		[]*universe.FedGlobalSyncConfig, len(req.GlobalSyncConfigs),
 	)
-	if err != nil {
-		return nil, err
-	}
+	for i := range req.GlobalSyncConfigs {
+		config := req.GlobalSyncConfigs[i]
 
-	resp := &unirpc.AssetLeafKeyResponse{
-		AssetKeys: make([]*unirpc.AssetKey, len(leafKeys)),
+		proofType, err := UnmarshalUniProofType(config.ProofType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unmarshal "+
+				"proof type: %w", err)
+		}
+
+		globalSyncConfig[i] = &universe.FedGlobalSyncConfig{
+			ProofType:       proofType,
+			AllowSyncInsert: config.AllowSyncInsert,
+			AllowSyncExport: config.AllowSyncExport,
+		}
 	}
 
-	for i, leafKey := range leafKeys {
-		resp.AssetKeys[i] = marshalLeafKey(leafKey)
+	// Unmarshal asset (asset/asset group) specific sync configs.
+	assetSyncConfigs := make(
+		[]*universe.FedUniSyncConfig, len(req.AssetSyncConfigs),
+	)
+	for i := range req.AssetSyncConfigs {
+		assetSyncConfig := req.AssetSyncConfigs[i]
+		config, err := unmarshalAssetSyncConfig(assetSyncConfig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse asset sync "+
+				"config: %w", err)
+		}
+
+		assetSyncConfigs[i] = config
 	}
 
-	return resp, nil
-}
-
-func marshalAssetLeaf(ctx context.Context, keys taprpc.KeyLookup,
-	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
-
-	rpcAsset, err := taprpc.MarshalAsset(
-		ctx, assetLeaf.Asset, false, true, keys,
+	// Update asset (asset/asset group) specific sync configs.
+	err := r.cfg.FederationDB.UpsertFederationSyncConfig(
+		ctx, globalSyncConfig, assetSyncConfigs,
 	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to set federation sync "+
+			"config: %w", err)
 	}
 
-	return &unirpc.AssetLeaf{
-		Asset: rpcAsset,
-		Proof: assetLeaf.RawProof,
-	}, nil
-}
-
-// marshalAssetLeaf marshals an asset leaf into the RPC form.
-func (r *rpcServer) marshalAssetLeaf(ctx context.Context,
-	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
-
-	return marshalAssetLeaf(ctx, r.cfg.AddrBook, assetLeaf)
+	return &unirpc.SetFederationSyncConfigResponse{}, nil
 }
 
-// AssetLeaves queries for the set of asset leaves (the values in the Universe
-// MS-SMT tree) for a given asset_id or group_key. These represents either
-// asset issuance events (they have a genesis witness) or asset transfers that
-// took place on chain. The leaves contain a normal Taproot asset proof, as well
-// as details for the asset.
-func (r *rpcServer) AssetLeaves(ctx context.Context,
-	req *unirpc.ID) (*unirpc.AssetLeafResponse, error) {
+// QueryFederationSyncConfig queries the universe federation sync configuration
+// settings.
+func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
+	_ *unirpc.QueryFederationSyncConfigRequest,
+) (*unirpc.QueryFederationSyncConfigResponse, error) {
 
-	universeID, err := UnmarshalUniID(req)
+	// Obtain the general and universe specific federation sync configs.
+	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
+	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to query federation sync "+
+			"config(s): %w", err)
 	}
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
-		return nil, err
-	}
+	// Marshal the general sync config into the RPC form.
+	globalConfigRPC := make(
+		[]*unirpc.GlobalFederationSyncConfig, len(globalConfigs),
+	)
+	for i := range globalConfigs {
+		globalConfig := globalConfigs[i]
 
-	assetLeaves, err := r.cfg.UniverseArchive.MintingLeaves(ctx, universeID)
-	if err != nil {
-		return nil, err
-	}
+		proofTypeRpc, err := MarshalUniProofType(globalConfig.ProofType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unmarshal "+
+				"proof type: %w", err)
+		}
 
-	resp := &unirpc.AssetLeafResponse{
-		Leaves: make([]*unirpc.AssetLeaf, len(assetLeaves)),
+		globalConfigRPC[i] = &unirpc.GlobalFederationSyncConfig{
+			ProofType:       proofTypeRpc,
+			AllowSyncInsert: globalConfig.AllowSyncInsert,
+			AllowSyncExport: globalConfig.AllowSyncExport,
+		}
 	}
-	for i, assetLeaf := range assetLeaves {
-		assetLeaf := assetLeaf
 
-		resp.Leaves[i], err = r.marshalAssetLeaf(ctx, &assetLeaf)
+	// Marshal universe specific sync configs into the RPC form.
+	uniConfigRPCs := make(
+		[]*unirpc.AssetFederationSyncConfig, len(uniSyncConfigs),
+	)
+	for i := range uniSyncConfigs {
+		uniSyncConfig := uniSyncConfigs[i]
+		uniConfigRPC, err := MarshalAssetFedSyncCfg(*uniSyncConfig)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("unable to marshal universe "+
+				"specific federation sync config: %w", err)
 		}
+		uniConfigRPCs[i] = uniConfigRPC
 	}
 
-	return resp, nil
+	return &unirpc.QueryFederationSyncConfigResponse{
+		GlobalSyncConfigs: globalConfigRPC,
+		AssetSyncConfigs:  uniConfigRPCs,
+	}, nil
 }
 
-// UnmarshalOutpoint un-marshals an outpoint from a string received via RPC.
-func UnmarshalOutpoint(outpoint string) (*wire.OutPoint, error) {
-	parts := strings.Split(outpoint, ":")
-	if len(parts) != 2 {
-		return nil, errors.New("outpoint should be of form txid:index")
-	}
+// ProveAssetOwnership creates an ownership proof embedded in an asset
+// transition proof. That ownership proof is a signed virtual transaction
+// spending the asset with a valid witness to prove the prover owns the keys
+// that can spend the asset.
+func (r *rpcServer) ProveAssetOwnership(ctx context.Context,
+	req *wrpc.ProveAssetOwnershipRequest) (*wrpc.ProveAssetOwnershipResponse,
+	error) {
 
-	txidStr := parts[0]
-	if hex.DecodedLen(len(txidStr)) != chainhash.HashSize {
-		return nil, fmt.Errorf("invalid hex-encoded txid %v", txidStr)
+	if len(req.ScriptKey) == 0 {
+		return nil, fmt.Errorf("a valid script key must be specified")
 	}
 
-	txid, err := chainhash.NewHashFromStr(txidStr)
+	scriptKey, err := parseUserKey(req.ScriptKey)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid script key: %w", err)
 	}
 
-	outputIndex, err := strconv.Atoi(parts[1])
+	if len(req.AssetId) != 32 {
+		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	}
+
+	assetID := fn.ToArray[asset.ID](req.AssetId)
+	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
+		AssetID:   &assetID,
+		ScriptKey: *scriptKey,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("invalid output index: %v", err)
+		return nil, fmt.Errorf("cannot fetch proof: %w", err)
 	}
 
-	return &wire.OutPoint{
-		Hash:  *txid,
-		Index: uint32(outputIndex),
-	}, nil
-}
+	proofFile := &proof.File{}
+	err = proofFile.Decode(bytes.NewReader(proofBlob))
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode proof: %w", err)
+	}
 
-// unmarshalLeafKey un-marshals a leaf key from the RPC form.
-func unmarshalLeafKey(key *unirpc.AssetKey) (universe.LeafKey, error) {
-	var (
-		leafKey universe.LeafKey
-		err     error
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	medianTimeSource := tapgarden.GenMedianTimeSource(
+		ctx, r.cfg.ChainBridge,
 	)
-
-	switch {
-	case key.GetScriptKeyBytes() != nil:
-		pubKey, err := parseUserKey(key.GetScriptKeyBytes())
-		if err != nil {
-			return leafKey, err
-		}
-
-		leafKey.ScriptKey = &asset.ScriptKey{
-			PubKey: pubKey,
-		}
-
-	case key.GetScriptKeyStr() != "":
-		scriptKeyBytes, sErr := hex.DecodeString(key.GetScriptKeyStr())
-		if sErr != nil {
-			return leafKey, err
-		}
-
-		pubKey, err := parseUserKey(scriptKeyBytes)
-		if err != nil {
-			return leafKey, err
-		}
-
-		leafKey.ScriptKey = &asset.ScriptKey{
-			PubKey: pubKey,
-		}
-	default:
-		// TODO(roasbeef): can actually allow not to be, then would
-		// fetch all for the given outpoint
-		return leafKey, fmt.Errorf("script key must be set")
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	lastSnapshot, err := proofFile.Verify(
+		ctx, headerVerifier, medianTimeSource, groupVerifier,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot verify proof: %w", err)
 	}
 
-	switch {
-	case key.GetOpStr() != "":
-		// Parse a bitcoin outpoint in the form txid:index into a
-		// wire.OutPoint struct.
-		outpointStr := key.GetOpStr()
-		outpoint, err := UnmarshalOutpoint(outpointStr)
-		if err != nil {
-			return leafKey, err
-		}
-
-		leafKey.OutPoint = *outpoint
-
-	case key.GetOutpoint() != nil:
-		op := key.GetOp()
-
-		hash, err := chainhash.NewHashFromStr(op.HashStr)
-		if err != nil {
-			return leafKey, err
-		}
-
-		leafKey.OutPoint = wire.OutPoint{
-			Hash:  *hash,
-			Index: uint32(op.Index),
-		}
+	inputAsset := lastSnapshot.Asset
+	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
+		ctx, inputAsset.ID(), lastSnapshot.OutPoint,
+		inputAsset.GroupKey, &inputAsset.ScriptKey, false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching commitment: %w", err)
+	}
 
-	default:
-		return leafKey, fmt.Errorf("outpoint not set: %v", err)
+	challengeWitness, err := r.cfg.AssetWallet.SignOwnershipProof(
+		inputCommitment.Asset.Copy(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error signing ownership proof: %w", err)
 	}
 
-	return leafKey, nil
-}
+	lastProof, err := proofFile.LastProof()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching last proof: %w", err)
+	}
 
-// marshalMssmtProof marshals a MS-SMT proof into the RPC form.
-func marshalMssmtProof(proof *mssmt.Proof) ([]byte, error) {
-	compressedProof := proof.Compress()
+	lastProof.ChallengeWitness = challengeWitness
 
-	var b bytes.Buffer
-	if err := compressedProof.Encode(&b); err != nil {
-		return nil, err
+	var buf bytes.Buffer
+	if err := lastProof.Encode(&buf); err != nil {
+		return nil, fmt.Errorf("error encoding proof file: %w", err)
 	}
 
-	return b.Bytes(), nil
+	return &wrpc.ProveAssetOwnershipResponse{
+		ProofWithWitness: buf.Bytes(),
+	}, nil
 }
 
-// marshalUniverseProofLeaf marshals a universe proof leaf into the RPC form.
-func (r *rpcServer) marshalUniverseProofLeaf(ctx context.Context,
-	req *unirpc.UniverseKey,
-	proof *universe.Proof) (*unirpc.AssetProofResponse, error) {
+// VerifyAssetOwnership verifies the asset ownership proof embedded in the
+// given transition proof of an asset and returns true if the proof is valid.
+func (r *rpcServer) VerifyAssetOwnership(ctx context.Context,
+	req *wrpc.VerifyAssetOwnershipRequest) (*wrpc.VerifyAssetOwnershipResponse,
+	error) {
 
-	uniProof, err := marshalMssmtProof(proof.UniverseInclusionProof)
-	if err != nil {
-		return nil, err
+	if len(req.ProofWithWitness) == 0 {
+		return nil, fmt.Errorf("a valid proof must be specified")
 	}
 
-	assetLeaf, err := r.marshalAssetLeaf(ctx, proof.Leaf)
+	p := &proof.Proof{}
+	err := p.Decode(bytes.NewReader(req.ProofWithWitness))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("cannot decode proof file: %w", err)
 	}
 
-	uniRoot, err := marshalUniverseRoot(universe.Root{
-		Node: proof.UniverseRoot,
-	})
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	medianTimeSource := tapgarden.GenMedianTimeSource(
+		ctx, r.cfg.ChainBridge,
+	)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	_, err = p.Verify(
+		ctx, nil, headerVerifier, medianTimeSource, groupVerifier,
+	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error verifying proof: %w", err)
 	}
 
-	uniRoot.AssetName = assetLeaf.Asset.AssetGenesis.Name
-	uniRoot.Id = req.Id
+	return &wrpc.VerifyAssetOwnershipResponse{
+		ValidProof: true,
+	}, nil
+}
 
-	// Marshal multiverse specific fields.
-	multiverseRoot := marshalMssmtNode(proof.MultiverseRoot)
+// UniverseStats returns a set of aggregate statistics for the current state
+// of the Universe.
+func (r *rpcServer) UniverseStats(ctx context.Context,
+	_ *unirpc.StatsRequest) (*unirpc.StatsResponse, error) {
 
-	multiverseProof, err := marshalMssmtProof(
-		proof.MultiverseInclusionProof,
-	)
+	universeStats, err := r.cfg.UniverseStats.AggregateSyncStats(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.AssetProofResponse{
-		Req:                      req,
-		UniverseRoot:             uniRoot,
-		UniverseInclusionProof:   uniProof,
-		AssetLeaf:                assetLeaf,
-		MultiverseRoot:           multiverseRoot,
-		MultiverseInclusionProof: multiverseProof,
+	return &unirpc.StatsResponse{
+		NumTotalAssets: int64(universeStats.NumTotalAssets),
+		NumTotalGroups: int64(universeStats.NumTotalGroups),
+		NumTotalSyncs:  int64(universeStats.NumTotalSyncs),
+		NumTotalProofs: int64(universeStats.NumTotalProofs),
 	}, nil
 }
 
-// QueryProof attempts to query for an issuance or transfer proof for a given
-// asset based on its UniverseKey. A UniverseKey is composed of the Universe ID
-// (asset_id/group_key) and also a leaf key (outpoint || script_key). If found,
-// the target universe proof leaf is returned in addition to inclusion proofs
-// for the Universe and Multiverse MS-SMTs. This allows a caller to verify the
-// known Universe root, Multiverse root, and transition or issuance proof for
-// the target asset.
-func (r *rpcServer) QueryProof(ctx context.Context,
-	req *unirpc.UniverseKey) (*unirpc.AssetProofResponse, error) {
+// marshalAssetSyncSnapshot maps a universe asset sync stat snapshot to the RPC
+// counterpart.
+func (r *rpcServer) marshalAssetSyncSnapshot(ctx context.Context,
+	a universe.AssetSyncSnapshot) *unirpc.AssetStatsSnapshot {
 
-	universeID, err := UnmarshalUniID(req.Id)
-	if err != nil {
-		return nil, err
+	resp := &unirpc.AssetStatsSnapshot{
+		TotalSyncs:  int64(a.TotalSyncs),
+		TotalProofs: int64(a.TotalProofs),
+		GroupSupply: int64(a.GroupSupply),
 	}
-	leafKey, err := unmarshalLeafKey(req.LeafKey)
-	if err != nil {
-		return nil, err
+	rpcAsset := &unirpc.AssetStatsAsset{
+		AssetId:          a.AssetID[:],
+		GenesisPoint:     a.GenesisPoint.String(),
+		AssetName:        a.AssetName,
+		AssetType:        taprpc.AssetType(a.AssetType),
+		TotalSupply:      int64(a.TotalSupply),
+		GenesisHeight:    int32(a.GenesisHeight),
+		GenesisTimestamp: r.getBlockTimestamp(ctx, a.GenesisHeight),
 	}
 
-	rpcsLog.Tracef("[QueryProof]: fetching proof at (universeID=%v, "+
-		"leafKey=%x)", universeID, leafKey.UniverseKey())
-
-	// Retrieve proof export config for the given universe.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
-	if err != nil {
-		return nil, err
+	if a.GroupKey != nil {
+		resp.GroupKey = a.GroupKey.SerializeCompressed()
+		resp.GroupAnchor = rpcAsset
+	} else {
+		resp.Asset = rpcAsset
 	}
 
-	var candidateIDs []universe.Identifier
+	return resp
+}
 
-	if universeID.ProofType == universe.ProofTypeUnspecified {
-		// If the proof type is unspecified, then we'll attempt to
-		// retrieve both the issuance and transfer proofs. We gather the
-		// corresponding universe IDs into a candidate set.
-		universeID.ProofType = universe.ProofTypeIssuance
-		if syncConfigs.IsSyncExportEnabled(universeID) {
-			candidateIDs = append(candidateIDs, universeID)
-		}
+// QueryAssetStats returns a set of statistics for a given set of assets.
+// Stats can be queried for all assets, or based on the: asset ID, name, or
+// asset type. Pagination is supported via the offset and limit params.
+// Results can also be sorted based on any of the main query params.
+func (r *rpcServer) QueryAssetStats(ctx context.Context,
+	req *unirpc.AssetStatsQuery) (*unirpc.UniverseAssetStats, error) {
 
-		universeID.ProofType = universe.ProofTypeTransfer
-		if syncConfigs.IsSyncExportEnabled(universeID) {
-			candidateIDs = append(candidateIDs, universeID)
-		}
-	} else {
-		// Otherwise, we'll only attempt to retrieve the proof for the
-		// specified proof type. But first we'll check that proof export
-		// is enabled for the given universe.
-		if !syncConfigs.IsSyncExportEnabled(universeID) {
-			return nil, fmt.Errorf("proof export is disabled for " +
-				"the given universe")
-		}
+	assetStats, err := r.cfg.UniverseStats.QuerySyncStats(
+		ctx, universe.SyncStatsQuery{
+			AssetNameFilter: req.AssetNameFilter,
+			AssetTypeFilter: func() *asset.Type {
+				switch req.AssetTypeFilter {
+				case unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL:
+					return fn.Ptr(asset.Normal)
 
-		candidateIDs = append(candidateIDs, universeID)
-	}
+				case unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE:
+					return fn.Ptr(asset.Collectible)
 
-	// If no candidate IDs were applicable then our config must have
-	// disabled proof export for the given universe.
-	if len(candidateIDs) == 0 {
-		return nil, fmt.Errorf("proof export is disabled for the " +
-			"given universe")
+				default:
+					return nil
+				}
+			}(),
+			AssetIDFilter: fn.ToArray[asset.ID](
+				req.AssetIdFilter,
+			),
+			SortBy:        universe.SyncStatsSort(req.SortBy),
+			SortDirection: universe.SortDirection(req.Direction),
+			Offset:        int(req.Offset),
+			Limit:         int(req.Limit),
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
-		return nil, err
+	resp := &unirpc.UniverseAssetStats{
+		AssetStats: make(
+			[]*unirpc.AssetStatsSnapshot, len(assetStats.SyncStats),
+		),
+	}
+	for idx, snapshot := range assetStats.SyncStats {
+		resp.AssetStats[idx] = r.marshalAssetSyncSnapshot(ctx, snapshot)
 	}
 
-	// Attempt to retrieve the proof given the candidate set of universe
-	// IDs.
-	var proofs []*universe.Proof
-	for i := range candidateIDs {
-		candidateID := candidateIDs[i]
+	return resp, nil
+}
 
-		proofs, err = r.cfg.UniverseArchive.FetchProofLeaf(
-			ctx, candidateID, leafKey,
-		)
-		if err != nil {
-			if errors.Is(err, universe.ErrNoUniverseProofFound) {
-				continue
-			}
+// getBlockTimestamp returns the timestamp of the block at the given height.
+func (r *rpcServer) getBlockTimestamp(ctx context.Context,
+	height uint32) int64 {
 
-			rpcsLog.Debugf("[QueryProof]: error querying for "+
-				"proof at (universeID=%v, leafKey=%x)",
-				universeID, leafKey.UniverseKey())
-			return nil, err
-		}
+	// Shortcut any lookup in case we don't have a valid height in the first
+	// place.
+	if height == 0 {
+		return 0
+	}
 
-		// At this point we've found a proof, so we'll break out of the
-		// loop. We don't need to attempt to retrieve a proof for any
-		// other candidate IDs.
-		break
+	cacheTS, err := r.blockTimestampCache.Get(height)
+	if err == nil {
+		return int64(cacheTS)
 	}
 
-	if len(proofs) == 0 {
-		return nil, universe.ErrNoUniverseProofFound
+	hash, err := r.cfg.Lnd.ChainKit.GetBlockHash(ctx, int64(height))
+	if err != nil {
+		return 0
 	}
 
-	// TODO(roasbeef): query may return multiple proofs, if allow key to
-	// not be fully specified
-	proof := proofs[0]
+	block, err := r.cfg.Lnd.ChainKit.GetBlock(ctx, hash)
+	if err != nil {
+		return 0
+	}
 
-	rpcsLog.Tracef("[QueryProof]: found proof at (universeID=%v, "+
-		"leafKey=%x)", universeID, leafKey.UniverseKey())
+	ts := uint32(block.Header.Timestamp.Unix())
+	_, _ = r.blockTimestampCache.Put(height, cacheableTimestamp(ts))
 
-	return r.marshalUniverseProofLeaf(ctx, req, proof)
+	return int64(ts)
 }
 
-// unmarshalAssetLeaf unmarshals an asset leaf from the RPC form.
-func unmarshalAssetLeaf(leaf *unirpc.AssetLeaf) (*universe.Leaf, error) {
-	// We'll just pull the asset details from the serialized issuance proof
-	// itself.
-	var assetProof proof.Proof
-	if err := assetProof.Decode(
-		bytes.NewReader(leaf.Proof),
-	); err != nil {
-		return nil, err
+// QueryEvents returns the number of sync and proof events for a given time
+// period, grouped by day.
+func (r *rpcServer) QueryEvents(ctx context.Context,
+	req *unirpc.QueryEventsRequest) (*unirpc.QueryEventsResponse, error) {
+
+	// If no start or end time is specified, default to the last 30 days.
+	var (
+		startTime = time.Now().AddDate(0, 0, -30)
+		endTime   = time.Now()
+	)
+	if req.StartTimestamp > 0 {
+		startTime = time.Unix(req.StartTimestamp, 0)
+	}
+	if req.EndTimestamp > 0 {
+		endTime = time.Unix(req.EndTimestamp, 0)
 	}
 
-	// TODO(roasbeef): double check posted file format everywhere
-	//  * raw proof, or within file?
+	if endTime.Before(startTime) {
+		return nil, fmt.Errorf("end time cannot be before start time")
+	}
+
+	stats, err := r.cfg.UniverseStats.QueryAssetStatsPerDay(
+		ctx, universe.GroupedStatsQuery{
+			StartTime: startTime,
+			EndTime:   endTime,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error querying stats: %w", err)
+	}
+
+	rpcStats := &unirpc.QueryEventsResponse{
+		Events: make([]*unirpc.GroupedUniverseEvents, len(stats)),
+	}
+	for day, s := range stats {
+		rpcStats.Events[day] = &unirpc.GroupedUniverseEvents{
+			Date:           s.Date,
+			SyncEvents:     s.NumTotalSyncs,
+			NewProofEvents: s.NumTotalProofs,
+		}
+	}
 
-	return &universe.Leaf{
-		GenesisWithGroup: universe.GenesisWithGroup{
-			Genesis:  assetProof.Asset.Genesis,
-			GroupKey: assetProof.Asset.GroupKey,
-		},
-		RawProof: leaf.Proof,
-		Asset:    &assetProof.Asset,
-		Amt:      assetProof.Asset.Amount,
-	}, nil
+	return rpcStats, nil
 }
 
-// InsertProof attempts to insert a new issuance or transfer proof into the
-// Universe tree specified by the UniverseKey. If valid, then the proof is
-// inserted into the database, with a new Universe root returned for the updated
-// asset_id/group_key.
-func (r *rpcServer) InsertProof(ctx context.Context,
-	req *unirpc.AssetProof) (*unirpc.AssetProofResponse, error) {
+// SubscribeUniverseEvents registers a subscription to the stream of new
+// leaves, root changes and sync events of the local Universe.
+func (r *rpcServer) SubscribeUniverseEvents(
+	req *unirpc.SubscribeUniverseEventsRequest,
+	ntfnStream subUniverseEventsStream) error {
 
-	if req.Key == nil {
-		return nil, fmt.Errorf("key cannot be nil")
+	filter, err := unmarshalUniverseEventFilter(req)
+	if err != nil {
+		return err
 	}
 
-	universeID, err := UnmarshalUniID(req.Key.Id)
+	// Create a new event subscriber and register it with the event
+	// notifier, which delivers the logged events after the resume cursor
+	// that match the filter first if requested.
+	eventSubscriber := fn.NewEventReceiver[*universe.Event](
+		fn.DefaultQueueSize,
+	)
+
+	err = r.cfg.UniverseEvents.RegisterFilteredSubscriber(
+		eventSubscriber, filter, req.DeliverExisting, req.ResumeCursor,
+	)
 	if err != nil {
-		return nil, err
+		eventSubscriber.Stop()
+
+		return fmt.Errorf("failed to register universe event "+
+			"notifications subscription: %w", err)
 	}
-	leafKey, err := unmarshalLeafKey(req.Key.LeafKey)
-	if err != nil {
-		return nil, err
+	defer func() {
+		err := r.cfg.UniverseEvents.RemoveSubscriber(eventSubscriber)
+		if err != nil {
+			rpcsLog.Warnf("Unable to remove universe event "+
+				"subscriber: %v", err)
+		}
+	}()
+
+	// Loop and read from the event subscription and forward the events
+	// selected by the filter to the RPC stream.
+	for {
+		select {
+		case event := <-eventSubscriber.NewItemCreated.ChanOut():
+			if !filter.Matches(event) {
+				continue
+			}
+
+			rpcEvent, err := marshalUniverseEvent(event)
+			if err != nil {
+				return fmt.Errorf("failed to marshal universe "+
+					"event into RPC event: %w", err)
+			}
+
+			err = ntfnStream.Send(rpcEvent)
+			if err != nil {
+				return fmt.Errorf("failed to RPC stream "+
+					"universe event: %w", err)
+			}
+
+		// Handle the case where the RPC stream is closed by the
+		// client.
+		case <-ntfnStream.Context().Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			isCanceledContext := errors.Is(
+				ntfnStream.Context().Err(), context.Canceled,
+			)
+			if isCanceledContext {
+				return nil
+			}
+
+			return ntfnStream.Context().Err()
+
+		// Handle the case where the RPC server is shutting down.
+		case <-r.quit:
+			return nil
+		}
 	}
+}
 
-	assetLeaf, err := unmarshalAssetLeaf(req.AssetLeaf)
-	if err != nil {
-		return nil, err
+// unmarshalUniverseEventFilter parses the universe event filter of the passed
+// subscription request.
+func unmarshalUniverseEventFilter(
+	req *unirpc.SubscribeUniverseEventsRequest) (*universe.EventFilter,
+	error) {
+
+	var filter universe.EventFilter
+
+	if len(req.AssetId) > 0 {
+		if len(req.AssetId) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], req.AssetId)
+		filter.AssetID = &assetID
 	}
 
-	// If universe proof type unspecified, set based on the provided asset
-	// proof.
-	if universeID.ProofType == universe.ProofTypeUnspecified {
-		universeID.ProofType, err = universe.NewProofTypeFromAsset(
-			assetLeaf.Asset,
-		)
+	if len(req.GroupKey) > 0 {
+		groupKey, err := parseUserKey(req.GroupKey)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("invalid group key: %w", err)
 		}
-	}
 
-	// Ensure that the new proof is of the correct type for the target
-	// universe.
-	err = universe.ValidateProofUniverseType(assetLeaf.Asset, universeID)
-	if err != nil {
-		return nil, err
+		filter.GroupKey = groupKey
 	}
 
-	// Ensure proof insert is enabled for the given universe.
-	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	proofType, err := UnmarshalUniProofType(req.ProofType)
 	if err != nil {
 		return nil, err
 	}
+	filter.ProofType = proofType
 
-	if !syncConfigs.IsSyncInsertEnabled(universeID) {
-		return nil, fmt.Errorf("proof insert is disabled for the " +
-			"given universe")
-	}
+	return &filter, nil
+}
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err := r.proofQueryRateLimiter.Wait(ctx); err != nil {
-		return nil, err
-	}
+// marshalUniverseEvent marshals a universe event into the RPC counterpart.
+func marshalUniverseEvent(event *universe.Event) (*unirpc.UniverseEvent,
+	error) {
 
-	rpcsLog.Debugf("[InsertProof]: inserting proof at "+
-		"(universeID=%v, leafKey=%x)", universeID,
-		leafKey.UniverseKey())
+	var eventType unirpc.UniverseEventType
+	switch event.Type {
+	case universe.EventTypeNewLeaf:
+		eventType = unirpc.UniverseEventType_UNIVERSE_EVENT_TYPE_NEW_LEAF
+	case universe.EventTypeNewRoot:
+		eventType = unirpc.UniverseEventType_UNIVERSE_EVENT_TYPE_NEW_ROOT
+	case universe.EventTypeSync:
+		eventType = unirpc.UniverseEventType_UNIVERSE_EVENT_TYPE_SYNC
+	default:
+		return nil, fmt.Errorf("unknown universe event type: %v",
+			event.Type)
+	}
 
-	newUniverseState, err := r.cfg.UniverseArchive.UpsertProofLeaf(
-		ctx, universeID, leafKey, assetLeaf,
-	)
+	uniID, err := MarshalUniID(event.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	universeRootHash := newUniverseState.UniverseRoot.NodeHash()
-	rpcsLog.Debugf("[InsertProof]: proof inserted, new universe root: %x",
-		universeRootHash[:])
-
-	return r.marshalUniverseProofLeaf(ctx, req.Key, newUniverseState)
-}
+	rpcEvent := &unirpc.UniverseEvent{
+		Cursor:     event.Cursor,
+		EventType:  eventType,
+		Id:         uniID,
+		ServerHost: event.ServerHost,
+		Timestamp:  event.Timestamp.Unix(),
+	}
 
-// Info returns a set of information about the current state of the Universe.
-func (r *rpcServer) Info(ctx context.Context,
-	_ *unirpc.InfoRequest) (*unirpc.InfoResponse, error) {
+	if event.LeafKey != nil {
+		rpcEvent.LeafKey = marshalLeafKey(*event.LeafKey)
+	}
+	if event.LeafAssetID != nil {
+		rpcEvent.LeafAssetId = fn.ByteSlice(*event.LeafAssetID)
+	}
+	if event.Root != nil {
+		rpcEvent.Root = marshalMssmtNode(event.Root)
+	}
 
-	return &unirpc.InfoResponse{
-		RuntimeId: r.cfg.RuntimeID,
-	}, nil
+	return rpcEvent, nil
 }
 
-// unmarshalUniverseSyncType maps an RPC universe sync type into a concrete
-// type.
-func unmarshalUniverseSyncType(req unirpc.UniverseSyncMode) (
-	universe.SyncType, error) {
+// ExportSnapshot exports a self-verifying snapshot of either only the set of
+// specified universes, or all universes if none are specified. The snapshot of
+// each universe is split into chunks, which are sent as separate messages of
+// the stream as soon as they're read.
+func (r *rpcServer) ExportSnapshot(req *unirpc.ExportSnapshotRequest,
+	stream exportSnapshotStream) error {
 
-	switch req {
-	case unirpc.UniverseSyncMode_SYNC_FULL:
-		return universe.SyncFull, nil
+	ctx := stream.Context()
 
-	case unirpc.UniverseSyncMode_SYNC_ISSUANCE_ONLY:
-		return universe.SyncIssuance, nil
+	var (
+		ids []universe.Identifier
+		err error
+	)
+	for _, rpcID := range req.Ids {
+		id, err := UnmarshalUniID(rpcID)
+		if err != nil {
+			return err
+		}
 
-	default:
-		return 0, fmt.Errorf("unknown sync type: %v", req)
+		ids = append(ids, id)
 	}
-}
 
-// unmarshalSyncTargets maps an RPC sync target into a concrete type.
-func unmarshalSyncTargets(targets []*unirpc.SyncTarget) ([]universe.Identifier, error) {
-	uniIDs := make([]universe.Identifier, 0, len(targets))
-	for _, target := range targets {
-		uniID, err := UnmarshalUniID(target.Id)
+	if len(ids) == 0 {
+		ids, err = r.cfg.UniverseSnapshotter.UniverseIDs(ctx)
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("unable to fetch universes: %w", err)
 		}
-		uniIDs = append(uniIDs, uniID)
 	}
 
-	return uniIDs, nil
-}
-
-// marshalUniverseDiff marshals a universe diff into the RPC form.
-func (r *rpcServer) marshalUniverseDiff(ctx context.Context,
-	uniDiff []universe.AssetSyncDiff) (*unirpc.SyncResponse, error) {
-
-	resp := &unirpc.SyncResponse{
-		SyncedUniverses: make([]*unirpc.SyncedUniverse, 0, len(uniDiff)),
-	}
+	rpcsLog.Infof("Exporting snapshot of %d universe(s)", len(ids))
 
-	err := fn.ForEachErr(uniDiff, func(diff universe.AssetSyncDiff) error {
-		oldUniRoot, err := marshalUniverseRoot(diff.OldUniverseRoot)
+	sendChunk := func(chunk *universe.SnapshotChunk) error {
+		rpcSnapshot, err := marshalSnapshotChunk(chunk)
 		if err != nil {
-			return fmt.Errorf("unable to marshal old uni "+
-				"root: %w", err)
+			return err
 		}
-		newUniRoot, err := marshalUniverseRoot(diff.NewUniverseRoot)
-		if err != nil {
-			return fmt.Errorf("unable to marshal new unit "+
-				"root: %w", err)
+
+		if err := stream.Send(rpcSnapshot); err != nil {
+			return fmt.Errorf("failed to RPC stream universe "+
+				"snapshot: %w", err)
 		}
 
-		leaves := make([]*unirpc.AssetLeaf, len(diff.NewLeafProofs))
-		for i, leaf := range diff.NewLeafProofs {
-			leaves[i], err = r.marshalAssetLeaf(ctx, leaf)
-			if err != nil {
-				return err
-			}
+		select {
+		case <-r.quit:
+			return fmt.Errorf("server shutting down")
+		default:
+			return nil
 		}
+	}
 
-		resp.SyncedUniverses = append(
-			resp.SyncedUniverses, &unirpc.SyncedUniverse{
-				OldAssetRoot:   oldUniRoot,
-				NewAssetRoot:   newUniRoot,
-				NewAssetLeaves: leaves,
-			},
+	for _, id := range ids {
+		err := r.cfg.UniverseSnapshotter.ExportUniverse(
+			ctx, id, universe.SnapshotChunkSizeBytes, sendChunk,
 		)
-		return nil
-	})
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return fmt.Errorf("unable to export universe %v: %w",
+				id.StringForLog(), err)
+		}
 	}
 
-	return resp, nil
+	return nil
 }
 
-// SyncUniverse takes host information for a remote Universe server, then
-// attempts to synchronize either only the set of specified asset_ids, or all
-// assets if none are specified. The sync process will attempt to query for the
-// latest known root for each asset, performing tree based reconciliation to
-// arrive at a new shared root.
-func (r *rpcServer) SyncUniverse(ctx context.Context,
-	req *unirpc.SyncRequest) (*unirpc.SyncResponse, error) {
-
-	// TODO(roasbeef): have another layer, only allow single outstanding
-	// sync request per host?
-
-	syncMode, err := unmarshalUniverseSyncType(req.SyncMode)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse sync type: %w", err)
-	}
-	syncTargets, err := unmarshalSyncTargets(req.SyncTargets)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse sync targets: %w", err)
-	}
+// ImportSnapshot receives the chunks of the snapshot of a single universe.
+// The leaves of each chunk are verified and staged as they're received. Once
+// all chunks were received and the leaves match the MS-SMT root embedded in
+// the snapshot, all leaves that aren't yet known are inserted into the local
+// Universe.
+func (r *rpcServer) ImportSnapshot(stream importSnapshotStream) error {
+	ctx := stream.Context()
+
+	snapshotImport := r.cfg.UniverseSnapshotter.NewImport()
+	defer func() {
+		if err := snapshotImport.Close(); err != nil {
+			rpcsLog.Warnf("Unable to remove staged universe "+
+				"snapshot: %v", err)
+		}
+	}()
+
+	var complete bool
+	for !complete {
+		req, err := stream.Recv()
+		switch {
+		case errors.Is(err, io.EOF):
+			return fmt.Errorf("stream closed before all " +
+				"chunks of the universe snapshot were received")
 
-	uniAddr := universe.NewServerAddrFromStr(req.UniverseHost)
+		case err != nil:
+			return err
+		}
 
-	// Obtain the general and universe specific federation sync configs.
-	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
-	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("unable to query federation sync "+
-			"config(s): %w", err)
-	}
+		var chunk universe.SnapshotChunk
+		err = chunk.Decode(bytes.NewReader(req.Snapshot))
+		if err != nil {
+			return fmt.Errorf("unable to decode snapshot: %w", err)
+		}
 
-	syncConfigs := universe.SyncConfigs{
-		GlobalSyncConfigs: globalConfigs,
-		UniSyncConfigs:    uniSyncConfigs,
+		complete, err = snapshotImport.AddChunk(ctx, &chunk)
+		if err != nil {
+			return fmt.Errorf("unable to add snapshot chunk: %w",
+				err)
+		}
 	}
 
-	// TODO(roasbeef): add layer of indirection in front of?
-	//  * just interface interaction
-	// TODO(ffranr): Sync via the FederationEnvoy rather than syncer.
-	universeDiff, err := r.cfg.UniverseSyncer.SyncUniverse(
-		ctx, uniAddr, syncMode, syncConfigs, syncTargets...,
-	)
+	uniID := snapshotImport.ID()
+	numNewLeaves, err := snapshotImport.Commit(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to sync universe: %w", err)
-	}
-
-	return r.marshalUniverseDiff(ctx, universeDiff)
-}
-
-func marshalUniverseServer(server universe.ServerAddr,
-) *unirpc.UniverseFederationServer {
-
-	return &unirpc.UniverseFederationServer{
-		Host: server.HostStr(),
-		Id:   int32(server.ID),
+		return fmt.Errorf("unable to import universe %v: %w",
+			uniID.StringForLog(), err)
 	}
-}
 
-// ListFederationServers lists the set of servers that make up the federation
-// of the local Universe server. This servers are used to push out new proofs,
-// and also periodically call sync new proofs from the remote server.
-func (r *rpcServer) ListFederationServers(ctx context.Context,
-	_ *unirpc.ListFederationServersRequest,
-) (*unirpc.ListFederationServersResponse, error) {
-
-	uniServers, err := r.cfg.FederationDB.UniverseServers(ctx)
+	rpcUniID, err := MarshalUniID(uniID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return &unirpc.ListFederationServersResponse{
-		Servers: fn.Map(uniServers, marshalUniverseServer),
-	}, nil
+	return stream.SendAndClose(&unirpc.ImportSnapshotResponse{
+		Id:           rpcUniID,
+		Root:         marshalMssmtNode(snapshotImport.Root()),
+		NumLeaves:    snapshotImport.NumLeaves(),
+		NumNewLeaves: uint64(numNewLeaves),
+	})
 }
 
-func unmarshalUniverseServer(
-	server *unirpc.UniverseFederationServer) universe.ServerAddr {
+// marshalSnapshotChunk marshals a chunk of a universe snapshot into the RPC
+// counterpart, along with the summary of the universe snapshot.
+func marshalSnapshotChunk(
+	chunk *universe.SnapshotChunk) (*unirpc.UniverseSnapshot, error) {
 
-	return universe.NewServerAddr(int64(server.Id), server.Host)
-}
+	uniID, err := MarshalUniID(chunk.ID)
+	if err != nil {
+		return nil, err
+	}
 
-// AddFederationServer adds a new server to the federation of the local
-// Universe server. Once a server is added, this call can also optionally be
-// used to trigger a sync of the remote server.
-func (r *rpcServer) AddFederationServer(ctx context.Context,
-	req *unirpc.AddFederationServerRequest,
-) (*unirpc.AddFederationServerResponse, error) {
+	var b bytes.Buffer
+	if err := chunk.Encode(&b); err != nil {
+		return nil, fmt.Errorf("unable to encode snapshot: %w", err)
+	}
 
-	serversToAdd := fn.Map(req.Servers, unmarshalUniverseServer)
+	return &unirpc.UniverseSnapshot{
+		Id:             uniID,
+		Root:           marshalMssmtNode(chunk.Root),
+		NumLeaves:      chunk.NumLeaves,
+		Snapshot:       b.Bytes(),
+		ChunkOffset:    chunk.Offset,
+		NumChunkLeaves: uint64(len(chunk.Leaves)),
+	}, nil
+}
 
-	for idx := range serversToAdd {
-		server := serversToAdd[idx]
+// RemoveUTXOLease removes the lease/lock/reservation of the given managed
+// UTXO.
+func (r *rpcServer) RemoveUTXOLease(ctx context.Context,
+	req *wrpc.RemoveUTXOLeaseRequest) (*wrpc.RemoveUTXOLeaseResponse,
+	error) {
 
-		// Before we add the server as a federation member, we check
-		// that we can actually connect to it and that it isn't
-		// ourselves.
-		err := CheckFederationServer(
-			r.cfg.RuntimeID, universe.DefaultTimeout, server,
-		)
-		if err != nil {
-			return nil, err
-		}
+	if req.Outpoint == nil {
+		return nil, fmt.Errorf("outpoint must be specified")
 	}
 
-	err := r.cfg.UniverseFederation.AddServer(serversToAdd...)
+	hash, err := chainhash.NewHash(req.Outpoint.Txid)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error parsing txid: %w", err)
 	}
 
-	return &unirpc.AddFederationServerResponse{}, nil
-}
-
-// DeleteFederationServer removes a server from the federation of the local
-// Universe server.
-func (r *rpcServer) DeleteFederationServer(ctx context.Context,
-	req *unirpc.DeleteFederationServerRequest,
-) (*unirpc.DeleteFederationServerResponse, error) {
-
-	serversToDel := fn.Map(req.Servers, unmarshalUniverseServer)
+	outPoint := wire.OutPoint{
+		Hash:  *hash,
+		Index: req.Outpoint.OutputIndex,
+	}
 
-	err := r.cfg.FederationDB.RemoveServers(ctx, serversToDel...)
+	err = r.cfg.CoinSelect.ReleaseCoins(ctx, outPoint)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.DeleteFederationServerResponse{}, nil
+	return &wrpc.RemoveUTXOLeaseResponse{}, nil
 }
 
-// SetFederationSyncConfig sets the configuration of the universe federation
-// sync.
-func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
-	req *unirpc.SetFederationSyncConfigRequest) (
-	*unirpc.SetFederationSyncConfigResponse, error) {
+// CreateMuSig2Session creates a new MuSig2 signing session for the aggregate
+// script key of the given signers, one of which must be a key of the local
+// wallet.
+func (r *rpcServer) CreateMuSig2Session(ctx context.Context,
+	req *wrpc.CreateMuSig2SessionRequest) (*wrpc.CreateMuSig2SessionResponse,
+	error) {
 
-	// Unmarshal global sync configs.
-	globalSyncConfig := make(
-		[]*universe.FedGlobalSyncConfig, len(req.GlobalSyncConfigs),
-	)
-	for i := range req.GlobalSyncConfigs {
-		config := req.GlobalSyncConfigs[i]
+	if req.LocalKeyLoc == nil {
+		return nil, fmt.Errorf("local key locator must be specified")
+	}
+	localKeyLoc := keychain.KeyLocator{
+		Family: keychain.KeyFamily(req.LocalKeyLoc.KeyFamily),
+		Index:  uint32(req.LocalKeyLoc.KeyIndex),
+	}
 
-		proofType, err := UnmarshalUniProofType(config.ProofType)
+	signers := make([]*btcec.PublicKey, len(req.SignerPubKeys))
+	for idx, keyBytes := range req.SignerPubKeys {
+		signer, err := btcec.ParsePubKey(keyBytes)
 		if err != nil {
-			return nil, fmt.Errorf("unable to unmarshal "+
-				"proof type: %w", err)
-		}
-
-		globalSyncConfig[i] = &universe.FedGlobalSyncConfig{
-			ProofType:       proofType,
-			AllowSyncInsert: config.AllowSyncInsert,
-			AllowSyncExport: config.AllowSyncExport,
+			return nil, fmt.Errorf("error parsing signer key %d: "+
+				"%w", idx, err)
 		}
+		signers[idx] = signer
 	}
 
-	// Unmarshal asset (asset/asset group) specific sync configs.
-	assetSyncConfigs := make(
-		[]*universe.FedUniSyncConfig, len(req.AssetSyncConfigs),
-	)
-	for i := range req.AssetSyncConfigs {
-		assetSyncConfig := req.AssetSyncConfigs[i]
-		config, err := unmarshalAssetSyncConfig(assetSyncConfig)
-		if err != nil {
-			return nil, fmt.Errorf("unable to parse asset sync "+
-				"config: %w", err)
-		}
+	switch len(req.TapscriptRoot) {
+	case 0, sha256.Size:
+	default:
+		return nil, fmt.Errorf("tapscript root must be %d bytes",
+			sha256.Size)
+	}
 
-		assetSyncConfigs[i] = config
+	otherNonces, err := unmarshalMuSig2Nonces(req.OtherSignerPublicNonces)
+	if err != nil {
+		return nil, err
 	}
 
-	// Update asset (asset/asset group) specific sync configs.
-	err := r.cfg.FederationDB.UpsertFederationSyncConfig(
-		ctx, globalSyncConfig, assetSyncConfigs,
+	session, err := r.cfg.AssetWallet.MuSig2CreateSession(
+		ctx, localKeyLoc, signers, req.TapscriptRoot, otherNonces,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to set federation sync "+
-			"config: %w", err)
+		return nil, fmt.Errorf("error creating MuSig2 session: %w", err)
 	}
 
-	return &unirpc.SetFederationSyncConfigResponse{}, nil
+	return &wrpc.CreateMuSig2SessionResponse{
+		SessionId:        session.SessionID[:],
+		LocalPublicNonce: session.PublicNonce[:],
+		ScriptKey:        marshalScriptKey(session.ScriptKey),
+		HaveAllNonces:    session.HaveAllNonces,
+	}, nil
 }
 
-// QueryFederationSyncConfig queries the universe federation sync configuration
-// settings.
-func (r *rpcServer) QueryFederationSyncConfig(ctx context.Context,
-	_ *unirpc.QueryFederationSyncConfigRequest,
-) (*unirpc.QueryFederationSyncConfigResponse, error) {
+// RegisterMuSig2Nonces registers the public nonces of the other signers with a
+// MuSig2 signing session.
+func (r *rpcServer) RegisterMuSig2Nonces(ctx context.Context,
+	req *wrpc.RegisterMuSig2NoncesRequest) (
+	*wrpc.RegisterMuSig2NoncesResponse, error) {
 
-	// Obtain the general and universe specific federation sync configs.
-	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
-	globalConfigs, uniSyncConfigs, err := queryFedSyncConfigs(ctx)
+	sessionID, err := unmarshalMuSig2SessionID(req.SessionId)
 	if err != nil {
-		return nil, fmt.Errorf("unable to query federation sync "+
-			"config(s): %w", err)
+		return nil, err
 	}
 
-	// Marshal the general sync config into the RPC form.
-	globalConfigRPC := make(
-		[]*unirpc.GlobalFederationSyncConfig, len(globalConfigs),
-	)
-	for i := range globalConfigs {
-		globalConfig := globalConfigs[i]
-
-		proofTypeRpc, err := MarshalUniProofType(globalConfig.ProofType)
-		if err != nil {
-			return nil, fmt.Errorf("unable to unmarshal "+
-				"proof type: %w", err)
-		}
-
-		globalConfigRPC[i] = &unirpc.GlobalFederationSyncConfig{
-			ProofType:       proofTypeRpc,
-			AllowSyncInsert: globalConfig.AllowSyncInsert,
-			AllowSyncExport: globalConfig.AllowSyncExport,
-		}
+	nonces, err := unmarshalMuSig2Nonces(req.OtherSignerPublicNonces)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonces) == 0 {
+		return nil, fmt.Errorf("no nonces specified")
 	}
 
-	// Marshal universe specific sync configs into the RPC form.
-	uniConfigRPCs := make(
-		[]*unirpc.AssetFederationSyncConfig, len(uniSyncConfigs),
+	haveAllNonces, err := r.cfg.AssetWallet.MuSig2RegisterNonces(
+		ctx, sessionID, nonces,
 	)
-	for i := range uniSyncConfigs {
-		uniSyncConfig := uniSyncConfigs[i]
-		uniConfigRPC, err := MarshalAssetFedSyncCfg(*uniSyncConfig)
-		if err != nil {
-			return nil, fmt.Errorf("unable to marshal universe "+
-				"specific federation sync config: %w", err)
-		}
-		uniConfigRPCs[i] = uniConfigRPC
+	if err != nil {
+		return nil, fmt.Errorf("error registering nonces: %w", err)
 	}
 
-	return &unirpc.QueryFederationSyncConfigResponse{
-		GlobalSyncConfigs: globalConfigRPC,
-		AssetSyncConfigs:  uniConfigRPCs,
+	return &wrpc.RegisterMuSig2NoncesResponse{
+		HaveAllNonces: haveAllNonces,
 	}, nil
 }
 
-// ProveAssetOwnership creates an ownership proof embedded in an asset
-// transition proof. That ownership proof is a signed virtual transaction
-// spending the asset with a valid witness to prove the prover owns the keys
-// that can spend the asset.
-func (r *rpcServer) ProveAssetOwnership(ctx context.Context,
-	req *wrpc.ProveAssetOwnershipRequest) (*wrpc.ProveAssetOwnershipResponse,
-	error) {
-
-	if len(req.ScriptKey) == 0 {
-		return nil, fmt.Errorf("a valid script key must be specified")
-	}
+// SignMuSig2VirtualPsbt creates the local partial MuSig2 signature for an input
+// of a funded virtual transaction.
+func (r *rpcServer) SignMuSig2VirtualPsbt(ctx context.Context,
+	req *wrpc.SignMuSig2VirtualPsbtRequest) (
+	*wrpc.SignMuSig2VirtualPsbtResponse, error) {
 
-	scriptKey, err := parseUserKey(req.ScriptKey)
+	sessionID, err := unmarshalMuSig2SessionID(req.SessionId)
 	if err != nil {
-		return nil, fmt.Errorf("invalid script key: %w", err)
+		return nil, err
 	}
 
-	if len(req.AssetId) != 32 {
-		return nil, fmt.Errorf("asset ID must be 32 bytes")
+	if req.FundedPsbt == nil {
+		return nil, fmt.Errorf("funded PSBT must be specified")
 	}
 
-	assetID := fn.ToArray[asset.ID](req.AssetId)
-	proofBlob, err := r.cfg.ProofArchive.FetchProof(ctx, proof.Locator{
-		AssetID:   &assetID,
-		ScriptKey: *scriptKey,
-	})
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("cannot fetch proof: %w", err)
+		return nil, fmt.Errorf("error decoding packet: %w", err)
 	}
 
-	proofFile := &proof.File{}
-	err = proofFile.Decode(bytes.NewReader(proofBlob))
+	partialSig, err := r.cfg.AssetWallet.MuSig2SignVirtualPacket(
+		ctx, sessionID, vPkt, int(req.InputIndex), req.Cleanup,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("cannot decode proof: %w", err)
+		return nil, fmt.Errorf("error signing packet: %w", err)
 	}
 
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
-	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
-	lastSnapshot, err := proofFile.Verify(
-		ctx, headerVerifier, groupVerifier,
-	)
+	return &wrpc.SignMuSig2VirtualPsbtResponse{
+		PartialSignature: partialSig,
+	}, nil
+}
+
+// CombineMuSig2VirtualPsbt combines the local partial MuSig2 signature with the
+// partial signatures of all other signers into the witness of an input of a
+// funded virtual transaction and signs all other inputs.
+func (r *rpcServer) CombineMuSig2VirtualPsbt(ctx context.Context,
+	req *wrpc.CombineMuSig2VirtualPsbtRequest) (
+	*wrpc.SignVirtualPsbtResponse, error) {
+
+	sessionID, err := unmarshalMuSig2SessionID(req.SessionId)
 	if err != nil {
-		return nil, fmt.Errorf("cannot verify proof: %w", err)
+		return nil, err
 	}
 
-	inputAsset := lastSnapshot.Asset
-	inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
-		ctx, inputAsset.ID(), lastSnapshot.OutPoint,
-		inputAsset.GroupKey, &inputAsset.ScriptKey, false,
+	if req.FundedPsbt == nil {
+		return nil, fmt.Errorf("funded PSBT must be specified")
+	}
+	if len(req.OtherPartialSignatures) == 0 {
+		return nil, fmt.Errorf("no partial signatures specified")
+	}
+
+	vPkt, err := tappsbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching commitment: %w", err)
+		return nil, fmt.Errorf("error decoding packet: %w", err)
 	}
 
-	challengeWitness, err := r.cfg.AssetWallet.SignOwnershipProof(
-		inputCommitment.Asset.Copy(),
+	signedInputs, err := r.cfg.AssetWallet.MuSig2CombineVirtualPacket(
+		ctx, sessionID, vPkt, int(req.InputIndex),
+		req.OtherPartialSignatures,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error signing ownership proof: %w", err)
+		return nil, fmt.Errorf("error signing packet: %w", err)
 	}
 
-	lastProof, err := proofFile.LastProof()
-	if err != nil {
-		return nil, fmt.Errorf("error fetching last proof: %w", err)
+	var b bytes.Buffer
+	if err := vPkt.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing packet: %w", err)
 	}
 
-	lastProof.ChallengeWitness = challengeWitness
+	return &wrpc.SignVirtualPsbtResponse{
+		SignedPsbt:   b.Bytes(),
+		SignedInputs: signedInputs,
+	}, nil
+}
 
-	var buf bytes.Buffer
-	if err := lastProof.Encode(&buf); err != nil {
-		return nil, fmt.Errorf("error encoding proof file: %w", err)
+// unmarshalMuSig2SessionID parses the RPC MuSig2 session ID.
+func unmarshalMuSig2SessionID(rpcID []byte) ([32]byte, error) {
+	var sessionID [32]byte
+	if len(rpcID) != len(sessionID) {
+		return sessionID, fmt.Errorf("session ID must be %d bytes",
+			len(sessionID))
 	}
+	copy(sessionID[:], rpcID)
 
-	return &wrpc.ProveAssetOwnershipResponse{
-		ProofWithWitness: buf.Bytes(),
-	}, nil
+	return sessionID, nil
 }
 
-// VerifyAssetOwnership verifies the asset ownership proof embedded in the
-// given transition proof of an asset and returns true if the proof is valid.
-func (r *rpcServer) VerifyAssetOwnership(ctx context.Context,
-	req *wrpc.VerifyAssetOwnershipRequest) (*wrpc.VerifyAssetOwnershipResponse,
-	error) {
+// unmarshalMuSig2Nonces parses the RPC MuSig2 public nonces.
+func unmarshalMuSig2Nonces(
+	rpcNonces [][]byte) ([][musig2.PubNonceSize]byte, error) {
 
-	if len(req.ProofWithWitness) == 0 {
-		return nil, fmt.Errorf("a valid proof must be specified")
+	nonces := make([][musig2.PubNonceSize]byte, len(rpcNonces))
+	for idx, rpcNonce := range rpcNonces {
+		if len(rpcNonce) != musig2.PubNonceSize {
+			return nil, fmt.Errorf("public nonce %d must be %d "+
+				"bytes", idx, musig2.PubNonceSize)
+		}
+		copy(nonces[idx][:], rpcNonce)
 	}
 
-	p := &proof.Proof{}
-	err := p.Decode(bytes.NewReader(req.ProofWithWitness))
+	return nonces, nil
+}
+
+// CreateSwapPsbt creates, funds and partially signs the BTC level anchor
+// transaction of an atomic swap that commits to the signed virtual
+// transactions of both swap parties.
+func (r *rpcServer) CreateSwapPsbt(ctx context.Context,
+	req *wrpc.CreateSwapPsbtRequest) (*wrpc.SwapPsbtResponse, error) {
+
+	params, err := r.unmarshalSwapTerms(ctx, req.Terms)
 	if err != nil {
-		return nil, fmt.Errorf("cannot decode proof file: %w", err)
+		return nil, err
+	}
+
+	params.BtcOutputs, err = r.unmarshalSwapBtcOutputs(req.BtcOutputs)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate != nil {
+		params.FeeRate = *feeRate
+	} else {
+		params.FeeRate, err = r.cfg.ChainBridge.EstimateFee(
+			ctx, tapscript.SendConfTarget,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to estimate fee: %w",
+				err)
+		}
 	}
 
-	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
-	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
-	_, err = p.Verify(ctx, nil, headerVerifier, groupVerifier)
+	anchorPkt, err := r.cfg.AssetWallet.CreateSwapAnchor(ctx, params)
 	if err != nil {
-		return nil, fmt.Errorf("error verifying proof: %w", err)
+		return nil, fmt.Errorf("error creating swap anchor: %w", err)
 	}
 
-	return &wrpc.VerifyAssetOwnershipResponse{
-		ValidProof: true,
-	}, nil
+	return marshalSwapPsbt(anchorPkt)
 }
 
-// UniverseStats returns a set of aggregate statistics for the current state
-// of the Universe.
-func (r *rpcServer) UniverseStats(ctx context.Context,
-	_ *unirpc.StatsRequest) (*unirpc.StatsResponse, error) {
+// SignSwapPsbt verifies the anchor transaction of an atomic swap created by
+// the counterparty and signs the anchor inputs of the local virtual
+// transaction.
+func (r *rpcServer) SignSwapPsbt(ctx context.Context,
+	req *wrpc.SignSwapPsbtRequest) (*wrpc.SwapPsbtResponse, error) {
 
-	universeStats, err := r.cfg.UniverseStats.AggregateSyncStats(ctx)
+	params, err := r.unmarshalSwapTerms(ctx, req.Terms)
 	if err != nil {
 		return nil, err
 	}
 
-	return &unirpc.StatsResponse{
-		NumTotalAssets: int64(universeStats.NumTotalAssets),
-		NumTotalGroups: int64(universeStats.NumTotalGroups),
-		NumTotalSyncs:  int64(universeStats.NumTotalSyncs),
-		NumTotalProofs: int64(universeStats.NumTotalProofs),
-	}, nil
-}
-
-// marshalAssetSyncSnapshot maps a universe asset sync stat snapshot to the RPC
-// counterpart.
-func (r *rpcServer) marshalAssetSyncSnapshot(ctx context.Context,
-	a universe.AssetSyncSnapshot) *unirpc.AssetStatsSnapshot {
-
-	resp := &unirpc.AssetStatsSnapshot{
-		TotalSyncs:  int64(a.TotalSyncs),
-		TotalProofs: int64(a.TotalProofs),
-		GroupSupply: int64(a.GroupSupply),
-	}
-	rpcAsset := &unirpc.AssetStatsAsset{
-		AssetId:          a.AssetID[:],
-		GenesisPoint:     a.GenesisPoint.String(),
-		AssetName:        a.AssetName,
-		AssetType:        taprpc.AssetType(a.AssetType),
-		TotalSupply:      int64(a.TotalSupply),
-		GenesisHeight:    int32(a.GenesisHeight),
-		GenesisTimestamp: r.getBlockTimestamp(ctx, a.GenesisHeight),
+	anchorPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.AnchorPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding anchor PSBT: %w", err)
 	}
 
-	if a.GroupKey != nil {
-		resp.GroupKey = a.GroupKey.SerializeCompressed()
-		resp.GroupAnchor = rpcAsset
-	} else {
-		resp.Asset = rpcAsset
+	signedPkt, err := r.cfg.AssetWallet.SignSwapAnchor(
+		ctx, anchorPkt, params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error signing swap anchor: %w", err)
 	}
 
-	return resp
+	return marshalSwapPsbt(signedPkt)
 }
 
-// QueryAssetStats returns a set of statistics for a given set of assets.
-// Stats can be queried for all assets, or based on the: asset ID, name, or
-// asset type. Pagination is supported via the offset and limit params.
-// Results can also be sorted based on any of the main query params.
-func (r *rpcServer) QueryAssetStats(ctx context.Context,
-	req *unirpc.AssetStatsQuery) (*unirpc.UniverseAssetStats, error) {
+// PublishSwapPsbt verifies, finalizes and broadcasts the fully signed anchor
+// transaction of an atomic swap and logs the transfer of the local virtual
+// transaction, if there is one.
+func (r *rpcServer) PublishSwapPsbt(ctx context.Context,
+	req *wrpc.PublishSwapPsbtRequest) (*wrpc.PublishSwapPsbtResponse,
+	error) {
 
-	assetStats, err := r.cfg.UniverseStats.QuerySyncStats(
-		ctx, universe.SyncStatsQuery{
-			AssetNameFilter: req.AssetNameFilter,
-			AssetTypeFilter: func() *asset.Type {
-				switch req.AssetTypeFilter {
-				case unirpc.AssetTypeFilter_FILTER_ASSET_NORMAL:
-					return fn.Ptr(asset.Normal)
+	params, err := r.unmarshalSwapTerms(ctx, req.Terms)
+	if err != nil {
+		return nil, err
+	}
 
-				case unirpc.AssetTypeFilter_FILTER_ASSET_COLLECTIBLE:
-					return fn.Ptr(asset.Collectible)
+	anchorPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.AnchorPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding anchor PSBT: %w", err)
+	}
 
-				default:
-					return nil
-				}
-			}(),
-			AssetIDFilter: fn.ToArray[asset.ID](
-				req.AssetIdFilter,
-			),
-			SortBy:        universe.SyncStatsSort(req.SortBy),
-			SortDirection: universe.SortDirection(req.Direction),
-			Offset:        int(req.Offset),
-			Limit:         int(req.Limit),
-		},
+	anchorTx, err := r.cfg.AssetWallet.FinalizeSwapAnchor(
+		ctx, anchorPkt, params,
 	)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error finalizing swap anchor: %w", err)
 	}
 
-	resp := &unirpc.UniverseAssetStats{
-		AssetStats: make(
-			[]*unirpc.AssetStatsSnapshot, len(assetStats.SyncStats),
+	var finalTx bytes.Buffer
+	if err := anchorTx.FinalTx.Serialize(&finalTx); err != nil {
+		return nil, fmt.Errorf("error serializing anchor TX: %w", err)
+	}
+
+	// If we only pay BTC in this swap, there is no transfer for us to log
+	// and we can just broadcast the transaction.
+	if params.LocalVPkt == nil {
+		err := r.cfg.ChainBridge.PublishTransaction(
+			ctx, anchorTx.FinalTx,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error publishing anchor TX: %w",
+				err)
+		}
+
+		return &wrpc.PublishSwapPsbtResponse{
+			FinalTx: finalTx.Bytes(),
+		}, nil
+	}
+
+	resp, err := r.cfg.ChainPorter.RequestShipment(
+		tapfreighter.NewPreAnchoredParcel(
+			[]*tappsbt.VPacket{params.LocalVPkt},
+			[]tappsbt.InputCommitments{
+				params.LocalInputCommitments,
+			}, nil, params.RemoteVPkts, anchorTx,
 		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting delivery: %w", err)
 	}
-	for idx, snapshot := range assetStats.SyncStats {
-		resp.AssetStats[idx] = r.marshalAssetSyncSnapshot(ctx, snapshot)
+
+	parcel, err := marshalOutboundParcel(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling outbound parcel: %w",
+			err)
 	}
 
-	return resp, nil
+	return &wrpc.PublishSwapPsbtResponse{
+		FinalTx:  finalTx.Bytes(),
+		Transfer: parcel,
+	}, nil
 }
 
-// getBlockTimestamp returns the timestamp of the block at the given height.
-func (r *rpcServer) getBlockTimestamp(ctx context.Context,
-	height uint32) int64 {
+// unmarshalSwapTerms parses the RPC swap terms and verifies the provenance of
+// all the inputs of the counterparty's virtual transactions.
+func (r *rpcServer) unmarshalSwapTerms(ctx context.Context,
+	terms *wrpc.SwapTerms) (*tapfreighter.SwapParams, error) {
 
-	// Shortcut any lookup in case we don't have a valid height in the first
-	// place.
-	if height == 0 {
-		return 0
+	if terms == nil {
+		return nil, fmt.Errorf("swap terms must be specified")
 	}
 
-	cacheTS, err := r.blockTimestampCache.Get(height)
-	if err == nil {
-		return int64(cacheTS)
+	params := &tapfreighter.SwapParams{}
+	if len(terms.LocalVirtualPsbt) > 0 {
+		vPkt, err := tappsbt.NewFromRawBytes(
+			bytes.NewReader(terms.LocalVirtualPsbt), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding local packet: "+
+				"%w", err)
+		}
+
+		params.LocalVPkt = vPkt
+		params.LocalInputCommitments = make(
+			tappsbt.InputCommitments, len(vPkt.Inputs),
+		)
+		for idx := range vPkt.Inputs {
+			inputAsset := vPkt.Inputs[idx].Asset()
+			prevID := vPkt.Inputs[idx].PrevID
+			inputCommitment, err := r.cfg.AssetStore.FetchCommitment(
+				ctx, inputAsset.ID(), prevID.OutPoint,
+				inputAsset.GroupKey, &inputAsset.ScriptKey,
+				true,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching input "+
+					"commitment: %w", err)
+			}
+
+			params.LocalInputCommitments[idx] =
+				inputCommitment.Commitment
+		}
 	}
 
-	hash, err := r.cfg.Lnd.ChainKit.GetBlockHash(ctx, int64(height))
-	if err != nil {
-		return 0
+	var remoteInputs []*tappsbt.VInput
+	for idx := range terms.RemoteVirtualPsbts {
+		vPkt, err := tappsbt.NewFromRawBytes(
+			bytes.NewReader(terms.RemoteVirtualPsbts[idx]), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding remote packet "+
+				"%d: %w", idx, err)
+		}
+
+		params.RemoteVPkts = append(params.RemoteVPkts, vPkt)
+		remoteInputs = append(remoteInputs, vPkt.Inputs...)
 	}
 
-	block, err := r.cfg.Lnd.ChainKit.GetBlock(ctx, hash)
-	if err != nil {
-		return 0
+	// We need to make sure the assets we receive have a valid provenance,
+	// which the inclusion proofs in the virtual packets alone can't show.
+	if len(terms.RemoteInputProofs) != len(remoteInputs) {
+		return nil, fmt.Errorf("expected %d remote input proofs, got "+
+			"%d", len(remoteInputs), len(terms.RemoteInputProofs))
 	}
 
-	ts := uint32(block.Header.Timestamp.Unix())
-	_, _ = r.blockTimestampCache.Put(height, cacheableTimestamp(ts))
+	headerVerifier := tapgarden.GenHeaderVerifier(ctx, r.cfg.ChainBridge)
+	medianTimeSource := tapgarden.GenMedianTimeSource(
+		ctx, r.cfg.ChainBridge,
+	)
+	groupVerifier := tapgarden.GenGroupVerifier(ctx, r.cfg.MintingStore)
+	for idx, vIn := range remoteInputs {
+		var proofFile proof.File
+		err := proofFile.Decode(
+			bytes.NewReader(terms.RemoteInputProofs[idx]),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode remote input "+
+				"proof %d: %w", idx, err)
+		}
 
-	return int64(ts)
-}
+		snapshot, err := proofFile.Verify(
+			ctx, headerVerifier, medianTimeSource, groupVerifier,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid remote input proof "+
+				"%d: %w", idx, err)
+		}
 
-// QueryEvents returns the number of sync and proof events for a given time
-// period, grouped by day.
-func (r *rpcServer) QueryEvents(ctx context.Context,
-	req *unirpc.QueryEventsRequest) (*unirpc.QueryEventsResponse, error) {
+		inputAsset := vIn.Asset()
+		if snapshot.OutPoint != vIn.PrevID.OutPoint ||
+			snapshot.Asset.ID() != inputAsset.ID() ||
+			snapshot.Asset.Amount != inputAsset.Amount ||
+			!snapshot.Asset.ScriptKey.PubKey.IsEqual(
+				inputAsset.ScriptKey.PubKey,
+			) {
 
-	// If no start or end time is specified, default to the last 30 days.
-	var (
-		startTime = time.Now().AddDate(0, 0, -30)
-		endTime   = time.Now()
-	)
-	if req.StartTimestamp > 0 {
-		startTime = time.Unix(req.StartTimestamp, 0)
-	}
-	if req.EndTimestamp > 0 {
-		endTime = time.Unix(req.EndTimestamp, 0)
+			return nil, fmt.Errorf("remote input proof %d doesn't "+
+				"match remote input", idx)
+		}
 	}
 
-	if endTime.Before(startTime) {
-		return nil, fmt.Errorf("end time cannot be before start time")
+	tapParams := address.ParamsForChain(r.cfg.ChainParams.Name)
+	for idx := range terms.ExpectedAddrs {
+		addr, err := address.DecodeAddress(
+			terms.ExpectedAddrs[idx], &tapParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode expected "+
+				"addr %d: %w", idx, err)
+		}
+
+		params.ExpectedAddrs = append(params.ExpectedAddrs, addr)
 	}
 
-	stats, err := r.cfg.UniverseStats.QueryAssetStatsPerDay(
-		ctx, universe.GroupedStatsQuery{
-			StartTime: startTime,
-			EndTime:   endTime,
-		},
+	var err error
+	params.ExpectedBtcOutputs, err = r.unmarshalSwapBtcOutputs(
+		terms.ExpectedBtcOutputs,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error querying stats: %w", err)
+		return nil, err
 	}
 
-	rpcStats := &unirpc.QueryEventsResponse{
-		Events: make([]*unirpc.GroupedUniverseEvents, len(stats)),
+	if terms.ChangeOutput != nil {
+		changeOutputs, err := r.unmarshalSwapBtcOutputs(
+			[]*wrpc.SwapBtcOutput{terms.ChangeOutput},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid change output: %w", err)
+		}
+
+		params.ChangeOutput = changeOutputs[0]
 	}
-	for day, s := range stats {
-		rpcStats.Events[day] = &unirpc.GroupedUniverseEvents{
-			Date:           s.Date,
-			SyncEvents:     s.NumTotalSyncs,
-			NewProofEvents: s.NumTotalProofs,
+
+	if terms.RemoteChangeOutput != nil {
+		changeOutputs, err := r.unmarshalSwapBtcOutputs(
+			[]*wrpc.SwapBtcOutput{terms.RemoteChangeOutput},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid remote change output: "+
+				"%w", err)
 		}
+
+		params.RemoteChangeOutput = changeOutputs[0]
 	}
 
-	return rpcStats, nil
+	return params, nil
 }
 
-// RemoveUTXOLease removes the lease/lock/reservation of the given managed
-// UTXO.
-func (r *rpcServer) RemoveUTXOLease(ctx context.Context,
-	req *wrpc.RemoveUTXOLeaseRequest) (*wrpc.RemoveUTXOLeaseResponse,
+// unmarshalSwapBtcOutputs parses the RPC swap BTC outputs.
+func (r *rpcServer) unmarshalSwapBtcOutputs(
+	rpcOutputs []*wrpc.SwapBtcOutput) ([]*tapfreighter.SwapBtcOutput,
 	error) {
 
-	if req.Outpoint == nil {
-		return nil, fmt.Errorf("outpoint must be specified")
-	}
+	outputs := make([]*tapfreighter.SwapBtcOutput, len(rpcOutputs))
+	for idx, rpcOut := range rpcOutputs {
+		addr, err := btcutil.DecodeAddress(
+			rpcOut.Address, &r.cfg.ChainParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode BTC address "+
+				"%d: %w", idx, err)
+		}
 
-	hash, err := chainhash.NewHash(req.Outpoint.Txid)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing txid: %w", err)
-	}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create script for "+
+				"BTC address %d: %w", idx, err)
+		}
 
-	outPoint := wire.OutPoint{
-		Hash:  *hash,
-		Index: req.Outpoint.OutputIndex,
+		outputs[idx] = &tapfreighter.SwapBtcOutput{
+			TxOut: &wire.TxOut{
+				Value:    int64(rpcOut.AmountSat),
+				PkScript: pkScript,
+			},
+		}
+
+		// P2TR outputs need their internal key, so we can create
+		// exclusion proofs for them.
+		_, isTaproot := addr.(*btcutil.AddressTaproot)
+		switch {
+		case isTaproot && len(rpcOut.InternalKey) == 0:
+			return nil, fmt.Errorf("BTC output %d is a P2TR "+
+				"output but has no internal key", idx)
+
+		case !isTaproot && len(rpcOut.InternalKey) > 0:
+			return nil, fmt.Errorf("BTC output %d has an internal "+
+				"key but is not a P2TR output", idx)
+
+		case !isTaproot:
+			continue
+		}
+
+		internalKey, err := schnorr.ParsePubKey(rpcOut.InternalKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid internal key of BTC "+
+				"output %d: %w", idx, err)
+		}
+
+		bip86Script, err := tapscript.PayToTaprootScript(
+			txscript.ComputeTaprootKeyNoScript(internalKey),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(bip86Script, pkScript) {
+			return nil, fmt.Errorf("BTC output %d is not a "+
+				"BIP-0086 output of its internal key", idx)
+		}
+
+		outputs[idx].InternalKey = internalKey
 	}
 
-	err = r.cfg.CoinSelect.ReleaseCoins(ctx, outPoint)
-	if err != nil {
-		return nil, err
+	return outputs, nil
+}
+
+// marshalSwapPsbt serializes the anchor PSBT of an atomic swap into the RPC
+// response.
+func marshalSwapPsbt(anchorPkt *psbt.Packet) (*wrpc.SwapPsbtResponse,
+	error) {
+
+	var b bytes.Buffer
+	if err := anchorPkt.Serialize(&b); err != nil {
+		return nil, fmt.Errorf("error serializing anchor PSBT: %w", err)
 	}
 
-	return &wrpc.RemoveUTXOLeaseResponse{}, nil
+	return &wrpc.SwapPsbtResponse{
+		AnchorPsbt: b.Bytes(),
+	}, nil
 }
 
 // MarshalAssetFedSyncCfg returns an RPC ready asset specific federation sync
@@ -151,11 +151,27 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 		return fmt.Errorf("unable to start chain porter: %v", err)
 	}
 
+	if err := s.cfg.UniverseEvents.Start(); err != nil {
+		return fmt.Errorf("unable to start universe event "+
+			"notifier: %v", err)
+	}
+
+	if err := s.cfg.UniverseGossiper.Start(); err != nil {
+		return fmt.Errorf("unable to start universe gossiper: %v", err)
+	}
+
 	if err := s.cfg.UniverseFederation.Start(); err != nil {
 		return fmt.Errorf("unable to start universe "+
 			"federation: %v", err)
 	}
 
+	if s.cfg.UniverseCanonical != nil {
+		if err := s.cfg.UniverseCanonical.Start(); err != nil {
+			return fmt.Errorf("unable to start canonical "+
+				"universe: %v", err)
+		}
+	}
+
 	if s.cfg.UniversePublicAccess {
 		err := s.cfg.UniverseFederation.SetAllowPublicAccess()
 		if err != nil {
@@ -238,6 +254,7 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 	macaroonWhitelist := perms.MacaroonWhitelist(
 		s.cfg.RPCConfig.AllowPublicUniProofCourier,
 		s.cfg.RPCConfig.AllowPublicStats,
+		s.cfg.RPCConfig.AllowPublicFederationList,
 	)
 
 	// Create a new RPC interceptor that we'll add to the GRPC server. This
@@ -568,6 +585,20 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if err := s.cfg.UniverseGossiper.Stop(); err != nil {
+		return err
+	}
+
+	if err := s.cfg.UniverseEvents.Stop(); err != nil {
+		return err
+	}
+
+	if s.cfg.UniverseCanonical != nil {
+		if err := s.cfg.UniverseCanonical.Stop(); err != nil {
+			return err
+		}
+	}
+
 	if s.macaroonService != nil {
 		err := s.macaroonService.Stop()
 		if err != nil {
@@ -23,6 +23,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -115,6 +116,10 @@ const (
 	// wait for before considering a transaction safely buried in the chain.
 	defaultReOrgSafeDepth = 6
 
+	// defaultCoinSelectStrategy is the default strategy used to select
+	// asset coins to fund a transfer.
+	defaultCoinSelectStrategy = "max-amount"
+
 	// defaultUniverseMaxQps is the default maximum number of queries per
 	// second for the universe server. This permis 100 queries per second
 	// by default.
@@ -124,6 +129,14 @@ const (
 	// universe queries. By default we'll allow 100 qps, with a max burst
 	// of 10 queries.
 	defaultUniverseQueriesBurst = 10
+
+	// defaultUniverseGossipRelayRate is the default maximum number of proof
+	// announcements per second we'll send to a single federation member.
+	defaultUniverseGossipRelayRate = 10
+
+	// defaultUniverseGossipRelayBurst is the default burst budget for the
+	// proof announcements sent to a single federation member.
+	defaultUniverseGossipRelayBurst = 50
 )
 
 var (
@@ -226,6 +239,7 @@ type RpcConfig struct {
 
 	AllowPublicUniProofCourier bool `long:"allow-public-uni-proof-courier" description:"Disable macaroon authentication for universe proof courier RPC endpoints."`
 	AllowPublicStats           bool `long:"allow-public-stats" description:"Disable macaroon authentication for stats RPC endpoints."`
+	AllowPublicFederationList  bool `long:"allow-public-federation-list" description:"Disable macaroon authentication for the RPC endpoint that lists the universe federation servers, so other universe servers can discover them."`
 
 	RestCORS []string `long:"restcors" description:"Add an ip:port/hostname to allow cross origin access from. To allow all origins, set as \"*\"."`
 
@@ -268,6 +282,18 @@ type UniverseConfig struct {
 	UniverseQueriesPerSecond rate.Limit `long:"max-qps" description:"The maximum number of queries per second across the set of active universe queries that is permitted. Anything above this starts to get rate limited."`
 
 	UniverseQueriesBurst int `long:"req-burst-budget" description:"The burst budget for the universe query rate limiting."`
+
+	GossipHops uint32 `long:"gossip-hops" description:"The number of times a new proof is relayed between federation members after it was first announced. Announcements received from other servers are relayed at most this many times as well. If zero, new proofs are only pushed to the direct federation members."`
+
+	GossipRelayRate rate.Limit `long:"gossip-relay-rate" description:"The maximum number of proof announcements per second that are sent to a single federation member. Anything above this is dropped and picked up by the next sync instead."`
+
+	GossipRelayBurst int `long:"gossip-relay-burst" description:"The burst budget for the proof announcements sent to a single federation member."`
+
+	CommitInterval time.Duration `long:"commit-interval" description:"If set, the root of the issuance multiverse is anchored in the chain once per interval if it changed since the last commitment. Each commitment is a new on-chain transaction. Disabled if zero."`
+
+	EventRetention time.Duration `long:"event-retention" description:"The amount of time universe events are kept in the event log before they're pruned. Subscribers can't resume from pruned events. If zero, events are never pruned."`
+
+	MaxEventReplay uint64 `long:"max-event-replay" description:"The maximum number of logged universe events matching its filter that are replayed to a new subscriber. Subscriptions that resume from a cursor that lies further back are rejected. If zero, the number of replayed events isn't limited."`
 }
 
 // AddressConfig is the config that houses any address Book related config
@@ -297,6 +323,10 @@ type Config struct {
 
 	ReOrgSafeDepth int32 `long:"reorgsafedepth" description:"The number of confirmations we'll wait for before considering a transaction safely buried in the chain."`
 
+	CoinSelectStrategy string `long:"coinselectstrategy" description:"The default strategy used to select the asset coins that fund a transfer, if a request doesn't specify one." choice:"max-amount" choice:"min-amount" choice:"exact-match" choice:"oldest" choice:"single-anchor"`
+
+	WatchOnly bool `long:"watchonly" description:"Run in watch-only mode: tapd tracks assets and builds virtual and anchor transactions but never signs them. All signing is done by an external signer, using the CommitVirtualPsbts and PublishAndLogTransfer RPCs of the asset wallet."`
+
 	// The following options are used to configure the proof courier.
 	DefaultProofCourierAddr string                    `long:"proofcourieraddr" description:"Default proof courier service address."`
 	HashMailCourier         *proof.HashMailCourierCfg `group:"proofcourier" namespace:"hashmailcourier"`
@@ -374,6 +404,7 @@ func DefaultConfig() Config {
 		Prometheus:              monitoring.DefaultPrometheusConfig(),
 		BatchMintingInterval:    defaultBatchMintingInterval,
 		ReOrgSafeDepth:          defaultReOrgSafeDepth,
+		CoinSelectStrategy:      defaultCoinSelectStrategy,
 		DefaultProofCourierAddr: defaultProofCourierAddr,
 		HashMailCourier: &proof.HashMailCourierCfg{
 			ReceiverAckTimeout: defaultProofTransferReceiverAckTimeout,
@@ -390,6 +421,13 @@ func DefaultConfig() Config {
 				defaultUniverseMaxQps,
 			),
 			UniverseQueriesBurst: defaultUniverseQueriesBurst,
+			GossipHops:           universe.DefaultGossipHops,
+			GossipRelayRate: rate.Limit(
+				defaultUniverseGossipRelayRate,
+			),
+			GossipRelayBurst: defaultUniverseGossipRelayBurst,
+			EventRetention:   universe.DefaultEventRetention,
+			MaxEventReplay:   universe.DefaultMaxEventReplay,
 		},
 		AddrBook: &AddrBookConfig{
 			DisableSyncer: false,
@@ -33,6 +33,13 @@ type databaseBackend interface {
 	WithTx(tx *sql.Tx) *sqlc.Queries
 }
 
+// anchorWallet is an interface that contains all methods the minting and
+// sending sub systems require from the wallet that anchors assets on-chain.
+type anchorWallet interface {
+	tapgarden.WalletAnchor
+	tapfreighter.WalletAnchor
+}
+
 // genServerConfig generates a server config from the given tapd config.
 //
 // NOTE: The RPCConfig and SignalInterceptor fields must be set by the caller
@@ -95,7 +102,10 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	)
 
 	keyRing := tap.NewLndRpcKeyRing(lndServices)
-	walletAnchor := tap.NewLndRpcWalletAnchor(lndServices)
+	var walletAnchor anchorWallet = tap.NewLndRpcWalletAnchor(lndServices)
+	if cfg.WatchOnly {
+		walletAnchor = tap.NewWatchOnlyWalletAnchor(lndServices)
+	}
 	chainBridge := tap.NewLndRpcChainBridge(lndServices)
 
 	assetStore := tapdb.NewAssetStore(assetDB, defaultClock)
@@ -130,9 +140,27 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		uniStatsDB, defaultClock, statsOpts...,
 	)
 
+	uniEventLogDB := tapdb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) tapdb.UniverseEventLogStore {
+			return db.WithTx(tx)
+		},
+	)
+	universeEvents := universe.NewEventNotifier(
+		universe.EventNotifierConfig{
+			EventLog: tapdb.NewUniverseEventLogDB(
+				uniEventLogDB, defaultClock,
+			),
+			Retention: cfg.Universe.EventRetention,
+			MaxReplay: cfg.Universe.MaxEventReplay,
+		},
+	)
+
 	headerVerifier := tapgarden.GenHeaderVerifier(
 		context.Background(), chainBridge,
 	)
+	medianTimeSource := tapgarden.GenMedianTimeSource(
+		context.Background(), chainBridge,
+	)
 	groupVerifier := tapgarden.GenGroupVerifier(
 		context.Background(), assetMintingStore,
 	)
@@ -142,10 +170,12 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				uniDB, id,
 			)
 		},
-		HeaderVerifier: headerVerifier,
-		GroupVerifier:  groupVerifier,
-		Multiverse:     multiverse,
-		UniverseStats:  universeStats,
+		HeaderVerifier:   headerVerifier,
+		MedianTimeSource: medianTimeSource,
+		GroupVerifier:    groupVerifier,
+		Multiverse:       multiverse,
+		UniverseStats:    universeStats,
+		Events:           universeEvents,
 	}
 
 	federationStore := tapdb.NewTransactionExecutor(db,
@@ -278,6 +308,15 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		NewRemoteDiffEngine: tap.NewRpcUniverseDiff,
 		LocalRegistrar:      baseUni,
 		SyncBatchSize:       defaultUniverseSyncBatchSize,
+		Events:              universeEvents,
+	})
+
+	universeSnapshotter := universe.NewSnapshotter(universe.SnapshotConfig{
+		LocalSnapshots:  multiverse,
+		LocalDiffEngine: baseUni,
+		LocalRegistrar:  baseUni,
+		BatchSize:       defaultUniverseSyncBatchSize,
+		StagingDir:      cfg.networkDir,
 	})
 
 	var runtimeIDBytes [8]byte
@@ -287,13 +326,23 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	}
 
 	runtimeID := int64(binary.BigEndian.Uint64(runtimeIDBytes[:]))
+	universeGossiper := universe.NewGossiper(universe.GossipConfig{
+		LocalRegistrar:      baseUni,
+		FederationDB:        federationDB,
+		NewRemoteGossipPeer: tap.NewRpcUniverseGossipPeer,
+		NewRemoteRegistrar:  tap.NewRpcUniverseRegistrar,
+		MaxHops:             cfg.Universe.GossipHops,
+		DiscoveryDepth:      universe.DefaultDiscoveryDepth,
+		RelayRate:           cfg.Universe.GossipRelayRate,
+		RelayBurst:          cfg.Universe.GossipRelayBurst,
+	})
 	universeFederation := universe.NewFederationEnvoy(
 		universe.FederationConfig{
 			FederationDB:            federationDB,
 			UniverseSyncer:          universeSyncer,
 			LocalRegistrar:          baseUni,
 			SyncInterval:            cfg.Universe.SyncInterval,
-			NewRemoteRegistrar:      tap.NewRpcUniverseRegistrar,
+			Gossiper:                universeGossiper,
 			StaticFederationMembers: federationMembers,
 			ServerChecker: func(addr universe.ServerAddr) error {
 				return tap.CheckFederationServer(
@@ -305,6 +354,34 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		},
 	)
 
+	// If enabled, we'll periodically anchor the issuance multiverse root
+	// in the chain.
+	var universeCanonical *universe.CanonicalUniverse
+	if cfg.Universe.CommitInterval != 0 {
+		commitmentStore := tapdb.NewTransactionExecutor(db,
+			func(tx *sql.Tx) tapdb.UniverseCommitmentStore {
+				return db.WithTx(tx)
+			},
+		)
+
+		universeCanonical = universe.NewCanonicalUniverse(
+			universe.CanonicalConfig{
+				Multiverse: multiverse,
+				ChainCommitter: tapgarden.NewUniverseCommitter(
+					tapgarden.UniverseCommitterConfig{
+						Wallet:      walletAnchor,
+						ChainBridge: chainBridge,
+						KeyRing:     keyRing,
+					},
+				),
+				CommitmentStore: tapdb.NewUniverseCommitmentDB(
+					commitmentStore,
+				),
+				CommitInterval: cfg.Universe.CommitInterval,
+			},
+		)
+	}
+
 	addrBookConfig := address.BookConfig{
 		Store:        tapdbAddrBook,
 		Syncer:       universeFederation,
@@ -317,14 +394,30 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	}
 	addrBook := address.NewBook(addrBookConfig)
 
-	virtualTxSigner := tap.NewLndRpcVirtualTxSigner(lndServices)
+	var virtualTxSigner tapscript.Signer = tap.NewLndRpcVirtualTxSigner(
+		lndServices,
+	)
+	if cfg.WatchOnly {
+		cfgLogger.Infof("Running in watch-only mode, all " +
+			"transactions must be signed externally")
+		virtualTxSigner = tap.NewWatchOnlyVirtualTxSigner()
+	}
 	coinSelect := tapfreighter.NewCoinSelect(assetStore)
+	coinSelectStrategy, err := tapfreighter.ParseCoinSelectStrategy(
+		cfg.CoinSelectStrategy,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse coin selection "+
+			"strategy: %w", err)
+	}
+
 	assetWallet := tapfreighter.NewAssetWallet(&tapfreighter.WalletConfig{
 		CoinSelector: coinSelect,
 		AssetProofs:  proofArchive,
 		AddrBook:     tapdbAddrBook,
 		KeyRing:      keyRing,
 		Signer:       virtualTxSigner,
+		MuSig2Signer: lndServices.Signer,
 		TxValidator:  &tap.ValidatorV0{},
 		Wallet:       walletAnchor,
 		ChainParams:  &tapChainParams,
@@ -332,6 +425,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	return &tap.Config{
 		DebugLevel:   cfg.DebugLevel,
+		WatchOnly:    cfg.WatchOnly,
 		RuntimeID:    runtimeID,
 		Lnd:          lndServices,
 		ChainParams:  cfg.ActiveNetParams,
@@ -350,7 +444,10 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				ProofWatcher:          reOrgWatcher,
 				UniversePushBatchSize: defaultUniverseSyncBatchSize,
 			},
-			BatchTicker:  ticker.NewForce(cfg.BatchMintingInterval),
+			BatchTicker: ticker.NewForce(cfg.BatchMintingInterval),
+			ScheduleTicker: ticker.New(
+				tapgarden.DefaultScheduleInterval,
+			),
 			ProofUpdates: proofArchive,
 			ErrChan:      mainErrChan,
 		}),
@@ -370,13 +467,14 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 				ProofWatcher:    reOrgWatcher,
 			},
 		),
-		ChainBridge:             chainBridge,
-		AddrBook:                addrBook,
-		AddrBookDisableSyncer:   cfg.AddrBook.DisableSyncer,
-		DefaultProofCourierAddr: proofCourierAddr.Url(),
-		ProofArchive:            proofArchive,
-		AssetWallet:             assetWallet,
-		CoinSelect:              coinSelect,
+		ChainBridge:               chainBridge,
+		AddrBook:                  addrBook,
+		AddrBookDisableSyncer:     cfg.AddrBook.DisableSyncer,
+		DefaultProofCourierAddr:   proofCourierAddr.Url(),
+		ProofArchive:              proofArchive,
+		AssetWallet:               assetWallet,
+		CoinSelect:                coinSelect,
+		DefaultCoinSelectStrategy: coinSelectStrategy,
 		ChainPorter: tapfreighter.NewChainPorter(
 			&tapfreighter.ChainPorterConfig{
 				Signer:      virtualTxSigner,
@@ -399,6 +497,10 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		UniverseSyncer:           universeSyncer,
 		UniverseFederation:       universeFederation,
 		UniverseStats:            universeStats,
+		UniverseGossiper:         universeGossiper,
+		UniverseEvents:           universeEvents,
+		UniverseSnapshotter:      universeSnapshotter,
+		UniverseCanonical:        universeCanonical,
 		UniversePublicAccess:     cfg.Universe.PublicAccess,
 		UniverseQueriesPerSecond: cfg.Universe.UniverseQueriesPerSecond,
 		UniverseQueriesBurst:     cfg.Universe.UniverseQueriesBurst,
@@ -467,6 +569,7 @@ func CreateServerFromConfig(cfg *Config, cfgLogger btclog.Logger,
 		MacaroonPath:               cfg.RpcConf.MacaroonPath,
 		AllowPublicUniProofCourier: cfg.RpcConf.AllowPublicUniProofCourier,
 		AllowPublicStats:           cfg.RpcConf.AllowPublicStats,
+		AllowPublicFederationList:  cfg.RpcConf.AllowPublicFederationList,
 		LetsEncryptDir:             cfg.RpcConf.LetsEncryptDir,
 		LetsEncryptListen:          cfg.RpcConf.LetsEncryptListen,
 		LetsEncryptEmail:           cfg.RpcConf.LetsEncryptEmail,
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil/psbt"
@@ -18,6 +19,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"golang.org/x/exp/maps"
 )
 
@@ -53,6 +55,10 @@ type (
 	// AssetSeedlingTuple is used to look up the ID of a seedling.
 	AssetSeedlingTuple = sqlc.FetchSeedlingIDParams
 
+	// AssetSeedlingUpdate is used to update the amount, meta and group
+	// anchor of a seedling.
+	AssetSeedlingUpdate = sqlc.UpdateAssetSeedlingParams
+
 	// MintingBatchTuple is used to update a batch state based on the raw
 	// key.
 	MintingBatchTuple = sqlc.UpdateMintingBatchStateParams
@@ -116,6 +122,46 @@ type (
 	// NewAssetMeta wraps the params needed to insert a new asset meta on
 	// disk.
 	NewAssetMeta = sqlc.UpsertAssetMetaParams
+
+	// BatchScheduleUpdate is used to update the schedule of a batch.
+	BatchScheduleUpdate = sqlc.UpdateMintingBatchScheduleParams
+
+	// RecurringMint is a recurring mint stored on disk.
+	RecurringMint = sqlc.RecurringMint
+
+	// NewRecurringMint wraps the params needed to insert a new recurring
+	// mint on disk.
+	NewRecurringMint = sqlc.InsertRecurringMintParams
+
+	// RecurringMintUpdate is used to update the next trigger of a
+	// recurring mint.
+	RecurringMintUpdate = sqlc.UpdateRecurringMintParams
+
+	// FrozenSproutItem is used to insert a sprout of a frozen batch into
+	// the batch based on the batch key.
+	FrozenSproutItem = sqlc.InsertFrozenBatchSproutParams
+
+	// FrozenSprout is a sprout of a frozen batch stored on disk.
+	FrozenSprout = sqlc.FetchFrozenBatchSproutsRow
+
+	// ReplacedGenesisTxItem is used to store a genesis transaction of a
+	// batch that was replaced by a fee bump.
+	ReplacedGenesisTxItem = sqlc.InsertReplacedGenesisTxParams
+
+	// ReplacedGenesisTx is a replaced genesis transaction stored on disk.
+	ReplacedGenesisTx = sqlc.FetchReplacedGenesisTxnsRow
+
+	// ReplacedGenesisTxTuple is used to delete a replaced genesis
+	// transaction of a batch.
+	ReplacedGenesisTxTuple = sqlc.DeleteReplacedGenesisTxParams
+
+	// BatchFinalizeParamsItem is used to store the parameters a batch was
+	// finalized with based on the batch key.
+	BatchFinalizeParamsItem = sqlc.UpsertBatchFinalizeParamsParams
+
+	// BatchFinalizeParams are the finalize parameters of a batch stored on
+	// disk.
+	BatchFinalizeParams = sqlc.FetchBatchFinalizeParamsRow
 )
 
 // PendingAssetStore is a sub-set of the main sqlc.Querier interface that
@@ -173,6 +219,13 @@ type PendingAssetStore interface {
 	FetchSeedlingByID(ctx context.Context,
 		seedlingID int64) (AssetSeedling, error)
 
+	// UpdateAssetSeedling updates the amount, meta and group anchor of an
+	// existing seedling.
+	UpdateAssetSeedling(ctx context.Context, arg AssetSeedlingUpdate) error
+
+	// DeleteAssetSeedling deletes a seedling from its batch.
+	DeleteAssetSeedling(ctx context.Context, seedlingID int64) error
+
 	// BindMintingBatchWithTx adds the minting transaction to an existing
 	// batch.
 	BindMintingBatchWithTx(ctx context.Context, arg BatchChainUpdate) error
@@ -181,6 +234,65 @@ type PendingAssetStore interface {
 	// batch.
 	UpdateBatchGenesisTx(ctx context.Context, arg GenesisTxUpdate) error
 
+	// InsertFrozenBatchSprout inserts a sprout of a frozen batch that is
+	// waiting for externally signed group witnesses.
+	InsertFrozenBatchSprout(ctx context.Context,
+		arg FrozenSproutItem) error
+
+	// FetchFrozenBatchSprouts fetches the sprouts of the frozen batch with
+	// the given batch key.
+	FetchFrozenBatchSprouts(ctx context.Context,
+		rawKey []byte) ([]FrozenSprout, error)
+
+	// DeleteFrozenBatchSprouts deletes the sprouts of the frozen batch with
+	// the given batch key.
+	DeleteFrozenBatchSprouts(ctx context.Context, rawKey []byte) error
+
+	// InsertReplacedGenesisTx stores a genesis transaction of a batch that
+	// was replaced by a fee bump.
+	InsertReplacedGenesisTx(ctx context.Context,
+		arg ReplacedGenesisTxItem) error
+
+	// FetchReplacedGenesisTxns fetches all replaced genesis transactions
+	// of the batch with the given batch key.
+	FetchReplacedGenesisTxns(ctx context.Context,
+		rawKey []byte) ([]ReplacedGenesisTx, error)
+
+	// DeleteReplacedGenesisTx deletes a replaced genesis transaction of a
+	// batch.
+	DeleteReplacedGenesisTx(ctx context.Context,
+		arg ReplacedGenesisTxTuple) error
+
+	// UpsertBatchFinalizeParams stores the parameters a batch was
+	// finalized with.
+	UpsertBatchFinalizeParams(ctx context.Context,
+		arg BatchFinalizeParamsItem) error
+
+	// FetchBatchFinalizeParams fetches the parameters the batch with the
+	// given batch key was finalized with.
+	FetchBatchFinalizeParams(ctx context.Context,
+		rawKey []byte) (BatchFinalizeParams, error)
+
+	// UpdateMintingBatchSchedule updates the schedule of an existing
+	// minting batch.
+	UpdateMintingBatchSchedule(ctx context.Context,
+		arg BatchScheduleUpdate) error
+
+	// InsertRecurringMint inserts a new recurring mint and returns its
+	// primary key.
+	InsertRecurringMint(ctx context.Context, arg NewRecurringMint) (int64,
+		error)
+
+	// FetchRecurringMints fetches all recurring mints.
+	FetchRecurringMints(ctx context.Context) ([]RecurringMint, error)
+
+	// UpdateRecurringMint updates the next trigger and the number of
+	// minted epochs of a recurring mint.
+	UpdateRecurringMint(ctx context.Context, arg RecurringMintUpdate) error
+
+	// DeleteRecurringMint deletes the recurring mint with the given ID.
+	DeleteRecurringMint(ctx context.Context, id int64) error
+
 	// UpsertManagedUTXO inserts a new or updates an existing managed UTXO
 	// to disk and returns the primary key.
 	UpsertManagedUTXO(ctx context.Context, arg RawManagedUTXO) (int64,
@@ -190,6 +302,11 @@ type PendingAssetStore interface {
 	// that once confirmed will mint the asset.
 	AnchorPendingAssets(ctx context.Context, arg AssetAnchor) error
 
+	// ReAnchorManagedUTXO moves a managed UTXO to a new outpoint of a
+	// new anchor transaction.
+	ReAnchorManagedUTXO(ctx context.Context,
+		arg ManagedUTXOReAnchor) error
+
 	// UpsertChainTx inserts a new or updates an existing chain tx into the
 	// DB.
 	UpsertChainTx(ctx context.Context, arg ChainTxParams) (int64, error)
@@ -197,6 +314,9 @@ type PendingAssetStore interface {
 	// ConfirmChainTx confirms an existing chain tx.
 	ConfirmChainTx(ctx context.Context, arg ChainTxConf) error
 
+	// FetchChainTx fetches a chain tx from the DB.
+	FetchChainTx(ctx context.Context, txid []byte) (ChainTx, error)
+
 	// FetchAssetsForBatch fetches all the assets created by a particular
 	// batch.
 	FetchAssetsForBatch(ctx context.Context, rawKey []byte) ([]AssetSprout,
@@ -290,6 +410,7 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 			BatchID:          batchID,
 			HeightHint:       int32(newBatch.HeightHint),
 			CreationTimeUnix: newBatch.CreationTime.UTC(),
+			BatchName:        newBatch.Name,
 		}); err != nil {
 			return fmt.Errorf("unable to insert minting "+
 				"batch: %w", err)
@@ -322,6 +443,8 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 				AssetSupply:     int64(seedling.Amount),
 				AssetMetaID:     assetMetaID,
 				EmissionEnabled: seedling.EnableEmission,
+
+				GroupTapscriptRoot: seedling.GroupTapscriptRoot,
 			}
 
 			// If this seedling is being issued to an existing
@@ -353,6 +476,15 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 				dbSeedling.GroupAnchorID = sqlInt64(anchorID)
 			}
 
+			// If the group of this seedling is created with an
+			// external group key, we need to store that key, as
+			// it isn't derived from our key ring.
+			externalKey := seedling.ExternalGroupKey
+			if externalKey != nil {
+				keyBytes := externalKey.SerializeCompressed()
+				dbSeedling.ExternalGroupKey = keyBytes
+			}
+
 			err = q.InsertAssetSeedling(ctx, dbSeedling)
 			if err != nil {
 				return err
@@ -396,6 +528,8 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 				AssetSupply:     int64(seedling.Amount),
 				AssetMetaID:     assetMetaID,
 				EmissionEnabled: seedling.EnableEmission,
+
+				GroupTapscriptRoot: seedling.GroupTapscriptRoot,
 			}
 
 			// If this seedling is being issued to an existing
@@ -427,6 +561,15 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 				dbSeedling.GroupAnchorID = sqlInt64(anchorID)
 			}
 
+			// If the group of this seedling is created with an
+			// external group key, we need to store that key, as
+			// it isn't derived from our key ring.
+			externalKey := seedling.ExternalGroupKey
+			if externalKey != nil {
+				keyBytes := externalKey.SerializeCompressed()
+				dbSeedling.ExternalGroupKey = keyBytes
+			}
+
 			err = q.InsertAssetSeedlingIntoBatch(ctx, dbSeedling)
 			if err != nil {
 				return fmt.Errorf("unable to insert "+
@@ -438,6 +581,80 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 	})
 }
 
+// UpdateSeedling replaces the amount, meta and group anchor of a seedling in a
+// pending batch with those of the given seedling, which is identified by its
+// asset name.
+func (a *AssetMintingStore) UpdateSeedling(ctx context.Context,
+	batchKey *btcec.PublicKey, seedling *tapgarden.Seedling) error {
+
+	rawBatchKey := batchKey.SerializeCompressed()
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		seedlingID, err := fetchSeedlingID(
+			ctx, q, rawBatchKey, seedling.AssetName,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch seedling: %w", err)
+		}
+
+		assetMetaID, err := maybeUpsertAssetMeta(
+			ctx, q, nil, seedling.Meta,
+		)
+		if err != nil {
+			return err
+		}
+
+		update := AssetSeedlingUpdate{
+			AssetSupply: int64(seedling.Amount),
+			AssetMetaID: assetMetaID,
+			SeedlingID:  seedlingID,
+		}
+		if seedling.GroupAnchor != nil {
+			anchorID, err := fetchSeedlingID(
+				ctx, q, rawBatchKey, *seedling.GroupAnchor,
+			)
+			if err != nil {
+				return err
+			}
+
+			update.GroupAnchorID = sqlInt64(anchorID)
+		}
+
+		err = q.UpdateAssetSeedling(ctx, update)
+		if err != nil {
+			return fmt.Errorf("unable to update seedling: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// RemoveSeedling removes the seedling with the given asset name from a pending
+// batch.
+func (a *AssetMintingStore) RemoveSeedling(ctx context.Context,
+	batchKey *btcec.PublicKey, seedlingName string) error {
+
+	rawBatchKey := batchKey.SerializeCompressed()
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		seedlingID, err := fetchSeedlingID(
+			ctx, q, rawBatchKey, seedlingName,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch seedling: %w", err)
+		}
+
+		err = q.DeleteAssetSeedling(ctx, seedlingID)
+		if err != nil {
+			return fmt.Errorf("unable to delete seedling: %w", err)
+		}
+
+		return nil
+	})
+}
+
 // fetchSeedlingID attempts to fetch the ID for a seedling from a specific
 // batch. This is performed within the context of a greater DB transaction.
 func fetchSeedlingID(ctx context.Context, q PendingAssetStore,
@@ -510,6 +727,17 @@ func fetchAssetSeedlings(ctx context.Context, q PendingAssetStore,
 			seedling.GroupAnchor = &seedlingAnchor.AssetName
 		}
 
+		if len(dbSeedling.ExternalGroupKey) != 0 {
+			seedling.ExternalGroupKey, err = btcec.ParsePubKey(
+				dbSeedling.ExternalGroupKey,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse "+
+					"external group key: %w", err)
+			}
+		}
+		seedling.GroupTapscriptRoot = dbSeedling.GroupTapscriptRoot
+
 		if len(dbSeedling.MetaDataBlob) != 0 {
 			seedling.Meta = &proof.MetaReveal{
 				Data: dbSeedling.MetaDataBlob,
@@ -834,8 +1062,18 @@ func marshalMintingBatch(ctx context.Context, q PendingAssetStore,
 			},
 			PubKey: batchKey,
 		},
-		HeightHint:   uint32(dbBatch.HeightHint),
-		CreationTime: dbBatch.CreationTimeUnix.UTC(),
+		HeightHint:      uint32(dbBatch.HeightHint),
+		CreationTime:    dbBatch.CreationTimeUnix.UTC(),
+		ExternalFunding: dbBatch.ExternalFunding,
+		Name:            dbBatch.BatchName,
+		Schedule: tapgarden.BatchSchedule{
+			FinalizeHeight: extractSqlInt32[uint32](
+				dbBatch.FinalizeHeight,
+			),
+		},
+	}
+	if dbBatch.FinalizeTime.Valid {
+		batch.Schedule.FinalizeTime = dbBatch.FinalizeTime.Time.UTC()
 	}
 
 	batchState, err := tapgarden.NewBatchState(uint8(dbBatch.BatchState))
@@ -845,6 +1083,18 @@ func marshalMintingBatch(ctx context.Context, q PendingAssetStore,
 
 	batch.UpdateState(batchState)
 
+	// Once a batch is frozen, we'll also need the parameters it was
+	// finalized with to fund its genesis transaction.
+	if batchState != tapgarden.BatchStatePending {
+		batch.FinalizeParams, err = fetchFinalizeParams(
+			ctx, q, dbBatch.RawKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch finalize "+
+				"params: %w", err)
+		}
+	}
+
 	if dbBatch.MintingTxPsbt != nil {
 		genesisPkt, err := psbt.NewFromRawBytes(
 			bytes.NewReader(dbBatch.MintingTxPsbt), false,
@@ -858,6 +1108,35 @@ func marshalMintingBatch(ctx context.Context, q PendingAssetStore,
 				dbBatch.ChangeOutputIndex,
 			),
 		}
+
+		// Any genesis transaction that was replaced by a fee bump
+		// might still confirm, so we'll load them as well.
+		replacedTxns, err := q.FetchReplacedGenesisTxns(
+			ctx, dbBatch.RawKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch replaced "+
+				"genesis txns: %w", err)
+		}
+		for _, replacedTx := range replacedTxns {
+			replacedPkt, err := psbt.NewFromRawBytes(
+				bytes.NewReader(replacedTx.MintingTxPsbt),
+				false,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			batch.ReplacedGenesisPackets = append(
+				batch.ReplacedGenesisPackets,
+				&tapgarden.FundedPsbt{
+					Pkt: replacedPkt,
+					ChangeOutputIndex: batch.GenesisPacket.
+						ChangeOutputIndex,
+					ChainFees: replacedTx.ChainFees,
+				},
+			)
+		}
 	}
 
 	// Depending on what state this batch is in, we'll
@@ -874,6 +1153,21 @@ func marshalMintingBatch(ctx context.Context, q PendingAssetStore,
 		batch.Seedlings, err = fetchAssetSeedlings(
 			ctx, q, dbBatch.RawKey,
 		)
+		if err != nil {
+			return nil, err
+		}
+
+		// A frozen batch that has a genesis packet is waiting
+		// for externally signed group witnesses, so we'll also
+		// fetch its sprouts.
+		if batchState != tapgarden.BatchStateFrozen ||
+			batch.GenesisPacket == nil {
+
+			break
+		}
+
+		batch.FrozenSprouts, batch.UnsignedGroupWitnesses, err =
+			fetchFrozenSprouts(ctx, q, dbBatch.RawKey)
 
 	default:
 		batch.RootAssetCommitment, err = fetchAssetSprouts(
@@ -912,6 +1206,126 @@ func (a *AssetMintingStore) UpdateBatchState(ctx context.Context,
 	})
 }
 
+// FreezeMintingBatch moves a pending batch to the frozen state and stores the
+// parameters it was finalized with, so its genesis transaction is funded the
+// same way if the batch is resumed after a restart.
+func (a *AssetMintingStore) FreezeMintingBatch(ctx context.Context,
+	batchKey *btcec.PublicKey, params tapgarden.FinalizeParams) error {
+
+	rawBatchKey := batchKey.SerializeCompressed()
+	paramsItem := BatchFinalizeParamsItem{
+		RawKey:     rawBatchKey,
+		ConfTarget: int32(params.ConfTarget),
+	}
+	if params.FeeRate != nil {
+		paramsItem.FeeRate = sqlInt64(*params.FeeRate)
+	}
+	if len(params.GenesisInputs) != 0 {
+		var err error
+		paramsItem.GenesisInputs, err = encodeOutpoints(
+			params.GenesisInputs,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to encode genesis inputs: %w",
+				err)
+		}
+	}
+
+	if params.ExternalGenesisPsbt != nil {
+		var b bytes.Buffer
+		err := params.ExternalGenesisPsbt.Serialize(&b)
+		if err != nil {
+			return fmt.Errorf("unable to encode external genesis "+
+				"psbt: %w", err)
+		}
+
+		paramsItem.ExternalGenesisPsbt = b.Bytes()
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		err := q.UpdateMintingBatchState(ctx, BatchStateUpdate{
+			RawKey:     rawBatchKey,
+			BatchState: int16(tapgarden.BatchStateFrozen),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to freeze batch: %w", err)
+		}
+
+		err = q.UpsertBatchFinalizeParams(ctx, paramsItem)
+		if err != nil {
+			return fmt.Errorf("unable to store finalize params: %w",
+				err)
+		}
+
+		return nil
+	})
+}
+
+// fetchFinalizeParams fetches the parameters the batch with the given batch
+// key was finalized with. Batches that were finalized before these were
+// stored have no parameters, which means the defaults are used.
+func fetchFinalizeParams(ctx context.Context, q PendingAssetStore,
+	rawBatchKey []byte) (tapgarden.FinalizeParams, error) {
+
+	var params tapgarden.FinalizeParams
+	dbParams, err := q.FetchBatchFinalizeParams(ctx, rawBatchKey)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return params, nil
+
+	case err != nil:
+		return params, err
+	}
+
+	params.ConfTarget = uint32(dbParams.ConfTarget)
+	if dbParams.FeeRate.Valid {
+		feeRate := chainfee.SatPerKWeight(dbParams.FeeRate.Int64)
+		params.FeeRate = &feeRate
+	}
+	params.GenesisInputs, err = decodeOutpoints(dbParams.GenesisInputs)
+	if err != nil {
+		return params, fmt.Errorf("unable to decode genesis inputs: %w",
+			err)
+	}
+
+	if dbParams.ExternalGenesisPsbt != nil {
+		params.ExternalGenesisPsbt, err = psbt.NewFromRawBytes(
+			bytes.NewReader(dbParams.ExternalGenesisPsbt), false,
+		)
+		if err != nil {
+			return params, fmt.Errorf("unable to decode external "+
+				"genesis psbt: %w", err)
+		}
+	}
+
+	return params, nil
+}
+
+// UpdateBatchSchedule updates the schedule of a pending batch based on the
+// batch key.
+func (a *AssetMintingStore) UpdateBatchSchedule(ctx context.Context,
+	batchKey *btcec.PublicKey, schedule tapgarden.BatchSchedule) error {
+
+	update := BatchScheduleUpdate{
+		RawKey: batchKey.SerializeCompressed(),
+	}
+	if schedule.FinalizeHeight != 0 {
+		update.FinalizeHeight = sqlInt32(schedule.FinalizeHeight)
+	}
+	if !schedule.FinalizeTime.IsZero() {
+		update.FinalizeTime = sql.NullTime{
+			Time:  schedule.FinalizeTime.UTC(),
+			Valid: true,
+		}
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		return q.UpdateMintingBatchSchedule(ctx, update)
+	})
+}
+
 // encodeOutpoint encodes the outpoint point in Bitcoin wire format, returning
 // the final result.
 func encodeOutpoint(outPoint wire.OutPoint) ([]byte, error) {
@@ -924,12 +1338,45 @@ func encodeOutpoint(outPoint wire.OutPoint) ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+// encodeOutpoints encodes the given outpoints in Bitcoin wire format, one
+// after the other.
+func encodeOutpoints(outPoints []wire.OutPoint) ([]byte, error) {
+	var b bytes.Buffer
+	for i := range outPoints {
+		err := wire.WriteOutPoint(&b, 0, 0, &outPoints[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeOutpoints decodes a list of outpoints encoded with encodeOutpoints.
+func decodeOutpoints(b []byte) ([]wire.OutPoint, error) {
+	var (
+		r         = bytes.NewReader(b)
+		outPoints []wire.OutPoint
+	)
+	for r.Len() > 0 {
+		var outPoint wire.OutPoint
+		err := readOutPoint(r, 0, 0, &outPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		outPoints = append(outPoints, outPoint)
+	}
+
+	return outPoints, nil
+}
+
 // AddSproutsToBatch updates a batch with the passed batch transaction and also
 // binds the genesis transaction (which will create the set of assets in the
 // batch) to the batch itself.
 func (a *AssetMintingStore) AddSproutsToBatch(ctx context.Context,
 	batchKey *btcec.PublicKey, genesisPacket *tapgarden.FundedPsbt,
-	assetRoot *commitment.TapCommitment) error {
+	assetRoot *commitment.TapCommitment, externalFunding bool) error {
 
 	// Before we open the DB transaction below, we'll fetch the set of
 	// assets committed to within the root commitment specified.
@@ -957,6 +1404,14 @@ func (a *AssetMintingStore) AddSproutsToBatch(ctx context.Context,
 
 	var writeTxOpts AssetStoreTxOptions
 	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		// If the batch was waiting for externally signed group
+		// witnesses, its frozen sprouts are replaced by the assets.
+		err := q.DeleteFrozenBatchSprouts(ctx, rawBatchKey)
+		if err != nil {
+			return fmt.Errorf("unable to delete frozen sprouts: %w",
+				err)
+		}
+
 		genesisPointID, _, err := upsertAssetsWithGenesis(
 			ctx, q, genesisOutpoint, sortedAssets, nil,
 		)
@@ -978,7 +1433,8 @@ func (a *AssetMintingStore) AddSproutsToBatch(ctx context.Context,
 			ChangeOutputIndex: sqlInt32(
 				genesisPacket.ChangeOutputIndex,
 			),
-			GenesisID: sqlInt64(genesisPointID),
+			GenesisID:       sqlInt64(genesisPointID),
+			ExternalFunding: externalFunding,
 		})
 		if err != nil {
 			return fmt.Errorf("unable to add batch tx: %w", err)
@@ -992,6 +1448,257 @@ func (a *AssetMintingStore) AddSproutsToBatch(ctx context.Context,
 	})
 }
 
+// CommitFrozenSprouts stores the funded genesis packet and the sprouts of a
+// frozen batch that waits for group witnesses signed with an external group
+// key, together with the unsigned group witnesses. The batch stays frozen, but
+// can be resumed after a restart without funding a new genesis packet.
+func (a *AssetMintingStore) CommitFrozenSprouts(ctx context.Context,
+	batchKey *btcec.PublicKey, genesisPacket *tapgarden.FundedPsbt,
+	sprouts []*asset.Asset,
+	unsignedWitnesses []*tapgarden.UnsignedGroupWitness,
+	externalFunding bool) error {
+
+	// Each unsigned group witness is stored along with the sprout it
+	// belongs to.
+	sproutWitnesses := make(
+		map[asset.ID]*tapgarden.UnsignedGroupWitness,
+		len(unsignedWitnesses),
+	)
+	for _, unsignedWitness := range unsignedWitnesses {
+		sproutWitnesses[unsignedWitness.AssetID] = unsignedWitness
+	}
+
+	var psbtBuf bytes.Buffer
+	if err := genesisPacket.Pkt.Serialize(&psbtBuf); err != nil {
+		return fmt.Errorf("unable to encode psbt: %w", err)
+	}
+
+	genesisOutpoint := genesisPacket.Pkt.UnsignedTx.TxIn[0].PreviousOutPoint
+
+	rawBatchKey := batchKey.SerializeCompressed()
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		genesisPointID, err := upsertGenesisPoint(
+			ctx, q, genesisOutpoint,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to upsert genesis point: %w",
+				err)
+		}
+
+		// We bind the genesis packet to the batch, but leave the
+		// batch in the frozen state.
+		err = q.BindMintingBatchWithTx(ctx, BatchChainUpdate{
+			RawKey:        rawBatchKey,
+			MintingTxPsbt: psbtBuf.Bytes(),
+			ChangeOutputIndex: sqlInt32(
+				genesisPacket.ChangeOutputIndex,
+			),
+			GenesisID:       sqlInt64(genesisPointID),
+			ExternalFunding: externalFunding,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to add batch tx: %w", err)
+		}
+
+		// Any sprouts stored before are replaced.
+		err = q.DeleteFrozenBatchSprouts(ctx, rawBatchKey)
+		if err != nil {
+			return fmt.Errorf("unable to delete frozen sprouts: %w",
+				err)
+		}
+
+		for _, sprout := range sprouts {
+			err := insertFrozenSprout(
+				ctx, q, rawBatchKey, sprout,
+				sproutWitnesses[sprout.ID()],
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert frozen "+
+					"sprout %v: %w", sprout.Tag, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// insertFrozenSprout inserts a sprout of a frozen batch, along with its
+// unsigned group witness if it has one.
+func insertFrozenSprout(ctx context.Context, q PendingAssetStore,
+	rawBatchKey []byte, sprout *asset.Asset,
+	unsignedWitness *tapgarden.UnsignedGroupWitness) error {
+
+	var sproutBuf bytes.Buffer
+	if err := sprout.Encode(&sproutBuf); err != nil {
+		return fmt.Errorf("unable to encode sprout: %w", err)
+	}
+
+	// The encoded sprout only contains the tweaked keys, so we store the
+	// key descriptors of the script key and the group key separately.
+	scriptKey := sprout.ScriptKey.TweakedScriptKey
+	if scriptKey == nil {
+		return fmt.Errorf("sprout script key has no raw key")
+	}
+	scriptKeyID, err := q.UpsertInternalKey(ctx, InternalKey{
+		RawKey:    scriptKey.RawKey.PubKey.SerializeCompressed(),
+		KeyFamily: int32(scriptKey.RawKey.Family),
+		KeyIndex:  int32(scriptKey.RawKey.Index),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert script key: %w", err)
+	}
+
+	item := FrozenSproutItem{
+		RawKey:      rawBatchKey,
+		AssetSprout: sproutBuf.Bytes(),
+		ScriptKeyID: scriptKeyID,
+	}
+
+	if sprout.GroupKey != nil {
+		rawGroupKey := sprout.GroupKey.RawKey
+		if rawGroupKey.PubKey == nil {
+			return fmt.Errorf("sprout group key has no raw key")
+		}
+		groupKeyID, err := q.UpsertInternalKey(ctx, InternalKey{
+			RawKey:    rawGroupKey.PubKey.SerializeCompressed(),
+			KeyFamily: int32(rawGroupKey.Family),
+			KeyIndex:  int32(rawGroupKey.Index),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert group key: %w", err)
+		}
+
+		item.GroupKeyID = sqlInt64(groupKeyID)
+		item.GroupTapscriptRoot = sprout.GroupKey.TapscriptRoot
+	}
+
+	if unsignedWitness != nil {
+		var txBuf bytes.Buffer
+		err := unsignedWitness.VirtualTx.Serialize(&txBuf)
+		if err != nil {
+			return fmt.Errorf("unable to encode virtual tx: %w",
+				err)
+		}
+
+		item.SingleTweak = unsignedWitness.SingleTweak
+		item.VirtualTx = txBuf.Bytes()
+		item.PrevOutValue = sqlInt64(unsignedWitness.PrevOut.Value)
+		item.PrevOutScript = unsignedWitness.PrevOut.PkScript
+	}
+
+	return q.InsertFrozenBatchSprout(ctx, item)
+}
+
+// fetchFrozenSprouts fetches the sprouts of a frozen batch that is waiting for
+// externally signed group witnesses, along with the unsigned group witnesses.
+func fetchFrozenSprouts(ctx context.Context, q PendingAssetStore,
+	rawBatchKey []byte) ([]*asset.Asset,
+	[]*tapgarden.UnsignedGroupWitness, error) {
+
+	dbSprouts, err := q.FetchFrozenBatchSprouts(ctx, rawBatchKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch frozen sprouts: "+
+			"%w", err)
+	}
+
+	var (
+		sprouts           = make([]*asset.Asset, 0, len(dbSprouts))
+		unsignedWitnesses []*tapgarden.UnsignedGroupWitness
+	)
+	for _, dbSprout := range dbSprouts {
+		var sprout asset.Asset
+		err := sprout.Decode(bytes.NewReader(dbSprout.AssetSprout))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode "+
+				"sprout: %w", err)
+		}
+
+		// The script key of a sprout is always a BIP-0086 key, so we
+		// can derive it from the raw key again.
+		scriptKeyPub, err := btcec.ParsePubKey(dbSprout.ScriptKeyRaw)
+		if err != nil {
+			return nil, nil, err
+		}
+		sprout.ScriptKey = asset.NewScriptKeyBip86(
+			keychain.KeyDescriptor{
+				PubKey: scriptKeyPub,
+				KeyLocator: keychain.KeyLocator{
+					Family: keychain.KeyFamily(
+						dbSprout.ScriptKeyFamily,
+					),
+					Index: uint32(dbSprout.ScriptKeyIndex),
+				},
+			},
+		)
+
+		if sprout.GroupKey != nil {
+			rawGroupKey, err := btcec.ParsePubKey(
+				dbSprout.GroupKeyRaw,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			groupKeyFamily := extractSqlInt32[keychain.KeyFamily](
+				dbSprout.GroupKeyFamily,
+			)
+			sprout.GroupKey.RawKey = keychain.KeyDescriptor{
+				PubKey: rawGroupKey,
+				KeyLocator: keychain.KeyLocator{
+					Family: groupKeyFamily,
+					Index: extractSqlInt32[uint32](
+						dbSprout.GroupKeyIndex,
+					),
+				},
+			}
+			sprout.GroupKey.TapscriptRoot =
+				dbSprout.GroupTapscriptRoot
+		}
+
+		// The sprouts that need an externally signed group witness
+		// have their unsigned group witness stored along with them.
+		// The others already have their group witness, which is also
+		// stored as their genesis witness.
+		switch {
+		case dbSprout.VirtualTx != nil:
+			var virtualTx wire.MsgTx
+			err := virtualTx.Deserialize(
+				bytes.NewReader(dbSprout.VirtualTx),
+			)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to decode "+
+					"virtual tx: %w", err)
+			}
+
+			prevOut := &wire.TxOut{
+				Value:    dbSprout.PrevOutValue.Int64,
+				PkScript: dbSprout.PrevOutScript,
+			}
+			unsignedWitnesses = append(
+				unsignedWitnesses,
+				&tapgarden.UnsignedGroupWitness{
+					AssetName:   sprout.Tag,
+					AssetID:     sprout.ID(),
+					GroupKey:    *sprout.GroupKey,
+					SingleTweak: dbSprout.SingleTweak,
+					VirtualTx:   &virtualTx,
+					PrevOut:     prevOut,
+				},
+			)
+
+		case sprout.HasGenesisWitnessForGroup():
+			genesisWitness := sprout.PrevWitnesses[0].TxWitness
+			sprout.GroupKey.Witness = genesisWitness
+		}
+
+		sprouts = append(sprouts, &sprout)
+	}
+
+	return sprouts, unsignedWitnesses, nil
+}
+
 // CommitSignedGenesisTx binds a fully signed genesis transaction to a pending
 // batch on disk. The anchor output index and script root are also stored to
 // ensure we can reconstruct the private key needed to sign for the batch. The
@@ -1051,7 +1758,8 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 			MintingTxPsbt: psbtBuf.Bytes(),
 		})
 		if err != nil {
-			return fmt.Errorf("unable to update genesis tx: %w", err)
+			return fmt.Errorf("unable to update genesis tx: %w",
+				err)
 		}
 
 		// Before we can insert a managed UTXO, we'll need to insert a
@@ -1112,6 +1820,144 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 	})
 }
 
+// ReplaceGenesisTx replaces the fully signed genesis transaction of a batch
+// that was already broadcast with a transaction that spends the same genesis
+// input and creates the same anchor output, but pays a higher fee. The managed
+// UTXO of the batch and the genesis point are moved to the new transaction.
+// The replaced transaction is kept, as it might still confirm. Replacing the
+// genesis tx with one that was previously replaced reverts the batch to it.
+func (a *AssetMintingStore) ReplaceGenesisTx(ctx context.Context,
+	batchKey *btcec.PublicKey, genesisPkt *tapgarden.FundedPsbt,
+	anchorOutputIndex uint32) error {
+
+	var txBuf bytes.Buffer
+	newGenTx, err := psbt.Extract(genesisPkt.Pkt)
+	if err != nil {
+		return fmt.Errorf("unable to extract psbt packet: %w", err)
+	}
+	if err := newGenTx.Serialize(&txBuf); err != nil {
+		return err
+	}
+
+	var psbtBuf bytes.Buffer
+	if err := genesisPkt.Pkt.Serialize(&psbtBuf); err != nil {
+		return err
+	}
+
+	newGenTXID := newGenTx.TxHash()
+	newAnchorOutpoint, err := encodeOutpoint(wire.OutPoint{
+		Hash:  newGenTXID,
+		Index: anchorOutputIndex,
+	})
+	if err != nil {
+		return err
+	}
+
+	genesisPoint := genesisPkt.Pkt.UnsignedTx.TxIn[0].PreviousOutPoint
+	genesisOutpoint, err := encodeOutpoint(genesisPoint)
+	if err != nil {
+		return err
+	}
+
+	rawBatchKey := batchKey.SerializeCompressed()
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		// We need the replaced genesis transaction to find the managed
+		// UTXO it created.
+		dbBatch, err := q.FetchMintingBatch(ctx, rawBatchKey)
+		if err != nil {
+			return fmt.Errorf("unable to fetch batch: %w", err)
+		}
+		if dbBatch.MintingTxPsbt == nil {
+			return fmt.Errorf("batch has no genesis tx to replace")
+		}
+
+		oldGenesisPkt, err := psbt.NewFromRawBytes(
+			bytes.NewReader(dbBatch.MintingTxPsbt), false,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode genesis psbt: %w",
+				err)
+		}
+
+		oldGenesisPoint := oldGenesisPkt.UnsignedTx.TxIn[0].
+			PreviousOutPoint
+		if oldGenesisPoint != genesisPoint {
+			return fmt.Errorf("replacement genesis tx spends "+
+				"genesis point %v instead of %v", genesisPoint,
+				oldGenesisPoint)
+		}
+
+		oldGenTXID := oldGenesisPkt.UnsignedTx.TxHash()
+		oldAnchorOutpoint, err := encodeOutpoint(wire.OutPoint{
+			Hash:  oldGenTXID,
+			Index: anchorOutputIndex,
+		})
+		if err != nil {
+			return err
+		}
+
+		// The replaced genesis tx might still confirm, so we keep it
+		// around. If the new genesis tx is one we replaced earlier,
+		// it's no longer a replaced one.
+		oldGenTx, err := q.FetchChainTx(ctx, oldGenTXID[:])
+		if err != nil {
+			return fmt.Errorf("unable to fetch genesis tx: %w", err)
+		}
+		err = q.InsertReplacedGenesisTx(ctx, ReplacedGenesisTxItem{
+			RawKey:        rawBatchKey,
+			Txid:          oldGenTXID[:],
+			MintingTxPsbt: dbBatch.MintingTxPsbt,
+			ChainFees:     oldGenTx.ChainFees,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to store replaced genesis "+
+				"tx: %w", err)
+		}
+		err = q.DeleteReplacedGenesisTx(ctx, ReplacedGenesisTxTuple{
+			RawKey: rawBatchKey,
+			Txid:   newGenTXID[:],
+		})
+		if err != nil {
+			return fmt.Errorf("unable to delete replaced genesis "+
+				"tx: %w", err)
+		}
+
+		err = q.UpdateBatchGenesisTx(ctx, GenesisTxUpdate{
+			RawKey:        rawBatchKey,
+			MintingTxPsbt: psbtBuf.Bytes(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update genesis tx: %w", err)
+		}
+
+		chainTXID, err := q.UpsertChainTx(ctx, ChainTxParams{
+			Txid:      newGenTXID[:],
+			RawTx:     txBuf.Bytes(),
+			ChainFees: genesisPkt.ChainFees,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert chain tx: %w", err)
+		}
+
+		err = q.ReAnchorManagedUTXO(ctx, ManagedUTXOReAnchor{
+			NewOutpoint: newAnchorOutpoint,
+			NewTxnID:    chainTXID,
+			OldOutpoint: oldAnchorOutpoint,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to re-anchor managed "+
+				"utxo: %w", err)
+		}
+
+		return q.AnchorGenesisPoint(ctx, GenesisPointAnchor{
+			PrevOut:    genesisOutpoint,
+			AnchorTxID: sqlInt64(chainTXID),
+		})
+	})
+}
+
 // MarkBatchConfirmed stores final confirmation information for a batch on
 // disk.
 func (a *AssetMintingStore) MarkBatchConfirmed(ctx context.Context,
@@ -1206,6 +2052,137 @@ func (a *AssetMintingStore) FetchGroupByGroupKey(ctx context.Context,
 	return dbGroup, nil
 }
 
+// recurringMintTrigger returns the nullable next height and next time of a
+// recurring mint, only one of which is set depending on its kind of epoch.
+func recurringMintTrigger(mint *tapgarden.RecurringMint) (sql.NullInt32,
+	sql.NullTime) {
+
+	if mint.IntervalBlocks != 0 {
+		return sqlInt32(mint.NextHeight), sql.NullTime{}
+	}
+
+	return sql.NullInt32{}, sql.NullTime{
+		Time:  mint.NextTime.UTC(),
+		Valid: true,
+	}
+}
+
+// AddRecurringMint stores a new recurring mint on disk and returns its ID.
+func (a *AssetMintingStore) AddRecurringMint(ctx context.Context,
+	mint *tapgarden.RecurringMint) (int64, error) {
+
+	nextHeight, nextTime := recurringMintTrigger(mint)
+
+	var (
+		writeTxOpts AssetStoreTxOptions
+		id          int64
+	)
+	err := a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		var err error
+		id, err = q.InsertRecurringMint(ctx, NewRecurringMint{
+			AssetName:        mint.AssetName,
+			AssetSupply:      int64(mint.Amount),
+			GroupKey:         mint.GroupKey.SerializeCompressed(),
+			IntervalBlocks:   int32(mint.IntervalBlocks),
+			IntervalSeconds:  int64(mint.Interval / time.Second),
+			NextHeight:       nextHeight,
+			NextTime:         nextTime,
+			MaxEpochs:        int32(mint.MaxEpochs),
+			EpochsMinted:     int32(mint.EpochsMinted),
+			CreationTimeUnix: mint.CreationTime.UTC(),
+		})
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to insert recurring mint: %w", err)
+	}
+
+	return id, nil
+}
+
+// FetchRecurringMints fetches all recurring mints on disk.
+func (a *AssetMintingStore) FetchRecurringMints(
+	ctx context.Context) ([]*tapgarden.RecurringMint, error) {
+
+	var (
+		readOpts = NewAssetStoreReadTx()
+		mints    []*tapgarden.RecurringMint
+	)
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q PendingAssetStore) error {
+		dbMints, err := q.FetchRecurringMints(ctx)
+		if err != nil {
+			return err
+		}
+
+		mints = make([]*tapgarden.RecurringMint, 0, len(dbMints))
+		for _, dbMint := range dbMints {
+			groupKey, err := btcec.ParsePubKey(dbMint.GroupKey)
+			if err != nil {
+				return fmt.Errorf("unable to parse group "+
+					"key: %w", err)
+			}
+
+			mint := &tapgarden.RecurringMint{
+				ID:             dbMint.ID,
+				AssetName:      dbMint.AssetName,
+				Amount:         uint64(dbMint.AssetSupply),
+				GroupKey:       groupKey,
+				IntervalBlocks: uint32(dbMint.IntervalBlocks),
+				Interval: time.Duration(
+					dbMint.IntervalSeconds,
+				) * time.Second,
+				NextHeight: extractSqlInt32[uint32](
+					dbMint.NextHeight,
+				),
+				MaxEpochs:    uint32(dbMint.MaxEpochs),
+				EpochsMinted: uint32(dbMint.EpochsMinted),
+				CreationTime: dbMint.CreationTimeUnix.UTC(),
+			}
+			if dbMint.NextTime.Valid {
+				mint.NextTime = dbMint.NextTime.Time.UTC()
+			}
+
+			mints = append(mints, mint)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to fetch recurring mints: %w",
+			dbErr)
+	}
+
+	return mints, nil
+}
+
+// UpdateRecurringMint updates the next trigger and the number of minted epochs
+// of a recurring mint on disk.
+func (a *AssetMintingStore) UpdateRecurringMint(ctx context.Context,
+	mint *tapgarden.RecurringMint) error {
+
+	nextHeight, nextTime := recurringMintTrigger(mint)
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		return q.UpdateRecurringMint(ctx, RecurringMintUpdate{
+			NextHeight:   nextHeight,
+			NextTime:     nextTime,
+			EpochsMinted: int32(mint.EpochsMinted),
+			ID:           mint.ID,
+		})
+	})
+}
+
+// DeleteRecurringMint deletes the recurring mint with the given ID from disk.
+func (a *AssetMintingStore) DeleteRecurringMint(ctx context.Context,
+	id int64) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		return q.DeleteRecurringMint(ctx, id)
+	})
+}
+
 // A compile-time assertion to ensure that AssetMintingStore meets the
 // tapgarden.MintingStore interface.
 var _ tapgarden.MintingStore = (*AssetMintingStore)(nil)
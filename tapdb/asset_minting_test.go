@@ -24,6 +24,7 @@ import (
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/maps"
 )
@@ -550,7 +551,7 @@ func TestAddSproutsToBatch(t *testing.T) {
 		mintingBatch.Seedlings, seedlingGroups,
 	)
 	require.NoError(t, assetStore.AddSproutsToBatch(
-		ctx, batchKey, genesisPacket, assetRoot,
+		ctx, batchKey, genesisPacket, assetRoot, true,
 	))
 
 	// Now we'll query for that same batch, and assert that the set of
@@ -565,6 +566,9 @@ func TestAddSproutsToBatch(t *testing.T) {
 	assertPsbtEqual(t, genesisPacket, mintingBatches[0].GenesisPacket)
 	assertAssetsEqual(t, assetRoot, mintingBatches[0].RootAssetCommitment)
 
+	// The genesis packet was marked as externally funded.
+	require.True(t, mintingBatches[0].ExternalFunding)
+
 	// We also expect that for each of the assets we created above, we're
 	// able to obtain the asset meta for them all.
 	require.Len(t, mintingBatches[0].AssetMetas, numSeedlings)
@@ -614,7 +618,7 @@ func addRandAssets(t *testing.T, ctx context.Context,
 		mintingBatch.Seedlings, seedlingGroups,
 	)
 	require.NoError(t, assetStore.AddSproutsToBatch(
-		ctx, batchKey, genesisPacket, assetRoot,
+		ctx, batchKey, genesisPacket, assetRoot, false,
 	))
 
 	scriptRoot := assetRoot.TapscriptRoot(nil)
@@ -860,6 +864,506 @@ func TestCommitBatchChainActions(t *testing.T) {
 	}
 }
 
+// TestReplaceGenesisTx tests that we're able to replace the genesis
+// transaction of a broadcast batch, and that the managed UTXO and the genesis
+// point are moved to the replacement transaction.
+func TestReplaceGenesisTx(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const numSeedlings = 3
+	assetStore, _, db := newAssetStore(t)
+
+	randAssetCtx := addRandAssets(t, ctx, assetStore, numSeedlings)
+	genesisPkt := randAssetCtx.genesisPkt
+	genesisPkt.Pkt.Inputs[0].FinalScriptSig = []byte{}
+
+	const anchorOutputIndex = 2
+	require.NoError(t, assetStore.CommitSignedGenesisTx(
+		ctx, randAssetCtx.batchKey, genesisPkt, anchorOutputIndex,
+		randAssetCtx.scriptRoot,
+	))
+
+	// We'll now create the replacement, which spends the same input and
+	// pays a higher fee from the change output.
+	var buf bytes.Buffer
+	require.NoError(t, genesisPkt.Pkt.Serialize(&buf))
+	replacementPkt, err := psbt.NewFromRawBytes(&buf, false)
+	require.NoError(t, err)
+	replacementPkt.UnsignedTx.TxOut[genesisPkt.ChangeOutputIndex].Value--
+
+	replacement := &tapgarden.FundedPsbt{
+		Pkt:               replacementPkt,
+		ChangeOutputIndex: genesisPkt.ChangeOutputIndex,
+		ChainFees:         genesisPkt.ChainFees + 1,
+	}
+	require.NoError(t, assetStore.ReplaceGenesisTx(
+		ctx, randAssetCtx.batchKey, replacement, anchorOutputIndex,
+	))
+
+	// The batch should still be broadcast, but now carry the replacement
+	// genesis transaction.
+	mintingBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	assertBatchState(
+		t, mintingBatches[0], tapgarden.BatchStateBroadcast,
+	)
+	assertPsbtEqual(t, replacement, mintingBatches[0].GenesisPacket)
+
+	// The replacement should be stored as a chain transaction, with the
+	// managed UTXO and all the assets anchored in it.
+	newTXID := replacementPkt.UnsignedTx.TxHash()
+	dbGenTx, err := db.FetchChainTx(ctx, newTXID[:])
+	require.NoError(t, err)
+	require.Equal(t, replacement.ChainFees, dbGenTx.ChainFees)
+
+	managedUTXO, err := db.FetchManagedUTXO(ctx, sqlc.FetchManagedUTXOParams{
+		TxnID: sqlInt64(dbGenTx.TxnID),
+	})
+	require.NoError(t, err)
+	require.Equal(t, randAssetCtx.scriptRoot, managedUTXO.MerkleRoot)
+
+	anchoredAssets, err := db.FetchAssetsByAnchorTx(
+		ctx, sqlInt64(managedUTXO.UtxoID),
+	)
+	require.NoError(t, err)
+	require.Equal(t, numSeedlings, len(anchoredAssets))
+
+	_, err = db.FetchGenesisPointByAnchorTx(ctx, sqlInt64(dbGenTx.TxnID))
+	require.NoError(t, err)
+
+	// The replaced genesis transaction might still confirm, so it should
+	// be returned with the batch.
+	origTXID := genesisPkt.Pkt.UnsignedTx.TxHash()
+	replacedPkts := mintingBatches[0].ReplacedGenesisPackets
+	require.Len(t, replacedPkts, 1)
+	require.Equal(t, origTXID, replacedPkts[0].Pkt.UnsignedTx.TxHash())
+
+	// If it does confirm, the batch is reverted to it, which turns the
+	// replacement into a replaced genesis transaction.
+	require.NoError(t, assetStore.ReplaceGenesisTx(
+		ctx, randAssetCtx.batchKey, replacedPkts[0], anchorOutputIndex,
+	))
+
+	mintingBatches = noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	require.Equal(
+		t, origTXID,
+		mintingBatches[0].GenesisPacket.Pkt.UnsignedTx.TxHash(),
+	)
+	replacedPkts = mintingBatches[0].ReplacedGenesisPackets
+	require.Len(t, replacedPkts, 1)
+	require.Equal(t, newTXID, replacedPkts[0].Pkt.UnsignedTx.TxHash())
+
+	dbGenTx, err = db.FetchChainTx(ctx, origTXID[:])
+	require.NoError(t, err)
+	_, err = db.FetchGenesisPointByAnchorTx(ctx, sqlInt64(dbGenTx.TxnID))
+	require.NoError(t, err)
+
+	// A replacement that spends a different genesis input must be
+	// rejected, as it would change the IDs of the assets.
+	replacementPkt.UnsignedTx.TxIn[0].PreviousOutPoint.Index++
+	require.Error(t, assetStore.ReplaceGenesisTx(
+		ctx, randAssetCtx.batchKey, replacement, anchorOutputIndex,
+	))
+}
+
+// TestBatchSchedule tests that the schedule of a pending batch can be set,
+// read back, and removed again.
+func TestBatchSchedule(t *testing.T) {
+	t.Parallel()
+
+	assetStore, _, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, 2)
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, mintingBatch))
+	batchKey := mintingBatch.BatchKey.PubKey
+
+	// A new batch isn't scheduled.
+	dbBatch, err := assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	require.False(t, dbBatch.Schedule.IsSet())
+
+	schedules := []tapgarden.BatchSchedule{{
+		FinalizeHeight: 800_000,
+	}, {
+		FinalizeTime: time.Unix(1_700_000_000, 0).UTC(),
+	}, {}}
+	for _, schedule := range schedules {
+		err := assetStore.UpdateBatchSchedule(ctx, batchKey, schedule)
+		require.NoError(t, err)
+
+		dbBatch, err := assetStore.FetchMintingBatch(ctx, batchKey)
+		require.NoError(t, err)
+		require.Equal(t, schedule, dbBatch.Schedule)
+
+		// The schedule is also returned for non-final batches, which
+		// is how it's restored on startup.
+		dbBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+		require.Len(t, dbBatches, 1)
+		require.Equal(t, schedule, dbBatches[0].Schedule)
+	}
+}
+
+// TestFreezeMintingBatchParams tests that the params a batch was finalized with
+// are stored when the batch is frozen and restored along with the batch.
+func TestFreezeMintingBatchParams(t *testing.T) {
+	t.Parallel()
+
+	assetStore, _, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	feeRate := chainfee.SatPerKWeight(2_500)
+	externalTx := wire.NewMsgTx(2)
+	externalTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	externalTx.AddTxOut(&tapgarden.DummyGenesisTxOut)
+	externalPkt, err := psbt.NewFromUnsignedTx(externalTx)
+	require.NoError(t, err)
+
+	// We'll compare the packet read from disk to its decoded encoding, so
+	// empty and nil fields match.
+	var b bytes.Buffer
+	require.NoError(t, externalPkt.Serialize(&b))
+	externalPkt, err = psbt.NewFromRawBytes(&b, false)
+	require.NoError(t, err)
+
+	testCases := []tapgarden.FinalizeParams{{}, {
+		FeeRate: &feeRate,
+	}, {
+		ConfTarget: 12,
+		GenesisInputs: []wire.OutPoint{
+			test.RandOp(t), test.RandOp(t),
+		},
+	}, {
+		ExternalGenesisPsbt: externalPkt,
+	}}
+	for _, params := range testCases {
+		mintingBatch := tapgarden.RandSeedlingMintingBatch(t, 2)
+		err := assetStore.CommitMintingBatch(ctx, mintingBatch)
+		require.NoError(t, err)
+		batchKey := mintingBatch.BatchKey.PubKey
+
+		err = assetStore.FreezeMintingBatch(ctx, batchKey, params)
+		require.NoError(t, err)
+
+		dbBatch, err := assetStore.FetchMintingBatch(ctx, batchKey)
+		require.NoError(t, err)
+		assertBatchState(t, dbBatch, tapgarden.BatchStateFrozen)
+		require.Equal(t, params, dbBatch.FinalizeParams)
+	}
+
+	// The params are also returned for non-final batches, which is how
+	// they're restored on startup.
+	dbBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	require.Len(t, dbBatches, len(testCases))
+}
+
+// TestUpdateRemoveSeedling tests that seedlings in a pending batch can be
+// updated and removed.
+func TestUpdateRemoveSeedling(t *testing.T) {
+	t.Parallel()
+
+	assetStore, _, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, 3)
+	seedlings := maps.Values(mintingBatch.Seedlings)
+	anchor, seedling, other := seedlings[0], seedlings[1], seedlings[2]
+	anchor.EnableEmission = true
+	seedling.EnableEmission = false
+
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, mintingBatch))
+	batchKey := mintingBatch.BatchKey.PubKey
+
+	// We'll change the amount and meta of the seedling, and group it
+	// with the anchor.
+	updated := *seedling
+	updated.Amount++
+	updated.Meta = &proof.MetaReveal{
+		Data: test.RandBytes(32),
+	}
+	updated.GroupAnchor = &anchor.AssetName
+	require.NoError(t, assetStore.UpdateSeedling(ctx, batchKey, &updated))
+
+	mintingBatch.Seedlings[seedling.AssetName] = &updated
+	dbBatch, err := assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	assertBatchEqual(t, mintingBatch, dbBatch)
+
+	// The group anchor can also be removed again.
+	updated.GroupAnchor = nil
+	require.NoError(t, assetStore.UpdateSeedling(ctx, batchKey, &updated))
+
+	dbBatch, err = assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	assertBatchEqual(t, mintingBatch, dbBatch)
+
+	// Removing a seedling leaves the rest of the batch intact.
+	err = assetStore.RemoveSeedling(ctx, batchKey, other.AssetName)
+	require.NoError(t, err)
+
+	delete(mintingBatch.Seedlings, other.AssetName)
+	dbBatch, err = assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	assertBatchEqual(t, mintingBatch, dbBatch)
+
+	// A seedling that isn't in the batch can't be updated or removed.
+	err = assetStore.RemoveSeedling(ctx, batchKey, other.AssetName)
+	require.Error(t, err)
+	err = assetStore.UpdateSeedling(ctx, batchKey, other)
+	require.Error(t, err)
+}
+
+// TestSeedlingExternalGroupKey tests that the external group key and group
+// tapscript root of seedlings are stored and restored along with the batch,
+// both for seedlings in a new batch and seedlings added to an existing one.
+func TestSeedlingExternalGroupKey(t *testing.T) {
+	t.Parallel()
+
+	assetStore, _, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, 1)
+	for _, seedling := range mintingBatch.Seedlings {
+		seedling.EnableEmission = true
+		seedling.ExternalGroupKey = test.RandPubKey(t)
+		seedling.GroupTapscriptRoot = test.RandBytes(32)
+	}
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, mintingBatch))
+	batchKey := mintingBatch.BatchKey.PubKey
+
+	newSeedlings := tapgarden.RandSeedlingMintingBatch(t, 1).Seedlings
+	for _, seedling := range newSeedlings {
+		seedling.EnableEmission = true
+		seedling.ExternalGroupKey = test.RandPubKey(t)
+	}
+	err := assetStore.AddSeedlingsToBatch(
+		ctx, batchKey, maps.Values(newSeedlings)...,
+	)
+	require.NoError(t, err)
+
+	mintingBatch.Seedlings = mergeMap(mintingBatch.Seedlings, newSeedlings)
+	dbBatch, err := assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	assertBatchEqual(t, mintingBatch, dbBatch)
+}
+
+// TestCommitFrozenSprouts tests that the genesis packet and the sprouts of a
+// frozen batch that waits for externally signed group witnesses are restored
+// along with the batch, until the sprouts are committed.
+func TestCommitFrozenSprouts(t *testing.T) {
+	t.Parallel()
+
+	assetStore, _, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, 3)
+	require.NoError(t, assetStore.CommitMintingBatch(ctx, mintingBatch))
+	batchKey := mintingBatch.BatchKey.PubKey
+	require.NoError(t, assetStore.UpdateBatchState(
+		ctx, batchKey, tapgarden.BatchStateFrozen,
+	))
+
+	genesisPacket := randGenesisPacket(t)
+	assetRoot := seedlingsToAssetRoot(
+		t, genesisPacket.Pkt.UnsignedTx.TxIn[0].PreviousOutPoint,
+		mintingBatch.Seedlings, nil,
+	)
+
+	// One sprout needs an externally signed group witness, and another
+	// one already has its group witness.
+	var sprouts []*asset.Asset
+	for _, committedAsset := range assetRoot.CommittedAssets() {
+		sprouts = append(sprouts, committedAsset.Copy())
+	}
+
+	externalKey, _ := randKeyDesc(t)
+	sprouts[0].GroupKey = &asset.GroupKey{
+		RawKey:        externalKey,
+		GroupPubKey:   *test.RandPubKey(t),
+		TapscriptRoot: test.RandBytes(32),
+	}
+
+	localKey, _ := randKeyDesc(t)
+	groupWitness := wire.TxWitness{test.RandBytes(64)}
+	sprouts[1].GroupKey = &asset.GroupKey{
+		RawKey:      localKey,
+		GroupPubKey: *test.RandPubKey(t),
+		Witness:     groupWitness,
+	}
+	sprouts[1].PrevWitnesses[0].TxWitness = groupWitness
+
+	virtualTx := wire.NewMsgTx(2)
+	virtualTx.AddTxIn(&wire.TxIn{
+		SignatureScript: []byte{},
+	})
+	virtualTx.AddTxOut(&wire.TxOut{
+		Value:    int64(sprouts[0].Amount),
+		PkScript: test.RandBytes(34),
+	})
+	unsignedWitnesses := []*tapgarden.UnsignedGroupWitness{{
+		AssetName:   sprouts[0].Tag,
+		AssetID:     sprouts[0].ID(),
+		GroupKey:    *sprouts[0].GroupKey,
+		SingleTweak: test.RandBytes(32),
+		VirtualTx:   virtualTx,
+		PrevOut: &wire.TxOut{
+			Value:    int64(sprouts[0].Amount),
+			PkScript: test.RandBytes(34),
+		},
+	}}
+
+	// Committing the frozen sprouts twice only stores the last ones.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, assetStore.CommitFrozenSprouts(
+			ctx, batchKey, genesisPacket, sprouts,
+			unsignedWitnesses, false,
+		))
+	}
+
+	// The batch is still frozen with its seedlings, but now also has the
+	// genesis packet, the sprouts and the unsigned group witnesses.
+	dbBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	require.Len(t, dbBatches, 1)
+
+	dbBatch := dbBatches[0]
+	assertBatchState(t, dbBatch, tapgarden.BatchStateFrozen)
+	require.Len(t, dbBatch.Seedlings, len(mintingBatch.Seedlings))
+	assertPsbtEqual(t, genesisPacket, dbBatch.GenesisPacket)
+	require.False(t, dbBatch.ExternalFunding)
+	require.Equal(t, sprouts, dbBatch.FrozenSprouts)
+	require.Equal(t, unsignedWitnesses, dbBatch.UnsignedGroupWitnesses)
+
+	// Once the sprouts are committed, the frozen sprouts are gone.
+	require.NoError(t, assetStore.AddSproutsToBatch(
+		ctx, batchKey, genesisPacket, assetRoot, false,
+	))
+
+	dbBatch, err := assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	assertBatchState(t, dbBatch, tapgarden.BatchStateCommitted)
+	require.Empty(t, dbBatch.FrozenSprouts)
+	require.Empty(t, dbBatch.UnsignedGroupWitnesses)
+
+	var (
+		dbSprouts []FrozenSprout
+		readOpts  = NewAssetStoreReadTx()
+	)
+	fetchSprouts := func(q PendingAssetStore) error {
+		var err error
+		dbSprouts, err = q.FetchFrozenBatchSprouts(
+			ctx, batchKey.SerializeCompressed(),
+		)
+		return err
+	}
+	require.NoError(t, assetStore.db.ExecTx(ctx, &readOpts, fetchSprouts))
+	require.Empty(t, dbSprouts)
+}
+
+// TestMintingBatchNames tests that the names of multiple pending batches are
+// stored and restored along with the batches.
+func TestMintingBatchNames(t *testing.T) {
+	t.Parallel()
+
+	assetStore, _, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	defaultBatch := tapgarden.RandSeedlingMintingBatch(t, 1)
+	namedBatch := tapgarden.RandSeedlingMintingBatch(t, 2)
+	namedBatch.Name = "named-batch"
+
+	for _, batch := range []*tapgarden.MintingBatch{
+		defaultBatch, namedBatch,
+	} {
+		require.NoError(t, assetStore.CommitMintingBatch(ctx, batch))
+
+		dbBatch, err := assetStore.FetchMintingBatch(
+			ctx, batch.BatchKey.PubKey,
+		)
+		require.NoError(t, err)
+		require.Equal(t, batch.Name, dbBatch.Name)
+		require.Len(t, dbBatch.Seedlings, len(batch.Seedlings))
+	}
+
+	// Both batches are pending, so they're returned with their own
+	// seedlings on startup.
+	dbBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	require.Len(t, dbBatches, 2)
+
+	names := make(map[string]int)
+	for _, dbBatch := range dbBatches {
+		names[dbBatch.Name] = len(dbBatch.Seedlings)
+	}
+	require.Equal(t, map[string]int{
+		"":            1,
+		"named-batch": 2,
+	}, names)
+}
+
+// TestRecurringMints tests that recurring mints can be added, updated and
+// deleted.
+func TestRecurringMints(t *testing.T) {
+	t.Parallel()
+
+	assetStore, _, _ := newAssetStore(t)
+	ctx := context.Background()
+
+	creationTime := time.Unix(1_700_000_000, 0).UTC()
+	blockMint := &tapgarden.RecurringMint{
+		AssetName:      "block-mint",
+		Amount:         1000,
+		GroupKey:       test.RandPubKey(t),
+		IntervalBlocks: 144,
+		NextHeight:     800_144,
+		MaxEpochs:      10,
+		CreationTime:   creationTime,
+	}
+	timeMint := &tapgarden.RecurringMint{
+		AssetName:    "time-mint",
+		Amount:       1,
+		GroupKey:     test.RandPubKey(t),
+		Interval:     24 * time.Hour,
+		NextTime:     creationTime.Add(24 * time.Hour),
+		CreationTime: creationTime,
+	}
+
+	for _, mint := range []*tapgarden.RecurringMint{blockMint, timeMint} {
+		id, err := assetStore.AddRecurringMint(ctx, mint)
+		require.NoError(t, err)
+
+		mint.ID = id
+	}
+
+	dbMints, err := assetStore.FetchRecurringMints(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*tapgarden.RecurringMint{
+		blockMint, timeMint,
+	}, dbMints)
+
+	// Advancing the mints to their next epoch should be reflected on
+	// disk.
+	blockMint.NextHeight += blockMint.IntervalBlocks
+	blockMint.EpochsMinted++
+	timeMint.NextTime = timeMint.NextTime.Add(timeMint.Interval)
+	timeMint.EpochsMinted++
+	require.NoError(t, assetStore.UpdateRecurringMint(ctx, blockMint))
+	require.NoError(t, assetStore.UpdateRecurringMint(ctx, timeMint))
+
+	dbMints, err = assetStore.FetchRecurringMints(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*tapgarden.RecurringMint{
+		blockMint, timeMint,
+	}, dbMints)
+
+	// Finally, once deleted, a mint is no longer returned.
+	require.NoError(t, assetStore.DeleteRecurringMint(ctx, blockMint.ID))
+
+	dbMints, err = assetStore.FetchRecurringMints(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*tapgarden.RecurringMint{timeMint}, dbMints)
+}
+
 // TestDuplicateGroupKey tests that if we attempt to insert a group key with
 // the exact same tweaked key blob, then the noop UPSERT logic triggers, and we
 // get the ID of that same key.
@@ -1121,7 +1625,7 @@ func TestGroupAnchors(t *testing.T) {
 		mintingBatch.Seedlings, seedlingGroups,
 	)
 	require.NoError(t, assetStore.AddSproutsToBatch(
-		ctx, batchKey, genesisPacket, assetRoot,
+		ctx, batchKey, genesisPacket, assetRoot, false,
 	))
 
 	// Now we'll query for that same batch, and assert that the set of
@@ -73,6 +73,10 @@ type UpsertAssetStore interface {
 	// UpsertAssetMeta inserts a new asset meta into the DB.
 	UpsertAssetMeta(ctx context.Context, arg NewAssetMeta) (int64, error)
 
+	// FetchAssetMetaByHash fetches the asset meta for a given meta hash.
+	FetchAssetMetaByHash(ctx context.Context,
+		metaDataHash []byte) (sqlc.FetchAssetMetaByHashRow, error)
+
 	// SetAssetSpent marks an asset as being spent in the database. The
 	// updated asset's database ID is returned.
 	SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (int64,
@@ -104,6 +108,13 @@ func upsertGenesisPoint(ctx context.Context, q UpsertAssetStore,
 func upsertGenesis(ctx context.Context, q UpsertAssetStore,
 	genesisPointID int64, genesis asset.Genesis) (int64, error) {
 
+	// If the meta reveal of the asset is already known, it might commit to
+	// a decimal display value that we store alongside the genesis.
+	decimalDisplay, err := decimalDisplayFromMeta(ctx, q, genesis.MetaHash)
+	if err != nil {
+		return 0, err
+	}
+
 	// Then we'll insert the genesis_assets row which tracks all the
 	// information that uniquely derives a given asset ID.
 	assetID := genesis.ID()
@@ -114,6 +125,7 @@ func upsertGenesis(ctx context.Context, q UpsertAssetStore,
 		OutputIndex:    int32(genesis.OutputIndex),
 		AssetType:      int16(genesis.Type),
 		GenesisPointID: genesisPointID,
+		DecimalDisplay: decimalDisplay,
 	})
 	if err != nil {
 		return 0, fmt.Errorf("unable to insert genesis asset: %w", err)
@@ -122,6 +134,41 @@ func upsertGenesis(ctx context.Context, q UpsertAssetStore,
 	return genAssetID, nil
 }
 
+// decimalDisplayFromMeta returns the decimal display value the meta reveal
+// with the given hash commits to. A NULL value is returned if the reveal isn't
+// known, or isn't a valid JSON meta.
+func decimalDisplayFromMeta(ctx context.Context, q UpsertAssetStore,
+	metaHash [32]byte) (sql.NullInt32, error) {
+
+	dbMeta, err := q.FetchAssetMetaByHash(ctx, metaHash[:])
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return sql.NullInt32{}, nil
+
+	case err != nil:
+		return sql.NullInt32{}, fmt.Errorf("unable to fetch asset "+
+			"meta: %w", err)
+	}
+
+	if !dbMeta.MetaDataType.Valid ||
+		proof.MetaType(dbMeta.MetaDataType.Int16) != proof.MetaJson {
+
+		return sql.NullInt32{}, nil
+	}
+
+	// The meta was validated before it was written to disk, but we might
+	// also have learned it from a proof of another node, so we don't fail
+	// on an invalid reveal.
+	jsonMeta, err := proof.DecodeJsonMeta(dbMeta.MetaDataBlob)
+	if err != nil {
+		log.Debugf("Unable to decode JSON meta %x: %v", metaHash[:],
+			err)
+		return sql.NullInt32{}, nil
+	}
+
+	return sqlInt32(jsonMeta.DecimalDisplay), nil
+}
+
 // fetchGenesisID fetches the primary key ID for a genesis record already
 // in the database.
 func fetchGenesisID(ctx context.Context, q UpsertAssetStore,
@@ -101,6 +101,18 @@ type (
 	// AssetTransferRow wraps a single transfer row.
 	AssetTransferRow = sqlc.QueryAssetTransfersRow
 
+	// TransferAnchorTxUpdate wraps the params needed to update the anchor
+	// transaction of an asset transfer.
+	TransferAnchorTxUpdate = sqlc.UpdateTransferAnchorTxParams
+
+	// NewReplacedAnchorTx wraps the params needed to record a replaced
+	// anchor transaction of an asset transfer.
+	NewReplacedAnchorTx = sqlc.InsertReplacedAnchorTxParams
+
+	// ManagedUTXOReAnchor wraps the params needed to move a managed UTXO
+	// to a new outpoint.
+	ManagedUTXOReAnchor = sqlc.ReAnchorManagedUTXOParams
+
 	// TransferInput tracks the inputs to an asset transfer.
 	TransferInput = sqlc.AssetTransferInput
 
@@ -264,6 +276,43 @@ type ActiveAssetsStore interface {
 		query sqlc.QueryAssetTransfersParams) ([]AssetTransferRow,
 		error)
 
+	// UpdateTransferAnchorTx updates the anchor transaction an asset
+	// transfer references.
+	UpdateTransferAnchorTx(ctx context.Context,
+		arg TransferAnchorTxUpdate) error
+
+	// InsertReplacedAnchorTx records an anchor transaction of an asset
+	// transfer that was replaced.
+	InsertReplacedAnchorTx(ctx context.Context,
+		arg NewReplacedAnchorTx) error
+
+	// FetchReplacedAnchorTxids fetches the hashes of all replaced anchor
+	// transactions of an asset transfer.
+	FetchReplacedAnchorTxids(ctx context.Context,
+		transferID int64) ([][]byte, error)
+
+	// ReAnchorManagedUTXO moves a managed UTXO to a new outpoint of a
+	// new anchor transaction.
+	ReAnchorManagedUTXO(ctx context.Context,
+		arg ManagedUTXOReAnchor) error
+
+	// DeletePassiveAssets deletes the passive assets of an asset transfer.
+	DeletePassiveAssets(ctx context.Context, transferID int64) error
+
+	// DeleteAssetTransferOutputs deletes the outputs of an asset
+	// transfer.
+	DeleteAssetTransferOutputs(ctx context.Context, transferID int64) error
+
+	// DeleteAssetTransferInputs deletes the inputs of an asset transfer.
+	DeleteAssetTransferInputs(ctx context.Context, transferID int64) error
+
+	// DeleteReplacedAnchorTxns deletes the records of the replaced anchor
+	// transactions of an asset transfer.
+	DeleteReplacedAnchorTxns(ctx context.Context, transferID int64) error
+
+	// DeleteAssetTransfer deletes an asset transfer.
+	DeleteAssetTransfer(ctx context.Context, id int64) error
+
 	// DeleteAssetWitnesses deletes the witnesses on disk associated with a
 	// given asset ID.
 	DeleteAssetWitnesses(ctx context.Context, assetID int64) error
@@ -299,6 +348,11 @@ type ActiveAssetsStore interface {
 	// FetchAssetMetaForAsset fetches the asset meta for a given asset.
 	FetchAssetMetaForAsset(ctx context.Context,
 		assetID []byte) (sqlc.FetchAssetMetaForAssetRow, error)
+
+	// FetchDecimalDisplay fetches the decimal display value stored for a
+	// given asset.
+	FetchDecimalDisplay(ctx context.Context,
+		assetID []byte) (sql.NullInt32, error)
 }
 
 // AssetBalance holds a balance query result for a particular asset or all
@@ -681,6 +735,10 @@ func (a *AssetStore) dbAssetsToChainAssets(dbAssets []ConfirmedAsset,
 				"%v", err)
 		}
 
+		assetSprout.ScriptVersion = asset.ScriptVersion(
+			sprout.ScriptVersion,
+		)
+
 		// We cannot use 0 as the amount when creating a new asset with
 		// the New function above. But if this is a tombstone asset, we
 		// actually have to set the amount to 0.
@@ -1515,8 +1573,10 @@ func (a *AssetStore) upsertAssetProof(ctx context.Context,
 //
 // NOTE: This implements the proof.ArchiveBackend interface.
 func (a *AssetStore) ImportProofs(ctx context.Context,
-	headerVerifier proof.HeaderVerifier, groupVerifier proof.GroupVerifier,
-	replace bool, proofs ...*proof.AnnotatedProof) error {
+	headerVerifier proof.HeaderVerifier,
+	medianTimeSource proof.MedianTimeSource,
+	groupVerifier proof.GroupVerifier, replace bool,
+	proofs ...*proof.AnnotatedProof) error {
 
 	var writeTxOpts AssetStoreTxOptions
 	err := a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
@@ -1884,6 +1944,7 @@ func (a *AssetStore) queryCommitments(ctx context.Context,
 		selectedAssets[i] = &tapfreighter.AnchoredCommitment{
 			AnchorPoint:       anchorPoint,
 			AnchorOutputValue: btcutil.Amount(anchorUTXO.AmtSats),
+			AnchorBlockHeight: matchingAsset.AnchorBlockHeight,
 			InternalKey: keychain.KeyDescriptor{
 				PubKey: internalKey,
 				KeyLocator: keychain.KeyLocator{
@@ -2420,6 +2481,45 @@ func (a *AssetStore) QueryProofTransferLog(ctx context.Context,
 	return timestamps, err
 }
 
+// outputTemplateAssetID returns the ID of the spent asset that serves as the
+// template for the new asset created by a transfer output with the given
+// witnesses. The template IDs are keyed by the asset ID of the spent inputs.
+func outputTemplateAssetID(witnesses []asset.Witness,
+	templateIDs map[asset.ID]int64) (int64, error) {
+
+	// If all inputs are of the same asset ID, any of them can be used.
+	if len(templateIDs) == 1 {
+		for _, templateID := range templateIDs {
+			return templateID, nil
+		}
+	}
+
+	if len(witnesses) == 0 {
+		return 0, fmt.Errorf("output has no witness")
+	}
+
+	// Split outputs don't reference the inputs directly, but commit to
+	// the root asset that spent them.
+	var assetID asset.ID
+	switch {
+	case witnesses[0].SplitCommitment != nil:
+		assetID = witnesses[0].SplitCommitment.RootAsset.ID()
+
+	case witnesses[0].PrevID != nil:
+		assetID = witnesses[0].PrevID.ID
+
+	default:
+		return 0, fmt.Errorf("output witness has no previous asset")
+	}
+
+	templateID, ok := templateIDs[assetID]
+	if !ok {
+		return 0, fmt.Errorf("no input of asset %v", assetID)
+	}
+
+	return templateID, nil
+}
+
 // ConfirmParcelDelivery marks a spend event on disk as confirmed. This updates
 // the on-chain reference information on disk to point to this new spend.
 func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
@@ -2450,11 +2550,11 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 		}
 
 		// We'll keep around the IDs of the assets that we set to being
-		// spent. We'll need one of them as our template to create the
-		// new assets.
-		spentAssetIDs := make([]int64, len(inputs))
+		// spent. We'll need one of them for each asset ID as our
+		// template to create the new assets.
+		templateIDs := make(map[asset.ID]int64)
 		for idx := range inputs {
-			spentAssetIDs[idx], err = q.SetAssetSpent(
+			spentAssetID, err := q.SetAssetSpent(
 				ctx, SetAssetSpentParams{
 					ScriptKey:  inputs[idx].ScriptKey,
 					GenAssetID: inputs[idx].AssetID,
@@ -2464,6 +2564,12 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 				return fmt.Errorf("unable to set asset spent: "+
 					"%w", err)
 			}
+
+			var assetID asset.ID
+			copy(assetID[:], inputs[idx].AssetID)
+			if _, ok := templateIDs[assetID]; !ok {
+				templateIDs[assetID] = spentAssetID
+			}
 		}
 
 		// Now is the time to fetch our outputs and create new assets
@@ -2517,16 +2623,21 @@ func (a *AssetStore) ConfirmParcelDelivery(ctx context.Context,
 				continue
 			}
 
-			// Since we define that a transfer can only move assets
-			// within the same asset ID, we can take any of the
-			// inputs as a template for the new asset, since the
-			// genesis and group key will be the same. We'll
-			// overwrite all other fields.
+			// We can take any of the inputs of the same asset ID
+			// as a template for the new asset, since the genesis
+			// and group key will be the same. We'll overwrite all
+			// other fields.
 			//
 			// TODO(guggero): This will need an update once we want
 			// to support full lock_time and relative_lock_time
 			// support.
-			templateID := spentAssetIDs[0]
+			templateID, err := outputTemplateAssetID(
+				witnessData, templateIDs,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to find template "+
+					"asset for output %d: %w", idx, err)
+			}
 			params := ApplyPendingOutput{
 				ScriptKeyID: out.ScriptKeyID,
 				AnchorUtxoID: sqlInt64(
@@ -2719,6 +2830,192 @@ func (a *AssetStore) reAnchorPassiveAssets(ctx context.Context,
 	return nil
 }
 
+// ReplaceAnchorTx replaces the anchor transaction of the pending parcel with
+// the given anchor transaction hash with a new transaction that spends the same
+// inputs and creates the same anchor outputs but pays the given, higher chain
+// fees. The replaced transaction is recorded with the transfer.
+func (a *AssetStore) ReplaceAnchorTx(ctx context.Context,
+	oldAnchorTxHash chainhash.Hash, newAnchorTx *wire.MsgTx,
+	chainFees int64) error {
+
+	newAnchorTXID := newAnchorTx.TxHash()
+	var txBuf bytes.Buffer
+	if err := newAnchorTx.Serialize(&txBuf); err != nil {
+		return err
+	}
+	anchorTxBytes := txBuf.Bytes()
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		assetTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			AnchorTxHash: oldAnchorTxHash[:],
+			UnconfOnly:   true,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset transfers: %w",
+				err)
+		}
+		if len(assetTransfers) != 1 {
+			return fmt.Errorf("expected 1 pending transfer with "+
+				"anchor TX %v, got %d", oldAnchorTxHash,
+				len(assetTransfers))
+		}
+		transferID := assetTransfers[0].ID
+
+		oldAnchorTx, err := q.FetchChainTx(ctx, oldAnchorTxHash[:])
+		if err != nil {
+			return fmt.Errorf("unable to fetch anchor tx: %w", err)
+		}
+
+		newTxnID, err := q.UpsertChainTx(ctx, ChainTxParams{
+			Txid:      newAnchorTXID[:],
+			RawTx:     anchorTxBytes,
+			ChainFees: chainFees,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert new chain tx: %w",
+				err)
+		}
+
+		err = q.UpdateTransferAnchorTx(ctx, TransferAnchorTxUpdate{
+			AnchorTxnID: newTxnID,
+			TransferID:  transferID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update transfer anchor "+
+				"tx: %w", err)
+		}
+
+		err = q.InsertReplacedAnchorTx(ctx, NewReplacedAnchorTx{
+			TransferID: transferID,
+			TxnID:      oldAnchorTx.TxnID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to record replaced anchor "+
+				"tx: %w", err)
+		}
+
+		// The anchor outputs keep their index in the replacement
+		// transaction, so we only need to update the outpoints of the
+		// managed UTXOs that were created by the old transaction.
+		for idx := range newAnchorTx.TxOut {
+			oldOutpoint, err := encodeOutpoint(wire.OutPoint{
+				Hash:  oldAnchorTxHash,
+				Index: uint32(idx),
+			})
+			if err != nil {
+				return err
+			}
+			newOutpoint, err := encodeOutpoint(wire.OutPoint{
+				Hash:  newAnchorTXID,
+				Index: uint32(idx),
+			})
+			if err != nil {
+				return err
+			}
+
+			err = q.ReAnchorManagedUTXO(ctx, ManagedUTXOReAnchor{
+				NewOutpoint: newOutpoint,
+				NewTxnID:    newTxnID,
+				OldOutpoint: oldOutpoint,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to re-anchor "+
+					"managed utxo: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// AbandonParcel removes the pending parcel with the given anchor transaction
+// hash from disk, including the speculative proof suffixes of its outputs, and
+// releases the leases of the asset UTXOs it spends. The input assets are
+// therefore spendable again once this returns.
+func (a *AssetStore) AbandonParcel(ctx context.Context,
+	anchorTxHash chainhash.Hash) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q ActiveAssetsStore) error {
+		assetTransfers, err := q.QueryAssetTransfers(ctx, TransferQuery{
+			AnchorTxHash: anchorTxHash[:],
+			UnconfOnly:   true,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to query asset transfers: %w",
+				err)
+		}
+		if len(assetTransfers) != 1 {
+			return fmt.Errorf("expected 1 pending transfer with "+
+				"anchor TX %v, got %d", anchorTxHash,
+				len(assetTransfers))
+		}
+		transferID := assetTransfers[0].ID
+
+		// The assets we wanted to spend were never marked as spent,
+		// so releasing the lease on their anchor UTXOs is enough to
+		// make them available for coin selection again.
+		inputs, err := q.FetchTransferInputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transfer inputs: %w",
+				err)
+		}
+		for _, input := range inputs {
+			err := q.DeleteUTXOLease(ctx, input.AnchorPoint)
+			if err != nil {
+				return fmt.Errorf("unable to delete UTXO "+
+					"lease: %w", err)
+			}
+		}
+
+		outputs, err := q.FetchTransferOutputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transfer outputs: "+
+				"%w", err)
+		}
+
+		// The passive assets and transfer outputs reference the new
+		// managed UTXOs, so they need to go first.
+		if err := q.DeletePassiveAssets(ctx, transferID); err != nil {
+			return fmt.Errorf("unable to delete passive assets: "+
+				"%w", err)
+		}
+		err = q.DeleteAssetTransferOutputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete transfer outputs: "+
+				"%w", err)
+		}
+		err = q.DeleteAssetTransferInputs(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete transfer inputs: "+
+				"%w", err)
+		}
+		err = q.DeleteReplacedAnchorTxns(ctx, transferID)
+		if err != nil {
+			return fmt.Errorf("unable to delete replaced anchor "+
+				"txns: %w", err)
+		}
+
+		// Several outputs can share the same anchor output, deleting
+		// the managed UTXO again is a no-op in that case.
+		for _, output := range outputs {
+			err := q.DeleteManagedUTXO(ctx, output.AnchorOutpoint)
+			if err != nil {
+				return fmt.Errorf("unable to delete managed "+
+					"UTXO: %w", err)
+			}
+		}
+
+		if err := q.DeleteAssetTransfer(ctx, transferID); err != nil {
+			return fmt.Errorf("unable to delete asset transfer: "+
+				"%w", err)
+		}
+
+		return nil
+	})
+}
+
 // PendingParcels returns the set of parcels that haven't yet been finalized.
 // This can be used to query the set of unconfirmed
 // transactions for re-broadcast.
@@ -2784,13 +3081,37 @@ func (a *AssetStore) QueryParcels(ctx context.Context,
 					"anchor tx: %w", err)
 			}
 
+			replacedTxids, err := q.FetchReplacedAnchorTxids(
+				ctx, dbT.ID,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to fetch replaced "+
+					"anchor txids: %w", err)
+			}
+			// If a replaced transaction confirmed after all, the
+			// transfer references it again, so it isn't replaced.
+			var replacedHashes []chainhash.Hash
+			for _, replacedTxid := range replacedTxids {
+				if bytes.Equal(replacedTxid, anchorTXID) {
+					continue
+				}
+
+				var replacedHash chainhash.Hash
+				copy(replacedHash[:], replacedTxid)
+				replacedHashes = append(
+					replacedHashes, replacedHash,
+				)
+			}
+
+			transferTime := dbT.TransferTimeUnix.UTC()
 			transfer := &tapfreighter.OutboundParcel{
-				AnchorTx:           anchorTx,
-				AnchorTxHeightHint: uint32(dbT.HeightHint),
-				TransferTime:       dbT.TransferTimeUnix.UTC(),
-				ChainFees:          dbAnchorTx.ChainFees,
-				Inputs:             inputs,
-				Outputs:            outputs,
+				AnchorTx:               anchorTx,
+				AnchorTxHeightHint:     uint32(dbT.HeightHint),
+				TransferTime:           transferTime,
+				ChainFees:              dbAnchorTx.ChainFees,
+				Inputs:                 inputs,
+				Outputs:                outputs,
+				ReplacedAnchorTxHashes: replacedHashes,
 			}
 			transfers = append(transfers, transfer)
 		}
@@ -2868,6 +3189,35 @@ func (a *AssetStore) FetchAssetMetaByHash(ctx context.Context,
 	return assetMeta, nil
 }
 
+// ErrDecimalDisplayNotFound is returned when the asset isn't known, or its meta
+// reveal doesn't commit to a decimal display value.
+var ErrDecimalDisplayNotFound = fmt.Errorf("decimal display not found")
+
+// FetchDecimalDisplay attempts to fetch the decimal display value the issuer of
+// the asset with the given ID committed to in the meta reveal of the asset.
+func (a *AssetStore) FetchDecimalDisplay(ctx context.Context,
+	assetID asset.ID) (uint32, error) {
+
+	var decimalDisplay sql.NullInt32
+
+	readOpts := NewAssetStoreReadTx()
+	dbErr := a.db.ExecTx(ctx, &readOpts, func(q ActiveAssetsStore) error {
+		var err error
+		decimalDisplay, err = q.FetchDecimalDisplay(ctx, assetID[:])
+		return err
+	})
+	switch {
+	case errors.Is(dbErr, sql.ErrNoRows):
+		return 0, ErrDecimalDisplayNotFound
+	case dbErr != nil:
+		return 0, dbErr
+	case !decimalDisplay.Valid:
+		return 0, ErrDecimalDisplayNotFound
+	}
+
+	return uint32(decimalDisplay.Int32), nil
+}
+
 // A compile-time constraint to ensure that AssetStore meets the
 // proof.NotifyArchiver interface.
 var _ proof.NotifyArchiver = (*AssetStore)(nil)
@@ -342,8 +342,8 @@ func TestImportAssetProof(t *testing.T) {
 	// With all our test data constructed, we'll now attempt to import the
 	// asset into the database.
 	require.NoError(t, assetStore.ImportProofs(
-		ctxb, proof.MockHeaderVerifier, proof.MockGroupVerifier, false,
-		testProof,
+		ctxb, proof.MockHeaderVerifier, proof.MockMedianTimeSource,
+		proof.MockGroupVerifier, false, testProof,
 	))
 
 	// We should now be able to retrieve the set of all assets inserted on
@@ -396,8 +396,8 @@ func TestImportAssetProof(t *testing.T) {
 	testProof.AnchorTxIndex = 5678
 	testProof.Blob = updatedBlob
 	require.NoError(t, assetStore.ImportProofs(
-		ctxb, proof.MockHeaderVerifier, proof.MockGroupVerifier, true,
-		testProof,
+		ctxb, proof.MockHeaderVerifier, proof.MockMedianTimeSource,
+		proof.MockGroupVerifier, true, testProof,
 	))
 
 	currentBlob, err = assetStore.FetchProof(ctxb, proof.Locator{
@@ -1389,6 +1389,52 @@ func TestAssetExportLog(t *testing.T) {
 	require.Equal(t, 1, len(parcels))
 	require.Equal(t, spendDelta, parcels[0])
 
+	// We'll now replace the anchor transaction with one that pays a
+	// higher fee. The transfer should then be anchored in the replacement
+	// transaction and remember the one it replaced.
+	replacementTx := newAnchorTx.Copy()
+	replacementTx.TxIn[0].SignatureScript = []byte{}
+	replacementTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x02}, 34),
+		Value:    500,
+	})
+	chainFees += 50
+	require.NoError(t, assetsStore.ReplaceAnchorTx(
+		ctx, anchorTxHash, replacementTx, chainFees,
+	))
+
+	// Replacing the same transaction again must fail, as there is no
+	// longer a pending transfer anchored in it.
+	require.Error(t, assetsStore.ReplaceAnchorTx(
+		ctx, anchorTxHash, replacementTx, chainFees,
+	))
+
+	spendDelta.AnchorTx = replacementTx
+	spendDelta.ChainFees = chainFees
+	spendDelta.ReplacedAnchorTxHashes = []chainhash.Hash{anchorTxHash}
+	anchorTxHash = replacementTx.TxHash()
+	for idx := range spendDelta.Outputs {
+		spendDelta.Outputs[idx].Anchor.OutPoint.Hash = anchorTxHash
+	}
+	firstOutput = spendDelta.Outputs[0]
+	firstOutputAnchor = firstOutput.Anchor
+
+	parcels, err = assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(parcels))
+	require.Equal(t, spendDelta, parcels[0])
+
+	// The managed UTXOs created by the transfer should now also reference
+	// the replacement transaction.
+	utxos, err = assetsStore.FetchManagedUTXOs(ctx)
+	require.NoError(t, err)
+	require.Len(t, utxos, 3)
+	require.Equal(t, assetGen.anchorPoints[0], utxos[0].OutPoint)
+	require.Equal(t, firstOutputAnchor.OutPoint, utxos[1].OutPoint)
+	require.Equal(
+		t, spendDelta.Outputs[1].Anchor.OutPoint, utxos[2].OutPoint,
+	)
+
 	// With the asset delta committed and verified, we'll now mark the
 	// delta as being confirmed on chain.
 	fakeBlockHash := chainhash.Hash(sha256.Sum256([]byte("fake")))
@@ -1502,6 +1548,158 @@ func TestAssetExportLog(t *testing.T) {
 	require.Equal(t, 0, len(parcels))
 }
 
+// TestAssetExportLogAbandon tests that abandoning a pending parcel removes it
+// from disk and makes the input assets spendable again.
+func TestAssetExportLogAbandon(t *testing.T) {
+	t.Parallel()
+
+	_, assetsStore, db := newAssetStore(t)
+	ctx := context.Background()
+
+	const numAssets = 2
+	assetGen := newAssetGenerator(t, numAssets, 1)
+	assetGen.genAssets(t, assetsStore, []assetDesc{
+		{
+			assetGen:    assetGen.assetGens[0],
+			anchorPoint: assetGen.anchorPoints[0],
+			amt:         16,
+		},
+		{
+			assetGen:    assetGen.assetGens[1],
+			anchorPoint: assetGen.anchorPoints[0],
+			amt:         10,
+		},
+	})
+
+	allAssets, err := assetsStore.FetchAllAssets(ctx, false, false, nil)
+	require.NoError(t, err)
+	require.Len(t, allAssets, numAssets)
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{})
+	anchorTx.TxIn[0].SignatureScript = []byte{}
+	anchorTx.AddTxOut(&wire.TxOut{
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+		Value:    1000,
+	})
+	anchorTxHash := anchorTx.TxHash()
+
+	// We'll spend the first asset completely and carry along the second
+	// one as a passive asset in the same anchor output.
+	inputAsset := allAssets[0]
+	scriptKey := asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+		KeyLocator: keychain.KeyLocator{
+			Index:  uint32(rand.Int31()),
+			Family: keychain.KeyFamily(rand.Int31()),
+		},
+	})
+	parcel := &tapfreighter.OutboundParcel{
+		AnchorTx:           anchorTx,
+		AnchorTxHeightHint: 1450,
+		ChainFees:          100,
+		Inputs: []tapfreighter.TransferInput{{
+			PrevID: asset.PrevID{
+				OutPoint: assetGen.anchorPoints[0],
+				ID:       inputAsset.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			},
+			Amount: inputAsset.Amount,
+		}},
+		Outputs: []tapfreighter.TransferOutput{{
+			Anchor: tapfreighter.Anchor{
+				Value: 1000,
+				OutPoint: wire.OutPoint{
+					Hash:  anchorTxHash,
+					Index: 0,
+				},
+				InternalKey: keychain.KeyDescriptor{
+					PubKey: test.RandPubKey(t),
+				},
+				TaprootAssetRoot: bytes.Repeat([]byte{0x1}, 32),
+				MerkleRoot:       bytes.Repeat([]byte{0x1}, 32),
+				NumPassiveAssets: 1,
+			},
+			ScriptKey:   scriptKey,
+			Amount:      inputAsset.Amount,
+			WitnessData: []asset.Witness{{PrevID: &asset.PrevID{}}},
+			ProofSuffix: bytes.Repeat([]byte{0x02}, 100),
+		}},
+		PassiveAssets: []*tapfreighter.PassiveAssetReAnchor{{
+			GenesisID:       allAssets[1].ID(),
+			PrevAnchorPoint: assetGen.anchorPoints[0],
+			ScriptKey:       allAssets[1].ScriptKey,
+			NewProof:        randProof(t),
+		}},
+	}
+
+	leaseOwner := fn.ToArray[[32]byte](test.RandBytes(32))
+	leaseExpiry := time.Now().Add(time.Hour)
+	require.NoError(t, assetsStore.LogPendingParcel(
+		ctx, parcel, leaseOwner, leaseExpiry,
+	))
+
+	// The input assets are now leased and the transfer created a new
+	// managed UTXO.
+	unleasedAssets, err := assetsStore.FetchAllAssets(
+		ctx, false, false, nil,
+	)
+	require.NoError(t, err)
+	require.Empty(t, unleasedAssets)
+
+	utxos, err := assetsStore.FetchManagedUTXOs(ctx)
+	require.NoError(t, err)
+	require.Len(t, utxos, 2)
+
+	parcels, err := assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Len(t, parcels, 1)
+
+	assetTransfers, err := db.QueryAssetTransfers(ctx, TransferQuery{})
+	require.NoError(t, err)
+	require.Len(t, assetTransfers, 1)
+	transferID := assetTransfers[0].ID
+
+	passiveAssets, err := db.QueryPassiveAssets(ctx, transferID)
+	require.NoError(t, err)
+	require.Len(t, passiveAssets, 1)
+
+	// Abandoning a transfer we don't know about fails.
+	require.Error(t, assetsStore.AbandonParcel(ctx, test.RandHash()))
+
+	require.NoError(t, assetsStore.AbandonParcel(ctx, anchorTxHash))
+
+	// The transfer, its outputs and its passive assets are gone.
+	parcels, err = assetsStore.PendingParcels(ctx)
+	require.NoError(t, err)
+	require.Empty(t, parcels)
+
+	assetTransfers, err = db.QueryAssetTransfers(ctx, TransferQuery{})
+	require.NoError(t, err)
+	require.Empty(t, assetTransfers)
+
+	passiveAssets, err = db.QueryPassiveAssets(ctx, transferID)
+	require.NoError(t, err)
+	require.Empty(t, passiveAssets)
+
+	utxos, err = assetsStore.FetchManagedUTXOs(ctx)
+	require.NoError(t, err)
+	require.Len(t, utxos, 1)
+	require.Equal(t, assetGen.anchorPoints[0], utxos[0].OutPoint)
+
+	// And the input assets are spendable again.
+	unleasedAssets, err = assetsStore.FetchAllAssets(
+		ctx, false, false, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, unleasedAssets, numAssets)
+
+	// The transfer can't be abandoned twice.
+	require.Error(t, assetsStore.AbandonParcel(ctx, anchorTxHash))
+}
+
 // TestAssetGroupSigUpsert tests that if you try to insert another asset
 // group sig with the same asset_gen_id, then only one is actually created.
 func TestAssetGroupSigUpsert(t *testing.T) {
@@ -1729,3 +1927,124 @@ func TestFetchGroupedAssets(t *testing.T) {
 	equalityCheck(allAssets[2].Asset, groupedAssets[1])
 	equalityCheck(allAssets[3].Asset, groupedAssets[2])
 }
+
+// TestOutputTemplateAssetID tests that the template asset for a transfer
+// output is chosen by the asset ID of the output's witness when a transfer
+// spends inputs of multiple asset IDs.
+func TestOutputTemplateAssetID(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rootAsset = asset.RandAsset(t, asset.Normal)
+		rootID    = rootAsset.ID()
+		otherID   = asset.RandID(t)
+	)
+
+	// With a single input asset ID, that input is always used.
+	templateID, err := outputTemplateAssetID(
+		nil, map[asset.ID]int64{otherID: 7},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, templateID)
+
+	templateIDs := map[asset.ID]int64{
+		rootID:  1,
+		otherID: 2,
+	}
+
+	// A full value or split root output references its input directly.
+	templateID, err = outputTemplateAssetID([]asset.Witness{{
+		PrevID: &asset.PrevID{ID: otherID},
+	}}, templateIDs)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, templateID)
+
+	// A split output commits to the root asset.
+	templateID, err = outputTemplateAssetID([]asset.Witness{{
+		PrevID: &asset.ZeroPrevID,
+		SplitCommitment: &asset.SplitCommitment{
+			RootAsset: *rootAsset,
+		},
+	}}, templateIDs)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, templateID)
+
+	// Outputs of unknown assets or without a witness are rejected.
+	_, err = outputTemplateAssetID([]asset.Witness{{
+		PrevID: &asset.PrevID{ID: asset.RandID(t)},
+	}}, templateIDs)
+	require.ErrorContains(t, err, "no input of asset")
+
+	_, err = outputTemplateAssetID(nil, templateIDs)
+	require.ErrorContains(t, err, "no witness")
+}
+
+// TestFetchDecimalDisplay tests that the decimal display value a JSON meta
+// reveal commits to is stored alongside the genesis of the asset.
+func TestFetchDecimalDisplay(t *testing.T) {
+	t.Parallel()
+
+	_, assetStore, db := newAssetStore(t)
+	ctx := context.Background()
+
+	jsonMeta, err := proof.NewJsonMetaReveal(&proof.JsonMeta{
+		DecimalDisplay: 2,
+	})
+	require.NoError(t, err)
+
+	insertGenesis := func(gen asset.Genesis, meta *proof.MetaReveal) {
+		_, err := maybeUpsertAssetMeta(ctx, db, &gen, meta)
+		require.NoError(t, err)
+
+		genPointID, err := upsertGenesisPoint(ctx, db, gen.FirstPrevOut)
+		require.NoError(t, err)
+
+		_, err = upsertGenesis(ctx, db, genPointID, gen)
+		require.NoError(t, err)
+	}
+
+	// An asset that commits to a decimal display in its JSON meta.
+	jsonGen := asset.RandGenesis(t, asset.Normal)
+	jsonGen.MetaHash = jsonMeta.MetaHash()
+	insertGenesis(jsonGen, jsonMeta)
+
+	decimalDisplay, err := assetStore.FetchDecimalDisplay(ctx, jsonGen.ID())
+	require.NoError(t, err)
+	require.EqualValues(t, 2, decimalDisplay)
+
+	// An asset with an opaque meta doesn't have a decimal display.
+	opaqueMeta := &proof.MetaReveal{
+		Type: proof.MetaOpaque,
+		Data: []byte(`{"decimal_display":2}`),
+	}
+	opaqueGen := asset.RandGenesis(t, asset.Normal)
+	opaqueGen.MetaHash = opaqueMeta.MetaHash()
+	insertGenesis(opaqueGen, opaqueMeta)
+
+	_, err = assetStore.FetchDecimalDisplay(ctx, opaqueGen.ID())
+	require.ErrorIs(t, err, ErrDecimalDisplayNotFound)
+
+	// If the meta reveal is only learned after the genesis, the decimal
+	// display is filled in once the genesis is inserted again.
+	laterMeta, err := proof.NewJsonMetaReveal(&proof.JsonMeta{
+		DecimalDisplay: 4,
+	})
+	require.NoError(t, err)
+
+	laterGen := asset.RandGenesis(t, asset.Normal)
+	laterGen.MetaHash = laterMeta.MetaHash()
+	insertGenesis(laterGen, nil)
+
+	_, err = assetStore.FetchDecimalDisplay(ctx, laterGen.ID())
+	require.ErrorIs(t, err, ErrDecimalDisplayNotFound)
+
+	insertGenesis(laterGen, laterMeta)
+
+	decimalDisplay, err = assetStore.FetchDecimalDisplay(ctx, laterGen.ID())
+	require.NoError(t, err)
+	require.EqualValues(t, 4, decimalDisplay)
+
+	// Unknown assets don't have a decimal display either.
+	_, err = assetStore.FetchDecimalDisplay(ctx, asset.RandID(t))
+	require.ErrorIs(t, err, ErrDecimalDisplayNotFound)
+}
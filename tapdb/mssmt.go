@@ -288,6 +288,15 @@ func (t *taprootAssetTreeStoreTx) GetChildren(height int, hashKey mssmt.NodeHash
 		return nil, nil, err
 	}
 
+	return decodeChildren(height, dbRows)
+}
+
+// decodeChildren decodes the left and right child of a node at the given
+// height from the rows of a children query. The first row is always the node
+// itself.
+func decodeChildren(height int, dbRows []StoredNode) (mssmt.Node, mssmt.Node,
+	error) {
+
 	var (
 		left  mssmt.Node = mssmt.EmptyTree[height+1]
 		right mssmt.Node = mssmt.EmptyTree[height+1]
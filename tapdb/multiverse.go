@@ -42,6 +42,8 @@ type (
 type BaseMultiverseStore interface {
 	BaseUniverseStore
 
+	UniverseEventInserter
+
 	UniverseRoots(ctx context.Context,
 		params UniverseRootsParams) ([]BaseUniverseRoot, error)
 }
@@ -640,6 +642,107 @@ func (b *MultiverseStore) UniverseLeafKeys(ctx context.Context,
 	return leafKeys, nil
 }
 
+// UniverseBranch returns the branch at the given path of the MS-SMT of the
+// given universe.
+func (b *MultiverseStore) UniverseBranch(ctx context.Context,
+	id universe.Identifier, path mssmt.NodePath) (*universe.Branch, error) {
+
+	var branch *universe.Branch
+
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		var err error
+		branch, err = universeBranch(ctx, db, id.String(), path)
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return branch, nil
+}
+
+// ReadUniverseSnapshot reads the root and all leaves of the universe with the
+// given identifier within a single read transaction, so the leaves always
+// match the root. The leaves are read page by page, so only a single page of
+// proofs is held in memory at a time.
+//
+// NOTE: This is part of the universe.SnapshotSource interface.
+func (b *MultiverseStore) ReadUniverseSnapshot(ctx context.Context,
+	id universe.Identifier, pageSize int,
+	start func(root mssmt.Node, numLeaves uint64) error,
+	page func(leaves []*universe.SnapshotLeaf) error) error {
+
+	namespace := id.String()
+
+	readTx := NewBaseUniverseReadTx()
+	return b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		universeTree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(db, namespace),
+		)
+
+		root, err := universeTree.Root(ctx)
+		if err != nil {
+			return err
+		}
+
+		if root.NodeHash() == mssmt.EmptyTreeRootHash {
+			return universe.ErrNoUniverseRoot
+		}
+
+		numLeaves, err := db.CountUniverseLeaves(ctx, namespace)
+		if err != nil {
+			return err
+		}
+
+		if err := start(root, uint64(numLeaves)); err != nil {
+			return err
+		}
+
+		var afterID int64
+		for {
+			dbLeaves, err := db.FetchUniverseSnapshotLeaves(
+				ctx, UniverseSnapshotLeavesQuery{
+					Namespace: namespace,
+					AfterID:   afterID,
+					NumLimit:  int32(pageSize),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			if len(dbLeaves) == 0 {
+				return nil
+			}
+
+			leaves := make([]*universe.SnapshotLeaf, len(dbLeaves))
+			for i, dbLeaf := range dbLeaves {
+				leafKey, err := decodeLeafKey(
+					dbLeaf.MintingPoint,
+					dbLeaf.ScriptKeyBytes,
+				)
+				if err != nil {
+					return err
+				}
+
+				leaves[i] = &universe.SnapshotLeaf{
+					Key: leafKey,
+					Leaf: &universe.Leaf{
+						RawProof: dbLeaf.GenesisProof,
+						Amt:      uint64(dbLeaf.SumAmt),
+					},
+				}
+			}
+
+			if err := page(leaves); err != nil {
+				return err
+			}
+
+			afterID = dbLeaves[len(dbLeaves)-1].ID
+		}
+	})
+}
+
 // RootNodes returns the complete set of known base universe root nodes for the
 // set of base universes tracked in the multiverse.
 func (b *MultiverseStore) RootNodes(ctx context.Context,
@@ -930,6 +1033,15 @@ func (b *MultiverseStore) UpsertProofLeaf(ctx context.Context,
 		issuanceProof.MultiverseRoot = multiverseRoot
 		issuanceProof.MultiverseInclusionProof = multiverseInclusionProof
 
+		// Finally, we log the new leaf and the new root of the
+		// universe, so the events are only persisted together with the
+		// leaf.
+		_, err = insertUniverseEvents(
+			ctx, dbTx, time.Now(),
+			universe.NewLeafEvent(id, key, leaf),
+			universe.NewRootEvent(id, universeRoot),
+		)
+
 		return err
 	}
 	dbErr := b.db.ExecTx(ctx, &writeTx, execTxFunc)
@@ -953,7 +1065,7 @@ func (b *MultiverseStore) UpsertProofLeafBatch(ctx context.Context,
 	items []*universe.Item) error {
 
 	insertProof := func(item *universe.Item,
-		dbTx BaseMultiverseStore) error {
+		dbTx BaseMultiverseStore) (mssmt.Node, error) {
 
 		// Upsert proof leaf into the asset (group) specific universe
 		// tree.
@@ -962,12 +1074,12 @@ func (b *MultiverseStore) UpsertProofLeafBatch(ctx context.Context,
 			item.MetaReveal,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		multiverseNS, err := namespaceForProof(item.ID.ProofType)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Retrieve a handle to the multiverse tree so that we can
@@ -996,24 +1108,55 @@ func (b *MultiverseStore) UpsertProofLeafBatch(ctx context.Context,
 
 		_, err = multiverseTree.Insert(ctx, leafNodeKey, leafNode)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		return nil
+		return universeRoot, nil
 	}
 
 	var writeTx BaseMultiverseOptions
 	dbErr := b.db.ExecTx(
 		ctx, &writeTx, func(store BaseMultiverseStore) error {
+			events := make([]*universe.Event, 0, len(items))
+
+			// We log a new leaf event for each item, followed by
+			// the final root of each universe that was updated.
+			// The group key pointers of items of the same universe
+			// can differ, so we key the universes by their string
+			// representation.
+			var (
+				rootIDs []string
+				roots   = make(map[string]*universe.Event)
+			)
 			for idx := range items {
 				item := items[idx]
-				err := insertProof(item, store)
+				root, err := insertProof(item, store)
 				if err != nil {
 					return err
 				}
+
+				events = append(events, universe.NewLeafEvent(
+					item.ID, item.Key, item.Leaf,
+				))
+
+				idStr := item.ID.String()
+				if _, ok := roots[idStr]; !ok {
+					rootIDs = append(rootIDs, idStr)
+				}
+				roots[idStr] = universe.NewRootEvent(
+					item.ID, root,
+				)
 			}
 
-			return nil
+			for _, idStr := range rootIDs {
+				events = append(events, roots[idStr])
+			}
+
+			_, err := insertUniverseEvents(
+				ctx, store, time.Now(), events...,
+			)
+
+			return err
 		},
 	)
 	if dbErr != nil {
@@ -87,7 +87,7 @@ func (q *Queries) AllInternalKeys(ctx context.Context) ([]InternalKey, error) {
 }
 
 const allMintingBatches = `-- name: AllMintingBatches :many
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, key_id, raw_key, key_family, key_index 
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, external_funding, finalize_height, finalize_time, batch_name, key_id, raw_key, key_family, key_index 
 FROM asset_minting_batches
 JOIN internal_keys 
 ON asset_minting_batches.batch_id = internal_keys.key_id
@@ -101,6 +101,10 @@ type AllMintingBatchesRow struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	ExternalFunding   bool
+	FinalizeHeight    sql.NullInt32
+	FinalizeTime      sql.NullTime
+	BatchName         string
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -124,6 +128,10 @@ func (q *Queries) AllMintingBatches(ctx context.Context) ([]AllMintingBatchesRow
 			&i.GenesisID,
 			&i.HeightHint,
 			&i.CreationTimeUnix,
+			&i.ExternalFunding,
+			&i.FinalizeHeight,
+			&i.FinalizeTime,
+			&i.BatchName,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -337,7 +345,8 @@ WITH target_batch AS (
     WHERE keys.raw_key = $1
 )
 UPDATE asset_minting_batches 
-SET minting_tx_psbt = $2, change_output_index = $3, genesis_id = $4
+SET minting_tx_psbt = $2, change_output_index = $3, genesis_id = $4,
+    external_funding = $5
 WHERE batch_id IN (SELECT batch_id FROM target_batch)
 `
 
@@ -346,6 +355,7 @@ type BindMintingBatchWithTxParams struct {
 	MintingTxPsbt     []byte
 	ChangeOutputIndex sql.NullInt32
 	GenesisID         sql.NullInt64
+	ExternalFunding   bool
 }
 
 func (q *Queries) BindMintingBatchWithTx(ctx context.Context, arg BindMintingBatchWithTxParams) error {
@@ -354,6 +364,7 @@ func (q *Queries) BindMintingBatchWithTx(ctx context.Context, arg BindMintingBat
 		arg.MintingTxPsbt,
 		arg.ChangeOutputIndex,
 		arg.GenesisID,
+		arg.ExternalFunding,
 	)
 	return err
 }
@@ -413,6 +424,16 @@ func (q *Queries) ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams)
 	return err
 }
 
+const deleteAssetSeedling = `-- name: DeleteAssetSeedling :exec
+DELETE FROM asset_seedlings
+WHERE seedling_id = $1
+`
+
+func (q *Queries) DeleteAssetSeedling(ctx context.Context, seedlingID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetSeedling, seedlingID)
+	return err
+}
+
 const deleteExpiredUTXOLeases = `-- name: DeleteExpiredUTXOLeases :exec
 UPDATE managed_utxos
 SET lease_owner = NULL, lease_expiry = NULL
@@ -426,6 +447,23 @@ func (q *Queries) DeleteExpiredUTXOLeases(ctx context.Context, now sql.NullTime)
 	return err
 }
 
+const deleteFrozenBatchSprouts = `-- name: DeleteFrozenBatchSprouts :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+DELETE FROM frozen_batch_sprouts
+WHERE batch_id IN (SELECT batch_id FROM target_batch)
+`
+
+func (q *Queries) DeleteFrozenBatchSprouts(ctx context.Context, rawKey []byte) error {
+	_, err := q.db.ExecContext(ctx, deleteFrozenBatchSprouts, rawKey)
+	return err
+}
+
 const deleteManagedUTXO = `-- name: DeleteManagedUTXO :exec
 DELETE FROM managed_utxos
 WHERE outpoint = $1
@@ -436,6 +474,28 @@ func (q *Queries) DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 	return err
 }
 
+const deleteReplacedGenesisTx = `-- name: DeleteReplacedGenesisTx :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+DELETE FROM replaced_genesis_txns
+WHERE batch_id IN (SELECT batch_id FROM target_batch) AND txid = $2
+`
+
+type DeleteReplacedGenesisTxParams struct {
+	RawKey []byte
+	Txid   []byte
+}
+
+func (q *Queries) DeleteReplacedGenesisTx(ctx context.Context, arg DeleteReplacedGenesisTxParams) error {
+	_, err := q.db.ExecContext(ctx, deleteReplacedGenesisTx, arg.RawKey, arg.Txid)
+	return err
+}
+
 const deleteUTXOLease = `-- name: DeleteUTXOLease :exec
 UPDATE managed_utxos
 SET lease_owner = NULL, lease_expiry = NULL
@@ -866,6 +926,38 @@ func (q *Queries) FetchAssetsForBatch(ctx context.Context, rawKey []byte) ([]Fet
 	return items, nil
 }
 
+const fetchBatchFinalizeParams = `-- name: FetchBatchFinalizeParams :one
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+SELECT fee_rate, conf_target, genesis_inputs, external_genesis_psbt
+FROM batch_finalize_params
+WHERE batch_id IN (SELECT batch_id FROM target_batch)
+`
+
+type FetchBatchFinalizeParamsRow struct {
+	FeeRate             sql.NullInt64
+	ConfTarget          int32
+	GenesisInputs       []byte
+	ExternalGenesisPsbt []byte
+}
+
+func (q *Queries) FetchBatchFinalizeParams(ctx context.Context, rawKey []byte) (FetchBatchFinalizeParamsRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchBatchFinalizeParams, rawKey)
+	var i FetchBatchFinalizeParamsRow
+	err := row.Scan(
+		&i.FeeRate,
+		&i.ConfTarget,
+		&i.GenesisInputs,
+		&i.ExternalGenesisPsbt,
+	)
+	return i, err
+}
+
 const fetchChainTx = `-- name: FetchChainTx :one
 SELECT txn_id, txid, chain_fees, raw_tx, block_height, block_hash, tx_index
 FROM chain_txns
@@ -887,6 +979,96 @@ func (q *Queries) FetchChainTx(ctx context.Context, txid []byte) (ChainTxn, erro
 	return i, err
 }
 
+const fetchDecimalDisplay = `-- name: FetchDecimalDisplay :one
+SELECT decimal_display
+FROM genesis_assets
+WHERE asset_id = $1
+`
+
+func (q *Queries) FetchDecimalDisplay(ctx context.Context, assetID []byte) (sql.NullInt32, error) {
+	row := q.db.QueryRowContext(ctx, fetchDecimalDisplay, assetID)
+	var decimal_display sql.NullInt32
+	err := row.Scan(&decimal_display)
+	return decimal_display, err
+}
+
+const fetchFrozenBatchSprouts = `-- name: FetchFrozenBatchSprouts :many
+WITH target_batch(batch_id) AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+SELECT sprout_id, asset_sprout, script_keys.raw_key AS script_key_raw,
+    script_keys.key_family AS script_key_family,
+    script_keys.key_index AS script_key_index,
+    group_keys.raw_key AS group_key_raw,
+    group_keys.key_family AS group_key_family,
+    group_keys.key_index AS group_key_index, group_tapscript_root,
+    single_tweak, virtual_tx, prev_out_value, prev_out_script
+FROM frozen_batch_sprouts sprouts
+JOIN internal_keys script_keys
+    ON sprouts.script_key_id = script_keys.key_id
+LEFT JOIN internal_keys group_keys
+    ON sprouts.group_key_id = group_keys.key_id
+WHERE sprouts.batch_id IN (SELECT batch_id FROM target_batch)
+ORDER BY sprout_id
+`
+
+type FetchFrozenBatchSproutsRow struct {
+	SproutID           int64
+	AssetSprout        []byte
+	ScriptKeyRaw       []byte
+	ScriptKeyFamily    int32
+	ScriptKeyIndex     int32
+	GroupKeyRaw        []byte
+	GroupKeyFamily     sql.NullInt32
+	GroupKeyIndex      sql.NullInt32
+	GroupTapscriptRoot []byte
+	SingleTweak        []byte
+	VirtualTx          []byte
+	PrevOutValue       sql.NullInt64
+	PrevOutScript      []byte
+}
+
+func (q *Queries) FetchFrozenBatchSprouts(ctx context.Context, rawKey []byte) ([]FetchFrozenBatchSproutsRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchFrozenBatchSprouts, rawKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchFrozenBatchSproutsRow
+	for rows.Next() {
+		var i FetchFrozenBatchSproutsRow
+		if err := rows.Scan(
+			&i.SproutID,
+			&i.AssetSprout,
+			&i.ScriptKeyRaw,
+			&i.ScriptKeyFamily,
+			&i.ScriptKeyIndex,
+			&i.GroupKeyRaw,
+			&i.GroupKeyFamily,
+			&i.GroupKeyIndex,
+			&i.GroupTapscriptRoot,
+			&i.SingleTweak,
+			&i.VirtualTx,
+			&i.PrevOutValue,
+			&i.PrevOutScript,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchGenesisByAssetID = `-- name: FetchGenesisByAssetID :one
 SELECT gen_asset_id, asset_id, asset_tag, meta_hash, output_index, asset_type, prev_out, block_height 
 FROM genesis_info_view
@@ -1273,7 +1455,7 @@ WITH target_batch AS (
         ON batches.batch_id = keys.key_id
     WHERE keys.raw_key = $1
 )
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, external_funding, finalize_height, finalize_time, batch_name, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -1288,6 +1470,10 @@ type FetchMintingBatchRow struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	ExternalFunding   bool
+	FinalizeHeight    sql.NullInt32
+	FinalizeTime      sql.NullTime
+	BatchName         string
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -1305,6 +1491,10 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 		&i.GenesisID,
 		&i.HeightHint,
 		&i.CreationTimeUnix,
+		&i.ExternalFunding,
+		&i.FinalizeHeight,
+		&i.FinalizeTime,
+		&i.BatchName,
 		&i.KeyID,
 		&i.RawKey,
 		&i.KeyFamily,
@@ -1314,7 +1504,7 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 }
 
 const fetchMintingBatchesByInverseState = `-- name: FetchMintingBatchesByInverseState :many
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, external_funding, finalize_height, finalize_time, batch_name, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -1329,6 +1519,10 @@ type FetchMintingBatchesByInverseStateRow struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	ExternalFunding   bool
+	FinalizeHeight    sql.NullInt32
+	FinalizeTime      sql.NullTime
+	BatchName         string
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -1352,6 +1546,10 @@ func (q *Queries) FetchMintingBatchesByInverseState(ctx context.Context, batchSt
 			&i.GenesisID,
 			&i.HeightHint,
 			&i.CreationTimeUnix,
+			&i.ExternalFunding,
+			&i.FinalizeHeight,
+			&i.FinalizeTime,
+			&i.BatchName,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -1370,6 +1568,49 @@ func (q *Queries) FetchMintingBatchesByInverseState(ctx context.Context, batchSt
 	return items, nil
 }
 
+const fetchReplacedGenesisTxns = `-- name: FetchReplacedGenesisTxns :many
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+SELECT txid, minting_tx_psbt, chain_fees
+FROM replaced_genesis_txns
+WHERE batch_id IN (SELECT batch_id FROM target_batch)
+ORDER BY id
+`
+
+type FetchReplacedGenesisTxnsRow struct {
+	Txid          []byte
+	MintingTxPsbt []byte
+	ChainFees     int64
+}
+
+func (q *Queries) FetchReplacedGenesisTxns(ctx context.Context, rawKey []byte) ([]FetchReplacedGenesisTxnsRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchReplacedGenesisTxns, rawKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchReplacedGenesisTxnsRow
+	for rows.Next() {
+		var i FetchReplacedGenesisTxnsRow
+		if err := rows.Scan(&i.Txid, &i.MintingTxPsbt, &i.ChainFees); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchScriptKeyByTweakedKey = `-- name: FetchScriptKeyByTweakedKey :one
 SELECT tweak, raw_key, key_family, key_index
 FROM script_keys
@@ -1411,7 +1652,7 @@ func (q *Queries) FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScrip
 }
 
 const fetchSeedlingByID = `-- name: FetchSeedlingByID :one
-SELECT seedling_id, asset_name, asset_version, asset_type, asset_supply, asset_meta_id, emission_enabled, batch_id, group_genesis_id, group_anchor_id
+SELECT seedling_id, asset_name, asset_version, asset_type, asset_supply, asset_meta_id, emission_enabled, batch_id, group_genesis_id, group_anchor_id, external_group_key, group_tapscript_root
 FROM asset_seedlings
 WHERE seedling_id = $1
 `
@@ -1430,6 +1671,8 @@ func (q *Queries) FetchSeedlingByID(ctx context.Context, seedlingID int64) (Asse
 		&i.BatchID,
 		&i.GroupGenesisID,
 		&i.GroupAnchorID,
+		&i.ExternalGroupKey,
+		&i.GroupTapscriptRoot,
 	)
 	return i, err
 }
@@ -1475,7 +1718,8 @@ WITH target_batch(batch_id) AS (
 SELECT seedling_id, asset_name, asset_type, asset_version, asset_supply, 
     assets_meta.meta_data_hash, assets_meta.meta_data_type, 
     assets_meta.meta_data_blob, emission_enabled, batch_id, 
-    group_genesis_id, group_anchor_id
+    group_genesis_id, group_anchor_id, external_group_key,
+    group_tapscript_root
 FROM asset_seedlings 
 LEFT JOIN assets_meta
     ON asset_seedlings.asset_meta_id = assets_meta.meta_id
@@ -1483,18 +1727,20 @@ WHERE asset_seedlings.batch_id in (SELECT batch_id FROM target_batch)
 `
 
 type FetchSeedlingsForBatchRow struct {
-	SeedlingID      int64
-	AssetName       string
-	AssetType       int16
-	AssetVersion    int16
-	AssetSupply     int64
-	MetaDataHash    []byte
-	MetaDataType    sql.NullInt16
-	MetaDataBlob    []byte
-	EmissionEnabled bool
-	BatchID         int64
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	SeedlingID         int64
+	AssetName          string
+	AssetType          int16
+	AssetVersion       int16
+	AssetSupply        int64
+	MetaDataHash       []byte
+	MetaDataType       sql.NullInt16
+	MetaDataBlob       []byte
+	EmissionEnabled    bool
+	BatchID            int64
+	GroupGenesisID     sql.NullInt64
+	GroupAnchorID      sql.NullInt64
+	ExternalGroupKey   []byte
+	GroupTapscriptRoot []byte
 }
 
 func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]FetchSeedlingsForBatchRow, error) {
@@ -1519,6 +1765,8 @@ func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]
 			&i.BatchID,
 			&i.GroupGenesisID,
 			&i.GroupAnchorID,
+			&i.ExternalGroupKey,
+			&i.GroupTapscriptRoot,
 		); err != nil {
 			return nil, err
 		}
@@ -1534,7 +1782,7 @@ func (q *Queries) FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]
 }
 
 const genesisAssets = `-- name: GenesisAssets :many
-SELECT gen_asset_id, asset_id, asset_tag, meta_data_id, output_index, asset_type, genesis_point_id 
+SELECT gen_asset_id, asset_id, asset_tag, meta_data_id, output_index, asset_type, genesis_point_id, decimal_display 
 FROM genesis_assets
 `
 
@@ -1555,6 +1803,7 @@ func (q *Queries) GenesisAssets(ctx context.Context) ([]GenesisAsset, error) {
 			&i.OutputIndex,
 			&i.AssetType,
 			&i.GenesisPointID,
+			&i.DecimalDisplay,
 		); err != nil {
 			return nil, err
 		}
@@ -1600,23 +1849,27 @@ func (q *Queries) GenesisPoints(ctx context.Context) ([]GenesisPoint, error) {
 const insertAssetSeedling = `-- name: InsertAssetSeedling :exec
 INSERT INTO asset_seedlings (
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    external_group_key, group_tapscript_root
 ) VALUES (
    $1, $2, $3, $4, $5, $6, $7,
-   $8, $9
+   $8, $9,
+   $10, $11
 )
 `
 
 type InsertAssetSeedlingParams struct {
-	AssetName       string
-	AssetType       int16
-	AssetVersion    int16
-	AssetSupply     int64
-	AssetMetaID     int64
-	EmissionEnabled bool
-	BatchID         int64
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	AssetName          string
+	AssetType          int16
+	AssetVersion       int16
+	AssetSupply        int64
+	AssetMetaID        int64
+	EmissionEnabled    bool
+	BatchID            int64
+	GroupGenesisID     sql.NullInt64
+	GroupAnchorID      sql.NullInt64
+	ExternalGroupKey   []byte
+	GroupTapscriptRoot []byte
 }
 
 func (q *Queries) InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedlingParams) error {
@@ -1630,6 +1883,8 @@ func (q *Queries) InsertAssetSeedling(ctx context.Context, arg InsertAssetSeedli
 		arg.BatchID,
 		arg.GroupGenesisID,
 		arg.GroupAnchorID,
+		arg.ExternalGroupKey,
+		arg.GroupTapscriptRoot,
 	)
 	return err
 }
@@ -1647,24 +1902,28 @@ WITH target_key_id AS (
 )
 INSERT INTO asset_seedlings(
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    external_group_key, group_tapscript_root
 ) VALUES (
     $2, $3, $4, $5, $6, $7,
     (SELECT key_id FROM target_key_id),
-    $8, $9
+    $8, $9,
+    $10, $11
 )
 `
 
 type InsertAssetSeedlingIntoBatchParams struct {
-	RawKey          []byte
-	AssetName       string
-	AssetType       int16
-	AssetVersion    int16
-	AssetSupply     int64
-	AssetMetaID     int64
-	EmissionEnabled bool
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	RawKey             []byte
+	AssetName          string
+	AssetType          int16
+	AssetVersion       int16
+	AssetSupply        int64
+	AssetMetaID        int64
+	EmissionEnabled    bool
+	GroupGenesisID     sql.NullInt64
+	GroupAnchorID      sql.NullInt64
+	ExternalGroupKey   []byte
+	GroupTapscriptRoot []byte
 }
 
 func (q *Queries) InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAssetSeedlingIntoBatchParams) error {
@@ -1678,6 +1937,8 @@ func (q *Queries) InsertAssetSeedlingIntoBatch(ctx context.Context, arg InsertAs
 		arg.EmissionEnabled,
 		arg.GroupGenesisID,
 		arg.GroupAnchorID,
+		arg.ExternalGroupKey,
+		arg.GroupTapscriptRoot,
 	)
 	return err
 }
@@ -1712,6 +1973,51 @@ func (q *Queries) InsertAssetWitness(ctx context.Context, arg InsertAssetWitness
 	return err
 }
 
+const insertFrozenBatchSprout = `-- name: InsertFrozenBatchSprout :exec
+WITH target_key_id AS (
+    SELECT key_id
+    FROM internal_keys keys
+    WHERE keys.raw_key = $1
+)
+INSERT INTO frozen_batch_sprouts(
+    batch_id, asset_sprout, script_key_id, group_key_id,
+    group_tapscript_root, single_tweak, virtual_tx, prev_out_value,
+    prev_out_script
+) VALUES (
+    (SELECT key_id FROM target_key_id), $2, $3, $4,
+    $5, $6,
+    $7, $8,
+    $9
+)
+`
+
+type InsertFrozenBatchSproutParams struct {
+	RawKey             []byte
+	AssetSprout        []byte
+	ScriptKeyID        int64
+	GroupKeyID         sql.NullInt64
+	GroupTapscriptRoot []byte
+	SingleTweak        []byte
+	VirtualTx          []byte
+	PrevOutValue       sql.NullInt64
+	PrevOutScript      []byte
+}
+
+func (q *Queries) InsertFrozenBatchSprout(ctx context.Context, arg InsertFrozenBatchSproutParams) error {
+	_, err := q.db.ExecContext(ctx, insertFrozenBatchSprout,
+		arg.RawKey,
+		arg.AssetSprout,
+		arg.ScriptKeyID,
+		arg.GroupKeyID,
+		arg.GroupTapscriptRoot,
+		arg.SingleTweak,
+		arg.VirtualTx,
+		arg.PrevOutValue,
+		arg.PrevOutScript,
+	)
+	return err
+}
+
 const insertNewAsset = `-- name: InsertNewAsset :one
 INSERT INTO assets (
     genesis_id, version, script_key_id, asset_group_witness_id, script_version, 
@@ -1752,20 +2058,58 @@ func (q *Queries) InsertNewAsset(ctx context.Context, arg InsertNewAssetParams)
 	return asset_id, err
 }
 
+const insertReplacedGenesisTx = `-- name: InsertReplacedGenesisTx :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+INSERT INTO replaced_genesis_txns (
+    batch_id, txid, minting_tx_psbt, chain_fees
+) VALUES (
+    (SELECT batch_id FROM target_batch), $2, $3, $4
+)
+`
+
+type InsertReplacedGenesisTxParams struct {
+	RawKey        []byte
+	Txid          []byte
+	MintingTxPsbt []byte
+	ChainFees     int64
+}
+
+func (q *Queries) InsertReplacedGenesisTx(ctx context.Context, arg InsertReplacedGenesisTxParams) error {
+	_, err := q.db.ExecContext(ctx, insertReplacedGenesisTx,
+		arg.RawKey,
+		arg.Txid,
+		arg.MintingTxPsbt,
+		arg.ChainFees,
+	)
+	return err
+}
+
 const newMintingBatch = `-- name: NewMintingBatch :exec
 INSERT INTO asset_minting_batches (
-    batch_state, batch_id, height_hint, creation_time_unix
-) VALUES (0, $1, $2, $3)
+    batch_state, batch_id, height_hint, creation_time_unix, batch_name
+) VALUES (0, $1, $2, $3, $4)
 `
 
 type NewMintingBatchParams struct {
 	BatchID          int64
 	HeightHint       int32
 	CreationTimeUnix time.Time
+	BatchName        string
 }
 
 func (q *Queries) NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error {
-	_, err := q.db.ExecContext(ctx, newMintingBatch, arg.BatchID, arg.HeightHint, arg.CreationTimeUnix)
+	_, err := q.db.ExecContext(ctx, newMintingBatch,
+		arg.BatchID,
+		arg.HeightHint,
+		arg.CreationTimeUnix,
+		arg.BatchName,
+	)
 	return err
 }
 
@@ -2081,6 +2425,23 @@ func (q *Queries) QueryAssets(ctx context.Context, arg QueryAssetsParams) ([]Que
 	return items, nil
 }
 
+const reAnchorManagedUTXO = `-- name: ReAnchorManagedUTXO :exec
+UPDATE managed_utxos
+SET outpoint = $1, txn_id = $2
+WHERE outpoint = $3
+`
+
+type ReAnchorManagedUTXOParams struct {
+	NewOutpoint []byte
+	NewTxnID    int64
+	OldOutpoint []byte
+}
+
+func (q *Queries) ReAnchorManagedUTXO(ctx context.Context, arg ReAnchorManagedUTXOParams) error {
+	_, err := q.db.ExecContext(ctx, reAnchorManagedUTXO, arg.NewOutpoint, arg.NewTxnID, arg.OldOutpoint)
+	return err
+}
+
 const setAssetSpent = `-- name: SetAssetSpent :one
 WITH target_asset(asset_id) AS (
     SELECT assets.asset_id
@@ -2113,6 +2474,31 @@ func (q *Queries) SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (i
 	return asset_id, err
 }
 
+const updateAssetSeedling = `-- name: UpdateAssetSeedling :exec
+UPDATE asset_seedlings
+SET asset_supply = $1,
+    asset_meta_id = $2,
+    group_anchor_id = $3
+WHERE seedling_id = $4
+`
+
+type UpdateAssetSeedlingParams struct {
+	AssetSupply   int64
+	AssetMetaID   int64
+	GroupAnchorID sql.NullInt64
+	SeedlingID    int64
+}
+
+func (q *Queries) UpdateAssetSeedling(ctx context.Context, arg UpdateAssetSeedlingParams) error {
+	_, err := q.db.ExecContext(ctx, updateAssetSeedling,
+		arg.AssetSupply,
+		arg.AssetMetaID,
+		arg.GroupAnchorID,
+		arg.SeedlingID,
+	)
+	return err
+}
+
 const updateBatchGenesisTx = `-- name: UpdateBatchGenesisTx :exec
 WITH target_batch AS (
     SELECT batch_id
@@ -2136,6 +2522,31 @@ func (q *Queries) UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenes
 	return err
 }
 
+const updateMintingBatchSchedule = `-- name: UpdateMintingBatchSchedule :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET finalize_height = $2,
+    finalize_time = $3
+WHERE batch_id IN (SELECT batch_id FROM target_batch)
+`
+
+type UpdateMintingBatchScheduleParams struct {
+	RawKey         []byte
+	FinalizeHeight sql.NullInt32
+	FinalizeTime   sql.NullTime
+}
+
+func (q *Queries) UpdateMintingBatchSchedule(ctx context.Context, arg UpdateMintingBatchScheduleParams) error {
+	_, err := q.db.ExecContext(ctx, updateMintingBatchSchedule, arg.RawKey, arg.FinalizeHeight, arg.FinalizeTime)
+	return err
+}
+
 const updateMintingBatchState = `-- name: UpdateMintingBatchState :exec
 WITH target_batch AS (
     -- This CTE is used to fetch the ID of a batch, based on the serialized
@@ -2298,6 +2709,44 @@ func (q *Queries) UpsertAssetProof(ctx context.Context, arg UpsertAssetProofPara
 	return err
 }
 
+const upsertBatchFinalizeParams = `-- name: UpsertBatchFinalizeParams :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+INSERT INTO batch_finalize_params (
+    batch_id, fee_rate, conf_target, genesis_inputs, external_genesis_psbt
+) VALUES (
+    (SELECT batch_id FROM target_batch), $2, $3, $4, $5
+) ON CONFLICT (batch_id)
+    DO UPDATE SET fee_rate = EXCLUDED.fee_rate,
+        conf_target = EXCLUDED.conf_target,
+        genesis_inputs = EXCLUDED.genesis_inputs,
+        external_genesis_psbt = EXCLUDED.external_genesis_psbt
+`
+
+type UpsertBatchFinalizeParamsParams struct {
+	RawKey              []byte
+	FeeRate             sql.NullInt64
+	ConfTarget          int32
+	GenesisInputs       []byte
+	ExternalGenesisPsbt []byte
+}
+
+func (q *Queries) UpsertBatchFinalizeParams(ctx context.Context, arg UpsertBatchFinalizeParamsParams) error {
+	_, err := q.db.ExecContext(ctx, upsertBatchFinalizeParams,
+		arg.RawKey,
+		arg.FeeRate,
+		arg.ConfTarget,
+		arg.GenesisInputs,
+		arg.ExternalGenesisPsbt,
+	)
+	return err
+}
+
 const upsertChainTx = `-- name: UpsertChainTx :one
 INSERT INTO chain_txns (
     txid, raw_tx, chain_fees, block_height, block_hash, tx_index
@@ -2343,12 +2792,17 @@ WITH target_meta_id AS (
     WHERE meta_data_hash = $1
 )
 INSERT INTO genesis_assets (
-    asset_id, asset_tag, meta_data_id, output_index, asset_type, genesis_point_id
+    asset_id, asset_tag, meta_data_id, output_index, asset_type,
+    genesis_point_id, decimal_display
 ) VALUES (
-    $2, $3, (SELECT meta_id FROM target_meta_id), $4, $5, $6
+    $2, $3, (SELECT meta_id FROM target_meta_id), $4, $5, $6,
+    $7
 ) ON CONFLICT (asset_id)
-    -- This is a NOP, asset_id is the unique field that caused the conflict.
-    DO UPDATE SET asset_id = EXCLUDED.asset_id
+    -- The decimal display is derived from the meta reveal, so we only fill it
+    -- in if it wasn't known before.
+    DO UPDATE SET decimal_display = COALESCE(
+        genesis_assets.decimal_display, EXCLUDED.decimal_display
+    )
 RETURNING gen_asset_id
 `
 
@@ -2359,6 +2813,7 @@ type UpsertGenesisAssetParams struct {
 	OutputIndex    int32
 	AssetType      int16
 	GenesisPointID int64
+	DecimalDisplay sql.NullInt32
 }
 
 func (q *Queries) UpsertGenesisAsset(ctx context.Context, arg UpsertGenesisAssetParams) (int64, error) {
@@ -2369,6 +2824,7 @@ func (q *Queries) UpsertGenesisAsset(ctx context.Context, arg UpsertGenesisAsset
 		arg.OutputIndex,
 		arg.AssetType,
 		arg.GenesisPointID,
+		arg.DecimalDisplay,
 	)
 	var gen_asset_id int64
 	err := row.Scan(&gen_asset_id)
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS replaced_anchor_txns_transfer_idx;
+DROP TABLE IF EXISTS replaced_anchor_txns;
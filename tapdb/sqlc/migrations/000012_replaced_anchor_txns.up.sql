@@ -0,0 +1,12 @@
+-- replaced_anchor_txns keeps track of the anchor transactions of an asset
+-- transfer that were replaced by a transaction paying a higher fee (RBF). The
+-- transfer itself always references the latest anchor transaction.
+CREATE TABLE IF NOT EXISTS replaced_anchor_txns (
+    id BIGINT PRIMARY KEY,
+
+    transfer_id BIGINT NOT NULL REFERENCES asset_transfers(id),
+
+    txn_id BIGINT NOT NULL REFERENCES chain_txns(txn_id)
+);
+CREATE INDEX IF NOT EXISTS replaced_anchor_txns_transfer_idx
+    ON replaced_anchor_txns (transfer_id);
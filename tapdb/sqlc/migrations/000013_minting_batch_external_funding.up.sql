@@ -0,0 +1,3 @@
+-- external_funding is true if the minting transaction of a batch is funded and
+-- signed by an external wallet instead of the backing lnd wallet.
+ALTER TABLE asset_minting_batches ADD COLUMN external_funding BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS recurring_mints;
+ALTER TABLE asset_minting_batches DROP COLUMN finalize_time;
+ALTER TABLE asset_minting_batches DROP COLUMN finalize_height;
@@ -0,0 +1,42 @@
+-- finalize_height and finalize_time optionally schedule a pending batch to be
+-- finalized once the chain reaches the given block height or once the given
+-- point in time has passed.
+ALTER TABLE asset_minting_batches ADD COLUMN finalize_height INTEGER;
+ALTER TABLE asset_minting_batches ADD COLUMN finalize_time TIMESTAMP;
+
+-- recurring_mints stores the schedules of recurring issuance events that each
+-- mint new units of an existing asset group once per epoch. An epoch is either
+-- a number of blocks or a duration in seconds.
+CREATE TABLE IF NOT EXISTS recurring_mints (
+    id BIGINT PRIMARY KEY,
+
+    -- asset_name is the name of the asset minted in each epoch.
+    asset_name TEXT NOT NULL,
+
+    -- asset_supply is the number of units minted in each epoch.
+    asset_supply BIGINT NOT NULL,
+
+    -- group_key is the tweaked group key of the group the new units are
+    -- minted into.
+    group_key BLOB NOT NULL,
+
+    -- Exactly one of interval_blocks and interval_seconds is non-zero.
+    interval_blocks INTEGER NOT NULL,
+
+    interval_seconds BIGINT NOT NULL,
+
+    -- next_height is the block height of the next issuance for block based
+    -- schedules.
+    next_height INTEGER,
+
+    -- next_time is the time of the next issuance for time based schedules.
+    next_time TIMESTAMP,
+
+    -- max_epochs is the total number of issuance events, zero if the
+    -- schedule repeats indefinitely.
+    max_epochs INTEGER NOT NULL,
+
+    epochs_minted INTEGER NOT NULL,
+
+    creation_time_unix TIMESTAMP NOT NULL
+);
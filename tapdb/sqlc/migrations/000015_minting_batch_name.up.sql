@@ -0,0 +1,4 @@
+-- batch_name is the name of a pending batch, which allows seedlings to be
+-- collected in multiple isolated pending batches at the same time. The default
+-- pending batch has an empty name.
+ALTER TABLE asset_minting_batches ADD COLUMN batch_name TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE asset_seedlings DROP COLUMN group_tapscript_root;
+ALTER TABLE asset_seedlings DROP COLUMN external_group_key;
@@ -0,0 +1,8 @@
+-- external_group_key is the raw group key of a new asset group that is held
+-- outside of the daemon, for example in an HSM. The group witness of such an
+-- asset is provided externally before the batch is broadcast.
+ALTER TABLE asset_seedlings ADD COLUMN external_group_key BLOB;
+
+-- group_tapscript_root is the optional root of the tapscript tree the tweaked
+-- group key of a new asset group with an external group key commits to.
+ALTER TABLE asset_seedlings ADD COLUMN group_tapscript_root BLOB;
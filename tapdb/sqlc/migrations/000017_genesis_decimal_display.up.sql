@@ -0,0 +1,4 @@
+-- decimal_display is the number of decimal places the issuer of an asset
+-- committed to in the JSON meta reveal of the asset. It's used to render
+-- human-readable amounts, and is NULL if the asset doesn't commit to a value.
+ALTER TABLE genesis_assets ADD COLUMN decimal_display INTEGER;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS universe_commitments_height_idx;
+DROP TABLE IF EXISTS universe_commitments;
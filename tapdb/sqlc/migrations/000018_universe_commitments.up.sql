@@ -0,0 +1,36 @@
+-- universe_commitments stores the on-chain commitments of the issuance
+-- multiverse root. Each commitment is a confirmed transaction with a taproot
+-- output that commits to the root.
+CREATE TABLE IF NOT EXISTS universe_commitments (
+    id BIGINT PRIMARY KEY,
+
+    -- block_height is the height of the block the anchor transaction
+    -- confirmed in.
+    block_height INTEGER NOT NULL,
+
+    -- block_header is the serialized header of the block the anchor
+    -- transaction confirmed in.
+    block_header BLOB NOT NULL,
+
+    -- merkle_proof is the serialized merkle proof of the anchor transaction
+    -- within the block.
+    merkle_proof BLOB NOT NULL,
+
+    -- anchor_tx is the serialized anchor transaction.
+    anchor_tx BLOB NOT NULL,
+
+    -- output_index is the index of the output of the anchor transaction
+    -- that commits to the multiverse root.
+    output_index INTEGER NOT NULL,
+
+    -- internal_key is the internal key of the anchor output.
+    internal_key BLOB NOT NULL,
+
+    -- root_hash and root_sum identify the committed multiverse root.
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+
+    root_sum BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS universe_commitments_height_idx
+    ON universe_commitments (block_height);
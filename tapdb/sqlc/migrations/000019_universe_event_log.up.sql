@@ -0,0 +1,36 @@
+-- universe_event_log is a log of the changes to the local Universe. Events
+-- are delivered to subscribers in the order of their id, which is used as
+-- the cursor to resume a subscription from.
+CREATE TABLE IF NOT EXISTS universe_event_log (
+    id BIGINT PRIMARY KEY,
+
+    event_type TEXT NOT NULL CHECK(
+        event_type IN ('new_leaf', 'new_root', 'sync')
+    ),
+
+    -- asset_id and group_key identify the universe of the event, only one
+    -- of them is set.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    group_key BLOB CHECK(LENGTH(group_key) = 33),
+
+    proof_type TEXT NOT NULL CHECK(proof_type IN ('issuance', 'transfer')),
+
+    -- leaf_outpoint, leaf_script_key and leaf_asset_id identify the new
+    -- leaf of a new leaf event.
+    leaf_outpoint BLOB,
+
+    leaf_script_key BLOB,
+
+    leaf_asset_id BLOB CHECK(length(leaf_asset_id) = 32),
+
+    -- root_hash and root_sum identify the universe root after the event.
+    root_hash BLOB CHECK(length(root_hash) = 32),
+
+    root_sum BIGINT,
+
+    -- server_host is the remote Universe server of a sync event.
+    server_host TEXT,
+
+    event_timestamp BIGINT NOT NULL
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS frozen_batch_sprouts_batch_idx;
+DROP TABLE IF EXISTS frozen_batch_sprouts;
@@ -0,0 +1,36 @@
+-- frozen_batch_sprouts stores the sprouts of a frozen batch that is waiting
+-- for group witnesses signed with an external group key. The genesis packet
+-- the sprouts commit to is stored in the batch itself, so the batch can be
+-- resumed after a restart without funding a new genesis packet.
+CREATE TABLE IF NOT EXISTS frozen_batch_sprouts (
+    sprout_id BIGINT PRIMARY KEY,
+
+    batch_id BIGINT NOT NULL REFERENCES asset_minting_batches(batch_id),
+
+    -- asset_sprout is the encoded sprout, which lacks its group witness if
+    -- the witness must be signed externally.
+    asset_sprout BLOB NOT NULL,
+
+    -- script_key_id references the internal key the BIP-0086 script key of
+    -- the sprout is derived from.
+    script_key_id BIGINT NOT NULL REFERENCES internal_keys(key_id),
+
+    -- group_key_id and group_tapscript_root are the raw group key and the
+    -- tapscript root of the group key of the sprout, if it has one.
+    group_key_id BIGINT REFERENCES internal_keys(key_id),
+
+    group_tapscript_root BLOB,
+
+    -- single_tweak, virtual_tx, prev_out_value and prev_out_script make up
+    -- the unsigned group witness of the sprout, if its group witness must
+    -- be signed externally.
+    single_tweak BLOB,
+
+    virtual_tx BLOB,
+
+    prev_out_value BIGINT,
+
+    prev_out_script BLOB
+);
+CREATE INDEX IF NOT EXISTS frozen_batch_sprouts_batch_idx
+    ON frozen_batch_sprouts (batch_id);
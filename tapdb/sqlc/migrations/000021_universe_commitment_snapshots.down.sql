@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS universe_commitment_leaf_proofs;
+DROP TABLE IF EXISTS universe_commitment_roots;
+DROP TABLE IF EXISTS pending_universe_commitments;
+DROP INDEX IF EXISTS universe_commitments_internal_key_idx;
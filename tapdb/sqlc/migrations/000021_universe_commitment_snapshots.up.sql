@@ -0,0 +1,79 @@
+-- A commitment is only stored once, so we can safely retry storing it after
+-- its anchor transaction confirmed.
+CREATE UNIQUE INDEX IF NOT EXISTS universe_commitments_internal_key_idx
+    ON universe_commitments (internal_key);
+
+-- pending_universe_commitments stores the commitments of the issuance
+-- multiverse root whose anchor transaction has been signed, but hasn't
+-- confirmed yet. These are stored before the transaction is published, so we
+-- can resume waiting for the confirmation after a restart.
+CREATE TABLE IF NOT EXISTS pending_universe_commitments (
+    id BIGINT PRIMARY KEY,
+
+    -- anchor_tx is the serialized signed anchor transaction.
+    anchor_tx BLOB NOT NULL,
+
+    -- output_index is the index of the output of the anchor transaction
+    -- that commits to the multiverse root.
+    output_index INTEGER NOT NULL,
+
+    -- internal_key is the internal key of the anchor output.
+    internal_key BLOB UNIQUE NOT NULL,
+
+    -- root_hash and root_sum identify the committed multiverse root.
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+
+    root_sum BIGINT NOT NULL,
+
+    -- height_hint is the block height at which the anchor transaction was
+    -- created.
+    height_hint INTEGER NOT NULL
+);
+
+-- universe_commitment_roots stores the root of each issuance universe, along
+-- with its multiverse inclusion proof, as of a committed multiverse root.
+CREATE TABLE IF NOT EXISTS universe_commitment_roots (
+    id BIGINT PRIMARY KEY,
+
+    -- multiverse_root_hash is the hash of the committed multiverse root.
+    multiverse_root_hash BLOB NOT NULL CHECK(
+        length(multiverse_root_hash) = 32
+    ),
+
+    -- namespace_root is the namespace of the universe tree.
+    namespace_root VARCHAR NOT NULL,
+
+    -- root_hash and root_sum identify the root of the universe tree.
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+
+    root_sum BIGINT NOT NULL,
+
+    -- multiverse_proof is the compressed inclusion proof of the universe
+    -- root within the multiverse.
+    multiverse_proof BLOB NOT NULL,
+
+    UNIQUE(multiverse_root_hash, namespace_root)
+);
+
+-- universe_commitment_leaf_proofs stores the inclusion proofs of the leaves of
+-- a universe tree as of a committed multiverse root. As the proofs only
+-- depend on the universe root, they're shared between all commitments that
+-- include the same universe root.
+CREATE TABLE IF NOT EXISTS universe_commitment_leaf_proofs (
+    id BIGINT PRIMARY KEY,
+
+    -- namespace_root is the namespace of the universe tree.
+    namespace_root VARCHAR NOT NULL,
+
+    -- root_hash is the hash of the root of the universe tree.
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+
+    -- leaf_node_key is the key of the leaf within the universe tree.
+    leaf_node_key BLOB NOT NULL,
+
+    -- universe_proof is the compressed inclusion proof of the leaf within
+    -- the universe tree.
+    universe_proof BLOB NOT NULL,
+
+    UNIQUE(namespace_root, root_hash, leaf_node_key)
+);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS universe_event_log_timestamp_idx;
@@ -0,0 +1,4 @@
+-- The event log is pruned by age, so we need an index on the timestamp to
+-- find the events that are older than the retention period.
+CREATE INDEX IF NOT EXISTS universe_event_log_timestamp_idx
+    ON universe_event_log (event_timestamp);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS replaced_genesis_txns_batch_idx;
+DROP TABLE IF EXISTS replaced_genesis_txns;
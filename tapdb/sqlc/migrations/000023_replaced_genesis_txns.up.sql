@@ -0,0 +1,22 @@
+-- replaced_genesis_txns keeps track of the genesis transactions of a minting
+-- batch that were replaced by a transaction paying a higher fee (RBF). Any of
+-- them might still confirm instead of the replacement, so they're watched
+-- until the batch confirms. The batch itself always references the latest
+-- genesis transaction.
+CREATE TABLE IF NOT EXISTS replaced_genesis_txns (
+    id BIGINT PRIMARY KEY,
+
+    batch_id BIGINT NOT NULL REFERENCES asset_minting_batches(batch_id),
+
+    -- txid is the hash of the replaced genesis transaction.
+    txid BLOB NOT NULL CHECK(length(txid) = 32),
+
+    -- minting_tx_psbt is the fully signed genesis packet of the replaced
+    -- transaction, which is needed to create the minting proofs if it
+    -- confirms.
+    minting_tx_psbt BLOB NOT NULL,
+
+    chain_fees BIGINT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS replaced_genesis_txns_batch_idx
+    ON replaced_genesis_txns (batch_id);
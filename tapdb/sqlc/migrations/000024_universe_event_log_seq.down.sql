@@ -0,0 +1 @@
+DROP TABLE IF EXISTS universe_event_log_seq;
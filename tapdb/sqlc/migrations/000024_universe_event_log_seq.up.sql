@@ -0,0 +1,15 @@
+-- universe_event_log_seq is a single row counter that assigns the ids of the
+-- universe event log. The counter is incremented in the same transaction that
+-- logs an event, which serializes the transactions that log events. An event
+-- therefore only becomes visible after all events with a lower id, so the id
+-- can be used as a cursor to resume a subscription from. Unlike the row id of
+-- the log, the counter is never reset when all events are pruned.
+CREATE TABLE IF NOT EXISTS universe_event_log_seq (
+    id INTEGER PRIMARY KEY CHECK(id = 1),
+
+    last_id BIGINT NOT NULL
+);
+
+INSERT INTO universe_event_log_seq (id, last_id)
+SELECT 1, COALESCE(MAX(id), 0)
+FROM universe_event_log;
@@ -0,0 +1,51 @@
+DROP INDEX IF EXISTS universe_commitment_nodes_snapshot_id_idx;
+DROP TABLE IF EXISTS universe_commitment_nodes;
+DROP TABLE IF EXISTS universe_commitment_snapshots;
+
+-- universe_commitment_roots stores the root of each issuance universe, along
+-- with its multiverse inclusion proof, as of a committed multiverse root.
+CREATE TABLE IF NOT EXISTS universe_commitment_roots (
+    id BIGINT PRIMARY KEY,
+
+    -- multiverse_root_hash is the hash of the committed multiverse root.
+    multiverse_root_hash BLOB NOT NULL CHECK(
+        length(multiverse_root_hash) = 32
+    ),
+
+    -- namespace_root is the namespace of the universe tree.
+    namespace_root VARCHAR NOT NULL,
+
+    -- root_hash and root_sum identify the root of the universe tree.
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+
+    root_sum BIGINT NOT NULL,
+
+    -- multiverse_proof is the compressed inclusion proof of the universe
+    -- root within the multiverse.
+    multiverse_proof BLOB NOT NULL,
+
+    UNIQUE(multiverse_root_hash, namespace_root)
+);
+
+-- universe_commitment_leaf_proofs stores the inclusion proofs of the leaves of
+-- a universe tree as of a committed multiverse root. As the proofs only
+-- depend on the universe root, they're shared between all commitments that
+-- include the same universe root.
+CREATE TABLE IF NOT EXISTS universe_commitment_leaf_proofs (
+    id BIGINT PRIMARY KEY,
+
+    -- namespace_root is the namespace of the universe tree.
+    namespace_root VARCHAR NOT NULL,
+
+    -- root_hash is the hash of the root of the universe tree.
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+
+    -- leaf_node_key is the key of the leaf within the universe tree.
+    leaf_node_key BLOB NOT NULL,
+
+    -- universe_proof is the compressed inclusion proof of the leaf within
+    -- the universe tree.
+    universe_proof BLOB NOT NULL,
+
+    UNIQUE(namespace_root, root_hash, leaf_node_key)
+);
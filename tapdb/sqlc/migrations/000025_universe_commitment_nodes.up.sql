@@ -0,0 +1,55 @@
+-- The inclusion proofs of the committed leaves are now derived from the
+-- stored tree nodes of each snapshot instead.
+DROP TABLE IF EXISTS universe_commitment_leaf_proofs;
+DROP TABLE IF EXISTS universe_commitment_roots;
+
+-- universe_commitment_snapshots stores the snapshots of the issuance
+-- multiverse that are taken before committing to its root in the chain. A
+-- snapshot is removed once no confirmed or pending commitment references its
+-- root anymore.
+CREATE TABLE IF NOT EXISTS universe_commitment_snapshots (
+    id BIGINT PRIMARY KEY,
+
+    -- root_hash and root_sum identify the root of the multiverse.
+    root_hash BLOB UNIQUE NOT NULL CHECK(length(root_hash) = 32),
+
+    root_sum BIGINT NOT NULL
+);
+
+-- universe_commitment_nodes stores the nodes of the multiverse tree and the
+-- issuance universe trees as of a snapshot. The nodes are addressed by their
+-- hash, so the nodes of a subtree that didn't change are shared with earlier
+-- snapshots and are only stored once.
+CREATE TABLE IF NOT EXISTS universe_commitment_nodes (
+    -- hash_key is the hash key by which we reference all nodes.
+    hash_key BLOB NOT NULL,
+
+    -- namespace is the namespace of the tree the node belongs to.
+    namespace VARCHAR NOT NULL,
+
+    -- l_hash_key and r_hash_key are the hash keys of the children if this
+    -- is a branch.
+    l_hash_key BLOB,
+
+    r_hash_key BLOB,
+
+    -- key is the leaf key if this is a compacted leaf node.
+    key BLOB,
+
+    -- value is the leaf value if this is a leaf node.
+    value BLOB,
+
+    -- sum is the sum of the node.
+    sum BIGINT NOT NULL,
+
+    -- snapshot_id references the first snapshot that included the node. As
+    -- a node is only shared with later snapshots, its ownership is passed
+    -- on to the next snapshot when the owning snapshot is removed.
+    snapshot_id BIGINT NOT NULL REFERENCES universe_commitment_snapshots(id)
+        ON DELETE CASCADE,
+
+    PRIMARY KEY (hash_key, namespace)
+);
+
+CREATE INDEX IF NOT EXISTS universe_commitment_nodes_snapshot_id_idx
+    ON universe_commitment_nodes (snapshot_id);
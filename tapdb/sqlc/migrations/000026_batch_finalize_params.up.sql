@@ -0,0 +1,18 @@
+-- batch_finalize_params stores the parameters a minting batch was finalized
+-- with, so its genesis transaction is funded the same way if the batch is
+-- resumed after a restart.
+CREATE TABLE IF NOT EXISTS batch_finalize_params (
+    batch_id BIGINT PRIMARY KEY REFERENCES asset_minting_batches(batch_id),
+
+    -- fee_rate is the manually set fee rate of the genesis transaction in
+    -- sat/kw, if any.
+    fee_rate BIGINT,
+
+    -- conf_target is the confirmation target used to estimate the fee rate
+    -- of the genesis transaction, zero for the default.
+    conf_target INTEGER NOT NULL,
+
+    -- genesis_inputs are the serialized outpoints of the wallet UTXOs that
+    -- must fund the genesis transaction, if any.
+    genesis_inputs BLOB
+);
@@ -0,0 +1 @@
+ALTER TABLE batch_finalize_params DROP COLUMN external_genesis_psbt;
@@ -0,0 +1,3 @@
+-- external_genesis_psbt is the genesis packet of a batch that was funded by an
+-- external wallet, if any.
+ALTER TABLE batch_finalize_params ADD COLUMN external_genesis_psbt BLOB;
@@ -77,6 +77,10 @@ type AssetMintingBatch struct {
 	GenesisID         sql.NullInt64
 	HeightHint        int32
 	CreationTimeUnix  time.Time
+	ExternalFunding   bool
+	FinalizeHeight    sql.NullInt32
+	FinalizeTime      sql.NullTime
+	BatchName         string
 }
 
 type AssetProof struct {
@@ -86,16 +90,18 @@ type AssetProof struct {
 }
 
 type AssetSeedling struct {
-	SeedlingID      int64
-	AssetName       string
-	AssetVersion    int16
-	AssetType       int16
-	AssetSupply     int64
-	AssetMetaID     int64
-	EmissionEnabled bool
-	BatchID         int64
-	GroupGenesisID  sql.NullInt64
-	GroupAnchorID   sql.NullInt64
+	SeedlingID         int64
+	AssetName          string
+	AssetVersion       int16
+	AssetType          int16
+	AssetSupply        int64
+	AssetMetaID        int64
+	EmissionEnabled    bool
+	BatchID            int64
+	GroupGenesisID     sql.NullInt64
+	GroupAnchorID      sql.NullInt64
+	ExternalGroupKey   []byte
+	GroupTapscriptRoot []byte
 }
 
 type AssetTransfer struct {
@@ -148,6 +154,14 @@ type AssetsMetum struct {
 	MetaDataType sql.NullInt16
 }
 
+type BatchFinalizeParam struct {
+	BatchID             int64
+	FeeRate             sql.NullInt64
+	ConfTarget          int32
+	GenesisInputs       []byte
+	ExternalGenesisPsbt []byte
+}
+
 type ChainTxn struct {
 	TxnID       int64
 	Txid        []byte
@@ -173,6 +187,19 @@ type FederationUniSyncConfig struct {
 	AllowSyncExport bool
 }
 
+type FrozenBatchSprout struct {
+	SproutID           int64
+	BatchID            int64
+	AssetSprout        []byte
+	ScriptKeyID        int64
+	GroupKeyID         sql.NullInt64
+	GroupTapscriptRoot []byte
+	SingleTweak        []byte
+	VirtualTx          []byte
+	PrevOutValue       sql.NullInt64
+	PrevOutScript      []byte
+}
+
 type GenesisAsset struct {
 	GenAssetID     int64
 	AssetID        []byte
@@ -181,6 +208,7 @@ type GenesisAsset struct {
 	OutputIndex    int32
 	AssetType      int16
 	GenesisPointID int64
+	DecimalDisplay sql.NullInt32
 }
 
 type GenesisInfoView struct {
@@ -263,12 +291,50 @@ type PassiveAsset struct {
 	NewProof        []byte
 }
 
+type PendingUniverseCommitment struct {
+	ID          int64
+	AnchorTx    []byte
+	OutputIndex int32
+	InternalKey []byte
+	RootHash    []byte
+	RootSum     int64
+	HeightHint  int32
+}
+
 type ProofTransferLog struct {
 	TransferType     string
 	ProofLocatorHash []byte
 	TimeUnix         time.Time
 }
 
+type RecurringMint struct {
+	ID               int64
+	AssetName        string
+	AssetSupply      int64
+	GroupKey         []byte
+	IntervalBlocks   int32
+	IntervalSeconds  int64
+	NextHeight       sql.NullInt32
+	NextTime         sql.NullTime
+	MaxEpochs        int32
+	EpochsMinted     int32
+	CreationTimeUnix time.Time
+}
+
+type ReplacedAnchorTxn struct {
+	ID         int64
+	TransferID int64
+	TxnID      int64
+}
+
+type ReplacedGenesisTxn struct {
+	ID            int64
+	BatchID       int64
+	Txid          []byte
+	MintingTxPsbt []byte
+	ChainFees     int64
+}
+
 type ScriptKey struct {
 	ScriptKeyID      int64
 	InternalKeyID    int64
@@ -276,6 +342,35 @@ type ScriptKey struct {
 	Tweak            []byte
 }
 
+type UniverseCommitment struct {
+	ID          int64
+	BlockHeight int32
+	BlockHeader []byte
+	MerkleProof []byte
+	AnchorTx    []byte
+	OutputIndex int32
+	InternalKey []byte
+	RootHash    []byte
+	RootSum     int64
+}
+
+type UniverseCommitmentNode struct {
+	HashKey    []byte
+	Namespace  string
+	LHashKey   []byte
+	RHashKey   []byte
+	Key        []byte
+	Value      []byte
+	Sum        int64
+	SnapshotID int64
+}
+
+type UniverseCommitmentSnapshot struct {
+	ID       int64
+	RootHash []byte
+	RootSum  int64
+}
+
 type UniverseEvent struct {
 	EventID        int64
 	EventType      string
@@ -284,6 +379,26 @@ type UniverseEvent struct {
 	EventTimestamp int64
 }
 
+type UniverseEventLog struct {
+	ID             int64
+	EventType      string
+	AssetID        []byte
+	GroupKey       []byte
+	ProofType      string
+	LeafOutpoint   []byte
+	LeafScriptKey  []byte
+	LeafAssetID    []byte
+	RootHash       []byte
+	RootSum        sql.NullInt64
+	ServerHost     sql.NullString
+	EventTimestamp int64
+}
+
+type UniverseEventLogSeq struct {
+	ID     int32
+	LastID int64
+}
+
 type UniverseLeafe struct {
 	ID                int64
 	AssetGenesisID    int64
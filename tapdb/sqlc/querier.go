@@ -22,13 +22,25 @@ type Querier interface {
 	BindMintingBatchWithTx(ctx context.Context, arg BindMintingBatchWithTxParams) error
 	ConfirmChainAnchorTx(ctx context.Context, arg ConfirmChainAnchorTxParams) error
 	ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams) error
+	CountUniverseLeaves(ctx context.Context, namespace string) (int64, error)
 	DeleteAllNodes(ctx context.Context, namespace string) (int64, error)
+	DeleteAssetSeedling(ctx context.Context, seedlingID int64) error
+	DeleteAssetTransfer(ctx context.Context, id int64) error
+	DeleteAssetTransferInputs(ctx context.Context, transferID int64) error
+	DeleteAssetTransferOutputs(ctx context.Context, transferID int64) error
 	DeleteAssetWitnesses(ctx context.Context, assetID int64) error
 	DeleteExpiredUTXOLeases(ctx context.Context, now sql.NullTime) error
+	DeleteFrozenBatchSprouts(ctx context.Context, rawKey []byte) error
 	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 	DeleteNode(ctx context.Context, arg DeleteNodeParams) (int64, error)
+	DeletePassiveAssets(ctx context.Context, transferID int64) error
+	DeletePendingUniverseCommitment(ctx context.Context, internalKey []byte) error
+	DeleteRecurringMint(ctx context.Context, id int64) error
+	DeleteReplacedAnchorTxns(ctx context.Context, transferID int64) error
+	DeleteReplacedGenesisTx(ctx context.Context, arg DeleteReplacedGenesisTxParams) error
 	DeleteRoot(ctx context.Context, namespace string) (int64, error)
 	DeleteUTXOLease(ctx context.Context, outpoint []byte) error
+	DeleteUniverseCommitmentSnapshot(ctx context.Context, id int64) error
 	DeleteUniverseEvents(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseLeaves(ctx context.Context, namespace string) error
 	DeleteUniverseRoot(ctx context.Context, namespaceRoot string) error
@@ -50,9 +62,12 @@ type Querier interface {
 	// doesn't have a group key. See the comment in fetchAssetSprouts for a work
 	// around that needs to be used with this query until a sqlc bug is fixed.
 	FetchAssetsForBatch(ctx context.Context, rawKey []byte) ([]FetchAssetsForBatchRow, error)
+	FetchBatchFinalizeParams(ctx context.Context, rawKey []byte) (FetchBatchFinalizeParamsRow, error)
 	FetchChainTx(ctx context.Context, txid []byte) (ChainTxn, error)
 	FetchChildren(ctx context.Context, arg FetchChildrenParams) ([]FetchChildrenRow, error)
 	FetchChildrenSelfJoin(ctx context.Context, arg FetchChildrenSelfJoinParams) ([]FetchChildrenSelfJoinRow, error)
+	FetchDecimalDisplay(ctx context.Context, assetID []byte) (sql.NullInt32, error)
+	FetchFrozenBatchSprouts(ctx context.Context, rawKey []byte) ([]FetchFrozenBatchSproutsRow, error)
 	FetchGenesisByAssetID(ctx context.Context, assetID []byte) (GenesisInfoView, error)
 	FetchGenesisByID(ctx context.Context, genAssetID int64) (FetchGenesisByIDRow, error)
 	FetchGenesisID(ctx context.Context, arg FetchGenesisIDParams) (int64, error)
@@ -61,10 +76,16 @@ type Querier interface {
 	// Sort and limit to return the genesis ID for initial genesis of the group.
 	FetchGroupByGroupKey(ctx context.Context, groupKey []byte) (FetchGroupByGroupKeyRow, error)
 	FetchGroupedAssets(ctx context.Context) ([]FetchGroupedAssetsRow, error)
+	FetchIssuanceUniverseRoots(ctx context.Context) ([]FetchIssuanceUniverseRootsRow, error)
+	FetchLatestUniverseCommitment(ctx context.Context) (UniverseCommitment, error)
 	FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOParams) (FetchManagedUTXORow, error)
 	FetchManagedUTXOs(ctx context.Context) ([]FetchManagedUTXOsRow, error)
 	FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMintingBatchRow, error)
 	FetchMintingBatchesByInverseState(ctx context.Context, batchState int16) ([]FetchMintingBatchesByInverseStateRow, error)
+	FetchPendingUniverseCommitment(ctx context.Context) (PendingUniverseCommitment, error)
+	FetchRecurringMints(ctx context.Context) ([]RecurringMint, error)
+	FetchReplacedAnchorTxids(ctx context.Context, transferID int64) ([][]byte, error)
+	FetchReplacedGenesisTxns(ctx context.Context, rawKey []byte) ([]FetchReplacedGenesisTxnsRow, error)
 	FetchRootNode(ctx context.Context, namespace string) (MssmtNode, error)
 	FetchScriptKeyByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (FetchScriptKeyByTweakedKeyRow, error)
 	FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (int64, error)
@@ -73,8 +94,18 @@ type Querier interface {
 	FetchSeedlingsForBatch(ctx context.Context, rawKey []byte) ([]FetchSeedlingsForBatchRow, error)
 	FetchTransferInputs(ctx context.Context, transferID int64) ([]FetchTransferInputsRow, error)
 	FetchTransferOutputs(ctx context.Context, transferID int64) ([]FetchTransferOutputsRow, error)
+	FetchUniverseCommitmentAtHeight(ctx context.Context, blockHeight int32) (UniverseCommitment, error)
+	FetchUniverseCommitmentChildren(ctx context.Context, arg FetchUniverseCommitmentChildrenParams) ([]FetchUniverseCommitmentChildrenRow, error)
+	FetchUniverseCommitmentNode(ctx context.Context, arg FetchUniverseCommitmentNodeParams) (UniverseCommitmentNode, error)
+	FetchUniverseCommitmentSnapshot(ctx context.Context, rootHash []byte) (int64, error)
+	FetchUniverseEventLog(ctx context.Context, arg FetchUniverseEventLogParams) ([]UniverseEventLog, error)
+	FetchUniverseEventLogHead(ctx context.Context) (int64, error)
+	FetchUniverseEventLogTail(ctx context.Context) (int64, error)
 	FetchUniverseKeys(ctx context.Context, arg FetchUniverseKeysParams) ([]FetchUniverseKeysRow, error)
+	FetchUniverseLeafKey(ctx context.Context, arg FetchUniverseLeafKeyParams) (FetchUniverseLeafKeyRow, error)
 	FetchUniverseRoot(ctx context.Context, namespace string) (FetchUniverseRootRow, error)
+	FetchUniverseSnapshotLeaves(ctx context.Context, arg FetchUniverseSnapshotLeavesParams) ([]FetchUniverseSnapshotLeavesRow, error)
+	FetchUnusedUniverseCommitmentSnapshots(ctx context.Context) ([]int64, error)
 	GenesisAssets(ctx context.Context) ([]GenesisAsset, error)
 	GenesisPoints(ctx context.Context) ([]GenesisPoint, error)
 	GetRootKey(ctx context.Context, id []byte) (Macaroon, error)
@@ -87,17 +118,28 @@ type Querier interface {
 	InsertAssetWitness(ctx context.Context, arg InsertAssetWitnessParams) error
 	InsertBranch(ctx context.Context, arg InsertBranchParams) error
 	InsertCompactedLeaf(ctx context.Context, arg InsertCompactedLeafParams) error
+	InsertFrozenBatchSprout(ctx context.Context, arg InsertFrozenBatchSproutParams) error
 	InsertLeaf(ctx context.Context, arg InsertLeafParams) error
 	InsertNewAsset(ctx context.Context, arg InsertNewAssetParams) (int64, error)
 	InsertNewProofEvent(ctx context.Context, arg InsertNewProofEventParams) error
 	InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEventParams) error
 	InsertPassiveAsset(ctx context.Context, arg InsertPassiveAssetParams) error
+	InsertPendingUniverseCommitment(ctx context.Context, arg InsertPendingUniverseCommitmentParams) error
+	InsertRecurringMint(ctx context.Context, arg InsertRecurringMintParams) (int64, error)
+	InsertReplacedAnchorTx(ctx context.Context, arg InsertReplacedAnchorTxParams) error
+	InsertReplacedGenesisTx(ctx context.Context, arg InsertReplacedGenesisTxParams) error
 	InsertRootKey(ctx context.Context, arg InsertRootKeyParams) error
+	InsertUniverseCommitment(ctx context.Context, arg InsertUniverseCommitmentParams) (int64, error)
+	InsertUniverseCommitmentNode(ctx context.Context, arg InsertUniverseCommitmentNodeParams) error
+	InsertUniverseCommitmentSnapshot(ctx context.Context, arg InsertUniverseCommitmentSnapshotParams) (int64, error)
+	InsertUniverseEventLog(ctx context.Context, arg InsertUniverseEventLogParams) error
 	InsertUniverseServer(ctx context.Context, arg InsertUniverseServerParams) error
 	ListUniverseServers(ctx context.Context) ([]UniverseServer, error)
 	LogProofTransferAttempt(ctx context.Context, arg LogProofTransferAttemptParams) error
 	LogServerSync(ctx context.Context, arg LogServerSyncParams) error
 	NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error
+	NextUniverseEventLogID(ctx context.Context) (int64, error)
+	PruneUniverseEventLog(ctx context.Context, before int64) error
 	// We use a LEFT JOIN here as not every asset has a group key, so this'll
 	// generate rows that have NULL values for the group key fields if an asset
 	// doesn't have a group key. See the comment in fetchAssetSprouts for a work
@@ -130,19 +172,26 @@ type Querier interface {
 	QueryUniverseAssetStats(ctx context.Context, arg QueryUniverseAssetStatsParams) ([]QueryUniverseAssetStatsRow, error)
 	QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeavesParams) ([]QueryUniverseLeavesRow, error)
 	QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow, error)
+	ReAnchorManagedUTXO(ctx context.Context, arg ReAnchorManagedUTXOParams) error
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassiveAssetsParams) error
+	ReassignUniverseCommitmentNodes(ctx context.Context, snapshotID int64) error
 	SetAddrManaged(ctx context.Context, arg SetAddrManagedParams) error
 	SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (int64, error)
 	UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error)
 	UniverseRoots(ctx context.Context, arg UniverseRootsParams) ([]UniverseRootsRow, error)
+	UpdateAssetSeedling(ctx context.Context, arg UpdateAssetSeedlingParams) error
 	UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenesisTxParams) error
+	UpdateMintingBatchSchedule(ctx context.Context, arg UpdateMintingBatchScheduleParams) error
 	UpdateMintingBatchState(ctx context.Context, arg UpdateMintingBatchStateParams) error
+	UpdateRecurringMint(ctx context.Context, arg UpdateRecurringMintParams) error
+	UpdateTransferAnchorTx(ctx context.Context, arg UpdateTransferAnchorTxParams) error
 	UpdateUTXOLease(ctx context.Context, arg UpdateUTXOLeaseParams) error
 	UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int64, error)
 	UpsertAssetGroupKey(ctx context.Context, arg UpsertAssetGroupKeyParams) (int64, error)
 	UpsertAssetGroupWitness(ctx context.Context, arg UpsertAssetGroupWitnessParams) (int64, error)
 	UpsertAssetMeta(ctx context.Context, arg UpsertAssetMetaParams) (int64, error)
 	UpsertAssetProof(ctx context.Context, arg UpsertAssetProofParams) error
+	UpsertBatchFinalizeParams(ctx context.Context, arg UpsertBatchFinalizeParamsParams) error
 	UpsertChainTx(ctx context.Context, arg UpsertChainTxParams) (int64, error)
 	UpsertFederationGlobalSyncConfig(ctx context.Context, arg UpsertFederationGlobalSyncConfigParams) error
 	UpsertFederationUniSyncConfig(ctx context.Context, arg UpsertFederationUniSyncConfigParams) error
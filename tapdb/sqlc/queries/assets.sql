@@ -10,8 +10,8 @@ RETURNING key_id;
 
 -- name: NewMintingBatch :exec
 INSERT INTO asset_minting_batches (
-    batch_state, batch_id, height_hint, creation_time_unix
-) VALUES (0, $1, $2, $3);
+    batch_state, batch_id, height_hint, creation_time_unix, batch_name
+) VALUES (0, $1, $2, $3, $4);
 
 -- name: FetchMintingBatchesByInverseState :many
 SELECT *
@@ -54,13 +54,28 @@ UPDATE asset_minting_batches
 SET batch_state = $2
 WHERE batch_id in (SELECT batch_id FROM target_batch);
 
+-- name: UpdateMintingBatchSchedule :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+UPDATE asset_minting_batches
+SET finalize_height = sqlc.narg('finalize_height'),
+    finalize_time = sqlc.narg('finalize_time')
+WHERE batch_id IN (SELECT batch_id FROM target_batch);
+
 -- name: InsertAssetSeedling :exec
 INSERT INTO asset_seedlings (
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    external_group_key, group_tapscript_root
 ) VALUES (
    $1, $2, $3, $4, $5, $6, $7,
-   sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id')
+   sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id'),
+   sqlc.narg('external_group_key'), sqlc.narg('group_tapscript_root')
 );
 
 -- name: FetchSeedlingID :one
@@ -85,6 +100,17 @@ SELECT *
 FROM asset_seedlings
 WHERE seedling_id = @seedling_id;
 
+-- name: UpdateAssetSeedling :exec
+UPDATE asset_seedlings
+SET asset_supply = @asset_supply,
+    asset_meta_id = @asset_meta_id,
+    group_anchor_id = sqlc.narg('group_anchor_id')
+WHERE seedling_id = @seedling_id;
+
+-- name: DeleteAssetSeedling :exec
+DELETE FROM asset_seedlings
+WHERE seedling_id = @seedling_id;
+
 -- name: AllInternalKeys :many
 SELECT * 
 FROM internal_keys;
@@ -108,11 +134,13 @@ WITH target_key_id AS (
 )
 INSERT INTO asset_seedlings(
     asset_name, asset_type, asset_version, asset_supply, asset_meta_id,
-    emission_enabled, batch_id, group_genesis_id, group_anchor_id
+    emission_enabled, batch_id, group_genesis_id, group_anchor_id,
+    external_group_key, group_tapscript_root
 ) VALUES (
     $2, $3, $4, $5, $6, $7,
     (SELECT key_id FROM target_key_id),
-    sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id')
+    sqlc.narg('group_genesis_id'), sqlc.narg('group_anchor_id'),
+    sqlc.narg('external_group_key'), sqlc.narg('group_tapscript_root')
 );
 
 -- name: FetchSeedlingsForBatch :many
@@ -126,7 +154,8 @@ WITH target_batch(batch_id) AS (
 SELECT seedling_id, asset_name, asset_type, asset_version, asset_supply, 
     assets_meta.meta_data_hash, assets_meta.meta_data_type, 
     assets_meta.meta_data_blob, emission_enabled, batch_id, 
-    group_genesis_id, group_anchor_id
+    group_genesis_id, group_anchor_id, external_group_key,
+    group_tapscript_root
 FROM asset_seedlings 
 LEFT JOIN assets_meta
     ON asset_seedlings.asset_meta_id = assets_meta.meta_id
@@ -170,12 +199,17 @@ WITH target_meta_id AS (
     WHERE meta_data_hash = $1
 )
 INSERT INTO genesis_assets (
-    asset_id, asset_tag, meta_data_id, output_index, asset_type, genesis_point_id
+    asset_id, asset_tag, meta_data_id, output_index, asset_type,
+    genesis_point_id, decimal_display
 ) VALUES (
-    $2, $3, (SELECT meta_id FROM target_meta_id), $4, $5, $6
+    $2, $3, (SELECT meta_id FROM target_meta_id), $4, $5, $6,
+    sqlc.narg('decimal_display')
 ) ON CONFLICT (asset_id)
-    -- This is a NOP, asset_id is the unique field that caused the conflict.
-    DO UPDATE SET asset_id = EXCLUDED.asset_id
+    -- The decimal display is derived from the meta reveal, so we only fill it
+    -- in if it wasn't known before.
+    DO UPDATE SET decimal_display = COALESCE(
+        genesis_assets.decimal_display, EXCLUDED.decimal_display
+    )
 RETURNING gen_asset_id;
 
 -- name: InsertNewAsset :one
@@ -461,7 +495,8 @@ WITH target_batch AS (
     WHERE keys.raw_key = $1
 )
 UPDATE asset_minting_batches 
-SET minting_tx_psbt = $2, change_output_index = $3, genesis_id = $4
+SET minting_tx_psbt = $2, change_output_index = $3, genesis_id = $4,
+    external_funding = $5
 WHERE batch_id IN (SELECT batch_id FROM target_batch);
 
 -- name: UpdateBatchGenesisTx :exec
@@ -476,6 +511,125 @@ UPDATE asset_minting_batches
 SET minting_tx_psbt = $2
 WHERE batch_id in (SELECT batch_id FROM target_batch);
 
+-- name: InsertFrozenBatchSprout :exec
+WITH target_key_id AS (
+    SELECT key_id
+    FROM internal_keys keys
+    WHERE keys.raw_key = $1
+)
+INSERT INTO frozen_batch_sprouts(
+    batch_id, asset_sprout, script_key_id, group_key_id,
+    group_tapscript_root, single_tweak, virtual_tx, prev_out_value,
+    prev_out_script
+) VALUES (
+    (SELECT key_id FROM target_key_id), $2, $3, sqlc.narg('group_key_id'),
+    sqlc.narg('group_tapscript_root'), sqlc.narg('single_tweak'),
+    sqlc.narg('virtual_tx'), sqlc.narg('prev_out_value'),
+    sqlc.narg('prev_out_script')
+);
+
+-- name: FetchFrozenBatchSprouts :many
+WITH target_batch(batch_id) AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+SELECT sprout_id, asset_sprout, script_keys.raw_key AS script_key_raw,
+    script_keys.key_family AS script_key_family,
+    script_keys.key_index AS script_key_index,
+    group_keys.raw_key AS group_key_raw,
+    group_keys.key_family AS group_key_family,
+    group_keys.key_index AS group_key_index, group_tapscript_root,
+    single_tweak, virtual_tx, prev_out_value, prev_out_script
+FROM frozen_batch_sprouts sprouts
+JOIN internal_keys script_keys
+    ON sprouts.script_key_id = script_keys.key_id
+LEFT JOIN internal_keys group_keys
+    ON sprouts.group_key_id = group_keys.key_id
+WHERE sprouts.batch_id IN (SELECT batch_id FROM target_batch)
+ORDER BY sprout_id;
+
+-- name: DeleteFrozenBatchSprouts :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+DELETE FROM frozen_batch_sprouts
+WHERE batch_id IN (SELECT batch_id FROM target_batch);
+
+-- name: InsertReplacedGenesisTx :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+INSERT INTO replaced_genesis_txns (
+    batch_id, txid, minting_tx_psbt, chain_fees
+) VALUES (
+    (SELECT batch_id FROM target_batch), $2, $3, $4
+);
+
+-- name: FetchReplacedGenesisTxns :many
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+SELECT txid, minting_tx_psbt, chain_fees
+FROM replaced_genesis_txns
+WHERE batch_id IN (SELECT batch_id FROM target_batch)
+ORDER BY id;
+
+-- name: DeleteReplacedGenesisTx :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+DELETE FROM replaced_genesis_txns
+WHERE batch_id IN (SELECT batch_id FROM target_batch) AND txid = $2;
+
+-- name: UpsertBatchFinalizeParams :exec
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+INSERT INTO batch_finalize_params (
+    batch_id, fee_rate, conf_target, genesis_inputs, external_genesis_psbt
+) VALUES (
+    (SELECT batch_id FROM target_batch), $2, $3, $4, $5
+) ON CONFLICT (batch_id)
+    DO UPDATE SET fee_rate = EXCLUDED.fee_rate,
+        conf_target = EXCLUDED.conf_target,
+        genesis_inputs = EXCLUDED.genesis_inputs,
+        external_genesis_psbt = EXCLUDED.external_genesis_psbt;
+
+-- name: FetchBatchFinalizeParams :one
+WITH target_batch AS (
+    SELECT batch_id
+    FROM asset_minting_batches batches
+    JOIN internal_keys keys
+        ON batches.batch_id = keys.key_id
+    WHERE keys.raw_key = $1
+)
+SELECT fee_rate, conf_target, genesis_inputs, external_genesis_psbt
+FROM batch_finalize_params
+WHERE batch_id IN (SELECT batch_id FROM target_batch);
+
 -- name: UpsertChainTx :one
 INSERT INTO chain_txns (
     txid, raw_tx, chain_fees, block_height, block_hash, tx_index
@@ -512,6 +666,11 @@ INSERT INTO managed_utxos (
    DO UPDATE SET tapscript_sibling = COALESCE(EXCLUDED.tapscript_sibling, managed_utxos.tapscript_sibling)
 RETURNING utxo_id;
 
+-- name: ReAnchorManagedUTXO :exec
+UPDATE managed_utxos
+SET outpoint = @new_outpoint, txn_id = @new_txn_id
+WHERE outpoint = @old_outpoint;
+
 -- name: FetchManagedUTXO :one
 SELECT *
 FROM managed_utxos utxos
@@ -790,3 +949,8 @@ FROM genesis_assets assets
 JOIN assets_meta
     ON assets.meta_data_id = assets_meta.meta_id
 WHERE assets.asset_id = $1;
+
+-- name: FetchDecimalDisplay :one
+SELECT decimal_display
+FROM genesis_assets
+WHERE asset_id = $1;
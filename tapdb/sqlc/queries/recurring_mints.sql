@@ -0,0 +1,26 @@
+-- name: InsertRecurringMint :one
+INSERT INTO recurring_mints (
+    asset_name, asset_supply, group_key, interval_blocks, interval_seconds,
+    next_height, next_time, max_epochs, epochs_minted, creation_time_unix
+) VALUES (
+    @asset_name, @asset_supply, @group_key, @interval_blocks,
+    @interval_seconds, sqlc.narg('next_height'), sqlc.narg('next_time'),
+    @max_epochs, @epochs_minted, @creation_time_unix
+)
+RETURNING id;
+
+-- name: FetchRecurringMints :many
+SELECT *
+FROM recurring_mints
+ORDER BY id;
+
+-- name: UpdateRecurringMint :exec
+UPDATE recurring_mints
+SET next_height = sqlc.narg('next_height'),
+    next_time = sqlc.narg('next_time'),
+    epochs_minted = @epochs_minted
+WHERE id = @id;
+
+-- name: DeleteRecurringMint :exec
+DELETE FROM recurring_mints
+WHERE id = $1;
@@ -162,3 +162,43 @@ FROM passive_assets as passive
     JOIN genesis_assets
         ON assets.genesis_id = genesis_assets.gen_asset_id
 WHERE passive.transfer_id = @transfer_id;
+
+-- name: UpdateTransferAnchorTx :exec
+UPDATE asset_transfers
+SET anchor_txn_id = @anchor_txn_id
+WHERE id = @transfer_id;
+
+-- name: InsertReplacedAnchorTx :exec
+INSERT INTO replaced_anchor_txns (
+    transfer_id, txn_id
+) VALUES (
+    @transfer_id, @txn_id
+);
+
+-- name: FetchReplacedAnchorTxids :many
+SELECT txns.txid
+FROM replaced_anchor_txns replaced
+JOIN chain_txns txns
+    ON replaced.txn_id = txns.txn_id
+WHERE replaced.transfer_id = $1
+ORDER BY replaced.id;
+
+-- name: DeletePassiveAssets :exec
+DELETE FROM passive_assets
+WHERE transfer_id = $1;
+
+-- name: DeleteAssetTransferOutputs :exec
+DELETE FROM asset_transfer_outputs
+WHERE transfer_id = $1;
+
+-- name: DeleteAssetTransferInputs :exec
+DELETE FROM asset_transfer_inputs
+WHERE transfer_id = $1;
+
+-- name: DeleteReplacedAnchorTxns :exec
+DELETE FROM replaced_anchor_txns
+WHERE transfer_id = $1;
+
+-- name: DeleteAssetTransfer :exec
+DELETE FROM asset_transfers
+WHERE id = $1;
@@ -79,6 +79,29 @@ ORDER BY
     CASE WHEN sqlc.narg('sort_direction') = 1 THEN leaves.id END DESC
 LIMIT @num_limit OFFSET @num_offset;
 
+-- name: FetchUniverseLeafKey :one
+SELECT leaves.minting_point, leaves.script_key_bytes
+FROM universe_leaves leaves
+WHERE leaves.leaf_node_namespace = @namespace AND
+    leaves.leaf_node_key = @leaf_node_key;
+
+-- name: CountUniverseLeaves :one
+SELECT COUNT(*)
+FROM universe_leaves
+WHERE leaf_node_namespace = @namespace;
+
+-- name: FetchUniverseSnapshotLeaves :many
+SELECT leaves.id, leaves.minting_point, leaves.script_key_bytes,
+       nodes.value genesis_proof, nodes.sum sum_amt
+FROM universe_leaves leaves
+JOIN mssmt_nodes nodes
+    ON leaves.leaf_node_key = nodes.key AND
+        leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = @namespace AND
+    leaves.id > @after_id
+ORDER BY leaves.id
+LIMIT @num_limit;
+
 -- name: UniverseLeaves :many
 SELECT * FROM universe_leaves;
 
@@ -358,4 +381,174 @@ ON CONFLICT(namespace)
 -- name: QueryFederationUniSyncConfigs :many
 SELECT namespace, asset_id, group_key, proof_type, allow_sync_insert, allow_sync_export
 FROM federation_uni_sync_config
-ORDER BY group_key NULLS LAST, asset_id NULLS LAST, proof_type;
\ No newline at end of file
+ORDER BY group_key NULLS LAST, asset_id NULLS LAST, proof_type;
+-- name: InsertUniverseCommitment :one
+INSERT INTO universe_commitments (
+    block_height, block_header, merkle_proof, anchor_tx, output_index,
+    internal_key, root_hash, root_sum
+) VALUES (
+    @block_height, @block_header, @merkle_proof, @anchor_tx, @output_index,
+    @internal_key, @root_hash, @root_sum
+)
+ON CONFLICT (internal_key) DO NOTHING
+RETURNING id;
+
+-- name: FetchLatestUniverseCommitment :one
+SELECT *
+FROM universe_commitments
+ORDER BY block_height DESC, id DESC
+LIMIT 1;
+
+-- name: FetchUniverseCommitmentAtHeight :one
+SELECT *
+FROM universe_commitments
+WHERE block_height <= @block_height
+ORDER BY block_height DESC, id DESC
+LIMIT 1;
+
+-- name: InsertPendingUniverseCommitment :exec
+INSERT INTO pending_universe_commitments (
+    anchor_tx, output_index, internal_key, root_hash, root_sum, height_hint
+) VALUES (
+    @anchor_tx, @output_index, @internal_key, @root_hash, @root_sum,
+    @height_hint
+);
+
+-- name: FetchPendingUniverseCommitment :one
+SELECT *
+FROM pending_universe_commitments
+ORDER BY id
+LIMIT 1;
+
+-- name: DeletePendingUniverseCommitment :exec
+DELETE FROM pending_universe_commitments
+WHERE internal_key = @internal_key;
+
+-- name: FetchIssuanceUniverseRoots :many
+SELECT universe_roots.namespace_root, universe_roots.asset_id,
+       universe_roots.group_key, mssmt_roots.root_hash root_hash,
+       mssmt_nodes.sum root_sum
+FROM universe_roots
+JOIN mssmt_roots
+    ON universe_roots.namespace_root = mssmt_roots.namespace
+JOIN mssmt_nodes
+    ON mssmt_nodes.hash_key = mssmt_roots.root_hash AND
+       mssmt_nodes.namespace = mssmt_roots.namespace
+WHERE universe_roots.proof_type = 'issuance'
+ORDER BY universe_roots.id;
+
+-- name: InsertUniverseCommitmentSnapshot :one
+INSERT INTO universe_commitment_snapshots (
+    root_hash, root_sum
+) VALUES (
+    @root_hash, @root_sum
+)
+RETURNING id;
+
+-- name: FetchUniverseCommitmentSnapshot :one
+SELECT id
+FROM universe_commitment_snapshots
+WHERE root_hash = @root_hash;
+
+-- name: FetchUnusedUniverseCommitmentSnapshots :many
+SELECT snapshots.id
+FROM universe_commitment_snapshots snapshots
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM universe_commitments
+    WHERE root_hash = snapshots.root_hash
+) AND NOT EXISTS (
+    SELECT 1
+    FROM pending_universe_commitments
+    WHERE root_hash = snapshots.root_hash
+)
+ORDER BY snapshots.id;
+
+-- name: ReassignUniverseCommitmentNodes :exec
+UPDATE universe_commitment_nodes
+SET snapshot_id = (
+    SELECT MIN(id)
+    FROM universe_commitment_snapshots
+    WHERE id > @snapshot_id
+)
+WHERE snapshot_id = @snapshot_id AND EXISTS (
+    SELECT 1
+    FROM universe_commitment_snapshots
+    WHERE id > @snapshot_id
+);
+
+-- name: DeleteUniverseCommitmentSnapshot :exec
+DELETE FROM universe_commitment_snapshots
+WHERE id = @id;
+
+-- name: InsertUniverseCommitmentNode :exec
+INSERT INTO universe_commitment_nodes (
+    hash_key, namespace, l_hash_key, r_hash_key, key, value, sum,
+    snapshot_id
+) VALUES (
+    @hash_key, @namespace, @l_hash_key, @r_hash_key, @key, @value, @sum,
+    @snapshot_id
+)
+ON CONFLICT (hash_key, namespace) DO NOTHING;
+
+-- name: FetchUniverseCommitmentNode :one
+SELECT *
+FROM universe_commitment_nodes
+WHERE hash_key = @hash_key AND namespace = @namespace;
+
+-- name: FetchUniverseCommitmentChildren :many
+SELECT hash_key, l_hash_key, r_hash_key, key, value, sum, namespace,
+       0 AS depth
+FROM universe_commitment_nodes
+WHERE hash_key = @hash_key AND namespace = @namespace
+UNION ALL
+SELECT children.hash_key, children.l_hash_key, children.r_hash_key,
+       children.key, children.value, children.sum, children.namespace,
+       1 AS depth
+FROM universe_commitment_nodes children
+JOIN universe_commitment_nodes parent
+    ON children.namespace = parent.namespace AND (
+        children.hash_key = parent.l_hash_key OR
+        children.hash_key = parent.r_hash_key
+    )
+WHERE parent.hash_key = @hash_key AND parent.namespace = @namespace
+ORDER BY depth;
+
+-- name: NextUniverseEventLogID :one
+UPDATE universe_event_log_seq
+SET last_id = last_id + 1
+WHERE id = 1
+RETURNING last_id;
+
+-- name: InsertUniverseEventLog :exec
+INSERT INTO universe_event_log (
+    id, event_type, asset_id, group_key, proof_type, leaf_outpoint,
+    leaf_script_key, leaf_asset_id, root_hash, root_sum, server_host,
+    event_timestamp
+) VALUES (
+    @id, @event_type, @asset_id, @group_key, @proof_type, @leaf_outpoint,
+    @leaf_script_key, @leaf_asset_id, @root_hash, @root_sum, @server_host,
+    @event_timestamp
+);
+
+-- name: FetchUniverseEventLog :many
+SELECT *
+FROM universe_event_log
+WHERE id > @cursor
+ORDER BY id
+LIMIT @num_limit;
+
+-- name: FetchUniverseEventLogHead :one
+SELECT last_id
+FROM universe_event_log_seq
+WHERE id = 1;
+
+-- name: FetchUniverseEventLogTail :one
+SELECT CAST(COALESCE(
+    MIN(id), (SELECT last_id + 1 FROM universe_event_log_seq WHERE id = 1)
+) AS BIGINT) AS cursor
+FROM universe_event_log;
+
+-- name: PruneUniverseEventLog :exec
+DELETE FROM universe_event_log
+WHERE event_timestamp < @before;
@@ -62,6 +62,36 @@ func (q *Queries) ApplyPendingOutput(ctx context.Context, arg ApplyPendingOutput
 	return asset_id, err
 }
 
+const deleteAssetTransfer = `-- name: DeleteAssetTransfer :exec
+DELETE FROM asset_transfers
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAssetTransfer(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetTransfer, id)
+	return err
+}
+
+const deleteAssetTransferInputs = `-- name: DeleteAssetTransferInputs :exec
+DELETE FROM asset_transfer_inputs
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeleteAssetTransferInputs(ctx context.Context, transferID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetTransferInputs, transferID)
+	return err
+}
+
+const deleteAssetTransferOutputs = `-- name: DeleteAssetTransferOutputs :exec
+DELETE FROM asset_transfer_outputs
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeleteAssetTransferOutputs(ctx context.Context, transferID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetTransferOutputs, transferID)
+	return err
+}
+
 const deleteAssetWitnesses = `-- name: DeleteAssetWitnesses :exec
 DELETE FROM asset_witnesses
 WHERE asset_id = $1
@@ -72,6 +102,58 @@ func (q *Queries) DeleteAssetWitnesses(ctx context.Context, assetID int64) error
 	return err
 }
 
+const deletePassiveAssets = `-- name: DeletePassiveAssets :exec
+DELETE FROM passive_assets
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeletePassiveAssets(ctx context.Context, transferID int64) error {
+	_, err := q.db.ExecContext(ctx, deletePassiveAssets, transferID)
+	return err
+}
+
+const deleteReplacedAnchorTxns = `-- name: DeleteReplacedAnchorTxns :exec
+DELETE FROM replaced_anchor_txns
+WHERE transfer_id = $1
+`
+
+func (q *Queries) DeleteReplacedAnchorTxns(ctx context.Context, transferID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteReplacedAnchorTxns, transferID)
+	return err
+}
+
+const fetchReplacedAnchorTxids = `-- name: FetchReplacedAnchorTxids :many
+SELECT txns.txid
+FROM replaced_anchor_txns replaced
+JOIN chain_txns txns
+    ON replaced.txn_id = txns.txn_id
+WHERE replaced.transfer_id = $1
+ORDER BY replaced.id
+`
+
+func (q *Queries) FetchReplacedAnchorTxids(ctx context.Context, transferID int64) ([][]byte, error) {
+	rows, err := q.db.QueryContext(ctx, fetchReplacedAnchorTxids, transferID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var txid []byte
+		if err := rows.Scan(&txid); err != nil {
+			return nil, err
+		}
+		items = append(items, txid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchTransferInputs = `-- name: FetchTransferInputs :many
 SELECT input_id, anchor_point, asset_id, script_key, amount
 FROM asset_transfer_inputs inputs
@@ -375,6 +457,24 @@ func (q *Queries) InsertPassiveAsset(ctx context.Context, arg InsertPassiveAsset
 	return err
 }
 
+const insertReplacedAnchorTx = `-- name: InsertReplacedAnchorTx :exec
+INSERT INTO replaced_anchor_txns (
+    transfer_id, txn_id
+) VALUES (
+    $1, $2
+)
+`
+
+type InsertReplacedAnchorTxParams struct {
+	TransferID int64
+	TxnID      int64
+}
+
+func (q *Queries) InsertReplacedAnchorTx(ctx context.Context, arg InsertReplacedAnchorTxParams) error {
+	_, err := q.db.ExecContext(ctx, insertReplacedAnchorTx, arg.TransferID, arg.TxnID)
+	return err
+}
+
 const logProofTransferAttempt = `-- name: LogProofTransferAttempt :exec
 INSERT INTO proof_transfer_log (
     transfer_type, proof_locator_hash, time_unix
@@ -558,3 +658,19 @@ func (q *Queries) ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassive
 	_, err := q.db.ExecContext(ctx, reAnchorPassiveAssets, arg.NewAnchorUtxoID, arg.AssetID)
 	return err
 }
+
+const updateTransferAnchorTx = `-- name: UpdateTransferAnchorTx :exec
+UPDATE asset_transfers
+SET anchor_txn_id = $1
+WHERE id = $2
+`
+
+type UpdateTransferAnchorTxParams struct {
+	AnchorTxnID int64
+	TransferID  int64
+}
+
+func (q *Queries) UpdateTransferAnchorTx(ctx context.Context, arg UpdateTransferAnchorTxParams) error {
+	_, err := q.db.ExecContext(ctx, updateTransferAnchorTx, arg.AnchorTxnID, arg.TransferID)
+	return err
+}
@@ -11,6 +11,39 @@ import (
 	"time"
 )
 
+const countUniverseLeaves = `-- name: CountUniverseLeaves :one
+SELECT COUNT(*)
+FROM universe_leaves
+WHERE leaf_node_namespace = $1
+`
+
+func (q *Queries) CountUniverseLeaves(ctx context.Context, namespace string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUniverseLeaves, namespace)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deletePendingUniverseCommitment = `-- name: DeletePendingUniverseCommitment :exec
+DELETE FROM pending_universe_commitments
+WHERE internal_key = $1
+`
+
+func (q *Queries) DeletePendingUniverseCommitment(ctx context.Context, internalKey []byte) error {
+	_, err := q.db.ExecContext(ctx, deletePendingUniverseCommitment, internalKey)
+	return err
+}
+
+const deleteUniverseCommitmentSnapshot = `-- name: DeleteUniverseCommitmentSnapshot :exec
+DELETE FROM universe_commitment_snapshots
+WHERE id = $1
+`
+
+func (q *Queries) DeleteUniverseCommitmentSnapshot(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteUniverseCommitmentSnapshot, id)
+	return err
+}
+
 const deleteUniverseEvents = `-- name: DeleteUniverseEvents :exec
 WITH root_id AS (
     SELECT id
@@ -61,6 +94,311 @@ func (q *Queries) DeleteUniverseServer(ctx context.Context, arg DeleteUniverseSe
 	return err
 }
 
+const fetchIssuanceUniverseRoots = `-- name: FetchIssuanceUniverseRoots :many
+SELECT universe_roots.namespace_root, universe_roots.asset_id,
+       universe_roots.group_key, mssmt_roots.root_hash root_hash,
+       mssmt_nodes.sum root_sum
+FROM universe_roots
+JOIN mssmt_roots
+    ON universe_roots.namespace_root = mssmt_roots.namespace
+JOIN mssmt_nodes
+    ON mssmt_nodes.hash_key = mssmt_roots.root_hash AND
+       mssmt_nodes.namespace = mssmt_roots.namespace
+WHERE universe_roots.proof_type = 'issuance'
+ORDER BY universe_roots.id
+`
+
+type FetchIssuanceUniverseRootsRow struct {
+	NamespaceRoot string
+	AssetID       []byte
+	GroupKey      []byte
+	RootHash      []byte
+	RootSum       int64
+}
+
+func (q *Queries) FetchIssuanceUniverseRoots(ctx context.Context) ([]FetchIssuanceUniverseRootsRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchIssuanceUniverseRoots)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchIssuanceUniverseRootsRow
+	for rows.Next() {
+		var i FetchIssuanceUniverseRootsRow
+		if err := rows.Scan(
+			&i.NamespaceRoot,
+			&i.AssetID,
+			&i.GroupKey,
+			&i.RootHash,
+			&i.RootSum,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchLatestUniverseCommitment = `-- name: FetchLatestUniverseCommitment :one
+SELECT id, block_height, block_header, merkle_proof, anchor_tx, output_index, internal_key, root_hash, root_sum
+FROM universe_commitments
+ORDER BY block_height DESC, id DESC
+LIMIT 1
+`
+
+func (q *Queries) FetchLatestUniverseCommitment(ctx context.Context) (UniverseCommitment, error) {
+	row := q.db.QueryRowContext(ctx, fetchLatestUniverseCommitment)
+	var i UniverseCommitment
+	err := row.Scan(
+		&i.ID,
+		&i.BlockHeight,
+		&i.BlockHeader,
+		&i.MerkleProof,
+		&i.AnchorTx,
+		&i.OutputIndex,
+		&i.InternalKey,
+		&i.RootHash,
+		&i.RootSum,
+	)
+	return i, err
+}
+
+const fetchPendingUniverseCommitment = `-- name: FetchPendingUniverseCommitment :one
+SELECT id, anchor_tx, output_index, internal_key, root_hash, root_sum, height_hint
+FROM pending_universe_commitments
+ORDER BY id
+LIMIT 1
+`
+
+func (q *Queries) FetchPendingUniverseCommitment(ctx context.Context) (PendingUniverseCommitment, error) {
+	row := q.db.QueryRowContext(ctx, fetchPendingUniverseCommitment)
+	var i PendingUniverseCommitment
+	err := row.Scan(
+		&i.ID,
+		&i.AnchorTx,
+		&i.OutputIndex,
+		&i.InternalKey,
+		&i.RootHash,
+		&i.RootSum,
+		&i.HeightHint,
+	)
+	return i, err
+}
+
+const fetchUniverseCommitmentAtHeight = `-- name: FetchUniverseCommitmentAtHeight :one
+SELECT id, block_height, block_header, merkle_proof, anchor_tx, output_index, internal_key, root_hash, root_sum
+FROM universe_commitments
+WHERE block_height <= $1
+ORDER BY block_height DESC, id DESC
+LIMIT 1
+`
+
+func (q *Queries) FetchUniverseCommitmentAtHeight(ctx context.Context, blockHeight int32) (UniverseCommitment, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseCommitmentAtHeight, blockHeight)
+	var i UniverseCommitment
+	err := row.Scan(
+		&i.ID,
+		&i.BlockHeight,
+		&i.BlockHeader,
+		&i.MerkleProof,
+		&i.AnchorTx,
+		&i.OutputIndex,
+		&i.InternalKey,
+		&i.RootHash,
+		&i.RootSum,
+	)
+	return i, err
+}
+
+const fetchUniverseCommitmentChildren = `-- name: FetchUniverseCommitmentChildren :many
+SELECT hash_key, l_hash_key, r_hash_key, key, value, sum, namespace,
+       0 AS depth
+FROM universe_commitment_nodes
+WHERE hash_key = $1 AND namespace = $2
+UNION ALL
+SELECT children.hash_key, children.l_hash_key, children.r_hash_key,
+       children.key, children.value, children.sum, children.namespace,
+       1 AS depth
+FROM universe_commitment_nodes children
+JOIN universe_commitment_nodes parent
+    ON children.namespace = parent.namespace AND (
+        children.hash_key = parent.l_hash_key OR
+        children.hash_key = parent.r_hash_key
+    )
+WHERE parent.hash_key = $1 AND parent.namespace = $2
+ORDER BY depth
+`
+
+type FetchUniverseCommitmentChildrenParams struct {
+	HashKey   []byte
+	Namespace string
+}
+
+type FetchUniverseCommitmentChildrenRow struct {
+	HashKey   []byte
+	LHashKey  []byte
+	RHashKey  []byte
+	Key       []byte
+	Value     []byte
+	Sum       int64
+	Namespace string
+	Depth     int32
+}
+
+func (q *Queries) FetchUniverseCommitmentChildren(ctx context.Context, arg FetchUniverseCommitmentChildrenParams) ([]FetchUniverseCommitmentChildrenRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchUniverseCommitmentChildren, arg.HashKey, arg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniverseCommitmentChildrenRow
+	for rows.Next() {
+		var i FetchUniverseCommitmentChildrenRow
+		if err := rows.Scan(
+			&i.HashKey,
+			&i.LHashKey,
+			&i.RHashKey,
+			&i.Key,
+			&i.Value,
+			&i.Sum,
+			&i.Namespace,
+			&i.Depth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchUniverseCommitmentNode = `-- name: FetchUniverseCommitmentNode :one
+SELECT hash_key, namespace, l_hash_key, r_hash_key, key, value, sum, snapshot_id
+FROM universe_commitment_nodes
+WHERE hash_key = $1 AND namespace = $2
+`
+
+type FetchUniverseCommitmentNodeParams struct {
+	HashKey   []byte
+	Namespace string
+}
+
+func (q *Queries) FetchUniverseCommitmentNode(ctx context.Context, arg FetchUniverseCommitmentNodeParams) (UniverseCommitmentNode, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseCommitmentNode, arg.HashKey, arg.Namespace)
+	var i UniverseCommitmentNode
+	err := row.Scan(
+		&i.HashKey,
+		&i.Namespace,
+		&i.LHashKey,
+		&i.RHashKey,
+		&i.Key,
+		&i.Value,
+		&i.Sum,
+		&i.SnapshotID,
+	)
+	return i, err
+}
+
+const fetchUniverseCommitmentSnapshot = `-- name: FetchUniverseCommitmentSnapshot :one
+SELECT id
+FROM universe_commitment_snapshots
+WHERE root_hash = $1
+`
+
+func (q *Queries) FetchUniverseCommitmentSnapshot(ctx context.Context, rootHash []byte) (int64, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseCommitmentSnapshot, rootHash)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const fetchUniverseEventLog = `-- name: FetchUniverseEventLog :many
+SELECT id, event_type, asset_id, group_key, proof_type, leaf_outpoint, leaf_script_key, leaf_asset_id, root_hash, root_sum, server_host, event_timestamp
+FROM universe_event_log
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type FetchUniverseEventLogParams struct {
+	Cursor   int64
+	NumLimit int32
+}
+
+func (q *Queries) FetchUniverseEventLog(ctx context.Context, arg FetchUniverseEventLogParams) ([]UniverseEventLog, error) {
+	rows, err := q.db.QueryContext(ctx, fetchUniverseEventLog, arg.Cursor, arg.NumLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UniverseEventLog
+	for rows.Next() {
+		var i UniverseEventLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.AssetID,
+			&i.GroupKey,
+			&i.ProofType,
+			&i.LeafOutpoint,
+			&i.LeafScriptKey,
+			&i.LeafAssetID,
+			&i.RootHash,
+			&i.RootSum,
+			&i.ServerHost,
+			&i.EventTimestamp,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchUniverseEventLogHead = `-- name: FetchUniverseEventLogHead :one
+SELECT last_id
+FROM universe_event_log_seq
+WHERE id = 1
+`
+
+func (q *Queries) FetchUniverseEventLogHead(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseEventLogHead)
+	var last_id int64
+	err := row.Scan(&last_id)
+	return last_id, err
+}
+
+const fetchUniverseEventLogTail = `-- name: FetchUniverseEventLogTail :one
+SELECT CAST(COALESCE(
+    MIN(id), (SELECT last_id + 1 FROM universe_event_log_seq WHERE id = 1)
+) AS BIGINT) AS cursor
+FROM universe_event_log
+`
+
+func (q *Queries) FetchUniverseEventLogTail(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseEventLogTail)
+	var cursor int64
+	err := row.Scan(&cursor)
+	return cursor, err
+}
+
 const fetchUniverseKeys = `-- name: FetchUniverseKeys :many
 SELECT leaves.minting_point, leaves.script_key_bytes
 FROM universe_leaves leaves
@@ -111,6 +449,30 @@ func (q *Queries) FetchUniverseKeys(ctx context.Context, arg FetchUniverseKeysPa
 	return items, nil
 }
 
+const fetchUniverseLeafKey = `-- name: FetchUniverseLeafKey :one
+SELECT leaves.minting_point, leaves.script_key_bytes
+FROM universe_leaves leaves
+WHERE leaves.leaf_node_namespace = $1 AND
+    leaves.leaf_node_key = $2
+`
+
+type FetchUniverseLeafKeyParams struct {
+	Namespace   string
+	LeafNodeKey []byte
+}
+
+type FetchUniverseLeafKeyRow struct {
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+}
+
+func (q *Queries) FetchUniverseLeafKey(ctx context.Context, arg FetchUniverseLeafKeyParams) (FetchUniverseLeafKeyRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseLeafKey, arg.Namespace, arg.LeafNodeKey)
+	var i FetchUniverseLeafKeyRow
+	err := row.Scan(&i.MintingPoint, &i.ScriptKeyBytes)
+	return i, err
+}
+
 const fetchUniverseRoot = `-- name: FetchUniverseRoot :one
 SELECT universe_roots.asset_id, group_key, proof_type,
        mssmt_nodes.hash_key root_hash, mssmt_nodes.sum root_sum,
@@ -149,6 +511,100 @@ func (q *Queries) FetchUniverseRoot(ctx context.Context, namespace string) (Fetc
 	return i, err
 }
 
+const fetchUniverseSnapshotLeaves = `-- name: FetchUniverseSnapshotLeaves :many
+SELECT leaves.id, leaves.minting_point, leaves.script_key_bytes,
+       nodes.value genesis_proof, nodes.sum sum_amt
+FROM universe_leaves leaves
+JOIN mssmt_nodes nodes
+    ON leaves.leaf_node_key = nodes.key AND
+        leaves.leaf_node_namespace = nodes.namespace
+WHERE leaves.leaf_node_namespace = $1 AND
+    leaves.id > $2
+ORDER BY leaves.id
+LIMIT $3
+`
+
+type FetchUniverseSnapshotLeavesParams struct {
+	Namespace string
+	AfterID   int64
+	NumLimit  int32
+}
+
+type FetchUniverseSnapshotLeavesRow struct {
+	ID             int64
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+	GenesisProof   []byte
+	SumAmt         int64
+}
+
+func (q *Queries) FetchUniverseSnapshotLeaves(ctx context.Context, arg FetchUniverseSnapshotLeavesParams) ([]FetchUniverseSnapshotLeavesRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchUniverseSnapshotLeaves, arg.Namespace, arg.AfterID, arg.NumLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniverseSnapshotLeavesRow
+	for rows.Next() {
+		var i FetchUniverseSnapshotLeavesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MintingPoint,
+			&i.ScriptKeyBytes,
+			&i.GenesisProof,
+			&i.SumAmt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchUnusedUniverseCommitmentSnapshots = `-- name: FetchUnusedUniverseCommitmentSnapshots :many
+SELECT snapshots.id
+FROM universe_commitment_snapshots snapshots
+WHERE NOT EXISTS (
+    SELECT 1
+    FROM universe_commitments
+    WHERE root_hash = snapshots.root_hash
+) AND NOT EXISTS (
+    SELECT 1
+    FROM pending_universe_commitments
+    WHERE root_hash = snapshots.root_hash
+)
+ORDER BY snapshots.id
+`
+
+func (q *Queries) FetchUnusedUniverseCommitmentSnapshots(ctx context.Context) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, fetchUnusedUniverseCommitmentSnapshots)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertNewProofEvent = `-- name: InsertNewProofEvent :exec
 WITH group_key_root_id AS (
     SELECT id
@@ -247,6 +703,177 @@ func (q *Queries) InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEvent
 	return err
 }
 
+const insertPendingUniverseCommitment = `-- name: InsertPendingUniverseCommitment :exec
+INSERT INTO pending_universe_commitments (
+    anchor_tx, output_index, internal_key, root_hash, root_sum, height_hint
+) VALUES (
+    $1, $2, $3, $4, $5,
+    $6
+)
+`
+
+type InsertPendingUniverseCommitmentParams struct {
+	AnchorTx    []byte
+	OutputIndex int32
+	InternalKey []byte
+	RootHash    []byte
+	RootSum     int64
+	HeightHint  int32
+}
+
+func (q *Queries) InsertPendingUniverseCommitment(ctx context.Context, arg InsertPendingUniverseCommitmentParams) error {
+	_, err := q.db.ExecContext(ctx, insertPendingUniverseCommitment,
+		arg.AnchorTx,
+		arg.OutputIndex,
+		arg.InternalKey,
+		arg.RootHash,
+		arg.RootSum,
+		arg.HeightHint,
+	)
+	return err
+}
+
+const insertUniverseCommitment = `-- name: InsertUniverseCommitment :one
+INSERT INTO universe_commitments (
+    block_height, block_header, merkle_proof, anchor_tx, output_index,
+    internal_key, root_hash, root_sum
+) VALUES (
+    $1, $2, $3, $4, $5,
+    $6, $7, $8
+)
+ON CONFLICT (internal_key) DO NOTHING
+RETURNING id
+`
+
+type InsertUniverseCommitmentParams struct {
+	BlockHeight int32
+	BlockHeader []byte
+	MerkleProof []byte
+	AnchorTx    []byte
+	OutputIndex int32
+	InternalKey []byte
+	RootHash    []byte
+	RootSum     int64
+}
+
+func (q *Queries) InsertUniverseCommitment(ctx context.Context, arg InsertUniverseCommitmentParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, insertUniverseCommitment,
+		arg.BlockHeight,
+		arg.BlockHeader,
+		arg.MerkleProof,
+		arg.AnchorTx,
+		arg.OutputIndex,
+		arg.InternalKey,
+		arg.RootHash,
+		arg.RootSum,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertUniverseCommitmentNode = `-- name: InsertUniverseCommitmentNode :exec
+INSERT INTO universe_commitment_nodes (
+    hash_key, namespace, l_hash_key, r_hash_key, key, value, sum,
+    snapshot_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7,
+    $8
+)
+ON CONFLICT (hash_key, namespace) DO NOTHING
+`
+
+type InsertUniverseCommitmentNodeParams struct {
+	HashKey    []byte
+	Namespace  string
+	LHashKey   []byte
+	RHashKey   []byte
+	Key        []byte
+	Value      []byte
+	Sum        int64
+	SnapshotID int64
+}
+
+func (q *Queries) InsertUniverseCommitmentNode(ctx context.Context, arg InsertUniverseCommitmentNodeParams) error {
+	_, err := q.db.ExecContext(ctx, insertUniverseCommitmentNode,
+		arg.HashKey,
+		arg.Namespace,
+		arg.LHashKey,
+		arg.RHashKey,
+		arg.Key,
+		arg.Value,
+		arg.Sum,
+		arg.SnapshotID,
+	)
+	return err
+}
+
+const insertUniverseCommitmentSnapshot = `-- name: InsertUniverseCommitmentSnapshot :one
+INSERT INTO universe_commitment_snapshots (
+    root_hash, root_sum
+) VALUES (
+    $1, $2
+)
+RETURNING id
+`
+
+type InsertUniverseCommitmentSnapshotParams struct {
+	RootHash []byte
+	RootSum  int64
+}
+
+func (q *Queries) InsertUniverseCommitmentSnapshot(ctx context.Context, arg InsertUniverseCommitmentSnapshotParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, insertUniverseCommitmentSnapshot, arg.RootHash, arg.RootSum)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertUniverseEventLog = `-- name: InsertUniverseEventLog :exec
+INSERT INTO universe_event_log (
+    id, event_type, asset_id, group_key, proof_type, leaf_outpoint,
+    leaf_script_key, leaf_asset_id, root_hash, root_sum, server_host,
+    event_timestamp
+) VALUES (
+    $1, $2, $3, $4, $5, $6,
+    $7, $8, $9, $10, $11,
+    $12
+)
+`
+
+type InsertUniverseEventLogParams struct {
+	ID             int64
+	EventType      string
+	AssetID        []byte
+	GroupKey       []byte
+	ProofType      string
+	LeafOutpoint   []byte
+	LeafScriptKey  []byte
+	LeafAssetID    []byte
+	RootHash       []byte
+	RootSum        sql.NullInt64
+	ServerHost     sql.NullString
+	EventTimestamp int64
+}
+
+func (q *Queries) InsertUniverseEventLog(ctx context.Context, arg InsertUniverseEventLogParams) error {
+	_, err := q.db.ExecContext(ctx, insertUniverseEventLog,
+		arg.ID,
+		arg.EventType,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.ProofType,
+		arg.LeafOutpoint,
+		arg.LeafScriptKey,
+		arg.LeafAssetID,
+		arg.RootHash,
+		arg.RootSum,
+		arg.ServerHost,
+		arg.EventTimestamp,
+	)
+	return err
+}
+
 const insertUniverseServer = `-- name: InsertUniverseServer :exec
 INSERT INTO universe_servers(
     server_host, last_sync_time
@@ -308,6 +935,30 @@ func (q *Queries) LogServerSync(ctx context.Context, arg LogServerSyncParams) er
 	return err
 }
 
+const nextUniverseEventLogID = `-- name: NextUniverseEventLogID :one
+UPDATE universe_event_log_seq
+SET last_id = last_id + 1
+WHERE id = 1
+RETURNING last_id
+`
+
+func (q *Queries) NextUniverseEventLogID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, nextUniverseEventLogID)
+	var last_id int64
+	err := row.Scan(&last_id)
+	return last_id, err
+}
+
+const pruneUniverseEventLog = `-- name: PruneUniverseEventLog :exec
+DELETE FROM universe_event_log
+WHERE event_timestamp < $1
+`
+
+func (q *Queries) PruneUniverseEventLog(ctx context.Context, before int64) error {
+	_, err := q.db.ExecContext(ctx, pruneUniverseEventLog, before)
+	return err
+}
+
 const queryAssetStatsPerDayPostgres = `-- name: QueryAssetStatsPerDayPostgres :many
 SELECT
     to_char(to_timestamp(event_timestamp), 'YYYY-MM-DD') AS day,
@@ -742,6 +1393,25 @@ func (q *Queries) QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow
 	return i, err
 }
 
+const reassignUniverseCommitmentNodes = `-- name: ReassignUniverseCommitmentNodes :exec
+UPDATE universe_commitment_nodes
+SET snapshot_id = (
+    SELECT MIN(id)
+    FROM universe_commitment_snapshots
+    WHERE id > $1
+)
+WHERE snapshot_id = $1 AND EXISTS (
+    SELECT 1
+    FROM universe_commitment_snapshots
+    WHERE id > $1
+)
+`
+
+func (q *Queries) ReassignUniverseCommitmentNodes(ctx context.Context, snapshotID int64) error {
+	_, err := q.db.ExecContext(ctx, reassignUniverseCommitmentNodes, snapshotID)
+	return err
+}
+
 const universeLeaves = `-- name: UniverseLeaves :many
 SELECT id, asset_genesis_id, minting_point, script_key_bytes, universe_root_id, leaf_node_key, leaf_node_namespace FROM universe_leaves
 `
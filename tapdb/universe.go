@@ -41,6 +41,20 @@ type (
 	// UniverseLeafKeysQuery is used to query for the set of keys that are
 	// currently stored for a given namespace.
 	UniverseLeafKeysQuery = sqlc.FetchUniverseKeysParams
+
+	// UniverseLeafKeyQuery is used to query for the leaf key of the leaf
+	// stored at a given MS-SMT key of a namespace.
+	UniverseLeafKeyQuery = sqlc.FetchUniverseLeafKeyParams
+
+	// UniverseLeafKeyRow is the leaf key of a universe leaf.
+	UniverseLeafKeyRow = sqlc.FetchUniverseLeafKeyRow
+
+	// UniverseSnapshotLeavesQuery is used to query a page of the leaves
+	// of a universe, along with their proofs.
+	UniverseSnapshotLeavesQuery = sqlc.FetchUniverseSnapshotLeavesParams
+
+	// UniverseSnapshotLeaf is a universe leaf along with its proof.
+	UniverseSnapshotLeaf = sqlc.FetchUniverseSnapshotLeavesRow
 )
 
 // BaseUniverseStore is the main interface for the Taproot Asset universe store.
@@ -89,6 +103,21 @@ type BaseUniverseStore interface {
 	// for a given namespace.
 	FetchUniverseKeys(ctx context.Context,
 		arg UniverseLeafKeysQuery) ([]UniverseKeys, error)
+
+	// FetchUniverseLeafKey fetches the leaf key of the leaf stored at the
+	// given MS-SMT key of a namespace.
+	FetchUniverseLeafKey(ctx context.Context,
+		arg UniverseLeafKeyQuery) (UniverseLeafKeyRow, error)
+
+	// CountUniverseLeaves returns the number of leaves of the universe
+	// with the given namespace.
+	CountUniverseLeaves(ctx context.Context, namespace string) (int64,
+		error)
+
+	// FetchUniverseSnapshotLeaves fetches a page of the leaves of a
+	// universe along with their proofs, in the order they were inserted.
+	FetchUniverseSnapshotLeaves(ctx context.Context,
+		arg UniverseSnapshotLeavesQuery) ([]UniverseSnapshotLeaf, error)
 }
 
 // BaseUniverseStoreOptions is the set of options for universe tree queries.
@@ -638,27 +667,14 @@ func mintingKeys(ctx context.Context, dbTx BaseUniverseStore,
 
 	var leafKeys []universe.LeafKey
 	err = fn.ForEachErr(universeKeys, func(key UniverseKeys) error {
-		scriptKeyPub, err := schnorr.ParsePubKey(
-			key.ScriptKeyBytes,
-		)
-		if err != nil {
-			return err
-		}
-		scriptKey := asset.NewScriptKey(scriptKeyPub)
-
-		var genPoint wire.OutPoint
-		err = readOutPoint(
-			bytes.NewReader(key.MintingPoint), 0, 0,
-			&genPoint,
+		leafKey, err := decodeLeafKey(
+			key.MintingPoint, key.ScriptKeyBytes,
 		)
 		if err != nil {
 			return err
 		}
 
-		leafKeys = append(leafKeys, universe.LeafKey{
-			OutPoint:  genPoint,
-			ScriptKey: &scriptKey,
-		})
+		leafKeys = append(leafKeys, leafKey)
 
 		return nil
 	})
@@ -669,6 +685,75 @@ func mintingKeys(ctx context.Context, dbTx BaseUniverseStore,
 	return leafKeys, nil
 }
 
+// decodeLeafKey decodes a universe leaf key from the minting point and script
+// key stored for a universe leaf.
+func decodeLeafKey(mintingPoint,
+	scriptKeyBytes []byte) (universe.LeafKey, error) {
+
+	scriptKeyPub, err := schnorr.ParsePubKey(scriptKeyBytes)
+	if err != nil {
+		return universe.LeafKey{}, err
+	}
+	scriptKey := asset.NewScriptKey(scriptKeyPub)
+
+	var genPoint wire.OutPoint
+	err = readOutPoint(bytes.NewReader(mintingPoint), 0, 0, &genPoint)
+	if err != nil {
+		return universe.LeafKey{}, err
+	}
+
+	return universe.LeafKey{
+		OutPoint:  genPoint,
+		ScriptKey: &scriptKey,
+	}, nil
+}
+
+// universeBranch returns the branch at the given path of the universe tree
+// with the given namespace.
+func universeBranch(ctx context.Context, db BaseUniverseStore,
+	namespace string, path mssmt.NodePath) (*universe.Branch, error) {
+
+	universeTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(db, namespace),
+	)
+
+	node, err := universeTree.NodeAtPath(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch node at path: %w", err)
+	}
+
+	branch := &universe.Branch{
+		Path: path,
+		Node: mssmt.NewComputedNode(node.NodeHash(), node.NodeSum()),
+	}
+
+	// If there's only a single leaf in the branch, we'll also look up its
+	// leaf key, so the caller can fetch the proof of the leaf.
+	compLeaf, ok := node.(*mssmt.CompactedLeafNode)
+	if !ok {
+		return branch, nil
+	}
+
+	smtKey := compLeaf.Key()
+	dbLeafKey, err := db.FetchUniverseLeafKey(ctx, UniverseLeafKeyQuery{
+		Namespace:   namespace,
+		LeafNodeKey: smtKey[:],
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch leaf key: %w", err)
+	}
+
+	leafKey, err := decodeLeafKey(
+		dbLeafKey.MintingPoint, dbLeafKey.ScriptKeyBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	branch.LeafKey = &leafKey
+
+	return branch, nil
+}
+
 // MintingKeys returns all the keys inserted in the universe.
 func (b *BaseUniverseTree) MintingKeys(ctx context.Context,
 	q universe.UniverseLeafKeysQuery) ([]universe.LeafKey, error) {
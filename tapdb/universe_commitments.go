@@ -0,0 +1,903 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+type (
+	// NewUniverseCommitment is used to insert a new chain commitment of
+	// the multiverse root.
+	NewUniverseCommitment = sqlc.InsertUniverseCommitmentParams
+
+	// UniverseCommitment is a chain commitment of the multiverse root
+	// stored on disk.
+	UniverseCommitment = sqlc.UniverseCommitment
+
+	// NewPendingCommitment is used to insert a new chain commitment whose
+	// anchor transaction hasn't confirmed yet.
+	NewPendingCommitment = sqlc.InsertPendingUniverseCommitmentParams
+
+	// PendingUniverseCommitment is a pending chain commitment of the
+	// multiverse root stored on disk.
+	PendingUniverseCommitment = sqlc.PendingUniverseCommitment
+
+	// IssuanceUniverseRoot is the root of an issuance universe.
+	IssuanceUniverseRoot = sqlc.FetchIssuanceUniverseRootsRow
+
+	// NewCommitmentSnapshot is used to insert a new snapshot of the
+	// multiverse.
+	NewCommitmentSnapshot = sqlc.InsertUniverseCommitmentSnapshotParams
+
+	// NewCommittedNode is used to insert a tree node as of a snapshot of
+	// the multiverse.
+	NewCommittedNode = sqlc.InsertUniverseCommitmentNodeParams
+
+	// CommittedNodeQuery is used to query for a tree node as of a snapshot
+	// of the multiverse.
+	CommittedNodeQuery = sqlc.FetchUniverseCommitmentNodeParams
+
+	// CommittedNode is a tree node as of a snapshot of the multiverse.
+	CommittedNode = sqlc.UniverseCommitmentNode
+
+	// CommittedChildQuery is used to query for the children of a tree
+	// node as of a snapshot of the multiverse.
+	CommittedChildQuery = sqlc.FetchUniverseCommitmentChildrenParams
+
+	// CommittedChildNode is a tree node, or one of its children, as of a
+	// snapshot of the multiverse.
+	CommittedChildNode = sqlc.FetchUniverseCommitmentChildrenRow
+)
+
+// UniverseCommitmentStore is the database interface used to persist the chain
+// commitments of the multiverse root.
+type UniverseCommitmentStore interface {
+	// BaseUniverseStore is used to read the multiverse and universe trees
+	// when taking a snapshot of the multiverse.
+	BaseUniverseStore
+
+	// FetchIssuanceUniverseRoots fetches the roots of all issuance
+	// universes.
+	FetchIssuanceUniverseRoots(
+		ctx context.Context) ([]IssuanceUniverseRoot, error)
+
+	// InsertUniverseCommitment inserts a new chain commitment, unless a
+	// commitment with the same internal key already exists.
+	InsertUniverseCommitment(ctx context.Context,
+		arg NewUniverseCommitment) (int64, error)
+
+	// InsertPendingUniverseCommitment inserts a new chain commitment
+	// whose anchor transaction hasn't confirmed yet.
+	InsertPendingUniverseCommitment(ctx context.Context,
+		arg NewPendingCommitment) error
+
+	// FetchPendingUniverseCommitment fetches the oldest pending chain
+	// commitment.
+	FetchPendingUniverseCommitment(
+		ctx context.Context) (PendingUniverseCommitment, error)
+
+	// DeletePendingUniverseCommitment deletes the pending chain
+	// commitment with the given internal key.
+	DeletePendingUniverseCommitment(ctx context.Context,
+		internalKey []byte) error
+
+	// InsertUniverseCommitmentSnapshot inserts a new snapshot of the
+	// multiverse with the given root.
+	InsertUniverseCommitmentSnapshot(ctx context.Context,
+		arg NewCommitmentSnapshot) (int64, error)
+
+	// FetchUniverseCommitmentSnapshot fetches the ID of the snapshot of
+	// the multiverse with the given root hash.
+	FetchUniverseCommitmentSnapshot(ctx context.Context,
+		rootHash []byte) (int64, error)
+
+	// FetchUnusedUniverseCommitmentSnapshots fetches the IDs of the
+	// snapshots whose root isn't committed to by a confirmed or pending
+	// commitment, ordered from oldest to newest.
+	FetchUnusedUniverseCommitmentSnapshots(
+		ctx context.Context) ([]int64, error)
+
+	// ReassignUniverseCommitmentNodes passes the tree nodes owned by the
+	// given snapshot on to the next newer snapshot, if there is one.
+	ReassignUniverseCommitmentNodes(ctx context.Context,
+		snapshotID int64) error
+
+	// DeleteUniverseCommitmentSnapshot deletes the given snapshot, along
+	// with the tree nodes it still owns.
+	DeleteUniverseCommitmentSnapshot(ctx context.Context, id int64) error
+
+	// InsertUniverseCommitmentNode inserts a tree node as of a snapshot,
+	// unless the node is already stored.
+	InsertUniverseCommitmentNode(ctx context.Context,
+		arg NewCommittedNode) error
+
+	// FetchUniverseCommitmentNode fetches a tree node as of a snapshot.
+	FetchUniverseCommitmentNode(ctx context.Context,
+		arg CommittedNodeQuery) (CommittedNode, error)
+
+	// FetchUniverseCommitmentChildren fetches a tree node as of a
+	// snapshot, followed by its children.
+	FetchUniverseCommitmentChildren(ctx context.Context,
+		arg CommittedChildQuery) ([]CommittedChildNode, error)
+
+	// FetchLatestUniverseCommitment fetches the chain commitment with the
+	// greatest block height.
+	FetchLatestUniverseCommitment(
+		ctx context.Context) (UniverseCommitment, error)
+
+	// FetchUniverseCommitmentAtHeight fetches the latest chain commitment
+	// that confirmed at or below the given block height.
+	FetchUniverseCommitmentAtHeight(ctx context.Context,
+		blockHeight int32) (UniverseCommitment, error)
+}
+
+// UniverseCommitmentOptions is the database tx object for the universe
+// commitment store.
+type UniverseCommitmentOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction is read only.
+func (u *UniverseCommitmentOptions) ReadOnly() bool {
+	return u.readOnly
+}
+
+// NewUniverseCommitmentReadTx returns a new read tx for the universe
+// commitment store.
+func NewUniverseCommitmentReadTx() UniverseCommitmentOptions {
+	return UniverseCommitmentOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedUniverseCommitmentStore allows for batched DB transactions for the
+// universe commitment store.
+type BatchedUniverseCommitmentStore interface {
+	UniverseCommitmentStore
+
+	BatchedTx[UniverseCommitmentStore]
+}
+
+// UniverseCommitmentDB is a database backed implementation of the
+// universe.CommitmentStore interface.
+type UniverseCommitmentDB struct {
+	db BatchedUniverseCommitmentStore
+}
+
+// A compile-time check to ensure that UniverseCommitmentDB meets the
+// universe.CommitmentStore interface.
+var _ universe.CommitmentStore = (*UniverseCommitmentDB)(nil)
+
+// NewUniverseCommitmentDB makes a new universe commitment DB.
+func NewUniverseCommitmentDB(
+	db BatchedUniverseCommitmentStore) *UniverseCommitmentDB {
+
+	return &UniverseCommitmentDB{
+		db: db,
+	}
+}
+
+// SnapshotMultiverse takes a snapshot of the current issuance multiverse and
+// stores it, so the proofs of the committed leaves can still be derived after
+// the multiverse changed. Only the tree nodes that aren't part of an earlier
+// snapshot are stored. Earlier snapshots that aren't used by any commitment
+// are removed first.
+func (u *UniverseCommitmentDB) SnapshotMultiverse(
+	ctx context.Context) (*universe.CommitmentSnapshot, error) {
+
+	var snapshot *universe.CommitmentSnapshot
+
+	var writeTx UniverseCommitmentOptions
+	dbErr := u.db.ExecTx(
+		ctx, &writeTx, func(db UniverseCommitmentStore) error {
+			err := deleteUnusedSnapshots(ctx, db)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err = snapshotMultiverse(ctx, db)
+			return err
+		},
+	)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return snapshot, nil
+}
+
+// snapshotMultiverse stores the nodes of the issuance multiverse tree and of
+// all issuance universe trees that aren't stored yet as part of a new
+// snapshot. If the multiverse didn't change since an earlier snapshot, then
+// that snapshot is re-used.
+func snapshotMultiverse(ctx context.Context,
+	db UniverseCommitmentStore) (*universe.CommitmentSnapshot, error) {
+
+	multiverseTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(db, issuanceMultiverseNS),
+	)
+	multiverseRoot, err := multiverseTree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &universe.CommitmentSnapshot{
+		Root: multiverseRoot,
+	}
+
+	// An empty multiverse is never committed to, so there's nothing to
+	// store.
+	if mssmt.IsEqualNode(multiverseRoot, mssmt.EmptyTree[0]) {
+		return snapshot, nil
+	}
+
+	rootHash := multiverseRoot.NodeHash()
+	_, err = db.FetchUniverseCommitmentSnapshot(ctx, rootHash[:])
+	switch {
+	case err == nil:
+		return snapshot, nil
+
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, fmt.Errorf("unable to fetch snapshot: %w", err)
+	}
+
+	snapshotID, err := db.InsertUniverseCommitmentSnapshot(
+		ctx, NewCommitmentSnapshot{
+			RootHash: rootHash[:],
+			RootSum:  int64(multiverseRoot.NodeSum()),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to insert snapshot: %w", err)
+	}
+
+	err = snapshotTree(
+		ctx, db, snapshotID, issuanceMultiverseNS, multiverseRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The multiverse leaves only reference the universe roots, so we'll
+	// store the universe trees separately. Any universe that didn't
+	// change since an earlier snapshot is skipped right at its root.
+	dbRoots, err := db.FetchIssuanceUniverseRoots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch universe roots: %w",
+			err)
+	}
+
+	for _, dbRoot := range dbRoots {
+		var universeRootHash mssmt.NodeHash
+		copy(universeRootHash[:], dbRoot.RootHash)
+
+		universeRoot := mssmt.NewComputedBranch(
+			universeRootHash, uint64(dbRoot.RootSum),
+		)
+		err := snapshotTree(
+			ctx, db, snapshotID, dbRoot.NamespaceRoot, universeRoot,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshot, nil
+}
+
+// snapshotTree stores the nodes of the tree with the given root and namespace
+// as part of the given snapshot. The tree is walked down from its root, but
+// any subtree whose root is already stored is skipped, as the nodes are
+// addressed by their hash and a node is always stored along with its subtree.
+func snapshotTree(ctx context.Context, db UniverseCommitmentStore,
+	snapshotID int64, namespace string, root mssmt.Node) error {
+
+	treeTx := &taprootAssetTreeStoreTx{
+		ctx:       ctx,
+		dbTx:      db,
+		namespace: namespace,
+	}
+
+	var snapshotBranch func(height int, branch mssmt.Node) error
+	snapshotBranch = func(height int, branch mssmt.Node) error {
+		hashKey := branch.NodeHash()
+		_, err := db.FetchUniverseCommitmentNode(
+			ctx, CommittedNodeQuery{
+				HashKey:   hashKey[:],
+				Namespace: namespace,
+			},
+		)
+		switch {
+		case err == nil:
+			return nil
+
+		case !errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("unable to fetch node: %w", err)
+		}
+
+		left, right, err := treeTx.GetChildren(height, hashKey)
+		if err != nil {
+			return err
+		}
+
+		lHashKey := left.NodeHash()
+		rHashKey := right.NodeHash()
+		err = db.InsertUniverseCommitmentNode(ctx, NewCommittedNode{
+			HashKey:    hashKey[:],
+			Namespace:  namespace,
+			LHashKey:   lHashKey[:],
+			RHashKey:   rHashKey[:],
+			Sum:        int64(branch.NodeSum()),
+			SnapshotID: snapshotID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert branch: %w", err)
+		}
+
+		for _, child := range []mssmt.Node{left, right} {
+			// Empty subtrees are never stored.
+			emptyHash := mssmt.EmptyTree[height+1].NodeHash()
+			if child.NodeHash() == emptyHash {
+				continue
+			}
+
+			leaf, ok := child.(*mssmt.CompactedLeafNode)
+			if !ok {
+				err := snapshotBranch(height+1, child)
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			leafHashKey := leaf.NodeHash()
+			key := leaf.Key()
+			err := db.InsertUniverseCommitmentNode(
+				ctx, NewCommittedNode{
+					HashKey:    leafHashKey[:],
+					Namespace:  namespace,
+					Key:        key[:],
+					Value:      leaf.Value,
+					Sum:        int64(leaf.NodeSum()),
+					SnapshotID: snapshotID,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert leaf: %w",
+					err)
+			}
+		}
+
+		return nil
+	}
+
+	return snapshotBranch(0, root)
+}
+
+// deleteUnusedSnapshots removes all snapshots that aren't used by a confirmed
+// or pending commitment. The nodes of a removed snapshot might still be shared
+// with a newer snapshot, so they're passed on to the next newer snapshot
+// instead of being removed, if there is one.
+func deleteUnusedSnapshots(ctx context.Context,
+	db UniverseCommitmentStore) error {
+
+	snapshotIDs, err := db.FetchUnusedUniverseCommitmentSnapshots(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch unused snapshots: %w", err)
+	}
+
+	for _, snapshotID := range snapshotIDs {
+		err := db.ReassignUniverseCommitmentNodes(ctx, snapshotID)
+		if err != nil {
+			return fmt.Errorf("unable to reassign snapshot nodes: "+
+				"%w", err)
+		}
+
+		err = db.DeleteUniverseCommitmentSnapshot(ctx, snapshotID)
+		if err != nil {
+			return fmt.Errorf("unable to delete snapshot: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// InsertPendingCommitment stores a new commitment whose anchor transaction
+// hasn't confirmed yet. The snapshot of the multiverse it commits to must
+// already be stored.
+func (u *UniverseCommitmentDB) InsertPendingCommitment(ctx context.Context,
+	pending *universe.PendingCommitment,
+	snapshot *universe.CommitmentSnapshot) error {
+
+	if pending.AnchorTx == nil || pending.InternalKey == nil ||
+		pending.UniverseRoot == nil {
+
+		return fmt.Errorf("incomplete pending universe commitment")
+	}
+
+	if !mssmt.IsEqualNode(pending.UniverseRoot, snapshot.Root) {
+		return fmt.Errorf("snapshot doesn't match committed root")
+	}
+
+	var txBuf bytes.Buffer
+	if err := pending.AnchorTx.Serialize(&txBuf); err != nil {
+		return err
+	}
+
+	rootHash := pending.UniverseRoot.NodeHash()
+	dbPending := NewPendingCommitment{
+		AnchorTx:    txBuf.Bytes(),
+		OutputIndex: int32(pending.OutputIndex),
+		InternalKey: pending.InternalKey.SerializeCompressed(),
+		RootHash:    rootHash[:],
+		RootSum:     int64(pending.UniverseRoot.NodeSum()),
+		HeightHint:  int32(pending.HeightHint),
+	}
+
+	var writeTx UniverseCommitmentOptions
+	return u.db.ExecTx(
+		ctx, &writeTx, func(db UniverseCommitmentStore) error {
+			_, err := db.FetchUniverseCommitmentSnapshot(
+				ctx, rootHash[:],
+			)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				return fmt.Errorf("no snapshot of committed " +
+					"root found")
+
+			case err != nil:
+				return err
+			}
+
+			return db.InsertPendingUniverseCommitment(
+				ctx, dbPending,
+			)
+		},
+	)
+}
+
+// PendingCommitment returns the commitment that is waiting for its anchor
+// transaction to confirm.
+func (u *UniverseCommitmentDB) PendingCommitment(
+	ctx context.Context) (*universe.PendingCommitment, error) {
+
+	var pending *universe.PendingCommitment
+
+	readTx := NewUniverseCommitmentReadTx()
+	dbErr := u.db.ExecTx(
+		ctx, &readTx, func(db UniverseCommitmentStore) error {
+			dbPending, err := db.FetchPendingUniverseCommitment(ctx)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				return universe.ErrNoCommitment
+
+			case err != nil:
+				return err
+			}
+
+			pending, err = decodePendingCommitment(dbPending)
+			return err
+		},
+	)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return pending, nil
+}
+
+// AbandonPendingCommitment removes a pending commitment whose anchor
+// transaction will never confirm, along with its snapshot if no other
+// commitment uses it.
+func (u *UniverseCommitmentDB) AbandonPendingCommitment(ctx context.Context,
+	pending *universe.PendingCommitment) error {
+
+	internalKey := pending.InternalKey.SerializeCompressed()
+
+	var writeTx UniverseCommitmentOptions
+	return u.db.ExecTx(
+		ctx, &writeTx, func(db UniverseCommitmentStore) error {
+			err := db.DeletePendingUniverseCommitment(
+				ctx, internalKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			return deleteUnusedSnapshots(ctx, db)
+		},
+	)
+}
+
+// InsertCommitment stores a new confirmed chain commitment, replacing the
+// pending commitment with the same anchor output. Inserting the same
+// commitment more than once is a no-op.
+func (u *UniverseCommitmentDB) InsertCommitment(ctx context.Context,
+	commitment *universe.Commitment) error {
+
+	if commitment.AnchorTx == nil || commitment.MerkleProof == nil ||
+		commitment.InternalKey == nil ||
+		commitment.UniverseRoot == nil {
+
+		return fmt.Errorf("incomplete universe commitment")
+	}
+
+	var headerBuf bytes.Buffer
+	if err := commitment.BlockHeader.Serialize(&headerBuf); err != nil {
+		return err
+	}
+
+	var proofBuf bytes.Buffer
+	if err := commitment.MerkleProof.Encode(&proofBuf); err != nil {
+		return err
+	}
+
+	var txBuf bytes.Buffer
+	if err := commitment.AnchorTx.Serialize(&txBuf); err != nil {
+		return err
+	}
+
+	rootHash := commitment.UniverseRoot.NodeHash()
+	dbCommitment := NewUniverseCommitment{
+		BlockHeight: int32(commitment.BlockHeight),
+		BlockHeader: headerBuf.Bytes(),
+		MerkleProof: proofBuf.Bytes(),
+		AnchorTx:    txBuf.Bytes(),
+		OutputIndex: int32(commitment.OutputIndex),
+		InternalKey: commitment.InternalKey.SerializeCompressed(),
+		RootHash:    rootHash[:],
+		RootSum:     int64(commitment.UniverseRoot.NodeSum()),
+	}
+
+	var writeTx UniverseCommitmentOptions
+	return u.db.ExecTx(
+		ctx, &writeTx, func(db UniverseCommitmentStore) error {
+			_, err := db.InsertUniverseCommitment(ctx, dbCommitment)
+			switch {
+			// The commitment was already stored, for example while
+			// waiting for it from more than one caller.
+			case errors.Is(err, sql.ErrNoRows):
+
+			case err != nil:
+				return err
+			}
+
+			return db.DeletePendingUniverseCommitment(
+				ctx, dbCommitment.InternalKey,
+			)
+		},
+	)
+}
+
+// LatestCommitment returns the chain commitment with the greatest block
+// height.
+func (u *UniverseCommitmentDB) LatestCommitment(
+	ctx context.Context) (*universe.Commitment, error) {
+
+	return u.fetchCommitment(
+		ctx, func(db UniverseCommitmentStore) (UniverseCommitment,
+			error) {
+
+			return db.FetchLatestUniverseCommitment(ctx)
+		},
+	)
+}
+
+// CommitmentAtHeight returns the latest chain commitment that was confirmed at
+// or below the given block height.
+func (u *UniverseCommitmentDB) CommitmentAtHeight(ctx context.Context,
+	height uint32) (*universe.Commitment, error) {
+
+	return u.fetchCommitment(
+		ctx, func(db UniverseCommitmentStore) (UniverseCommitment,
+			error) {
+
+			return db.FetchUniverseCommitmentAtHeight(
+				ctx, int32(height),
+			)
+		},
+	)
+}
+
+// fetchCommitment fetches a single commitment with the given query and decodes
+// it.
+func (u *UniverseCommitmentDB) fetchCommitment(ctx context.Context,
+	query func(UniverseCommitmentStore) (UniverseCommitment,
+		error)) (*universe.Commitment, error) {
+
+	var commitment *universe.Commitment
+
+	readTx := NewUniverseCommitmentReadTx()
+	dbErr := u.db.ExecTx(
+		ctx, &readTx, func(db UniverseCommitmentStore) error {
+			dbCommitment, err := query(db)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				return universe.ErrNoCommitment
+
+			case err != nil:
+				return err
+			}
+
+			commitment, err = decodeUniverseCommitment(
+				dbCommitment,
+			)
+			return err
+		},
+	)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return commitment, nil
+}
+
+// decodeUniverseCommitment decodes a chain commitment stored on disk.
+func decodeUniverseCommitment(
+	dbCommitment UniverseCommitment) (*universe.Commitment, error) {
+
+	var header wire.BlockHeader
+	err := header.Deserialize(bytes.NewReader(dbCommitment.BlockHeader))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode block header: %w", err)
+	}
+
+	var merkleProof proof.TxMerkleProof
+	err = merkleProof.Decode(bytes.NewReader(dbCommitment.MerkleProof))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode merkle proof: %w", err)
+	}
+
+	var anchorTx wire.MsgTx
+	err = anchorTx.Deserialize(bytes.NewReader(dbCommitment.AnchorTx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode anchor tx: %w", err)
+	}
+
+	internalKey, err := btcec.ParsePubKey(dbCommitment.InternalKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse internal key: %w", err)
+	}
+
+	var rootHash mssmt.NodeHash
+	copy(rootHash[:], dbCommitment.RootHash)
+
+	return &universe.Commitment{
+		BlockHeight: uint32(dbCommitment.BlockHeight),
+		BlockHeader: header,
+		MerkleProof: &merkleProof,
+		AnchorTx:    &anchorTx,
+		OutputIndex: uint32(dbCommitment.OutputIndex),
+		InternalKey: internalKey,
+		UniverseRoot: mssmt.NewComputedBranch(
+			rootHash, uint64(dbCommitment.RootSum),
+		),
+	}, nil
+}
+
+// FetchCommittedProof returns the universe and multiverse inclusion proofs of
+// the given issuance leaf, as of the snapshot of the given committed
+// multiverse root. The proofs are derived from the tree nodes stored with the
+// snapshot. The Leaf of the returned proof isn't set.
+func (u *UniverseCommitmentDB) FetchCommittedProof(ctx context.Context,
+	multiverseRoot mssmt.Node, id universe.Identifier,
+	key universe.LeafKey) (*universe.Proof, error) {
+
+	var (
+		rootHash       = multiverseRoot.NodeHash()
+		universeKey    = key.UniverseKey()
+		namespace      = id.String()
+		committedProof *universe.Proof
+	)
+
+	readTx := NewUniverseCommitmentReadTx()
+	dbErr := u.db.ExecTx(
+		ctx, &readTx, func(db UniverseCommitmentStore) error {
+			_, err := db.FetchUniverseCommitmentSnapshot(
+				ctx, rootHash[:],
+			)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				return universe.ErrNoUniverseProofFound
+
+			case err != nil:
+				return err
+			}
+
+			multiverseStore := newCommittedTreeStore(
+				db, issuanceMultiverseNS, multiverseRoot,
+			)
+			multiverseTree := mssmt.NewCompactedTree(
+				multiverseStore,
+			)
+			multiverseLeaf, err := multiverseTree.Get(
+				ctx, id.Bytes(),
+			)
+			switch {
+			case err != nil:
+				return err
+
+			case multiverseLeaf.IsEmpty():
+				return universe.ErrNoUniverseProofFound
+			}
+
+			multiverseProof, err := multiverseTree.MerkleProof(
+				ctx, id.Bytes(),
+			)
+			if err != nil {
+				return err
+			}
+
+			// The multiverse leaf commits to the hash of the
+			// universe root, but not to its sum, so we'll fetch
+			// the root node itself.
+			universeRootHash, err := newKey(multiverseLeaf.Value)
+			if err != nil {
+				return err
+			}
+			dbRoot, err := db.FetchUniverseCommitmentNode(
+				ctx, CommittedNodeQuery{
+					HashKey:   universeRootHash[:],
+					Namespace: namespace,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to fetch universe "+
+					"root: %w", err)
+			}
+
+			universeRoot := mssmt.NewComputedBranch(
+				universeRootHash, uint64(dbRoot.Sum),
+			)
+			universeTree := mssmt.NewCompactedTree(
+				newCommittedTreeStore(
+					db, namespace, universeRoot,
+				),
+			)
+			leaf, err := universeTree.Get(ctx, universeKey)
+			switch {
+			case err != nil:
+				return err
+
+			case leaf.IsEmpty():
+				return universe.ErrNoUniverseProofFound
+			}
+
+			universeProof, err := universeTree.MerkleProof(
+				ctx, universeKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			committedProof = &universe.Proof{
+				LeafKey:                  key,
+				UniverseRoot:             universeRoot,
+				UniverseInclusionProof:   universeProof,
+				MultiverseRoot:           multiverseRoot,
+				MultiverseInclusionProof: multiverseProof,
+			}
+
+			return nil
+		},
+	)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return committedProof, nil
+}
+
+// committedTreeStore is a read only view of a tree as of a snapshot of the
+// multiverse. It re-uses an existing transaction.
+type committedTreeStore struct {
+	db        UniverseCommitmentStore
+	namespace string
+	root      *mssmt.BranchNode
+}
+
+// newCommittedTreeStore creates a view of the tree with the given namespace
+// and root, as stored with a snapshot of the multiverse.
+func newCommittedTreeStore(db UniverseCommitmentStore, namespace string,
+	root mssmt.Node) *committedTreeStore {
+
+	return &committedTreeStore{
+		db:        db,
+		namespace: namespace,
+		root: mssmt.NewComputedBranch(
+			root.NodeHash(), root.NodeSum(),
+		),
+	}
+}
+
+// Update always fails, as the tree of a snapshot is never modified.
+func (c *committedTreeStore) Update(context.Context,
+	func(tx mssmt.TreeStoreUpdateTx) error) error {
+
+	return fmt.Errorf("snapshot tree is read only")
+}
+
+// View re-uses the existing transaction to view the tree.
+func (c *committedTreeStore) View(ctx context.Context,
+	view func(tx mssmt.TreeStoreViewTx) error) error {
+
+	return view(&committedTreeStoreTx{
+		ctx:   ctx,
+		store: c,
+	})
+}
+
+// committedTreeStoreTx is a view transaction of a snapshot tree.
+type committedTreeStoreTx struct {
+	ctx   context.Context
+	store *committedTreeStore
+}
+
+// GetChildren returns the left and right child of the node keyed by the given
+// NodeHash.
+func (c *committedTreeStoreTx) GetChildren(height int,
+	hashKey mssmt.NodeHash) (mssmt.Node, mssmt.Node, error) {
+
+	dbRows, err := c.store.db.FetchUniverseCommitmentChildren(
+		c.ctx, CommittedChildQuery{
+			HashKey:   hashKey[:],
+			Namespace: c.store.namespace,
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storedNodes := make([]StoredNode, 0, len(dbRows))
+	for _, dbRow := range dbRows {
+		storedNodes = append(storedNodes, StoredNode(dbRow))
+	}
+
+	return decodeChildren(height, storedNodes)
+}
+
+// RootNode returns the root node of the tree as of the snapshot.
+func (c *committedTreeStoreTx) RootNode() (mssmt.Node, error) {
+	return c.store.root, nil
+}
+
+// decodePendingCommitment decodes a pending chain commitment stored on disk.
+func decodePendingCommitment(
+	dbPending PendingUniverseCommitment) (*universe.PendingCommitment,
+	error) {
+
+	var anchorTx wire.MsgTx
+	err := anchorTx.Deserialize(bytes.NewReader(dbPending.AnchorTx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode anchor tx: %w", err)
+	}
+
+	internalKey, err := btcec.ParsePubKey(dbPending.InternalKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse internal key: %w", err)
+	}
+
+	var rootHash mssmt.NodeHash
+	copy(rootHash[:], dbPending.RootHash)
+
+	return &universe.PendingCommitment{
+		AnchorTx:    &anchorTx,
+		OutputIndex: uint32(dbPending.OutputIndex),
+		InternalKey: internalKey,
+		UniverseRoot: mssmt.NewComputedBranch(
+			rootHash, uint64(dbPending.RootSum),
+		),
+		HeightHint: uint32(dbPending.HeightHint),
+	}, nil
+}
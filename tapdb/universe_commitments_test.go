@@ -0,0 +1,434 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUniverseCommitmentDB(t *testing.T) *UniverseCommitmentDB {
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) UniverseCommitmentStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewUniverseCommitmentDB(dbTxer)
+}
+
+// randUniverseCommitment creates a random chain commitment confirmed at the
+// given height.
+func randUniverseCommitment(t *testing.T,
+	height uint32) *universe.Commitment {
+
+	root := mssmt.NewComputedBranch(
+		mssmt.NodeHash(test.RandHash()), test.RandInt[uint64](),
+	)
+	internalKey := test.RandPubKey(t)
+
+	pkScript, err := universe.RootCommitmentPkScript(internalKey, root)
+	require.NoError(t, err)
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		Value:    1_000,
+		PkScript: pkScript,
+	})
+
+	merkleProof, err := proof.NewTxMerkleProof(
+		[]*wire.MsgTx{anchorTx}, 0,
+	)
+	require.NoError(t, err)
+
+	merkleRoot := blockchain.CalcMerkleRoot(
+		[]*btcutil.Tx{btcutil.NewTx(anchorTx)}, false,
+	)
+
+	return &universe.Commitment{
+		BlockHeight: height,
+		BlockHeader: wire.BlockHeader{
+			PrevBlock:  test.RandHash(),
+			MerkleRoot: merkleRoot,
+			Timestamp:  time.Unix(int64(height)*600, 0),
+		},
+		MerkleProof:  merkleProof,
+		AnchorTx:     anchorTx,
+		OutputIndex:  0,
+		InternalKey:  internalKey,
+		UniverseRoot: root,
+	}
+}
+
+// assertCommitmentEqual asserts that the two chain commitments are equal.
+func assertCommitmentEqual(t *testing.T, expected,
+	actual *universe.Commitment) {
+
+	require.Equal(t, expected.BlockHeight, actual.BlockHeight)
+	require.Equal(t, expected.BlockHeader, actual.BlockHeader)
+	require.Equal(t, expected.MerkleProof, actual.MerkleProof)
+	require.Equal(t, expected.AnchorTx.TxHash(), actual.AnchorTx.TxHash())
+	require.Equal(t, expected.OutputIndex, actual.OutputIndex)
+	require.True(t, expected.InternalKey.IsEqual(actual.InternalKey))
+	require.True(t, mssmt.IsEqualNode(
+		expected.UniverseRoot, actual.UniverseRoot,
+	))
+}
+
+// TestUniverseCommitmentDB tests that we can store chain commitments of the
+// multiverse root and look them up by height.
+func TestUniverseCommitmentDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	commitmentDB := newTestUniverseCommitmentDB(t)
+
+	// Without any commitments, we should get the expected error.
+	_, err := commitmentDB.LatestCommitment(ctx)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	_, err = commitmentDB.CommitmentAtHeight(ctx, 100)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	// We'll now insert a few commitments.
+	heights := []uint32{100, 200, 300}
+	commitments := make([]*universe.Commitment, 0, len(heights))
+	for _, height := range heights {
+		commitment := randUniverseCommitment(t, height)
+		require.NoError(t, commitmentDB.InsertCommitment(
+			ctx, commitment,
+		))
+
+		commitments = append(commitments, commitment)
+	}
+
+	// The latest commitment should be the one with the greatest height.
+	latest, err := commitmentDB.LatestCommitment(ctx)
+	require.NoError(t, err)
+	assertCommitmentEqual(t, commitments[2], latest)
+
+	// The stored commitment should still verify.
+	noopVerifier := func(wire.BlockHeader, uint32) error {
+		return nil
+	}
+	require.NoError(t, latest.Verify(noopVerifier))
+
+	// Looking up a commitment by height should return the latest one at
+	// or below that height.
+	testCases := []struct {
+		height   uint32
+		expected *universe.Commitment
+	}{
+		{height: 100, expected: commitments[0]},
+		{height: 150, expected: commitments[0]},
+		{height: 200, expected: commitments[1]},
+		{height: 1_000, expected: commitments[2]},
+	}
+	for _, testCase := range testCases {
+		commitment, err := commitmentDB.CommitmentAtHeight(
+			ctx, testCase.height,
+		)
+		require.NoError(t, err)
+		assertCommitmentEqual(t, testCase.expected, commitment)
+	}
+
+	_, err = commitmentDB.CommitmentAtHeight(ctx, 99)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+}
+
+// TestUniverseCommitmentSnapshot tests that the snapshot of the multiverse
+// that is stored with a commitment still proves the committed leaves after the
+// multiverse changed, and that pending commitments are tracked until they're
+// either confirmed or abandoned.
+func TestUniverseCommitmentSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := NewTestDB(t)
+
+	multiverse := NewMultiverseStore(NewTransactionExecutor(db,
+		func(tx *sql.Tx) BaseMultiverseStore {
+			return db.WithTx(tx)
+		},
+	))
+	commitmentDB := NewUniverseCommitmentDB(NewTransactionExecutor(db,
+		func(tx *sql.Tx) UniverseCommitmentStore {
+			return db.WithTx(tx)
+		},
+	))
+
+	insertLeaf := func(id universe.Identifier) universe.LeafKey {
+		assetGen := asset.RandGenesis(t, asset.Normal)
+		leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+		leafKey := randLeafKey(t)
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, leafKey, &leaf, nil,
+		)
+		require.NoError(t, err)
+
+		return leafKey
+	}
+
+	// newPending snapshots the multiverse and stores a new pending
+	// commitment of its root.
+	newPending := func(height uint32) (*universe.Commitment,
+		*universe.CommitmentSnapshot) {
+
+		snapshot, err := commitmentDB.SnapshotMultiverse(ctx)
+		require.NoError(t, err)
+
+		commitment := randUniverseCommitment(t, height)
+		commitment.UniverseRoot = snapshot.Root
+
+		pending := &universe.PendingCommitment{
+			AnchorTx:     commitment.AnchorTx,
+			OutputIndex:  commitment.OutputIndex,
+			InternalKey:  commitment.InternalKey,
+			UniverseRoot: commitment.UniverseRoot,
+			HeightHint:   height - 1,
+		}
+		require.NoError(t, commitmentDB.InsertPendingCommitment(
+			ctx, pending, snapshot,
+		))
+
+		storedPending, err := commitmentDB.PendingCommitment(ctx)
+		require.NoError(t, err)
+		require.Equal(
+			t, pending.AnchorTx.TxHash(),
+			storedPending.AnchorTx.TxHash(),
+		)
+		require.Equal(t, pending.OutputIndex, storedPending.OutputIndex)
+		require.True(t, pending.InternalKey.IsEqual(
+			storedPending.InternalKey,
+		))
+		require.True(t, mssmt.IsEqualNode(
+			pending.UniverseRoot, storedPending.UniverseRoot,
+		))
+		require.Equal(t, pending.HeightHint, storedPending.HeightHint)
+
+		return commitment, snapshot
+	}
+
+	// confirm stores the confirmed commitment, which replaces the pending
+	// one.
+	confirm := func(commitment *universe.Commitment) {
+		// Storing the same commitment twice should be a no-op.
+		for i := 0; i < 2; i++ {
+			require.NoError(t, commitmentDB.InsertCommitment(
+				ctx, commitment,
+			))
+		}
+
+		_, err := commitmentDB.PendingCommitment(ctx)
+		require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+		latest, err := commitmentDB.LatestCommitment(ctx)
+		require.NoError(t, err)
+		assertCommitmentEqual(t, commitment, latest)
+	}
+
+	assertCommitted := func(commitment *universe.Commitment,
+		id universe.Identifier, leafKey universe.LeafKey) {
+
+		committedProof, err := commitmentDB.FetchCommittedProof(
+			ctx, commitment.UniverseRoot, id, leafKey,
+		)
+		require.NoError(t, err)
+
+		proofs, err := multiverse.FetchProofLeaf(ctx, id, leafKey)
+		require.NoError(t, err)
+		require.Len(t, proofs, 1)
+
+		committedProof.Leaf = proofs[0].Leaf
+		require.True(t, committedProof.VerifyRoot(
+			committedProof.UniverseRoot,
+		))
+		require.True(t, committedProof.VerifyMultiverseRoot(
+			id, commitment.UniverseRoot,
+		))
+	}
+
+	assertNotCommitted := func(commitment *universe.Commitment,
+		id universe.Identifier, leafKey universe.LeafKey) {
+
+		_, err := commitmentDB.FetchCommittedProof(
+			ctx, commitment.UniverseRoot, id, leafKey,
+		)
+		require.ErrorIs(t, err, universe.ErrNoUniverseProofFound)
+	}
+
+	// snapshotID returns the ID of the stored snapshot of the given
+	// multiverse root.
+	snapshotID := func(root mssmt.Node) int64 {
+		rootHash := root.NodeHash()
+		id, err := db.FetchUniverseCommitmentSnapshot(ctx, rootHash[:])
+		require.NoError(t, err)
+
+		return id
+	}
+
+	// rootOwner returns the ID of the snapshot that stored the current
+	// root node of the given universe, or an error if it isn't stored.
+	rootOwner := func(id universe.Identifier) (int64, error) {
+		root, err := multiverse.UniverseRootNode(ctx, id)
+		require.NoError(t, err)
+
+		rootHash := root.Node.NodeHash()
+		dbNode, err := db.FetchUniverseCommitmentNode(
+			ctx, CommittedNodeQuery{
+				HashKey:   rootHash[:],
+				Namespace: id.String(),
+			},
+		)
+
+		return dbNode.SnapshotID, err
+	}
+
+	// We'll start with a grouped universe and a second universe, each with
+	// a single leaf.
+	groupID := randUniverseID(t, true)
+	otherID := randUniverseID(t, false)
+	groupKey1 := insertLeaf(groupID)
+	otherKey := insertLeaf(otherID)
+
+	// An abandoned commitment removes its snapshot. The nodes that are
+	// shared with a newer snapshot are passed on to that snapshot.
+	abandon := func(commitment *universe.Commitment) {
+		require.NoError(t, commitmentDB.AbandonPendingCommitment(
+			ctx, &universe.PendingCommitment{
+				InternalKey:  commitment.InternalKey,
+				UniverseRoot: commitment.UniverseRoot,
+			},
+		))
+	}
+
+	abandoned, _ := newPending(50)
+	unusedKey := insertLeaf(otherID)
+
+	newerSnapshot, err := commitmentDB.SnapshotMultiverse(ctx)
+	require.NoError(t, err)
+	newer := randUniverseCommitment(t, 60)
+	newer.UniverseRoot = newerSnapshot.Root
+	require.NoError(t, commitmentDB.InsertPendingCommitment(
+		ctx, &universe.PendingCommitment{
+			AnchorTx:     newer.AnchorTx,
+			OutputIndex:  newer.OutputIndex,
+			InternalKey:  newer.InternalKey,
+			UniverseRoot: newer.UniverseRoot,
+		}, newerSnapshot,
+	))
+
+	abandon(abandoned)
+	assertNotCommitted(abandoned, otherID, otherKey)
+	assertCommitted(newer, otherID, otherKey)
+
+	owner, err := rootOwner(groupID)
+	require.NoError(t, err)
+	require.Equal(t, snapshotID(newerSnapshot.Root), owner)
+
+	// Without a newer snapshot, the nodes are removed along with the
+	// snapshot.
+	abandon(newer)
+	assertNotCommitted(newer, otherID, otherKey)
+
+	_, err = rootOwner(groupID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// A snapshot that isn't used by any commitment is removed once the
+	// next snapshot is taken.
+	unusedSnapshot, err := commitmentDB.SnapshotMultiverse(ctx)
+	require.NoError(t, err)
+
+	insertLeaf(otherID)
+	commitment1, snapshot := newPending(100)
+	snapshot1 := snapshotID(snapshot.Root)
+
+	unusedHash := unusedSnapshot.Root.NodeHash()
+	_, err = db.FetchUniverseCommitmentSnapshot(ctx, unusedHash[:])
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	owner, err = rootOwner(groupID)
+	require.NoError(t, err)
+	require.Equal(t, snapshot1, owner)
+
+	// Taking a snapshot of an unchanged multiverse re-uses the stored
+	// snapshot.
+	sameSnapshot, err := commitmentDB.SnapshotMultiverse(ctx)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(snapshot.Root, sameSnapshot.Root))
+	require.Equal(t, snapshot1, snapshotID(sameSnapshot.Root))
+
+	confirm(commitment1)
+
+	assertCommitted(commitment1, groupID, groupKey1)
+	assertCommitted(commitment1, otherID, otherKey)
+	assertCommitted(commitment1, otherID, unusedKey)
+
+	// Adding a new leaf changes the multiverse root, but the leaves of
+	// the first commitment should still be proven by its snapshot.
+	groupKey2 := insertLeaf(groupID)
+
+	assertCommitted(commitment1, groupID, groupKey1)
+	assertCommitted(commitment1, otherID, otherKey)
+	assertNotCommitted(commitment1, groupID, groupKey2)
+
+	// The nodes of the unchanged universe are shared with the first
+	// snapshot, so only the nodes of the changed universe are stored.
+	commitment2, snapshot := newPending(200)
+	snapshot2 := snapshotID(snapshot.Root)
+
+	owner, err = rootOwner(otherID)
+	require.NoError(t, err)
+	require.Equal(t, snapshot1, owner)
+
+	owner, err = rootOwner(groupID)
+	require.NoError(t, err)
+	require.Equal(t, snapshot2, owner)
+
+	// Until the commitment is confirmed, the first one is still the
+	// latest.
+	latest, err := commitmentDB.LatestCommitment(ctx)
+	require.NoError(t, err)
+	assertCommitmentEqual(t, commitment1, latest)
+
+	confirm(commitment2)
+
+	assertCommitted(commitment2, groupID, groupKey1)
+	assertCommitted(commitment2, groupID, groupKey2)
+	assertCommitted(commitment2, otherID, otherKey)
+	assertCommitted(commitment1, groupID, groupKey1)
+
+	// An abandoned commitment shouldn't leave its snapshot behind.
+	groupKey3 := insertLeaf(groupID)
+	commitment3, _ := newPending(300)
+	assertCommitted(commitment3, groupID, groupKey3)
+
+	abandon(commitment3)
+
+	_, err = commitmentDB.PendingCommitment(ctx)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+	assertNotCommitted(commitment3, groupID, groupKey3)
+
+	_, err = rootOwner(groupID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	// The confirmed commitments are unaffected.
+	assertCommitted(commitment2, groupID, groupKey2)
+	assertCommitted(commitment1, otherID, otherKey)
+}
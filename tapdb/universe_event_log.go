@@ -0,0 +1,377 @@
+package tapdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+type (
+	// NewUniverseEvent is used to append a new event to the universe event
+	// log.
+	NewUniverseEvent = sqlc.InsertUniverseEventLogParams
+
+	// UniverseEventQuery is used to fetch the events logged after a
+	// cursor.
+	UniverseEventQuery = sqlc.FetchUniverseEventLogParams
+
+	// UniverseEventLogEntry is a universe event stored on disk.
+	UniverseEventLogEntry = sqlc.UniverseEventLog
+)
+
+// UniverseEventInserter is the database interface used to append events to
+// the universe event log.
+type UniverseEventInserter interface {
+	// NextUniverseEventLogID increments the counter of the event log and
+	// returns the ID of the next event. The counter is locked until the
+	// transaction is committed.
+	NextUniverseEventLogID(ctx context.Context) (int64, error)
+
+	// InsertUniverseEventLog appends a new event to the log.
+	InsertUniverseEventLog(ctx context.Context, arg NewUniverseEvent) error
+}
+
+// UniverseEventLogStore is the database interface used to persist the log of
+// universe events.
+type UniverseEventLogStore interface {
+	UniverseEventInserter
+
+	// FetchUniverseEventLog fetches the events logged after a cursor.
+	FetchUniverseEventLog(ctx context.Context,
+		arg UniverseEventQuery) ([]UniverseEventLogEntry, error)
+
+	// FetchUniverseEventLogHead fetches the cursor of the last logged
+	// event, or zero if no event was logged yet.
+	FetchUniverseEventLogHead(ctx context.Context) (int64, error)
+
+	// FetchUniverseEventLogTail fetches the cursor of the oldest event in
+	// the log, or the cursor of the next event if the log is empty.
+	FetchUniverseEventLogTail(ctx context.Context) (int64, error)
+
+	// PruneUniverseEventLog deletes the events logged before a unix
+	// timestamp.
+	PruneUniverseEventLog(ctx context.Context, before int64) error
+}
+
+// UniverseEventLogOptions is the database tx object for the universe event
+// log store.
+type UniverseEventLogOptions struct {
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction is read only.
+func (u *UniverseEventLogOptions) ReadOnly() bool {
+	return u.readOnly
+}
+
+// NewUniverseEventLogReadTx returns a new read tx for the universe event log
+// store.
+func NewUniverseEventLogReadTx() UniverseEventLogOptions {
+	return UniverseEventLogOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedUniverseEventLogStore allows for batched DB transactions for the
+// universe event log store.
+type BatchedUniverseEventLogStore interface {
+	UniverseEventLogStore
+
+	BatchedTx[UniverseEventLogStore]
+}
+
+// UniverseEventLogDB is a database backed implementation of the
+// universe.EventLog interface.
+type UniverseEventLogDB struct {
+	db BatchedUniverseEventLogStore
+
+	clock clock.Clock
+}
+
+// A compile-time check to ensure that UniverseEventLogDB meets the
+// universe.EventLog interface.
+var _ universe.EventLog = (*UniverseEventLogDB)(nil)
+
+// NewUniverseEventLogDB makes a new universe event log DB.
+func NewUniverseEventLogDB(db BatchedUniverseEventLogStore,
+	clock clock.Clock) *UniverseEventLogDB {
+
+	return &UniverseEventLogDB{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// LogEvents appends the passed events to the log, setting their cursor and
+// timestamp.
+func (u *UniverseEventLogDB) LogEvents(ctx context.Context,
+	events ...*universe.Event) error {
+
+	now := u.clock.Now().UTC()
+
+	var (
+		writeTx UniverseEventLogOptions
+		cursors []int64
+	)
+	err := u.db.ExecTx(ctx, &writeTx, func(db UniverseEventLogStore) error {
+		var err error
+		cursors, err = insertUniverseEvents(ctx, db, now, events...)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Only once the events were committed, we'll update them with their
+	// position in the log.
+	for i, event := range events {
+		event.Cursor = uint64(cursors[i])
+		event.Timestamp = time.Unix(now.Unix(), 0)
+	}
+
+	return nil
+}
+
+// FetchEvents returns up to limit events that were logged after the event
+// with the given cursor, in the order they were logged.
+func (u *UniverseEventLogDB) FetchEvents(ctx context.Context, cursor uint64,
+	limit int) ([]*universe.Event, error) {
+
+	var events []*universe.Event
+
+	readTx := NewUniverseEventLogReadTx()
+	dbErr := u.db.ExecTx(
+		ctx, &readTx, func(db UniverseEventLogStore) error {
+			events = nil
+
+			dbEvents, err := db.FetchUniverseEventLog(
+				ctx, UniverseEventQuery{
+					Cursor:   int64(cursor),
+					NumLimit: int32(limit),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, dbEvent := range dbEvents {
+				event, err := decodeUniverseEvent(dbEvent)
+				if err != nil {
+					return err
+				}
+
+				events = append(events, event)
+			}
+
+			return nil
+		},
+	)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return events, nil
+}
+
+// LatestCursor returns the cursor of the last logged event, or zero if no
+// events were logged yet.
+func (u *UniverseEventLogDB) LatestCursor(ctx context.Context) (uint64,
+	error) {
+
+	var cursor int64
+
+	readTx := NewUniverseEventLogReadTx()
+	dbErr := u.db.ExecTx(
+		ctx, &readTx, func(db UniverseEventLogStore) error {
+			var err error
+			cursor, err = db.FetchUniverseEventLogHead(ctx)
+			return err
+		},
+	)
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return uint64(cursor), nil
+}
+
+// FirstCursor returns the cursor of the oldest event in the log. If the log
+// is empty, this is the cursor the next event will be logged with.
+func (u *UniverseEventLogDB) FirstCursor(ctx context.Context) (uint64,
+	error) {
+
+	var cursor int64
+
+	readTx := NewUniverseEventLogReadTx()
+	dbErr := u.db.ExecTx(
+		ctx, &readTx, func(db UniverseEventLogStore) error {
+			var err error
+			cursor, err = db.FetchUniverseEventLogTail(ctx)
+			return err
+		},
+	)
+	if dbErr != nil {
+		return 0, dbErr
+	}
+
+	return uint64(cursor), nil
+}
+
+// PruneEvents removes all events that were logged before the given time.
+func (u *UniverseEventLogDB) PruneEvents(ctx context.Context,
+	before time.Time) error {
+
+	var writeTx UniverseEventLogOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseEventLogStore) error {
+		return db.PruneUniverseEventLog(ctx, before.Unix())
+	})
+}
+
+// insertUniverseEvents appends the passed events to the event log with the
+// given timestamp, returning their cursors. This can be used to log events
+// within the same database transaction as the change they describe.
+//
+// NOTE: The cursors are taken from a counter that stays locked until the
+// transaction is committed, so the events of concurrent transactions are
+// assigned their cursors in the order the transactions are committed.
+func insertUniverseEvents(ctx context.Context, db UniverseEventInserter,
+	timestamp time.Time, events ...*universe.Event) ([]int64, error) {
+
+	cursors := make([]int64, len(events))
+	for i, event := range events {
+		dbEvent, err := encodeUniverseEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		dbEvent.ID, err = db.NextUniverseEventLogID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to assign universe "+
+				"event cursor: %w", err)
+		}
+		dbEvent.EventTimestamp = timestamp.Unix()
+		cursors[i] = dbEvent.ID
+
+		err = db.InsertUniverseEventLog(ctx, dbEvent)
+		if err != nil {
+			return nil, fmt.Errorf("unable to log universe event: "+
+				"%w", err)
+		}
+	}
+
+	return cursors, nil
+}
+
+// encodeUniverseEvent encodes a universe event to be stored on disk.
+func encodeUniverseEvent(event *universe.Event) (NewUniverseEvent, error) {
+	dbEvent := NewUniverseEvent{
+		EventType: event.Type.String(),
+		ProofType: event.ID.ProofType.String(),
+	}
+
+	if event.ID.GroupKey != nil {
+		dbEvent.GroupKey = event.ID.GroupKey.SerializeCompressed()
+	} else {
+		dbEvent.AssetID = fn.ByteSlice(event.ID.AssetID)
+	}
+
+	if event.LeafKey != nil {
+		outPoint, err := encodeOutpoint(event.LeafKey.OutPoint)
+		if err != nil {
+			return dbEvent, err
+		}
+
+		dbEvent.LeafOutpoint = outPoint
+		dbEvent.LeafScriptKey = schnorr.SerializePubKey(
+			event.LeafKey.ScriptKey.PubKey,
+		)
+	}
+
+	if event.LeafAssetID != nil {
+		dbEvent.LeafAssetID = fn.ByteSlice(*event.LeafAssetID)
+	}
+
+	if event.Root != nil {
+		rootHash := event.Root.NodeHash()
+		dbEvent.RootHash = rootHash[:]
+		dbEvent.RootSum = sqlInt64(event.Root.NodeSum())
+	}
+
+	dbEvent.ServerHost = sqlStr(event.ServerHost)
+
+	return dbEvent, nil
+}
+
+// decodeUniverseEvent decodes a universe event stored on disk.
+func decodeUniverseEvent(
+	dbEvent UniverseEventLogEntry) (*universe.Event, error) {
+
+	eventType, err := universe.ParseStrEventType(dbEvent.EventType)
+	if err != nil {
+		return nil, err
+	}
+
+	proofType, err := universe.ParseStrProofType(dbEvent.ProofType)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &universe.Event{
+		Cursor: uint64(dbEvent.ID),
+		Type:   eventType,
+		ID: universe.Identifier{
+			ProofType: proofType,
+		},
+		ServerHost: dbEvent.ServerHost.String,
+		Timestamp:  time.Unix(dbEvent.EventTimestamp, 0),
+	}
+
+	if dbEvent.GroupKey != nil {
+		event.ID.GroupKey, err = btcec.ParsePubKey(dbEvent.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse group key: %w",
+				err)
+		}
+	} else {
+		copy(event.ID.AssetID[:], dbEvent.AssetID)
+	}
+
+	if dbEvent.LeafOutpoint != nil {
+		leafKey, err := decodeLeafKey(
+			dbEvent.LeafOutpoint, dbEvent.LeafScriptKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode leaf key: %w",
+				err)
+		}
+
+		event.LeafKey = &leafKey
+	}
+
+	if dbEvent.LeafAssetID != nil {
+		var leafAssetID asset.ID
+		copy(leafAssetID[:], dbEvent.LeafAssetID)
+
+		event.LeafAssetID = &leafAssetID
+	}
+
+	if dbEvent.RootHash != nil {
+		var rootHash mssmt.NodeHash
+		copy(rootHash[:], dbEvent.RootHash)
+
+		event.Root = mssmt.NewComputedBranch(
+			rootHash, uint64(dbEvent.RootSum.Int64),
+		)
+	}
+
+	return event, nil
+}
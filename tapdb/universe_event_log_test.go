@@ -0,0 +1,303 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUniverseEventLogDB(t *testing.T,
+	clock clock.Clock) *UniverseEventLogDB {
+
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) UniverseEventLogStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewUniverseEventLogDB(dbTxer, clock)
+}
+
+// randUniverseEvents creates a random event of each type.
+func randUniverseEvents(t *testing.T) []*universe.Event {
+	var assetID asset.ID
+	test.RandRead(t, assetID[:])
+
+	scriptKey := asset.NewScriptKey(test.RandPubKey(t))
+	root := mssmt.NewComputedBranch(
+		mssmt.NodeHash(test.RandHash()), test.RandInt[uint64](),
+	)
+
+	return []*universe.Event{
+		{
+			Type: universe.EventTypeNewLeaf,
+			ID: universe.Identifier{
+				GroupKey:  test.RandPubKey(t),
+				ProofType: universe.ProofTypeTransfer,
+			},
+			LeafKey: &universe.LeafKey{
+				OutPoint:  test.RandOp(t),
+				ScriptKey: &scriptKey,
+			},
+			LeafAssetID: &assetID,
+		},
+		{
+			Type: universe.EventTypeNewRoot,
+			ID: universe.Identifier{
+				AssetID:   assetID,
+				ProofType: universe.ProofTypeIssuance,
+			},
+			Root: root,
+		},
+		{
+			Type: universe.EventTypeSync,
+			ID: universe.Identifier{
+				AssetID:   assetID,
+				ProofType: universe.ProofTypeIssuance,
+			},
+			Root:       root,
+			ServerHost: "universe.example.com:10029",
+		},
+	}
+}
+
+// assertEventEqual asserts that the two universe events are equal.
+func assertEventEqual(t *testing.T, expected, actual *universe.Event) {
+	require.Equal(t, expected.Cursor, actual.Cursor)
+	require.Equal(t, expected.Type, actual.Type)
+	require.Equal(t, expected.ID.String(), actual.ID.String())
+	require.Equal(t, expected.LeafAssetID, actual.LeafAssetID)
+	require.Equal(t, expected.ServerHost, actual.ServerHost)
+	require.Equal(t, expected.Timestamp.Unix(), actual.Timestamp.Unix())
+
+	if expected.LeafKey == nil {
+		require.Nil(t, actual.LeafKey)
+	} else {
+		require.Equal(
+			t, expected.LeafKey.UniverseKey(),
+			actual.LeafKey.UniverseKey(),
+		)
+	}
+
+	if expected.Root == nil {
+		require.Nil(t, actual.Root)
+	} else {
+		require.True(t, mssmt.IsEqualNode(expected.Root, actual.Root))
+	}
+}
+
+// TestUniverseEventLogDB tests that events are logged with increasing cursors
+// and can be fetched after any cursor.
+func TestUniverseEventLogDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testClock := clock.NewTestClock(time.Unix(1_700_000_000, 0))
+	eventLog := newTestUniverseEventLogDB(t, testClock)
+
+	// There are no events to fetch yet.
+	events, err := eventLog.FetchEvents(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	// We'll log two sets of events, each set should get the current time
+	// of the clock.
+	firstEvents := randUniverseEvents(t)
+	require.NoError(t, eventLog.LogEvents(ctx, firstEvents...))
+
+	testClock.SetTime(testClock.Now().Add(time.Minute))
+	secondEvents := randUniverseEvents(t)
+	require.NoError(t, eventLog.LogEvents(ctx, secondEvents...))
+
+	allEvents := append(firstEvents, secondEvents...)
+	for i, event := range allEvents {
+		require.Equal(t, uint64(i+1), event.Cursor)
+	}
+	require.Equal(
+		t, testClock.Now().Unix(), secondEvents[0].Timestamp.Unix(),
+	)
+
+	// Fetching all events should return them in the order they were
+	// logged.
+	events, err = eventLog.FetchEvents(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, len(allEvents))
+	for i := range allEvents {
+		assertEventEqual(t, allEvents[i], events[i])
+	}
+
+	// Fetching from a cursor should only return the events logged after
+	// it, up to the limit.
+	events, err = eventLog.FetchEvents(ctx, 2, 3)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	for i, event := range events {
+		assertEventEqual(t, allEvents[i+2], event)
+	}
+
+	events, err = eventLog.FetchEvents(ctx, uint64(len(allEvents)), 10)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	latestCursor, err := eventLog.LatestCursor(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, len(allEvents), latestCursor)
+
+	firstCursor, err := eventLog.FirstCursor(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, firstCursor)
+
+	// Pruning the events logged before the second set should only leave
+	// the second set, without affecting the cursors.
+	require.NoError(t, eventLog.PruneEvents(ctx, testClock.Now()))
+
+	events, err = eventLog.FetchEvents(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, len(secondEvents))
+	for i := range secondEvents {
+		assertEventEqual(t, secondEvents[i], events[i])
+	}
+
+	latestCursor, err = eventLog.LatestCursor(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, len(allEvents), latestCursor)
+
+	firstCursor, err = eventLog.FirstCursor(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, len(firstEvents)+1, firstCursor)
+
+	// Once all events are pruned, the cursors aren't reused, so a
+	// subscriber can't confuse new events with the ones it received.
+	testClock.SetTime(testClock.Now().Add(time.Minute))
+	require.NoError(t, eventLog.PruneEvents(ctx, testClock.Now()))
+
+	firstCursor, err = eventLog.FirstCursor(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, len(allEvents)+1, firstCursor)
+
+	latestCursor, err = eventLog.LatestCursor(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, len(allEvents), latestCursor)
+
+	thirdEvents := randUniverseEvents(t)
+	require.NoError(t, eventLog.LogEvents(ctx, thirdEvents...))
+	require.EqualValues(t, len(allEvents)+1, thirdEvents[0].Cursor)
+}
+
+// TestMultiverseEventLog tests that the multiverse logs the new leaf and root
+// events in the same transaction as the leaves it inserts.
+func TestMultiverseEventLog(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := NewTestDB(t)
+
+	multiverse := NewMultiverseStore(NewTransactionExecutor(
+		db, func(tx *sql.Tx) BaseMultiverseStore {
+			return db.WithTx(tx)
+		},
+	))
+	eventLog := NewUniverseEventLogDB(NewTransactionExecutor(
+		db, func(tx *sql.Tx) UniverseEventLogStore {
+			return db.WithTx(tx)
+		},
+	), clock.NewDefaultClock())
+
+	// A single leaf results in a new leaf and a new root event.
+	id := randUniverseID(t, false)
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	leafKey := randLeafKey(t)
+	leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+
+	uniProof, err := multiverse.UpsertProofLeaf(
+		ctx, id, leafKey, &leaf, nil,
+	)
+	require.NoError(t, err)
+
+	events, err := eventLog.FetchEvents(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	leafAssetID := leaf.Asset.ID()
+	require.Equal(t, universe.EventTypeNewLeaf, events[0].Type)
+	require.Equal(t, id.String(), events[0].ID.String())
+	require.Equal(
+		t, leafKey.UniverseKey(), events[0].LeafKey.UniverseKey(),
+	)
+	require.Equal(t, &leafAssetID, events[0].LeafAssetID)
+
+	require.Equal(t, universe.EventTypeNewRoot, events[1].Type)
+	require.True(
+		t, mssmt.IsEqualNode(uniProof.UniverseRoot, events[1].Root),
+	)
+
+	// A batch results in a new leaf event per item, followed by the final
+	// root of each universe.
+	otherID := randUniverseID(t, false)
+	otherGen := asset.RandGenesis(t, asset.Normal)
+	items := make([]*universe.Item, 0, 3)
+	for _, itemID := range []universe.Identifier{id, otherID, id} {
+		gen := assetGen
+		if itemID.String() == otherID.String() {
+			gen = otherGen
+		}
+
+		leaf := randMintingLeaf(t, gen, itemID.GroupKey)
+		items = append(items, &universe.Item{
+			ID:   itemID,
+			Key:  randLeafKey(t),
+			Leaf: &leaf,
+		})
+	}
+	require.NoError(t, multiverse.UpsertProofLeafBatch(ctx, items))
+
+	events, err = eventLog.FetchEvents(ctx, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, events, len(items)+2)
+
+	for i, item := range items {
+		require.Equal(t, universe.EventTypeNewLeaf, events[i].Type)
+		require.Equal(
+			t, item.Key.UniverseKey(),
+			events[i].LeafKey.UniverseKey(),
+		)
+	}
+	for i, rootID := range []universe.Identifier{id, otherID} {
+		event := events[len(items)+i]
+		require.Equal(t, universe.EventTypeNewRoot, event.Type)
+		require.Equal(t, rootID.String(), event.ID.String())
+
+		root, err := multiverse.UniverseRootNode(ctx, rootID)
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(root, event.Root))
+	}
+
+	// If a batch fails, none of its events are logged.
+	badLeaf := randMintingLeaf(t, assetGen, id.GroupKey)
+	badID := id
+	badID.ProofType = universe.ProofTypeUnspecified
+	err = multiverse.UpsertProofLeafBatch(ctx, []*universe.Item{{
+		ID:   id,
+		Key:  randLeafKey(t),
+		Leaf: &leaf,
+	}, {
+		ID:   badID,
+		Key:  randLeafKey(t),
+		Leaf: &badLeaf,
+	}})
+	require.Error(t, err)
+
+	latestCursor, err := eventLog.LatestCursor(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, len(items)+4, latestCursor)
+}
@@ -930,3 +930,165 @@ func TestMultiverseRootSum(t *testing.T) {
 		})
 	}
 }
+
+// TestMultiverseUniverseBranch tests that the branches of a universe tree can
+// be queried, and that bisecting the tree finds all of its leaves.
+func TestMultiverseUniverseBranch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse, _ := newTestMultiverse(t)
+
+	id := randUniverseID(t, false)
+	assetGen := asset.RandGenesis(t, asset.Normal)
+
+	const numLeaves = 10
+	leafKeys := make(map[[32]byte]universe.LeafKey, numLeaves)
+	for i := 0; i < numLeaves; i++ {
+		targetKey := randLeafKey(t)
+		leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, targetKey, &leaf, nil,
+		)
+		require.NoError(t, err)
+
+		leafKeys[targetKey.UniverseKey()] = targetKey
+	}
+
+	// The branch at the root of the tree should match the universe root.
+	rootBranch, err := multiverse.UniverseBranch(ctx, id, mssmt.NodePath{})
+	require.NoError(t, err)
+	require.Nil(t, rootBranch.LeafKey)
+
+	uniRoot, err := multiverse.UniverseRootNode(ctx, id)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(uniRoot, rootBranch.Node))
+
+	// Each leaf should be found at the bottom of the tree, along with its
+	// leaf key.
+	for smtKey, leafKey := range leafKeys {
+		leafPath, err := mssmt.NewNodePath(mssmt.MaxTreeLevels, smtKey)
+		require.NoError(t, err)
+
+		branch, err := multiverse.UniverseBranch(ctx, id, leafPath)
+		require.NoError(t, err)
+		require.False(t, branch.IsEmpty())
+		require.NotNil(t, branch.LeafKey)
+		require.Equal(t, leafKey.OutPoint, branch.LeafKey.OutPoint)
+		require.True(t, leafKey.ScriptKey.PubKey.IsEqual(
+			branch.LeafKey.ScriptKey.PubKey,
+		))
+	}
+
+	// A key that isn't in the tree should lead to an empty branch.
+	var unknownKey [32]byte
+	test.RandRead(t, unknownKey[:])
+	unknownPath, err := mssmt.NewNodePath(mssmt.MaxTreeLevels, unknownKey)
+	require.NoError(t, err)
+
+	branch, err := multiverse.UniverseBranch(ctx, id, unknownPath)
+	require.NoError(t, err)
+	require.True(t, branch.IsEmpty())
+	require.Nil(t, branch.LeafKey)
+
+	// Finally, bisecting the tree from the root should find every leaf
+	// exactly once.
+	foundKeys := make(map[[32]byte]struct{})
+	var bisect func(path mssmt.NodePath)
+	bisect = func(path mssmt.NodePath) {
+		branch, err := multiverse.UniverseBranch(ctx, id, path)
+		require.NoError(t, err)
+
+		switch {
+		case branch.IsEmpty():
+			return
+
+		case branch.LeafKey != nil:
+			smtKey := branch.LeafKey.UniverseKey()
+			require.True(t, path.Contains(smtKey))
+			require.NotContains(t, foundKeys, smtKey)
+
+			foundKeys[smtKey] = struct{}{}
+			return
+		}
+
+		bisect(path.Child(0))
+		bisect(path.Child(1))
+	}
+	bisect(mssmt.NodePath{})
+
+	require.Len(t, foundKeys, numLeaves)
+	for smtKey := range leafKeys {
+		require.Contains(t, foundKeys, smtKey)
+	}
+}
+
+// TestMultiverseReadUniverseSnapshot tests that the leaves of a universe are
+// read page by page in insertion order, along with the matching root.
+func TestMultiverseReadUniverseSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse, _ := newTestMultiverse(t)
+
+	id := randUniverseID(t, false)
+	assetGen := asset.RandGenesis(t, asset.Normal)
+
+	const numLeaves = 7
+	leaves := make([]leafWithKey, numLeaves)
+	for i := 0; i < numLeaves; i++ {
+		leaves[i] = leafWithKey{
+			LeafKey: randLeafKey(t),
+			Leaf:    randMintingLeaf(t, assetGen, id.GroupKey),
+		}
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, leaves[i].LeafKey, &leaves[i].Leaf, nil,
+		)
+		require.NoError(t, err)
+	}
+
+	uniRoot, err := multiverse.UniverseRootNode(ctx, id)
+	require.NoError(t, err)
+
+	var (
+		pageSizes  []int
+		readLeaves []*universe.SnapshotLeaf
+	)
+	err = multiverse.ReadUniverseSnapshot(
+		ctx, id, 3, func(root mssmt.Node, n uint64) error {
+			require.True(t, mssmt.IsEqualNode(uniRoot, root))
+			require.EqualValues(t, numLeaves, n)
+
+			return nil
+		}, func(page []*universe.SnapshotLeaf) error {
+			pageSizes = append(pageSizes, len(page))
+			readLeaves = append(readLeaves, page...)
+
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 3, 1}, pageSizes)
+
+	for i, leaf := range readLeaves {
+		require.Equal(
+			t, leaves[i].LeafKey.UniverseKey(),
+			leaf.Key.UniverseKey(),
+		)
+		require.Equal(t, leaves[i].RawProof, leaf.Leaf.RawProof)
+		require.Equal(t, leaves[i].Amt, leaf.Leaf.Amt)
+	}
+
+	// An unknown universe doesn't have a snapshot.
+	err = multiverse.ReadUniverseSnapshot(
+		ctx, randUniverseID(t, false), 3,
+		func(mssmt.Node, uint64) error {
+			return nil
+		}, func([]*universe.SnapshotLeaf) error {
+			return nil
+		},
+	)
+	require.ErrorIs(t, err, universe.ErrNoUniverseRoot)
+}
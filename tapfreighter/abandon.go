@@ -0,0 +1,276 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// AbandonResult is the result of successfully abandoning a pending transfer.
+type AbandonResult struct {
+	// Parcel is the abandoned transfer as it was logged to disk before it
+	// was removed.
+	Parcel *OutboundParcel
+
+	// DoubleSpendTx is the transaction that spends the BTC inputs of the
+	// anchor transaction back to the wallet, if the anchor transaction was
+	// double spent.
+	DoubleSpendTx *wire.MsgTx
+}
+
+// abandonReq is a request to abandon a pending transfer that is sent to the
+// goroutine waiting for the transfer's anchor transaction to confirm.
+type abandonReq struct {
+	// feeRate is the fee rate of the transaction double spending the
+	// anchor transaction.
+	feeRate chainfee.SatPerKWeight
+
+	// skipDoubleSpend indicates that the anchor transaction shouldn't be
+	// double spent and that its BTC inputs should only be released.
+	skipDoubleSpend bool
+
+	// respChan is the channel the result will be sent over.
+	respChan chan *AbandonResult
+
+	// errChan is the channel the error will be sent over.
+	errChan chan error
+}
+
+// AbandonTransfer abandons the pending transfer with the given anchor
+// transaction hash. Unless skipDoubleSpend is set, the BTC inputs of the
+// anchor transaction are double spent back to the wallet with the given fee
+// rate, which must be high enough to replace the anchor transaction in the
+// mempool. Otherwise, the inputs are only released, which is only safe if the
+// anchor transaction was never broadcast or was evicted from the mempool.
+// The transfer is then removed from disk, which makes the input assets
+// spendable again.
+//
+// NOTE: If the anchor transaction confirms after all, the transfer is no
+// longer tracked and the assets it created won't be accounted for.
+func (p *ChainPorter) AbandonTransfer(anchorTxHash chainhash.Hash,
+	feeRate chainfee.SatPerKWeight,
+	skipDoubleSpend bool) (*AbandonResult, error) {
+
+	req := &abandonReq{
+		feeRate:         feeRate,
+		skipDoubleSpend: skipDoubleSpend,
+		respChan:        make(chan *AbandonResult, 1),
+		errChan:         make(chan error, 1),
+	}
+
+	p.pendingTxsMtx.Lock()
+	pending, ok := p.pendingTxs[anchorTxHash]
+	p.pendingTxsMtx.Unlock()
+
+	// If no goroutine is waiting for the transfer to confirm, for example
+	// because broadcasting its anchor transaction failed, we abandon the
+	// transfer as it was logged to disk.
+	if !ok {
+		return p.abandonStoredParcel(anchorTxHash, req)
+	}
+
+	select {
+	case pending.abandonReqs <- req:
+
+	case <-pending.done:
+		return p.abandonStoredParcel(anchorTxHash, req)
+
+	case <-p.Quit:
+		return nil, fmt.Errorf("ChainPorter shutting down")
+	}
+
+	select {
+	case err := <-req.errChan:
+		return nil, err
+
+	case resp := <-req.respChan:
+		return resp, nil
+
+	case <-p.Quit:
+		return nil, fmt.Errorf("ChainPorter shutting down")
+	}
+}
+
+// abandonStoredParcel abandons the pending parcel with the given anchor
+// transaction hash that was logged to disk but isn't currently waiting for
+// its confirmation.
+func (p *ChainPorter) abandonStoredParcel(anchorTxHash chainhash.Hash,
+	req *abandonReq) (*AbandonResult, error) {
+
+	ctx, cancel := p.WithCtxQuit()
+	defer cancel()
+
+	parcels, err := p.cfg.ExportLog.PendingParcels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pending parcels: %w",
+			err)
+	}
+
+	for _, parcel := range parcels {
+		if parcel.AnchorTx.TxHash() != anchorTxHash {
+			continue
+		}
+
+		return p.abandonTransfer(NewPendingParcel(parcel).pkg(), req)
+	}
+
+	return nil, fmt.Errorf("%w: %v", ErrNoPendingTransfer, anchorTxHash)
+}
+
+// abandonTransfer executes the given abandon request for the given send
+// package. On success, the send package is moved to the abandoned state.
+func (p *ChainPorter) abandonTransfer(pkg *sendPackage,
+	req *abandonReq) (*AbandonResult, error) {
+
+	ctx, cancel := p.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	parcel := pkg.OutboundPkg
+	anchorTxHash := parcel.AnchorTx.TxHash()
+	walletInputs := anchorWalletInputs(parcel)
+
+	log.Infof("Abandoning transfer with anchor_txid=%v, "+
+		"skip_double_spend=%v", anchorTxHash, req.skipDoubleSpend)
+
+	result := &AbandonResult{
+		Parcel: parcel,
+	}
+	if req.skipDoubleSpend {
+		// The wallet might have released the inputs already, so we
+		// don't fail if it doesn't know about them.
+		for _, op := range walletInputs {
+			err := p.cfg.Wallet.UnlockInput(ctx, op)
+			if err != nil {
+				log.Warnf("Unable to unlock input %v: %v", op,
+					err)
+			}
+		}
+	} else {
+		doubleSpendTx, err := p.doubleSpendAnchorTx(
+			ctx, parcel, walletInputs, req.feeRate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result.DoubleSpendTx = doubleSpendTx
+	}
+
+	// Now that the anchor transaction can no longer confirm, we remove the
+	// transfer from disk, which makes the input assets spendable again.
+	err := p.cfg.ExportLog.AbandonParcel(ctx, anchorTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to abandon parcel: %w", err)
+	}
+
+	pkg.SendState = SendStateAbandoned
+	p.publishSubscriberEvent(NewExecuteSendStateEvent(pkg.SendState))
+
+	return result, nil
+}
+
+// doubleSpendAnchorTx creates, signs and broadcasts a transaction that spends
+// the given BTC inputs of the anchor transaction of the given parcel back to
+// the wallet. It must pay more fees than the anchor transaction to replace it
+// in the mempool.
+func (p *ChainPorter) doubleSpendAnchorTx(ctx context.Context,
+	parcel *OutboundParcel, inputs []wire.OutPoint,
+	feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error) {
+
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("anchor transaction has no BTC inputs " +
+			"to double spend")
+	}
+
+	// We only specify the inputs and let the wallet sweep them to a new
+	// change output. The transaction signals replaceability, so it can be
+	// bumped itself.
+	prevOuts := make([]*wire.OutPoint, len(inputs))
+	sequences := make([]uint32, len(inputs))
+	for idx := range inputs {
+		prevOuts[idx] = &inputs[idx]
+		sequences[idx] = wire.MaxTxInSequenceNum - 2
+	}
+	templatePkt, err := psbt.New(prevOuts, nil, 2, 0, sequences)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create double spend psbt: "+
+			"%w", err)
+	}
+
+	fundedPkt, err := p.cfg.Wallet.FundPsbt(ctx, templatePkt, 0, feeRate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund double spend psbt: %w",
+			err)
+	}
+
+	signedPkt, err := p.cfg.Wallet.SignAndFinalizePsbt(ctx, fundedPkt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign double spend psbt: %w",
+			err)
+	}
+
+	fee, err := signedPkt.GetTxFee()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get double spend fee: %w",
+			err)
+	}
+
+	doubleSpendTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract double spend psbt: "+
+			"%w", err)
+	}
+
+	// Just like a fee bump, the double spend must pay for the fees of the
+	// transaction it replaces and its own relay bandwidth.
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(doubleSpendTx))
+	minFee := parcel.ChainFees +
+		int64(minRelayFeeIncrement.FeeForWeight(weight))
+	if int64(fee) < minFee {
+		return nil, fmt.Errorf("%w: double spend must pay at least "+
+			"%d sats but only pays %d sats", ErrFeeRateTooLow,
+			minFee, fee)
+	}
+
+	log.Infof("Broadcasting double spend tx, txid=%v, anchor_txid=%v",
+		doubleSpendTx.TxHash(), parcel.AnchorTx.TxHash())
+
+	err = p.cfg.ChainBridge.PublishTransaction(ctx, doubleSpendTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish double spend tx: %w",
+			err)
+	}
+
+	return doubleSpendTx, nil
+}
+
+// anchorWalletInputs returns the inputs of the anchor transaction of the given
+// parcel that don't carry any assets, which are the BTC inputs the wallet
+// added to pay for the fees.
+func anchorWalletInputs(parcel *OutboundParcel) []wire.OutPoint {
+	assetInputs := fn.NewSet[wire.OutPoint]()
+	for _, input := range parcel.Inputs {
+		assetInputs.Add(input.OutPoint)
+	}
+	for _, passiveAsset := range parcel.PassiveAssets {
+		assetInputs.Add(passiveAsset.PrevAnchorPoint)
+	}
+
+	var walletInputs []wire.OutPoint
+	for _, txIn := range parcel.AnchorTx.TxIn {
+		if !assetInputs.Contains(txIn.PreviousOutPoint) {
+			walletInputs = append(
+				walletInputs, txIn.PreviousOutPoint,
+			)
+		}
+	}
+
+	return walletInputs
+}
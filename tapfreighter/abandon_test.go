@@ -0,0 +1,49 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAnchorWalletInputs tests that only the BTC inputs the wallet added to
+// the anchor transaction are selected for double spending or unlocking.
+func TestAnchorWalletInputs(t *testing.T) {
+	t.Parallel()
+
+	var (
+		activeInput  = wire.OutPoint{Hash: test.RandHash(), Index: 1}
+		passiveInput = wire.OutPoint{Hash: test.RandHash(), Index: 0}
+		walletInput1 = wire.OutPoint{Hash: test.RandHash(), Index: 2}
+		walletInput2 = wire.OutPoint{Hash: test.RandHash(), Index: 3}
+	)
+
+	anchorTx := wire.NewMsgTx(2)
+	for _, op := range []wire.OutPoint{
+		walletInput1, activeInput, passiveInput, walletInput2,
+	} {
+		anchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: op})
+	}
+
+	parcel := &OutboundParcel{
+		AnchorTx: anchorTx,
+		Inputs: []TransferInput{{
+			PrevID: asset.PrevID{OutPoint: activeInput},
+		}},
+		PassiveAssets: []*PassiveAssetReAnchor{{
+			PrevAnchorPoint: passiveInput,
+		}},
+	}
+	require.Equal(
+		t, []wire.OutPoint{walletInput1, walletInput2},
+		anchorWalletInputs(parcel),
+	)
+
+	// Without any wallet inputs, there's nothing to double spend.
+	parcel.AnchorTx = wire.NewMsgTx(2)
+	parcel.AnchorTx.AddTxIn(&wire.TxIn{PreviousOutPoint: activeInput})
+	require.Empty(t, anchorWalletInputs(parcel))
+}
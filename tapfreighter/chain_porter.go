@@ -11,6 +11,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -93,6 +94,13 @@ type ChainPorter struct {
 	// subscriberMtx guards the subscribers map.
 	subscriberMtx sync.Mutex
 
+	// pendingTxs is a map of the transfers that are waiting for their
+	// anchor transaction to confirm, keyed by the anchor transaction hash.
+	pendingTxs map[chainhash.Hash]*pendingTx
+
+	// pendingTxsMtx guards the pendingTxs map.
+	pendingTxsMtx sync.Mutex
+
 	*fn.ContextGuard
 }
 
@@ -106,6 +114,7 @@ func NewChainPorter(cfg *ChainPorterConfig) *ChainPorter {
 		cfg:         cfg,
 		exportReqs:  make(chan Parcel),
 		subscribers: subscribers,
+		pendingTxs:  make(map[chainhash.Hash]*pendingTx),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: tapgarden.DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -259,50 +268,176 @@ func (p *ChainPorter) advanceState(pkg *sendPackage, kit *parcelKit) {
 
 // waitForTransferTxConf waits for the confirmation of the final transaction
 // within the delta. Once confirmed, the parcel will be marked as delivered on
-// chain, with the goroutine cleaning up its state.
+// chain, with the goroutine cleaning up its state. While waiting, the fee of
+// the transfer can be bumped or the transfer can be abandoned.
 func (p *ChainPorter) waitForTransferTxConf(pkg *sendPackage) error {
+	pending := &pendingTx{
+		bumpReqs:    make(chan *feeBumpReq),
+		abandonReqs: make(chan *abandonReq),
+		done:        make(chan struct{}),
+	}
+	p.trackPendingTx(pkg.OutboundPkg.AnchorTx.TxHash(), pending)
+	defer p.untrackPendingTx(pending)
+
+	for {
+		replaced, err := p.waitForAnchorTxConf(pkg, pending)
+		if err != nil || !replaced {
+			return err
+		}
+
+		// The anchor transaction was replaced, so we need to start
+		// over and also wait for the replacement to confirm.
+		p.trackPendingTx(pkg.OutboundPkg.AnchorTx.TxHash(), pending)
+	}
+}
+
+// waitForAnchorTxConf waits for the confirmation of the anchor transaction of
+// the given package or any of the anchor transactions it replaced, while
+// serving fee bump and abandon requests. It returns true if the anchor
+// transaction was replaced, in which case the caller needs to wait again.
+func (p *ChainPorter) waitForAnchorTxConf(pkg *sendPackage,
+	pending *pendingTx) (bool, error) {
+
 	outboundPkg := pkg.OutboundPkg
 
 	txHash := outboundPkg.AnchorTx.TxHash()
 	log.Infof("Waiting for confirmation of transfer_txid=%v", txHash)
 
 	confCtx, confCancel := p.WithCtxQuitNoTimeout()
-	confNtfn, errChan, err := p.cfg.ChainBridge.RegisterConfirmationsNtfn(
-		confCtx, &txHash, outboundPkg.AnchorTx.TxOut[0].PkScript, 1,
-		outboundPkg.AnchorTxHeightHint, true, nil,
-	)
-	if err != nil {
-		return fmt.Errorf("unable to register for package tx conf: %w",
-			err)
+	defer confCancel()
+
+	// Any of the anchor transactions we replaced might still confirm, so
+	// we need to watch all of them.
+	anchorTxns := []*wire.MsgTx{outboundPkg.AnchorTx}
+	for _, replacedTx := range pkg.ReplacedAnchorTxns {
+		anchorTxns = append(anchorTxns, replacedTx.FinalTx)
 	}
 
-	// Launch a goroutine that'll notify us when the transaction confirms.
-	defer confCancel()
+	confChan := make(chan *chainntnfs.TxConfirmation, len(anchorTxns))
+	errChan := make(chan error, len(anchorTxns))
+	for _, anchorTx := range anchorTxns {
+		anchorTxHash := anchorTx.TxHash()
+		confNtfn, ntfnErrChan, err :=
+			p.cfg.ChainBridge.RegisterConfirmationsNtfn(
+				confCtx, &anchorTxHash,
+				anchorTx.TxOut[0].PkScript, 1,
+				outboundPkg.AnchorTxHeightHint, true, nil,
+			)
+		if err != nil {
+			return false, fmt.Errorf("unable to register for "+
+				"package tx conf: %w", err)
+		}
 
-	var confEvent *chainntnfs.TxConfirmation
-	select {
-	case confEvent = <-confNtfn.Confirmed:
-		log.Debugf("Got chain confirmation: %v", confEvent.Tx.TxHash())
-		pkg.TransferTxConfEvent = confEvent
-		pkg.SendState = SendStateStoreProofs
+		go func() {
+			select {
+			case confEvent := <-confNtfn.Confirmed:
+				confChan <- confEvent
 
-	case err := <-errChan:
-		return fmt.Errorf("error whilst waiting for package tx "+
-			"confirmation: %w", err)
+			case err := <-ntfnErrChan:
+				errChan <- err
 
-	case <-confCtx.Done():
-		log.Debugf("Skipping TX confirmation, context done")
+			case <-confCtx.Done():
+			}
+		}()
+	}
 
-	case <-p.Quit:
-		log.Debugf("Skipping TX confirmation, exiting")
+	for {
+		select {
+		case confEvent := <-confChan:
+			log.Debugf("Got chain confirmation: %v",
+				confEvent.Tx.TxHash())
+
+			err := p.maybeRevertAnchorTx(pkg, confEvent.Tx.TxHash())
+			if err != nil {
+				return false, err
+			}
+
+			pkg.TransferTxConfEvent = confEvent
+			pkg.SendState = SendStateStoreProofs
+
+			return false, nil
+
+		case err := <-errChan:
+			return false, fmt.Errorf("error whilst waiting for "+
+				"package tx confirmation: %w", err)
+
+		case req := <-pending.bumpReqs:
+			resp, err := p.bumpTransferFee(pkg, req)
+			if err != nil {
+				req.errChan <- err
+			} else {
+				req.respChan <- resp
+			}
+
+			// Even if broadcasting the replacement failed, it
+			// was logged to disk, so we need to watch it.
+			if outboundPkg.AnchorTx.TxHash() != txHash {
+				return true, nil
+			}
+
+		case req := <-pending.abandonReqs:
+			resp, err := p.abandonTransfer(pkg, req)
+			if err != nil {
+				req.errChan <- err
+				continue
+			}
+
+			// The transfer was removed from disk, so there's
+			// nothing left to wait for.
+			req.respChan <- resp
+			return false, nil
+
+		case <-confCtx.Done():
+			log.Debugf("Skipping TX confirmation, context done")
+
+			return false, fmt.Errorf("got empty package tx " +
+				"confirmation event in batch")
+
+		case <-p.Quit:
+			log.Debugf("Skipping TX confirmation, exiting")
+			return false, nil
+		}
+	}
+}
+
+// maybeRevertAnchorTx makes sure the anchor transaction with the given hash,
+// which just confirmed, is the one the send package and its parcel on disk
+// reference. This might not be the case if a transaction we tried to replace
+// confirmed instead of its replacement.
+func (p *ChainPorter) maybeRevertAnchorTx(pkg *sendPackage,
+	confirmedTxHash chainhash.Hash) error {
+
+	currentTxHash := pkg.OutboundPkg.AnchorTx.TxHash()
+	if confirmedTxHash == currentTxHash {
 		return nil
 	}
 
-	if confEvent == nil {
-		return fmt.Errorf("got empty package tx confirmation event " +
-			"in batch")
+	var confirmedTx *AnchorTransaction
+	for _, replacedTx := range pkg.ReplacedAnchorTxns {
+		if replacedTx.FinalTx.TxHash() == confirmedTxHash {
+			confirmedTx = replacedTx
+		}
+	}
+	if confirmedTx == nil {
+		return fmt.Errorf("unknown anchor transaction %v confirmed",
+			confirmedTxHash)
 	}
 
+	log.Infof("Replaced transfer tx %v confirmed instead of %v",
+		confirmedTxHash, currentTxHash)
+
+	ctx, cancel := p.CtxBlocking()
+	defer cancel()
+
+	err := p.cfg.ExportLog.ReplaceAnchorTx(
+		ctx, currentTxHash, confirmedTx.FinalTx, confirmedTx.ChainFees,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to log confirmed anchor tx: %w", err)
+	}
+
+	pkg.replaceAnchorTx(confirmedTx)
+
 	return nil
 }
 
@@ -321,6 +456,9 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 
 	// Use callback to verify that block header exists on chain.
 	headerVerifier := tapgarden.GenHeaderVerifier(ctx, p.cfg.ChainBridge)
+	medianTimeSource := tapgarden.GenMedianTimeSource(
+		ctx, p.cfg.ChainBridge,
+	)
 
 	// Generate updated passive asset proof files.
 	passiveAssetProofFiles := make(
@@ -349,8 +487,8 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 	log.Infof("Importing %d passive asset proofs into local Proof "+
 		"Archive", len(passiveAssetProofFiles))
 	err := p.cfg.AssetProofs.ImportProofs(
-		ctx, headerVerifier, p.cfg.GroupVerifier, false,
-		passiveAssetProofFiles...,
+		ctx, headerVerifier, medianTimeSource, p.cfg.GroupVerifier,
+		false, passiveAssetProofFiles...,
 	)
 	if err != nil {
 		return fmt.Errorf("error importing passive proof: %w", err)
@@ -385,7 +523,6 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 		map[asset.SerializedKey]*proof.AnnotatedProof,
 		len(parcel.Outputs),
 	)
-	firstInput := parcel.Inputs[0]
 	for idx := range parcel.Outputs {
 		out := parcel.Outputs[idx]
 
@@ -417,6 +554,21 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 				"%d: %w", idx, err)
 		}
 
+		// A transfer can move multiple assets, each in its own
+		// virtual transaction. The output's proof only continues the
+		// proofs of the inputs of the same asset.
+		outputAssetID := proofSuffix.Asset.ID()
+		inputs := fn.Filter(
+			parcel.Inputs, func(in TransferInput) bool {
+				return in.ID == outputAssetID
+			},
+		)
+		if len(inputs) == 0 {
+			return fmt.Errorf("no inputs found for output %d of "+
+				"asset %v", idx, outputAssetID)
+		}
+		firstInput := inputs[0]
+
 		// The suffix is complete, so we need to fetch the input proof
 		// in order to append the suffix to it.
 		inputProofFile, err := p.fetchInputProof(ctx, firstInput)
@@ -426,9 +578,9 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 
 		// Are there more inputs? Then this is a merge, and we need to
 		// add those additional files to the suffix as well.
-		for idx := 1; idx < len(parcel.Inputs); idx++ {
+		for idx := 1; idx < len(inputs); idx++ {
 			additionalInputProofFile, err := p.fetchInputProof(
-				ctx, parcel.Inputs[idx],
+				ctx, inputs[idx],
 			)
 			if err != nil {
 				return fmt.Errorf("error fetching input "+
@@ -469,8 +621,8 @@ func (p *ChainPorter) storeProofs(sendPkg *sendPackage) error {
 		log.Infof("Importing proof for output %d into local Proof "+
 			"Archive", idx)
 		err = p.cfg.AssetProofs.ImportProofs(
-			ctx, headerVerifier, p.cfg.GroupVerifier, false,
-			outputProof,
+			ctx, headerVerifier, medianTimeSource,
+			p.cfg.GroupVerifier, false, outputProof,
 		)
 		if err != nil {
 			return fmt.Errorf("error importing proof: %w", err)
@@ -845,18 +997,31 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 			return nil, fmt.Errorf("unable to cast parcel to " +
 				"address parcel")
 		}
-		fundSendRes, outputIdxToAddr, err :=
-			p.cfg.AssetWallet.FundAddressSend(
-				ctx, addrParcel.destAddrs...,
+
+		// We fund one virtual packet for each asset ID we send, all of
+		// which will be anchored in the same anchor transaction.
+		fundedVPkts, outputIdxToAddrs, err :=
+			p.cfg.AssetWallet.FundBatchSend(
+				ctx, addrParcel.coinSelectStrategy,
+				addrParcel.destAddrs...,
 			)
 		if err != nil {
 			return nil, fmt.Errorf("unable to fund address send: "+
 				"%w", err)
 		}
 
-		currentPkg.VirtualPacket = fundSendRes.VPacket
-		currentPkg.InputCommitments = fundSendRes.InputCommitments
-		currentPkg.OutputIdxToAddr = outputIdxToAddr
+		currentPkg.VirtualPackets = nil
+		currentPkg.InputCommitments = nil
+		for _, fundedVPkt := range fundedVPkts {
+			currentPkg.VirtualPackets = append(
+				currentPkg.VirtualPackets, fundedVPkt.VPacket,
+			)
+			currentPkg.InputCommitments = append(
+				currentPkg.InputCommitments,
+				fundedVPkt.InputCommitments,
+			)
+		}
+		currentPkg.OutputIdxToAddr = outputIdxToAddrs
 
 		currentPkg.SendState = SendStateVirtualSign
 
@@ -865,18 +1030,20 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 	// At this point, we have everything we need to sign our _virtual_
 	// transaction on the Taproot Asset layer.
 	case SendStateVirtualSign:
-		vPacket := currentPkg.VirtualPacket
-		receiverScriptKey := vPacket.Outputs[1].ScriptKey.PubKey
-		log.Infof("Generating Taproot Asset witnesses for send to: %x",
-			receiverScriptKey.SerializeCompressed())
-
-		// Now we'll use the signer to sign all the inputs for the new
-		// Taproot Asset leaves. The witness data for each input will be
-		// assigned for us.
-		_, err := p.cfg.AssetWallet.SignVirtualPacket(vPacket)
-		if err != nil {
-			return nil, fmt.Errorf("unable to sign and commit "+
-				"virtual packet: %w", err)
+		for _, vPacket := range currentPkg.VirtualPackets {
+			receiverScriptKey := vPacket.Outputs[1].ScriptKey.PubKey
+			log.Infof("Generating Taproot Asset witnesses for "+
+				"send to: %x",
+				receiverScriptKey.SerializeCompressed())
+
+			// Now we'll use the signer to sign all the inputs for
+			// the new Taproot Asset leaves. The witness data for
+			// each input will be assigned for us.
+			_, err := p.cfg.AssetWallet.SignVirtualPacket(vPacket)
+			if err != nil {
+				return nil, fmt.Errorf("unable to sign and "+
+					"commit virtual packet: %w", err)
+			}
 		}
 
 		currentPkg.SendState = SendStateAnchorSign
@@ -899,13 +1066,18 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		)
 
 		// First, use a manual fee rate if specified by the parcel.
-		// TODO(jhb): Support PSBT flow / PreSignedParcels
-		addrParcel, ok := currentPkg.Parcel.(*AddressParcel)
+		// TODO(jhb): Support PSBT flow
+		addrParcel, isAddrParcel := currentPkg.Parcel.(*AddressParcel)
+		signedParcel, isSigned := currentPkg.Parcel.(*PreSignedParcel)
 		switch {
-		case ok && addrParcel.transferFeeRate != nil:
+		case isAddrParcel && addrParcel.transferFeeRate != nil:
 			feeRate = *addrParcel.transferFeeRate
 			log.Infof("sending with manual fee rate")
 
+		case isSigned && signedParcel.transferFeeRate != nil:
+			feeRate = *signedParcel.transferFeeRate
+			log.Infof("sending with manual fee rate")
+
 		default:
 			feeRate, err = p.cfg.ChainBridge.EstimateFee(
 				ctx, tapscript.SendConfTarget,
@@ -919,25 +1091,32 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		readableFeeRate := feeRate.FeePerKVByte().String()
 		log.Infof("sending with fee rate: %v", readableFeeRate)
 
-		vPacket := currentPkg.VirtualPacket
-		firstRecipient, err := vPacket.FirstNonSplitRootOutput()
-		if err != nil {
-			return nil, fmt.Errorf("unable to get first "+
-				"interactive output: %w", err)
-		}
-		receiverScriptKey := firstRecipient.ScriptKey.PubKey
-		log.Infof("Constructing new Taproot Asset commitments for "+
-			"send to: %x", receiverScriptKey.SerializeCompressed())
-
 		// Gather passive assets virtual packets and sign them.
 		wallet := p.cfg.AssetWallet
 
-		currentPkg.PassiveAssets, err = wallet.SignPassiveAssets(
-			vPacket, currentPkg.InputCommitments,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to sign passive "+
-				"assets: %w", err)
+		currentPkg.PassiveAssets = nil
+		for idx, vPacket := range currentPkg.VirtualPackets {
+			firstRecipient, err := vPacket.FirstNonSplitRootOutput()
+			if err != nil {
+				return nil, fmt.Errorf("unable to get first "+
+					"interactive output: %w", err)
+			}
+			receiverScriptKey := firstRecipient.ScriptKey.PubKey
+			log.Infof("Constructing new Taproot Asset commitments "+
+				"for send to: %x",
+				receiverScriptKey.SerializeCompressed())
+
+			passiveAssets, err := wallet.SignPassiveAssets(
+				vPacket, currentPkg.InputCommitments[idx],
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to sign "+
+					"passive assets: %w", err)
+			}
+
+			currentPkg.PassiveAssets = append(
+				currentPkg.PassiveAssets, passiveAssets...,
+			)
 		}
 
 		var passiveVPackets []*tappsbt.VPacket
@@ -950,7 +1129,7 @@ func (p *ChainPorter) stateStep(currentPkg sendPackage) (*sendPackage, error) {
 		anchorTx, err := wallet.AnchorVirtualTransactions(
 			ctx, &AnchorVTxnsParams{
 				FeeRate:            feeRate,
-				VPkts:              []*tappsbt.VPacket{vPacket},
+				VPkts:              currentPkg.VirtualPackets,
 				InputCommitments:   currentPkg.InputCommitments,
 				PassiveAssetsVPkts: passiveVPackets,
 			},
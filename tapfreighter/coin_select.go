@@ -0,0 +1,235 @@
+package tapfreighter
+
+import (
+	"sort"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+const (
+	// maxExactMatchTries is the maximum number of branches the exact match
+	// search explores before giving up.
+	maxExactMatchTries = 100_000
+)
+
+// sortByAmount sorts the given commitments by their asset amount, either in
+// descending or ascending order.
+func sortByAmount(commitments []*AnchoredCommitment, descending bool) {
+	sort.SliceStable(commitments, func(i, j int) bool {
+		if descending {
+			return commitments[i].Asset.Amount >
+				commitments[j].Asset.Amount
+		}
+
+		return commitments[i].Asset.Amount < commitments[j].Asset.Amount
+	})
+}
+
+// sortByAnchorHeight sorts the given commitments by the block height their
+// anchor transaction confirmed at, oldest first. Commitments with unconfirmed
+// anchors are sorted last.
+func sortByAnchorHeight(commitments []*AnchoredCommitment) {
+	sort.SliceStable(commitments, func(i, j int) bool {
+		heightI := commitments[i].AnchorBlockHeight
+		heightJ := commitments[j].AnchorBlockHeight
+
+		switch {
+		case heightI == 0:
+			return false
+
+		case heightJ == 0:
+			return true
+
+		default:
+			return heightI < heightJ
+		}
+	})
+}
+
+// selectInOrder selects the first subset of the given commitments, in the
+// order given, which cumulatively sums to at least the minimum required
+// amount.
+func selectInOrder(minTotalAmount uint64,
+	commitments []*AnchoredCommitment) ([]*AnchoredCommitment, error) {
+
+	var (
+		selectedCommitments []*AnchoredCommitment
+		amountSum           uint64
+	)
+	for _, anchoredCommitment := range commitments {
+		selectedCommitments = append(
+			selectedCommitments, anchoredCommitment,
+		)
+
+		// Keep track of the total amount of assets we've seen so far.
+		amountSum += anchoredCommitment.Asset.Amount
+		if amountSum >= minTotalAmount {
+			return selectedCommitments, nil
+		}
+	}
+
+	// Having examined all the eligible commitments, return an error if the
+	// minimal funding amount was not reached.
+	return nil, ErrMatchingAssetsNotFound
+}
+
+// selectExactMatch uses a branch and bound search to find a subset of the
+// given commitments that sums up to exactly the target amount. If no such
+// subset is found within a bounded number of tries, nil is returned.
+func selectExactMatch(target uint64,
+	commitments []*AnchoredCommitment) []*AnchoredCommitment {
+
+	// Exploring the largest amounts first finds a match with fewer inputs
+	// and lets us prune branches early.
+	candidates := make([]*AnchoredCommitment, len(commitments))
+	copy(candidates, commitments)
+	sortByAmount(candidates, true)
+
+	// remaining[i] is the sum of all candidate amounts from index i on,
+	// which allows us to cut branches that can't reach the target anymore.
+	remaining := make([]uint64, len(candidates)+1)
+	for i := len(candidates) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + candidates[i].Asset.Amount
+	}
+
+	var (
+		tries    int
+		selected []*AnchoredCommitment
+	)
+
+	var search func(idx int, sum uint64) bool
+	search = func(idx int, sum uint64) bool {
+		tries++
+
+		switch {
+		case sum == target:
+			return true
+
+		case idx == len(candidates), tries > maxExactMatchTries,
+			sum+remaining[idx] < target:
+
+			return false
+		}
+
+		// Branch one: include the current candidate, unless that
+		// overshoots the target.
+		amount := candidates[idx].Asset.Amount
+		if sum+amount <= target {
+			selected = append(selected, candidates[idx])
+			if search(idx+1, sum+amount) {
+				return true
+			}
+			selected = selected[:len(selected)-1]
+		}
+
+		// Branch two: exclude the current candidate.
+		return search(idx+1, sum)
+	}
+
+	if target == 0 || !search(0, 0) {
+		return nil
+	}
+
+	return selected
+}
+
+// selectSingleAnchor selects commitments that all share the same anchor
+// outpoint, so a transfer never links multiple anchor outputs on-chain. Of all
+// anchor outputs that can fund the target amount on their own, the one that
+// results in the least change is chosen.
+func selectSingleAnchor(minTotalAmount uint64,
+	commitments []*AnchoredCommitment) ([]*AnchoredCommitment, error) {
+
+	var (
+		anchorOrder []wire.OutPoint
+		byAnchor    = make(map[wire.OutPoint][]*AnchoredCommitment)
+		anchorSums  = make(map[wire.OutPoint]uint64)
+	)
+	for _, c := range commitments {
+		if _, ok := byAnchor[c.AnchorPoint]; !ok {
+			anchorOrder = append(anchorOrder, c.AnchorPoint)
+		}
+
+		byAnchor[c.AnchorPoint] = append(byAnchor[c.AnchorPoint], c)
+		anchorSums[c.AnchorPoint] += c.Asset.Amount
+	}
+
+	var (
+		bestAnchor *wire.OutPoint
+		bestSum    uint64
+	)
+	for idx := range anchorOrder {
+		anchor := anchorOrder[idx]
+		sum := anchorSums[anchor]
+		if sum < minTotalAmount {
+			continue
+		}
+
+		if bestAnchor == nil || sum < bestSum {
+			bestAnchor = &anchor
+			bestSum = sum
+		}
+	}
+
+	if bestAnchor == nil {
+		return nil, ErrMatchingAssetsNotFound
+	}
+
+	anchorCommitments := byAnchor[*bestAnchor]
+	sortByAmount(anchorCommitments, true)
+
+	return selectInOrder(minTotalAmount, anchorCommitments)
+}
+
+// selectForConsolidation selects up to the given maximum number of the given
+// commitments, smallest amounts first. Only commitments with the same asset ID
+// can be merged, so the selected commitments are returned in one set per asset
+// ID, each with at least two commitments. If the given commitments have
+// different asset IDs, for example the tranches of an asset group, the asset
+// IDs with the most commitments are selected first.
+func selectForConsolidation(commitments []*AnchoredCommitment,
+	maxInputs int) ([][]*AnchoredCommitment, error) {
+
+	var (
+		idOrder []asset.ID
+		byID    = make(map[asset.ID][]*AnchoredCommitment)
+	)
+	for _, c := range commitments {
+		id := c.Asset.ID()
+		if _, ok := byID[id]; !ok {
+			idOrder = append(idOrder, id)
+		}
+
+		byID[id] = append(byID[id], c)
+	}
+
+	sort.SliceStable(idOrder, func(i, j int) bool {
+		return len(byID[idOrder[i]]) > len(byID[idOrder[j]])
+	})
+
+	var (
+		selected  [][]*AnchoredCommitment
+		remaining = maxInputs
+	)
+	for _, id := range idOrder {
+		candidates := byID[id]
+		if remaining < 2 || len(candidates) < 2 {
+			break
+		}
+
+		sortByAmount(candidates, false)
+		if len(candidates) > remaining {
+			candidates = candidates[:remaining]
+		}
+
+		selected = append(selected, candidates)
+		remaining -= len(candidates)
+	}
+
+	if len(selected) == 0 {
+		return nil, ErrNothingToConsolidate
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,206 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrWatchOnly is returned if a signature is requested from a daemon
+	// that runs in watch-only mode. The virtual and anchor transactions
+	// need to be signed by an external signer instead.
+	ErrWatchOnly = errors.New("daemon is running in watch-only mode, " +
+		"transactions must be signed externally")
+
+	// ErrInvalidChangeIndex is returned if the change output index of an
+	// externally signed anchor transaction doesn't point to an output
+	// that can be the change output of the wallet.
+	ErrInvalidChangeIndex = errors.New("invalid change output index")
+)
+
+// CommitVirtualTransactions creates and funds the BTC level anchor transaction
+// that anchors all the given signed virtual transactions (for both sending and
+// passive asset re-anchoring). The returned PSBT isn't signed, so it can be
+// signed by an external signer and then be finalized with
+// FinalizeAnchorTransaction.
+func (f *AssetWallet) CommitVirtualTransactions(ctx context.Context,
+	params *AnchorVTxnsParams) (*tapgarden.FundedPsbt, error) {
+
+	anchorPkt, _, err := f.commitVirtualTransactions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return anchorPkt, nil
+}
+
+// FinalizeAnchorTransaction verifies that the given externally signed BTC
+// level anchor transaction commits to all the given virtual transactions and
+// spends all their anchor inputs, finalizes it and returns the anchor
+// transaction information required to log and broadcast the transfer. The
+// change index is the index of the wallet's change output as returned by
+// CommitVirtualTransactions, or -1 if the transaction has no change output.
+func (f *AssetWallet) FinalizeAnchorTransaction(ctx context.Context,
+	anchorPkt *psbt.Packet, changeIndex int32,
+	params *AnchorVTxnsParams) (*AnchorTransaction, error) {
+
+	outputCommitments, _, err := createOutputCommitments(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// We re-create the anchor output keys on a copy of the PSBT, which
+	// then also holds all the output information required for the
+	// exclusion proofs, no matter what the external signer kept.
+	fundedPkt, err := copyPsbt(anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+	mergedCommitments, err := updateAnchorOutputKeys(
+		fundedPkt, params.VPkts, outputCommitments,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We can't tell the wallet's change output apart from any other
+	// non-anchor output the external signer might have added, so we rely
+	// on the index returned by CommitVirtualTransactions. It must at
+	// least point to an output that isn't an anchor output.
+	txOuts := anchorPkt.UnsignedTx.TxOut
+	switch {
+	case changeIndex < -1 || changeIndex >= int32(len(txOuts)):
+		return nil, fmt.Errorf("%w: %d", ErrInvalidChangeIndex,
+			changeIndex)
+
+	case changeIndex >= 0:
+		_, isAnchor := mergedCommitments[uint32(changeIndex)]
+		if isAnchor {
+			return nil, fmt.Errorf("%w: output %d is an anchor "+
+				"output", ErrInvalidChangeIndex, changeIndex)
+		}
+	}
+
+	for anchorIdx := range mergedCommitments {
+		expectedScript := fundedPkt.UnsignedTx.TxOut[anchorIdx].PkScript
+		if !bytes.Equal(txOuts[anchorIdx].PkScript, expectedScript) {
+			return nil, fmt.Errorf("anchor output %d doesn't "+
+				"commit to the virtual transactions",
+				anchorIdx)
+		}
+	}
+
+	txInputs := fn.NewSet(fn.Map(
+		anchorPkt.UnsignedTx.TxIn, func(txIn *wire.TxIn) wire.OutPoint {
+			return txIn.PreviousOutPoint
+		},
+	)...)
+	for _, vPkt := range params.VPkts {
+		for _, vIn := range vPkt.Inputs {
+			if !txInputs.Contains(vIn.PrevID.OutPoint) {
+				return nil, fmt.Errorf("anchor TX doesn't "+
+					"spend anchor input %v",
+					vIn.PrevID.OutPoint)
+			}
+		}
+	}
+
+	chainFees, err := tapgarden.GetTxFee(anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for psbt: "+
+			"%w", err)
+	}
+
+	signedPkt, err := copyPsbt(anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+
+	err = psbt.MaybeFinalizeAll(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to finalize psbt, not all "+
+			"inputs signed: %w", err)
+	}
+
+	finalTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(finalTx))
+	if err != nil {
+		return nil, fmt.Errorf("anchor TX failed final checks: %w", err)
+	}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(finalTx))
+	feeRate := chainfee.SatPerKWeight(chainFees * 1000 / weight)
+
+	return &AnchorTransaction{
+		FundedPsbt: &tapgarden.FundedPsbt{
+			Pkt:               fundedPkt,
+			ChangeOutputIndex: changeIndex,
+			ChainFees:         chainFees,
+		},
+		FinalTx:           finalTx,
+		TargetFeeRate:     feeRate,
+		ChainFees:         chainFees,
+		OutputCommitments: mergedCommitments,
+	}, nil
+}
+
+// NewPassiveAssetReAnchor creates the re-anchor information of a passive asset
+// from the given virtual transaction that re-anchors it.
+func NewPassiveAssetReAnchor(vPkt *tappsbt.VPacket) (*PassiveAssetReAnchor,
+	error) {
+
+	if len(vPkt.Inputs) != 1 || len(vPkt.Outputs) != 1 {
+		return nil, fmt.Errorf("passive asset virtual transaction " +
+			"must have exactly one input and one output")
+	}
+
+	vIn := vPkt.Inputs[0]
+	passiveAsset := vIn.Asset()
+	if passiveAsset == nil {
+		return nil, fmt.Errorf("passive asset virtual transaction " +
+			"has no input asset")
+	}
+
+	return &PassiveAssetReAnchor{
+		VPacket:         vPkt,
+		GenesisID:       passiveAsset.ID(),
+		PrevAnchorPoint: vIn.PrevID.OutPoint,
+		ScriptKey:       passiveAsset.ScriptKey,
+		AssetVersion:    passiveAsset.Version,
+	}, nil
+}
+
+// PruneInputCommitments removes all tombstones and burns from the given input
+// commitments, as those are never re-anchored.
+func PruneInputCommitments(
+	inputCommitments tappsbt.InputCommitments) (tappsbt.InputCommitments,
+	error) {
+
+	pruned := make(tappsbt.InputCommitments, len(inputCommitments))
+	for idx := range inputCommitments {
+		var err error
+		pruned[idx], err = pruneTombstonesAndBurns(
+			inputCommitments[idx],
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pruned, nil
+}
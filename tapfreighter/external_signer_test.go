@@ -0,0 +1,54 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewPassiveAssetReAnchor tests that the re-anchor information of a
+// passive asset is derived correctly from its virtual packet.
+func TestNewPassiveAssetReAnchor(t *testing.T) {
+	t.Parallel()
+
+	passiveAsset := asset.RandAsset(t, asset.Normal)
+	anchorPoint := test.RandOp(t)
+
+	vPkt := &tappsbt.VPacket{
+		ChainParams: &address.RegressionNetTap,
+		Inputs: []*tappsbt.VInput{{
+			PrevID: asset.PrevID{
+				OutPoint: anchorPoint,
+				ID:       passiveAsset.ID(),
+			},
+		}},
+		Outputs: []*tappsbt.VOutput{{
+			Amount: passiveAsset.Amount,
+		}},
+	}
+	vPkt.SetInputAsset(0, passiveAsset, nil)
+
+	reAnchor, err := NewPassiveAssetReAnchor(vPkt)
+	require.NoError(t, err)
+	require.Equal(t, vPkt, reAnchor.VPacket)
+	require.Equal(t, passiveAsset.ID(), reAnchor.GenesisID)
+	require.Equal(t, anchorPoint, reAnchor.PrevAnchorPoint)
+	require.Equal(t, passiveAsset.ScriptKey, reAnchor.ScriptKey)
+	require.Equal(t, passiveAsset.Version, reAnchor.AssetVersion)
+
+	// A passive asset is always re-anchored in a single output.
+	vPkt.Outputs = append(vPkt.Outputs, &tappsbt.VOutput{})
+	_, err = NewPassiveAssetReAnchor(vPkt)
+	require.ErrorContains(t, err, "exactly one input and one output")
+
+	// The input asset is required to identify the passive asset.
+	_, err = NewPassiveAssetReAnchor(&tappsbt.VPacket{
+		Inputs:  []*tappsbt.VInput{{}},
+		Outputs: []*tappsbt.VOutput{{}},
+	})
+	require.ErrorContains(t, err, "has no input asset")
+}
@@ -0,0 +1,443 @@
+package tapfreighter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// FeeBumpMethod is an enum that describes how the fee of a pending transfer's
+// anchor transaction should be bumped.
+type FeeBumpMethod uint8
+
+const (
+	// FeeBumpRBF replaces the anchor transaction with a transaction that
+	// spends the same inputs and creates the same anchor outputs but pays a
+	// higher fee, which is deducted from the BTC change output.
+	FeeBumpRBF FeeBumpMethod = iota
+
+	// FeeBumpCPFP creates a child transaction that spends the BTC change
+	// output of the anchor transaction and pays enough fees for the
+	// package of both transactions to reach the target fee rate.
+	FeeBumpCPFP
+)
+
+// String returns a human-readable version of FeeBumpMethod.
+func (m FeeBumpMethod) String() string {
+	switch m {
+	case FeeBumpRBF:
+		return "FeeBumpRBF"
+
+	case FeeBumpCPFP:
+		return "FeeBumpCPFP"
+
+	default:
+		return fmt.Sprintf("<unknown_fee_bump_method(%d)>", m)
+	}
+}
+
+var (
+	// ErrNoPendingTransfer is returned when a fee bump or abandonment is
+	// requested for an anchor transaction that doesn't belong to a pending
+	// transfer.
+	ErrNoPendingTransfer = errors.New("no pending transfer for anchor " +
+		"transaction")
+
+	// ErrFeeRateTooLow is returned when the requested fee rate wouldn't
+	// increase the fee paid for the anchor transaction.
+	ErrFeeRateTooLow = errors.New("fee rate too low to bump fee")
+
+	// ErrNoFundedAnchorPsbt is returned when the anchor transaction of a
+	// transfer should be replaced, but the funded anchor PSBT it was
+	// signed from isn't available anymore. That's the case for transfers
+	// that were resumed after a restart or that were anchored externally.
+	ErrNoFundedAnchorPsbt = errors.New("anchor transaction can't be " +
+		"replaced, no funded anchor PSBT available")
+)
+
+// minRelayFeeIncrement is the minimum fee rate by which a replacement
+// transaction must increase the fee of the transaction it replaces, as
+// required by BIP-0125 (rule 4) with the default incremental relay fee of
+// 1 sat/vByte.
+var minRelayFeeIncrement = chainfee.SatPerKVByte(1000).FeePerKWeight()
+
+// FeeBumpResult is the result of a successful fee bump of a pending transfer.
+type FeeBumpResult struct {
+	// Parcel is the updated pending transfer. If the anchor transaction
+	// was replaced, this references the replacement transaction.
+	Parcel *OutboundParcel
+
+	// ChildTx is the transaction spending the BTC change output of the
+	// anchor transaction if the fee was bumped with CPFP.
+	ChildTx *wire.MsgTx
+}
+
+// feeBumpReq is a request to bump the fee of a pending transfer that is sent
+// to the goroutine waiting for the transfer's anchor transaction to confirm.
+type feeBumpReq struct {
+	// method is the way the fee should be bumped.
+	method FeeBumpMethod
+
+	// feeRate is the target fee rate of the anchor transaction (RBF) or
+	// the package of the anchor and child transaction (CPFP).
+	feeRate chainfee.SatPerKWeight
+
+	// respChan is the channel the result will be sent over.
+	respChan chan *FeeBumpResult
+
+	// errChan is the channel the error will be sent over.
+	errChan chan error
+}
+
+// pendingTx houses the channels used to communicate with the goroutine that
+// waits for the anchor transaction of a pending transfer to confirm.
+type pendingTx struct {
+	// bumpReqs is the channel fee bump requests are sent over.
+	bumpReqs chan *feeBumpReq
+
+	// abandonReqs is the channel requests to abandon the transfer are
+	// sent over.
+	abandonReqs chan *abandonReq
+
+	// done is closed once the goroutine stopped waiting for the
+	// confirmation.
+	done chan struct{}
+}
+
+// BumpTransferFee bumps the fee of the pending transfer with the given anchor
+// transaction hash. The transfer must currently be waiting for its anchor
+// transaction to confirm.
+func (p *ChainPorter) BumpTransferFee(anchorTxHash chainhash.Hash,
+	method FeeBumpMethod,
+	feeRate chainfee.SatPerKWeight) (*FeeBumpResult, error) {
+
+	p.pendingTxsMtx.Lock()
+	pending, ok := p.pendingTxs[anchorTxHash]
+	p.pendingTxsMtx.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrNoPendingTransfer,
+			anchorTxHash)
+	}
+
+	req := &feeBumpReq{
+		method:   method,
+		feeRate:  feeRate,
+		respChan: make(chan *FeeBumpResult, 1),
+		errChan:  make(chan error, 1),
+	}
+
+	select {
+	case pending.bumpReqs <- req:
+
+	case <-pending.done:
+		return nil, fmt.Errorf("%w: %v", ErrNoPendingTransfer,
+			anchorTxHash)
+
+	case <-p.Quit:
+		return nil, fmt.Errorf("ChainPorter shutting down")
+	}
+
+	select {
+	case err := <-req.errChan:
+		return nil, err
+
+	case resp := <-req.respChan:
+		return resp, nil
+
+	case <-p.Quit:
+		return nil, fmt.Errorf("ChainPorter shutting down")
+	}
+}
+
+// trackPendingTx registers the channels used to bump the fee of or abandon the
+// pending transfer with the given anchor transaction hash.
+func (p *ChainPorter) trackPendingTx(anchorTxHash chainhash.Hash,
+	pending *pendingTx) {
+
+	p.pendingTxsMtx.Lock()
+	defer p.pendingTxsMtx.Unlock()
+
+	p.pendingTxs[anchorTxHash] = pending
+}
+
+// untrackPendingTx removes the given pending transfer from the set of tracked
+// transfers and signals that it no longer accepts requests.
+func (p *ChainPorter) untrackPendingTx(pending *pendingTx) {
+	p.pendingTxsMtx.Lock()
+	defer p.pendingTxsMtx.Unlock()
+
+	for txHash, candidate := range p.pendingTxs {
+		if candidate == pending {
+			delete(p.pendingTxs, txHash)
+		}
+	}
+
+	close(pending.done)
+}
+
+// bumpTransferFee executes the given fee bump request for the given send
+// package.
+func (p *ChainPorter) bumpTransferFee(pkg *sendPackage,
+	req *feeBumpReq) (*FeeBumpResult, error) {
+
+	ctx, cancel := p.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	log.Infof("Bumping fee of transfer_txid=%v with %v to fee rate %v",
+		pkg.OutboundPkg.AnchorTx.TxHash(), req.method,
+		req.feeRate.FeePerKVByte())
+
+	switch req.method {
+	case FeeBumpRBF:
+		return p.replaceAnchorTx(ctx, pkg, req.feeRate)
+
+	case FeeBumpCPFP:
+		return p.createChildTx(ctx, pkg, req.feeRate)
+
+	default:
+		return nil, fmt.Errorf("unknown fee bump method: %v",
+			req.method)
+	}
+}
+
+// replaceAnchorTx re-signs the anchor PSBT of the given send package with the
+// additional fee deducted from the BTC change output, logs the replacement to
+// disk and broadcasts it. The virtual transactions and passive assets stay
+// the same, as the anchor outputs don't change.
+func (p *ChainPorter) replaceAnchorTx(ctx context.Context, pkg *sendPackage,
+	feeRate chainfee.SatPerKWeight) (*FeeBumpResult, error) {
+
+	// We can only re-sign the anchor transaction if we still have the
+	// unsigned PSBT with all the derivation information that we funded
+	// ourselves. That isn't the case for transfers that were resumed
+	// after a restart or that were anchored externally. The wallet inputs
+	// of the anchor transaction are already spent in the mempool, so the
+	// wallet can't provide their UTXO and derivation information to
+	// rebuild the PSBT either.
+	anchorTx := pkg.AnchorTx
+	if anchorTx == nil || anchorTx.FundedPsbt == nil ||
+		anchorTx.FundedPsbt.ChangeOutputIndex < 0 {
+
+		return nil, fmt.Errorf("%w for anchor tx %v, use CPFP instead",
+			ErrNoFundedAnchorPsbt,
+			pkg.OutboundPkg.AnchorTx.TxHash())
+	}
+
+	replacementPkt, err := rbfAnchorPsbt(anchorTx, feeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	signedPkt, err := p.cfg.Wallet.SignPsbt(ctx, replacementPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign replacement psbt: %w",
+			err)
+	}
+
+	chainFees, err := tapgarden.GetTxFee(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for psbt: "+
+			"%w", err)
+	}
+
+	err = psbt.MaybeFinalizeAll(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to finalize psbt: %w", err)
+	}
+
+	finalTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(finalTx))
+	if err != nil {
+		return nil, fmt.Errorf("replacement anchor TX failed final "+
+			"checks: %w", err)
+	}
+
+	// The replacement is logged to disk before we broadcast it, so we'll
+	// keep re-broadcasting it after a restart.
+	oldTxHash := pkg.OutboundPkg.AnchorTx.TxHash()
+	err = p.cfg.ExportLog.ReplaceAnchorTx(
+		ctx, oldTxHash, finalTx, chainFees,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to log replacement anchor tx: "+
+			"%w", err)
+	}
+
+	fundedPsbt := anchorTx.FundedPsbt
+	pkg.replaceAnchorTx(&AnchorTransaction{
+		FundedPsbt: &tapgarden.FundedPsbt{
+			Pkt:               replacementPkt,
+			ChangeOutputIndex: fundedPsbt.ChangeOutputIndex,
+			LockedUTXOs:       fundedPsbt.LockedUTXOs,
+		},
+		FinalTx:           finalTx,
+		TargetFeeRate:     feeRate,
+		ChainFees:         chainFees,
+		OutputCommitments: anchorTx.OutputCommitments,
+	})
+
+	log.Infof("Broadcasting replacement transfer tx, txid=%v, "+
+		"replaces=%v", finalTx.TxHash(), oldTxHash)
+
+	err = p.cfg.ChainBridge.PublishTransaction(ctx, finalTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish replacement anchor "+
+			"tx: %w", err)
+	}
+
+	return &FeeBumpResult{
+		Parcel: pkg.OutboundPkg,
+	}, nil
+}
+
+// createChildTx creates, signs and broadcasts a transaction that spends the
+// BTC change output of the anchor transaction of the given send package,
+// paying enough fees for the package to reach the given fee rate.
+func (p *ChainPorter) createChildTx(ctx context.Context, pkg *sendPackage,
+	feeRate chainfee.SatPerKWeight) (*FeeBumpResult, error) {
+
+	parcel := pkg.OutboundPkg
+	changeIndex, err := pkg.changeOutputIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	childFeeRate, err := cpfpChildFeeRate(
+		parcel.AnchorTx, parcel.ChainFees, feeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We only specify the input and let the wallet sweep it to a new
+	// change output, using the fee rate required for the child.
+	templatePkt, err := psbt.New(
+		[]*wire.OutPoint{{
+			Hash:  parcel.AnchorTx.TxHash(),
+			Index: changeIndex,
+		}}, nil, 2, 0, []uint32{wire.MaxTxInSequenceNum},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create child psbt: %w", err)
+	}
+
+	fundedPkt, err := p.cfg.Wallet.FundPsbt(
+		ctx, templatePkt, 0, childFeeRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund child psbt: %w", err)
+	}
+
+	signedPkt, err := p.cfg.Wallet.SignAndFinalizePsbt(ctx, fundedPkt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign child psbt: %w", err)
+	}
+
+	childTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract child psbt: %w", err)
+	}
+
+	log.Infof("Broadcasting CPFP child tx, txid=%v, parent=%v",
+		childTx.TxHash(), parcel.AnchorTx.TxHash())
+
+	err = p.cfg.ChainBridge.PublishTransaction(ctx, childTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish child tx: %w", err)
+	}
+
+	return &FeeBumpResult{
+		Parcel:  parcel,
+		ChildTx: childTx,
+	}, nil
+}
+
+// rbfAnchorPsbt returns a copy of the unsigned anchor PSBT of the given anchor
+// transaction that pays the given fee rate. The additional fee is deducted
+// from the BTC change output.
+func rbfAnchorPsbt(anchorTx *AnchorTransaction,
+	feeRate chainfee.SatPerKWeight) (*psbt.Packet, error) {
+
+	// The replacement has the same inputs and outputs, so it has the same
+	// weight as the final transaction it replaces.
+	weight := blockchain.GetTransactionWeight(
+		btcutil.NewTx(anchorTx.FinalTx),
+	)
+	newFee := int64(feeRate.FeeForWeight(weight))
+	minFee := anchorTx.ChainFees +
+		int64(minRelayFeeIncrement.FeeForWeight(weight))
+	if newFee < minFee {
+		return nil, fmt.Errorf("%w: replacement must pay at least %d "+
+			"sats but only pays %d sats", ErrFeeRateTooLow, minFee,
+			newFee)
+	}
+
+	pkt, err := copyPsbt(anchorTx.FundedPsbt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+
+	changeIndex := anchorTx.FundedPsbt.ChangeOutputIndex
+	if int(changeIndex) >= len(pkt.UnsignedTx.TxOut) {
+		return nil, fmt.Errorf("invalid change output index %d",
+			changeIndex)
+	}
+
+	changeOut := pkt.UnsignedTx.TxOut[changeIndex]
+	newValue := changeOut.Value - (newFee - anchorTx.ChainFees)
+	// The dust limit of lnd panics for unknown script types, so we use
+	// the generic one of the mempool policy instead.
+	dustLimit := mempool.GetDustThreshold(changeOut)
+	if newValue < dustLimit {
+		return nil, fmt.Errorf("change output of %d sats too small "+
+			"to pay additional fee of %d sats", changeOut.Value,
+			newFee-anchorTx.ChainFees)
+	}
+	changeOut.Value = newValue
+
+	return pkt, nil
+}
+
+// cpfpChildFeeRate returns the fee rate a child transaction spending a single
+// P2TR output of the given parent transaction needs to pay for the package of
+// both transactions to reach the given fee rate.
+func cpfpChildFeeRate(parentTx *wire.MsgTx, parentFee int64,
+	feeRate chainfee.SatPerKWeight) (chainfee.SatPerKWeight, error) {
+
+	var estimator input.TxWeightEstimator
+	estimator.AddTaprootKeySpendInput(txscript.SigHashDefault)
+	estimator.AddP2TROutput()
+	childWeight := int64(estimator.Weight())
+
+	parentWeight := blockchain.GetTransactionWeight(btcutil.NewTx(parentTx))
+	packageFee := int64(feeRate.FeeForWeight(parentWeight + childWeight))
+	childFee := packageFee - parentFee
+	if childFee <= 0 {
+		return 0, fmt.Errorf("%w: anchor transaction already pays "+
+			"the target fee rate", ErrFeeRateTooLow)
+	}
+
+	// The wallet funds transactions with a fee rate in sat/vByte, so we
+	// round up to make sure the package reaches the target fee rate.
+	childVSize := (childWeight + blockchain.WitnessScaleFactor - 1) /
+		blockchain.WitnessScaleFactor
+	satPerVByte := (childFee + childVSize - 1) / childVSize
+
+	return chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight(), nil
+}
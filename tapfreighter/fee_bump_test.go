@@ -0,0 +1,262 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// randAnchorTx creates a signed anchor transaction with a single key spend
+// input, an anchor output and a change output, together with the funded PSBT
+// it was created from.
+func randAnchorTx(t *testing.T, changeValue int64,
+	feeRate chainfee.SatPerKWeight) *AnchorTransaction {
+
+	p2trScript := func() []byte {
+		key := test.RandPubKey(t)
+		pkScript, err := tapscript.PayToTaprootScript(
+			txscript.ComputeTaprootKeyNoScript(key),
+		)
+		require.NoError(t, err)
+
+		return pkScript
+	}
+
+	unsignedTx := wire.NewMsgTx(2)
+	unsignedTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  test.RandHash(),
+			Index: 1,
+		},
+	})
+	unsignedTx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: p2trScript()})
+	unsignedTx.AddTxOut(&wire.TxOut{
+		Value:    changeValue,
+		PkScript: p2trScript(),
+	})
+
+	pkt, err := psbt.NewFromUnsignedTx(unsignedTx.Copy())
+	require.NoError(t, err)
+
+	finalTx := unsignedTx.Copy()
+	finalTx.TxIn[0].Witness = wire.TxWitness{make([]byte, 64)}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(finalTx))
+	return &AnchorTransaction{
+		FundedPsbt: &tapgarden.FundedPsbt{
+			Pkt:               pkt,
+			ChangeOutputIndex: 1,
+		},
+		FinalTx:   finalTx,
+		ChainFees: int64(feeRate.FeeForWeight(weight)),
+	}
+}
+
+// TestRbfAnchorPsbt tests that the replacement of an anchor transaction only
+// deducts the additional fee from the change output and enforces the minimum
+// relay fee increment.
+func TestRbfAnchorPsbt(t *testing.T) {
+	t.Parallel()
+
+	const oldFeeRate = chainfee.SatPerKWeight(253)
+	anchorTx := randAnchorTx(t, 100_000, oldFeeRate)
+	weight := blockchain.GetTransactionWeight(
+		btcutil.NewTx(anchorTx.FinalTx),
+	)
+
+	// A replacement at the same fee rate isn't relayed.
+	_, err := rbfAnchorPsbt(anchorTx, oldFeeRate)
+	require.ErrorIs(t, err, ErrFeeRateTooLow)
+
+	// Neither is one that doesn't pay for its own relay bandwidth.
+	_, err = rbfAnchorPsbt(anchorTx, oldFeeRate+minRelayFeeIncrement/2)
+	require.ErrorIs(t, err, ErrFeeRateTooLow)
+
+	// A sufficiently higher fee rate only changes the change output.
+	const newFeeRate = chainfee.SatPerKWeight(2500)
+	pkt, err := rbfAnchorPsbt(anchorTx, newFeeRate)
+	require.NoError(t, err)
+
+	oldTx := anchorTx.FundedPsbt.Pkt.UnsignedTx
+	newTx := pkt.UnsignedTx
+	require.Len(t, newTx.TxIn, 1)
+	require.Equal(
+		t, oldTx.TxIn[0].PreviousOutPoint,
+		newTx.TxIn[0].PreviousOutPoint,
+	)
+	require.Equal(t, oldTx.TxOut[0], newTx.TxOut[0])
+
+	newFee := int64(newFeeRate.FeeForWeight(weight))
+	require.Equal(
+		t, oldTx.TxOut[1].Value-(newFee-anchorTx.ChainFees),
+		newTx.TxOut[1].Value,
+	)
+
+	// The original packet must not be modified.
+	require.EqualValues(t, 100_000, oldTx.TxOut[1].Value)
+
+	// If the change output can't pay for the additional fee, we can't
+	// replace the transaction.
+	anchorTx = randAnchorTx(t, 1_000, oldFeeRate)
+	_, err = rbfAnchorPsbt(anchorTx, newFeeRate)
+	require.ErrorContains(t, err, "too small")
+
+	// The same goes for a change output with a non-standard script, for
+	// which we still need to be able to determine the dust limit.
+	changeOut := anchorTx.FundedPsbt.Pkt.UnsignedTx.TxOut[1]
+	changeOut.PkScript = test.RandBytes(40)
+	_, err = rbfAnchorPsbt(anchorTx, newFeeRate)
+	require.ErrorContains(t, err, "too small")
+}
+
+// TestReplaceAnchorTxAfterRestart tests that the anchor transaction of a
+// transfer that was resumed after a restart can't be replaced, as the funded
+// anchor PSBT isn't available anymore.
+func TestReplaceAnchorTxAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	anchorTx := randAnchorTx(t, 100_000, 253)
+	parcel := &OutboundParcel{
+		AnchorTx:  anchorTx.FinalTx,
+		ChainFees: anchorTx.ChainFees,
+	}
+
+	// On restart, the pending parcel is resumed from what's stored on
+	// disk, which doesn't include the funded anchor PSBT.
+	pkg := NewPendingParcel(parcel).pkg()
+	porter := &ChainPorter{}
+	_, err := porter.replaceAnchorTx(context.Background(), pkg, 2500)
+	require.ErrorIs(t, err, ErrNoFundedAnchorPsbt)
+	require.ErrorContains(t, err, anchorTx.FinalTx.TxHash().String())
+}
+
+// TestCpfpChildFeeRate tests that the child fee rate makes the package of the
+// anchor and the child transaction reach the target fee rate.
+func TestCpfpChildFeeRate(t *testing.T) {
+	t.Parallel()
+
+	const oldFeeRate = chainfee.SatPerKWeight(253)
+	anchorTx := randAnchorTx(t, 100_000, oldFeeRate)
+	parentWeight := blockchain.GetTransactionWeight(
+		btcutil.NewTx(anchorTx.FinalTx),
+	)
+
+	// The parent alone already pays enough fees for the whole package to
+	// reach the target fee rate.
+	_, err := cpfpChildFeeRate(
+		anchorTx.FinalTx, anchorTx.ChainFees, oldFeeRate/4,
+	)
+	require.ErrorIs(t, err, ErrFeeRateTooLow)
+
+	const targetFeeRate = chainfee.SatPerKWeight(5000)
+	childFeeRate, err := cpfpChildFeeRate(
+		anchorTx.FinalTx, anchorTx.ChainFees, targetFeeRate,
+	)
+	require.NoError(t, err)
+
+	// The child pays more than the target, since it also pays for the
+	// parent. Its rate is always a whole number of sat/vByte.
+	require.Greater(t, childFeeRate, targetFeeRate)
+	require.Zero(t, childFeeRate.FeePerKVByte()%1000)
+
+	// Spending the change output with a single key spend input into a
+	// single P2TR output at the child fee rate makes the package reach the
+	// target fee rate.
+	childTx := wire.NewMsgTx(2)
+	childTx.AddTxIn(&wire.TxIn{
+		Witness: wire.TxWitness{make([]byte, 64)},
+	})
+	childTx.AddTxOut(&wire.TxOut{PkScript: make([]byte, 34)})
+	childWeight := blockchain.GetTransactionWeight(btcutil.NewTx(childTx))
+
+	childFee := childFeeRate.FeeForWeight(childWeight)
+	packageFee := anchorTx.ChainFees + int64(childFee)
+	require.GreaterOrEqual(
+		t, packageFee, int64(targetFeeRate.FeeForWeight(
+			parentWeight+childWeight,
+		)),
+	)
+}
+
+// TestSendPackageReplaceAnchorTx tests that replacing the anchor transaction
+// of a send package updates the parcel and keeps track of the replaced
+// transactions.
+func TestSendPackageReplaceAnchorTx(t *testing.T) {
+	t.Parallel()
+
+	firstTx := randAnchorTx(t, 100_000, 253)
+	firstHash := firstTx.FinalTx.TxHash()
+
+	pkg := &sendPackage{
+		AnchorTx: firstTx,
+		OutboundPkg: &OutboundParcel{
+			AnchorTx:  firstTx.FinalTx,
+			ChainFees: firstTx.ChainFees,
+			Outputs: []TransferOutput{{
+				Anchor: Anchor{
+					OutPoint: wire.OutPoint{
+						Hash:  firstHash,
+						Index: 0,
+					},
+				},
+			}, {
+				Anchor: Anchor{
+					OutPoint: wire.OutPoint{
+						Hash:  firstHash,
+						Index: 1,
+					},
+				},
+			}},
+		},
+	}
+
+	assertAnchor := func(anchorTx *AnchorTransaction,
+		replaced ...chainhash.Hash) {
+
+		txHash := anchorTx.FinalTx.TxHash()
+		parcel := pkg.OutboundPkg
+
+		require.Equal(t, anchorTx, pkg.AnchorTx)
+		require.Equal(t, anchorTx.FinalTx, parcel.AnchorTx)
+		require.Equal(t, anchorTx.ChainFees, parcel.ChainFees)
+		require.Equal(t, replaced, parcel.ReplacedAnchorTxHashes)
+		require.Len(t, pkg.ReplacedAnchorTxns, len(replaced))
+		for idx := range replaced {
+			require.Equal(
+				t, replaced[idx],
+				pkg.ReplacedAnchorTxns[idx].FinalTx.TxHash(),
+			)
+		}
+
+		for idx, out := range parcel.Outputs {
+			require.Equal(t, txHash, out.Anchor.OutPoint.Hash)
+			require.EqualValues(t, idx, out.Anchor.OutPoint.Index)
+		}
+	}
+
+	secondTx := randAnchorTx(t, 90_000, 2500)
+	pkg.replaceAnchorTx(secondTx)
+	assertAnchor(secondTx, firstHash)
+
+	thirdTx := randAnchorTx(t, 80_000, 5000)
+	pkg.replaceAnchorTx(thirdTx)
+	assertAnchor(thirdTx, firstHash, secondTx.FinalTx.TxHash())
+
+	// If the first transaction confirms instead, we go back to it and it is
+	// no longer a replaced transaction.
+	pkg.replaceAnchorTx(firstTx)
+	assertAnchor(
+		firstTx, secondTx.FinalTx.TxHash(), thirdTx.FinalTx.TxHash(),
+	)
+}
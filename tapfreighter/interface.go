@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -18,7 +20,9 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // CommitmentConstraints conveys the constraints on the type of Taproot asset
@@ -52,6 +56,10 @@ type AnchoredCommitment struct {
 	// AnchorOutputValue is output value of the anchor output.
 	AnchorOutputValue btcutil.Amount
 
+	// AnchorBlockHeight is the height of the block the anchor transaction
+	// was confirmed in. This is zero if the anchor is unconfirmed.
+	AnchorBlockHeight uint32
+
 	// InternalKey is the internal key that's used to anchor the commitment
 	// in the above out point.
 	InternalKey keychain.KeyDescriptor
@@ -70,6 +78,16 @@ type AnchoredCommitment struct {
 	Asset *asset.Asset
 }
 
+// PrevID returns the previous input ID of the asset that is anchored in the
+// commitment.
+func (c *AnchoredCommitment) PrevID() asset.PrevID {
+	return asset.PrevID{
+		OutPoint:  c.AnchorPoint,
+		ID:        c.Asset.ID(),
+		ScriptKey: asset.ToSerialized(c.Asset.ScriptKey.PubKey),
+	}
+}
+
 var (
 	// ErrMatchingAssetsNotFound is returned when an instance of
 	// AssetStoreListCoins cannot satisfy the given asset identification
@@ -114,8 +132,80 @@ const (
 	// descending amounts and selects the first subset which cumulatively
 	// sums to at least the minimum target amount.
 	PreferMaxAmount MultiCommitmentSelectStrategy = iota
+
+	// PreferMinAmount is a strategy which considers commitments in order of
+	// ascending amounts and selects the first subset which cumulatively
+	// sums to at least the minimum target amount. This consolidates small
+	// commitments over time.
+	PreferMinAmount
+
+	// PreferExactMatch is a strategy which searches for a subset of
+	// commitments that sums up to exactly the target amount, so no change
+	// output is required. If no such subset exists, the PreferMaxAmount
+	// strategy is used instead.
+	PreferExactMatch
+
+	// PreferOldest is a strategy which considers commitments in order of
+	// the block height their anchor transaction confirmed at, oldest first.
+	PreferOldest
+
+	// AvoidAnchorMixing is a strategy which only selects commitments that
+	// share a single anchor outpoint, so a transfer never links multiple
+	// anchor outputs on-chain. Selection fails if no single anchor output
+	// holds enough of the asset.
+	AvoidAnchorMixing
 )
 
+// String returns the human-readable name of the strategy.
+func (s MultiCommitmentSelectStrategy) String() string {
+	switch s {
+	case PreferMaxAmount:
+		return "max-amount"
+
+	case PreferMinAmount:
+		return "min-amount"
+
+	case PreferExactMatch:
+		return "exact-match"
+
+	case PreferOldest:
+		return "oldest"
+
+	case AvoidAnchorMixing:
+		return "single-anchor"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// ParseCoinSelectStrategy parses the human-readable name of a coin selection
+// strategy.
+func ParseCoinSelectStrategy(name string) (MultiCommitmentSelectStrategy,
+	error) {
+
+	switch name {
+	case PreferMaxAmount.String():
+		return PreferMaxAmount, nil
+
+	case PreferMinAmount.String():
+		return PreferMinAmount, nil
+
+	case PreferExactMatch.String():
+		return PreferExactMatch, nil
+
+	case PreferOldest.String():
+		return PreferOldest, nil
+
+	case AvoidAnchorMixing.String():
+		return AvoidAnchorMixing, nil
+
+	default:
+		return 0, fmt.Errorf("unknown coin selection strategy: %v",
+			name)
+	}
+}
+
 // CoinSelector is an interface that describes the functionality used in
 // selecting coins during the asset send process.
 type CoinSelector interface {
@@ -126,6 +216,24 @@ type CoinSelector interface {
 		strategy MultiCommitmentSelectStrategy) ([]*AnchoredCommitment,
 		error)
 
+	// SelectCoinsBatch returns a set of not yet leased coins for each of
+	// the given constraints, using the given strategy. A coin is only
+	// selected for a single set of constraints, but the sets can share
+	// anchor outputs. The anchor outputs of all returned coins are leased
+	// together for the default lease duration.
+	SelectCoinsBatch(ctx context.Context,
+		constraints []CommitmentConstraints,
+		strategy MultiCommitmentSelectStrategy) (
+		[][]*AnchoredCommitment, error)
+
+	// SelectConsolidationCoins returns up to the given maximum number of
+	// not yet leased coins that satisfy the given constraints, smallest
+	// amounts first, in one set per asset ID. The coins returned are
+	// leased for the default lease duration.
+	SelectConsolidationCoins(ctx context.Context,
+		constraints CommitmentConstraints,
+		maxInputs int) ([][]*AnchoredCommitment, error)
+
 	// ReleaseCoins releases/unlocks coins that were previously leased and
 	// makes them available for coin selection again.
 	ReleaseCoins(ctx context.Context, utxoOutpoints ...wire.OutPoint) error
@@ -247,6 +355,11 @@ type OutboundParcel struct {
 	// Outputs represents the list of new assets that were created with this
 	// transfer.
 	Outputs []TransferOutput
+
+	// ReplacedAnchorTxHashes is the list of hashes of previous anchor
+	// transactions of this transfer that were replaced by a transaction
+	// paying a higher fee, in the order they were replaced.
+	ReplacedAnchorTxHashes []chainhash.Hash
 }
 
 // AssetConfirmEvent is used to mark a batched spend as confirmed on disk.
@@ -325,6 +438,18 @@ type ExportLog interface {
 	// updates the on-chain reference information on disk to point to this
 	// new spend.
 	ConfirmParcelDelivery(context.Context, *AssetConfirmEvent) error
+
+	// ReplaceAnchorTx replaces the anchor transaction of the pending parcel
+	// with the given anchor transaction hash with a new transaction that
+	// spends the same inputs and creates the same anchor outputs but pays
+	// the given, higher chain fees.
+	ReplaceAnchorTx(ctx context.Context, oldAnchorTxHash chainhash.Hash,
+		newAnchorTx *wire.MsgTx, chainFees int64) error
+
+	// AbandonParcel removes the pending parcel with the given anchor
+	// transaction hash from disk and releases the leases on its inputs,
+	// making the input assets spendable again.
+	AbandonParcel(ctx context.Context, anchorTxHash chainhash.Hash) error
 }
 
 // ChainBridge aliases into the ChainBridge of the tapgarden package.
@@ -345,6 +470,35 @@ type KeyRing = tapgarden.KeyRing
 // Signer aliases into the Signer interface of the tapscript package.
 type Signer = tapscript.Signer
 
+// MuSig2Signer is the interface used to co-sign virtual transaction inputs
+// that are locked to a MuSig2 aggregate script key. The MuSig2 sessions and
+// our local nonces and partial signatures are kept by the backing lnd node.
+type MuSig2Signer interface {
+	// MuSig2CreateSession creates a new MuSig2 signing session with the
+	// local key identified by the key locator and the given signers.
+	MuSig2CreateSession(ctx context.Context, version input.MuSig2Version,
+		signerLoc *keychain.KeyLocator, signers [][]byte,
+		opts ...lndclient.MuSig2SessionOpts) (*input.MuSig2SessionInfo,
+		error)
+
+	// MuSig2RegisterNonces registers additional public nonces for a
+	// MuSig2 session. It returns a boolean indicating whether we have all
+	// of our nonces present.
+	MuSig2RegisterNonces(ctx context.Context, sessionID [32]byte,
+		nonces [][musig2.PubNonceSize]byte) (bool, error)
+
+	// MuSig2Sign creates a partial signature for the 32 byte digest of a
+	// message. This can only be called once all public nonces are known.
+	MuSig2Sign(ctx context.Context, sessionID [32]byte, message [32]byte,
+		cleanup bool) ([]byte, error)
+
+	// MuSig2CombineSig combines the given partial signature(s) with the
+	// local one and returns the final signature once all partial
+	// signatures are known.
+	MuSig2CombineSig(ctx context.Context, sessionID [32]byte,
+		otherPartialSigs [][]byte) (bool, []byte, error)
+}
+
 // Porter is a high level interface that wraps the main caller execution point
 // to the ChainPorter.
 type Porter interface {
@@ -353,6 +507,21 @@ type Porter interface {
 	// returned with the pending transfer information.
 	RequestShipment(req Parcel) (*OutboundParcel, error)
 
+	// BumpTransferFee attempts to bump the fee of the pending transfer
+	// that is anchored in the transaction with the given hash, either by
+	// replacing the anchor transaction or by spending its change output
+	// with a child transaction.
+	BumpTransferFee(anchorTxHash chainhash.Hash, method FeeBumpMethod,
+		feeRate chainfee.SatPerKWeight) (*FeeBumpResult, error)
+
+	// AbandonTransfer abandons the pending transfer that is anchored in
+	// the transaction with the given hash. Its BTC inputs are either
+	// double spent with the given fee rate or only released, and the
+	// transfer is removed from disk.
+	AbandonTransfer(anchorTxHash chainhash.Hash,
+		feeRate chainfee.SatPerKWeight,
+		skipDoubleSpend bool) (*AbandonResult, error)
+
 	// Start signals that the asset minter should being operations.
 	Start() error
 
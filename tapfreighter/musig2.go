@@ -0,0 +1,288 @@
+package tapfreighter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// MuSig2Session describes a MuSig2 signing session for an aggregate asset
+// script key.
+type MuSig2Session struct {
+	*input.MuSig2SessionInfo
+
+	// ScriptKey is the aggregate script key of all signers of the session.
+	// Its raw key is the untweaked MuSig2 combined key and its tweak is the
+	// optional tapscript root the key commits to.
+	ScriptKey asset.ScriptKey
+}
+
+// MuSig2CreateSession creates a new MuSig2 signing session for the aggregate
+// script key of the given signers (which must include our local key) and the
+// optional tapscript root. If no tapscript root is given, the aggregate key is
+// tweaked according to BIP-0086. The aggregate script key is stored along with
+// its tweak, so outputs sent to it are recognized by the wallet, even after a
+// restart.
+//
+// NOTE: This is part of the Wallet interface.
+func (f *AssetWallet) MuSig2CreateSession(ctx context.Context,
+	localKey keychain.KeyLocator, signers []*btcec.PublicKey,
+	tapscriptRoot []byte,
+	otherNonces [][musig2.PubNonceSize]byte) (*MuSig2Session, error) {
+
+	if f.cfg.MuSig2Signer == nil {
+		return nil, fmt.Errorf("MuSig2 signing not supported")
+	}
+
+	if len(signers) < 2 {
+		return nil, fmt.Errorf("at least two signers are required")
+	}
+
+	signerKeys := make([][]byte, len(signers))
+	for idx := range signers {
+		signerKeys[idx] = signers[idx].SerializeCompressed()
+	}
+
+	opts := []lndclient.MuSig2SessionOpts{
+		lndclient.MuSig2TaprootTweakOpt(
+			tapscriptRoot, len(tapscriptRoot) == 0,
+		),
+	}
+	if len(otherNonces) > 0 {
+		opts = append(opts, lndclient.MuSig2NonceOpt(otherNonces))
+	}
+
+	info, err := f.cfg.MuSig2Signer.MuSig2CreateSession(
+		ctx, input.MuSig2Version100RC2, &localKey, signerKeys, opts...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create MuSig2 session: %w",
+			err)
+	}
+
+	if info.TaprootInternalKey == nil {
+		return nil, fmt.Errorf("MuSig2 session is missing taproot " +
+			"internal key")
+	}
+
+	scriptKey := asset.ScriptKey{
+		PubKey: info.CombinedKey,
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: keychain.KeyDescriptor{
+				PubKey: info.TaprootInternalKey,
+			},
+			Tweak: tapscriptRoot,
+		},
+	}
+	err = f.cfg.AddrBook.InsertScriptKey(ctx, scriptKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store aggregate script key: "+
+			"%w", err)
+	}
+
+	return &MuSig2Session{
+		MuSig2SessionInfo: info,
+		ScriptKey:         scriptKey,
+	}, nil
+}
+
+// MuSig2RegisterNonces registers the public nonces of other signers with the
+// given MuSig2 session and returns true if all nonces are now known.
+//
+// NOTE: This is part of the Wallet interface.
+func (f *AssetWallet) MuSig2RegisterNonces(ctx context.Context,
+	sessionID [32]byte, nonces [][musig2.PubNonceSize]byte) (bool, error) {
+
+	if f.cfg.MuSig2Signer == nil {
+		return false, fmt.Errorf("MuSig2 signing not supported")
+	}
+
+	return f.cfg.MuSig2Signer.MuSig2RegisterNonces(ctx, sessionID, nonces)
+}
+
+// MuSig2SignVirtualPacket creates our partial MuSig2 signature for the key
+// spend path of the input with the given index of the virtual packet. The
+// output assets of the packet must already be prepared (e.g. by funding the
+// packet). If cleanup is true, the session is removed after signing, which
+// should be done by all signers that don't combine the final signature.
+//
+// NOTE: This is part of the Wallet interface.
+func (f *AssetWallet) MuSig2SignVirtualPacket(ctx context.Context,
+	sessionID [32]byte, vPkt *tappsbt.VPacket, inputIdx int,
+	cleanup bool) ([]byte, error) {
+
+	if f.cfg.MuSig2Signer == nil {
+		return nil, fmt.Errorf("MuSig2 signing not supported")
+	}
+
+	if inputIdx < 0 || inputIdx >= len(vPkt.Inputs) {
+		return nil, fmt.Errorf("invalid input index %d", inputIdx)
+	}
+
+	// Before we sign anything, we want to make sure the input asset is
+	// actually committed in the anchor transaction.
+	err := verifyInclusionProof(vPkt.Inputs[inputIdx])
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify inclusion proof: %w",
+			err)
+	}
+
+	digest, err := muSig2InputDigest(vPkt, inputIdx)
+	if err != nil {
+		return nil, err
+	}
+
+	partialSig, err := f.cfg.MuSig2Signer.MuSig2Sign(
+		ctx, sessionID, digest, cleanup,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create partial MuSig2 "+
+			"signature: %w", err)
+	}
+
+	return partialSig, nil
+}
+
+// MuSig2CombineVirtualPacket combines our partial MuSig2 signature with the
+// ones of the other signers into the witness of the input with the given
+// index. All other inputs of the packet are signed with the wallet's default
+// signer, so they must be locked to local keys. Only a single MuSig2 input per
+// packet is supported. The transfer is then validated with the Taproot Asset
+// VM and the input indexes that were signed are returned.
+//
+// NOTE: This is part of the Wallet interface.
+func (f *AssetWallet) MuSig2CombineVirtualPacket(ctx context.Context,
+	sessionID [32]byte, vPkt *tappsbt.VPacket, inputIdx int,
+	otherPartialSigs [][]byte) ([]uint32, error) {
+
+	if f.cfg.MuSig2Signer == nil {
+		return nil, fmt.Errorf("MuSig2 signing not supported")
+	}
+
+	if inputIdx < 0 || inputIdx >= len(vPkt.Inputs) {
+		return nil, fmt.Errorf("invalid input index %d", inputIdx)
+	}
+
+	// All other inputs are signed by our default signer, which can only
+	// work for key spends of local keys. We check this up front, so a
+	// packet with more than one MuSig2 input is rejected before the
+	// session is used up. Script spends are signed with the key of their
+	// derivation info instead, so we leave them to the signer.
+	for idx, vIn := range vPkt.Inputs {
+		if idx == inputIdx || len(vIn.TaprootLeafScript) != 0 {
+			continue
+		}
+
+		scriptKey := vIn.Asset().ScriptKey
+		if scriptKey.TweakedScriptKey == nil ||
+			!f.cfg.KeyRing.IsLocalKey(ctx, scriptKey.RawKey) {
+
+			return nil, fmt.Errorf("input %d is not locked to a "+
+				"local script key, only a single MuSig2 input "+
+				"per packet is supported", idx)
+		}
+	}
+
+	for idx := range vPkt.Inputs {
+		err := verifyInclusionProof(vPkt.Inputs[idx])
+		if err != nil {
+			return nil, fmt.Errorf("unable to verify inclusion "+
+				"proof: %w", err)
+		}
+	}
+
+	haveAllSigs, finalSig, err := f.cfg.MuSig2Signer.MuSig2CombineSig(
+		ctx, sessionID, otherPartialSigs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to combine MuSig2 signatures: "+
+			"%w", err)
+	}
+	if !haveAllSigs {
+		return nil, fmt.Errorf("not all partial MuSig2 signatures " +
+			"are known yet")
+	}
+
+	witness, err := muSig2InputWitness(vPkt, inputIdx, finalSig)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tapscript.SignVirtualTransactionWithWitnesses(
+		vPkt, map[int]wire.TxWitness{inputIdx: witness}, f.cfg.Signer,
+		f.cfg.TxValidator,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate Taproot Asset "+
+			"witness data: %w", err)
+	}
+
+	// Mark all inputs as signed.
+	signedInputs := make([]uint32, len(vPkt.Inputs))
+	for idx := range vPkt.Inputs {
+		signedInputs[idx] = uint32(idx)
+	}
+
+	return signedInputs, nil
+}
+
+// muSig2InputDigest returns the key spend path signature hash of the input
+// with the given index of the virtual packet as the 32-byte digest that is
+// signed by the MuSig2 signers.
+func muSig2InputDigest(vPkt *tappsbt.VPacket, inputIdx int) ([32]byte,
+	error) {
+
+	var digest [32]byte
+	sigHash, err := tapscript.InputKeySpendPacketSigHash(vPkt, inputIdx)
+	if err != nil {
+		return digest, fmt.Errorf("unable to compute signature hash: "+
+			"%w", err)
+	}
+	copy(digest[:], sigHash)
+
+	return digest, nil
+}
+
+// muSig2InputWitness verifies the final MuSig2 signature against the script key
+// of the input with the given index and returns the key spend path witness for
+// the input.
+func muSig2InputWitness(vPkt *tappsbt.VPacket, inputIdx int,
+	finalSig []byte) (wire.TxWitness, error) {
+
+	sig, err := schnorr.ParseSignature(finalSig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse final MuSig2 "+
+			"signature: %w", err)
+	}
+
+	digest, err := muSig2InputDigest(vPkt, inputIdx)
+	if err != nil {
+		return nil, err
+	}
+
+	vIn := vPkt.Inputs[inputIdx]
+	scriptKey := vIn.Asset().ScriptKey.PubKey
+	if !sig.Verify(digest[:], scriptKey) {
+		return nil, fmt.Errorf("final MuSig2 signature is not valid "+
+			"for script key %x of input %d",
+			scriptKey.SerializeCompressed(), inputIdx)
+	}
+
+	sigBytes := sig.Serialize()
+	if vIn.SighashType != txscript.SigHashDefault {
+		sigBytes = append(sigBytes, byte(vIn.SighashType))
+	}
+
+	return wire.TxWitness{sigBytes}, nil
+}
@@ -0,0 +1,207 @@
+package tapfreighter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMuSig2Signer is a mock implementation of the MuSig2Signer interface
+// that returns a fixed session.
+type mockMuSig2Signer struct {
+	session *input.MuSig2SessionInfo
+
+	combineCalls int
+}
+
+func (m *mockMuSig2Signer) MuSig2CreateSession(context.Context,
+	input.MuSig2Version, *keychain.KeyLocator, [][]byte,
+	...lndclient.MuSig2SessionOpts) (*input.MuSig2SessionInfo, error) {
+
+	return m.session, nil
+}
+
+func (m *mockMuSig2Signer) MuSig2RegisterNonces(context.Context, [32]byte,
+	[][musig2.PubNonceSize]byte) (bool, error) {
+
+	return true, nil
+}
+
+func (m *mockMuSig2Signer) MuSig2Sign(context.Context, [32]byte, [32]byte,
+	bool) ([]byte, error) {
+
+	return nil, nil
+}
+
+func (m *mockMuSig2Signer) MuSig2CombineSig(context.Context, [32]byte,
+	[][]byte) (bool, []byte, error) {
+
+	m.combineCalls++
+	return false, nil, nil
+}
+
+// mockAddrBook is a mock implementation of the AddrBook interface that keeps
+// the script keys in memory.
+type mockAddrBook struct {
+	scriptKeys map[asset.SerializedKey]asset.ScriptKey
+}
+
+func (m *mockAddrBook) FetchScriptKey(_ context.Context,
+	tweakedScriptKey *btcec.PublicKey) (*asset.TweakedScriptKey, error) {
+
+	scriptKey, ok := m.scriptKeys[asset.ToSerialized(tweakedScriptKey)]
+	if !ok {
+		return nil, address.ErrScriptKeyNotFound
+	}
+
+	return scriptKey.TweakedScriptKey, nil
+}
+
+func (m *mockAddrBook) InsertScriptKey(_ context.Context,
+	scriptKey asset.ScriptKey) error {
+
+	m.scriptKeys[asset.ToSerialized(scriptKey.PubKey)] = scriptKey
+	return nil
+}
+
+// mockLocalKeyRing is a mock key ring that, like the lnd key ring, doesn't
+// consider keys without a key locator as local.
+type mockLocalKeyRing struct {
+	*tapgarden.MockKeyRing
+}
+
+func (m *mockLocalKeyRing) IsLocalKey(_ context.Context,
+	desc keychain.KeyDescriptor) bool {
+
+	return desc.Family != 0 || desc.Index != 0
+}
+
+// newMuSig2TestWallet creates an asset wallet that creates MuSig2 sessions
+// for an aggregate key with the given internal key and tapscript root.
+func newMuSig2TestWallet(t *testing.T, internalKey *btcec.PublicKey,
+	tapscriptRoot []byte) (*AssetWallet, *mockMuSig2Signer,
+	*mockAddrBook) {
+
+	muSig2Signer := &mockMuSig2Signer{
+		session: &input.MuSig2SessionInfo{
+			SessionID: [32]byte(test.RandBytes(32)),
+			CombinedKey: txscript.ComputeTaprootOutputKey(
+				internalKey, tapscriptRoot,
+			),
+			TaprootInternalKey: internalKey,
+		},
+	}
+	addrBook := &mockAddrBook{
+		scriptKeys: make(map[asset.SerializedKey]asset.ScriptKey),
+	}
+	wallet := NewAssetWallet(&WalletConfig{
+		AddrBook:     addrBook,
+		KeyRing:      &mockLocalKeyRing{tapgarden.NewMockKeyRing()},
+		MuSig2Signer: muSig2Signer,
+		ChainParams:  &address.RegressionNetTap,
+	})
+
+	return wallet, muSig2Signer, addrBook
+}
+
+// TestMuSig2CreateSessionStoresScriptKey tests that the aggregate script key
+// of a new MuSig2 session is stored along with its tweak, so outputs sent to
+// it are recognized by the wallet.
+func TestMuSig2CreateSessionStoresScriptKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	internalKey := test.RandPubKey(t)
+	tapscriptRoot := test.RandBytes(32)
+	wallet, _, addrBook := newMuSig2TestWallet(
+		t, internalKey, tapscriptRoot,
+	)
+
+	signers := []*btcec.PublicKey{test.RandPubKey(t), test.RandPubKey(t)}
+	session, err := wallet.MuSig2CreateSession(
+		ctx, keychain.KeyLocator{
+			Family: asset.TaprootAssetsKeyFamily,
+			Index:  1,
+		}, signers, tapscriptRoot, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, internalKey, session.ScriptKey.RawKey.PubKey)
+	require.Equal(t, tapscriptRoot, session.ScriptKey.Tweak)
+
+	tweakedKey, err := wallet.cfg.AddrBook.FetchScriptKey(
+		ctx, session.ScriptKey.PubKey,
+	)
+	require.NoError(t, err)
+	require.Equal(t, session.ScriptKey.TweakedScriptKey, tweakedKey)
+	require.Len(t, addrBook.scriptKeys, 1)
+}
+
+// TestMuSig2CombineRejectsNonLocalInputs tests that combining the MuSig2
+// signature of a packet is rejected before the session is used if another
+// input of the packet can't be signed by the wallet, for example because it's
+// locked to another MuSig2 aggregate key.
+func TestMuSig2CombineRejectsNonLocalInputs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wallet, muSig2Signer, _ := newMuSig2TestWallet(
+		t, test.RandPubKey(t), nil,
+	)
+
+	// The first input is locked to the aggregate key of the session, the
+	// second one to another aggregate key, which isn't local either.
+	newMuSig2Asset := func() *asset.Asset {
+		muSig2Asset := asset.RandAsset(t, asset.Normal)
+		muSig2Asset.ScriptKey = asset.NewScriptKeyBip86(
+			keychain.KeyDescriptor{
+				PubKey: test.RandPubKey(t),
+			},
+		)
+
+		return muSig2Asset
+	}
+	vPkt := &tappsbt.VPacket{
+		ChainParams: &address.RegressionNetTap,
+		Inputs:      []*tappsbt.VInput{{}, {}},
+	}
+	vPkt.SetInputAsset(0, newMuSig2Asset(), nil)
+	vPkt.SetInputAsset(1, newMuSig2Asset(), nil)
+
+	_, err := wallet.MuSig2CombineVirtualPacket(
+		ctx, muSig2Signer.session.SessionID, vPkt, 0,
+		[][]byte{test.RandBytes(32)},
+	)
+	require.ErrorContains(t, err, "input 1 is not locked to a local")
+	require.Zero(t, muSig2Signer.combineCalls)
+
+	// An input that is locked to a local key is signed by the wallet, so
+	// it passes the check, and the wallet moves on to verify the inputs.
+	localAsset := asset.RandAsset(t, asset.Normal)
+	localAsset.ScriptKey = asset.NewScriptKeyBip86(keychain.KeyDescriptor{
+		PubKey: test.RandPubKey(t),
+		KeyLocator: keychain.KeyLocator{
+			Family: asset.TaprootAssetsKeyFamily,
+			Index:  7,
+		},
+	})
+	vPkt.SetInputAsset(1, localAsset, nil)
+
+	_, err = wallet.MuSig2CombineVirtualPacket(
+		ctx, muSig2Signer.session.SessionID, vPkt, 0,
+		[][]byte{test.RandBytes(32)},
+	)
+	require.ErrorContains(t, err, "unable to verify inclusion proof")
+	require.Zero(t, muSig2Signer.combineCalls)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -64,6 +65,11 @@ const (
 	// SendStateComplete is the state which is reached once entire asset
 	// transfer process is complete.
 	SendStateComplete
+
+	// SendStateAbandoned is the state which is reached if a pending
+	// transfer was abandoned before its anchor transaction confirmed. The
+	// transfer was removed from disk and its inputs were released.
+	SendStateAbandoned
 )
 
 // String returns a human-readable version of SendState.
@@ -96,6 +102,9 @@ func (s SendState) String() string {
 	case SendStateComplete:
 		return "SendStateComplete"
 
+	case SendStateAbandoned:
+		return "SendStateAbandoned"
+
 	default:
 		return fmt.Sprintf("<unknown_state(%d)>", s)
 	}
@@ -135,6 +144,10 @@ type AddressParcel struct {
 	// transferFeeRate is an optional manually-set feerate specified when
 	// requesting an asset transfer.
 	transferFeeRate *chainfee.SatPerKWeight
+
+	// coinSelectStrategy is the strategy used to select the asset coins
+	// that fund the transfer.
+	coinSelectStrategy MultiCommitmentSelectStrategy
 }
 
 // A compile-time assertion to ensure AddressParcel implements the parcel
@@ -143,6 +156,7 @@ var _ Parcel = (*AddressParcel)(nil)
 
 // NewAddressParcel creates a new AddressParcel.
 func NewAddressParcel(feeRate *chainfee.SatPerKWeight,
+	strategy MultiCommitmentSelectStrategy,
 	destAddrs ...*address.Tap) *AddressParcel {
 
 	return &AddressParcel{
@@ -150,8 +164,9 @@ func NewAddressParcel(feeRate *chainfee.SatPerKWeight,
 			respChan: make(chan *OutboundParcel, 1),
 			errChan:  make(chan error, 1),
 		},
-		destAddrs:       destAddrs,
-		transferFeeRate: feeRate,
+		destAddrs:          destAddrs,
+		transferFeeRate:    feeRate,
+		coinSelectStrategy: strategy,
 	}
 }
 
@@ -234,48 +249,74 @@ func (p *PendingParcel) Validate() error {
 }
 
 // PreSignedParcel is a request to issue an asset transfer of a pre-signed
-// parcel. This packages a virtual transaction, the input commitment, and also
-// the response context.
+// parcel. This packages the virtual transactions, their input commitments, and
+// also the response context.
 type PreSignedParcel struct {
 	*parcelKit
 
-	// vPkt is the virtual transaction that should be delivered.
-	vPkt *tappsbt.VPacket
+	// vPkts are the virtual transactions that should be delivered, all of
+	// which are anchored in the same anchor transaction.
+	vPkts []*tappsbt.VPacket
 
-	// inputCommitments are the commitments for the input that are being
-	// spent in the virtual transaction.
-	inputCommitments tappsbt.InputCommitments
+	// inputCommitments are the commitments for the inputs that are being
+	// spent in the virtual transactions, one set for each packet.
+	inputCommitments []tappsbt.InputCommitments
+
+	// transferFeeRate is an optional manually-set feerate for the anchor
+	// transaction.
+	transferFeeRate *chainfee.SatPerKWeight
 }
 
 // A compile-time assertion to ensure PreSignedParcel implements the parcel
 // interface.
 var _ Parcel = (*PreSignedParcel)(nil)
 
-// NewPreSignedParcel creates a new PreSignedParcel.
+// NewPreSignedParcel creates a new PreSignedParcel. If the fee rate is nil, the
+// fee rate of the anchor transaction is estimated.
 func NewPreSignedParcel(vPkt *tappsbt.VPacket,
-	inputCommitments tappsbt.InputCommitments) *PreSignedParcel {
+	inputCommitments tappsbt.InputCommitments,
+	feeRate *chainfee.SatPerKWeight) *PreSignedParcel {
+
+	return NewPreSignedBatchParcel(
+		[]*tappsbt.VPacket{vPkt},
+		[]tappsbt.InputCommitments{inputCommitments}, feeRate,
+	)
+}
+
+// NewPreSignedBatchParcel creates a new PreSignedParcel for multiple virtual
+// transactions that are anchored in the same anchor transaction. The anchor
+// output indexes of the virtual transactions must not overlap. If the fee rate
+// is nil, the fee rate of the anchor transaction is estimated.
+func NewPreSignedBatchParcel(vPkts []*tappsbt.VPacket,
+	inputCommitments []tappsbt.InputCommitments,
+	feeRate *chainfee.SatPerKWeight) *PreSignedParcel {
 
 	return &PreSignedParcel{
 		parcelKit: &parcelKit{
 			respChan: make(chan *OutboundParcel, 1),
 			errChan:  make(chan error, 1),
 		},
-		vPkt:             vPkt,
+		vPkts:            vPkts,
 		inputCommitments: inputCommitments,
+		transferFeeRate:  feeRate,
 	}
 }
 
 // pkg returns the send package that should be delivered.
 func (p *PreSignedParcel) pkg() *sendPackage {
-	log.Infof("New signed delivery request with %d outputs",
-		len(p.vPkt.Outputs))
+	numOutputs := 0
+	for _, vPkt := range p.vPkts {
+		numOutputs += len(vPkt.Outputs)
+	}
+	log.Infof("New signed delivery request with %d virtual "+
+		"transactions and %d outputs", len(p.vPkts), numOutputs)
 
-	// Initialize a package the signed virtual transaction and input
-	// commitment.
+	// Initialize a package the signed virtual transactions and input
+	// commitments.
 	return &sendPackage{
 		Parcel:           p,
 		SendState:        SendStateAnchorSign,
-		VirtualPacket:    p.vPkt,
+		VirtualPackets:   p.vPkts,
 		InputCommitments: p.inputCommitments,
 	}
 }
@@ -287,7 +328,106 @@ func (p *PreSignedParcel) kit() *parcelKit {
 
 // Validate validates the parcel.
 func (p *PreSignedParcel) Validate() error {
-	// TODO(ffranr): Add validation where appropriate.
+	if len(p.vPkts) != len(p.inputCommitments) {
+		return fmt.Errorf("pre-signed parcel has %d virtual "+
+			"transactions but %d input commitment sets",
+			len(p.vPkts), len(p.inputCommitments))
+	}
+
+	return nil
+}
+
+// PreAnchoredParcel is a request to log and broadcast an asset transfer of a
+// pre-signed virtual transaction that is already committed to in a fully
+// signed BTC level anchor transaction. The anchor transaction might also
+// contain the virtual transactions of other parties, for example in an atomic
+// swap.
+type PreAnchoredParcel struct {
+	*parcelKit
+
+	// vPkts are the virtual transactions that should be delivered.
+	vPkts []*tappsbt.VPacket
+
+	// inputCommitments are the commitments for the inputs that are being
+	// spent in the virtual transactions, one set for each packet.
+	inputCommitments []tappsbt.InputCommitments
+
+	// passiveAssets are the passive assets that are re-anchored in the
+	// anchor transaction.
+	passiveAssets []*PassiveAssetReAnchor
+
+	// foreignVPkts are the virtual transactions of other parties that are
+	// anchored in the same anchor transaction.
+	foreignVPkts []*tappsbt.VPacket
+
+	// anchorTx is the fully signed anchor transaction that commits to all
+	// virtual transactions.
+	anchorTx *AnchorTransaction
+}
+
+// A compile-time assertion to ensure PreAnchoredParcel implements the parcel
+// interface.
+var _ Parcel = (*PreAnchoredParcel)(nil)
+
+// NewPreAnchoredParcel creates a new PreAnchoredParcel.
+func NewPreAnchoredParcel(vPkts []*tappsbt.VPacket,
+	inputCommitments []tappsbt.InputCommitments,
+	passiveAssets []*PassiveAssetReAnchor, foreignVPkts []*tappsbt.VPacket,
+	anchorTx *AnchorTransaction) *PreAnchoredParcel {
+
+	return &PreAnchoredParcel{
+		parcelKit: &parcelKit{
+			respChan: make(chan *OutboundParcel, 1),
+			errChan:  make(chan error, 1),
+		},
+		vPkts:            vPkts,
+		inputCommitments: inputCommitments,
+		passiveAssets:    passiveAssets,
+		foreignVPkts:     foreignVPkts,
+		anchorTx:         anchorTx,
+	}
+}
+
+// pkg returns the send package that should be delivered.
+func (p *PreAnchoredParcel) pkg() *sendPackage {
+	log.Infof("New pre-anchored delivery request with %d virtual "+
+		"transactions, %d passive assets and %d foreign virtual "+
+		"transactions", len(p.vPkts), len(p.passiveAssets),
+		len(p.foreignVPkts))
+
+	// The anchor transaction is already fully signed, so we can go
+	// straight to logging the transfer to disk.
+	return &sendPackage{
+		Parcel:           p,
+		SendState:        SendStateLogCommit,
+		VirtualPackets:   p.vPkts,
+		ForeignVPackets:  p.foreignVPkts,
+		InputCommitments: p.inputCommitments,
+		PassiveAssets:    p.passiveAssets,
+		AnchorTx:         p.anchorTx,
+	}
+}
+
+// kit returns the parcel kit used for delivery.
+func (p *PreAnchoredParcel) kit() *parcelKit {
+	return p.parcelKit
+}
+
+// Validate validates the parcel.
+func (p *PreAnchoredParcel) Validate() error {
+	if p.anchorTx == nil || p.anchorTx.FinalTx == nil ||
+		p.anchorTx.FundedPsbt == nil {
+
+		return fmt.Errorf("pre-anchored parcel is missing the final " +
+			"anchor transaction")
+	}
+
+	if len(p.vPkts) != len(p.inputCommitments) {
+		return fmt.Errorf("pre-anchored parcel has %d virtual "+
+			"transactions but %d input commitment sets",
+			len(p.vPkts), len(p.inputCommitments))
+	}
+
 	return nil
 }
 
@@ -296,17 +436,26 @@ type sendPackage struct {
 	// SendState is the current send state of this parcel.
 	SendState SendState
 
-	// VirtualPacket is the virtual packet that we'll use to construct the
-	// virtual asset transition transaction.
-	VirtualPacket *tappsbt.VPacket
+	// VirtualPackets are the virtual packets that we'll use to construct
+	// the virtual asset transition transactions, one for each asset ID that
+	// is transferred. All of them are anchored in the same anchor
+	// transaction.
+	VirtualPackets []*tappsbt.VPacket
 
-	// OutputIdxToAddr is a map from a VPacket's VOutput index to its
-	// associated Tap address.
-	OutputIdxToAddr tappsbt.OutputIdxToAddr
+	// OutputIdxToAddr holds a map from a VPacket's VOutput index to its
+	// associated Tap address for each of the virtual packets (at the same
+	// index).
+	OutputIdxToAddr []tappsbt.OutputIdxToAddr
 
-	// InputCommitments is a map from virtual package input index to its
-	// associated Taproot Asset commitment.
-	InputCommitments tappsbt.InputCommitments
+	// InputCommitments holds a map from virtual package input index to its
+	// associated Taproot Asset commitment for each of the virtual packets
+	// (at the same index).
+	InputCommitments []tappsbt.InputCommitments
+
+	// ForeignVPackets are the virtual packets of other parties that are
+	// anchored in the same anchor transaction as our virtual packet. We
+	// need them to create exclusion proofs for their anchor outputs.
+	ForeignVPackets []*tappsbt.VPacket
 
 	// PassiveAssets is the data used in re-anchoring passive assets.
 	PassiveAssets []*PassiveAssetReAnchor
@@ -318,6 +467,11 @@ type sendPackage struct {
 	// as it was used when funding/signing it.
 	AnchorTx *AnchorTransaction
 
+	// ReplacedAnchorTxns are the previous anchor transactions of this
+	// transfer that were replaced by a transaction paying a higher fee.
+	// Any of them might still confirm instead of the current one.
+	ReplacedAnchorTxns []*AnchorTransaction
+
 	// OutboundPkg is the on-disk level information that tracks the pending
 	// transfer.
 	OutboundPkg *OutboundParcel
@@ -332,6 +486,76 @@ type sendPackage struct {
 	TransferTxConfEvent *chainntnfs.TxConfirmation
 }
 
+// replaceAnchorTx replaces the anchor transaction of the send package and of
+// its outbound parcel with the given one, which must spend the same inputs and
+// create the same anchor outputs.
+func (s *sendPackage) replaceAnchorTx(anchorTx *AnchorTransaction) {
+	oldAnchorTx := s.AnchorTx
+	if oldAnchorTx == nil {
+		oldAnchorTx = &AnchorTransaction{
+			FinalTx:   s.OutboundPkg.AnchorTx,
+			ChainFees: s.OutboundPkg.ChainFees,
+		}
+	}
+	oldTxHash := oldAnchorTx.FinalTx.TxHash()
+	newTxHash := anchorTx.FinalTx.TxHash()
+	parcel := s.OutboundPkg
+
+	// If we go back to a transaction we replaced before, it is no longer
+	// a replaced transaction.
+	var (
+		replacedTxns   []*AnchorTransaction
+		replacedHashes []chainhash.Hash
+	)
+	for _, replacedTx := range s.ReplacedAnchorTxns {
+		if replacedTx.FinalTx.TxHash() != newTxHash {
+			replacedTxns = append(replacedTxns, replacedTx)
+		}
+	}
+	for _, replacedHash := range parcel.ReplacedAnchorTxHashes {
+		if replacedHash != newTxHash {
+			replacedHashes = append(replacedHashes, replacedHash)
+		}
+	}
+	replacedTxns = append(replacedTxns, oldAnchorTx)
+	replacedHashes = append(replacedHashes, oldTxHash)
+
+	s.AnchorTx = anchorTx
+	s.ReplacedAnchorTxns = replacedTxns
+
+	parcel.AnchorTx = anchorTx.FinalTx
+	parcel.ChainFees = anchorTx.ChainFees
+	parcel.ReplacedAnchorTxHashes = replacedHashes
+
+	for idx := range parcel.Outputs {
+		parcel.Outputs[idx].Anchor.OutPoint.Hash = newTxHash
+	}
+}
+
+// changeOutputIndex returns the index of the BTC change output of the anchor
+// transaction.
+func (s *sendPackage) changeOutputIndex() (uint32, error) {
+	if s.AnchorTx != nil && s.AnchorTx.FundedPsbt != nil &&
+		s.AnchorTx.FundedPsbt.ChangeOutputIndex >= 0 {
+
+		return uint32(s.AnchorTx.FundedPsbt.ChangeOutputIndex), nil
+	}
+
+	// If we don't know the funded PSBT anymore (for example after a
+	// restart), we rely on the change output always being the last output
+	// of the anchor transaction.
+	parcel := s.OutboundPkg
+	lastIndex := uint32(len(parcel.AnchorTx.TxOut) - 1)
+	for idx := range parcel.Outputs {
+		if parcel.Outputs[idx].Anchor.OutPoint.Index == lastIndex {
+			return 0, fmt.Errorf("anchor transaction has no " +
+				"change output")
+		}
+	}
+
+	return lastIndex, nil
+}
+
 // prepareForStorage prepares the send package for storing to the database.
 func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 	error) {
@@ -352,7 +576,6 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		passiveAsset.NewWitnessData = signedAsset.PrevWitnesses
 	}
 
-	vPkt := s.VirtualPacket
 	anchorTXID := s.AnchorTx.FinalTx.TxHash()
 	parcel := &OutboundParcel{
 		AnchorTx:           s.AnchorTx.FinalTx,
@@ -360,11 +583,41 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		// TODO(bhandras): use clock.Clock instead.
 		TransferTime:  time.Now(),
 		ChainFees:     s.AnchorTx.ChainFees,
-		Inputs:        make([]TransferInput, len(vPkt.Inputs)),
-		Outputs:       make([]TransferOutput, len(vPkt.Outputs)),
 		PassiveAssets: s.PassiveAssets,
 	}
 
+	// The inputs and outputs of all virtual packets are stored as part of
+	// the same transfer.
+	for pktIdx, vPkt := range s.VirtualPackets {
+		var outputIdxToAddr tappsbt.OutputIdxToAddr
+		if pktIdx < len(s.OutputIdxToAddr) {
+			outputIdxToAddr = s.OutputIdxToAddr[pktIdx]
+		}
+
+		inputs, err := s.transferInputs(vPkt)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs, err := s.transferOutputs(
+			vPkt, outputIdxToAddr, anchorTXID,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		parcel.Inputs = append(parcel.Inputs, inputs...)
+		parcel.Outputs = append(parcel.Outputs, outputs...)
+	}
+
+	return parcel, nil
+}
+
+// transferInputs returns the transfer inputs spent by the given virtual packet.
+func (s *sendPackage) transferInputs(
+	vPkt *tappsbt.VPacket) ([]TransferInput, error) {
+
+	inputs := make([]TransferInput, len(vPkt.Inputs))
 	for idx := range vPkt.Inputs {
 		vIn := vPkt.Inputs[idx]
 
@@ -389,7 +642,7 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 				"outpoint for input %d", idx)
 		}
 
-		parcel.Inputs[idx] = TransferInput{
+		inputs[idx] = TransferInput{
 			PrevID: asset.PrevID{
 				OutPoint: *anchorOutPoint,
 				ID:       vIn.Asset().ID(),
@@ -401,6 +654,17 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		}
 	}
 
+	return inputs, nil
+}
+
+// transferOutputs returns the transfer outputs created by the given virtual
+// packet, using the given map to look up the proof courier address of each
+// output.
+func (s *sendPackage) transferOutputs(vPkt *tappsbt.VPacket,
+	outputIdxToAddr tappsbt.OutputIdxToAddr,
+	anchorTXID chainhash.Hash) ([]TransferOutput, error) {
+
+	outputs := make([]TransferOutput, len(vPkt.Outputs))
 	outputCommitments := s.AnchorTx.OutputCommitments
 	for idx := range vPkt.Outputs {
 		vOut := vPkt.Outputs[idx]
@@ -408,8 +672,8 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		// Convert any proof courier address associated with this output
 		// to bytes for db storage.
 		var proofCourierAddrBytes []byte
-		if s.OutputIdxToAddr != nil {
-			if addr, ok := s.OutputIdxToAddr[idx]; ok {
+		if outputIdxToAddr != nil {
+			if addr, ok := outputIdxToAddr[idx]; ok {
 				proofCourierAddrBytes = []byte(
 					addr.ProofCourierAddr.String(),
 				)
@@ -450,7 +714,9 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		// If there are passive assets, they are always committed to the
 		// output that is marked as the split root.
 		if vOut.Type.CanCarryPassive() {
-			numPassiveAssets = uint32(len(s.PassiveAssets))
+			numPassiveAssets = s.numPassiveAssets(
+				vOut.AnchorOutputIndex,
+			)
 		}
 
 		// Either we have an asset that we commit to or we have an
@@ -466,7 +732,7 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		// In any other case we expect an active asset transfer to be
 		// committed to.
 		case vOut.Asset != nil:
-			proofSuffix, err := s.createProofSuffix(idx)
+			proofSuffix, err := s.createProofSuffix(vPkt, idx)
 			if err != nil {
 				return nil, fmt.Errorf("unable to create "+
 					"proof %d: %w", idx, err)
@@ -486,7 +752,7 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		}
 
 		txOut := s.AnchorTx.FinalTx.TxOut[vOut.AnchorOutputIndex]
-		parcel.Outputs[idx] = TransferOutput{
+		outputs[idx] = TransferOutput{
 			Anchor: Anchor{
 				OutPoint: wire.OutPoint{
 					Hash:  anchorTXID,
@@ -510,37 +776,37 @@ func (s *sendPackage) prepareForStorage(currentHeight uint32) (*OutboundParcel,
 		}
 	}
 
-	return parcel, nil
+	return outputs, nil
 }
 
 // createProofSuffix creates the new proof for the given output. This is the
 // final state transition that will be added to the proofs of the receiver. The
 // proof returned will have all the Taproot Asset level proof information, but
 // contains dummy data for the on-chain part.
-func (s *sendPackage) createProofSuffix(outIndex int) (*proof.Proof, error) {
-	inputPrevID := s.VirtualPacket.Inputs[0].PrevID
+func (s *sendPackage) createProofSuffix(vPkt *tappsbt.VPacket,
+	outIndex int) (*proof.Proof, error) {
+
+	inputPrevID := vPkt.Inputs[0].PrevID
 
-	params, err := proofParams(s.AnchorTx, s.VirtualPacket, outIndex)
+	params, err := proofParams(s.AnchorTx, vPkt, outIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	// Any anchor outputs of our other or foreign virtual packets need an
+	// exclusion proof for our asset as well.
+	err = s.addOtherPacketsExclusionProofs(
+		vPkt, vPkt.Outputs[outIndex].Asset, params,
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	// We also need to account for any P2TR change outputs.
 	if len(s.AnchorTx.FundedPsbt.Pkt.UnsignedTx.TxOut) > 1 {
-		isAnchor := func(idx uint32) bool {
-			for outIdx := range s.VirtualPacket.Outputs {
-				vOut := s.VirtualPacket.Outputs[outIdx]
-				if vOut.AnchorOutputIndex == idx {
-					return true
-				}
-			}
-
-			return false
-		}
-
 		err := proof.AddExclusionProofs(
 			&params.BaseProofParams, s.AnchorTx.FundedPsbt.Pkt,
-			isAnchor,
+			s.isAnchorOutput,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error adding exclusion "+
@@ -736,6 +1002,94 @@ func addOtherOutputExclusionProofs(outputs []*tappsbt.VOutput,
 	return nil
 }
 
+// allVPackets returns our own virtual packets followed by the foreign virtual
+// packets that are anchored in the same anchor transaction.
+func (s *sendPackage) allVPackets() []*tappsbt.VPacket {
+	vPkts := make(
+		[]*tappsbt.VPacket, 0,
+		len(s.VirtualPackets)+len(s.ForeignVPackets),
+	)
+	vPkts = append(vPkts, s.VirtualPackets...)
+
+	return append(vPkts, s.ForeignVPackets...)
+}
+
+// numPassiveAssets returns the number of passive assets that are re-anchored
+// in the anchor output with the given index.
+func (s *sendPackage) numPassiveAssets(anchorIdx uint32) uint32 {
+	var numPassiveAssets uint32
+	for _, passiveAsset := range s.PassiveAssets {
+		passiveOut := passiveAsset.VPacket.Outputs[0]
+		if passiveOut.AnchorOutputIndex == anchorIdx {
+			numPassiveAssets++
+		}
+	}
+
+	return numPassiveAssets
+}
+
+// isAnchorOutput returns true if the anchor output with the given index
+// commits to assets of any of our own or the foreign virtual packets.
+func (s *sendPackage) isAnchorOutput(idx uint32) bool {
+	for _, vPkt := range s.allVPackets() {
+		for outIdx := range vPkt.Outputs {
+			if vPkt.Outputs[outIdx].AnchorOutputIndex == idx {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// addOtherPacketsExclusionProofs adds exclusion proofs for the given asset for
+// all anchor outputs of our own virtual packets other than the given one and
+// of the foreign virtual packets.
+func (s *sendPackage) addOtherPacketsExclusionProofs(vPkt *tappsbt.VPacket,
+	asset *asset.Asset, params *proof.TransitionParams) error {
+
+	for _, otherPkt := range s.allVPackets() {
+		if otherPkt == vPkt {
+			continue
+		}
+
+		err := addOtherOutputExclusionProofs(
+			otherPkt.Outputs, asset, params,
+			s.AnchorTx.OutputCommitments,
+			func(int, *tappsbt.VOutput) bool {
+				return false
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to add exclusion proofs "+
+				"for other outputs: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// passiveAssetsOutput returns the output of our own virtual packets that
+// carries the passive assets re-anchored in the anchor output with the given
+// index.
+func (s *sendPackage) passiveAssetsOutput(
+	anchorIdx uint32) (*tappsbt.VOutput, error) {
+
+	for _, vPkt := range s.VirtualPackets {
+		passiveOut, err := vPkt.PassiveAssetsOutput()
+		if err != nil {
+			continue
+		}
+
+		if passiveOut.AnchorOutputIndex == anchorIdx {
+			return passiveOut, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no passive asset carrying output with "+
+		"anchor output index %d", anchorIdx)
+}
+
 // createReAnchorProof creates the new proof for the re-anchoring of a passive
 // asset.
 func (s *sendPackage) createReAnchorProof(
@@ -745,18 +1099,19 @@ func (s *sendPackage) createReAnchorProof(
 	passiveIn := passivePkt.Inputs[0]
 	passiveOut := passivePkt.Outputs[0]
 
-	// Passive assets are always anchored at a specific marked output, which
-	// normally contains asset change. But it can also be that the split
-	// root output was just created for the passive assets, if there is no
-	// active transfer or no change.
-	passiveCarrierOut, err := s.VirtualPacket.PassiveAssetsOutput()
+	// Passive assets are always anchored at a specific marked output of
+	// the virtual packet that spent them, which normally contains asset
+	// change. But it can also be that the split root output was just
+	// created for the passive assets, if there is no active transfer or no
+	// change.
+	passiveOutputIndex := passiveOut.AnchorOutputIndex
+	passiveCarrierOut, err := s.passiveAssetsOutput(passiveOutputIndex)
 	if err != nil {
 		return nil, fmt.Errorf("anchor output for passive assets not "+
 			"found: %w", err)
 	}
 
 	outputCommitments := s.AnchorTx.OutputCommitments
-	passiveOutputIndex := passiveOut.AnchorOutputIndex
 	passiveTapTree := outputCommitments[passiveOutputIndex]
 
 	// The base parameters include the inclusion proof of the passive asset
@@ -769,34 +1124,27 @@ func (s *sendPackage) createReAnchorProof(
 
 	// Since a transfer might contain other anchor outputs, we need to
 	// provide an exclusion proof of the passive asset for each of the other
-	// BTC level outputs.
-	err = addOtherOutputExclusionProofs(
-		s.VirtualPacket.Outputs, passiveOut.Asset, passiveParams,
-		outputCommitments, func(i int, vOut *tappsbt.VOutput) bool {
-			return vOut.AnchorOutputIndex == passiveOutputIndex
-		},
-	)
-	if err != nil {
-		return nil, err
+	// BTC level outputs of all virtual packets.
+	for _, vPkt := range s.allVPackets() {
+		err = addOtherOutputExclusionProofs(
+			vPkt.Outputs, passiveOut.Asset, passiveParams,
+			outputCommitments,
+			func(i int, vOut *tappsbt.VOutput) bool {
+				anchorIdx := vOut.AnchorOutputIndex
+				return anchorIdx == passiveOutputIndex
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Add exclusion proof(s) for any P2TR (=BIP-0086, not carrying any
 	// assets) change outputs.
 	if len(s.AnchorTx.FundedPsbt.Pkt.UnsignedTx.TxOut) > 1 {
-		isAnchor := func(idx uint32) bool {
-			for outIdx := range s.VirtualPacket.Outputs {
-				vOut := s.VirtualPacket.Outputs[outIdx]
-				if vOut.AnchorOutputIndex == idx {
-					return true
-				}
-			}
-
-			return false
-		}
-
 		err := proof.AddExclusionProofs(
 			&passiveParams.BaseProofParams,
-			s.AnchorTx.FundedPsbt.Pkt, isAnchor,
+			s.AnchorTx.FundedPsbt.Pkt, s.isAnchorOutput,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error adding exclusion "+
@@ -0,0 +1,81 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSendPackagePassiveAssets tests that passive assets are attributed to the
+// carrier output of the virtual packet they are re-anchored in when a send
+// package contains multiple virtual packets.
+func TestSendPackagePassiveAssets(t *testing.T) {
+	t.Parallel()
+
+	newOutput := func(anchorIdx uint32,
+		outType tappsbt.VOutputType) *tappsbt.VOutput {
+
+		return &tappsbt.VOutput{
+			Type:              outType,
+			AnchorOutputIndex: anchorIdx,
+		}
+	}
+	newPassiveAsset := func(anchorIdx uint32) *PassiveAssetReAnchor {
+		return &PassiveAssetReAnchor{
+			VPacket: &tappsbt.VPacket{
+				Outputs: []*tappsbt.VOutput{
+					newOutput(anchorIdx, tappsbt.TypeSimple),
+				},
+			},
+		}
+	}
+
+	// The first packet carries passive assets in its change output, the
+	// second one has a plain change output and the third one carries
+	// passive assets as well.
+	pkg := &sendPackage{
+		VirtualPackets: []*tappsbt.VPacket{{
+			Outputs: []*tappsbt.VOutput{
+				newOutput(0, tappsbt.TypePassiveSplitRoot),
+				newOutput(1, tappsbt.TypeSimple),
+			},
+		}, {
+			Outputs: []*tappsbt.VOutput{
+				newOutput(2, tappsbt.TypeSplitRoot),
+				newOutput(3, tappsbt.TypeSimple),
+			},
+		}, {
+			Outputs: []*tappsbt.VOutput{
+				newOutput(4, tappsbt.TypePassiveSplitRoot),
+				newOutput(5, tappsbt.TypeSimple),
+			},
+		}},
+		PassiveAssets: []*PassiveAssetReAnchor{
+			newPassiveAsset(0), newPassiveAsset(4),
+			newPassiveAsset(0),
+		},
+	}
+
+	require.EqualValues(t, 2, pkg.numPassiveAssets(0))
+	require.EqualValues(t, 0, pkg.numPassiveAssets(2))
+	require.EqualValues(t, 1, pkg.numPassiveAssets(4))
+
+	carrierOut, err := pkg.passiveAssetsOutput(4)
+	require.NoError(t, err)
+	require.Same(t, pkg.VirtualPackets[2].Outputs[0], carrierOut)
+
+	carrierOut, err = pkg.passiveAssetsOutput(0)
+	require.NoError(t, err)
+	require.Same(t, pkg.VirtualPackets[0].Outputs[0], carrierOut)
+
+	_, err = pkg.passiveAssetsOutput(2)
+	require.Error(t, err)
+
+	// All outputs of all packets are anchor outputs, any other output of
+	// the anchor transaction isn't.
+	for idx := uint32(0); idx < 6; idx++ {
+		require.True(t, pkg.isAnchorOutput(idx))
+	}
+	require.False(t, pkg.isAnchorOutput(6))
+}
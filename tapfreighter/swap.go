@@ -0,0 +1,799 @@
+package tapfreighter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrSwapPassiveAssets is returned if an input of our side of an
+	// atomic swap is anchored in an output that also carries other
+	// (passive) assets. The anchor outputs of a swap must be verifiable by
+	// the counterparty, so we can't re-anchor passive assets in them.
+	ErrSwapPassiveAssets = errors.New("swap inputs must not be anchored " +
+		"together with passive assets")
+
+	// ErrSwapExpectationNotMet is returned if the virtual transactions or
+	// the anchor transaction of an atomic swap don't pay us what we
+	// expect.
+	ErrSwapExpectationNotMet = errors.New("swap expectation not met")
+)
+
+// SwapBtcOutput is a plain BTC output of an atomic swap anchor transaction,
+// for example the payment for the assets in an asset for BTC swap.
+type SwapBtcOutput struct {
+	// TxOut is the BTC output.
+	TxOut *wire.TxOut
+
+	// InternalKey is the BIP-0086 internal key of the output. This must be
+	// set for P2TR outputs, so the exclusion proofs of the asset transfers
+	// can show that the output doesn't commit to any assets.
+	InternalKey *btcec.PublicKey
+}
+
+// SwapParams holds the information one party of an atomic swap needs to
+// create, verify and sign the shared BTC level anchor transaction. Both
+// parties fund and sign their virtual transactions independently, with
+// distinct anchor output indexes. The virtual transactions are then exchanged
+// and anchored in a single BTC transaction, which is only signed by a party
+// once it verified that the transaction pays it what it expects.
+type SwapParams struct {
+	// LocalVPkt is our signed virtual transaction. This can be nil if we
+	// don't give any assets in the swap (e.g. in an asset for BTC swap).
+	LocalVPkt *tappsbt.VPacket
+
+	// LocalInputCommitments are the Taproot Asset commitments of the
+	// anchor outputs spent by our virtual transaction.
+	LocalInputCommitments tappsbt.InputCommitments
+
+	// RemoteVPkts are the signed virtual transactions of the counterparty.
+	RemoteVPkts []*tappsbt.VPacket
+
+	// ExpectedAddrs are the Taproot Asset addresses we gave to the
+	// counterparty. Each of them must be paid by one of the counterparty's
+	// virtual transactions.
+	ExpectedAddrs []*address.Tap
+
+	// ExpectedBtcOutputs are the plain BTC outputs we expect the anchor
+	// transaction to pay us.
+	ExpectedBtcOutputs []*SwapBtcOutput
+
+	// BtcOutputs are the plain BTC outputs we pay to the counterparty.
+	// These are only added when creating the anchor transaction.
+	BtcOutputs []*SwapBtcOutput
+
+	// ChangeOutput is the output the BTC of the anchor inputs spent by
+	// our virtual transaction must be returned to. This is required when
+	// signing the anchor transaction created by the counterparty, so we
+	// never sign away the BTC of our anchor inputs. The value is ignored,
+	// the output must pay at least the value of our anchor inputs.
+	ChangeOutput *SwapBtcOutput
+
+	// RemoteChangeOutput is the output the BTC of the anchor inputs spent
+	// by the counterparty's virtual transactions is returned to. This is
+	// only used when creating the anchor transaction. The value is
+	// ignored, the output pays the value of the counterparty's anchor
+	// inputs.
+	RemoteChangeOutput *SwapBtcOutput
+
+	// FeeRate is the fee rate used to fund the anchor transaction. This is
+	// only used when creating the anchor transaction.
+	FeeRate chainfee.SatPerKWeight
+}
+
+// vPackets returns all virtual transactions of the swap, starting with our
+// own one, if we have one.
+func (p *SwapParams) vPackets() []*tappsbt.VPacket {
+	var vPkts []*tappsbt.VPacket
+	if p.LocalVPkt != nil {
+		vPkts = append(vPkts, p.LocalVPkt)
+	}
+
+	return append(vPkts, p.RemoteVPkts...)
+}
+
+// swapAnchors describes the anchor outputs of all virtual transactions of a
+// swap.
+type swapAnchors struct {
+	// pkScripts are the expected output scripts of the anchor outputs,
+	// keyed by their index in the anchor transaction.
+	pkScripts map[uint32][]byte
+
+	// commitments are the Taproot Asset commitments of the anchor
+	// outputs, keyed by their index in the anchor transaction.
+	commitments map[uint32]*commitment.TapCommitment
+}
+
+// CreateSwapAnchor creates the BTC level anchor transaction for an atomic swap
+// that commits to all the given virtual transactions and pays the given BTC
+// outputs. The transaction is funded by our wallet, which also pays the chain
+// fees. The BTC of the anchor inputs of each party is returned to that party's
+// change output. Once the virtual transactions of the counterparty are
+// verified against our expectations, all inputs of the transaction except the
+// ones spent by the counterparty are signed. The returned PSBT must then be
+// signed by the counterparty with SignSwapAnchor.
+func (f *AssetWallet) CreateSwapAnchor(ctx context.Context,
+	params *SwapParams) (*psbt.Packet, error) {
+
+	// Our own anchor input value goes to the change output of our wallet,
+	// so we don't expect a separate change output.
+	if params.ChangeOutput != nil {
+		return nil, fmt.Errorf("change output is only used when " +
+			"signing the counterparty's anchor transaction")
+	}
+
+	anchors, err := f.verifySwapPackets(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct our template PSBT with dummy outputs for all the anchor
+	// outputs, followed by the BTC outputs we pay.
+	var vOutputs []*tappsbt.VOutput
+	for _, vPkt := range params.vPackets() {
+		vOutputs = append(vOutputs, vPkt.Outputs...)
+	}
+	templatePkt, err := tapscript.CreateAnchorTx(vOutputs)
+	if err != nil {
+		return nil, fmt.Errorf("error creating anchor TX: %w", err)
+	}
+
+	for _, btcOut := range params.BtcOutputs {
+		var pOut psbt.POutput
+		if btcOut.InternalKey != nil {
+			pOut.TaprootInternalKey = schnorr.SerializePubKey(
+				btcOut.InternalKey,
+			)
+		}
+
+		templatePkt.UnsignedTx.AddTxOut(btcOut.TxOut)
+		templatePkt.Outputs = append(templatePkt.Outputs, pOut)
+	}
+
+	// We need a copy of the template outputs, as the wallet might re-order
+	// the outputs when funding the PSBT.
+	anchorPkt, err := copyPsbt(templatePkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+
+	fundedPkt, err := f.cfg.Wallet.FundPsbt(
+		ctx, templatePkt, 1, params.FeeRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fund psbt: %w", err)
+	}
+
+	anchorPkt, err = assembleSwapAnchor(
+		anchorPkt, &fundedPkt, params, anchors,
+		f.cfg.ChainParams.Params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We verify the final transaction the same way the counterparty will,
+	// to make sure we don't hand out anything they'd refuse to sign.
+	if err := verifySwapAnchor(anchorPkt, params, anchors); err != nil {
+		return nil, err
+	}
+
+	// We sign all inputs except for the ones the counterparty spends.
+	remoteInputs := swapInputOutPoints(params.RemoteVPkts...)
+	isLocal := func(op wire.OutPoint) bool {
+		_, isRemote := remoteInputs[op]
+		return !isRemote
+	}
+
+	return f.signSwapAnchorInputs(ctx, anchorPkt, isLocal)
+}
+
+// SignSwapAnchor verifies the BTC level anchor transaction of an atomic swap
+// created by the counterparty and, if it commits to all the virtual
+// transactions, returns the BTC of our anchor inputs to our change output and
+// pays us what we expect, signs the anchor inputs spent by our virtual
+// transaction.
+func (f *AssetWallet) SignSwapAnchor(ctx context.Context,
+	anchorPkt *psbt.Packet, params *SwapParams) (*psbt.Packet, error) {
+
+	if params.LocalVPkt == nil {
+		return nil, fmt.Errorf("no local virtual transaction to sign " +
+			"the anchor inputs for")
+	}
+
+	// Without a change output we can't tell whether the BTC of our anchor
+	// inputs goes to an output we control.
+	if params.ChangeOutput == nil {
+		return nil, fmt.Errorf("change output for the anchor inputs " +
+			"must be specified")
+	}
+
+	anchors, err := f.verifySwapPackets(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifySwapAnchor(anchorPkt, params, anchors); err != nil {
+		return nil, err
+	}
+
+	localInputs := swapInputOutPoints(params.LocalVPkt)
+	isLocal := func(op wire.OutPoint) bool {
+		_, ok := localInputs[op]
+		return ok
+	}
+
+	return f.signSwapAnchorInputs(ctx, anchorPkt, isLocal)
+}
+
+// FinalizeSwapAnchor verifies the fully signed BTC level anchor transaction of
+// an atomic swap, finalizes it and returns the anchor transaction information
+// required to log and broadcast our side of the transfer.
+func (f *AssetWallet) FinalizeSwapAnchor(ctx context.Context,
+	anchorPkt *psbt.Packet, params *SwapParams) (*AnchorTransaction,
+	error) {
+
+	anchors, err := f.verifySwapPackets(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifySwapAnchor(anchorPkt, params, anchors); err != nil {
+		return nil, err
+	}
+
+	// We keep a copy of the PSBT with all the output information intact
+	// for the exclusion proofs.
+	fundedPkt, err := copyPsbt(anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+
+	chainFees, err := tapgarden.GetTxFee(anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for psbt: "+
+			"%w", err)
+	}
+
+	signedPkt, err := copyPsbt(anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+
+	err = psbt.MaybeFinalizeAll(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to finalize psbt, not all "+
+			"inputs signed: %w", err)
+	}
+
+	finalTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(finalTx))
+	if err != nil {
+		return nil, fmt.Errorf("anchor TX failed final checks: %w", err)
+	}
+
+	return &AnchorTransaction{
+		FundedPsbt: &tapgarden.FundedPsbt{
+			Pkt:               fundedPkt,
+			ChangeOutputIndex: -1,
+			ChainFees:         chainFees,
+		},
+		FinalTx:           finalTx,
+		ChainFees:         chainFees,
+		OutputCommitments: anchors.commitments,
+	}, nil
+}
+
+// assembleSwapAnchor adds the wallet inputs of the funded template PSBT and
+// the anchor inputs of all virtual transactions to the anchor PSBT of a swap,
+// which must contain all anchor outputs and the BTC outputs we pay. The BTC of
+// the counterparty's anchor inputs is returned to its change output, while
+// the BTC of our own anchor inputs goes to the change output of our wallet,
+// which is added as the last output and pays the chain fees.
+func assembleSwapAnchor(anchorPkt *psbt.Packet,
+	fundedPkt *tapgarden.FundedPsbt, params *SwapParams,
+	anchors *swapAnchors, chainParams *chaincfg.Params) (*psbt.Packet,
+	error) {
+
+	// We need a change output to pay for the anchor outputs and fees, so
+	// we can adjust its value once all the anchor inputs are added.
+	changeIndex := fundedPkt.ChangeOutputIndex
+	if changeIndex < 0 {
+		return nil, fmt.Errorf("funded swap anchor TX has no change " +
+			"output")
+	}
+
+	anchorPkt.UnsignedTx.TxIn = fundedPkt.Pkt.UnsignedTx.TxIn
+	anchorPkt.Inputs = fundedPkt.Pkt.Inputs
+
+	// The counterparty gets the BTC of its anchor inputs back, so we never
+	// take more than we put in.
+	remoteInputValue := anchorInputValue(params.RemoteVPkts...)
+	if remoteInputValue > 0 {
+		remoteChange := params.RemoteChangeOutput
+		if remoteChange == nil {
+			return nil, fmt.Errorf("counterparty change output " +
+				"for its anchor inputs must be specified")
+		}
+
+		var pOut psbt.POutput
+		if remoteChange.InternalKey != nil {
+			pOut.TaprootInternalKey = schnorr.SerializePubKey(
+				remoteChange.InternalKey,
+			)
+		}
+
+		anchorPkt.UnsignedTx.AddTxOut(&wire.TxOut{
+			Value:    remoteInputValue,
+			PkScript: remoteChange.TxOut.PkScript,
+		})
+		anchorPkt.Outputs = append(anchorPkt.Outputs, pOut)
+	}
+
+	// Only the BTC of our own anchor inputs goes to our change output, the
+	// fee is adjusted when adding the anchor inputs below.
+	changeOut := fundedPkt.Pkt.UnsignedTx.TxOut[changeIndex]
+	localInputValue := int64(0)
+	if params.LocalVPkt != nil {
+		localInputValue = anchorInputValue(params.LocalVPkt)
+	}
+	anchorPkt.UnsignedTx.AddTxOut(&wire.TxOut{
+		Value:    changeOut.Value + localInputValue,
+		PkScript: changeOut.PkScript,
+	})
+	anchorPkt.Outputs = append(
+		anchorPkt.Outputs, fundedPkt.Pkt.Outputs[changeIndex],
+	)
+
+	for idx, pkScript := range anchors.pkScripts {
+		anchorPkt.UnsignedTx.TxOut[idx].PkScript = pkScript
+	}
+
+	// The fee is re-adjusted each time the anchor inputs of a virtual
+	// transaction are added. We add the counterparty's inputs first, which
+	// balance its change output, so each intermediate adjustment only ever
+	// takes the value of our own anchor inputs from our change output.
+	vPkts := append([]*tappsbt.VPacket{}, params.RemoteVPkts...)
+	if params.LocalVPkt != nil {
+		vPkts = append(vPkts, params.LocalVPkt)
+	}
+	for _, vPkt := range vPkts {
+		err := addAnchorPsbtInputs(
+			anchorPkt, vPkt, params.FeeRate, chainParams,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error adding anchor input: %w",
+				err)
+		}
+	}
+
+	return anchorPkt, nil
+}
+
+// verifySwapPackets verifies all virtual transactions of a swap, makes sure
+// the counterparty's transactions pay to all our expected addresses and
+// returns the anchor outputs that the anchor transaction must contain.
+func (f *AssetWallet) verifySwapPackets(ctx context.Context,
+	params *SwapParams) (*swapAnchors, error) {
+
+	vPkts := params.vPackets()
+	if len(vPkts) == 0 {
+		return nil, fmt.Errorf("no virtual transactions in swap")
+	}
+
+	// The counterparty can't verify our anchor outputs if they contain
+	// passive assets they don't know about.
+	if params.LocalVPkt != nil {
+		if len(params.LocalInputCommitments) == 0 {
+			return nil, fmt.Errorf("missing local input " +
+				"commitments")
+		}
+
+		for idx := range params.LocalInputCommitments {
+			passiveCommitments, err := removeActiveCommitments(
+				params.LocalInputCommitments[idx],
+				params.LocalVPkt,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(passiveCommitments) > 0 {
+				return nil, ErrSwapPassiveAssets
+			}
+		}
+	}
+
+	// Each anchor output can only be spent once, so we don't allow
+	// multiple inputs with the same anchor outpoint.
+	numInputs := 0
+	for _, vPkt := range vPkts {
+		numInputs += len(vPkt.Inputs)
+	}
+	if len(swapInputOutPoints(vPkts...)) != numInputs {
+		return nil, fmt.Errorf("swap inputs must be anchored in " +
+			"distinct outputs")
+	}
+
+	for pktIdx, vPkt := range vPkts {
+		if len(vPkt.Inputs) == 0 || len(vPkt.Outputs) == 0 {
+			return nil, fmt.Errorf("virtual transaction %d has no "+
+				"inputs or outputs", pktIdx)
+		}
+
+		for idx := range vPkt.Inputs {
+			err := verifyInclusionProof(vPkt.Inputs[idx])
+			if err != nil {
+				return nil, fmt.Errorf("unable to verify "+
+					"inclusion proof of virtual "+
+					"transaction %d: %w", pktIdx, err)
+			}
+		}
+
+		for idx := range vPkt.Outputs {
+			if vPkt.Outputs[idx].Asset == nil {
+				return nil, fmt.Errorf("output %d of virtual "+
+					"transaction %d has no asset", idx,
+					pktIdx)
+			}
+		}
+
+		err := tapscript.VerifyVirtualTransaction(
+			vPkt, f.cfg.TxValidator,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid virtual transaction "+
+				"%d: %w", pktIdx, err)
+		}
+	}
+
+	for _, addr := range params.ExpectedAddrs {
+		if !swapPaysAddr(params.RemoteVPkts, addr) {
+			return nil, fmt.Errorf("%w: no output pays %d units "+
+				"of asset %v to script key %x",
+				ErrSwapExpectationNotMet, addr.Amount,
+				addr.AssetID,
+				addr.ScriptKey.SerializeCompressed())
+		}
+	}
+
+	return swapAnchorOutputs(vPkts)
+}
+
+// swapPaysAddr returns true if one of the given virtual transactions has an
+// output that pays the given address.
+func swapPaysAddr(vPkts []*tappsbt.VPacket, addr *address.Tap) bool {
+	addrSibling, err := siblingTapHash(addr.TapscriptSibling)
+	if err != nil {
+		return false
+	}
+
+	for _, vPkt := range vPkts {
+		for _, vOut := range vPkt.Outputs {
+			outSibling, err := siblingTapHash(
+				vOut.AnchorOutputTapscriptSibling,
+			)
+			if err != nil {
+				return false
+			}
+
+			switch {
+			case vOut.Asset.ID() != addr.AssetID:
+			case vOut.Amount != addr.Amount:
+			case !vOut.ScriptKey.PubKey.IsEqual(&addr.ScriptKey):
+			case !vOut.AnchorOutputInternalKey.IsEqual(
+				&addr.InternalKey,
+			):
+			case outSibling != addrSibling:
+
+			default:
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// siblingTapHash returns the tap hash of the given optional tapscript sibling
+// or the zero hash if there is no sibling.
+func siblingTapHash(
+	sibling *commitment.TapscriptPreimage) (chainhash.Hash, error) {
+
+	if sibling == nil {
+		return chainhash.Hash{}, nil
+	}
+
+	siblingHash, err := sibling.TapHash()
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	return *siblingHash, nil
+}
+
+// swapAnchorOutputs computes the Taproot Asset commitments and output scripts
+// of the anchor outputs of all the given virtual transactions. Because the
+// inputs of a swap don't carry any passive assets, the anchor outputs only
+// depend on the virtual transactions themselves and can be computed by both
+// parties.
+func swapAnchorOutputs(vPkts []*tappsbt.VPacket) (*swapAnchors, error) {
+	anchors := &swapAnchors{
+		pkScripts:   make(map[uint32][]byte),
+		commitments: make(map[uint32]*commitment.TapCommitment),
+	}
+
+	for pktIdx, vPkt := range vPkts {
+		// Virtual transactions can't share anchor outputs, otherwise
+		// one party could hide assets in the other party's outputs.
+		for _, vOut := range vPkt.Outputs {
+			_, ok := anchors.commitments[vOut.AnchorOutputIndex]
+			if ok {
+				return nil, fmt.Errorf("anchor output %d used "+
+					"by multiple virtual transactions",
+					vOut.AnchorOutputIndex)
+			}
+		}
+
+		inputAssets := make([]*asset.Asset, len(vPkt.Inputs))
+		for idx := range vPkt.Inputs {
+			inputAssets[idx] = vPkt.Inputs[idx].Asset()
+		}
+		inputCommitment, err := commitment.FromAssets(inputAssets...)
+		if err != nil {
+			return nil, err
+		}
+
+		outputCommitments, err := tapscript.CreateOutputCommitments(
+			tappsbt.InputCommitments{0: inputCommitment}, vPkt,
+			nil,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create output "+
+				"commitments of virtual transaction %d: %w",
+				pktIdx, err)
+		}
+
+		// Merge the commitments of all outputs that share an anchor
+		// output first, then compute the final output scripts.
+		pktCommitments := make(map[uint32]*commitment.TapCommitment)
+		for idx, vOut := range vPkt.Outputs {
+			anchorIdx := vOut.AnchorOutputIndex
+			outCommitment := outputCommitments[idx]
+			anchorCommitment, ok := pktCommitments[anchorIdx]
+			if !ok {
+				pktCommitments[anchorIdx] = outCommitment
+				continue
+			}
+
+			err := anchorCommitment.Merge(outCommitment)
+			if err != nil {
+				return nil, fmt.Errorf("cannot merge output "+
+					"commitments: %w", err)
+			}
+		}
+
+		for _, vOut := range vPkt.Outputs {
+			anchorIdx := vOut.AnchorOutputIndex
+			siblingHash, err := siblingTapHash(
+				vOut.AnchorOutputTapscriptSibling,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			var sibling *chainhash.Hash
+			if vOut.AnchorOutputTapscriptSibling != nil {
+				sibling = &siblingHash
+			}
+
+			anchorCommitment := pktCommitments[anchorIdx]
+			pkScript, err := tapscript.PayToAddrScript(
+				*vOut.AnchorOutputInternalKey, sibling,
+				*anchorCommitment,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			anchors.pkScripts[anchorIdx] = pkScript
+			anchors.commitments[anchorIdx] = anchorCommitment
+		}
+	}
+
+	return anchors, nil
+}
+
+// verifySwapAnchor verifies that the given anchor transaction spends all inputs
+// of the swap's virtual transactions, contains all the anchor outputs, returns
+// the BTC of our anchor inputs to our change output and pays the BTC outputs we
+// expect. Each expected output must be paid by a distinct output.
+func verifySwapAnchor(anchorPkt *psbt.Packet, params *SwapParams,
+	anchors *swapAnchors) error {
+
+	tx := anchorPkt.UnsignedTx
+	if len(anchorPkt.Inputs) != len(tx.TxIn) ||
+		len(anchorPkt.Outputs) != len(tx.TxOut) {
+
+		return fmt.Errorf("invalid anchor PSBT")
+	}
+
+	// If any of the virtual transactions' inputs isn't spent, the assets
+	// of that virtual transaction wouldn't move, so the swap wouldn't be
+	// atomic.
+	txInputs := make(map[wire.OutPoint]struct{}, len(tx.TxIn))
+	for _, txIn := range tx.TxIn {
+		txInputs[txIn.PreviousOutPoint] = struct{}{}
+	}
+	for op := range swapInputOutPoints(params.vPackets()...) {
+		if _, ok := txInputs[op]; !ok {
+			return fmt.Errorf("anchor TX doesn't spend virtual "+
+				"transaction input %v", op)
+		}
+	}
+
+	for idx, pkScript := range anchors.pkScripts {
+		if idx >= uint32(len(tx.TxOut)) ||
+			!bytes.Equal(tx.TxOut[idx].PkScript, pkScript) {
+
+			return fmt.Errorf("anchor TX output %d doesn't commit "+
+				"to virtual transaction outputs", idx)
+		}
+	}
+
+	// All other P2TR outputs must be provably free of any assets, so the
+	// asset proofs can contain valid exclusion proofs for them.
+	for idx, txOut := range tx.TxOut {
+		if _, ok := anchors.pkScripts[uint32(idx)]; ok {
+			continue
+		}
+
+		if !txscript.IsPayToTaproot(txOut.PkScript) {
+			continue
+		}
+
+		internalKey, err := schnorr.ParsePubKey(
+			anchorPkt.Outputs[idx].TaprootInternalKey,
+		)
+		if err != nil {
+			return fmt.Errorf("P2TR output %d has invalid "+
+				"internal key: %w", idx, err)
+		}
+
+		pkScript, err := tapscript.PayToTaprootScript(
+			txscript.ComputeTaprootKeyNoScript(internalKey),
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(anchorPkt.Outputs[idx].TaprootTapTree) > 0 ||
+			!bytes.Equal(pkScript, txOut.PkScript) {
+
+			return fmt.Errorf("P2TR output %d is not a BIP-0086 "+
+				"output", idx)
+		}
+	}
+
+	var expectedOutputs []*wire.TxOut
+	for _, expected := range params.ExpectedBtcOutputs {
+		expectedOutputs = append(expectedOutputs, expected.TxOut)
+	}
+
+	// The BTC of our anchor inputs must be returned to us, otherwise the
+	// counterparty could take it by sending it to an output it controls.
+	if params.LocalVPkt != nil && params.ChangeOutput != nil {
+		expectedOutputs = append(expectedOutputs, &wire.TxOut{
+			Value:    anchorInputValue(params.LocalVPkt),
+			PkScript: params.ChangeOutput.TxOut.PkScript,
+		})
+	}
+
+	claimed := make(map[int]struct{}, len(expectedOutputs))
+	for _, expectedOut := range expectedOutputs {
+		found := false
+		for idx, txOut := range tx.TxOut {
+			if _, ok := anchors.pkScripts[uint32(idx)]; ok {
+				continue
+			}
+			if _, ok := claimed[idx]; ok {
+				continue
+			}
+
+			if bytes.Equal(txOut.PkScript, expectedOut.PkScript) &&
+				txOut.Value >= expectedOut.Value {
+
+				claimed[idx] = struct{}{}
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("%w: no output pays %d sats to "+
+				"script %x", ErrSwapExpectationNotMet,
+				expectedOut.Value, expectedOut.PkScript)
+		}
+	}
+
+	return nil
+}
+
+// swapInputOutPoints returns the set of anchor outpoints spent by the given
+// virtual transactions.
+func swapInputOutPoints(
+	vPkts ...*tappsbt.VPacket) map[wire.OutPoint]struct{} {
+
+	outPoints := make(map[wire.OutPoint]struct{})
+	for _, vPkt := range vPkts {
+		for _, vIn := range vPkt.Inputs {
+			outPoints[vIn.PrevID.OutPoint] = struct{}{}
+		}
+	}
+
+	return outPoints
+}
+
+// signSwapAnchorInputs signs the inputs of the anchor PSBT for which the given
+// function returns true. The derivation information of all other inputs is
+// removed before handing the PSBT to the wallet, so we never sign anything
+// that isn't ours.
+func (f *AssetWallet) signSwapAnchorInputs(ctx context.Context,
+	anchorPkt *psbt.Packet,
+	signInput func(wire.OutPoint) bool) (*psbt.Packet, error) {
+
+	signPkt, err := copyPsbt(anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+
+	for idx := range signPkt.Inputs {
+		if signInput(signPkt.UnsignedTx.TxIn[idx].PreviousOutPoint) {
+			continue
+		}
+
+		signPkt.Inputs[idx].Bip32Derivation = nil
+		signPkt.Inputs[idx].TaprootBip32Derivation = nil
+	}
+
+	log.Debugf("Signing swap anchor PSBT")
+	signedPkt, err := f.cfg.Wallet.SignPsbt(ctx, signPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	resultPkt, err := copyPsbt(anchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+	}
+	for idx := range resultPkt.Inputs {
+		if signInput(resultPkt.UnsignedTx.TxIn[idx].PreviousOutPoint) {
+			resultPkt.Inputs[idx] = signedPkt.Inputs[idx]
+		}
+	}
+
+	return resultPkt, nil
+}
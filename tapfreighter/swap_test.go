@@ -0,0 +1,338 @@
+package tapfreighter
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapgarden"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVerifySwapAnchor tests that the anchor transaction of an atomic swap is
+// only accepted if it spends all virtual inputs, commits to all virtual
+// outputs and pays the expected BTC outputs.
+func TestVerifySwapAnchor(t *testing.T) {
+	t.Parallel()
+
+	vInOutPoint := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+	changeScript := append(
+		[]byte{txscript.OP_0, txscript.OP_DATA_20},
+		test.RandBytes(20)...,
+	)
+	params := &SwapParams{
+		LocalVPkt: &tappsbt.VPacket{
+			Inputs: []*tappsbt.VInput{{
+				PrevID: asset.PrevID{OutPoint: vInOutPoint},
+				Anchor: tappsbt.Anchor{Value: 5_000},
+			}},
+		},
+		ChangeOutput: &SwapBtcOutput{
+			TxOut: &wire.TxOut{PkScript: changeScript},
+		},
+	}
+
+	p2trScript := func() []byte {
+		key := test.RandPubKey(t)
+		pkScript, err := tapscript.PayToTaprootScript(
+			txscript.ComputeTaprootKeyNoScript(key),
+		)
+		require.NoError(t, err)
+
+		return pkScript
+	}
+
+	anchorScript := p2trScript()
+	anchors := &swapAnchors{
+		pkScripts: map[uint32][]byte{0: anchorScript},
+	}
+
+	btcKey := test.RandPubKey(t)
+	btcScript, err := tapscript.PayToTaprootScript(
+		txscript.ComputeTaprootKeyNoScript(btcKey),
+	)
+	require.NoError(t, err)
+
+	params.ExpectedBtcOutputs = []*SwapBtcOutput{{
+		TxOut: &wire.TxOut{Value: 10_000, PkScript: btcScript},
+	}}
+
+	// newAnchorPkt creates an anchor PSBT that is valid unless it is
+	// modified by the given function.
+	newAnchorPkt := func(modify func(*wire.MsgTx)) *psbt.Packet {
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: vInOutPoint})
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: wire.OutPoint{Index: 7},
+		})
+		tx.AddTxOut(&wire.TxOut{Value: 1_000, PkScript: anchorScript})
+		tx.AddTxOut(&wire.TxOut{Value: 10_000, PkScript: btcScript})
+		tx.AddTxOut(&wire.TxOut{Value: 5_000, PkScript: changeScript})
+
+		if modify != nil {
+			modify(tx)
+		}
+
+		pkt, err := psbt.NewFromUnsignedTx(tx)
+		require.NoError(t, err)
+
+		if len(pkt.Outputs) > 1 {
+			pkt.Outputs[1].TaprootInternalKey =
+				schnorr.SerializePubKey(btcKey)
+		}
+
+		return pkt
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(*wire.MsgTx)
+		err    string
+	}{{
+		name: "valid anchor",
+	}, {
+		name: "virtual input not spent",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxIn = tx.TxIn[1:]
+		},
+		err: "doesn't spend virtual transaction input",
+	}, {
+		name: "anchor output replaced",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxOut[0].PkScript = btcScript
+		},
+		err: "doesn't commit to virtual transaction outputs",
+	}, {
+		name: "P2TR output with hidden script tree",
+		modify: func(tx *wire.MsgTx) {
+			tx.AddTxOut(&wire.TxOut{
+				Value: 330, PkScript: p2trScript(),
+			})
+		},
+		err: "has invalid internal key",
+	}, {
+		name: "expected BTC output underpaid",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxOut[1].Value = 9_999
+		},
+		err: ErrSwapExpectationNotMet.Error(),
+	}, {
+		name: "anchor input BTC not returned",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxOut = tx.TxOut[:2]
+		},
+		err: ErrSwapExpectationNotMet.Error(),
+	}, {
+		name: "anchor input BTC partially returned",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxOut[2].Value = 4_999
+		},
+		err: ErrSwapExpectationNotMet.Error(),
+	}, {
+		name: "change output also counted as expected output",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxOut[1].PkScript = changeScript
+			tx.TxOut[2].Value = 10_000
+		},
+		err: ErrSwapExpectationNotMet.Error(),
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifySwapAnchor(
+				newAnchorPkt(tc.modify), params, anchors,
+			)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+// TestAssembleSwapAnchorBalances tests that the anchor transaction of an
+// atomic swap returns the BTC of each party's anchor inputs to that party, and
+// that the chain fees are only paid by the creator of the transaction.
+func TestAssembleSwapAnchorBalances(t *testing.T) {
+	t.Parallel()
+
+	p2trScript := func() ([]byte, *btcec.PublicKey) {
+		key := test.RandPubKey(t)
+		pkScript, err := tapscript.PayToTaprootScript(
+			txscript.ComputeTaprootKeyNoScript(key),
+		)
+		require.NoError(t, err)
+
+		return pkScript, key
+	}
+
+	// newVPkt creates a virtual packet with anchor inputs of the given
+	// values and a single output anchored at the given index.
+	newVPkt := func(anchorIdx uint32, values ...uint64) *tappsbt.VPacket {
+		vPkt := &tappsbt.VPacket{
+			Outputs: []*tappsbt.VOutput{{
+				AnchorOutputIndex: anchorIdx,
+			}},
+		}
+		for _, value := range values {
+			pkScript, internalKey := p2trScript()
+			vPkt.Inputs = append(vPkt.Inputs, &tappsbt.VInput{
+				PrevID: asset.PrevID{
+					OutPoint: test.RandOp(t),
+				},
+				Anchor: tappsbt.Anchor{
+					Value:       btcutil.Amount(value),
+					PkScript:    pkScript,
+					InternalKey: internalKey,
+				},
+			})
+		}
+
+		return vPkt
+	}
+
+	localVPkt := newVPkt(0, 1_000)
+	remoteVPkt := newVPkt(1, 50_000, 20_000)
+
+	remoteChangeScript, remoteChangeKey := p2trScript()
+	params := &SwapParams{
+		LocalVPkt:   localVPkt,
+		RemoteVPkts: []*tappsbt.VPacket{remoteVPkt},
+		RemoteChangeOutput: &SwapBtcOutput{
+			TxOut:       &wire.TxOut{PkScript: remoteChangeScript},
+			InternalKey: remoteChangeKey,
+		},
+		FeeRate: chainfee.FeePerKwFloor,
+	}
+
+	anchorScripts := make([][]byte, 2)
+	anchors := &swapAnchors{
+		pkScripts: make(map[uint32][]byte),
+	}
+	for idx := range anchorScripts {
+		anchorScripts[idx], _ = p2trScript()
+		anchors.pkScripts[uint32(idx)] = anchorScripts[idx]
+	}
+
+	// The template contains the two anchor outputs and a BTC payment to
+	// the counterparty. The wallet funds it with a single input and adds
+	// a change output.
+	payScript, payKey := p2trScript()
+	newTemplate := func() *psbt.Packet {
+		tx := wire.NewMsgTx(2)
+		for range anchorScripts {
+			tx.AddTxOut(&wire.TxOut{
+				Value:    1_000,
+				PkScript: anchorScripts[0],
+			})
+		}
+		tx.AddTxOut(&wire.TxOut{Value: 30_000, PkScript: payScript})
+
+		pkt, err := psbt.NewFromUnsignedTx(tx)
+		require.NoError(t, err)
+		pkt.Outputs[2].TaprootInternalKey = schnorr.SerializePubKey(
+			payKey,
+		)
+
+		return pkt
+	}
+
+	const walletInputValue = 100_000
+	walletChangeScript, walletChangeKey := p2trScript()
+	fundedPkt := newTemplate()
+	fundedPkt.UnsignedTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	fundedPkt.Inputs = append(fundedPkt.Inputs, psbt.PInput{
+		WitnessUtxo: &wire.TxOut{
+			Value:    walletInputValue,
+			PkScript: walletChangeScript,
+		},
+	})
+	fundedPkt.UnsignedTx.AddTxOut(&wire.TxOut{
+		Value:    walletInputValue - 32_000 - 500,
+		PkScript: walletChangeScript,
+	})
+	fundedPkt.Outputs = append(fundedPkt.Outputs, psbt.POutput{
+		TaprootInternalKey: schnorr.SerializePubKey(walletChangeKey),
+	})
+
+	anchorPkt, err := assembleSwapAnchor(
+		newTemplate(), &tapgarden.FundedPsbt{
+			Pkt:               fundedPkt,
+			ChangeOutputIndex: 3,
+		}, params, anchors, &chaincfg.RegressionNetParams,
+	)
+	require.NoError(t, err)
+
+	tx := anchorPkt.UnsignedTx
+	require.Len(t, tx.TxIn, 4)
+	require.Len(t, tx.TxOut, 5)
+
+	// The counterparty gets exactly the BTC of its anchor inputs back.
+	remoteChange := tx.TxOut[3]
+	require.Equal(t, remoteChangeScript, remoteChange.PkScript)
+	require.EqualValues(t, 70_000, remoteChange.Value)
+
+	// We pay the anchor outputs, the BTC payment and the chain fees from
+	// our wallet input and our own anchor input, nothing else.
+	var inputValue, outputValue int64
+	for _, pIn := range anchorPkt.Inputs {
+		inputValue += pIn.WitnessUtxo.Value
+	}
+	for _, txOut := range tx.TxOut {
+		outputValue += txOut.Value
+	}
+	chainFees := inputValue - outputValue
+	require.Positive(t, chainFees)
+
+	localChange := tx.TxOut[4]
+	require.Equal(t, walletChangeScript, localChange.PkScript)
+	require.EqualValues(
+		t, walletInputValue+1_000-32_000-chainFees, localChange.Value,
+	)
+
+	// The counterparty accepts the transaction, as it gets the BTC of its
+	// anchor inputs back to its change output.
+	remoteParams := &SwapParams{
+		LocalVPkt:    remoteVPkt,
+		RemoteVPkts:  []*tappsbt.VPacket{localVPkt},
+		ChangeOutput: params.RemoteChangeOutput,
+	}
+	require.NoError(t, verifySwapAnchor(anchorPkt, remoteParams, anchors))
+
+	// Had we sent the counterparty's BTC to our own change output, it
+	// would refuse to sign.
+	tx.TxOut[4].Value += remoteChange.Value
+	tx.TxOut[3].Value = 0
+	require.ErrorIs(
+		t, verifySwapAnchor(anchorPkt, remoteParams, anchors),
+		ErrSwapExpectationNotMet,
+	)
+
+	// We can't create the anchor transaction without knowing where to
+	// return the counterparty's BTC to.
+	params.RemoteChangeOutput = nil
+	_, err = assembleSwapAnchor(
+		newTemplate(), &tapgarden.FundedPsbt{
+			Pkt:               fundedPkt,
+			ChangeOutputIndex: 3,
+		}, params, anchors, &chaincfg.RegressionNetParams,
+	)
+	require.ErrorContains(t, err, "counterparty change output")
+}
@@ -5,13 +5,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sort"
 	"sync"
 	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -64,6 +64,11 @@ var (
 	// assets of an anchor output, which is not supported.
 	ErrFullBurnNotSupported = errors.New("burning all assets of an " +
 		"anchor output is not supported")
+
+	// ErrNothingToConsolidate is returned when there are fewer than two
+	// asset coins that could be merged by a consolidation.
+	ErrNothingToConsolidate = errors.New("at least two asset coins are " +
+		"required for a consolidation")
 )
 
 // AnchorTransaction is a type that holds all information about a BTC level
@@ -98,22 +103,47 @@ type Wallet interface {
 	// spend in order to pay the given address. It also returns supporting
 	// data which assists in processing the virtual transaction: passive
 	// asset re-anchors and the Taproot Asset level commitment of the
-	// selected assets.
+	// selected assets. The given strategy is used to select the assets.
 	FundAddressSend(ctx context.Context,
+		strategy MultiCommitmentSelectStrategy,
 		receiverAddrs ...*address.Tap) (*FundedVPacket,
 		tappsbt.OutputIdxToAddr, error)
 
+	// FundBatchSend funds one virtual transaction for each distinct asset
+	// ID of the given addresses. The assets to spend are selected for all
+	// virtual transactions at once, so they can spend from the same anchor
+	// outputs. The anchor output indexes of the virtual transactions don't
+	// overlap, so they can all be anchored in a single BTC level
+	// transaction. The returned output index to address maps
+	// belong to the virtual transaction with the same index. The given
+	// strategy is used to select the assets.
+	FundBatchSend(ctx context.Context,
+		strategy MultiCommitmentSelectStrategy,
+		receiverAddrs ...*address.Tap) ([]*FundedVPacket,
+		[]tappsbt.OutputIdxToAddr, error)
+
 	// FundPacket funds a virtual transaction, selecting assets to spend
-	// in order to pay the given recipient. The selected input is then added
-	// to the given virtual transaction.
+	// in order to pay the given recipient using the given strategy. The
+	// selected input is then added to the given virtual transaction.
 	FundPacket(ctx context.Context, fundDesc *tapscript.FundingDescriptor,
-		vPkt *tappsbt.VPacket) (*FundedVPacket, error)
+		vPkt *tappsbt.VPacket,
+		strategy MultiCommitmentSelectStrategy) (*FundedVPacket, error)
 
 	// FundBurn funds a virtual transaction for burning the given amount of
 	// units of the given asset.
 	FundBurn(ctx context.Context,
 		fundDesc *tapscript.FundingDescriptor) (*FundedVPacket, error)
 
+	// FundConsolidation funds one virtual transaction for each asset ID
+	// of up to the given maximum number of asset coins that satisfy the
+	// given constraints, each of which merges the coins of its asset ID
+	// into a single output to ourselves. The anchor output indexes of the
+	// virtual transactions don't overlap, so they can all be anchored in a
+	// single BTC level transaction.
+	FundConsolidation(ctx context.Context,
+		constraints CommitmentConstraints,
+		maxInputs int) ([]*FundedVPacket, error)
+
 	// SignVirtualPacket signs the virtual transaction of the given packet
 	// and returns the input indexes that were signed.
 	SignVirtualPacket(vPkt *tappsbt.VPacket,
@@ -126,6 +156,29 @@ type Wallet interface {
 		inputCommitments tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
 		error)
 
+	// CreatePassiveAssets creates the unsigned passive asset packets for
+	// the given input commitment and virtual packet that contains the
+	// active asset transfer.
+	CreatePassiveAssets(vPkt *tappsbt.VPacket,
+		inputCommitments tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
+		error)
+
+	// CommitVirtualTransactions creates and funds a BTC level anchor
+	// transaction that anchors all the virtual transactions of the given
+	// packets, without signing it. The returned PSBT can be signed by an
+	// external signer and then be passed to FinalizeAnchorTransaction.
+	CommitVirtualTransactions(ctx context.Context,
+		params *AnchorVTxnsParams) (*tapgarden.FundedPsbt, error)
+
+	// FinalizeAnchorTransaction verifies and finalizes an externally
+	// signed BTC level anchor transaction that was created by
+	// CommitVirtualTransactions for the same packets. The change output
+	// index must be the one returned by CommitVirtualTransactions, or -1
+	// if there is no change output.
+	FinalizeAnchorTransaction(ctx context.Context, anchorPkt *psbt.Packet,
+		changeIndex int32, params *AnchorVTxnsParams) (
+		*AnchorTransaction, error)
+
 	// AnchorVirtualTransactions creates a BTC level anchor transaction that
 	// anchors all the virtual transactions of the given packets (for both
 	// sending and passive asset re-anchoring).
@@ -141,6 +194,51 @@ type Wallet interface {
 	// owned asset. The ownership proof consists of a valid witness of a
 	// signed virtual packet that spends the asset fully to the NUMS key.
 	SignOwnershipProof(ownedAsset *asset.Asset) (wire.TxWitness, error)
+
+	// MuSig2CreateSession creates a new MuSig2 signing session for the
+	// aggregate script key of the given signers (which must include our
+	// local key) and the optional tapscript root.
+	MuSig2CreateSession(ctx context.Context, localKey keychain.KeyLocator,
+		signers []*btcec.PublicKey, tapscriptRoot []byte,
+		otherNonces [][musig2.PubNonceSize]byte) (*MuSig2Session, error)
+
+	// MuSig2RegisterNonces registers the public nonces of other signers
+	// with the given MuSig2 session and returns true if all nonces are
+	// now known.
+	MuSig2RegisterNonces(ctx context.Context, sessionID [32]byte,
+		nonces [][musig2.PubNonceSize]byte) (bool, error)
+
+	// MuSig2SignVirtualPacket creates our partial MuSig2 signature for the
+	// input with the given index of the virtual packet.
+	MuSig2SignVirtualPacket(ctx context.Context, sessionID [32]byte,
+		vPkt *tappsbt.VPacket, inputIdx int, cleanup bool) ([]byte,
+		error)
+
+	// MuSig2CombineVirtualPacket combines our partial MuSig2 signature
+	// with the ones of the other signers into the witness of the input
+	// with the given index, signs all other inputs and returns the input
+	// indexes that were signed.
+	MuSig2CombineVirtualPacket(ctx context.Context, sessionID [32]byte,
+		vPkt *tappsbt.VPacket, inputIdx int,
+		otherPartialSigs [][]byte) ([]uint32, error)
+
+	// CreateSwapAnchor creates, funds and partially signs the BTC level
+	// anchor transaction of an atomic swap that commits to the virtual
+	// transactions of both parties, after verifying the counterparty's
+	// virtual transactions against our expectations.
+	CreateSwapAnchor(ctx context.Context,
+		params *SwapParams) (*psbt.Packet, error)
+
+	// SignSwapAnchor verifies the anchor transaction of an atomic swap
+	// created by the counterparty and signs the anchor inputs of our
+	// virtual transaction.
+	SignSwapAnchor(ctx context.Context, anchorPkt *psbt.Packet,
+		params *SwapParams) (*psbt.Packet, error)
+
+	// FinalizeSwapAnchor verifies and finalizes the fully signed anchor
+	// transaction of an atomic swap.
+	FinalizeSwapAnchor(ctx context.Context, anchorPkt *psbt.Packet,
+		params *SwapParams) (*AnchorTransaction, error)
 }
 
 // AddrBook is an interface that provides access to the address book.
@@ -151,6 +249,11 @@ type AddrBook interface {
 	FetchScriptKey(ctx context.Context,
 		tweakedScriptKey *btcec.PublicKey) (*asset.TweakedScriptKey,
 		error)
+
+	// InsertScriptKey inserts a script key into the database, so it can be
+	// recognized as belonging to the wallet when a transfer comes in later
+	// on.
+	InsertScriptKey(ctx context.Context, scriptKey asset.ScriptKey) error
 }
 
 // AnchorVTxnsParams holds all the parameters needed to create a BTC level
@@ -164,9 +267,10 @@ type AnchorVTxnsParams struct {
 	// anchored by the anchor transaction.
 	VPkts []*tappsbt.VPacket
 
-	// InputCommitments is a map from virtual package input index to its
-	// associated Taproot Assets commitment.
-	InputCommitments tappsbt.InputCommitments
+	// InputCommitments holds the input Taproot Asset commitments of each
+	// virtual transaction in VPkts (at the same index), each being a map
+	// from virtual package input index to its associated commitment.
+	InputCommitments []tappsbt.InputCommitments
 
 	// PassiveAssetsVPkts is a list of all the virtual transactions which
 	// re-anchor passive assets.
@@ -235,20 +339,158 @@ func (s *CoinSelect) SelectCoins(ctx context.Context,
 
 	// We now need to lock/lease/reserve those selected coins so
 	// that they can't be used by other processes.
+	if err := s.leaseSelectedCoins(ctx, selectedCoins); err != nil {
+		return nil, err
+	}
+
+	return selectedCoins, nil
+}
+
+// SelectCoinsBatch returns a set of not yet leased coins for each of the given
+// constraints, using the given strategy. A coin is only selected for a single
+// set of constraints, but the sets can share anchor outputs. The anchor outputs
+// of all returned coins are leased together for the default lease duration.
+func (s *CoinSelect) SelectCoinsBatch(ctx context.Context,
+	constraints []CommitmentConstraints,
+	strategy MultiCommitmentSelectStrategy) (
+	[][]*AnchoredCommitment, error) {
+
+	s.coinLock.Lock()
+	defer s.coinLock.Unlock()
+
+	// Before we select any coins, let's do some cleanup of expired leases.
+	if err := s.coinLister.DeleteExpiredLeases(ctx); err != nil {
+		return nil, fmt.Errorf("unable to delete expired leases: %w",
+			err)
+	}
+
+	// We don't lease anything before all constraints are satisfied, so we
+	// need to keep track of the coins we already selected ourselves. Coins
+	// sharing an anchor output with an already selected coin stay eligible.
+	var (
+		selectedCoins = make([][]*AnchoredCommitment, len(constraints))
+		allCoins      []*AnchoredCommitment
+		selectedIDs   = fn.NewSet[asset.PrevID]()
+	)
+	for idx, constraint := range constraints {
+		listConstraints := CommitmentConstraints{
+			GroupKey: constraint.GroupKey,
+			AssetID:  constraint.AssetID,
+			MinAmt:   1,
+		}
+		eligibleCommitments, err := s.coinLister.ListEligibleCoins(
+			ctx, listConstraints,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list eligible "+
+				"coins: %w", err)
+		}
+
+		eligibleCommitments = fn.Filter(
+			eligibleCommitments, func(c *AnchoredCommitment) bool {
+				return !selectedIDs.Contains(c.PrevID())
+			},
+		)
+
+		log.Infof("Identified %v eligible asset inputs for send of %d "+
+			"to %x", len(eligibleCommitments), constraint.MinAmt,
+			constraint.AssetID[:])
+
+		selectedCoins[idx], err = s.selectForAmount(
+			constraint.MinAmt, eligibleCommitments, strategy,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to select coins: %w",
+				err)
+		}
+
+		for _, coin := range selectedCoins[idx] {
+			selectedIDs.Add(coin.PrevID())
+		}
+		allCoins = append(allCoins, selectedCoins[idx]...)
+	}
+
+	// Only now that we know all constraints can be satisfied, we lease
+	// the anchor outputs of all selected coins at once.
+	if err := s.leaseSelectedCoins(ctx, allCoins); err != nil {
+		return nil, err
+	}
+
+	return selectedCoins, nil
+}
+
+// SelectConsolidationCoins returns up to the given maximum number of not yet
+// leased coins that satisfy the given constraints, smallest amounts first, in
+// one set per asset ID. If the constraints specify a group key, the coins of
+// all asset IDs of the group are considered, the ones with the most coins
+// first. The coins returned are leased for the default lease duration.
+func (s *CoinSelect) SelectConsolidationCoins(ctx context.Context,
+	constraints CommitmentConstraints,
+	maxInputs int) ([][]*AnchoredCommitment, error) {
+
+	s.coinLock.Lock()
+	defer s.coinLock.Unlock()
+
+	// Before we select any coins, let's do some cleanup of expired leases.
+	if err := s.coinLister.DeleteExpiredLeases(ctx); err != nil {
+		return nil, fmt.Errorf("unable to delete expired leases: %w",
+			err)
+	}
+
+	listConstraints := CommitmentConstraints{
+		GroupKey: constraints.GroupKey,
+		AssetID:  constraints.AssetID,
+		MinAmt:   1,
+	}
+	eligibleCommitments, err := s.coinLister.ListEligibleCoins(
+		ctx, listConstraints,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list eligible coins: %w", err)
+	}
+
+	selectedCoins, err := selectForConsolidation(
+		eligibleCommitments, maxInputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var allCoins []*AnchoredCommitment
+	for _, coins := range selectedCoins {
+		allCoins = append(allCoins, coins...)
+	}
+
+	log.Infof("Selected %v of %v eligible asset inputs of %v asset IDs "+
+		"for consolidation", len(allCoins), len(eligibleCommitments),
+		len(selectedCoins))
+
+	if err := s.leaseSelectedCoins(ctx, allCoins); err != nil {
+		return nil, err
+	}
+
+	return selectedCoins, nil
+}
+
+// leaseSelectedCoins leases the anchor outputs of the given coins for the
+// default lease duration, so they can't be used by other processes.
+func (s *CoinSelect) leaseSelectedCoins(ctx context.Context,
+	selectedCoins []*AnchoredCommitment) error {
+
 	expiry := time.Now().Add(defaultCoinLeaseDuration)
 	coinOutPoints := fn.Map(
 		selectedCoins, func(c *AnchoredCommitment) wire.OutPoint {
 			return c.AnchorPoint
 		},
 	)
-	err = s.coinLister.LeaseCoins(
+	err := s.coinLister.LeaseCoins(
 		ctx, defaultWalletLeaseIdentifier, expiry, coinOutPoints...,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to lease coin: %w", err)
+		return fmt.Errorf("unable to lease coin: %w", err)
 	}
 
-	return selectedCoins, nil
+	return nil
 }
 
 // LeaseCoins leases/locks/reserves coins for the given lease owner until the
@@ -284,54 +526,50 @@ func (s *CoinSelect) selectForAmount(minTotalAmount uint64,
 	strategy MultiCommitmentSelectStrategy) ([]*AnchoredCommitment,
 	error) {
 
-	// Select the first subset of eligible commitments which cumulatively
-	// sum to at least the minimum required amount.
-	var selectedCommitments []*AnchoredCommitment
-	amountSum := uint64(0)
-
 	switch strategy {
 	case PreferMaxAmount:
-		// Sort eligible commitments from the largest amount to
+		// Consider the commitments from the largest amount to the
 		// smallest.
-		sort.Slice(
-			eligibleCommitments, func(i, j int) bool {
-				isLess := eligibleCommitments[i].Asset.Amount <
-					eligibleCommitments[j].Asset.Amount
-
-				// Negate the result to sort in descending
-				// order.
-				return !isLess
-			},
-		)
+		sortByAmount(eligibleCommitments, true)
 
-		// Select the first subset of eligible commitments which
-		// cumulatively sum to at least the minimum required amount.
-		for _, anchoredCommitment := range eligibleCommitments {
-			selectedCommitments = append(
-				selectedCommitments, anchoredCommitment,
-			)
+		return selectInOrder(minTotalAmount, eligibleCommitments)
 
-			// Keep track of the total amount of assets we've seen
-			// so far.
-			amountSum += uint64(anchoredCommitment.Asset.Amount)
-			if amountSum >= minTotalAmount {
-				// At this point a target min amount was
-				// specified and has been reached.
-				break
-			}
+	case PreferMinAmount:
+		// Consider the commitments from the smallest amount to the
+		// largest, which sweeps up small coins along the way.
+		sortByAmount(eligibleCommitments, false)
+
+		return selectInOrder(minTotalAmount, eligibleCommitments)
+
+	case PreferExactMatch:
+		// Try to find a subset that matches the target amount exactly,
+		// so no change output is required. If there is none, we fall
+		// back to the default strategy.
+		exactMatch := selectExactMatch(
+			minTotalAmount, eligibleCommitments,
+		)
+		if exactMatch != nil {
+			return exactMatch, nil
 		}
 
+		sortByAmount(eligibleCommitments, true)
+
+		return selectInOrder(minTotalAmount, eligibleCommitments)
+
+	case PreferOldest:
+		// Consider the commitments from the oldest anchor to the
+		// newest. Unconfirmed anchors come last.
+		sortByAnchorHeight(eligibleCommitments)
+
+		return selectInOrder(minTotalAmount, eligibleCommitments)
+
+	case AvoidAnchorMixing:
+		return selectSingleAnchor(minTotalAmount, eligibleCommitments)
+
 	default:
 		return nil, fmt.Errorf("unknown multi coin selection "+
 			"strategy: %v", strategy)
 	}
-
-	// Having examined all the eligible commitments, return an error if the
-	// minimal funding amount was not reached.
-	if amountSum < minTotalAmount {
-		return nil, ErrMatchingAssetsNotFound
-	}
-	return selectedCommitments, nil
 }
 
 var _ CoinSelector = (*CoinSelect)(nil)
@@ -360,6 +598,10 @@ type WalletConfig struct {
 	// virtual transaction.
 	Signer Signer
 
+	// MuSig2Signer is used to co-sign virtual transaction inputs that are
+	// locked to a MuSig2 aggregate script key.
+	MuSig2Signer MuSig2Signer
+
 	// TxValidator allows us to validate each Taproot Asset virtual
 	// transaction we create.
 	TxValidator tapscript.TxValidator
@@ -400,10 +642,12 @@ type FundedVPacket struct {
 // FundAddressSend funds a virtual transaction, selecting assets to spend in
 // order to pay the given address. It also returns supporting data which assists
 // in processing the virtual transaction: passive asset re-anchors and the
-// Taproot Asset level commitment of the selected assets.
+// Taproot Asset level commitment of the selected assets. The given strategy is
+// used to select the assets.
 //
 // NOTE: This is part of the Wallet interface.
 func (f *AssetWallet) FundAddressSend(ctx context.Context,
+	strategy MultiCommitmentSelectStrategy,
 	receiverAddrs ...*address.Tap) (*FundedVPacket,
 	tappsbt.OutputIdxToAddr, error) {
 
@@ -423,7 +667,7 @@ func (f *AssetWallet) FundAddressSend(ctx context.Context,
 			"%w", err)
 	}
 
-	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt)
+	fundedVPkt, err := f.FundPacket(ctx, fundDesc, vPkt, strategy)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -431,6 +675,240 @@ func (f *AssetWallet) FundAddressSend(ctx context.Context,
 	return fundedVPkt, outputIdxToAddr, nil
 }
 
+// FundBatchSend funds one virtual transaction for each distinct asset ID of the
+// given addresses. The assets to spend are selected for all virtual
+// transactions at once, so they can spend from the same anchor outputs, for
+// example when sending multiple tranches of an asset group that were minted in
+// the same batch. The anchor output indexes of the virtual transactions don't
+// overlap, so they can all be anchored in a single BTC level transaction. The
+// returned output index to address maps belong to the virtual transaction with
+// the same index. The given strategy is used to select the assets.
+//
+// NOTE: This is part of the Wallet interface.
+func (f *AssetWallet) FundBatchSend(ctx context.Context,
+	strategy MultiCommitmentSelectStrategy,
+	receiverAddrs ...*address.Tap) ([]*FundedVPacket,
+	[]tappsbt.OutputIdxToAddr, error) {
+
+	// A virtual transaction can only transfer a single asset ID, and an
+	// address commits to the asset ID of a single tranche if the asset is
+	// grouped. So we group the addresses by asset ID while keeping the
+	// order in which the assets were first requested.
+	var (
+		assetIDs  []asset.ID
+		addrsByID = make(map[asset.ID][]*address.Tap)
+	)
+	for _, addr := range receiverAddrs {
+		if _, ok := addrsByID[addr.AssetID]; !ok {
+			assetIDs = append(assetIDs, addr.AssetID)
+		}
+		addrsByID[addr.AssetID] = append(addrsByID[addr.AssetID], addr)
+	}
+
+	if len(assetIDs) == 0 {
+		return nil, nil, fmt.Errorf("at least one address must be " +
+			"specified")
+	}
+
+	var (
+		numPkts         = len(assetIDs)
+		vPkts           = make([]*tappsbt.VPacket, numPkts)
+		fundDescs       = make([]*tapscript.FundingDescriptor, numPkts)
+		addrMaps        = make([]tappsbt.OutputIdxToAddr, numPkts)
+		constraints     = make([]CommitmentConstraints, numPkts)
+		nextAnchorIndex uint32
+	)
+	for idx, assetID := range assetIDs {
+		addrs := addrsByID[assetID]
+
+		// Each virtual transaction gets its own change output, followed
+		// by one anchor output for each of its recipients.
+		vPkt, outputIdxToAddr, err := tappsbt.FromAddresses(
+			addrs, nextAnchorIndex+1,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to create virtual "+
+				"transaction from addresses: %w", err)
+		}
+		vPkt.Outputs[0].AnchorOutputIndex = nextAnchorIndex
+
+		// The input and address networks must match.
+		hrp := vPkt.ChainParams.TapHRP
+		if !address.IsForNet(hrp, f.cfg.ChainParams) {
+			return nil, nil, address.ErrMismatchedHRP
+		}
+
+		fundDesc, err := tapscript.DescribeAddrs(addrs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to describe "+
+				"recipients: %w", err)
+		}
+
+		vPkts[idx] = vPkt
+		fundDescs[idx] = fundDesc
+		addrMaps[idx] = outputIdxToAddr
+		constraints[idx] = CommitmentConstraints{
+			GroupKey: fundDesc.GroupKey,
+			AssetID:  &fundDesc.ID,
+			MinAmt:   fundDesc.Amount,
+		}
+
+		for _, vOut := range vPkt.Outputs {
+			if vOut.AnchorOutputIndex >= nextAnchorIndex {
+				nextAnchorIndex = vOut.AnchorOutputIndex + 1
+			}
+		}
+	}
+
+	// We select the coins for all assets before leasing any of them, so a
+	// coin selected for one asset doesn't block an anchor output that is
+	// needed for another one.
+	selectedCoins, err := f.cfg.CoinSelector.SelectCoinsBatch(
+		ctx, constraints, strategy,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// If we can't fund all virtual transactions, we release all the coins
+	// we selected.
+	success := false
+	defer func() {
+		if success {
+			return
+		}
+
+		var outpoints []wire.OutPoint
+		for _, coins := range selectedCoins {
+			for _, coin := range coins {
+				outpoints = append(outpoints, coin.AnchorPoint)
+			}
+		}
+
+		err := f.cfg.CoinSelector.ReleaseCoins(ctx, outpoints...)
+		if err != nil {
+			log.Errorf("Unable to release coins: %v", err)
+		}
+	}()
+
+	batchSpends := newBatchSpends(selectedCoins)
+	fundedVPkts := make([]*FundedVPacket, len(vPkts))
+	for idx, vPkt := range vPkts {
+		fundedVPkts[idx], err = f.fundPacketWithInputs(
+			ctx, fundDescs[idx], vPkt, selectedCoins[idx],
+			batchSpends[idx],
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to fund send of "+
+				"asset %v: %w", assetIDs[idx], err)
+		}
+	}
+
+	success = true
+	return fundedVPkts, addrMaps, nil
+}
+
+// batchSpend describes how the inputs of a virtual transaction of a batch send
+// relate to the inputs of the other virtual transactions of the same batch.
+type batchSpend struct {
+	// ownInputs are the assets spent by the virtual transaction itself.
+	ownInputs fn.Set[asset.PrevID]
+
+	// otherInputs are the assets spent by the other virtual transactions
+	// of the batch.
+	otherInputs fn.Set[asset.PrevID]
+
+	// passiveAnchors are the anchor outputs whose passive assets are
+	// re-anchored by the virtual transaction. If multiple virtual
+	// transactions spend from the same anchor output, only the first one
+	// carries its passive assets along.
+	passiveAnchors fn.Set[wire.OutPoint]
+}
+
+// newBatchSpends returns the batch spend information for each of the given
+// sets of selected coins, one set per virtual transaction of a batch send.
+func newBatchSpends(selectedCoins [][]*AnchoredCommitment) []*batchSpend {
+	var (
+		spends      = make([]*batchSpend, len(selectedCoins))
+		allInputs   = fn.NewSet[asset.PrevID]()
+		seenAnchors = fn.NewSet[wire.OutPoint]()
+	)
+	for idx, coins := range selectedCoins {
+		spends[idx] = &batchSpend{
+			ownInputs:      fn.NewSet[asset.PrevID](),
+			passiveAnchors: fn.NewSet[wire.OutPoint](),
+		}
+		for _, coin := range coins {
+			spends[idx].ownInputs.Add(coin.PrevID())
+			allInputs.Add(coin.PrevID())
+
+			if !seenAnchors.Contains(coin.AnchorPoint) {
+				seenAnchors.Add(coin.AnchorPoint)
+				spends[idx].passiveAnchors.Add(coin.AnchorPoint)
+			}
+		}
+	}
+
+	for _, spend := range spends {
+		spend.otherInputs = allInputs.Diff(spend.ownInputs)
+	}
+
+	return spends
+}
+
+// inputCommitments returns the input commitments of a virtual transaction of
+// the batch, with all assets removed that are spent by other virtual
+// transactions. If another virtual transaction carries along the passive
+// assets of a shared anchor output, only the assets spent by the virtual
+// transaction itself remain in that input commitment. A nil batch spend
+// returns the input commitments unchanged.
+func (b *batchSpend) inputCommitments(vPkt *tappsbt.VPacket,
+	inputCommitments tappsbt.InputCommitments) (tappsbt.InputCommitments,
+	error) {
+
+	if b == nil {
+		return inputCommitments, nil
+	}
+
+	trimmed := make(tappsbt.InputCommitments, len(inputCommitments))
+	for idx, inputCommitment := range inputCommitments {
+		anchorPoint := vPkt.Inputs[idx].PrevID.OutPoint
+		carriesPassive := b.passiveAnchors.Contains(anchorPoint)
+
+		allAssets := inputCommitment.CommittedAssets()
+		committedAssets := fn.Filter(
+			allAssets, func(a *asset.Asset) bool {
+				prevID := asset.PrevID{
+					OutPoint: anchorPoint,
+					ID:       a.ID(),
+					ScriptKey: asset.ToSerialized(
+						a.ScriptKey.PubKey,
+					),
+				}
+
+				if carriesPassive {
+					return !b.otherInputs.Contains(prevID)
+				}
+
+				return b.ownInputs.Contains(prevID)
+			},
+		)
+		if len(committedAssets) == len(allAssets) {
+			trimmed[idx] = inputCommitment
+			continue
+		}
+
+		var err error
+		trimmed[idx], err = commitment.FromAssets(committedAssets...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create input "+
+				"commitment: %w", err)
+		}
+	}
+
+	return trimmed, nil
+}
+
 // passiveAssetVPacket creates a virtual packet for the given passive asset.
 func (f *AssetWallet) passiveAssetVPacket(passiveAsset *asset.Asset,
 	anchorPoint wire.OutPoint, anchorOutputIndex uint32,
@@ -462,8 +940,9 @@ func (f *AssetWallet) passiveAssetVPacket(passiveAsset *asset.Asset,
 	}}
 
 	vOutput := tappsbt.VOutput{
-		Amount:       outputAsset.Amount,
-		AssetVersion: outputAsset.Version,
+		Amount:        outputAsset.Amount,
+		AssetVersion:  outputAsset.Version,
+		ScriptVersion: outputAsset.ScriptVersion,
 
 		// In this case, the receiver of the output is also the sender.
 		// We therefore set interactive to true to indicate that the
@@ -495,11 +974,11 @@ func (f *AssetWallet) passiveAssetVPacket(passiveAsset *asset.Asset,
 }
 
 // FundPacket funds a virtual transaction, selecting assets to spend in order to
-// pay the given recipient. The selected input is then added to the given
-// virtual transaction.
+// pay the given recipient using the given strategy. The selected input is then
+// added to the given virtual transaction.
 func (f *AssetWallet) FundPacket(ctx context.Context,
-	fundDesc *tapscript.FundingDescriptor,
-	vPkt *tappsbt.VPacket) (*FundedVPacket, error) {
+	fundDesc *tapscript.FundingDescriptor, vPkt *tappsbt.VPacket,
+	strategy MultiCommitmentSelectStrategy) (*FundedVPacket, error) {
 
 	// The input and address networks must match.
 	if !address.IsForNet(vPkt.ChainParams.TapHRP, f.cfg.ChainParams) {
@@ -515,13 +994,15 @@ func (f *AssetWallet) FundPacket(ctx context.Context,
 		MinAmt:   fundDesc.Amount,
 	}
 	selectedCommitments, err := f.cfg.CoinSelector.SelectCoins(
-		ctx, constraints, PreferMaxAmount,
+		ctx, constraints, strategy,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return f.fundPacketWithInputs(ctx, fundDesc, vPkt, selectedCommitments)
+	return f.fundPacketWithInputs(
+		ctx, fundDesc, vPkt, selectedCommitments, nil,
+	)
 }
 
 // FundBurn funds a virtual transaction for burning the given amount of units of
@@ -637,7 +1118,7 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 	// The virtual transaction is now ready to be further enriched with the
 	// split commitment and other data.
 	fundedPkt, err := f.fundPacketWithInputs(
-		ctx, fundDesc, vPkt, selectedCommitments,
+		ctx, fundDesc, vPkt, selectedCommitments, nil,
 	)
 	if err != nil {
 		return nil, err
@@ -676,10 +1157,150 @@ func (f *AssetWallet) FundBurn(ctx context.Context,
 	return fundedPkt, nil
 }
 
-// fundPacketWithInputs funds a virtual transaction with the given inputs.
+// FundConsolidation funds one virtual transaction for each asset ID of up to
+// the given maximum number of asset coins that satisfy the given constraints,
+// each of which merges the coins of its asset ID into a single output to
+// ourselves. The anchor output indexes of the virtual transactions don't
+// overlap, so they can all be anchored in a single BTC level transaction.
+//
+// NOTE: This is part of the Wallet interface.
+func (f *AssetWallet) FundConsolidation(ctx context.Context,
+	constraints CommitmentConstraints,
+	maxInputs int) ([]*FundedVPacket, error) {
+
+	selectedCoins, err := f.cfg.CoinSelector.SelectConsolidationCoins(
+		ctx, constraints, maxInputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we return with an error, we want to release the coins we've
+	// selected.
+	success := false
+	defer func() {
+		if success {
+			return
+		}
+
+		var outpoints []wire.OutPoint
+		for _, coins := range selectedCoins {
+			for _, coin := range coins {
+				outpoints = append(outpoints, coin.AnchorPoint)
+			}
+		}
+
+		err := f.cfg.CoinSelector.ReleaseCoins(ctx, outpoints...)
+		if err != nil {
+			log.Errorf("Unable to release coins: %v", err)
+		}
+	}()
+
+	// The coins of different asset IDs, for example the tranches of an
+	// asset group, can't be merged into a single asset. So each asset ID
+	// gets its own virtual transaction, all of which may spend from the
+	// same anchor outputs.
+	batchSpends := newBatchSpends(selectedCoins)
+	fundedVPkts := make([]*FundedVPacket, len(selectedCoins))
+	for idx, coins := range selectedCoins {
+		fundedVPkts[idx], err = f.fundConsolidationPacket(
+			ctx, coins, uint32(idx), batchSpends[idx],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fund consolidation "+
+				"of asset %v: %w", coins[0].Asset.ID(), err)
+		}
+	}
+
+	// Don't release the coins we've selected, as so far we've been
+	// successful.
+	success = true
+	return fundedVPkts, nil
+}
+
+// fundConsolidationPacket funds a virtual transaction that merges the given
+// coins, which all have the same asset ID, into a single output to ourselves
+// at the given anchor output index.
+func (f *AssetWallet) fundConsolidationPacket(ctx context.Context,
+	selectedCommitments []*AnchoredCommitment, anchorOutputIndex uint32,
+	batch *batchSpend) (*FundedVPacket, error) {
+
+	// All selected coins have the same asset ID, so we merge their full
+	// amount.
+	firstAsset := selectedCommitments[0].Asset
+	fundDesc := &tapscript.FundingDescriptor{
+		ID: firstAsset.ID(),
+	}
+	if firstAsset.GroupKey != nil {
+		fundDesc.GroupKey = &firstAsset.GroupKey.GroupPubKey
+	}
+
+	maxVersion := asset.V0
+	for _, c := range selectedCommitments {
+		fundDesc.Amount += c.Asset.Amount
+
+		if c.Asset.Version > maxVersion {
+			maxVersion = c.Asset.Version
+		}
+	}
+
+	scriptKey, err := f.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, err
+	}
+	newInternalKey, err := f.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Multiple inputs can only be merged through a split commitment. So
+	// the merged asset is a split of a zero value tombstone root, both of
+	// which are committed to in the same anchor output. The tombstone also
+	// carries any passive assets of the inputs.
+	vPkt := &tappsbt.VPacket{
+		Inputs: []*tappsbt.VInput{{
+			PrevID: asset.PrevID{
+				ID: fundDesc.ID,
+			},
+		}},
+		Outputs: []*tappsbt.VOutput{{
+			Amount:            0,
+			Type:              tappsbt.TypeSplitRoot,
+			AnchorOutputIndex: anchorOutputIndex,
+			AssetVersion:      maxVersion,
+			ScriptKey:         asset.NUMSScriptKey,
+		}, {
+			Amount:            fundDesc.Amount,
+			Type:              tappsbt.TypeSimple,
+			AnchorOutputIndex: anchorOutputIndex,
+			AssetVersion:      maxVersion,
+			ScriptKey:         asset.NewScriptKeyBip86(scriptKey),
+		}},
+		ChainParams: f.cfg.ChainParams,
+	}
+	vPkt.Outputs[0].SetAnchorInternalKey(
+		newInternalKey, f.cfg.ChainParams.HDCoinType,
+	)
+	vPkt.Outputs[1].SetAnchorInternalKey(
+		newInternalKey, f.cfg.ChainParams.HDCoinType,
+	)
+
+	return f.fundPacketWithInputs(
+		ctx, fundDesc, vPkt, selectedCommitments, batch,
+	)
+}
+
+// fundPacketWithInputs funds a virtual transaction with the given inputs. If
+// the virtual transaction is part of a batch send, the batch spend describes
+// the inputs shared with the other virtual transactions of the batch.
 func (f *AssetWallet) fundPacketWithInputs(ctx context.Context,
 	fundDesc *tapscript.FundingDescriptor, vPkt *tappsbt.VPacket,
-	selectedCommitments []*AnchoredCommitment) (*FundedVPacket, error) {
+	selectedCommitments []*AnchoredCommitment,
+	batch *batchSpend) (*FundedVPacket, error) {
 
 	log.Infof("Selected %v asset inputs for send of %d to %x",
 		len(selectedCommitments), fundDesc.Amount, fundDesc.ID[:])
@@ -708,6 +1329,13 @@ func (f *AssetWallet) fundPacketWithInputs(ctx context.Context,
 		return nil, err
 	}
 
+	// Assets spent by the other virtual transactions of a batch are
+	// neither passive assets nor change of this one.
+	inputCommitments, err = batch.inputCommitments(vPkt, inputCommitments)
+	if err != nil {
+		return nil, err
+	}
+
 	// Gather Taproot Asset commitments from the selected anchored assets.
 	var selectedTapCommitments []*commitment.TapCommitment
 	for _, selectedCommitment := range selectedCommitments {
@@ -1268,6 +1896,32 @@ func (f *AssetWallet) SignPassiveAssets(vPkt *tappsbt.VPacket,
 	inputCommitments tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
 	error) {
 
+	passiveAssets, err := f.CreatePassiveAssets(vPkt, inputCommitments)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign all the passive assets virtual packets.
+	for idx := range passiveAssets {
+		passiveAsset := passiveAssets[idx]
+		_, err := f.SignVirtualPacket(
+			passiveAsset.VPacket, SkipInputProofVerify(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign passive asset "+
+				"virtual packet: %w", err)
+		}
+	}
+
+	return passiveAssets, nil
+}
+
+// CreatePassiveAssets creates the unsigned passive asset packets for the given
+// input commitment and virtual packet that contains the active asset transfer.
+func (f *AssetWallet) CreatePassiveAssets(vPkt *tappsbt.VPacket,
+	inputCommitments tappsbt.InputCommitments) ([]*PassiveAssetReAnchor,
+	error) {
+
 	// Gather passive assets found in each input Taproot Asset commitment.
 	var passiveAssets []*PassiveAssetReAnchor
 	for inputIdx := range inputCommitments {
@@ -1321,18 +1975,6 @@ func (f *AssetWallet) SignPassiveAssets(vPkt *tappsbt.VPacket,
 		}
 	}
 
-	// Sign all the passive assets virtual packets.
-	for idx := range passiveAssets {
-		passiveAsset := passiveAssets[idx]
-		_, err := f.SignVirtualPacket(
-			passiveAsset.VPacket, SkipInputProofVerify(),
-		)
-		if err != nil {
-			return nil, fmt.Errorf("unable to sign passive asset "+
-				"virtual packet: %w", err)
-		}
-	}
-
 	return passiveAssets, nil
 }
 
@@ -1347,36 +1989,88 @@ func (f *AssetWallet) SignPassiveAssets(vPkt *tappsbt.VPacket,
 func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	params *AnchorVTxnsParams) (*AnchorTransaction, error) {
 
-	// We currently only support anchoring a single virtual transaction.
-	//
-	// TODO(guggero): Support merging and anchoring multiple virtual
-	// transactions.
-	if len(params.VPkts) != 1 {
-		return nil, fmt.Errorf("only a single virtual transaction is " +
-			"supported for now")
+	anchorPkt, mergedCommitments, err := f.commitVirtualTransactions(
+		ctx, params,
+	)
+	if err != nil {
+		return nil, err
 	}
-	vPacket := params.VPkts[0]
+	signAnchorPkt := anchorPkt.Pkt
 
-	outputCommitments, err := tapscript.CreateOutputCommitments(
-		params.InputCommitments, vPacket, params.PassiveAssetsVPkts,
-	)
+	// With all the input and output information in the packet, we
+	// can now ask lnd to sign it, and then extract the final
+	// version ourselves.
+	log.Debugf("Signing PSBT")
+	log.Tracef("PSBT: %s", spew.Sdump(signAnchorPkt))
+	signedPsbt, err := f.cfg.Wallet.SignPsbt(ctx, signAnchorPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+	log.Debugf("Got signed PSBT")
+	log.Tracef("PSBT: %s", spew.Sdump(signedPsbt))
+
+	// Before we finalize, we need to calculate the actual, final fees that
+	// we pay.
+	chainFees, err := tapgarden.GetTxFee(signedPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for psbt: "+
+			"%w", err)
+	}
+	log.Infof("PSBT absolute fee: %d sats", chainFees)
+
+	err = psbt.MaybeFinalizeAll(signedPsbt)
 	if err != nil {
-		return nil, fmt.Errorf("unable to create new output "+
-			"commitments: %w", err)
+		return nil, fmt.Errorf("unable to finalize psbt: %w", err)
+	}
+
+	// Extract the final packet from the PSBT transaction (has all sigs
+	// included).
+	finalTx, err := psbt.Extract(signedPsbt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	// Final TX sanity check.
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(finalTx))
+	if err != nil {
+		return nil, fmt.Errorf("anchor TX failed final checks: %w", err)
+	}
+
+	return &AnchorTransaction{
+		FundedPsbt:        anchorPkt,
+		FinalTx:           finalTx,
+		TargetFeeRate:     params.FeeRate,
+		ChainFees:         chainFees,
+		OutputCommitments: mergedCommitments,
+	}, nil
+}
+
+// commitVirtualTransactions creates and funds the BTC level anchor transaction
+// that anchors all the virtual transactions of the given packets, with the
+// anchor outputs committing to the new Taproot Asset commitments and the
+// anchor inputs of the virtual transactions added. The returned funded PSBT
+// isn't signed yet.
+func (f *AssetWallet) commitVirtualTransactions(ctx context.Context,
+	params *AnchorVTxnsParams) (*tapgarden.FundedPsbt,
+	map[uint32]*commitment.TapCommitment, error) {
+
+	outputCommitments, allOutputs, err := createOutputCommitments(params)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Construct our template PSBT to commits to the set of dummy locators
 	// we use to make fee estimation work.
-	sendPacket, err := tapscript.CreateAnchorTx(vPacket.Outputs)
+	sendPacket, err := tapscript.CreateAnchorTx(allOutputs)
 	if err != nil {
-		return nil, fmt.Errorf("error creating anchor TX: %w", err)
+		return nil, nil, fmt.Errorf("error creating anchor TX: %w", err)
 	}
 
 	anchorPkt, err := f.cfg.Wallet.FundPsbt(
 		ctx, sendPacket, 1, params.FeeRate,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to fund psbt: %w", err)
+		return nil, nil, fmt.Errorf("unable to fund psbt: %w", err)
 	}
 
 	// TODO(roasbeef): also want to log the total fee to disk for
@@ -1388,7 +2082,7 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	// TODO(jhb): Do we need richer handling for the change output?
 	// We could reassign the change value to our Taproot Asset change output
 	// and remove the change output entirely.
-	adjustFundedPsbt(&anchorPkt, int64(vPacket.Inputs[0].Anchor.Value))
+	adjustFundedPsbt(&anchorPkt, anchorInputValue(params.VPkts...))
 
 	log.Infof("Received funded PSBT packet")
 	log.Tracef("Packet: %v", spew.Sdump(anchorPkt.Pkt))
@@ -1398,77 +2092,125 @@ func (f *AssetWallet) AnchorVirtualTransactions(ctx context.Context,
 	// because those fields get removed when we sign it.
 	signAnchorPkt, err := copyPsbt(anchorPkt.Pkt)
 	if err != nil {
-		return nil, fmt.Errorf("unable to copy PSBT: %w", err)
+		return nil, nil, fmt.Errorf("unable to copy PSBT: %w", err)
 	}
 
 	// First, we'll update the PSBT packets to insert the _real_ outputs we
 	// need to commit to the asset transfer.
-	mergedCommitments, err := tapscript.UpdateTaprootOutputKeys(
-		signAnchorPkt, vPacket, outputCommitments,
+	mergedCommitments, err := updateAnchorOutputKeys(
+		signAnchorPkt, params.VPkts, outputCommitments,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error updating taproot output keys: %w",
-			err)
+		return nil, nil, err
 	}
 
 	// Now that all the real outputs are in the PSBT, we'll also
 	// add our anchor inputs as well, since the wallet can sign for
 	// it itself.
-	err = addAnchorPsbtInputs(
-		signAnchorPkt, vPacket, params.FeeRate,
-		f.cfg.ChainParams.Params,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error adding anchor input: %w", err)
+	for _, vPkt := range params.VPkts {
+		err = addAnchorPsbtInputs(
+			signAnchorPkt, vPkt, params.FeeRate,
+			f.cfg.ChainParams.Params,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error adding anchor "+
+				"input: %w", err)
+		}
 	}
 	anchorPkt.Pkt = signAnchorPkt
 
-	// With all the input and output information in the packet, we
-	// can now ask lnd to sign it, and then extract the final
-	// version ourselves.
-	log.Debugf("Signing PSBT")
-	log.Tracef("PSBT: %s", spew.Sdump(signAnchorPkt))
-	signedPsbt, err := f.cfg.Wallet.SignPsbt(ctx, signAnchorPkt)
-	if err != nil {
-		return nil, fmt.Errorf("unable to sign psbt: %w", err)
-	}
-	log.Debugf("Got signed PSBT")
-	log.Tracef("PSBT: %s", spew.Sdump(signedPsbt))
+	return &anchorPkt, mergedCommitments, nil
+}
 
-	// Before we finalize, we need to calculate the actual, final fees that
-	// we pay.
-	chainFees, err := tapgarden.GetTxFee(signedPsbt)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get on-chain fees for psbt: "+
-			"%w", err)
-	}
-	log.Infof("PSBT absolute fee: %d sats", chainFees)
+// createOutputCommitments creates the new Taproot Asset commitments of the
+// anchor outputs of each of the given virtual transactions. It also returns
+// the outputs of all virtual transactions.
+func createOutputCommitments(
+	params *AnchorVTxnsParams) ([][]*commitment.TapCommitment,
+	[]*tappsbt.VOutput, error) {
 
-	err = psbt.MaybeFinalizeAll(signedPsbt)
-	if err != nil {
-		return nil, fmt.Errorf("unable to finalize psbt: %w", err)
+	if len(params.VPkts) == 0 {
+		return nil, nil, fmt.Errorf("no virtual transactions to " +
+			"anchor")
+	}
+	if len(params.InputCommitments) != len(params.VPkts) {
+		return nil, nil, fmt.Errorf("expected input commitments for "+
+			"%d virtual transactions, got %d", len(params.VPkts),
+			len(params.InputCommitments))
 	}
 
-	// Extract the final packet from the PSBT transaction (has all sigs
-	// included).
-	finalTx, err := psbt.Extract(signedPsbt)
-	if err != nil {
-		return nil, fmt.Errorf("unable to extract psbt: %w", err)
+	// Each virtual transaction commits to its own set of anchor outputs,
+	// so we can create the output commitments for each of them
+	// separately. The passive assets are anchored in the output of the
+	// virtual transaction that spent them.
+	var (
+		allOutputs        []*tappsbt.VOutput
+		outputCommitments = make(
+			[][]*commitment.TapCommitment, len(params.VPkts),
+		)
+		anchorIndexes = fn.NewSet[uint32]()
+	)
+	for idx, vPkt := range params.VPkts {
+		pktAnchorIndexes := fn.NewSet[uint32]()
+		for _, vOut := range vPkt.Outputs {
+			anchorIdx := vOut.AnchorOutputIndex
+			if anchorIndexes.Contains(anchorIdx) {
+				return nil, nil, fmt.Errorf("anchor output %d "+
+					"used by multiple virtual "+
+					"transactions", anchorIdx)
+			}
+			pktAnchorIndexes.Add(anchorIdx)
+		}
+		anchorIndexes = anchorIndexes.Union(pktAnchorIndexes)
+
+		passiveVPkts := fn.Filter(
+			params.PassiveAssetsVPkts,
+			func(passivePkt *tappsbt.VPacket) bool {
+				anchorIdx := passivePkt.Outputs[0].
+					AnchorOutputIndex
+				return pktAnchorIndexes.Contains(anchorIdx)
+			},
+		)
+
+		var err error
+		outputCommitments[idx], err = tapscript.CreateOutputCommitments(
+			params.InputCommitments[idx], vPkt, passiveVPkts,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to create new "+
+				"output commitments: %w", err)
+		}
+
+		allOutputs = append(allOutputs, vPkt.Outputs...)
 	}
 
-	// Final TX sanity check.
-	err = blockchain.CheckTransactionSanity(btcutil.NewTx(finalTx))
-	if err != nil {
-		return nil, fmt.Errorf("anchor TX failed final checks: %w", err)
+	return outputCommitments, allOutputs, nil
+}
+
+// updateAnchorOutputKeys updates the anchor outputs of the given PSBT to commit
+// to the given output commitments of each of the virtual transactions. The
+// commitments of all anchor outputs are returned, keyed by their index in the
+// anchor transaction.
+func updateAnchorOutputKeys(anchorPkt *psbt.Packet, vPkts []*tappsbt.VPacket,
+	outputCommitments [][]*commitment.TapCommitment) (
+	map[uint32]*commitment.TapCommitment, error) {
+
+	mergedCommitments := make(map[uint32]*commitment.TapCommitment)
+	for idx, vPkt := range vPkts {
+		pktCommitments, err := tapscript.UpdateTaprootOutputKeys(
+			anchorPkt, vPkt, outputCommitments[idx],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error updating taproot output "+
+				"keys: %w", err)
+		}
+
+		for anchorIdx, anchorCommitment := range pktCommitments {
+			mergedCommitments[anchorIdx] = anchorCommitment
+		}
 	}
 
-	return &AnchorTransaction{
-		FundedPsbt:        &anchorPkt,
-		FinalTx:           finalTx,
-		TargetFeeRate:     params.FeeRate,
-		ChainFees:         chainFees,
-		OutputCommitments: mergedCommitments,
-	}, nil
+	return mergedCommitments, nil
 }
 
 // SignOwnershipProof creates and signs an ownership proof for the given owned
@@ -1623,16 +2365,51 @@ func adjustFundedPsbt(fPkt *tapgarden.FundedPsbt, anchorInputValue int64) {
 	fPkt.ChangeOutputIndex = int32(maxOutputIndex)
 }
 
+// anchorInputValue returns the total value of all distinct anchor outputs
+// spent by the given virtual transactions.
+func anchorInputValue(vPkts ...*tappsbt.VPacket) int64 {
+	var (
+		total int64
+		seen  = fn.NewSet[wire.OutPoint]()
+	)
+	for _, vPkt := range vPkts {
+		for _, vIn := range vPkt.Inputs {
+			if seen.Contains(vIn.PrevID.OutPoint) {
+				continue
+			}
+			seen.Add(vIn.PrevID.OutPoint)
+
+			total += int64(vIn.Anchor.Value)
+		}
+	}
+
+	return total
+}
+
+// spendsOutPoint returns true if the given transaction already has an input
+// spending the given outpoint.
+func spendsOutPoint(tx *wire.MsgTx, outPoint wire.OutPoint) bool {
+	return fn.Any(tx.TxIn, func(txIn *wire.TxIn) bool {
+		return txIn.PreviousOutPoint == outPoint
+	})
+}
+
 // addAnchorPsbtInputs adds anchor information from all inputs to the PSBT
 // packet. This is called after the PSBT has been funded, but before signing.
 func addAnchorPsbtInputs(btcPkt *psbt.Packet, vPkt *tappsbt.VPacket,
 	feeRate chainfee.SatPerKWeight, params *chaincfg.Params) error {
 
 	for idx := range vPkt.Inputs {
+		// Multiple virtual inputs can spend assets from the same anchor
+		// output, which we only add once.
+		vIn := vPkt.Inputs[idx]
+		if spendsOutPoint(btcPkt.UnsignedTx, vIn.PrevID.OutPoint) {
+			continue
+		}
+
 		// With the BIP-0032 information completed, we'll now add the
 		// information as a partial input and also add the input to the
 		// unsigned transaction.
-		vIn := vPkt.Inputs[idx]
 		btcPkt.Inputs = append(btcPkt.Inputs, psbt.PInput{
 			WitnessUtxo: &wire.TxOut{
 				Value:    int64(vIn.Anchor.Value),
@@ -5,25 +5,44 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/stretchr/testify/require"
 )
 
 // mockCoinLister is a mock implementation of the CoinLister interface.
 type mockCoinLister struct {
 	eligibleCommitments []*AnchoredCommitment
+
+	leaseCalls int
+	leased     []wire.OutPoint
 }
 
 func (m *mockCoinLister) ListEligibleCoins(
 	ctx context.Context, constraints CommitmentConstraints) (
 	[]*AnchoredCommitment, error) {
 
-	return m.eligibleCommitments, nil
+	if constraints.AssetID == nil {
+		return m.eligibleCommitments, nil
+	}
+
+	return fn.Filter(
+		m.eligibleCommitments, func(c *AnchoredCommitment) bool {
+			return c.Asset.ID() == *constraints.AssetID
+		},
+	), nil
 }
 
-func (m *mockCoinLister) LeaseCoins(context.Context, [32]byte, time.Time,
-	...wire.OutPoint) error {
+func (m *mockCoinLister) LeaseCoins(_ context.Context, _ [32]byte, _ time.Time,
+	outPoints ...wire.OutPoint) error {
+
+	m.leaseCalls++
+	m.leased = append(m.leased, outPoints...)
 
 	return nil
 }
@@ -40,6 +59,24 @@ func (m *mockCoinLister) DeleteExpiredLeases(ctx context.Context) error {
 func TestCoinSelection(t *testing.T) {
 	t.Parallel()
 
+	var (
+		anchor1 = wire.OutPoint{Index: 1}
+		anchor2 = wire.OutPoint{Index: 2}
+		anchor3 = wire.OutPoint{Index: 3}
+		anchor4 = wire.OutPoint{Index: 4}
+	)
+	newCommitment := func(amount uint64, anchor wire.OutPoint,
+		height uint32) *AnchoredCommitment {
+
+		return &AnchoredCommitment{
+			AnchorPoint:       anchor,
+			AnchorBlockHeight: height,
+			Asset: &asset.Asset{
+				Amount: amount,
+			},
+		}
+	}
+
 	type testCase struct {
 		minTotalAmount      uint64
 		eligibleCommitments []*AnchoredCommitment
@@ -130,6 +167,106 @@ func TestCoinSelection(t *testing.T) {
 				},
 			},
 		},
+
+		// Test that when the PreferMinAmount strategy is employed
+		// the smallest commitments are selected first.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				newCommitment(510, anchor1, 0),
+				newCommitment(2000, anchor2, 0),
+				newCommitment(490, anchor3, 0),
+			},
+			strategy:                 PreferMinAmount,
+			checkSelectedCommitments: true,
+			expectedCommitments: []*AnchoredCommitment{
+				newCommitment(490, anchor3, 0),
+				newCommitment(510, anchor1, 0),
+			},
+		},
+
+		// Test that when the PreferExactMatch strategy is employed
+		// a subset that sums to exactly the target is selected.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				newCommitment(600, anchor1, 0),
+				newCommitment(300, anchor2, 0),
+				newCommitment(1200, anchor3, 0),
+				newCommitment(700, anchor4, 0),
+			},
+			strategy:                 PreferExactMatch,
+			checkSelectedCommitments: true,
+			expectedCommitments: []*AnchoredCommitment{
+				newCommitment(700, anchor4, 0),
+				newCommitment(300, anchor2, 0),
+			},
+		},
+
+		// Test that the PreferExactMatch strategy falls back to the
+		// max amount strategy if there is no exact match.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				newCommitment(600, anchor1, 0),
+				newCommitment(700, anchor2, 0),
+			},
+			strategy:                 PreferExactMatch,
+			checkSelectedCommitments: true,
+			expectedCommitments: []*AnchoredCommitment{
+				newCommitment(700, anchor2, 0),
+				newCommitment(600, anchor1, 0),
+			},
+		},
+
+		// Test that when the PreferOldest strategy is employed
+		// commitments with older anchors are selected first and
+		// unconfirmed ones last.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				newCommitment(2000, anchor1, 0),
+				newCommitment(600, anchor2, 200),
+				newCommitment(500, anchor3, 100),
+			},
+			strategy:                 PreferOldest,
+			checkSelectedCommitments: true,
+			expectedCommitments: []*AnchoredCommitment{
+				newCommitment(500, anchor3, 100),
+				newCommitment(600, anchor2, 200),
+			},
+		},
+
+		// Test that when the AvoidAnchorMixing strategy is employed
+		// only commitments of a single anchor are selected, preferring
+		// the anchor that results in the least change.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				newCommitment(600, anchor1, 0),
+				newCommitment(2000, anchor2, 0),
+				newCommitment(500, anchor3, 0),
+				newCommitment(600, anchor1, 0),
+			},
+			strategy:                 AvoidAnchorMixing,
+			checkSelectedCommitments: true,
+			expectedCommitments: []*AnchoredCommitment{
+				newCommitment(600, anchor1, 0),
+				newCommitment(600, anchor1, 0),
+			},
+		},
+
+		// Test that the AvoidAnchorMixing strategy fails if no single
+		// anchor holds enough assets.
+		{
+			minTotalAmount: 1000,
+			eligibleCommitments: []*AnchoredCommitment{
+				newCommitment(600, anchor1, 0),
+				newCommitment(500, anchor2, 0),
+			},
+			strategy:        AvoidAnchorMixing,
+			expectedSomeErr: true,
+		},
 	}
 
 	// Execute test cases.
@@ -163,3 +300,275 @@ func TestCoinSelection(t *testing.T) {
 		_ = idx
 	}
 }
+
+// TestSelectCoinsBatch tests that coins are selected for all constraints of a
+// batch before any of them are leased, and that the constraints can share an
+// anchor output.
+func TestSelectCoinsBatch(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx      = context.Background()
+		genesis1 = asset.Genesis{Tag: "asset-1"}
+		genesis2 = asset.Genesis{Tag: "asset-2"}
+		id1      = genesis1.ID()
+		id2      = genesis2.ID()
+		anchor1  = wire.OutPoint{Hash: test.RandHash(), Index: 1}
+		anchor2  = wire.OutPoint{Hash: test.RandHash(), Index: 2}
+	)
+	newCommitment := func(genesis asset.Genesis, amount uint64,
+		anchor wire.OutPoint) *AnchoredCommitment {
+
+		return &AnchoredCommitment{
+			AnchorPoint: anchor,
+			Asset: &asset.Asset{
+				Genesis: genesis,
+				Amount:  amount,
+				ScriptKey: asset.NewScriptKey(
+					test.RandPubKey(t),
+				),
+			},
+		}
+	}
+
+	// Both assets were minted in the same anchor output, the first asset
+	// was also received in another anchor output.
+	coin1 := newCommitment(genesis1, 5, anchor1)
+	coin2 := newCommitment(genesis2, 7, anchor1)
+	coin3 := newCommitment(genesis1, 3, anchor2)
+	coinLister := &mockCoinLister{
+		eligibleCommitments: []*AnchoredCommitment{coin1, coin2, coin3},
+	}
+	coinSelect := NewCoinSelect(coinLister)
+
+	selected, err := coinSelect.SelectCoinsBatch(
+		ctx, []CommitmentConstraints{
+			{AssetID: &id1, MinAmt: 5},
+			{AssetID: &id2, MinAmt: 7},
+			{AssetID: &id1, MinAmt: 3},
+		}, PreferMaxAmount,
+	)
+	require.NoError(t, err)
+	require.Equal(t, [][]*AnchoredCommitment{
+		{coin1}, {coin2}, {coin3},
+	}, selected)
+
+	// The shared anchor output was selected for two constraints, but all
+	// coins were leased at once.
+	require.Equal(t, 1, coinLister.leaseCalls)
+	require.ElementsMatch(
+		t, []wire.OutPoint{anchor1, anchor1, anchor2},
+		coinLister.leased,
+	)
+
+	// If the coins selected for one constraint can't be used for another,
+	// nothing is leased at all.
+	coinLister = &mockCoinLister{
+		eligibleCommitments: []*AnchoredCommitment{coin1, coin2, coin3},
+	}
+	coinSelect = NewCoinSelect(coinLister)
+
+	_, err = coinSelect.SelectCoinsBatch(
+		ctx, []CommitmentConstraints{
+			{AssetID: &id1, MinAmt: 6},
+			{AssetID: &id1, MinAmt: 3},
+			{AssetID: &id2, MinAmt: 7},
+		}, PreferMaxAmount,
+	)
+	require.Error(t, err)
+	require.Zero(t, coinLister.leaseCalls)
+}
+
+// TestBatchSpendInputCommitments tests that the passive assets of an anchor
+// output shared by multiple virtual transactions of a batch are only carried
+// along by the first of them, and that the assets spent by the other virtual
+// transactions are removed from the input commitments.
+func TestBatchSpendInputCommitments(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sharedAnchor = wire.OutPoint{Hash: test.RandHash(), Index: 1}
+		ownAnchor    = wire.OutPoint{Hash: test.RandHash(), Index: 2}
+		active1      = asset.RandAsset(t, asset.Normal)
+		active2      = asset.RandAsset(t, asset.Normal)
+		passive      = asset.RandAsset(t, asset.Normal)
+		other        = asset.RandAsset(t, asset.Normal)
+	)
+	sharedCommitment, err := commitment.FromAssets(
+		active1, active2, passive,
+	)
+	require.NoError(t, err)
+	ownCommitment, err := commitment.FromAssets(other)
+	require.NoError(t, err)
+
+	newCoin := func(a *asset.Asset, anchor wire.OutPoint,
+		c *commitment.TapCommitment) *AnchoredCommitment {
+
+		return &AnchoredCommitment{
+			AnchorPoint: anchor,
+			Commitment:  c,
+			Asset:       a,
+		}
+	}
+	selectedCoins := [][]*AnchoredCommitment{{
+		newCoin(active1, sharedAnchor, sharedCommitment),
+	}, {
+		newCoin(active2, sharedAnchor, sharedCommitment),
+		newCoin(other, ownAnchor, ownCommitment),
+	}}
+	newVPkt := func(coins []*AnchoredCommitment) (*tappsbt.VPacket,
+		tappsbt.InputCommitments) {
+
+		vPkt := &tappsbt.VPacket{}
+		inputCommitments := make(tappsbt.InputCommitments)
+		for idx, coin := range coins {
+			vPkt.Inputs = append(vPkt.Inputs, &tappsbt.VInput{
+				PrevID: coin.PrevID(),
+			})
+			inputCommitments[idx] = coin.Commitment
+		}
+
+		return vPkt, inputCommitments
+	}
+	assetsOf := func(c *commitment.TapCommitment) []*asset.Asset {
+		return c.CommittedAssets()
+	}
+
+	spends := newBatchSpends(selectedCoins)
+	require.Len(t, spends, 2)
+
+	// The first virtual transaction carries along the passive asset of the
+	// shared anchor output, but not the asset spent by the second one.
+	vPkt1, inputCommitments1 := newVPkt(selectedCoins[0])
+	trimmed1, err := spends[0].inputCommitments(vPkt1, inputCommitments1)
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t, []*asset.Asset{active1, passive}, assetsOf(trimmed1[0]),
+	)
+
+	// The second virtual transaction only keeps its own asset of the shared
+	// anchor output, while the anchor output only it spends from stays
+	// untouched.
+	vPkt2, inputCommitments2 := newVPkt(selectedCoins[1])
+	trimmed2, err := spends[1].inputCommitments(vPkt2, inputCommitments2)
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t, []*asset.Asset{active2}, assetsOf(trimmed2[0]),
+	)
+	require.Same(t, ownCommitment, trimmed2[1])
+
+	// Outside a batch, the input commitments aren't changed at all.
+	var noBatch *batchSpend
+	unchanged, err := noBatch.inputCommitments(vPkt1, inputCommitments1)
+	require.NoError(t, err)
+	require.Equal(t, inputCommitments1, unchanged)
+}
+
+// TestSelectForConsolidation tests that consolidation selects the smallest
+// coins of each asset ID, starting with the asset ID with the most coins, up to
+// the maximum number of inputs.
+func TestSelectForConsolidation(t *testing.T) {
+	t.Parallel()
+
+	var (
+		genesis1 = asset.Genesis{Tag: "asset-1"}
+		genesis2 = asset.Genesis{Tag: "asset-2"}
+	)
+	newCommitment := func(genesis asset.Genesis,
+		amount uint64) *AnchoredCommitment {
+
+		return &AnchoredCommitment{
+			Asset: &asset.Asset{
+				Genesis: genesis,
+				Amount:  amount,
+			},
+		}
+	}
+
+	commitments := []*AnchoredCommitment{
+		newCommitment(genesis2, 50),
+		newCommitment(genesis1, 30),
+		newCommitment(genesis1, 10),
+		newCommitment(genesis1, 20),
+		newCommitment(genesis2, 5),
+	}
+
+	selected, err := selectForConsolidation(commitments, 2)
+	require.NoError(t, err)
+	require.Equal(t, [][]*AnchoredCommitment{{
+		newCommitment(genesis1, 10), newCommitment(genesis1, 20),
+	}}, selected)
+
+	// The coins of different asset IDs, like the tranches of an asset
+	// group, are all selected, one set per asset ID.
+	selected, err = selectForConsolidation(commitments, 100)
+	require.NoError(t, err)
+	require.Equal(t, [][]*AnchoredCommitment{{
+		newCommitment(genesis1, 10), newCommitment(genesis1, 20),
+		newCommitment(genesis1, 30),
+	}, {
+		newCommitment(genesis2, 5), newCommitment(genesis2, 50),
+	}}, selected)
+
+	// A single remaining input isn't enough to merge another asset ID.
+	selected, err = selectForConsolidation(commitments, 4)
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	require.Len(t, selected[0], 3)
+
+	// A single coin or a limit of one input can't be consolidated.
+	_, err = selectForConsolidation(commitments[:2], 100)
+	require.ErrorIs(t, err, ErrNothingToConsolidate)
+
+	_, err = selectForConsolidation(commitments, 1)
+	require.ErrorIs(t, err, ErrNothingToConsolidate)
+}
+
+// TestParseCoinSelectStrategy tests that all coin selection strategies can be
+// parsed from their string representation.
+func TestParseCoinSelectStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategies := []MultiCommitmentSelectStrategy{
+		PreferMaxAmount, PreferMinAmount, PreferExactMatch,
+		PreferOldest, AvoidAnchorMixing,
+	}
+	for _, strategy := range strategies {
+		parsed, err := ParseCoinSelectStrategy(strategy.String())
+		require.NoError(t, err)
+		require.Equal(t, strategy, parsed)
+	}
+
+	_, err := ParseCoinSelectStrategy("unknown")
+	require.Error(t, err)
+}
+
+// TestAnchorInputValue tests that the value of anchor outputs that are spent
+// by multiple virtual inputs is only counted once.
+func TestAnchorInputValue(t *testing.T) {
+	t.Parallel()
+
+	var (
+		outPoint1 = wire.OutPoint{Hash: test.RandHash(), Index: 1}
+		outPoint2 = wire.OutPoint{Hash: test.RandHash(), Index: 2}
+	)
+	newInput := func(op wire.OutPoint, value int64) *tappsbt.VInput {
+		return &tappsbt.VInput{
+			PrevID: asset.PrevID{OutPoint: op},
+			Anchor: tappsbt.Anchor{Value: btcutil.Amount(value)},
+		}
+	}
+
+	vPkt1 := &tappsbt.VPacket{
+		Inputs: []*tappsbt.VInput{
+			newInput(outPoint1, 1_000), newInput(outPoint1, 1_000),
+		},
+	}
+	vPkt2 := &tappsbt.VPacket{
+		Inputs: []*tappsbt.VInput{newInput(outPoint2, 2_000)},
+	}
+
+	require.EqualValues(t, 1_000, anchorInputValue(vPkt1))
+	require.EqualValues(t, 3_000, anchorInputValue(vPkt1, vPkt2))
+	require.Zero(t, anchorInputValue())
+}
@@ -14,6 +14,10 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 )
 
+// MaxBatchNameLength is the maximum byte length of the name of a minting
+// batch.
+const MaxBatchNameLength = 64
+
 // AssetMetas maps the serialized script key of an asset to the meta reveal for
 // that asset, if it has one.
 type AssetMetas map[asset.SerializedKey]*proof.MetaReveal
@@ -26,6 +30,10 @@ type MintingBatch struct {
 	// CreationTime is the time that this batch was created.
 	CreationTime time.Time
 
+	// Name is the name of the batch, which is unique among all pending
+	// batches. The default pending batch has an empty name.
+	Name string
+
 	// HeightHint is the recorded block height at time of creating this
 	// batch. We use it to know where to start looking for the signed batch
 	// transaction.
@@ -49,9 +57,17 @@ type MintingBatch struct {
 	// within this batch.
 	//
 	// NOTE: This field is only set if the state is beyond
-	// BatchStateCommitted.
+	// BatchStateCommitted, or if the frozen batch is waiting for
+	// externally signed group witnesses.
 	GenesisPacket *FundedPsbt
 
+	// ReplacedGenesisPackets are the genesis packets that were replaced by
+	// fee bumps of the GenesisPacket. As any of them might still confirm
+	// instead of the GenesisPacket, we keep watching them.
+	//
+	// NOTE: This field is only set if the state is BatchStateBroadcast.
+	ReplacedGenesisPackets []*FundedPsbt
+
 	// RootAssetCommitment is the root Taproot Asset commitment for all the
 	// assets contained in this batch.
 	//
@@ -63,6 +79,38 @@ type MintingBatch struct {
 	// reveal for that asset, if it has one.
 	AssetMetas AssetMetas
 
+	// ExternalFunding is true if the GenesisPacket was funded by an
+	// external wallet. The packet then also needs to be signed by that
+	// wallet before the batch can be broadcast.
+	ExternalFunding bool
+
+	// Schedule optionally determines when the batch is finalized while
+	// it's pending.
+	Schedule BatchSchedule
+
+	// FinalizeParams are the parameters the batch was finalized with,
+	// which determine how its genesis transaction is funded.
+	//
+	// NOTE: This field is only set if the state is beyond
+	// BatchStatePending.
+	FinalizeParams FinalizeParams
+
+	// UnsignedGroupWitnesses are the group witnesses of the assets in the
+	// batch that must be signed with an external group key before the
+	// batch can be committed.
+	//
+	// NOTE: This field is only set while the frozen batch is waiting for
+	// the signed group witnesses.
+	UnsignedGroupWitnesses []*UnsignedGroupWitness
+
+	// FrozenSprouts are the sprouts of a frozen batch that is waiting for
+	// externally signed group witnesses. The sprouts that need such a
+	// witness lack it until it's provided.
+	//
+	// NOTE: This field is only set while the frozen batch is waiting for
+	// the signed group witnesses.
+	FrozenSprouts []*asset.Asset
+
 	// mintingPubKey is the top-level Taproot output key that will be used
 	// to commit to the Taproot Asset commitment above.
 	mintingPubKey *btcec.PublicKey
@@ -149,3 +197,20 @@ func (m *MintingBatch) State() BatchState {
 func (m *MintingBatch) UpdateState(state BatchState) {
 	m.batchState.Store(uint32(state))
 }
+
+// replaceGenesisPacket replaces the genesis packet of the batch with the given
+// one and adds the replaced packet to the set of replaced genesis packets. If
+// the new packet was replaced before, it's no longer a replaced packet.
+func (m *MintingBatch) replaceGenesisPacket(genesisPkt *FundedPsbt) {
+	newTxHash := genesisPkt.Pkt.UnsignedTx.TxHash()
+
+	var replacedPkts []*FundedPsbt
+	for _, replacedPkt := range m.ReplacedGenesisPackets {
+		if replacedPkt.Pkt.UnsignedTx.TxHash() != newTxHash {
+			replacedPkts = append(replacedPkts, replacedPkt)
+		}
+	}
+
+	m.ReplacedGenesisPackets = append(replacedPkts, m.GenesisPacket)
+	m.GenesisPacket = genesisPkt
+}
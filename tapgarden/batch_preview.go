@@ -0,0 +1,288 @@
+package tapgarden
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// BatchPreview is the result of a dry run of a pending batch. It shows the
+// assets, commitment and fee of a batch with the same seedlings and
+// parameters, but nothing is signed, broadcast or written to disk, and the
+// pending batch stays in BatchStatePending.
+//
+// NOTE: The preview isn't the batch that's minted once the pending batch is
+// finalized. The dry run derives its own script keys and group keys, and
+// releases its genesis inputs again. The finalized batch therefore has other
+// keys, and unless the genesis inputs are specified when finalizing, another
+// genesis point and other asset IDs. The preview is only meant to check the
+// seedlings and estimate the fee of the batch.
+type BatchPreview struct {
+	// Batch is the pending batch the preview was created for.
+	Batch *MintingBatch
+
+	// GenesisPacket is the funded but unsigned genesis packet of the batch,
+	// including the fee it pays. The wallet inputs locked when funding the
+	// packet are unlocked again once the preview is created.
+	GenesisPacket *FundedPsbt
+
+	// AnchorOutputIndex is the index of the output of the genesis
+	// transaction that commits to the assets.
+	AnchorOutputIndex uint32
+
+	// Assets are the assets the batch would create.
+	Assets []*asset.Asset
+
+	// UnsignedGroupWitnesses are the group witnesses that the batch needs
+	// to be signed with an external group key. They can't be signed for
+	// the finalized batch, which commits to other script keys.
+	UnsignedGroupWitnesses []*UnsignedGroupWitness
+
+	// RootAssetCommitment is the Taproot Asset commitment of the batch.
+	//
+	// NOTE: This field is only set if no group witnesses must be signed
+	// externally, as the commitment also commits to the group witnesses.
+	RootAssetCommitment *commitment.TapCommitment
+
+	// DraftProofs are the genesis proofs of the assets, keyed by their
+	// script key. As the genesis transaction isn't confirmed, the proofs
+	// are anchored in a placeholder block that only contains the unsigned
+	// genesis transaction.
+	//
+	// NOTE: This field is only set if RootAssetCommitment is set.
+	DraftProofs proof.AssetProofs
+}
+
+// previewBatch runs a dry run of the given pending batch. The dry run works on
+// a copy of the batch with a caretaker that isn't started or tracked by the
+// planter, so the pending batch itself is never frozen, and none of the keys
+// derived for the dry run are used by the finalized batch.
+func (c *ChainPlanter) previewBatch(ctx context.Context, batch *MintingBatch,
+	params FinalizeParams) (*BatchPreview, error) {
+
+	draftBatch := &MintingBatch{
+		CreationTime: batch.CreationTime,
+		Name:         batch.Name,
+		HeightHint:   batch.HeightHint,
+		BatchKey:     batch.BatchKey,
+		Seedlings:    batch.Seedlings,
+		AssetMetas:   make(AssetMetas),
+	}
+	caretaker := NewBatchCaretaker(&BatchCaretakerConfig{
+		Batch:          draftBatch,
+		FinalizeParams: params,
+		GardenKit:      c.cfg.GardenKit,
+		ErrChan:        c.cfg.ErrChan,
+	})
+
+	preview, err := caretaker.previewBatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preview.Batch = batch
+
+	return preview, nil
+}
+
+// previewBatch funds the genesis packet of the caretaker's batch and turns its
+// seedlings into sprouts, then creates the commitment and the draft genesis
+// proofs of the sprouts, all without signing anything or committing it to
+// disk.
+func (b *BatchCaretaker) previewBatch(ctx context.Context) (*BatchPreview,
+	error) {
+
+	log.Infof("BatchCaretaker(%x): creating batch preview", b.batchKey[:])
+
+	genesisTxPkt, err := b.fundGenesisPsbt(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The packet of a preview is never signed, so we release the inputs
+	// our wallet locked for it right away.
+	if b.cfg.FinalizeParams.ExternalGenesisPsbt == nil {
+		defer b.unlockGenesisInputs(genesisTxPkt)
+	}
+
+	chainFees, err := GetTxFee(genesisTxPkt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for psbt: "+
+			"%w", err)
+	}
+	genesisTxPkt.ChainFees = chainFees
+
+	genesisPoint := extractGenesisOutpoint(genesisTxPkt.Pkt.UnsignedTx)
+	b.anchorOutputIndex = genesisAnchorOutputIndex(
+		genesisTxPkt.ChangeOutputIndex,
+	)
+
+	sprouts, unsignedWitnesses, err := b.seedlingsToAssetSprouts(
+		ctx, genesisPoint, b.anchorOutputIndex,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to map seedlings to sprouts: %w",
+			err)
+	}
+
+	preview := &BatchPreview{
+		GenesisPacket:          genesisTxPkt,
+		AnchorOutputIndex:      b.anchorOutputIndex,
+		Assets:                 sprouts,
+		UnsignedGroupWitnesses: unsignedWitnesses,
+	}
+
+	// Without the group witnesses, we can't know the final commitment of
+	// the batch.
+	if len(unsignedWitnesses) != 0 {
+		return preview, nil
+	}
+
+	tapCommitment, err := commitment.FromAssets(sprouts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create asset commitment: %w",
+			err)
+	}
+
+	b.cfg.Batch.RootAssetCommitment = tapCommitment
+	genesisScript, err := b.cfg.Batch.genesisScript()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create genesis script: %w",
+			err)
+	}
+
+	anchorOutput := genesisTxPkt.Pkt.UnsignedTx.TxOut[b.anchorOutputIndex]
+	anchorOutput.PkScript = genesisScript
+
+	for _, sprout := range sprouts {
+		seedling, ok := b.cfg.Batch.Seedlings[sprout.Tag]
+		if !ok {
+			continue
+		}
+
+		scriptKey := asset.ToSerialized(sprout.ScriptKey.PubKey)
+		b.cfg.Batch.AssetMetas[scriptKey] = seedling.Meta
+	}
+
+	draftProofs, err := b.draftMintingProofs(genesisTxPkt, tapCommitment)
+	if err != nil {
+		return nil, err
+	}
+
+	preview.RootAssetCommitment = tapCommitment
+	preview.DraftProofs = draftProofs
+
+	return preview, nil
+}
+
+// draftMintingProofs creates the genesis proofs of the committed assets of the
+// batch, anchored in a placeholder block that only contains the unsigned
+// genesis transaction.
+func (b *BatchCaretaker) draftMintingProofs(genesisTxPkt *FundedPsbt,
+	tapCommitment *commitment.TapCommitment) (proof.AssetProofs, error) {
+
+	genesisTx := genesisTxPkt.Pkt.UnsignedTx
+	draftBlock := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			MerkleRoot: genesisTx.TxHash(),
+		},
+		Transactions: []*wire.MsgTx{genesisTx},
+	}
+
+	baseProof := &proof.MintParams{
+		BaseProofParams: proof.BaseProofParams{
+			Block:            draftBlock,
+			Tx:               genesisTx,
+			OutputIndex:      int(b.anchorOutputIndex),
+			InternalKey:      b.cfg.Batch.BatchKey.PubKey,
+			TaprootAssetRoot: tapCommitment,
+		},
+		GenesisPoint: extractGenesisOutpoint(genesisTx),
+	}
+	err := proof.AddExclusionProofs(
+		&baseProof.BaseProofParams, genesisTxPkt.Pkt,
+		func(idx uint32) bool {
+			return idx == b.anchorOutputIndex
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add exclusion proofs: %w",
+			err)
+	}
+
+	// The placeholder block can't be verified against the chain, and the
+	// new groups of the batch aren't known yet, so only the group keys of
+	// the batch itself are accepted.
+	groupKeys := make(map[asset.SerializedKey]struct{})
+	for _, newAsset := range tapCommitment.CommittedAssets() {
+		if newAsset.GroupKey == nil {
+			continue
+		}
+
+		groupKey := asset.ToSerialized(&newAsset.GroupKey.GroupPubKey)
+		groupKeys[groupKey] = struct{}{}
+	}
+
+	headerVerifier := func(wire.BlockHeader, uint32) error {
+		return nil
+	}
+	groupVerifier := func(groupKey *btcec.PublicKey) error {
+		_, ok := groupKeys[asset.ToSerialized(groupKey)]
+		if !ok {
+			return ErrGroupKeyUnknown
+		}
+
+		return nil
+	}
+
+	draftProofs, err := proof.NewMintingBlobs(
+		baseProof, headerVerifier, groupVerifier, isGroupAnchor,
+		proof.WithAssetMetaReveals(b.cfg.Batch.AssetMetas),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct draft minting "+
+			"proofs: %w", err)
+	}
+
+	return draftProofs, nil
+}
+
+// isGroupAnchor returns an error if the given genesis isn't the genesis of the
+// asset that created the group with the given group key, which is the case if
+// the raw group key was tweaked with the ID of another genesis.
+func isGroupAnchor(gen *asset.Genesis, groupKey *asset.GroupKey) error {
+	genesisID := gen.ID()
+	anchorKey, err := asset.GroupPubKey(
+		groupKey.RawKey.PubKey, genesisID[:], groupKey.TapscriptRoot,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !anchorKey.IsEqual(&groupKey.GroupPubKey) {
+		return ErrGenesisNotGroupAnchor
+	}
+
+	return nil
+}
+
+// unlockGenesisInputs unlocks the inputs our wallet locked when funding the
+// given genesis packet. The wallet might have released the inputs already, so
+// failures are only logged.
+func (b *BatchCaretaker) unlockGenesisInputs(genesisTxPkt *FundedPsbt) {
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+
+	for _, op := range genesisTxPkt.LockedUTXOs {
+		err := b.cfg.Wallet.UnlockInput(ctx, op)
+		if err != nil {
+			log.Warnf("BatchCaretaker(%x): unable to unlock input "+
+				"%v: %v", b.batchKey[:], op, err)
+		}
+	}
+}
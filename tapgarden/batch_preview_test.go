@@ -0,0 +1,109 @@
+package tapgarden
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+// TestPreviewBatch tests that a dry run of a pending batch returns the assets,
+// commitment, fee and draft proofs of the batch without changing the pending
+// batch itself.
+func TestPreviewBatch(t *testing.T) {
+	t.Parallel()
+
+	wallet := NewMockWalletAnchor()
+	keyRing := NewMockKeyRing()
+	planter := NewChainPlanter(PlanterConfig{
+		GardenKit: GardenKit{
+			Wallet:       wallet,
+			KeyRing:      keyRing,
+			GenSigner:    NewMockGenSigner(keyRing),
+			GenTxBuilder: &tapscript.GroupTxBuilder{},
+			TxValidator:  &vmValidator{},
+		},
+	})
+
+	// The mocks signal every funded packet and derived key, which we don't
+	// need here.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-wallet.FundPsbtSignal:
+			case <-keyRing.ReqKeys:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	ctx := context.Background()
+	feeRate := chainfee.SatPerKWeight(1_000)
+	params := FinalizeParams{
+		FeeRate: &feeRate,
+	}
+
+	batch := RandSeedlingMintingBatch(t, 3)
+	preview, err := planter.previewBatch(ctx, batch, params)
+	require.NoError(t, err)
+
+	// The pending batch is left untouched.
+	require.Equal(t, batch, preview.Batch)
+	require.Equal(t, BatchStatePending, batch.State())
+	require.Nil(t, batch.GenesisPacket)
+	require.Nil(t, batch.RootAssetCommitment)
+
+	// The mock wallet adds an input of 100k sats and a change output of
+	// 50k sats next to the genesis output.
+	require.EqualValues(t, 49_000, preview.GenesisPacket.ChainFees)
+	require.Empty(t, preview.UnsignedGroupWitnesses)
+	require.Len(t, preview.Assets, len(batch.Seedlings))
+	require.Len(t, preview.DraftProofs, len(batch.Seedlings))
+
+	// The anchor output commits to the assets, and the draft proofs are
+	// anchored in the unsigned genesis transaction.
+	genesisTx := preview.GenesisPacket.Pkt.UnsignedTx
+	anchorOutput := genesisTx.TxOut[preview.AnchorOutputIndex]
+	tapscriptRoot := preview.RootAssetCommitment.TapscriptRoot(nil)
+	outputKey := txscript.ComputeTaprootOutputKey(
+		batch.BatchKey.PubKey, tapscriptRoot[:],
+	)
+	expectedScript, err := tapscript.PayToTaprootScript(outputKey)
+	require.NoError(t, err)
+	require.Equal(t, expectedScript, anchorOutput.PkScript)
+
+	for _, newAsset := range preview.Assets {
+		scriptKey := asset.ToSerialized(newAsset.ScriptKey.PubKey)
+		draftProof, ok := preview.DraftProofs[scriptKey]
+		require.True(t, ok)
+		require.Equal(t, newAsset.ID(), draftProof.Asset.ID())
+		require.Equal(
+			t, genesisTx.TxHash(), draftProof.AnchorTx.TxHash(),
+		)
+	}
+
+	// If a group witness must be signed externally, the commitment isn't
+	// known yet.
+	seedling := maps.Values(batch.Seedlings)[0]
+	seedling.EnableEmission = true
+	seedling.ExternalGroupKey = test.RandPubKey(t)
+
+	preview, err = planter.previewBatch(ctx, batch, params)
+	require.NoError(t, err)
+	require.Len(t, preview.UnsignedGroupWitnesses, 1)
+	require.Equal(
+		t, seedling.AssetName,
+		preview.UnsignedGroupWitnesses[0].AssetName,
+	)
+	require.Nil(t, preview.RootAssetCommitment)
+	require.Nil(t, preview.DraftProofs)
+}
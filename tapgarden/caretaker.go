@@ -25,6 +25,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
@@ -71,9 +72,9 @@ type BatchCaretakerConfig struct {
 	// Batch is the minting batch that this caretaker is responsible for?
 	Batch *MintingBatch
 
-	// BatchFeeRate is an optional manually-set feerate specified when
-	// finalizing a batch.
-	BatchFeeRate *chainfee.SatPerKWeight
+	// FinalizeParams are the optional parameters specified when
+	// finalizing a batch, used to fund the genesis transaction.
+	FinalizeParams FinalizeParams
 
 	GardenKit
 
@@ -128,6 +129,26 @@ type BatchCaretaker struct {
 	// confInfo is used to store a delivered confirmation event.
 	confInfo *chainntnfs.TxConfirmation
 
+	// stopConfWatch stops waiting for the confirmation of the currently
+	// broadcast genesis transaction, if we're waiting for one.
+	stopConfWatch func()
+
+	// bumpReqs is the channel requests to bump the fee of the genesis
+	// transaction are sent over.
+	bumpReqs chan *bumpFeeReq
+
+	// publishReqs is the channel the externally signed genesis packet of
+	// an externally funded batch is sent over.
+	publishReqs chan *publishSignedReq
+
+	// witnessReqs is the channel the externally signed group witnesses of
+	// a frozen batch are sent over.
+	witnessReqs chan *groupWitnessReq
+
+	// cultivatorDone is closed once the main goroutine of the caretaker
+	// exited, after which no more requests are handled.
+	cultivatorDone chan struct{}
+
 	// anchorOutputIndex is the index in the anchor output that commits to
 	// the Taproot Asset commitment.
 	anchorOutputIndex uint32
@@ -143,9 +164,14 @@ type BatchCaretaker struct {
 // TODO(roasbeef): rename to Cultivator?
 func NewBatchCaretaker(cfg *BatchCaretakerConfig) *BatchCaretaker {
 	return &BatchCaretaker{
-		batchKey:  asset.ToSerialized(cfg.Batch.BatchKey.PubKey),
-		cfg:       cfg,
-		confEvent: make(chan *chainntnfs.TxConfirmation, 1),
+		batchKey:    asset.ToSerialized(cfg.Batch.BatchKey.PubKey),
+		cfg:         cfg,
+		confEvent:   make(chan *chainntnfs.TxConfirmation, 1),
+		bumpReqs:    make(chan *bumpFeeReq),
+		publishReqs: make(chan *publishSignedReq),
+		witnessReqs: make(chan *groupWitnessReq),
+
+		cultivatorDone: make(chan struct{}),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -286,6 +312,15 @@ func (b *BatchCaretaker) advanceStateUntil(currentState,
 // confirmation to progress the batch to the final terminal state.
 func (b *BatchCaretaker) assetCultivator() {
 	defer b.Wg.Done()
+	defer close(b.cultivatorDone)
+
+	// If the genesis packet was already funded before a restart, we need
+	// to know which output commits to the assets.
+	if b.cfg.Batch.GenesisPacket != nil {
+		b.anchorOutputIndex = genesisAnchorOutputIndex(
+			b.cfg.Batch.GenesisPacket.ChangeOutputIndex,
+		)
+	}
 
 	currentBatchState := b.cfg.Batch.State()
 	// If the batch is already marked as confirmed, then we just need to
@@ -310,7 +345,7 @@ func (b *BatchCaretaker) assetCultivator() {
 	// for some reason. If we can broadcast, then we'll await a
 	// confirmation notification, which'll let us advance to the final
 	// state.
-	_, err := b.advanceStateUntil(
+	currentBatchState, err := b.advanceStateUntil(
 		currentBatchState, BatchStateBroadcast,
 	)
 	if err != nil {
@@ -319,18 +354,37 @@ func (b *BatchCaretaker) assetCultivator() {
 		return
 	}
 
-	// We've now broadcast the minting transaction, so we can inform the
-	// caller that the synchronous part is over, and we're now entering the
-	// long-running, asynchronous part.
+	// We've now broadcast the minting transaction, committed the
+	// externally funded one, or need externally signed group witnesses,
+	// so we can inform the caller that the synchronous part is over, and
+	// we're now entering the long-running, asynchronous part.
 	b.cfg.BroadcastCompleteChan <- struct{}{}
 
+	// A batch with assets that need group witnesses signed with an
+	// external group key stops in the frozen state until the witnesses
+	// are provided.
+	if currentBatchState == BatchStateFrozen {
+		var ok bool
+		currentBatchState, ok = b.awaitGroupWitnesses()
+		if !ok {
+			return
+		}
+	}
+
+	// An externally funded batch stops in the committed state until the
+	// externally signed minting transaction is published.
+	if currentBatchState == BatchStateCommitted &&
+		!b.awaitSignedGenesisPsbt() {
+
+		return
+	}
+
 	// TODO(roasbeef): proper restart logic?
 
 	// At this point, we've advanced all the way to broadcasting the
 	// minting transaction, so we'll wait until we need to exit, or we get
-	// the confirmation notification.
-	//
-	// TODO(roasbeef): eventually should attempt to RBF if needed?
+	// the confirmation notification. Until then, the fee of the minting
+	// transaction can be bumped.
 	for {
 		select {
 		// We've received the confirmation notification, so we can
@@ -340,6 +394,14 @@ func (b *BatchCaretaker) assetCultivator() {
 				"hash=%v, height=%v)", b.batchKey[:],
 				confInfo.BlockHash, confInfo.BlockHeight)
 
+			// The confirmed transaction might be one that was
+			// replaced by a fee bump.
+			err := b.maybeRevertGenesisTx(confInfo.Tx.TxHash())
+			if err != nil {
+				log.Error(err)
+				return
+			}
+
 			b.confInfo = confInfo
 			b.cfg.Batch.UpdateState(BatchStateConfirmed)
 			currentBatchState = b.cfg.Batch.State()
@@ -360,6 +422,15 @@ func (b *BatchCaretaker) assetCultivator() {
 			b.cfg.SignalCompletion()
 			return
 
+		case req := <-b.bumpReqs:
+			batch, err := b.replaceGenesisTx(req.feeRate)
+			if err != nil {
+				req.errChan <- err
+				continue
+			}
+
+			req.respChan <- batch
+
 		case <-b.cfg.CancelReqChan:
 			b.cfg.CancelRespChan <- b.Cancel()
 
@@ -376,10 +447,28 @@ func (b *BatchCaretaker) assetCultivator() {
 // We need to use a dummy script as we can't know the actual script key since
 // that's dependent on the genesis outpoint.
 func (b *BatchCaretaker) fundGenesisPsbt(ctx context.Context) (*FundedPsbt, error) {
+	// If the genesis packet was already funded by an external wallet, we
+	// don't need to ask our wallet.
+	params := b.cfg.FinalizeParams
+	if params.ExternalGenesisPsbt != nil {
+		log.Infof("BatchCaretaker(%x): using externally funded "+
+			"GenesisPacket", b.batchKey[:])
+
+		return externalGenesisPsbt(params.ExternalGenesisPsbt)
+	}
+
 	log.Infof("BatchCaretaker(%x): attempting to fund GenesisPacket",
 		b.batchKey[:])
 
+	// If the inputs were specified when finalizing the batch, we add them
+	// to the template, so the wallet only adds a change output. The first
+	// input then becomes the genesis point.
 	txTemplate := wire.NewMsgTx(2)
+	for _, genesisInput := range params.GenesisInputs {
+		txTemplate.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: genesisInput,
+		})
+	}
 	txTemplate.AddTxOut(&DummyGenesisTxOut)
 	genesisPkt, err := psbt.NewFromUnsignedTx(txTemplate)
 	if err != nil {
@@ -393,15 +482,18 @@ func (b *BatchCaretaker) fundGenesisPsbt(ctx context.Context) (*FundedPsbt, erro
 	// of a fee rate estimate.
 	var feeRate chainfee.SatPerKWeight
 	switch {
-	case b.cfg.BatchFeeRate != nil:
-		feeRate = *b.cfg.BatchFeeRate
+	case params.FeeRate != nil:
+		feeRate = *params.FeeRate
 		log.Infof("BatchCaretaker(%x): using manual fee rate",
 			b.batchKey[:])
 
 	default:
-		feeRate, err = b.cfg.ChainBridge.EstimateFee(
-			ctx, GenesisConfTarget,
-		)
+		confTarget := uint32(GenesisConfTarget)
+		if params.ConfTarget != 0 {
+			confTarget = params.ConfTarget
+		}
+
+		feeRate, err = b.cfg.ChainBridge.EstimateFee(ctx, confTarget)
 		if err != nil {
 			return nil, fmt.Errorf("unable to estimate fee: %w", err)
 		}
@@ -417,30 +509,163 @@ func (b *BatchCaretaker) fundGenesisPsbt(ctx context.Context) (*FundedPsbt, erro
 		return nil, fmt.Errorf("unable to fund psbt: %w", err)
 	}
 
+	// The asset IDs commit to the genesis point, so we make sure the
+	// wallet didn't re-order the inputs we've specified.
+	genesisPoint := extractGenesisOutpoint(fundedGenesisPkt.Pkt.UnsignedTx)
+	if len(params.GenesisInputs) > 0 &&
+		genesisPoint != params.GenesisInputs[0] {
+
+		return nil, fmt.Errorf("funded genesis tx spends %v as "+
+			"genesis point instead of %v", genesisPoint,
+			params.GenesisInputs[0])
+	}
+
 	log.Infof("BatchCaretaker(%x): funded GenesisPacket", b.batchKey[:])
 	log.Tracef("GenesisPacket: %v", spew.Sdump(fundedGenesisPkt))
 
 	return &fundedGenesisPkt, nil
 }
 
+// commitSignedGenesisPsbt verifies the given fully signed genesis packet of the
+// batch, commits it to disk and imports the minting output key into the
+// backing wallet. Once this returns, the batch is in BatchStateBroadcast.
+func (b *BatchCaretaker) commitSignedGenesisPsbt(ctx context.Context,
+	signedPkt *psbt.Packet) error {
+
+	// Final TX sanity check.
+	signedTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(signedTx))
+	if err != nil {
+		return fmt.Errorf("genesis TX failed final checks: %w", err)
+	}
+
+	// Populate how much this tx paid in on-chain fees.
+	chainFees, err := GetTxFee(signedPkt)
+	if err != nil {
+		return fmt.Errorf("unable to get on-chain fees for psbt: %w",
+			err)
+	}
+
+	b.cfg.Batch.GenesisPacket.Pkt = signedPkt
+	b.cfg.Batch.GenesisPacket.ChainFees = chainFees
+
+	log.Infof("BatchCaretaker(%x): GenesisPacket absolute fee: %d sats",
+		b.batchKey[:], chainFees)
+	log.Infof("BatchCaretaker(%x): GenesisPacket finalized",
+		b.batchKey[:])
+	log.Tracef("GenesisPacket: %v", spew.Sdump(signedPkt))
+
+	// At this point we have a fully signed PSBT packet which'll create our
+	// set of assets once mined. We'll write this to disk, then import the
+	// public key into the wallet.
+	//
+	// TODO(roasbeef): re-run during the broadcast phase to ensure it's
+	// fully imported?
+	mintingOutputKey, tapRoot, err := b.cfg.Batch.MintingOutputKey()
+	if err != nil {
+		return err
+	}
+	err = b.cfg.Log.CommitSignedGenesisTx(
+		ctx, b.cfg.Batch.BatchKey.PubKey, b.cfg.Batch.GenesisPacket,
+		b.anchorOutputIndex, tapRoot,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to commit genesis tx: %w", err)
+	}
+
+	// With the genesis transaction committed to disk, we'll also import
+	// this public key into the backing wallet, so it recognizes the de
+	// minimis amt sats under out control.
+	//
+	// TODO(roasbeef): should be idempotent along w/ all other operations
+	// above
+	_, err = b.cfg.Wallet.ImportTaprootOutput(ctx, mintingOutputKey)
+	switch {
+	case err == nil:
+		break
+
+	// On restart, we'll get an error that the output has already been
+	// added to the wallet, so we'll catch this now and move along if so.
+	case strings.Contains(err.Error(), "already exists"):
+		break
+
+	case err != nil:
+		return fmt.Errorf("unable to import key: %w", err)
+	}
+
+	return nil
+}
+
 // extractGenesisOutpoint extracts the genesis point (the first output from the
 // genesis transaction).
 func extractGenesisOutpoint(tx *wire.MsgTx) wire.OutPoint {
 	return tx.TxIn[0].PreviousOutPoint
 }
 
+// sproutSeedlings funds the genesis packet of the frozen batch, then turns all
+// the seedlings of the batch into sprouts.
+func (b *BatchCaretaker) sproutSeedlings(ctx context.Context) error {
+	genesisTxPkt, err := b.fundGenesisPsbt(ctx)
+	if err != nil {
+		return err
+	}
+
+	genesisPoint := extractGenesisOutpoint(genesisTxPkt.Pkt.UnsignedTx)
+
+	// If the change output is first, then our commitment is second, and
+	// vice versa.
+	b.anchorOutputIndex = genesisAnchorOutputIndex(
+		genesisTxPkt.ChangeOutputIndex,
+	)
+
+	sprouts, unsignedWitnesses, err := b.seedlingsToAssetSprouts(
+		ctx, genesisPoint, b.anchorOutputIndex,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to map seedlings to sprouts: %v", err)
+	}
+
+	// If some group witnesses must be signed with an external group key,
+	// we'll store the genesis packet and the sprouts, as a different
+	// genesis point would change the virtual transactions to sign.
+	externalFunding := b.cfg.FinalizeParams.ExternalGenesisPsbt != nil
+	if len(unsignedWitnesses) != 0 {
+		err := b.cfg.Log.CommitFrozenSprouts(
+			ctx, b.cfg.Batch.BatchKey.PubKey, genesisTxPkt,
+			sprouts, unsignedWitnesses, externalFunding,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to commit frozen sprouts: "+
+				"%w", err)
+		}
+	}
+
+	b.cfg.Batch.GenesisPacket = genesisTxPkt
+	b.cfg.Batch.FrozenSprouts = sprouts
+	b.cfg.Batch.UnsignedGroupWitnesses = unsignedWitnesses
+	b.cfg.Batch.ExternalFunding = externalFunding
+
+	return nil
+}
+
 // seedlingsToAssetSprouts maps a set of seedlings in the internal batch into a
 // set of sprouts: Assets that aren't yet fully linked to broadcast genesis
-// transaction.
+// transaction. If a group witness must be signed with an external group key,
+// the sprout lacks the witness, and the unsigned group witness is returned.
 func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
-	genesisPoint wire.OutPoint,
-	assetOutputIndex uint32) (*commitment.TapCommitment, error) {
+	genesisPoint wire.OutPoint, assetOutputIndex uint32) ([]*asset.Asset,
+	[]*UnsignedGroupWitness, error) {
 
 	log.Infof("BatchCaretaker(%x): mapping %v seedlings to asset sprouts, "+
 		"with genesis_point=%v", b.batchKey[:],
 		len(b.cfg.Batch.Seedlings), genesisPoint)
 
 	newAssets := make([]*asset.Asset, 0, len(b.cfg.Batch.Seedlings))
+	var unsignedWitnesses []*UnsignedGroupWitness
 
 	// Seedlings that anchor a group may be referenced by other seedlings,
 	// and therefore need to be mapped to sprouts first so that we derive
@@ -469,16 +694,17 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 			ctx, asset.TaprootAssetsKeyFamily,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to obtain script "+
+			return nil, nil, fmt.Errorf("unable to obtain script "+
 				"key: %w", err)
 		}
 		tweakedScriptKey := asset.NewScriptKeyBip86(scriptKey)
 
 		var (
-			amount         uint64
-			groupInfo      *asset.AssetGroup
-			protoAsset     *asset.Asset
-			sproutGroupKey *asset.GroupKey
+			amount          uint64
+			groupInfo       *asset.AssetGroup
+			protoAsset      *asset.Asset
+			sproutGroupKey  *asset.GroupKey
+			unsignedWitness *UnsignedGroupWitness
 		)
 
 		// Determine the amount for the actual asset.
@@ -500,8 +726,15 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 		// was validated earlier and the corresponding group has already
 		// been created. We need to look up the group key and sign
 		// the asset genesis with that key.
+		//
+		// The group key is held externally if it was set for the
+		// seedling, or for the group anchor it references.
+		heldExternally := seedling.ExternalGroupKey != nil
 		if seedling.GroupAnchor != nil {
 			groupInfo = newGroups[*seedling.GroupAnchor]
+
+			anchor := b.cfg.Batch.Seedlings[*seedling.GroupAnchor]
+			heldExternally = anchor.ExternalGroupKey != nil
 		}
 
 		// If a group witness needs to be produced, then we will need a
@@ -512,43 +745,49 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 				asset.WithAssetVersion(seedling.AssetVersion),
 			)
 			if err != nil {
-				return nil, fmt.Errorf("unable to create "+
+				return nil, nil, fmt.Errorf("unable to create "+
 					"asset for group key signing: %w", err)
 			}
 		}
 
 		if groupInfo != nil {
-			sproutGroupKey, err = asset.DeriveGroupKey(
-				b.cfg.GenSigner, b.cfg.GenTxBuilder,
-				groupInfo.GroupKey.RawKey,
+			sproutGroupKey, unsignedWitness, err = b.newGroupKey(
+				groupInfo.GroupKey.RawKey, heldExternally,
+				groupInfo.GroupKey.TapscriptRoot,
 				*groupInfo.Genesis, protoAsset,
 			)
 			if err != nil {
-				return nil, fmt.Errorf("unable to tweak group "+
-					"key: %w", err)
+				return nil, nil, fmt.Errorf("unable to tweak "+
+					"group key: %w", err)
 			}
 		}
 
 		// If emission is enabled without a group key specified,
-		// then we'll need to generate another public key,
-		// then use that to derive the key group signature
-		// along with the tweaked key group.
+		// then we'll need to generate another public key, unless
+		// the group key is held externally, then use that to derive
+		// the key group signature along with the tweaked key group.
 		if seedling.EnableEmission {
-			rawGroupKey, err := b.cfg.KeyRing.DeriveNextKey(
-				ctx, asset.TaprootAssetsKeyFamily,
-			)
-			if err != nil {
-				return nil, fmt.Errorf("unable to derive "+
-					"group key: %w", err)
+			rawGroupKey := keychain.KeyDescriptor{
+				PubKey: seedling.ExternalGroupKey,
+			}
+			if seedling.ExternalGroupKey == nil {
+				rawGroupKey, err = b.cfg.KeyRing.DeriveNextKey(
+					ctx, asset.TaprootAssetsKeyFamily,
+				)
+				if err != nil {
+					return nil, nil, fmt.Errorf("unable "+
+						"to derive group key: %w", err)
+				}
 			}
 
-			sproutGroupKey, err = asset.DeriveGroupKey(
-				b.cfg.GenSigner, b.cfg.GenTxBuilder,
-				rawGroupKey, assetGen, protoAsset,
+			sproutGroupKey, unsignedWitness, err = b.newGroupKey(
+				rawGroupKey, heldExternally,
+				seedling.GroupTapscriptRoot,
+				assetGen, protoAsset,
 			)
 			if err != nil {
-				return nil, fmt.Errorf("unable to tweak group "+
-					"key: %w", err)
+				return nil, nil, fmt.Errorf("unable to tweak "+
+					"group key: %w", err)
 			}
 
 			newGroups[seedlingName] = &asset.AssetGroup{
@@ -565,15 +804,23 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 			asset.WithAssetVersion(seedling.AssetVersion),
 		)
 		if err != nil {
-			return nil, fmt.Errorf("unable to create new asset: %w",
-				err)
+			return nil, nil, fmt.Errorf("unable to create new "+
+				"asset: %w", err)
 		}
 
+		switch {
+		// The group witness must be signed externally, so it can only
+		// be verified once it's provided.
+		case unsignedWitness != nil:
+			unsignedWitnesses = append(
+				unsignedWitnesses, unsignedWitness,
+			)
+
 		// Verify the group witness if present.
-		if sproutGroupKey != nil {
+		case sproutGroupKey != nil:
 			err := b.cfg.TxValidator.Execute(newAsset, nil, nil)
 			if err != nil {
-				return nil, fmt.Errorf("unable to verify "+
+				return nil, nil, fmt.Errorf("unable to verify "+
 					"asset group witness: %w", err)
 			}
 		}
@@ -581,10 +828,126 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 		newAssets = append(newAssets, newAsset)
 	}
 
-	// Now that we have all our assets created, we'll make a new
-	// Taproot asset commitment, which commits to all the assets we
-	// created above in a new root.
-	return commitment.FromAssets(newAssets...)
+	return newAssets, unsignedWitnesses, nil
+}
+
+// watchGenesisTxConf registers for the confirmation of the given genesis
+// transaction and launches a goroutine that delivers the confirmation event to
+// the caretaker. Once the transaction confirms, the given cancel function is
+// called to stop waiting for any other genesis transaction of the batch.
+func (b *BatchCaretaker) watchGenesisTxConf(confCtx context.Context,
+	confCancel func(), genesisTx *wire.MsgTx) error {
+
+	heightHint := b.cfg.Batch.HeightHint
+	txHash := genesisTx.TxHash()
+	confNtfn, errChan, err := b.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		confCtx, &txHash, genesisTx.TxOut[0].PkScript, 1, heightHint,
+		true, nil,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to register for minting tx conf: %w",
+			err)
+	}
+
+	// Launch a goroutine that'll notify us when the transaction confirms.
+	//
+	// TODO(roasbeef): make blocking here?
+	b.Wg.Add(1)
+	go func() {
+		defer confCancel()
+		defer b.Wg.Done()
+
+		var confEvent *chainntnfs.TxConfirmation
+		select {
+		case confEvent = <-confNtfn.Confirmed:
+			log.Debugf("Got chain confirmation: %v",
+				confEvent.Tx.TxHash())
+
+		case err := <-errChan:
+			// The registration is cancelled once the genesis
+			// transaction is replaced, which isn't an error.
+			if confCtx.Err() != nil {
+				return
+			}
+
+			b.cfg.ErrChan <- fmt.Errorf("error getting "+
+				"confirmation: %w", err)
+			return
+
+		case <-confCtx.Done():
+			log.Debugf("Skipping TX confirmation, context done")
+			return
+
+		case <-b.cfg.CancelReqChan:
+			b.cfg.CancelRespChan <- b.Cancel()
+
+		case <-b.Quit:
+			log.Debugf("Skipping TX confirmation, exiting")
+			return
+		}
+
+		if confEvent == nil {
+			b.cfg.ErrChan <- fmt.Errorf("got empty confirmation " +
+				"event in batch")
+			return
+		}
+
+		select {
+		case b.confEvent <- confEvent:
+
+		case <-confCtx.Done():
+			log.Debugf("Skipping TX confirmation, context done")
+
+		case <-b.cfg.CancelReqChan:
+			b.cfg.CancelRespChan <- b.Cancel()
+
+		case <-b.Quit:
+			log.Debugf("Skipping TX confirmation, exiting")
+			return
+		}
+	}()
+
+	return nil
+}
+
+// maybeRevertGenesisTx switches the batch back to one of its replaced genesis
+// transactions if that one confirmed instead of the current one.
+func (b *BatchCaretaker) maybeRevertGenesisTx(
+	confirmedTxHash chainhash.Hash) error {
+
+	genesisPkt := b.cfg.Batch.GenesisPacket
+	if genesisPkt.Pkt.UnsignedTx.TxHash() == confirmedTxHash {
+		return nil
+	}
+
+	var confirmedPkt *FundedPsbt
+	for _, replacedPkt := range b.cfg.Batch.ReplacedGenesisPackets {
+		if replacedPkt.Pkt.UnsignedTx.TxHash() == confirmedTxHash {
+			confirmedPkt = replacedPkt
+			break
+		}
+	}
+	if confirmedPkt == nil {
+		return fmt.Errorf("confirmed tx %v is not a genesis tx of "+
+			"the batch", confirmedTxHash)
+	}
+
+	log.Infof("BatchCaretaker(%x): replaced genesis tx %v confirmed, "+
+		"reverting GenesisPacket", b.batchKey[:], confirmedTxHash)
+
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+	err := b.cfg.Log.ReplaceGenesisTx(
+		ctx, b.cfg.Batch.BatchKey.PubKey, confirmedPkt,
+		genesisAnchorOutputIndex(confirmedPkt.ChangeOutputIndex),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to revert genesis tx: %w", err)
+	}
+
+	b.cfg.Batch.replaceGenesisPacket(confirmedPkt)
+
+	return nil
 }
 
 // stateStep attempts to transition the state machine from one state to
@@ -600,7 +963,9 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		// Finalize the batch, then move the batch state to frozen.
 		ctx, cancel := b.WithCtxQuit()
 		defer cancel()
-		err := freezeMintingBatch(ctx, b.cfg.Log, b.cfg.Batch)
+		err := freezeMintingBatch(
+			ctx, b.cfg.Log, b.cfg.Batch, b.cfg.FinalizeParams,
+		)
 		if err != nil {
 			return 0, err
 		}
@@ -622,29 +987,38 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		// restart leases are gone
 		ctx, cancel := b.WithCtxQuitNoTimeout()
 		defer cancel()
-		genesisTxPkt, err := b.fundGenesisPsbt(ctx)
-		if err != nil {
-			return 0, err
+
+		// Unless the batch was waiting for externally signed group
+		// witnesses, we'll fund the genesis packet and turn all the
+		// seedlings into actual taproot assets first.
+		if b.cfg.Batch.GenesisPacket == nil {
+			if err := b.sproutSeedlings(ctx); err != nil {
+				return 0, err
+			}
 		}
 
-		genesisPoint := extractGenesisOutpoint(
-			genesisTxPkt.Pkt.UnsignedTx,
-		)
+		// If some group witnesses must be signed with an external
+		// group key, we'll stay in the frozen state until they're
+		// provided.
+		unsignedWitnesses := b.cfg.Batch.UnsignedGroupWitnesses
+		if len(unsignedWitnesses) != 0 {
+			log.Infof("BatchCaretaker(%x): %d group witnesses "+
+				"must be signed externally", b.batchKey[:],
+				len(unsignedWitnesses))
 
-		// If the change output is first, then our commitment is second,
-		// and vice versa.
-		b.anchorOutputIndex = 0
-		if genesisTxPkt.ChangeOutputIndex == 0 {
-			b.anchorOutputIndex = 1
+			return BatchStateFrozen, nil
 		}
 
-		// First, we'll turn all the seedlings into actual taproot assets.
-		tapCommitment, err := b.seedlingsToAssetSprouts(
-			ctx, genesisPoint, b.anchorOutputIndex,
+		// Now that we have all our assets created, we'll make a new
+		// Taproot asset commitment, which commits to all the assets
+		// we created above in a new root.
+		genesisTxPkt := b.cfg.Batch.GenesisPacket
+		tapCommitment, err := commitment.FromAssets(
+			b.cfg.Batch.FrozenSprouts...,
 		)
 		if err != nil {
-			return 0, fmt.Errorf("unable to map seedlings to "+
-				"sprouts: %v", err)
+			return 0, fmt.Errorf("unable to create asset "+
+				"commitment: %w", err)
 		}
 
 		b.cfg.Batch.RootAssetCommitment = tapCommitment
@@ -669,12 +1043,13 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		err = b.cfg.Log.AddSproutsToBatch(
 			ctx, b.cfg.Batch.BatchKey.PubKey,
 			genesisTxPkt, b.cfg.Batch.RootAssetCommitment,
+			b.cfg.Batch.ExternalFunding,
 		)
 		if err != nil {
 			return 0, fmt.Errorf("unable to commit batch: %w", err)
 		}
 
-		b.cfg.Batch.GenesisPacket = genesisTxPkt
+		b.cfg.Batch.FrozenSprouts = nil
 
 		// Now that we know the script key for all the assets, we'll
 		// populate the asset metas map as we need that to create the
@@ -702,6 +1077,15 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 	// We'll have the backing wallet sign the transaction, then import the
 	// resulting key into the wallet so it tracks the balance.
 	case BatchStateCommitted:
+		// An externally funded genesis packet needs to be signed by the
+		// external wallet, so we'll wait until it's published.
+		if b.cfg.Batch.ExternalFunding {
+			log.Infof("BatchCaretaker(%x): GenesisPacket funded "+
+				"externally, not signing", b.batchKey[:])
+
+			return BatchStateCommitted, nil
+		}
+
 		log.Infof("BatchCaretaker(%x): finalizing GenesisPacket",
 			b.batchKey[:])
 
@@ -718,76 +1102,10 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 			return 0, fmt.Errorf("unable to sign psbt: %w", err)
 		}
 
-		// Final TX sanity check.
-		signedTx, err := psbt.Extract(signedPkt)
-		if err != nil {
-			return 0, fmt.Errorf("unable to extract psbt: %w", err)
-		}
-
-		err = blockchain.CheckTransactionSanity(btcutil.NewTx(signedTx))
-		if err != nil {
-			return 0, fmt.Errorf("genesis TX failed final checks: "+
-				"%w", err)
-		}
-
-		b.cfg.Batch.GenesisPacket.Pkt = signedPkt
-
-		// Populate how much this tx paid in on-chain fees.
-		chainFees, err := GetTxFee(signedPkt)
-		if err != nil {
-			return 0, fmt.Errorf("unable to get on-chain fees "+
-				"for psbt: %w", err)
-		}
-		b.cfg.Batch.GenesisPacket.ChainFees = chainFees
-
-		log.Infof("BatchCaretaker(%x): GenesisPacket absolute fee: "+
-			"%d sats", chainFees)
-		log.Infof("BatchCaretaker(%x): GenesisPacket finalized",
-			b.batchKey[:])
-		log.Tracef("GenesisPacket: %v", spew.Sdump(signedPkt))
-
-		// At this point we have a fully signed PSBT packet which'll
-		// create our set of assets once mined. We'll write this to
-		// disk, then import the public key into the wallet.
-		//
-		// TODO(roasbeef): re-run during the broadcast phase to ensure
-		// it's fully imported?
-		mintingOutputKey, tapRoot, err := b.cfg.Batch.MintingOutputKey()
+		err = b.commitSignedGenesisPsbt(ctx, signedPkt)
 		if err != nil {
 			return 0, err
 		}
-		err = b.cfg.Log.CommitSignedGenesisTx(
-			ctx, b.cfg.Batch.BatchKey.PubKey,
-			b.cfg.Batch.GenesisPacket, b.anchorOutputIndex,
-			tapRoot,
-		)
-		if err != nil {
-			return 0, fmt.Errorf("unable to commit genesis "+
-				"tx: %w", err)
-		}
-
-		// With the genesis transaction committed to disk, we'll also
-		// import this public key into the backing wallet, so it
-		// recognizes the de minimis amt sats under out control.
-		//
-		// TODO(roasbeef): should be idempotent along w/ all other
-		// operations above
-		ctx, cancel = b.WithCtxQuit()
-		defer cancel()
-		_, err = b.cfg.Wallet.ImportTaprootOutput(ctx, mintingOutputKey)
-		switch {
-		case err == nil:
-			break
-
-		// On restart, we'll get an error that the output has already
-		// been added to the wallet, so we'll catch this now and move
-		// along if so.
-		case strings.Contains(err.Error(), "already exists"):
-			break
-
-		case err != nil:
-			return 0, fmt.Errorf("unable to import key: %w", err)
-		}
 
 		log.Infof("BatchCaretaker(%x): transition states: %v -> %v",
 			b.batchKey, BatchStateCommitted, BatchStateBroadcast)
@@ -825,74 +1143,36 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		// state that requires an on-chain event to shift from. We make
 		// sure to request that the block is included as well, since we
 		// need this to construct the proof files for each of the
-		// assets later.
-		//
-		// TODO(roasbeef): eventually want to be able to RBF the bump
-		heightHint := b.cfg.Batch.HeightHint
-		txHash := signedTx.TxHash()
-		confCtx, confCancel := b.WithCtxQuitNoTimeout()
-		confNtfn, errChan, err := b.cfg.ChainBridge.RegisterConfirmationsNtfn(
-			confCtx, &txHash, signedTx.TxOut[0].PkScript, 1,
-			heightHint, true, nil,
-		)
-		if err != nil {
-			return 0, fmt.Errorf("unable to register for "+
-				"minting tx conf: %v", err)
+		// assets later. If the genesis transaction was replaced, the
+		// replaced one might still confirm, so we wait for all of them
+		// and stop waiting once any of them confirms.
+		if b.stopConfWatch != nil {
+			b.stopConfWatch()
 		}
 
-		// Launch a goroutine that'll notify us when the transaction
-		// confirms.
-		//
-		// TODO(roasbeef): make blocking here?
-		b.Wg.Add(1)
-		go func() {
-			defer confCancel()
-			defer b.Wg.Done()
-
-			var confEvent *chainntnfs.TxConfirmation
-			select {
-			case confEvent = <-confNtfn.Confirmed:
-				log.Debugf("Got chain confirmation: %v",
-					confEvent.Tx.TxHash())
-
-			case err := <-errChan:
-				b.cfg.ErrChan <- fmt.Errorf("error getting "+
-					"confirmation: %w", err)
-				return
-
-			case <-confCtx.Done():
-				log.Debugf("Skipping TX confirmation, context " +
-					"done")
-
-			case <-b.cfg.CancelReqChan:
-				b.cfg.CancelRespChan <- b.Cancel()
-
-			case <-b.Quit:
-				log.Debugf("Skipping TX confirmation, exiting")
-				return
-			}
+		confCtx, confCancel := b.WithCtxQuitNoTimeout()
+		b.stopConfWatch = confCancel
 
-			if confEvent == nil {
-				b.cfg.ErrChan <- fmt.Errorf("got empty " +
-					"confirmation event in batch")
-				return
+		watchTxns := []*wire.MsgTx{signedTx}
+		for _, replacedPkt := range b.cfg.Batch.ReplacedGenesisPackets {
+			replacedTx, err := psbt.Extract(replacedPkt.Pkt)
+			if err != nil {
+				confCancel()
+				return 0, fmt.Errorf("unable to extract "+
+					"replaced genesis tx: %w", err)
 			}
+			watchTxns = append(watchTxns, replacedTx)
+		}
 
-			select {
-			case b.confEvent <- confEvent:
-
-			case <-confCtx.Done():
-				log.Debugf("Skipping TX confirmation, context " +
-					"done")
-
-			case <-b.cfg.CancelReqChan:
-				b.cfg.CancelRespChan <- b.Cancel()
-
-			case <-b.Quit:
-				log.Debugf("Skipping TX confirmation, exiting")
-				return
+		for _, watchTx := range watchTxns {
+			err := b.watchGenesisTxConf(
+				confCtx, confCancel, watchTx,
+			)
+			if err != nil {
+				confCancel()
+				return 0, err
 			}
-		}()
+		}
 
 		log.Infof("BatchCaretaker(%x): transition states: %v -> %v",
 			b.batchKey, BatchStateBroadcast, BatchStateBroadcast)
@@ -908,6 +1188,7 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		defer cancel()
 
 		headerVerifier := GenHeaderVerifier(ctx, b.cfg.ChainBridge)
+		medianTimeSource := GenMedianTimeSource(ctx, b.cfg.ChainBridge)
 		groupVerifier := GenGroupVerifier(ctx, b.cfg.Log)
 		groupAnchorVerifier := GenGroupAnchorVerifier(ctx, b.cfg.Log)
 
@@ -1005,7 +1286,8 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 
 			proofBlob, uniProof, err := b.storeMintingProof(
 				ctx, newAsset, mintingProof, mintTxHash,
-				headerVerifier, groupVerifier,
+				headerVerifier, medianTimeSource,
+				groupVerifier,
 			)
 			if err != nil {
 				return fmt.Errorf("unable to store "+
@@ -1095,6 +1377,7 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 func (b *BatchCaretaker) storeMintingProof(ctx context.Context,
 	a *asset.Asset, mintingProof *proof.Proof, mintTxHash chainhash.Hash,
 	headerVerifier proof.HeaderVerifier,
+	medianTimeSource proof.MedianTimeSource,
 	groupVerifier proof.GroupVerifier) (proof.Blob, *universe.Item,
 	error) {
 
@@ -1114,7 +1397,8 @@ func (b *BatchCaretaker) storeMintingProof(ctx context.Context,
 	}
 
 	err = b.cfg.ProofFiles.ImportProofs(
-		ctx, headerVerifier, groupVerifier, false, fullProof,
+		ctx, headerVerifier, medianTimeSource, groupVerifier, false,
+		fullProof,
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to insert proofs: %w", err)
@@ -1306,6 +1590,16 @@ func GenHeaderVerifier(ctx context.Context,
 	}
 }
 
+// GenMedianTimeSource generates a callback function that returns the median
+// time past of a block given a chain bridge.
+func GenMedianTimeSource(ctx context.Context,
+	chainBridge ChainBridge) proof.MedianTimeSource {
+
+	return func(height uint32) (time.Time, error) {
+		return chainBridge.MedianTime(ctx, height)
+	}
+}
+
 // assetGroupCacheSize is the size of the cache for group keys.
 const assetGroupCacheSize = 10000
 
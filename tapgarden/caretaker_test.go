@@ -0,0 +1,69 @@
+package tapgarden
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFundGenesisPsbtParams tests that the parameters specified when
+// finalizing a batch are used to fund the genesis transaction.
+func TestFundGenesisPsbtParams(t *testing.T) {
+	t.Parallel()
+
+	newCaretaker := func(params FinalizeParams) (*BatchCaretaker,
+		*MockChainBridge) {
+
+		wallet := NewMockWalletAnchor()
+		wallet.FundPsbtSignal = make(chan *FundedPsbt, 1)
+
+		chainBridge := NewMockChainBridge()
+		chainBridge.FeeEstimateSignal = make(chan struct{}, 1)
+
+		return NewBatchCaretaker(&BatchCaretakerConfig{
+			Batch: RandSeedlingMintingBatch(t, 1),
+			GardenKit: GardenKit{
+				Wallet:      wallet,
+				ChainBridge: chainBridge,
+			},
+			FinalizeParams: params,
+		}), chainBridge
+	}
+
+	ctx := context.Background()
+
+	// Without any parameters, the fee rate is estimated and the wallet
+	// selects the genesis input.
+	caretaker, chainBridge := newCaretaker(FinalizeParams{})
+	genesisPkt, err := caretaker.fundGenesisPsbt(ctx)
+	require.NoError(t, err)
+	require.Len(t, genesisPkt.Pkt.UnsignedTx.TxIn, 1)
+	require.Len(t, chainBridge.FeeEstimateSignal, 1)
+
+	// With a manual fee rate, no fee estimate is needed. The specified
+	// inputs are used to fund the genesis transaction, with the first one
+	// becoming the genesis point.
+	feeRate := chainfee.SatPerKWeight(1000)
+	genesisInputs := []wire.OutPoint{{
+		Hash:  test.RandHash(),
+		Index: 1,
+	}, {
+		Hash:  test.RandHash(),
+		Index: 2,
+	}}
+	caretaker, chainBridge = newCaretaker(FinalizeParams{
+		FeeRate:       &feeRate,
+		GenesisInputs: genesisInputs,
+	})
+	genesisPkt, err = caretaker.fundGenesisPsbt(ctx)
+	require.NoError(t, err)
+	require.Empty(t, chainBridge.FeeEstimateSignal)
+
+	genesisTx := genesisPkt.Pkt.UnsignedTx
+	require.Equal(t, genesisInputs[0], extractGenesisOutpoint(genesisTx))
+	require.Equal(t, genesisInputs[1], genesisTx.TxIn[1].PreviousOutPoint)
+}
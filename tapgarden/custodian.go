@@ -449,9 +449,12 @@ func (c *Custodian) inspectWalletTx(walletTx *lndclient.Transaction) error {
 			headerVerifier := GenHeaderVerifier(
 				ctx, c.cfg.ChainBridge,
 			)
+			medianTimeSource := GenMedianTimeSource(
+				ctx, c.cfg.ChainBridge,
+			)
 			err = c.cfg.ProofArchive.ImportProofs(
-				ctx, headerVerifier, c.cfg.GroupVerifier, false,
-				addrProof,
+				ctx, headerVerifier, medianTimeSource,
+				c.cfg.GroupVerifier, false, addrProof,
 			)
 			if err != nil {
 				log.Errorf("unable to import proofs: %v", err)
@@ -0,0 +1,274 @@
+package tapgarden
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+)
+
+var (
+	// ErrNotExternallyFunded is returned when a signed genesis transaction
+	// is published for a batch that is funded by the backing wallet.
+	ErrNotExternallyFunded = errors.New("batch genesis transaction not " +
+		"funded externally")
+
+	// ErrBatchNotCommitted is returned when a signed genesis transaction
+	// is published for a batch that isn't waiting for one.
+	ErrBatchNotCommitted = errors.New("batch not waiting for signed " +
+		"genesis transaction")
+)
+
+// publishSignedReq is a request to publish the externally signed genesis
+// transaction of a batch that is sent to the caretaker of the batch.
+type publishSignedReq struct {
+	// signedPkt is the externally signed genesis packet.
+	signedPkt *psbt.Packet
+
+	// respChan is the channel the updated batch will be sent over.
+	respChan chan *MintingBatch
+
+	// errChan is the channel the error will be sent over.
+	errChan chan error
+}
+
+// PublishSignedBatch verifies the given externally signed genesis packet of the
+// caretaker's batch, then commits it to disk and broadcasts it. The batch must
+// be externally funded and waiting for its signed genesis packet.
+func (b *BatchCaretaker) PublishSignedBatch(
+	signedPkt *psbt.Packet) (*MintingBatch, error) {
+
+	if !b.cfg.Batch.ExternalFunding {
+		return nil, ErrNotExternallyFunded
+	}
+	if b.cfg.Batch.State() != BatchStateCommitted {
+		return nil, ErrBatchNotCommitted
+	}
+
+	req := &publishSignedReq{
+		signedPkt: signedPkt,
+		respChan:  make(chan *MintingBatch, 1),
+		errChan:   make(chan error, 1),
+	}
+
+	select {
+	case b.publishReqs <- req:
+
+	case <-b.cultivatorDone:
+		return nil, fmt.Errorf("BatchCaretaker(%x), no longer active",
+			b.batchKey[:])
+
+	case <-b.Quit:
+		return nil, fmt.Errorf("BatchCaretaker(%x), shutting down",
+			b.batchKey[:])
+	}
+
+	select {
+	case err := <-req.errChan:
+		return nil, err
+
+	case batch := <-req.respChan:
+		return batch, nil
+
+	case <-b.Quit:
+		return nil, fmt.Errorf("BatchCaretaker(%x), shutting down",
+			b.batchKey[:])
+	}
+}
+
+// awaitSignedGenesisPsbt waits until the externally signed genesis packet of
+// the batch is published, then broadcasts it. False is returned if the
+// caretaker should exit instead.
+func (b *BatchCaretaker) awaitSignedGenesisPsbt() bool {
+	log.Infof("BatchCaretaker(%x): waiting for externally signed "+
+		"GenesisPacket", b.batchKey[:])
+
+	for {
+		select {
+		case req := <-b.publishReqs:
+			batch, err := b.publishSignedGenesisPsbt(req.signedPkt)
+			if err != nil {
+				req.errChan <- err
+
+				// If the signed packet was already committed
+				// to disk, it'll be broadcast again once we
+				// restart.
+				if b.cfg.Batch.State() != BatchStateCommitted {
+					return false
+				}
+
+				continue
+			}
+
+			req.respChan <- batch
+			return true
+
+		case <-b.cfg.CancelReqChan:
+			cancelResp := b.Cancel()
+			b.cfg.CancelRespChan <- cancelResp
+
+			if cancelResp.finalState != nil {
+				return false
+			}
+
+		case <-b.Quit:
+			return false
+		}
+	}
+}
+
+// publishSignedGenesisPsbt verifies and finalizes the externally signed
+// genesis packet, commits it to disk, then broadcasts it and waits for its
+// confirmation.
+func (b *BatchCaretaker) publishSignedGenesisPsbt(
+	signedPkt *psbt.Packet) (*MintingBatch, error) {
+
+	finalPkt, err := verifySignedGenesisPsbt(
+		b.cfg.Batch.GenesisPacket, b.anchorOutputIndex, signedPkt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+	if err := b.commitSignedGenesisPsbt(ctx, finalPkt); err != nil {
+		return nil, err
+	}
+
+	log.Infof("BatchCaretaker(%x): transition states: %v -> %v",
+		b.batchKey, BatchStateCommitted, BatchStateBroadcast)
+
+	b.cfg.Batch.UpdateState(BatchStateBroadcast)
+
+	_, err = b.stateStep(BatchStateBroadcast)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.cfg.Batch, nil
+}
+
+// externalGenesisPsbt returns the funded genesis packet for a genesis PSBT
+// that was funded by an external wallet. The packet must contain the
+// DummyGenesisTxOut as the genesis output and at most one other output for
+// the change.
+func externalGenesisPsbt(pkt *psbt.Packet) (*FundedPsbt, error) {
+	tx := pkt.UnsignedTx
+	if len(tx.TxIn) == 0 {
+		return nil, fmt.Errorf("external genesis psbt has no inputs")
+	}
+	if len(tx.TxOut) == 0 || len(tx.TxOut) > 2 {
+		return nil, fmt.Errorf("external genesis psbt must have a " +
+			"genesis output and at most one change output")
+	}
+
+	anchorIndex := -1
+	for idx, txOut := range tx.TxOut {
+		if !bytes.Equal(txOut.PkScript, DummyGenesisTxOut.PkScript) {
+			continue
+		}
+
+		if anchorIndex != -1 {
+			return nil, fmt.Errorf("external genesis psbt has " +
+				"more than one genesis output")
+		}
+		if txOut.Value != DummyGenesisTxOut.Value {
+			return nil, fmt.Errorf("genesis output must have a "+
+				"value of %d sats", DummyGenesisTxOut.Value)
+		}
+
+		anchorIndex = idx
+	}
+	if anchorIndex == -1 {
+		return nil, fmt.Errorf("external genesis psbt has no genesis " +
+			"output")
+	}
+
+	// The fee can only be calculated if the packet contains the UTXO
+	// information of all inputs, which the signer needs as well.
+	chainFees, err := GetTxFee(pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for "+
+			"external genesis psbt: %w", err)
+	}
+	if chainFees < 0 {
+		return nil, fmt.Errorf("external genesis psbt spends more "+
+			"than its inputs by %d sats", -chainFees)
+	}
+
+	changeIndex := int32(-1)
+	if len(tx.TxOut) == 2 {
+		changeIndex = int32(1 - anchorIndex)
+	}
+
+	return &FundedPsbt{
+		Pkt:               pkt,
+		ChangeOutputIndex: changeIndex,
+		ChainFees:         chainFees,
+	}, nil
+}
+
+// verifySignedGenesisPsbt verifies that the externally signed genesis packet
+// still spends the genesis point and creates the asset commitment output of
+// the unsigned genesis packet, and returns a finalized copy of it.
+func verifySignedGenesisPsbt(genesisPkt *FundedPsbt, anchorOutputIndex uint32,
+	signedPkt *psbt.Packet) (*psbt.Packet, error) {
+
+	unsignedTx := genesisPkt.Pkt.UnsignedTx
+	signedTx := signedPkt.UnsignedTx
+
+	genesisPoint := extractGenesisOutpoint(unsignedTx)
+	if len(signedTx.TxIn) == 0 ||
+		extractGenesisOutpoint(signedTx) != genesisPoint {
+
+		return nil, fmt.Errorf("signed genesis tx doesn't spend "+
+			"genesis point %v", genesisPoint)
+	}
+
+	anchorOut := unsignedTx.TxOut[anchorOutputIndex]
+	if int(anchorOutputIndex) >= len(signedTx.TxOut) ||
+		signedTx.TxOut[anchorOutputIndex].Value != anchorOut.Value ||
+		!bytes.Equal(
+			signedTx.TxOut[anchorOutputIndex].PkScript,
+			anchorOut.PkScript,
+		) {
+
+		return nil, fmt.Errorf("signed genesis tx doesn't create the "+
+			"asset commitment output %d", anchorOutputIndex)
+	}
+
+	if signedTx.TxHash() != unsignedTx.TxHash() {
+		return nil, fmt.Errorf("signed genesis tx doesn't match the " +
+			"unsigned genesis tx")
+	}
+
+	finalPkt, err := copyPsbt(signedPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = psbt.MaybeFinalizeAll(finalPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to finalize psbt, not all "+
+			"inputs signed: %w", err)
+	}
+
+	return finalPkt, nil
+}
+
+// copyPsbt returns a deep copy of the given PSBT packet.
+func copyPsbt(pkt *psbt.Packet) (*psbt.Packet, error) {
+	var buf bytes.Buffer
+	if err := pkt.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("unable to serialize psbt: %w", err)
+	}
+
+	pktCopy, err := psbt.NewFromRawBytes(&buf, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy psbt: %w", err)
+	}
+
+	return pktCopy, nil
+}
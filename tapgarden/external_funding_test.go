@@ -0,0 +1,153 @@
+package tapgarden
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// randExternalGenesisPsbt creates a genesis packet funded by an external
+// wallet, with the genesis output at the given index followed or preceded by
+// a change output.
+func randExternalGenesisPsbt(t *testing.T, anchorIndex int) *psbt.Packet {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  test.RandHash(),
+			Index: 1,
+		},
+	})
+
+	changeOut := &wire.TxOut{
+		Value:    50_000,
+		PkScript: bytes.Repeat([]byte{0x01}, 34),
+	}
+	genesisOut := DummyGenesisTxOut
+	if anchorIndex == 0 {
+		tx.AddTxOut(&genesisOut)
+		tx.AddTxOut(changeOut)
+	} else {
+		tx.AddTxOut(changeOut)
+		tx.AddTxOut(&genesisOut)
+	}
+
+	pkt, err := psbt.NewFromUnsignedTx(tx)
+	require.NoError(t, err)
+
+	pkt.Inputs[0].WitnessUtxo = &wire.TxOut{
+		Value:    52_000,
+		PkScript: bytes.Repeat([]byte{0x02}, 34),
+	}
+
+	return pkt
+}
+
+// TestExternalGenesisPsbt tests that the genesis output and change output of
+// an externally funded genesis packet are found, and that invalid packets are
+// rejected.
+func TestExternalGenesisPsbt(t *testing.T) {
+	t.Parallel()
+
+	for _, anchorIndex := range []int{0, 1} {
+		pkt := randExternalGenesisPsbt(t, anchorIndex)
+		fundedPkt, err := externalGenesisPsbt(pkt)
+		require.NoError(t, err)
+
+		require.EqualValues(
+			t, 1-anchorIndex, fundedPkt.ChangeOutputIndex,
+		)
+		require.EqualValues(
+			t, anchorIndex,
+			genesisAnchorOutputIndex(fundedPkt.ChangeOutputIndex),
+		)
+		require.EqualValues(t, 1_000, fundedPkt.ChainFees)
+	}
+
+	// Without a change output, the genesis output must be the first one.
+	pkt := randExternalGenesisPsbt(t, 0)
+	pkt.UnsignedTx.TxOut = pkt.UnsignedTx.TxOut[:1]
+	pkt.Outputs = pkt.Outputs[:1]
+	fundedPkt, err := externalGenesisPsbt(pkt)
+	require.NoError(t, err)
+	require.EqualValues(t, -1, fundedPkt.ChangeOutputIndex)
+	require.Zero(t, genesisAnchorOutputIndex(fundedPkt.ChangeOutputIndex))
+
+	// A packet without a genesis output is rejected.
+	pkt = randExternalGenesisPsbt(t, 0)
+	pkt.UnsignedTx.TxOut[0].PkScript = bytes.Repeat([]byte{0x03}, 34)
+	_, err = externalGenesisPsbt(pkt)
+	require.ErrorContains(t, err, "no genesis output")
+
+	// So is one with a genesis output of the wrong value.
+	pkt = randExternalGenesisPsbt(t, 1)
+	pkt.UnsignedTx.TxOut[1].Value++
+	_, err = externalGenesisPsbt(pkt)
+	require.ErrorContains(t, err, "genesis output must have a value")
+
+	// Or one that lacks the UTXO information of its inputs.
+	pkt = randExternalGenesisPsbt(t, 0)
+	pkt.Inputs[0].WitnessUtxo = nil
+	_, err = externalGenesisPsbt(pkt)
+	require.ErrorContains(t, err, "unable to get on-chain fees")
+}
+
+// TestVerifySignedGenesisPsbt tests that an externally signed genesis packet
+// is only accepted if it matches the unsigned genesis packet.
+func TestVerifySignedGenesisPsbt(t *testing.T) {
+	t.Parallel()
+
+	const anchorIndex = 1
+	genesisPkt, err := externalGenesisPsbt(
+		randExternalGenesisPsbt(t, anchorIndex),
+	)
+	require.NoError(t, err)
+
+	// Commit to some assets in the genesis output.
+	anchorScript := bytes.Repeat([]byte{0x04}, 34)
+	genesisPkt.Pkt.UnsignedTx.TxOut[anchorIndex].PkScript = anchorScript
+
+	signedPkt := func() *psbt.Packet {
+		pkt, err := copyPsbt(genesisPkt.Pkt)
+		require.NoError(t, err)
+
+		pkt.Inputs[0].FinalScriptWitness = append(
+			[]byte{0x01, 0x40}, make([]byte, 64)...,
+		)
+
+		return pkt
+	}
+
+	// The unsigned packet can't be finalized.
+	_, err = verifySignedGenesisPsbt(
+		genesisPkt, anchorIndex, genesisPkt.Pkt,
+	)
+	require.ErrorContains(t, err, "not all inputs signed")
+
+	finalPkt, err := verifySignedGenesisPsbt(
+		genesisPkt, anchorIndex, signedPkt(),
+	)
+	require.NoError(t, err)
+	require.True(t, finalPkt.IsComplete())
+
+	// Spending a different genesis point would change the asset IDs.
+	pkt := signedPkt()
+	pkt.UnsignedTx.TxIn[0].PreviousOutPoint.Index++
+	_, err = verifySignedGenesisPsbt(genesisPkt, anchorIndex, pkt)
+	require.ErrorContains(t, err, "doesn't spend genesis point")
+
+	// The asset commitment output must be intact.
+	pkt = signedPkt()
+	pkt.UnsignedTx.TxOut[anchorIndex].PkScript = DummyGenesisTxOut.PkScript
+	_, err = verifySignedGenesisPsbt(genesisPkt, anchorIndex, pkt)
+	require.ErrorContains(t, err, "asset commitment output")
+
+	// And so must the rest of the transaction.
+	pkt = signedPkt()
+	pkt.UnsignedTx.TxOut[1-anchorIndex].Value--
+	_, err = verifySignedGenesisPsbt(genesisPkt, anchorIndex, pkt)
+	require.ErrorContains(t, err, "doesn't match")
+}
@@ -0,0 +1,250 @@
+package tapgarden
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/mempool"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrBatchNotBroadcast is returned when a fee bump is requested for a
+	// batch whose genesis transaction isn't waiting for its confirmation.
+	ErrBatchNotBroadcast = errors.New("batch genesis transaction not " +
+		"waiting for confirmation")
+
+	// ErrFeeRateTooLow is returned when the requested fee rate wouldn't
+	// increase the fee paid for the genesis transaction.
+	ErrFeeRateTooLow = errors.New("fee rate too low to bump fee")
+)
+
+// minRelayFeeIncrement is the minimum fee rate by which a replacement
+// transaction must increase the fee of the transaction it replaces, as
+// required by BIP-0125 (rule 4) with the default incremental relay fee of
+// 1 sat/vByte.
+var minRelayFeeIncrement = chainfee.SatPerKVByte(1000).FeePerKWeight()
+
+// bumpFeeReq is a request to bump the fee of the genesis transaction of a
+// batch that is sent to the caretaker of the batch.
+type bumpFeeReq struct {
+	// feeRate is the target fee rate of the replacement genesis
+	// transaction.
+	feeRate chainfee.SatPerKWeight
+
+	// respChan is the channel the updated batch will be sent over.
+	respChan chan *MintingBatch
+
+	// errChan is the channel the error will be sent over.
+	errChan chan error
+}
+
+// BumpFee replaces the genesis transaction of the caretaker's batch with a
+// transaction that pays the given, higher fee rate. The batch must be waiting
+// for its genesis transaction to confirm.
+func (b *BatchCaretaker) BumpFee(
+	feeRate chainfee.SatPerKWeight) (*MintingBatch, error) {
+
+	if b.cfg.Batch.State() != BatchStateBroadcast {
+		return nil, ErrBatchNotBroadcast
+	}
+
+	// The replacement would need to be signed by the external wallet as
+	// well, which we can't do here.
+	if b.cfg.Batch.ExternalFunding {
+		return nil, fmt.Errorf("fee of externally funded batch " +
+			"can't be bumped")
+	}
+
+	req := &bumpFeeReq{
+		feeRate:  feeRate,
+		respChan: make(chan *MintingBatch, 1),
+		errChan:  make(chan error, 1),
+	}
+
+	select {
+	case b.bumpReqs <- req:
+
+	case <-b.cultivatorDone:
+		return nil, fmt.Errorf("BatchCaretaker(%x), no longer active",
+			b.batchKey[:])
+
+	case <-b.Quit:
+		return nil, fmt.Errorf("BatchCaretaker(%x), shutting down",
+			b.batchKey[:])
+	}
+
+	select {
+	case err := <-req.errChan:
+		return nil, err
+
+	case batch := <-req.respChan:
+		return batch, nil
+
+	case <-b.Quit:
+		return nil, fmt.Errorf("BatchCaretaker(%x), shutting down",
+			b.batchKey[:])
+	}
+}
+
+// replaceGenesisTx re-signs the genesis PSBT of the batch with the additional
+// fee deducted from the BTC change output, logs the replacement to disk and
+// then broadcasts it and waits for its confirmation instead of the replaced
+// transaction. As the replacement spends the same genesis input and creates
+// the same anchor output, the IDs of the assets in the batch stay the same.
+func (b *BatchCaretaker) replaceGenesisTx(
+	feeRate chainfee.SatPerKWeight) (*MintingBatch, error) {
+
+	if b.cfg.Batch.State() != BatchStateBroadcast {
+		return nil, ErrBatchNotBroadcast
+	}
+
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+
+	log.Infof("BatchCaretaker(%x): bumping fee of GenesisPacket to fee "+
+		"rate %v", b.batchKey[:], feeRate.FeePerKVByte())
+
+	genesisPkt := b.cfg.Batch.GenesisPacket
+	replacementPkt, err := rbfGenesisPsbt(genesisPkt, feeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	signedPkt, err := b.cfg.Wallet.SignAndFinalizePsbt(ctx, replacementPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign replacement psbt: %w",
+			err)
+	}
+
+	signedTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(signedTx))
+	if err != nil {
+		return nil, fmt.Errorf("replacement genesis TX failed final "+
+			"checks: %w", err)
+	}
+
+	chainFees, err := GetTxFee(signedPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for psbt: "+
+			"%w", err)
+	}
+
+	newGenesisPkt := &FundedPsbt{
+		Pkt:               signedPkt,
+		ChangeOutputIndex: genesisPkt.ChangeOutputIndex,
+		ChainFees:         chainFees,
+		LockedUTXOs:       genesisPkt.LockedUTXOs,
+	}
+
+	// The replacement is logged to disk before we broadcast it, so we'll
+	// keep re-broadcasting it after a restart.
+	err = b.cfg.Log.ReplaceGenesisTx(
+		ctx, b.cfg.Batch.BatchKey.PubKey, newGenesisPkt,
+		genesisAnchorOutputIndex(genesisPkt.ChangeOutputIndex),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to log replacement genesis tx: "+
+			"%w", err)
+	}
+
+	b.cfg.Batch.replaceGenesisPacket(newGenesisPkt)
+
+	log.Infof("BatchCaretaker(%x): GenesisPacket replaced, new absolute "+
+		"fee: %d sats", b.batchKey[:], chainFees)
+
+	// Broadcasting the replacement also makes us wait for its
+	// confirmation instead of the one of the replaced transaction.
+	_, err = b.stateStep(BatchStateBroadcast)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.cfg.Batch, nil
+}
+
+// genesisAnchorOutputIndex returns the index of the anchor output of a genesis
+// transaction with the given change output index. If the change output is
+// first, then the anchor output is second, and vice versa.
+func genesisAnchorOutputIndex(changeOutputIndex int32) uint32 {
+	if changeOutputIndex == 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// rbfGenesisPsbt returns an unsigned copy of the given signed genesis PSBT that
+// pays the given fee rate. The additional fee is deducted from the BTC change
+// output, so the genesis input and the anchor output stay the same.
+func rbfGenesisPsbt(genesisPkt *FundedPsbt,
+	feeRate chainfee.SatPerKWeight) (*psbt.Packet, error) {
+
+	changeIndex := genesisPkt.ChangeOutputIndex
+	if changeIndex < 0 ||
+		int(changeIndex) >= len(genesisPkt.Pkt.UnsignedTx.TxOut) {
+
+		return nil, fmt.Errorf("genesis transaction has no change " +
+			"output to pay the additional fee")
+	}
+
+	finalTx, err := psbt.Extract(genesisPkt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract final signed tx: %w",
+			err)
+	}
+
+	oldFee, err := GetTxFee(genesisPkt.Pkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get on-chain fees for psbt: "+
+			"%w", err)
+	}
+
+	// The replacement has the same inputs and outputs, so it has the same
+	// weight as the final transaction it replaces.
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(finalTx))
+	newFee := int64(feeRate.FeeForWeight(weight))
+	minFee := oldFee + int64(minRelayFeeIncrement.FeeForWeight(weight))
+	if newFee < minFee {
+		return nil, fmt.Errorf("%w: replacement must pay at least %d "+
+			"sats but only pays %d sats", ErrFeeRateTooLow, minFee,
+			newFee)
+	}
+
+	pkt, err := copyPsbt(genesisPkt.Pkt)
+	if err != nil {
+		return nil, err
+	}
+
+	// The wallet only signs inputs that aren't finalized yet, so we remove
+	// all signatures of the replaced transaction.
+	for idx := range pkt.Inputs {
+		pIn := &pkt.Inputs[idx]
+		pIn.FinalScriptSig = nil
+		pIn.FinalScriptWitness = nil
+		pIn.PartialSigs = nil
+		pIn.TaprootKeySpendSig = nil
+		pIn.TaprootScriptSpendSig = nil
+	}
+
+	changeOut := pkt.UnsignedTx.TxOut[changeIndex]
+	newValue := changeOut.Value - (newFee - oldFee)
+	// The dust limit of lnd panics for unknown script types, so we use
+	// the generic one of the mempool policy instead.
+	dustLimit := mempool.GetDustThreshold(changeOut)
+	if newValue < dustLimit {
+		return nil, fmt.Errorf("change output of %d sats too small "+
+			"to pay additional fee of %d sats", changeOut.Value,
+			newFee-oldFee)
+	}
+	changeOut.Value = newValue
+
+	return pkt, nil
+}
@@ -0,0 +1,139 @@
+package tapgarden
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// randSignedGenesisPacket creates a signed genesis packet with a single key
+// spend input, an anchor output and a change output, that pays the given fee
+// rate.
+func randSignedGenesisPacket(t *testing.T, changeValue int64,
+	feeRate chainfee.SatPerKWeight) *FundedPsbt {
+
+	p2trScript := func() []byte {
+		key := test.RandPubKey(t)
+		pkScript, err := tapscript.PayToTaprootScript(
+			txscript.ComputeTaprootKeyNoScript(key),
+		)
+		require.NoError(t, err)
+
+		return pkScript
+	}
+
+	unsignedTx := wire.NewMsgTx(2)
+	unsignedTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  test.RandHash(),
+			Index: 1,
+		},
+	})
+	unsignedTx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: p2trScript()})
+	unsignedTx.AddTxOut(&wire.TxOut{
+		Value:    changeValue,
+		PkScript: p2trScript(),
+	})
+
+	finalTx := unsignedTx.Copy()
+	finalTx.TxIn[0].Witness = wire.TxWitness{make([]byte, 64)}
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(finalTx))
+	fee := int64(feeRate.FeeForWeight(weight))
+
+	pkt, err := psbt.NewFromUnsignedTx(unsignedTx)
+	require.NoError(t, err)
+
+	pkt.Inputs[0].WitnessUtxo = &wire.TxOut{
+		Value:    1000 + changeValue + fee,
+		PkScript: p2trScript(),
+	}
+	pkt.Inputs[0].TaprootKeySpendSig = make([]byte, 64)
+	pkt.Inputs[0].FinalScriptWitness = []byte{0x01, 0x40}
+	pkt.Inputs[0].FinalScriptWitness = append(
+		pkt.Inputs[0].FinalScriptWitness, make([]byte, 64)...,
+	)
+
+	return &FundedPsbt{
+		Pkt:               pkt,
+		ChangeOutputIndex: 1,
+		ChainFees:         fee,
+	}
+}
+
+// TestRbfGenesisPsbt tests that the replacement of a genesis transaction keeps
+// the genesis input and the anchor output, only deducts the additional fee
+// from the change output and enforces the minimum relay fee increment.
+func TestRbfGenesisPsbt(t *testing.T) {
+	t.Parallel()
+
+	const oldFeeRate = chainfee.SatPerKWeight(253)
+	genesisPkt := randSignedGenesisPacket(t, 100_000, oldFeeRate)
+
+	finalTx, err := psbt.Extract(genesisPkt.Pkt)
+	require.NoError(t, err)
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(finalTx))
+
+	// A replacement at the same fee rate isn't relayed.
+	_, err = rbfGenesisPsbt(genesisPkt, oldFeeRate)
+	require.ErrorIs(t, err, ErrFeeRateTooLow)
+
+	// Neither is one that doesn't pay for its own relay bandwidth.
+	_, err = rbfGenesisPsbt(genesisPkt, oldFeeRate+minRelayFeeIncrement/2)
+	require.ErrorIs(t, err, ErrFeeRateTooLow)
+
+	// A sufficiently higher fee rate only changes the change output.
+	const newFeeRate = chainfee.SatPerKWeight(2500)
+	pkt, err := rbfGenesisPsbt(genesisPkt, newFeeRate)
+	require.NoError(t, err)
+
+	oldTx := genesisPkt.Pkt.UnsignedTx
+	newTx := pkt.UnsignedTx
+	require.Len(t, newTx.TxIn, 1)
+	require.Equal(
+		t, oldTx.TxIn[0].PreviousOutPoint,
+		newTx.TxIn[0].PreviousOutPoint,
+	)
+	require.Equal(t, oldTx.TxOut[0], newTx.TxOut[0])
+
+	newFee := int64(newFeeRate.FeeForWeight(weight))
+	require.Equal(
+		t, oldTx.TxOut[1].Value-(newFee-genesisPkt.ChainFees),
+		newTx.TxOut[1].Value,
+	)
+
+	// The replacement must be unsigned, so the wallet signs it again.
+	require.Empty(t, pkt.Inputs[0].FinalScriptWitness)
+	require.Empty(t, pkt.Inputs[0].TaprootKeySpendSig)
+
+	// The original packet must not be modified.
+	require.EqualValues(t, 100_000, oldTx.TxOut[1].Value)
+	require.NotEmpty(t, genesisPkt.Pkt.Inputs[0].FinalScriptWitness)
+
+	// If the change output can't pay for the additional fee, we can't
+	// replace the transaction.
+	genesisPkt = randSignedGenesisPacket(t, 1_000, oldFeeRate)
+	_, err = rbfGenesisPsbt(genesisPkt, newFeeRate)
+	require.ErrorContains(t, err, "too small")
+
+	// Without a change output, there's nothing to pay the fee from.
+	genesisPkt.ChangeOutputIndex = -1
+	_, err = rbfGenesisPsbt(genesisPkt, newFeeRate)
+	require.ErrorContains(t, err, "no change output")
+}
+
+// TestGenesisAnchorOutputIndex tests that the anchor output index is derived
+// correctly from the change output index of a genesis transaction.
+func TestGenesisAnchorOutputIndex(t *testing.T) {
+	t.Parallel()
+
+	require.EqualValues(t, 1, genesisAnchorOutputIndex(0))
+	require.EqualValues(t, 0, genesisAnchorOutputIndex(1))
+}
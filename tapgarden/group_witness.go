@@ -0,0 +1,310 @@
+package tapgarden
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+const (
+	// genesisLeaseDuration is the duration the wallet inputs of the genesis
+	// packet of a frozen batch are leased for while the batch waits for
+	// externally signed group witnesses.
+	genesisLeaseDuration = 10 * time.Minute
+
+	// genesisLeaseRenewInterval is how often the leases of the wallet
+	// inputs of the genesis packet are renewed while waiting.
+	genesisLeaseRenewInterval = genesisLeaseDuration / 2
+)
+
+var (
+	// ErrNoPendingGroupWitnesses is returned when group witnesses are
+	// provided for a batch that isn't waiting for any.
+	ErrNoPendingGroupWitnesses = errors.New("batch not waiting for " +
+		"group witnesses")
+)
+
+// UnsignedGroupWitness is the virtual transaction of a new grouped asset that
+// must be signed with an external group key to create the group witness of the
+// asset.
+type UnsignedGroupWitness struct {
+	// AssetName is the name of the seedling the asset is minted for.
+	AssetName string
+
+	// AssetID is the ID of the new asset.
+	AssetID asset.ID
+
+	// GroupKey is the group key of the new asset, without a witness.
+	GroupKey asset.GroupKey
+
+	// SingleTweak is the tweak that is applied to the raw group key before
+	// the tapscript tweak, which is the ID of the asset that created the
+	// group.
+	SingleTweak []byte
+
+	// VirtualTx is the virtual transaction that spends the genesis of the
+	// new asset, which must be signed to create the group witness.
+	VirtualTx *wire.MsgTx
+
+	// PrevOut is the output spent by the virtual transaction, which pays
+	// to the tweaked group key.
+	PrevOut *wire.TxOut
+}
+
+// groupWitnessReq is a request to add the externally signed group witnesses of
+// a frozen batch that is sent to the caretaker of the batch.
+type groupWitnessReq struct {
+	// witnesses maps the name of each asset that needs an external group
+	// witness to that witness.
+	witnesses map[string]wire.TxWitness
+
+	// respChan is the channel the updated batch will be sent over.
+	respChan chan *MintingBatch
+
+	// errChan is the channel the error will be sent over.
+	errChan chan error
+}
+
+// externalGroupKey returns the group key of a new grouped asset for a raw
+// group key that is held externally, together with the unsigned virtual
+// transaction that must be signed to create the group witness of the asset.
+func externalGroupKey(genBuilder asset.GenesisTxBuilder,
+	rawKey keychain.KeyDescriptor, tapscriptRoot []byte,
+	initialGen asset.Genesis, protoAsset *asset.Asset) (*asset.GroupKey,
+	*UnsignedGroupWitness, error) {
+
+	if initialGen.Type != protoAsset.Type {
+		return nil, nil, fmt.Errorf("asset group type mismatch")
+	}
+
+	genesisTweak := initialGen.ID()
+	tweakedGroupKey, err := asset.GroupPubKey(
+		rawKey.PubKey, genesisTweak[:], tapscriptRoot,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot tweak group key: %w", err)
+	}
+
+	groupKey := &asset.GroupKey{
+		RawKey:        rawKey,
+		GroupPubKey:   *tweakedGroupKey,
+		TapscriptRoot: tapscriptRoot,
+	}
+
+	assetWithGroup := protoAsset.Copy()
+	assetWithGroup.GroupKey = &asset.GroupKey{
+		GroupPubKey: *tweakedGroupKey,
+	}
+
+	genesisTx, prevOut, err := genBuilder.BuildGenesisTx(assetWithGroup)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot build virtual tx: %w", err)
+	}
+
+	return groupKey, &UnsignedGroupWitness{
+		AssetName:   protoAsset.Tag,
+		AssetID:     protoAsset.ID(),
+		GroupKey:    *groupKey,
+		SingleTweak: genesisTweak[:],
+		VirtualTx:   genesisTx,
+		PrevOut:     prevOut,
+	}, nil
+}
+
+// addGroupWitnesses returns a copy of the given sprouts with the externally
+// signed group witnesses added. A witness must be provided for each sprout
+// that lacks one, and every witness is verified before it's accepted.
+func addGroupWitnesses(sprouts []*asset.Asset,
+	witnesses map[string]wire.TxWitness,
+	validator tapscript.TxValidator) ([]*asset.Asset, error) {
+
+	signedSprouts := make([]*asset.Asset, 0, len(sprouts))
+	numSigned := 0
+	for _, sprout := range sprouts {
+		if !sprout.NeedsGenesisWitnessForGroup() {
+			signedSprouts = append(signedSprouts, sprout)
+			continue
+		}
+
+		witness, ok := witnesses[sprout.Tag]
+		if !ok || len(witness) == 0 {
+			return nil, fmt.Errorf("missing group witness for "+
+				"asset %v", sprout.Tag)
+		}
+
+		// The group witness is also stored as the genesis witness of
+		// the asset.
+		signedSprout := sprout.Copy()
+		signedSprout.GroupKey.Witness = witness
+		signedSprout.PrevWitnesses[0].TxWitness = witness
+		err := validator.Execute(signedSprout, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group witness for "+
+				"asset %v: %w", sprout.Tag, err)
+		}
+
+		signedSprouts = append(signedSprouts, signedSprout)
+		numSigned++
+	}
+
+	if numSigned != len(witnesses) {
+		return nil, fmt.Errorf("group witnesses provided for assets " +
+			"that don't need one")
+	}
+
+	return signedSprouts, nil
+}
+
+// AddGroupWitnesses adds the externally signed group witnesses of the assets
+// in the caretaker's batch, which continues the minting of the batch. The
+// batch must be frozen and waiting for the group witnesses.
+func (b *BatchCaretaker) AddGroupWitnesses(
+	witnesses map[string]wire.TxWitness) (*MintingBatch, error) {
+
+	if b.cfg.Batch.State() != BatchStateFrozen {
+		return nil, ErrNoPendingGroupWitnesses
+	}
+
+	req := &groupWitnessReq{
+		witnesses: witnesses,
+		respChan:  make(chan *MintingBatch, 1),
+		errChan:   make(chan error, 1),
+	}
+
+	select {
+	case b.witnessReqs <- req:
+
+	case <-b.cultivatorDone:
+		return nil, fmt.Errorf("BatchCaretaker(%x), no longer active",
+			b.batchKey[:])
+
+	case <-b.Quit:
+		return nil, fmt.Errorf("BatchCaretaker(%x), shutting down",
+			b.batchKey[:])
+	}
+
+	select {
+	case err := <-req.errChan:
+		return nil, err
+
+	case batch := <-req.respChan:
+		return batch, nil
+
+	case <-b.Quit:
+		return nil, fmt.Errorf("BatchCaretaker(%x), shutting down",
+			b.batchKey[:])
+	}
+}
+
+// awaitGroupWitnesses waits until the externally signed group witnesses of the
+// frozen batch are provided, then advances the batch until it's broadcast, or
+// committed if it's externally funded. False is returned if the caretaker
+// should exit instead.
+func (b *BatchCaretaker) awaitGroupWitnesses() (BatchState, bool) {
+	log.Infof("BatchCaretaker(%x): waiting for %d externally signed "+
+		"group witnesses", b.batchKey[:],
+		len(b.cfg.Batch.UnsignedGroupWitnesses))
+
+	// The wait is indefinite, so we'll keep the wallet inputs of the
+	// genesis packet leased until the witnesses are provided. The leases
+	// may also have expired during a restart.
+	b.renewGenesisLeases()
+
+	leaseTicker := time.NewTicker(genesisLeaseRenewInterval)
+	defer leaseTicker.Stop()
+
+	for {
+		select {
+		case <-leaseTicker.C:
+			b.renewGenesisLeases()
+
+		case req := <-b.witnessReqs:
+			signedSprouts, err := addGroupWitnesses(
+				b.cfg.Batch.FrozenSprouts, req.witnesses,
+				b.cfg.TxValidator,
+			)
+			if err != nil {
+				req.errChan <- err
+				continue
+			}
+
+			b.cfg.Batch.FrozenSprouts = signedSprouts
+			b.cfg.Batch.UnsignedGroupWitnesses = nil
+
+			batchState, err := b.advanceStateUntil(
+				BatchStateFrozen, BatchStateBroadcast,
+			)
+			if err != nil {
+				log.Errorf("unable to advance state machine: "+
+					"%v", err)
+				req.errChan <- err
+				return 0, false
+			}
+
+			req.respChan <- b.cfg.Batch
+			return batchState, true
+
+		case <-b.cfg.CancelReqChan:
+			cancelResp := b.Cancel()
+			b.cfg.CancelRespChan <- cancelResp
+
+			if cancelResp.finalState != nil {
+				return 0, false
+			}
+
+		case <-b.Quit:
+			return 0, false
+		}
+	}
+}
+
+// renewGenesisLeases leases the wallet inputs of the genesis packet of the
+// frozen batch for another genesisLeaseDuration. The inputs of an externally
+// funded genesis packet aren't owned by the wallet, so they're skipped.
+func (b *BatchCaretaker) renewGenesisLeases() {
+	genesisPkt := b.cfg.Batch.GenesisPacket
+	if genesisPkt == nil || b.cfg.Batch.ExternalFunding {
+		return
+	}
+
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+
+	for _, txIn := range genesisPkt.Pkt.UnsignedTx.TxIn {
+		op := txIn.PreviousOutPoint
+		err := b.cfg.Wallet.LeaseInput(ctx, op, genesisLeaseDuration)
+		if err != nil {
+			log.Warnf("BatchCaretaker(%x): unable to lease "+
+				"genesis input %v: %v", b.batchKey[:], op, err)
+		}
+	}
+}
+
+// newGroupKey derives the group key of a new grouped asset. If the raw group
+// key is held by the daemon, the group witness is signed right away. Otherwise,
+// the returned group key lacks the witness, and the unsigned group witness is
+// returned instead.
+func (b *BatchCaretaker) newGroupKey(rawKey keychain.KeyDescriptor,
+	external bool, tapscriptRoot []byte, initialGen asset.Genesis,
+	protoAsset *asset.Asset) (*asset.GroupKey, *UnsignedGroupWitness,
+	error) {
+
+	if !external {
+		localKey, err := asset.DeriveGroupKey(
+			b.cfg.GenSigner, b.cfg.GenTxBuilder, rawKey, initialGen,
+			protoAsset,
+		)
+
+		return localKey, nil, err
+	}
+
+	return externalGroupKey(
+		b.cfg.GenTxBuilder, rawKey, tapscriptRoot, initialGen,
+		protoAsset,
+	)
+}
@@ -0,0 +1,233 @@
+package tapgarden
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/tapscript"
+	"github.com/lightninglabs/taproot-assets/vm"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// vmValidator validates group witnesses with the Taproot Asset VM.
+type vmValidator struct{}
+
+// Execute creates and runs an instance of the Taproot Asset VM.
+func (v *vmValidator) Execute(newAsset *asset.Asset,
+	splitAssets []*commitment.SplitAsset,
+	prevAssets commitment.InputSet) error {
+
+	engine, err := vm.New(newAsset, splitAssets, prevAssets)
+	if err != nil {
+		return err
+	}
+
+	return engine.Execute()
+}
+
+// signGroupWitness signs the unsigned group witness with the given private key
+// as a key spend.
+func signGroupWitness(t *testing.T, priv *btcec.PrivateKey,
+	unsigned *UnsignedGroupWitness) wire.TxWitness {
+
+	signDesc := &lndclient.SignDescriptor{
+		KeyDesc:     unsigned.GroupKey.RawKey,
+		SingleTweak: unsigned.SingleTweak,
+		TapTweak:    unsigned.GroupKey.TapscriptRoot,
+		SignMethod:  input.TaprootKeySpendSignMethod,
+		Output:      unsigned.PrevOut,
+		HashType:    txscript.SigHashDefault,
+	}
+	if len(unsigned.GroupKey.TapscriptRoot) == 0 {
+		signDesc.SignMethod = input.TaprootKeySpendBIP0086SignMethod
+	}
+
+	sig, err := asset.SignVirtualTx(
+		priv, signDesc, unsigned.VirtualTx, unsigned.PrevOut,
+	)
+	require.NoError(t, err)
+
+	return wire.TxWitness{sig.Serialize()}
+}
+
+// TestAddGroupWitnesses tests that externally signed group witnesses are only
+// added to the sprouts that need one, and only if they're valid.
+func TestAddGroupWitnesses(t *testing.T) {
+	t.Parallel()
+
+	groupPriv := test.RandPrivKey(t)
+	rawKey := keychain.KeyDescriptor{PubKey: groupPriv.PubKey()}
+
+	// A new group committing to a tapscript tree, and a reissuance into
+	// an existing group with a plain key spend.
+	newGen := asset.RandGenesis(t, asset.Normal)
+	newGen.Tag = "new-group"
+	reissueGen := asset.RandGenesis(t, asset.Normal)
+	reissueGen.Tag = "reissuance"
+	groupGen := asset.RandGenesis(t, asset.Normal)
+
+	tapscriptRoot := test.RandBytes(32)
+
+	var (
+		sprouts   []*asset.Asset
+		unsigned  []*UnsignedGroupWitness
+		txBuilder = &tapscript.GroupTxBuilder{}
+	)
+	for _, gen := range []struct {
+		genesis       asset.Genesis
+		initialGen    asset.Genesis
+		tapscriptRoot []byte
+	}{
+		{newGen, newGen, tapscriptRoot},
+		{reissueGen, groupGen, nil},
+	} {
+		scriptKey := asset.RandScriptKey(t)
+		protoAsset, err := asset.New(
+			gen.genesis, 100, 0, 0, scriptKey, nil,
+		)
+		require.NoError(t, err)
+
+		groupKey, unsignedWitness, err := externalGroupKey(
+			txBuilder, rawKey, gen.tapscriptRoot, gen.initialGen,
+			protoAsset,
+		)
+		require.NoError(t, err)
+		require.Equal(t, gen.genesis.Tag, unsignedWitness.AssetName)
+		require.Equal(t, gen.genesis.ID(), unsignedWitness.AssetID)
+		require.Equal(t, gen.tapscriptRoot, groupKey.TapscriptRoot)
+
+		sprout, err := asset.New(
+			gen.genesis, 100, 0, 0, scriptKey, groupKey,
+		)
+		require.NoError(t, err)
+
+		sprouts = append(sprouts, sprout)
+		unsigned = append(unsigned, unsignedWitness)
+	}
+
+	// An ungrouped sprout doesn't need a witness.
+	plainSprout := asset.RandAsset(t, asset.Normal)
+	plainSprout.GroupKey = nil
+	sprouts = append(sprouts, plainSprout)
+
+	witnesses := map[string]wire.TxWitness{
+		newGen.Tag:     signGroupWitness(t, groupPriv, unsigned[0]),
+		reissueGen.Tag: signGroupWitness(t, groupPriv, unsigned[1]),
+	}
+
+	// All witnesses must be provided at once.
+	_, err := addGroupWitnesses(
+		sprouts, map[string]wire.TxWitness{
+			newGen.Tag: witnesses[newGen.Tag],
+		}, &vmValidator{},
+	)
+	require.ErrorContains(t, err, "missing group witness")
+
+	// A witness signed by a different key is rejected.
+	_, err = addGroupWitnesses(
+		sprouts, map[string]wire.TxWitness{
+			newGen.Tag: witnesses[newGen.Tag],
+			reissueGen.Tag: signGroupWitness(
+				t, test.RandPrivKey(t), unsigned[1],
+			),
+		}, &vmValidator{},
+	)
+	require.ErrorContains(t, err, "invalid group witness")
+
+	// So are witnesses for assets that don't need one.
+	_, err = addGroupWitnesses(
+		sprouts, map[string]wire.TxWitness{
+			newGen.Tag:      witnesses[newGen.Tag],
+			reissueGen.Tag:  witnesses[reissueGen.Tag],
+			plainSprout.Tag: witnesses[newGen.Tag],
+		}, &vmValidator{},
+	)
+	require.ErrorContains(t, err, "don't need one")
+
+	// With all valid witnesses, the sprouts are signed without modifying
+	// the original sprouts.
+	signedSprouts, err := addGroupWitnesses(
+		sprouts, witnesses, &vmValidator{},
+	)
+	require.NoError(t, err)
+	require.Len(t, signedSprouts, len(sprouts))
+
+	newGroupKey := signedSprouts[0].GroupKey
+	require.Equal(t, witnesses[newGen.Tag], newGroupKey.Witness)
+	require.Equal(t, tapscriptRoot, newGroupKey.TapscriptRoot)
+	require.Equal(
+		t, witnesses[reissueGen.Tag], signedSprouts[1].GroupKey.Witness,
+	)
+	require.Equal(t, plainSprout, signedSprouts[2])
+	require.Empty(t, sprouts[0].GroupKey.Witness)
+}
+
+// TestExternalGroupKeySeedling tests that external group keys and tapscript
+// roots are only accepted for seedlings that use them for a group.
+func TestExternalGroupKeySeedling(t *testing.T) {
+	t.Parallel()
+
+	externalKey := test.RandPubKey(t)
+	seedling := Seedling{
+		AssetType:        asset.Normal,
+		AssetName:        "external",
+		Amount:           100,
+		ExternalGroupKey: externalKey,
+	}
+	require.ErrorContains(t, seedling.validateFields(), "requires emission")
+
+	seedling.EnableEmission = true
+	require.NoError(t, seedling.validateFields())
+
+	seedling.GroupTapscriptRoot = test.RandBytes(31)
+	require.ErrorContains(t, seedling.validateFields(), "must be 32 bytes")
+
+	seedling.GroupTapscriptRoot = test.RandBytes(32)
+	require.NoError(t, seedling.validateFields())
+
+	seedling.ExternalGroupKey = nil
+	require.ErrorContains(t, seedling.validateFields(), "tapscript root")
+
+	// Issuing into a group with a raw key that isn't held by the daemon
+	// requires that raw key to be set as the external group key.
+	rawKey := keychain.KeyDescriptor{PubKey: externalKey}
+	group := asset.AssetGroup{
+		Genesis: &asset.Genesis{Type: asset.Normal},
+		GroupKey: &asset.GroupKey{
+			RawKey:      rawKey,
+			GroupPubKey: *test.RandPubKey(t),
+		},
+	}
+	reissuance := Seedling{
+		AssetType: asset.Normal,
+		AssetName: "reissuance",
+		Amount:    100,
+		GroupInfo: &group,
+	}
+	require.ErrorContains(
+		t, reissuance.validateGroupKey(group), "can't sign with group",
+	)
+
+	reissuance.ExternalGroupKey = test.RandPubKey(t)
+	require.ErrorContains(
+		t, reissuance.validateGroupKey(group), "can't sign with group",
+	)
+
+	reissuance.ExternalGroupKey = externalKey
+	require.NoError(t, reissuance.validateFields())
+	require.NoError(t, reissuance.validateGroupKey(group))
+
+	// A group key held by the daemon can't be declared external.
+	group.GroupKey.RawKey.Family = asset.TaprootAssetsKeyFamily
+	require.ErrorContains(
+		t, reissuance.validateGroupKey(group), "held by the daemon",
+	)
+}
@@ -3,6 +3,7 @@ package tapgarden
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
@@ -36,6 +37,18 @@ type Planter interface {
 	// details of a specific batch.
 	ListBatches(batchKey *btcec.PublicKey) ([]*MintingBatch, error)
 
+	// PendingBatch returns the pending batch with the given name, or nil
+	// if there's no such pending batch.
+	PendingBatch(batchName string) (*MintingBatch, error)
+
+	// UpdateSeedling changes the amount, meta or group anchor of a
+	// seedling in a pending batch, returning the updated batch.
+	UpdateSeedling(change SeedlingChange) (*MintingBatch, error)
+
+	// RemoveSeedling removes the seedling with the given asset name from
+	// the pending batch with the given name, returning the updated batch.
+	RemoveSeedling(batchName, assetName string) (*MintingBatch, error)
+
 	// CancelSeedling attempts to cancel the creation of a new asset
 	// identified by its name. If the seedling has already progressed to a
 	// point where the genesis PSBT has been broadcasted, an error is
@@ -43,12 +56,51 @@ type Planter interface {
 	CancelSeedling() error
 
 	// FinalizeBatch signals that the asset minter should finalize
-	// the current batch, if one exists.
-	FinalizeBatch(feeRate *chainfee.SatPerKWeight) (*MintingBatch, error)
+	// the pending batch selected by the params, if one exists.
+	FinalizeBatch(params FinalizeParams) (*MintingBatch, error)
+
+	// PreviewBatch runs a dry run of the pending batch selected by the
+	// params, returning what the batch would create if it were finalized
+	// with the same params. The pending batch is left unchanged.
+	PreviewBatch(params FinalizeParams) (*BatchPreview, error)
 
 	// CancelBatch signals that the asset minter should cancel the
-	// current batch, if one exists.
-	CancelBatch() (*btcec.PublicKey, error)
+	// batch with the given name, if one exists.
+	CancelBatch(batchName string) (*btcec.PublicKey, error)
+
+	// PublishSignedBatch publishes the externally signed genesis packet of
+	// the externally funded batch with the given key, which continues the
+	// minting of the batch.
+	PublishSignedBatch(batchKey *btcec.PublicKey,
+		signedPkt *psbt.Packet) (*MintingBatch, error)
+
+	// AddGroupWitnesses adds the externally signed group witnesses of the
+	// assets in the frozen batch with the given key, which continues the
+	// minting of the batch. The witnesses are keyed by asset name.
+	AddGroupWitnesses(batchKey *btcec.PublicKey,
+		witnesses map[string]wire.TxWitness) (*MintingBatch, error)
+
+	// BumpBatchFee replaces the genesis transaction of the batch with the
+	// given key, which must have been broadcast but not yet confirmed,
+	// with a transaction that pays the given, higher fee rate.
+	BumpBatchFee(batchKey *btcec.PublicKey,
+		feeRate chainfee.SatPerKWeight) (*MintingBatch, error)
+
+	// ScheduleBatch schedules the pending batch with the given name to be
+	// finalized once the given block height or time is reached. An empty
+	// schedule removes an existing schedule from the batch.
+	ScheduleBatch(batchName string,
+		schedule BatchSchedule) (*MintingBatch, error)
+
+	// AddRecurringMint adds a new recurring mint that mints new units of
+	// an existing asset group once per epoch.
+	AddRecurringMint(mint RecurringMint) (*RecurringMint, error)
+
+	// ListRecurringMints lists all active recurring mints.
+	ListRecurringMints() ([]*RecurringMint, error)
+
+	// CancelRecurringMint cancels the recurring mint with the given ID.
+	CancelRecurringMint(id int64) error
 
 	// Start signals that the asset minter should being operations.
 	Start() error
@@ -58,6 +110,37 @@ type Planter interface {
 	Stop() error
 }
 
+// FinalizeParams are the options available to change how a batch is
+// finalized, and how its genesis transaction is funded.
+type FinalizeParams struct {
+	// BatchName is the name of the pending batch to finalize. If empty,
+	// the default pending batch is finalized.
+	BatchName string
+
+	// FeeRate is an optional manually-set fee rate to use for the genesis
+	// transaction. If set, ConfTarget is ignored.
+	FeeRate *chainfee.SatPerKWeight
+
+	// ConfTarget is an optional confirmation target used to estimate the
+	// fee rate of the genesis transaction. If zero, GenesisConfTarget is
+	// used.
+	ConfTarget uint32
+
+	// GenesisInputs is an optional list of wallet UTXOs that fund the
+	// genesis transaction. If set, the first one becomes the genesis point
+	// of all assets in the batch, and no other inputs are added by the
+	// wallet. Otherwise, the wallet selects the inputs itself.
+	GenesisInputs []wire.OutPoint
+
+	// ExternalGenesisPsbt is an optional genesis packet that was funded by
+	// an external wallet. It must contain the DummyGenesisTxOut and at
+	// most one change output. If set, the backing wallet neither funds nor
+	// signs the genesis transaction, and all other parameters are
+	// ignored. The batch then waits in BatchStateCommitted until the
+	// packet signed by the external wallet is published.
+	ExternalGenesisPsbt *psbt.Packet
+}
+
 // BatchState an enum that represents the various stages of a minting batch.
 type BatchState uint8
 
@@ -178,6 +261,11 @@ type MintingStore interface {
 	UpdateBatchState(ctx context.Context, batchKey *btcec.PublicKey,
 		newState BatchState) error
 
+	// FreezeMintingBatch moves a pending batch to BatchStateFrozen, and
+	// stores the parameters it was finalized with along with it.
+	FreezeMintingBatch(ctx context.Context, batchKey *btcec.PublicKey,
+		params FinalizeParams) error
+
 	// AddSeedlingsToBatch adds a new seedling to an existing batch. Once
 	// added this batch should remain in the BatchStatePending state.
 	//
@@ -185,6 +273,16 @@ type MintingStore interface {
 	AddSeedlingsToBatch(ctx context.Context, batchKey *btcec.PublicKey,
 		seedlings ...*Seedling) error
 
+	// UpdateSeedling replaces the amount, meta and group anchor of the
+	// seedling with the same asset name in the batch with the given key.
+	UpdateSeedling(ctx context.Context, batchKey *btcec.PublicKey,
+		seedling *Seedling) error
+
+	// RemoveSeedling removes the seedling with the given asset name from
+	// the batch with the given key.
+	RemoveSeedling(ctx context.Context, batchKey *btcec.PublicKey,
+		seedlingName string) error
+
 	// FetchAllBatches fetches all the batches on disk.
 	FetchAllBatches(ctx context.Context) ([]*MintingBatch, error)
 
@@ -197,14 +295,31 @@ type MintingStore interface {
 	FetchMintingBatch(ctx context.Context,
 		batchKey *btcec.PublicKey) (*MintingBatch, error)
 
+	// CommitFrozenSprouts stores the funded genesis packet and the sprouts
+	// of a frozen batch that waits for group witnesses signed with an
+	// external group key, together with the unsigned group witnesses. This
+	// allows the batch to be resumed after a restart without funding a new
+	// genesis packet, which would invalidate the group witnesses. If
+	// externalFunding is true, then the GenesisPacket was funded by an
+	// external wallet.
+	//
+	// NOTE: The BatchState should remain BatchStateFrozen.
+	CommitFrozenSprouts(ctx context.Context, batchKey *btcec.PublicKey,
+		genesisPacket *FundedPsbt, sprouts []*asset.Asset,
+		unsignedWitnesses []*UnsignedGroupWitness,
+		externalFunding bool) error
+
 	// AddSproutsToBatch adds a new set of sprouts to the batch, along with
 	// a GenesisPacket, that once signed and broadcast with create the
-	// set of assets on chain.
+	// set of assets on chain. If externalFunding is true, then the
+	// GenesisPacket was funded by, and needs to be signed by, an external
+	// wallet.
 	//
 	// NOTE: The BatchState should transition to BatchStateCommitted upon a
 	// successful call.
 	AddSproutsToBatch(ctx context.Context, batchKey *btcec.PublicKey,
-		genesisPacket *FundedPsbt, assets *commitment.TapCommitment) error
+		genesisPacket *FundedPsbt, assets *commitment.TapCommitment,
+		externalFunding bool) error
 
 	// CommitSignedGenesisTx adds a fully signed genesis transaction to the
 	// batch, along with the Taproot Asset script root, which is the
@@ -217,6 +332,13 @@ type MintingStore interface {
 		genesisTx *FundedPsbt, anchorOutputIndex uint32,
 		tapRoot []byte) error
 
+	// ReplaceGenesisTx replaces the fully signed genesis transaction of a
+	// batch that was already broadcast with a transaction that spends the
+	// same genesis input and creates the same anchor output, but pays a
+	// higher fee.
+	ReplaceGenesisTx(ctx context.Context, batchKey *btcec.PublicKey,
+		genesisTx *FundedPsbt, anchorOutputIndex uint32) error
+
 	// MarkBatchConfirmed marks the batch as confirmed on chain. The passed
 	// block location information determines where exactly in the chain the
 	// batch was confirmed.
@@ -236,6 +358,26 @@ type MintingStore interface {
 	// key, including the genesis information used to create the group.
 	FetchGroupByGroupKey(ctx context.Context,
 		groupKey *btcec.PublicKey) (*asset.AssetGroup, error)
+
+	// UpdateBatchSchedule updates the schedule of a pending batch on disk.
+	UpdateBatchSchedule(ctx context.Context, batchKey *btcec.PublicKey,
+		schedule BatchSchedule) error
+
+	// AddRecurringMint stores a new recurring mint on disk and returns
+	// its ID.
+	AddRecurringMint(ctx context.Context, mint *RecurringMint) (int64,
+		error)
+
+	// FetchRecurringMints fetches all recurring mints on disk.
+	FetchRecurringMints(ctx context.Context) ([]*RecurringMint, error)
+
+	// UpdateRecurringMint updates the next trigger and the number of
+	// minted epochs of a recurring mint on disk.
+	UpdateRecurringMint(ctx context.Context, mint *RecurringMint) error
+
+	// DeleteRecurringMint deletes the recurring mint with the given ID
+	// from disk.
+	DeleteRecurringMint(ctx context.Context, id int64) error
 }
 
 // ChainBridge is our bridge to the target chain. It's used to get confirmation
@@ -268,6 +410,10 @@ type ChainBridge interface {
 	VerifyBlock(ctx context.Context, header wire.BlockHeader,
 		height uint32) error
 
+	// MedianTime returns the median time past of the block at the given
+	// height as defined in BIP-113.
+	MedianTime(ctx context.Context, blockHeight uint32) (time.Time, error)
+
 	// CurrentHeight return the current height of the main chain.
 	CurrentHeight(context.Context) (uint32, error)
 
@@ -315,9 +461,14 @@ type WalletAnchor interface {
 	// P2TR output.
 	ImportTaprootOutput(context.Context, *btcec.PublicKey) (btcutil.Address, error)
 
-	// UnlockInput unlocks the set of target inputs after a batch is
-	// abandoned.
-	UnlockInput(context.Context) error
+	// UnlockInput unlocks the given input that was locked by the wallet
+	// when funding a PSBT, e.g. after a batch or transfer is abandoned.
+	UnlockInput(ctx context.Context, op wire.OutPoint) error
+
+	// LeaseInput leases the given input that was locked by the wallet when
+	// funding a PSBT for the given duration, extending any existing lease.
+	LeaseInput(ctx context.Context, op wire.OutPoint,
+		leaseTime time.Duration) error
 
 	// ListUnspentImportScripts lists all UTXOs of the imported Taproot
 	// scripts.
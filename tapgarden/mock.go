@@ -163,7 +163,15 @@ func (m *MockWalletAnchor) ImportTaprootOutput(ctx context.Context,
 	)
 }
 
-func (m *MockWalletAnchor) UnlockInput(_ context.Context) error {
+func (m *MockWalletAnchor) UnlockInput(_ context.Context,
+	_ wire.OutPoint) error {
+
+	return nil
+}
+
+func (m *MockWalletAnchor) LeaseInput(_ context.Context, _ wire.OutPoint,
+	_ time.Duration) error {
+
 	return nil
 }
 
@@ -340,6 +348,13 @@ func (m *MockChainBridge) VerifyBlock(_ context.Context,
 	return nil
 }
 
+// MedianTime returns the median time past of the block at the given height.
+func (m *MockChainBridge) MedianTime(_ context.Context,
+	_ uint32) (time.Time, error) {
+
+	return time.Unix(0, 0), nil
+}
+
 func (m *MockChainBridge) CurrentHeight(_ context.Context) (uint32, error) {
 	return 0, nil
 }
@@ -560,8 +575,10 @@ func (m *MockProofArchive) FetchProofs(ctx context.Context,
 }
 
 func (m *MockProofArchive) ImportProofs(ctx context.Context,
-	headerVerifier proof.HeaderVerifier, groupVerifier proof.GroupVerifier,
-	replace bool, proofs ...*proof.AnnotatedProof) error {
+	headerVerifier proof.HeaderVerifier,
+	medianTimeSource proof.MedianTimeSource,
+	groupVerifier proof.GroupVerifier, replace bool,
+	proofs ...*proof.AnnotatedProof) error {
 
 	return nil
 }
@@ -0,0 +1,158 @@
+package tapgarden
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
+)
+
+// TestCanCancelBatch tests that the batch to cancel is selected by its name,
+// preferring pending batches over batches managed by a caretaker.
+func TestCanCancelBatch(t *testing.T) {
+	t.Parallel()
+
+	planter := NewChainPlanter(PlanterConfig{})
+
+	addCaretaker := func(name string) *MintingBatch {
+		batch := RandSeedlingMintingBatch(t, 1)
+		batch.Name = name
+
+		batchKey := asset.ToSerialized(batch.BatchKey.PubKey)
+		planter.caretakers[batchKey] = &BatchCaretaker{
+			cfg: &BatchCaretakerConfig{
+				Batch: batch,
+			},
+		}
+
+		return batch
+	}
+
+	// Without any batches, there's nothing to cancel.
+	_, err := planter.canCancelBatch("")
+	require.ErrorContains(t, err, "no pending batch")
+	_, err = planter.canCancelBatch("foo")
+	require.ErrorContains(t, err, "no pending batch named")
+
+	// A batch that is already managed by a caretaker can be selected by
+	// its name.
+	fooCaretaker := addCaretaker("foo")
+	batch, err := planter.canCancelBatch("foo")
+	require.NoError(t, err)
+	require.Equal(t, fooCaretaker, batch)
+
+	// But a pending batch with the same name takes precedence, as names
+	// are only unique among pending batches.
+	fooPending := RandSeedlingMintingBatch(t, 1)
+	fooPending.Name = "foo"
+	planter.pendingBatches["foo"] = fooPending
+
+	batch, err = planter.canCancelBatch("foo")
+	require.NoError(t, err)
+	require.Equal(t, fooPending, batch)
+
+	// The default batch is selected independently of named batches.
+	defaultPending := RandSeedlingMintingBatch(t, 1)
+	planter.pendingBatches[""] = defaultPending
+
+	batch, err = planter.canCancelBatch("")
+	require.NoError(t, err)
+	require.Equal(t, defaultPending, batch)
+
+	// Once the pending batch is gone, multiple caretakers managing a batch
+	// with the same name make the batch to cancel ambiguous.
+	delete(planter.pendingBatches, "foo")
+	addCaretaker("foo")
+
+	_, err = planter.canCancelBatch("foo")
+	require.ErrorContains(t, err, "ambiguous")
+}
+
+// TestSeedlingChanges tests that changes to a seedling in a pending batch are
+// applied to a copy of the seedling, and that invalid changes are rejected
+// before they're written to disk.
+func TestSeedlingChanges(t *testing.T) {
+	t.Parallel()
+
+	planter := NewChainPlanter(PlanterConfig{})
+
+	batch := RandSeedlingMintingBatch(t, 2)
+	batch.Name = "foo"
+	planter.pendingBatches[batch.Name] = batch
+
+	seedlings := maps.Values(batch.Seedlings)
+	anchor, seedling := seedlings[0], seedlings[1]
+	anchor.EnableEmission = true
+	seedling.EnableEmission = false
+	seedling.AssetType = asset.Normal
+
+	// Only the fields that are set are changed, and the original seedling
+	// is left untouched.
+	amount := seedling.Amount + 1
+	change := SeedlingChange{
+		BatchName:   batch.Name,
+		AssetName:   seedling.AssetName,
+		Amount:      &amount,
+		GroupAnchor: &anchor.AssetName,
+	}
+	updated := change.apply(seedling)
+	require.Equal(t, amount, updated.Amount)
+	require.Equal(t, seedling.Meta, updated.Meta)
+	require.Equal(t, anchor.AssetName, *updated.GroupAnchor)
+	require.Nil(t, seedling.GroupAnchor)
+
+	noAnchor := ""
+	change.GroupAnchor = &noAnchor
+	require.Nil(t, change.apply(updated).GroupAnchor)
+
+	// The seedling must exist in the named pending batch.
+	_, err := planter.updateSeedling(context.Background(), SeedlingChange{
+		AssetName: seedling.AssetName,
+	})
+	require.ErrorContains(t, err, "no pending batch")
+	_, err = planter.updateSeedling(context.Background(), SeedlingChange{
+		BatchName: batch.Name,
+		AssetName: "unknown",
+	})
+	require.ErrorContains(t, err, "not in batch")
+
+	// The changed seedling is validated like a new one.
+	zero := uint64(0)
+	_, err = planter.updateSeedling(context.Background(), SeedlingChange{
+		BatchName: batch.Name,
+		AssetName: seedling.AssetName,
+		Amount:    &zero,
+	})
+	require.ErrorIs(t, err, ErrInvalidAssetAmt)
+
+	_, err = planter.updateSeedling(context.Background(), SeedlingChange{
+		BatchName:   batch.Name,
+		AssetName:   seedling.AssetName,
+		GroupAnchor: &seedling.AssetName,
+	})
+	require.ErrorContains(t, err, "own group")
+
+	_, err = planter.updateSeedling(context.Background(), SeedlingChange{
+		BatchName:   batch.Name,
+		AssetName:   anchor.AssetName,
+		GroupAnchor: &seedling.AssetName,
+	})
+	require.ErrorContains(t, err, "must disable emission")
+
+	// A group anchor can't be removed while other seedlings are grouped
+	// with it.
+	seedling.GroupAnchor = &anchor.AssetName
+	_, err = planter.removeSeedling(
+		context.Background(), batch.Name, anchor.AssetName,
+	)
+	require.ErrorContains(t, err, "group anchor of")
+
+	// And the last seedling of a batch can't be removed at all.
+	delete(batch.Seedlings, seedling.AssetName)
+	_, err = planter.removeSeedling(
+		context.Background(), batch.Name, anchor.AssetName,
+	)
+	require.ErrorContains(t, err, "cancel the batch instead")
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -17,7 +18,6 @@ import (
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/ticker"
-	"golang.org/x/exp/maps"
 )
 
 // GardenKit holds the set of shared fundamental interfaces all sub-systems of
@@ -76,6 +76,12 @@ type PlanterConfig struct {
 	// all asset requests into a new batch.
 	BatchTicker *ticker.Force
 
+	// ScheduleTicker is used to periodically check whether the pending
+	// batch is scheduled to be finalized, or whether any recurring mints
+	// are due. If nil, batch schedules and recurring mints are never
+	// triggered.
+	ScheduleTicker ticker.Ticker
+
 	// ProofUpdates is the storage backend for updated proofs.
 	ProofUpdates proof.Archiver
 
@@ -157,6 +163,25 @@ func (s *stateParamReq[T, S]) Param() any {
 	return s.param
 }
 
+// removeSeedlingParams are the parameters of a request to remove a seedling
+// from a pending batch.
+type removeSeedlingParams struct {
+	// batchName is the name of the pending batch the seedling is in.
+	batchName string
+
+	// assetName is the name of the seedling to remove.
+	assetName string
+}
+
+// scheduleParams are the parameters of a request to schedule a pending batch.
+type scheduleParams struct {
+	// batchName is the name of the pending batch to schedule.
+	batchName string
+
+	// schedule is the new schedule of the batch.
+	schedule BatchSchedule
+}
+
 func typedParam[T any](req stateRequest) (*T, error) {
 	if param, ok := req.Param().(T); ok {
 		return &param, nil
@@ -173,6 +198,14 @@ const (
 	reqTypeListBatches
 	reqTypeFinalizeBatch
 	reqTypeCancelBatch
+	reqTypeBatchCaretaker
+	reqTypeScheduleBatch
+	reqTypeAddRecurringMint
+	reqTypeListRecurringMints
+	reqTypeCancelRecurringMint
+	reqTypeUpdateSeedling
+	reqTypeRemoveSeedling
+	reqTypePreviewBatch
 )
 
 // ChainPlanter is responsible for accepting new incoming requests to create
@@ -189,15 +222,19 @@ type ChainPlanter struct {
 	// seedlingReqs is used to accept new asset issuance requests.
 	seedlingReqs chan *Seedling
 
-	// pendingBatch is the current pending, non-frozen batch. Only one of
-	// these will exist at any given time.
-	pendingBatch *MintingBatch
+	// pendingBatches maps the name of each pending, non-frozen batch to
+	// the batch itself. The default pending batch has an empty name.
+	pendingBatches map[string]*MintingBatch
 
 	// caretakers maps a batch key (which is used as the internal key for
 	// the transaction that mints the assets) to the caretaker that will
 	// progress the batch through the final phases.
 	caretakers map[BatchKey]*BatchCaretaker
 
+	// recurringMints maps the ID of each active recurring mint to the
+	// recurring mint itself.
+	recurringMints map[int64]*RecurringMint
+
 	// completionSignals is a channel used to allow the caretakers to
 	// signal that the batch is fully final, allowing garbage collection of
 	// any relevant resources.
@@ -216,7 +253,9 @@ type ChainPlanter struct {
 func NewChainPlanter(cfg PlanterConfig) *ChainPlanter {
 	return &ChainPlanter{
 		cfg:               cfg,
+		pendingBatches:    make(map[string]*MintingBatch),
 		caretakers:        make(map[BatchKey]*BatchCaretaker),
+		recurringMints:    make(map[int64]*RecurringMint),
 		completionSignals: make(chan BatchKey),
 		seedlingReqs:      make(chan *Seedling),
 		stateReqs:         make(chan stateRequest),
@@ -230,7 +269,7 @@ func NewChainPlanter(cfg PlanterConfig) *ChainPlanter {
 // newCaretakerForBatch creates a new BatchCaretaker for a given batch and
 // inserts it into the caretaker map.
 func (c *ChainPlanter) newCaretakerForBatch(batch *MintingBatch,
-	feeRate *chainfee.SatPerKWeight) *BatchCaretaker {
+	params FinalizeParams) *BatchCaretaker {
 
 	batchKey := asset.ToSerialized(batch.BatchKey.PubKey)
 	batchConfig := &BatchCaretakerConfig{
@@ -245,9 +284,7 @@ func (c *ChainPlanter) newCaretakerForBatch(batch *MintingBatch,
 		CancelRespChan:      make(chan CancelResp, 1),
 		UpdateMintingProofs: c.updateMintingProofs,
 		ErrChan:             c.cfg.ErrChan,
-	}
-	if feeRate != nil {
-		batchConfig.BatchFeeRate = feeRate
+		FinalizeParams:      params,
 	}
 
 	caretaker := NewBatchCaretaker(batchConfig)
@@ -295,6 +332,23 @@ func (c *ChainPlanter) Start() error {
 				continue
 			}
 
+			// A pending batch that is named or scheduled to be
+			// finalized later becomes a pending batch again,
+			// instead of being finalized right away.
+			_, nameTaken := c.pendingBatches[batch.Name]
+			restore := batchState == BatchStatePending &&
+				(batch.Name != "" || batch.Schedule.IsSet())
+			if restore && !nameTaken {
+				batchKey := batch.BatchKey.PubKey
+				log.Infof("Restoring pending "+
+					"MintingBatch(%x, name=%q)",
+					batchKey.SerializeCompressed(),
+					batch.Name)
+
+				c.pendingBatches[batch.Name] = batch
+				continue
+			}
+
 			log.Infof("Launching ChainCaretaker(%x)",
 				batch.BatchKey.PubKey.SerializeCompressed())
 
@@ -305,14 +359,29 @@ func (c *ChainPlanter) Start() error {
 				batch.AssetMetas = make(AssetMetas)
 			}
 
-			// TODO(jhb): Log manual fee rates?
-			caretaker := c.newCaretakerForBatch(batch, nil)
+			// A frozen batch is resumed with the params it was
+			// finalized with, so the genesis transaction is funded
+			// with the same inputs and fee rate.
+			caretaker := c.newCaretakerForBatch(
+				batch, batch.FinalizeParams,
+			)
 			if err := caretaker.Start(); err != nil {
 				startErr = err
 				return
 			}
 		}
 
+		// We'll also load the recurring mints, so we can continue
+		// minting new units in each of their epochs.
+		recurringMints, err := c.cfg.Log.FetchRecurringMints(ctx)
+		if err != nil {
+			startErr = err
+			return
+		}
+		for _, mint := range recurringMints {
+			c.recurringMints[mint.ID] = mint
+		}
+
 		// With all the caretakers for each minting batch launched,
 		// we'll start up the main gardener goroutine so we can accept
 		// new minting requests.
@@ -353,7 +422,7 @@ func (c *ChainPlanter) stopCaretakers() {
 // freezeMintingBatch freezes a target minting batch which means that no new
 // assets can be added to the batch.
 func freezeMintingBatch(ctx context.Context, batchStore MintingStore,
-	batch *MintingBatch) error {
+	batch *MintingBatch, params FinalizeParams) error {
 
 	batchKey := batch.BatchKey.PubKey
 
@@ -362,11 +431,18 @@ func freezeMintingBatch(ctx context.Context, batchStore MintingStore,
 
 	// In order to freeze a batch, we need to update the state of the batch
 	// to BatchStateFinalized, meaning that no other changes can happen.
+	// The finalize params are stored along with it, so the genesis
+	// transaction is funded the same way after a restart.
 	//
 	// TODO(roasbeef): assert not in some other state first?
-	return batchStore.UpdateBatchState(
-		ctx, batchKey, BatchStateFrozen,
-	)
+	err := batchStore.FreezeMintingBatch(ctx, batchKey, params)
+	if err != nil {
+		return err
+	}
+
+	batch.FinalizeParams = params
+
+	return nil
 }
 
 // ListBatches returns the single batch specified by the batch key, or the set
@@ -386,50 +462,53 @@ func listBatches(ctx context.Context, batchStore MintingStore,
 	return []*MintingBatch{batch}, nil
 }
 
-// canCancelBatch returns a batch key if the planter is in a state where a batch
-// can be cancelled. This does not account for the state of a caretaker that
-// may be managing a batch.
-func (c *ChainPlanter) canCancelBatch() (*btcec.PublicKey, error) {
-	caretakerCount := len(c.caretakers)
+// canCancelBatch returns the batch with the given name if the planter is in a
+// state where it can be cancelled. This does not account for the state of a
+// caretaker that may be managing a batch.
+func (c *ChainPlanter) canCancelBatch(batchName string) (*MintingBatch,
+	error) {
+
+	// A pending batch with the given name is always the batch to cancel,
+	// as it can't have been assigned a caretaker yet.
+	if batch, ok := c.pendingBatches[batchName]; ok {
+		return batch, nil
+	}
+
+	// Otherwise, the batch may already be managed by a caretaker. Since
+	// names are only unique among pending batches, the batch to cancel is
+	// ambiguous if multiple caretakers manage a batch with that name.
+	var candidates []*MintingBatch
+	for _, caretaker := range c.caretakers {
+		if caretaker.cfg.Batch.Name == batchName {
+			candidates = append(candidates, caretaker.cfg.Batch)
+		}
+	}
 
-	switch caretakerCount {
+	switch len(candidates) {
 	case 0:
-		// If there are no caretakers, the only batch we could cancel
-		// would be the current pending batch.
-		if c.pendingBatch == nil {
+		if batchName == "" {
 			return nil, fmt.Errorf("no pending batch")
 		}
 
-		return c.pendingBatch.BatchKey.PubKey, nil
-	case 1:
-		// TODO(jhb): Update once we support multiple batches.
-		// If there is exactly one caretaker, our pending batch should
-		// be empty. Otherwise, the batch to cancel is ambiguous.
-		if c.pendingBatch != nil {
-			return nil, fmt.Errorf("multiple batches not supported")
-		}
+		return nil, fmt.Errorf("no pending batch named %q", batchName)
 
-		batchKeys := maps.Keys(c.caretakers)
-		batchKey, err := btcec.ParsePubKey(batchKeys[0][:])
-		if err != nil {
-			return nil, fmt.Errorf("bad caretaker key: %w", err)
-		}
+	case 1:
+		return candidates[0], nil
 
-		return batchKey, nil
 	default:
+		return nil, fmt.Errorf("multiple batches named %q, batch to "+
+			"cancel is ambiguous", batchName)
 	}
-
-	// TODO(jhb): Update once we support multiple batches.
-	return nil, fmt.Errorf("multiple caretakers not supported")
 }
 
 // cancelMintingBatch attempts to cancel a target minting batch. This can fail
 // if the batch is managed by a caretaker and has already been broadcast.
 func (c *ChainPlanter) cancelMintingBatch(ctx context.Context,
-	batchKey *btcec.PublicKey) error {
+	batch *MintingBatch) error {
 
 	// The target batch may have already been assigned a caretaker. If so,
 	// we need to signal to the caretaker to cancel the batch.
+	batchKey := batch.BatchKey.PubKey
 	batchKeySerialized := asset.ToSerialized(batchKey)
 	caretaker, ok := c.caretakers[batchKeySerialized]
 	if ok {
@@ -459,7 +538,7 @@ func (c *ChainPlanter) cancelMintingBatch(ctx context.Context,
 	}
 
 	log.Infof("Cancelling MintingBatch(key=%x, num_assets=%v)",
-		batchKeySerialized, len(c.pendingBatch.Seedlings))
+		batchKeySerialized, len(batch.Seedlings))
 
 	// If the target batch was not assigned a caretaker, we only need to
 	// update the batch state on disk to cancel it.
@@ -486,25 +565,50 @@ func (c *ChainPlanter) gardener() {
 
 	log.Infof("Gardener for ChainPlanter now active!")
 
+	// If no schedule ticker is set, the nil channel ensures we never check
+	// the schedules.
+	var scheduleTicks <-chan time.Time
+	if c.cfg.ScheduleTicker != nil {
+		c.cfg.ScheduleTicker.Resume()
+		defer c.cfg.ScheduleTicker.Stop()
+
+		scheduleTicks = c.cfg.ScheduleTicker.Ticks()
+	}
+
 	for {
 		select {
+		// The pending batch may be scheduled to be finalized, and new
+		// units may need to be minted for recurring mints.
+		case <-scheduleTicks:
+			if err := c.processSchedules(); err != nil {
+				c.cfg.ErrChan <- err
+			}
+
 		case <-c.cfg.BatchTicker.Ticks():
 			// There is no pending batch, so we can just abort.
-			if c.pendingBatch == nil {
+			if len(c.pendingBatches) == 0 {
 				log.Debugf("No batches pending...doing nothing")
 				continue
 			}
 
-			_, err := c.finalizeBatch(nil)
-			if err != nil {
-				c.cfg.ErrChan <- fmt.Errorf("unable to freeze "+
-					"minting batch: %w", err)
-				continue
-			}
+			// Each pending batch is finalized on its own, so a
+			// failure to freeze one doesn't hold up the others.
+			for name, batch := range c.pendingBatches {
+				_, err := c.finalizeBatch(
+					batch, FinalizeParams{},
+				)
+				if err != nil {
+					c.cfg.ErrChan <- fmt.Errorf("unable "+
+						"to freeze minting batch: %w",
+						err)
+					continue
+				}
 
-			// Now that we have a caretaker launched for this
-			// batch, we'll set the pending batch to nil
-			c.pendingBatch = nil
+				// Now that we have a caretaker launched for
+				// this batch, we'll remove it from the pending
+				// batches.
+				delete(c.pendingBatches, name)
+			}
 
 		// A request for new asset issuance just arrived, add this to
 		// the pending batch and acknowledge the receipt back to the
@@ -534,7 +638,7 @@ func (c *ChainPlanter) gardener() {
 			// TODO(roasbeef): extend the ticker by a certain
 			// portion?
 			req.updates <- SeedlingUpdate{
-				PendingBatch: c.pendingBatch,
+				PendingBatch: c.pendingBatches[req.BatchName],
 				NewState:     MintingStateSeed,
 			}
 
@@ -565,7 +669,14 @@ func (c *ChainPlanter) gardener() {
 		case req := <-c.stateReqs:
 			switch req.Type() {
 			case reqTypePendingBatch:
-				req.Resolve(c.pendingBatch)
+				name, err := typedParam[string](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad batch name: "+
+						"%w", err))
+					break
+				}
+
+				req.Resolve(c.pendingBatches[*name])
 
 			case reqTypeNumActiveBatches:
 				req.Resolve(len(c.caretakers))
@@ -591,24 +702,26 @@ func (c *ChainPlanter) gardener() {
 				req.Resolve(batches)
 
 			case reqTypeFinalizeBatch:
-				if c.pendingBatch == nil {
+				params, err := typedParam[FinalizeParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad finalize "+
+						"params: %w", err))
+					break
+				}
+
+				batch, ok := c.pendingBatches[params.BatchName]
+				if !ok {
 					req.Error(fmt.Errorf("no pending batch"))
 					break
 				}
 
-				batchKey := c.pendingBatch.BatchKey.PubKey
+				batchKey := batch.BatchKey.PubKey
 				log.Infof("Finalizing batch %x",
 					batchKey.SerializeCompressed())
 
-				feeRate, err :=
-					typedParam[*chainfee.SatPerKWeight](req)
-				if err != nil {
-					req.Error(fmt.Errorf("bad fee rate: "+
-						"%w", err))
-					break
-				}
-
-				caretaker, err := c.finalizeBatch(*feeRate)
+				caretaker, err := c.finalizeBatch(
+					batch, *params,
+				)
 				if err != nil {
 					c.cfg.ErrChan <- fmt.Errorf("unable "+
 						"to freeze minting batch: %w",
@@ -633,25 +746,173 @@ func (c *ChainPlanter) gardener() {
 				// Now that we have a caretaker launched for
 				// this batch and broadcast its minting
 				// transaction, we can remove the pending batch.
-				c.pendingBatch = nil
+				delete(c.pendingBatches, params.BatchName)
+
+			case reqTypePreviewBatch:
+				params, err := typedParam[FinalizeParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad finalize "+
+						"params: %w", err))
+					break
+				}
+
+				batch, ok := c.pendingBatches[params.BatchName]
+				if !ok {
+					req.Error(fmt.Errorf("no pending batch"))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuitNoTimeout()
+				preview, err := c.previewBatch(
+					ctx, batch, *params,
+				)
+				cancel()
+				if err != nil {
+					req.Error(fmt.Errorf("unable to "+
+						"preview batch: %w", err))
+					break
+				}
+
+				req.Resolve(preview)
 
 			case reqTypeCancelBatch:
-				batchKey, err := c.canCancelBatch()
+				name, err := typedParam[string](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad batch name: "+
+						"%w", err))
+					break
+				}
+
+				batch, err := c.canCancelBatch(*name)
 				if err != nil {
 					req.Error(err)
 					break
 				}
 
-				// Attempt to cancel the current batch, and then
-				// clear the pending batch in the planter.
+				// Attempt to cancel the batch, and then remove
+				// it from the pending batches in the planter.
 				ctx, cancel := c.WithCtxQuit()
-				err = c.cancelMintingBatch(ctx, batchKey)
+				err = c.cancelMintingBatch(ctx, batch)
 				cancel()
-				c.pendingBatch = nil
+				if c.pendingBatches[*name] == batch {
+					delete(c.pendingBatches, *name)
+				}
 
 				// Always return the key of the batch we tried
 				// to cancel.
-				req.Return(batchKey, err)
+				req.Return(batch.BatchKey.PubKey, err)
+
+			case reqTypeBatchCaretaker:
+				batchKey, err := typedParam[*btcec.PublicKey](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad batch key: "+
+						"%w", err))
+					break
+				}
+
+				key := asset.ToSerialized(*batchKey)
+				caretaker, ok := c.caretakers[key]
+				if !ok {
+					req.Error(fmt.Errorf("no active "+
+						"caretaker for batch %x",
+						key[:]))
+					break
+				}
+
+				req.Resolve(caretaker)
+
+			case reqTypeScheduleBatch:
+				params, err := typedParam[scheduleParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad batch "+
+						"schedule: %w", err))
+					break
+				}
+
+				batch, ok := c.pendingBatches[params.batchName]
+				if !ok {
+					req.Error(fmt.Errorf("no pending " +
+						"batch"))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuit()
+				err = c.cfg.Log.UpdateBatchSchedule(
+					ctx, batch.BatchKey.PubKey,
+					params.schedule,
+				)
+				cancel()
+				if err != nil {
+					req.Error(fmt.Errorf("unable to "+
+						"schedule batch: %w", err))
+					break
+				}
+
+				batch.Schedule = params.schedule
+				req.Resolve(batch)
+
+			case reqTypeAddRecurringMint:
+				mint, err := typedParam[RecurringMint](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad recurring "+
+						"mint: %w", err))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuit()
+				newMint, err := c.addRecurringMint(ctx, *mint)
+				cancel()
+
+				req.Return(newMint, err)
+
+			case reqTypeListRecurringMints:
+				req.Resolve(c.listRecurringMints())
+
+			case reqTypeCancelRecurringMint:
+				id, err := typedParam[int64](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad recurring "+
+						"mint ID: %w", err))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuit()
+				err = c.cancelRecurringMint(ctx, *id)
+				cancel()
+
+				req.Return(struct{}{}, err)
+
+			case reqTypeUpdateSeedling:
+				change, err := typedParam[SeedlingChange](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad seedling "+
+						"change: %w", err))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuit()
+				batch, err := c.updateSeedling(ctx, *change)
+				cancel()
+
+				req.Return(batch, err)
+
+			case reqTypeRemoveSeedling:
+				params, err := typedParam[removeSeedlingParams](
+					req,
+				)
+				if err != nil {
+					req.Error(fmt.Errorf("bad seedling: %w",
+						err))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuit()
+				batch, err := c.removeSeedling(
+					ctx, params.batchName, params.assetName,
+				)
+				cancel()
+
+				req.Return(batch, err)
 			}
 
 		case <-c.Quit:
@@ -660,18 +921,19 @@ func (c *ChainPlanter) gardener() {
 	}
 }
 
-// finalizeBatch creates a new caretaker for the batch and starts it.
-func (c *ChainPlanter) finalizeBatch(
-	feeRate *chainfee.SatPerKWeight) (*BatchCaretaker, error) {
+// finalizeBatch creates a new caretaker for the given pending batch and starts
+// it.
+func (c *ChainPlanter) finalizeBatch(batch *MintingBatch,
+	params FinalizeParams) (*BatchCaretaker, error) {
 
 	// Prep the new care taker that'll be launched assuming the call below
 	// to freeze the batch succeeds.
-	caretaker := c.newCaretakerForBatch(c.pendingBatch, feeRate)
+	caretaker := c.newCaretakerForBatch(batch, params)
 
 	// At this point, we have a non-empty batch, so we'll first finalize it
 	// on disk. This means no further seedlings can be added to this batch.
 	ctx, cancel := c.WithCtxQuit()
-	err := freezeMintingBatch(ctx, c.cfg.Log, c.pendingBatch)
+	err := freezeMintingBatch(ctx, c.cfg.Log, batch, params)
 	cancel()
 	if err != nil {
 		return nil, fmt.Errorf("unable to freeze minting batch: %w",
@@ -688,10 +950,10 @@ func (c *ChainPlanter) finalizeBatch(
 	return caretaker, nil
 }
 
-// PendingBatch returns the current pending batch. If there's no pending batch,
-// then an error is returned.
-func (c *ChainPlanter) PendingBatch() (*MintingBatch, error) {
-	req := newStateReq[*MintingBatch](reqTypePendingBatch)
+// PendingBatch returns the pending batch with the given name. If there's no
+// such pending batch, then nil is returned.
+func (c *ChainPlanter) PendingBatch(batchName string) (*MintingBatch, error) {
+	req := newStateParamReq[*MintingBatch](reqTypePendingBatch, batchName)
 
 	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
 		return nil, fmt.Errorf("chain planter shutting down")
@@ -726,11 +988,12 @@ func (c *ChainPlanter) ListBatches(batchKey *btcec.PublicKey) ([]*MintingBatch,
 	return <-req.resp, <-req.err
 }
 
-// FinalizeBatch sends a signal to the planter to finalize the current batch.
+// FinalizeBatch sends a signal to the planter to finalize the pending batch
+// selected by the finalize params.
 func (c *ChainPlanter) FinalizeBatch(
-	feeRate *chainfee.SatPerKWeight) (*MintingBatch, error) {
+	params FinalizeParams) (*MintingBatch, error) {
 
-	req := newStateParamReq[*MintingBatch](reqTypeFinalizeBatch, feeRate)
+	req := newStateParamReq[*MintingBatch](reqTypeFinalizeBatch, params)
 
 	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
 		return nil, fmt.Errorf("chain planter shutting down")
@@ -739,9 +1002,193 @@ func (c *ChainPlanter) FinalizeBatch(
 	return <-req.resp, <-req.err
 }
 
-// CancelBatch sends a signal to the planter to cancel the current batch.
-func (c *ChainPlanter) CancelBatch() (*btcec.PublicKey, error) {
-	req := newStateReq[*btcec.PublicKey](reqTypeCancelBatch)
+// PreviewBatch sends a signal to the planter to run a dry run of the pending
+// batch selected by the finalize params, without finalizing it.
+func (c *ChainPlanter) PreviewBatch(
+	params FinalizeParams) (*BatchPreview, error) {
+
+	req := newStateParamReq[*BatchPreview](reqTypePreviewBatch, params)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// CancelBatch sends a signal to the planter to cancel the batch with the given
+// name.
+func (c *ChainPlanter) CancelBatch(batchName string) (*btcec.PublicKey,
+	error) {
+
+	req := newStateParamReq[*btcec.PublicKey](
+		reqTypeCancelBatch, batchName,
+	)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// BumpBatchFee replaces the genesis transaction of the batch with the given
+// key, which must have been broadcast but not yet confirmed, with a
+// transaction that pays the given, higher fee rate.
+func (c *ChainPlanter) BumpBatchFee(batchKey *btcec.PublicKey,
+	feeRate chainfee.SatPerKWeight) (*MintingBatch, error) {
+
+	caretaker, err := c.batchCaretaker(batchKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBatchNotBroadcast, err)
+	}
+
+	// The caretaker re-signs and broadcasts the replacement, which we
+	// don't want to block the gardener for.
+	return caretaker.BumpFee(feeRate)
+}
+
+// PublishSignedBatch publishes the externally signed genesis packet of the
+// externally funded batch with the given key, which continues the minting of
+// the batch.
+func (c *ChainPlanter) PublishSignedBatch(batchKey *btcec.PublicKey,
+	signedPkt *psbt.Packet) (*MintingBatch, error) {
+
+	caretaker, err := c.batchCaretaker(batchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// The caretaker commits and broadcasts the signed packet, which we
+	// don't want to block the gardener for.
+	return caretaker.PublishSignedBatch(signedPkt)
+}
+
+// AddGroupWitnesses adds the externally signed group witnesses of the assets
+// in the frozen batch with the given key, which continues the minting of the
+// batch. The witnesses are keyed by asset name.
+func (c *ChainPlanter) AddGroupWitnesses(batchKey *btcec.PublicKey,
+	witnesses map[string]wire.TxWitness) (*MintingBatch, error) {
+
+	caretaker, err := c.batchCaretaker(batchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// The caretaker verifies and commits the signed sprouts, which we
+	// don't want to block the gardener for.
+	return caretaker.AddGroupWitnesses(witnesses)
+}
+
+// ScheduleBatch schedules the pending batch with the given name to be
+// finalized once the given block height or time is reached. An empty schedule
+// removes an existing schedule from the batch.
+func (c *ChainPlanter) ScheduleBatch(batchName string,
+	schedule BatchSchedule) (*MintingBatch, error) {
+
+	if err := schedule.validate(); err != nil {
+		return nil, err
+	}
+
+	req := newStateParamReq[*MintingBatch](
+		reqTypeScheduleBatch, scheduleParams{
+			batchName: batchName,
+			schedule:  schedule,
+		},
+	)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// AddRecurringMint adds a new recurring mint that mints new units of an
+// existing asset group once per epoch.
+func (c *ChainPlanter) AddRecurringMint(
+	mint RecurringMint) (*RecurringMint, error) {
+
+	if err := mint.validate(); err != nil {
+		return nil, err
+	}
+
+	req := newStateParamReq[*RecurringMint](reqTypeAddRecurringMint, mint)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// ListRecurringMints lists all active recurring mints.
+func (c *ChainPlanter) ListRecurringMints() ([]*RecurringMint, error) {
+	req := newStateReq[[]*RecurringMint](reqTypeListRecurringMints)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// CancelRecurringMint cancels the recurring mint with the given ID.
+func (c *ChainPlanter) CancelRecurringMint(id int64) error {
+	req := newStateParamReq[struct{}](reqTypeCancelRecurringMint, id)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return fmt.Errorf("chain planter shutting down")
+	}
+
+	<-req.resp
+	return <-req.err
+}
+
+// UpdateSeedling changes the amount, meta or group anchor of a seedling in a
+// pending batch, returning the updated batch.
+//
+// NOTE: This is part of the Planter interface.
+func (c *ChainPlanter) UpdateSeedling(
+	change SeedlingChange) (*MintingBatch, error) {
+
+	req := newStateParamReq[*MintingBatch](reqTypeUpdateSeedling, change)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// RemoveSeedling removes the seedling with the given asset name from the
+// pending batch with the given name, returning the updated batch.
+//
+// NOTE: This is part of the Planter interface.
+func (c *ChainPlanter) RemoveSeedling(batchName,
+	assetName string) (*MintingBatch, error) {
+
+	req := newStateParamReq[*MintingBatch](
+		reqTypeRemoveSeedling, removeSeedlingParams{
+			batchName: batchName,
+			assetName: assetName,
+		},
+	)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// batchCaretaker returns the active caretaker of the batch with the given key.
+func (c *ChainPlanter) batchCaretaker(
+	batchKey *btcec.PublicKey) (*BatchCaretaker, error) {
+
+	req := newStateParamReq[*BatchCaretaker](
+		reqTypeBatchCaretaker, batchKey,
+	)
 
 	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
 		return nil, fmt.Errorf("chain planter shutting down")
@@ -782,15 +1229,19 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 		req.GroupInfo = groupInfo
 	}
 
+	// The seedling is added to the pending batch with the requested name,
+	// which is created if it doesn't exist yet.
+	pendingBatch := c.pendingBatches[req.BatchName]
+
 	// If a group anchor is specified, we need to ensure that the anchor
 	// seedling is already in the batch and has emission enabled.
 	if req.GroupAnchor != nil {
-		if c.pendingBatch == nil {
+		if pendingBatch == nil {
 			return fmt.Errorf("batch empty, group anchor %v "+
 				"invalid", *req.GroupAnchor)
 		}
 
-		err := c.pendingBatch.validateGroupAnchor(req)
+		err := pendingBatch.validateGroupAnchor(req)
 		if err != nil {
 			return err
 		}
@@ -801,8 +1252,9 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 	switch {
 	// No batch, so we'll create a new one with only this seedling as part
 	// of the batch.
-	case c.pendingBatch == nil:
-		log.Infof("Creating new MintingBatch w/ %v", req)
+	case pendingBatch == nil:
+		log.Infof("Creating new MintingBatch(name=%q) w/ %v",
+			req.BatchName, req)
 
 		// To create a new batch we'll first need to grab a new
 		// internal key, which'll be used in the output we create, and
@@ -826,6 +1278,7 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 		// where we left off upon restart.
 		newBatch := &MintingBatch{
 			CreationTime: time.Now(),
+			Name:         req.BatchName,
 			HeightHint:   currentHeight,
 			BatchKey:     newInternalKey,
 			Seedlings: map[string]*Seedling{
@@ -841,12 +1294,13 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 			return err
 		}
 
-		c.pendingBatch = newBatch
+		c.pendingBatches[req.BatchName] = newBatch
 
 	// A batch already exists, so we'll add this seedling to the batch,
 	// committing it to disk fully before we move on.
-	case c.pendingBatch != nil:
-		log.Infof("Adding %v to existing MintingBatch", req)
+	case pendingBatch != nil:
+		log.Infof("Adding %v to existing MintingBatch(name=%q)", req,
+			req.BatchName)
 
 		// First attempt to add the seedling to our pending batch, if
 		// this name is already taken (in the batch), then an error
@@ -854,7 +1308,7 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 		//
 		// TODO(roasbeef): unique constraint below? will trigger on the
 		// name?
-		if err := c.pendingBatch.addSeedling(req); err != nil {
+		if err := pendingBatch.addSeedling(req); err != nil {
 			return err
 		}
 
@@ -862,7 +1316,7 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 		ctx, cancel := c.WithCtxQuit()
 		defer cancel()
 		err := c.cfg.Log.AddSeedlingsToBatch(
-			ctx, c.pendingBatch.BatchKey.PubKey, req,
+			ctx, pendingBatch.BatchKey.PubKey, req,
 		)
 		if err != nil {
 			return err
@@ -875,6 +1329,124 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 	return nil
 }
 
+// pendingSeedling returns the pending batch with the given name, and the
+// seedling with the given asset name in that batch.
+func (c *ChainPlanter) pendingSeedling(batchName,
+	assetName string) (*MintingBatch, *Seedling, error) {
+
+	batch, ok := c.pendingBatches[batchName]
+	if !ok {
+		return nil, nil, fmt.Errorf("no pending batch named %q",
+			batchName)
+	}
+
+	seedling, ok := batch.Seedlings[assetName]
+	if !ok {
+		return nil, nil, fmt.Errorf("asset with name %v not in batch",
+			assetName)
+	}
+
+	return batch, seedling, nil
+}
+
+// updateSeedling validates the changes to a seedling in a pending batch, then
+// commits the changed seedling to disk and to the batch.
+func (c *ChainPlanter) updateSeedling(ctx context.Context,
+	change SeedlingChange) (*MintingBatch, error) {
+
+	batch, seedling, err := c.pendingSeedling(
+		change.BatchName, change.AssetName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The changed seedling must pass the same validation as a new one.
+	updated := change.apply(seedling)
+	if err := updated.validateFields(); err != nil {
+		return nil, err
+	}
+	if updated.HasGroupKey() {
+		err := updated.validateGroupKey(*updated.GroupInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if updated.GroupAnchor != nil {
+		switch {
+		case updated.EnableEmission:
+			return nil, fmt.Errorf("must disable emission to " +
+				"specify a group")
+
+		case updated.HasGroupKey():
+			return nil, fmt.Errorf("seedling with group key " +
+				"can't have a group anchor")
+
+		case *updated.GroupAnchor == updated.AssetName:
+			return nil, fmt.Errorf("seedling can't anchor its " +
+				"own group")
+		}
+
+		if err := batch.validateGroupAnchor(updated); err != nil {
+			return nil, err
+		}
+	}
+
+	err = c.cfg.Log.UpdateSeedling(ctx, batch.BatchKey.PubKey, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Updated %v in MintingBatch(name=%q)", updated,
+		change.BatchName)
+
+	batch.Seedlings[updated.AssetName] = updated
+
+	return batch, nil
+}
+
+// removeSeedling removes a seedling from a pending batch, both on disk and in
+// memory.
+func (c *ChainPlanter) removeSeedling(ctx context.Context, batchName,
+	assetName string) (*MintingBatch, error) {
+
+	batch, seedling, err := c.pendingSeedling(batchName, assetName)
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty batch can't be finalized, so the whole batch should be
+	// cancelled instead.
+	if len(batch.Seedlings) == 1 {
+		return nil, fmt.Errorf("can't remove the last seedling of a " +
+			"batch, cancel the batch instead")
+	}
+
+	// Other seedlings may be grouped with this seedling, in which case it
+	// can't be removed before them.
+	for _, other := range batch.Seedlings {
+		if other.GroupAnchor != nil &&
+			*other.GroupAnchor == seedling.AssetName {
+
+			return nil, fmt.Errorf("seedling is group anchor of %v",
+				other.AssetName)
+		}
+	}
+
+	err = c.cfg.Log.RemoveSeedling(ctx, batch.BatchKey.PubKey, assetName)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Removed %v from MintingBatch(name=%q)", seedling,
+		batchName)
+
+	delete(batch.Seedlings, assetName)
+
+	return batch, nil
+}
+
 // updateMintingProofs is called by the re-org watcher when it detects a re-org
 // and has updated the minting proofs. This cannot be done by the caretaker
 // itself, because its job is already done at the point that a re-org can happen
@@ -884,13 +1456,14 @@ func (c *ChainPlanter) updateMintingProofs(proofs []*proof.Proof) error {
 	defer cancel()
 
 	headerVerifier := GenHeaderVerifier(ctx, c.cfg.ChainBridge)
+	medianTimeSource := GenMedianTimeSource(ctx, c.cfg.ChainBridge)
 	groupVerifier := GenGroupVerifier(ctx, c.cfg.Log)
 	for idx := range proofs {
 		p := proofs[idx]
 
 		err := proof.ReplaceProofInBlob(
 			ctx, p, c.cfg.ProofUpdates, headerVerifier,
-			groupVerifier,
+			medianTimeSource, groupVerifier,
 		)
 		if err != nil {
 			return fmt.Errorf("unable to update minted proofs: %w",
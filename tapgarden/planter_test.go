@@ -30,6 +30,7 @@ import (
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/stretchr/testify/require"
 )
@@ -214,7 +215,7 @@ func (t *mintingTestHarness) queueSeedlingsInBatch(
 func (t *mintingTestHarness) assertPendingBatchExists(numSeedlings int) {
 	t.Helper()
 
-	batch, err := t.planter.PendingBatch()
+	batch, err := t.planter.PendingBatch("")
 	require.NoError(t, err)
 	require.NotNil(t, batch)
 	require.Len(t, batch.Seedlings, numSeedlings)
@@ -224,7 +225,7 @@ func (t *mintingTestHarness) assertPendingBatchExists(numSeedlings int) {
 func (t *mintingTestHarness) assertNoPendingBatch() {
 	t.Helper()
 
-	batch, err := t.planter.PendingBatch()
+	batch, err := t.planter.PendingBatch("")
 	require.NoError(t, err)
 	require.Nil(t, batch)
 }
@@ -305,7 +306,7 @@ func (t *mintingTestHarness) assertNewBatchFrozen(
 func (t *mintingTestHarness) cancelMintingBatch(noBatch bool) *btcec.PublicKey {
 	t.Helper()
 
-	batchKey, err := t.planter.CancelBatch()
+	batchKey, err := t.planter.CancelBatch("")
 	if noBatch {
 		require.ErrorContains(t, err, "no pending batch")
 		require.Nil(t, batchKey)
@@ -709,6 +710,117 @@ func testBasicAssetCreation(t *mintingTestHarness) {
 	t.assertNumCaretakersActive(0)
 }
 
+// testMintingReplacedGenesisConf tests that a batch whose genesis transaction
+// was replaced by a fee bump is still finalized if the replaced transaction
+// confirms instead of the replacement, even after a restart.
+func testMintingReplacedGenesisConf(t *mintingTestHarness) {
+	t.refreshChainPlanter()
+
+	// We'll mint a batch of seedlings until its genesis transaction is
+	// published.
+	const numSeedlings = 3
+	seedlings := t.newRandSeedlings(numSeedlings)
+	t.queueSeedlingsInBatch(seedlings...)
+	t.tickMintingBatch(false)
+
+	_ = t.assertGenesisTxFunded()
+	for i := 0; i < numSeedlings; i++ {
+		t.assertKeyDerived()
+
+		if seedlings[i].EnableEmission {
+			t.assertKeyDerived()
+		}
+	}
+
+	t.assertGenesisPsbtFinalized()
+	origTx := t.assertTxPublished()
+	_, err := fn.RecvOrTimeout(t.chain.ConfReqSignal, defaultTimeout)
+	require.NoError(t, err)
+
+	batches, err := t.store.FetchNonFinalBatches(context.Background())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	batch := batches[0]
+
+	// We now bump the fee of the genesis transaction by 1000 sats.
+	oldFee, err := tapgarden.GetTxFee(batch.GenesisPacket.Pkt)
+	require.NoError(t, err)
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(origTx))
+	feeRate := chainfee.SatPerKWeight((oldFee+1000)*1000/weight + 1)
+
+	bumpErr := make(chan error, 1)
+	go func() {
+		_, err := t.planter.BumpBatchFee(
+			batch.BatchKey.PubKey, feeRate,
+		)
+		bumpErr <- err
+	}()
+
+	_, err = fn.RecvOrTimeout(t.wallet.SignPsbtSignal, defaultTimeout)
+	require.NoError(t, err)
+	replacementTx := t.assertTxPublished()
+	require.NotEqual(t, origTx.TxHash(), replacementTx.TxHash())
+
+	// We now wait for the confirmation of both transactions.
+	for i := 0; i < 2; i++ {
+		_, err = fn.RecvOrTimeout(
+			t.chain.ConfReqSignal, defaultTimeout,
+		)
+		require.NoError(t, err)
+	}
+	err = <-bumpErr
+	require.NoError(t, err)
+
+	// After a restart, the replacement is published again and we still
+	// wait for both transactions.
+	t.refreshChainPlanter()
+	select {
+	case <-t.errChan:
+	default:
+	}
+
+	republishedTx := t.assertTxPublished()
+	require.Equal(t, replacementTx.TxHash(), republishedTx.TxHash())
+
+	_, err = fn.RecvOrTimeout(t.chain.ConfReqSignal, defaultTimeout)
+	require.NoError(t, err)
+	origReqNo, err := fn.RecvOrTimeout(
+		t.chain.ConfReqSignal, defaultTimeout,
+	)
+	require.NoError(t, err)
+
+	// The original transaction confirms instead of the replacement.
+	merkleTree := blockchain.BuildMerkleTreeStore(
+		[]*btcutil.Tx{btcutil.NewTx(origTx)}, false,
+	)
+	merkleRoot := merkleTree[len(merkleTree)-1]
+	blockHeader := wire.NewBlockHeader(
+		0, chaincfg.MainNetParams.GenesisHash, merkleRoot, 0, 0,
+	)
+	block := &wire.MsgBlock{
+		Header:       *blockHeader,
+		Transactions: []*wire.MsgTx{origTx},
+	}
+	t.chain.SendConfNtfn(
+		*origReqNo, &chainhash.Hash{}, 1, 0, block, origTx,
+	)
+
+	// The batch should be finalized with the original transaction as its
+	// genesis transaction.
+	t.assertNumCaretakersActive(0)
+	t.assertNoError()
+
+	dbBatch, err := t.store.FetchMintingBatch(
+		context.Background(), batch.BatchKey.PubKey,
+	)
+	require.NoError(t, err)
+	require.Equal(t, tapgarden.BatchStateFinalized, dbBatch.State())
+	require.Equal(
+		t, origTx.TxHash(),
+		dbBatch.GenesisPacket.Pkt.UnsignedTx.TxHash(),
+	)
+}
+
 func testMintingTicker(t *mintingTestHarness) {
 	// First, create a new chain planter instance using the supplied test
 	// harness.
@@ -985,6 +1097,11 @@ var testCases = []mintingStoreTestCase{
 		interval: minterInterval,
 		testFunc: testMintingCancelFinalize,
 	},
+	{
+		name:     "minting_replaced_genesis_conf",
+		interval: defaultInterval,
+		testFunc: testMintingReplacedGenesisConf,
+	},
 }
 
 // TestBatchedAssetIssuance runs a test of tests to ensure that the set of
@@ -567,10 +567,14 @@ func (w *ReOrgWatcher) DefaultUpdateCallback() proof.UpdateCallback {
 		defer cancel()
 
 		headerVerifier := GenHeaderVerifier(ctxt, w.cfg.ChainBridge)
+		medianTimeSource := GenMedianTimeSource(
+			ctxt, w.cfg.ChainBridge,
+		)
 		for idx := range proofs {
 			err := proof.ReplaceProofInBlob(
 				ctxt, proofs[idx], w.cfg.ProofArchive,
-				headerVerifier, w.cfg.GroupVerifier,
+				headerVerifier, medianTimeSource,
+				w.cfg.GroupVerifier,
 			)
 			if err != nil {
 				return fmt.Errorf("unable to update proofs: %w",
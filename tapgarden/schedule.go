@@ -0,0 +1,391 @@
+package tapgarden
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+)
+
+const (
+	// DefaultScheduleInterval is the default interval at which the planter
+	// checks whether a scheduled batch or a recurring mint is due.
+	DefaultScheduleInterval = time.Minute
+)
+
+var (
+	// ErrInvalidSchedule is returned if a batch schedule specifies both a
+	// block height and a time to finalize the batch at.
+	ErrInvalidSchedule = errors.New("only one of block height or time " +
+		"can be specified")
+
+	// ErrUnknownRecurringMint is returned if a recurring mint with the
+	// given ID doesn't exist.
+	ErrUnknownRecurringMint = errors.New("unknown recurring mint")
+)
+
+// BatchSchedule describes when a pending batch should be finalized
+// automatically, independent of the batch ticker.
+type BatchSchedule struct {
+	// FinalizeHeight is the block height at which the batch should be
+	// finalized. Zero if the batch isn't scheduled by height.
+	FinalizeHeight uint32
+
+	// FinalizeTime is the time after which the batch should be finalized.
+	// The zero time if the batch isn't scheduled by time.
+	FinalizeTime time.Time
+}
+
+// IsSet returns true if the schedule specifies a trigger.
+func (s BatchSchedule) IsSet() bool {
+	return s.FinalizeHeight != 0 || !s.FinalizeTime.IsZero()
+}
+
+// validate makes sure at most one trigger is set for the schedule.
+func (s BatchSchedule) validate() error {
+	if s.FinalizeHeight != 0 && !s.FinalizeTime.IsZero() {
+		return ErrInvalidSchedule
+	}
+
+	return nil
+}
+
+// isDue returns true if the batch should be finalized given the current block
+// height and time.
+func (s BatchSchedule) isDue(height uint32, now time.Time) bool {
+	switch {
+	case s.FinalizeHeight != 0:
+		return height >= s.FinalizeHeight
+
+	case !s.FinalizeTime.IsZero():
+		return !now.Before(s.FinalizeTime)
+
+	default:
+		return false
+	}
+}
+
+// RecurringMint describes the recurring issuance of new units of an existing
+// asset group. Once per epoch, which is either a number of blocks or a fixed
+// duration, a new seedling is added to the pending batch of the recurring mint
+// and the batch is finalized.
+type RecurringMint struct {
+	// ID is the unique identifier of the recurring mint, assigned once it
+	// is stored on disk.
+	ID int64
+
+	// AssetName is the name of the asset minted in each epoch.
+	AssetName string
+
+	// Amount is the number of units minted in each epoch.
+	Amount uint64
+
+	// GroupKey is the tweaked group key of the group the new units are
+	// minted into.
+	GroupKey *btcec.PublicKey
+
+	// IntervalBlocks is the length of an epoch in blocks. Zero if the
+	// epoch is time based.
+	IntervalBlocks uint32
+
+	// Interval is the length of an epoch. Zero if the epoch is block
+	// based.
+	Interval time.Duration
+
+	// NextHeight is the block height at which the next units are minted
+	// for block based epochs.
+	NextHeight uint32
+
+	// NextTime is the time after which the next units are minted for time
+	// based epochs.
+	NextTime time.Time
+
+	// MaxEpochs is the total number of epochs to mint new units in. Zero
+	// if the issuance repeats indefinitely.
+	MaxEpochs uint32
+
+	// EpochsMinted is the number of epochs new units were minted in so
+	// far.
+	EpochsMinted uint32
+
+	// CreationTime is the time the recurring mint was created.
+	CreationTime time.Time
+}
+
+// validate checks that the recurring mint has a valid template and a single
+// kind of epoch.
+func (r *RecurringMint) validate() error {
+	if err := asset.ValidateAssetName(r.AssetName); err != nil {
+		return err
+	}
+
+	switch {
+	case r.Amount == 0:
+		return ErrInvalidAssetAmt
+
+	case r.GroupKey == nil:
+		return fmt.Errorf("recurring mint requires a group key")
+
+	case (r.IntervalBlocks == 0) == (r.Interval == 0):
+		return fmt.Errorf("exactly one of interval blocks or " +
+			"interval duration must be specified")
+
+	case r.Interval < 0:
+		return fmt.Errorf("interval must be positive")
+	}
+
+	return nil
+}
+
+// isDue returns true if new units should be minted given the current block
+// height and time.
+func (r *RecurringMint) isDue(height uint32, now time.Time) bool {
+	if r.IntervalBlocks != 0 {
+		return height >= r.NextHeight
+	}
+
+	return !now.Before(r.NextTime)
+}
+
+// advance moves the trigger to the start of the next epoch. If epochs were
+// missed, for example because the daemon was offline, they're skipped instead
+// of being minted at once.
+func (r *RecurringMint) advance(height uint32, now time.Time) {
+	if r.IntervalBlocks != 0 {
+		if r.NextHeight <= height {
+			epochs := (height-r.NextHeight)/r.IntervalBlocks + 1
+			r.NextHeight += epochs * r.IntervalBlocks
+		}
+
+		return
+	}
+
+	if !r.NextTime.After(now) {
+		epochs := now.Sub(r.NextTime)/r.Interval + 1
+		r.NextTime = r.NextTime.Add(epochs * r.Interval)
+	}
+}
+
+// isComplete returns true if new units were minted in all epochs of the
+// recurring mint.
+func (r *RecurringMint) isComplete() bool {
+	return r.MaxEpochs != 0 && r.EpochsMinted >= r.MaxEpochs
+}
+
+// batchName returns the name of the pending batch the units of the recurring
+// mint are minted in. Each recurring mint uses its own batch, so it never
+// finalizes a batch that other seedlings were added to.
+func (r *RecurringMint) batchName() string {
+	return fmt.Sprintf("recurring-%d", r.ID)
+}
+
+// seedling creates the seedling for the units minted in the current epoch
+// into the given asset group.
+func (r *RecurringMint) seedling(group *asset.AssetGroup) *Seedling {
+	return &Seedling{
+		AssetType: group.Genesis.Type,
+		AssetName: r.AssetName,
+		Amount:    r.Amount,
+		GroupInfo: group,
+		BatchName: r.batchName(),
+	}
+}
+
+// addRecurringMint validates the new recurring mint against the asset group
+// it mints into, then stores it on disk and activates it.
+func (c *ChainPlanter) addRecurringMint(ctx context.Context,
+	mint RecurringMint) (*RecurringMint, error) {
+
+	// We make sure the units can actually be minted into the group now,
+	// instead of finding out once the first epoch is reached.
+	group, err := c.cfg.Log.FetchGroupByGroupKey(ctx, mint.GroupKey)
+	if err != nil {
+		return nil, fmt.Errorf("group key %x not found: %w",
+			mint.GroupKey.SerializeCompressed(), err)
+	}
+
+	seedling := mint.seedling(group)
+	if err := seedling.validateFields(); err != nil {
+		return nil, err
+	}
+	if err := seedling.validateGroupKey(*group); err != nil {
+		return nil, err
+	}
+
+	currentHeight, err := c.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get current height: %w",
+			err)
+	}
+
+	// Unless specified otherwise, the first epoch starts now and new
+	// units are minted once it ends.
+	now := time.Now()
+	switch {
+	case mint.IntervalBlocks != 0 && mint.NextHeight == 0:
+		mint.NextHeight = currentHeight + mint.IntervalBlocks
+
+	case mint.Interval != 0 && mint.NextTime.IsZero():
+		mint.NextTime = now.Add(mint.Interval)
+	}
+	mint.EpochsMinted = 0
+	mint.CreationTime = now
+
+	mint.ID, err = c.cfg.Log.AddRecurringMint(ctx, &mint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add recurring mint: %w", err)
+	}
+
+	log.Infof("Added recurring mint %d of %d units of %v into group %x",
+		mint.ID, mint.Amount, mint.AssetName,
+		mint.GroupKey.SerializeCompressed())
+
+	c.recurringMints[mint.ID] = &mint
+
+	mintCopy := mint
+	return &mintCopy, nil
+}
+
+// listRecurringMints returns a copy of all active recurring mints, ordered by
+// their ID.
+func (c *ChainPlanter) listRecurringMints() []*RecurringMint {
+	mints := make([]*RecurringMint, 0, len(c.recurringMints))
+	for _, mint := range c.recurringMints {
+		mintCopy := *mint
+		mints = append(mints, &mintCopy)
+	}
+
+	sort.Slice(mints, func(i, j int) bool {
+		return mints[i].ID < mints[j].ID
+	})
+
+	return mints
+}
+
+// cancelRecurringMint deletes the recurring mint with the given ID, so no
+// further units are minted for it.
+func (c *ChainPlanter) cancelRecurringMint(ctx context.Context,
+	id int64) error {
+
+	if _, ok := c.recurringMints[id]; !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownRecurringMint, id)
+	}
+
+	if err := c.cfg.Log.DeleteRecurringMint(ctx, id); err != nil {
+		return fmt.Errorf("unable to delete recurring mint: %w", err)
+	}
+
+	delete(c.recurringMints, id)
+
+	return nil
+}
+
+// processSchedules adds a seedling to the pending batch of each recurring mint
+// that is due. Each pending batch is then finalized if it received any of
+// those seedlings, or if it is scheduled to be finalized by now.
+func (c *ChainPlanter) processSchedules() error {
+	if len(c.pendingBatches) == 0 && len(c.recurringMints) == 0 {
+		return nil
+	}
+
+	ctx, cancel := c.WithCtxQuit()
+	defer cancel()
+
+	// Failing to fetch the height isn't critical, we'll just try again on
+	// the next tick.
+	currentHeight, err := c.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		log.Warnf("Unable to get current height to process minting "+
+			"schedules: %v", err)
+		return nil
+	}
+	now := time.Now()
+
+	finalize := make(map[string]struct{})
+	for name, batch := range c.pendingBatches {
+		if batch.Schedule.isDue(currentHeight, now) {
+			finalize[name] = struct{}{}
+		}
+	}
+
+	for _, mint := range c.listRecurringMints() {
+		if !mint.isDue(currentHeight, now) {
+			continue
+		}
+
+		// A failure to mint new units only skips the current epoch,
+		// so a single bad epoch can't stall the recurring mint.
+		err := c.queueRecurringMint(ctx, mint)
+		if err != nil {
+			log.Errorf("Unable to mint units for recurring mint "+
+				"%d: %v", mint.ID, err)
+		} else {
+			mint.EpochsMinted++
+			finalize[mint.batchName()] = struct{}{}
+		}
+
+		mint.advance(currentHeight, now)
+
+		if mint.isComplete() {
+			log.Infof("Recurring mint %d complete after %d epochs",
+				mint.ID, mint.EpochsMinted)
+
+			err = c.cancelRecurringMint(ctx, mint.ID)
+		} else {
+			err = c.cfg.Log.UpdateRecurringMint(ctx, mint)
+			c.recurringMints[mint.ID] = mint
+		}
+		if err != nil {
+			return fmt.Errorf("unable to update recurring mint: "+
+				"%w", err)
+		}
+	}
+
+	for name := range finalize {
+		batch, ok := c.pendingBatches[name]
+		if !ok {
+			continue
+		}
+
+		log.Infof("Finalizing scheduled batch %x",
+			batch.BatchKey.PubKey.SerializeCompressed())
+
+		_, err := c.finalizeBatch(batch, FinalizeParams{})
+		if err != nil {
+			return fmt.Errorf("unable to freeze minting batch: %w",
+				err)
+		}
+
+		// Now that we have a caretaker launched for this batch, we'll
+		// remove it from the pending batches.
+		delete(c.pendingBatches, name)
+	}
+
+	return nil
+}
+
+// queueRecurringMint adds a seedling for the units of the current epoch of a
+// recurring mint to the pending batch of the recurring mint.
+func (c *ChainPlanter) queueRecurringMint(ctx context.Context,
+	mint *RecurringMint) error {
+
+	group, err := c.cfg.Log.FetchGroupByGroupKey(ctx, mint.GroupKey)
+	if err != nil {
+		return fmt.Errorf("group key %x not found: %w",
+			mint.GroupKey.SerializeCompressed(), err)
+	}
+
+	seedling := mint.seedling(group)
+	if err := c.prepAssetSeedling(ctx, seedling); err != nil {
+		return err
+	}
+
+	log.Infof("Added %v for epoch %d of recurring mint %d", seedling,
+		mint.EpochsMinted+1, mint.ID)
+
+	return nil
+}
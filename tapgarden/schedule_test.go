@@ -0,0 +1,102 @@
+package tapgarden
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBatchScheduleDue tests that a batch schedule is due once its block
+// height or time is reached.
+func TestBatchScheduleDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	var schedule BatchSchedule
+	require.False(t, schedule.IsSet())
+	require.False(t, schedule.isDue(1_000, now))
+	require.NoError(t, schedule.validate())
+
+	schedule = BatchSchedule{FinalizeHeight: 100}
+	require.True(t, schedule.IsSet())
+	require.False(t, schedule.isDue(99, now))
+	require.True(t, schedule.isDue(100, now))
+	require.True(t, schedule.isDue(101, now))
+
+	schedule = BatchSchedule{FinalizeTime: now}
+	require.True(t, schedule.IsSet())
+	require.False(t, schedule.isDue(1_000, now.Add(-time.Second)))
+	require.True(t, schedule.isDue(0, now))
+
+	schedule.FinalizeHeight = 100
+	require.ErrorIs(t, schedule.validate(), ErrInvalidSchedule)
+}
+
+// TestRecurringMintEpochs tests that recurring mints are due at the end of
+// each epoch, skip missed epochs, and complete after their last epoch.
+func TestRecurringMintEpochs(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	groupKey := test.RandPubKey(t)
+
+	blockMint := &RecurringMint{
+		AssetName:      "block-mint",
+		Amount:         10,
+		GroupKey:       groupKey,
+		IntervalBlocks: 10,
+		NextHeight:     110,
+		MaxEpochs:      2,
+	}
+	require.NoError(t, blockMint.validate())
+	require.False(t, blockMint.isDue(109, now))
+	require.True(t, blockMint.isDue(110, now))
+
+	blockMint.advance(110, now)
+	require.EqualValues(t, 120, blockMint.NextHeight)
+
+	// If a few epochs were missed, the next trigger is the end of the
+	// current epoch.
+	blockMint.advance(145, now)
+	require.EqualValues(t, 150, blockMint.NextHeight)
+
+	blockMint.EpochsMinted = 1
+	require.False(t, blockMint.isComplete())
+	blockMint.EpochsMinted = 2
+	require.True(t, blockMint.isComplete())
+
+	timeMint := &RecurringMint{
+		AssetName: "time-mint",
+		Amount:    10,
+		GroupKey:  groupKey,
+		Interval:  time.Hour,
+		NextTime:  now,
+	}
+	require.NoError(t, timeMint.validate())
+	require.False(t, timeMint.isDue(0, now.Add(-time.Second)))
+	require.True(t, timeMint.isDue(0, now))
+
+	timeMint.advance(0, now.Add(90*time.Minute))
+	require.Equal(t, now.Add(2*time.Hour), timeMint.NextTime)
+
+	// Without a maximum number of epochs, the mint never completes.
+	timeMint.EpochsMinted = 1_000
+	require.False(t, timeMint.isComplete())
+
+	// A recurring mint needs exactly one kind of epoch.
+	timeMint.IntervalBlocks = 10
+	require.ErrorContains(t, timeMint.validate(), "exactly one")
+	timeMint.IntervalBlocks = 0
+	timeMint.Interval = 0
+	require.ErrorContains(t, timeMint.validate(), "exactly one")
+
+	// And it can only mint a non-zero amount into a group.
+	blockMint.Amount = 0
+	require.ErrorIs(t, blockMint.validate(), ErrInvalidAssetAmt)
+	blockMint.Amount = 10
+	blockMint.GroupKey = nil
+	require.ErrorContains(t, blockMint.validate(), "group key")
+}
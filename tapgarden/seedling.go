@@ -1,8 +1,10 @@
 package tapgarden
 
 import (
+	"crypto/sha256"
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/proof"
 )
@@ -93,10 +95,66 @@ type Seedling struct {
 	// same group key as the anchor asset.
 	GroupAnchor *string
 
+	// ExternalGroupKey is the raw group key of the asset if that key is
+	// held outside of the daemon, for example in an HSM. It can be set to
+	// create a new asset group if emission is enabled, or to issue into an
+	// existing group with that raw key. The group witness of the asset
+	// must then be provided externally before the batch is committed.
+	ExternalGroupKey *btcec.PublicKey
+
+	// GroupTapscriptRoot is the optional root of the tapscript tree the
+	// new asset group created with an external group key commits to.
+	GroupTapscriptRoot []byte
+
+	// BatchName is the name of the pending batch the seedling should be
+	// added to. If empty, the seedling is added to the default pending
+	// batch.
+	BatchName string
+
 	// update is used to send updates w.r.t the state of the batch.
 	updates SeedlingUpdates
 }
 
+// SeedlingChange describes the changes to apply to a seedling in a pending
+// batch. Fields that are nil are left unchanged.
+type SeedlingChange struct {
+	// BatchName is the name of the pending batch the seedling is in.
+	BatchName string
+
+	// AssetName is the name of the seedling to change.
+	AssetName string
+
+	// Amount is the new total amount of the asset.
+	Amount *uint64
+
+	// Meta is the new set of metadata associated with the asset.
+	Meta *proof.MetaReveal
+
+	// GroupAnchor is the name of the new group anchor of the asset. An
+	// empty name removes the group anchor from the seedling.
+	GroupAnchor *string
+}
+
+// apply returns a copy of the given seedling with the changes applied.
+func (c SeedlingChange) apply(seedling *Seedling) *Seedling {
+	updated := *seedling
+
+	if c.Amount != nil {
+		updated.Amount = *c.Amount
+	}
+	if c.Meta != nil {
+		updated.Meta = c.Meta
+	}
+	if c.GroupAnchor != nil {
+		updated.GroupAnchor = c.GroupAnchor
+		if *c.GroupAnchor == "" {
+			updated.GroupAnchor = nil
+		}
+	}
+
+	return &updated
+}
+
 // validateFields attempts to validate the set of input fields for the passed
 // seedling, an error is returned if any of the fields are out of spec.
 //
@@ -120,19 +178,72 @@ func (c Seedling) validateFields() error {
 	// Creating an asset with zero available supply is not allowed.
 	case c.Amount == 0:
 		return ErrInvalidAssetAmt
+
+	case len(c.BatchName) > MaxBatchNameLength:
+		return fmt.Errorf("batch name cannot exceed %d bytes",
+			MaxBatchNameLength)
+
+	case c.ExternalGroupKey != nil && !c.EnableEmission &&
+		!c.HasGroupKey():
+
+		return fmt.Errorf("external group key requires emission to " +
+			"be enabled or a group key")
+
+	case len(c.GroupTapscriptRoot) != 0 &&
+		(c.ExternalGroupKey == nil || !c.EnableEmission):
+
+		return fmt.Errorf("group tapscript root can only be set for " +
+			"a new group with an external group key")
+
+	case len(c.GroupTapscriptRoot) != 0 &&
+		len(c.GroupTapscriptRoot) != sha256.Size:
+
+		return fmt.Errorf("group tapscript root must be %d bytes",
+			sha256.Size)
+	}
+
+	// The meta data must be valid for its type, so consumers can rely on
+	// the structure of the meta data.
+	if err := c.Meta.Validate(); err != nil {
+		return fmt.Errorf("invalid asset meta: %w", err)
+	}
+
+	// A collectible can't be divided, so it can't be displayed with
+	// decimal places either.
+	if c.AssetType == asset.Collectible && c.Meta != nil &&
+		c.Meta.Type == proof.MetaJson {
+
+		jsonMeta, err := c.Meta.JsonMeta()
+		if err != nil {
+			return fmt.Errorf("invalid asset meta: %w", err)
+		}
+
+		if jsonMeta.DecimalDisplay != 0 {
+			return fmt.Errorf("collectible cannot have a decimal " +
+				"display")
+		}
 	}
 
 	return nil
 }
 
 // validateGroupKey attempts to validate that the non-zero group key provided
-// with a seedling is owned by the daemon and can be used with this seedling.
+// with a seedling is owned by the daemon, or explicitly held externally, and
+// can be used with this seedling.
 func (c Seedling) validateGroupKey(group asset.AssetGroup) error {
-	// We must be able to sign with the group key.
-	if !group.GroupKey.IsLocal() {
+	// We must be able to sign with the group key, unless the group witness
+	// is provided with the external group key.
+	rawKey := group.GroupKey.RawKey.PubKey
+	heldExternally := c.ExternalGroupKey != nil &&
+		c.ExternalGroupKey.IsEqual(rawKey)
+	if !group.GroupKey.IsLocal() && !heldExternally {
 		groupKeyBytes := c.GroupInfo.GroupPubKey.SerializeCompressed()
 		return fmt.Errorf("can't sign with group key %x", groupKeyBytes)
 	}
+	if group.GroupKey.IsLocal() && c.ExternalGroupKey != nil {
+		return fmt.Errorf("group key is held by the daemon, not " +
+			"externally")
+	}
 
 	// The seedling asset type must match the group asset type.
 	if c.AssetType != group.Genesis.Type {
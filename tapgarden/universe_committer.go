@@ -0,0 +1,308 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+const (
+	// UniverseCommitConfTarget is the confirmation target we'll use to
+	// query for a fee estimate for universe root commitments.
+	UniverseCommitConfTarget = 6
+)
+
+// UniverseCommitterConfig houses all the items that the UniverseCommitter
+// needs to anchor multiverse roots in the chain.
+type UniverseCommitterConfig struct {
+	// Wallet is used to fund and sign the anchor transactions.
+	Wallet WalletAnchor
+
+	// ChainBridge is used to publish the anchor transactions and to wait
+	// for them to confirm.
+	ChainBridge ChainBridge
+
+	// KeyRing is used to derive the internal keys of the anchor outputs.
+	KeyRing KeyRing
+}
+
+// UniverseCommitter is an implementation of the universe.ChainCommitter
+// interface that uses the backing wallet to anchor multiverse roots in a
+// taproot output of a new transaction.
+type UniverseCommitter struct {
+	cfg UniverseCommitterConfig
+}
+
+// A compile-time check to ensure that UniverseCommitter meets the
+// universe.ChainCommitter interface.
+var _ universe.ChainCommitter = (*UniverseCommitter)(nil)
+
+// NewUniverseCommitter creates a new universe committer from the passed
+// config.
+func NewUniverseCommitter(cfg UniverseCommitterConfig) *UniverseCommitter {
+	return &UniverseCommitter{
+		cfg: cfg,
+	}
+}
+
+// NewCommitmentTx creates and signs a new transaction that anchors the given
+// multiverse root, without publishing it.
+//
+// NOTE: This is part of the universe.ChainCommitter interface.
+func (u *UniverseCommitter) NewCommitmentTx(ctx context.Context,
+	root mssmt.Node) (*universe.PendingCommitment, error) {
+
+	// The anchor transaction can't confirm below the current height, so
+	// we'll use it as the height hint when waiting for the confirmation.
+	heightHint, err := u.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch current height: %w",
+			err)
+	}
+
+	internalKey, err := u.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive internal key: %w", err)
+	}
+
+	pkScript, err := universe.RootCommitmentPkScript(
+		internalKey.PubKey, root,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorTx, outputIndex, err := u.signAnchorTx(ctx, pkScript)
+	if err != nil {
+		return nil, err
+	}
+
+	return &universe.PendingCommitment{
+		AnchorTx:     anchorTx,
+		OutputIndex:  outputIndex,
+		InternalKey:  internalKey.PubKey,
+		UniverseRoot: root,
+		HeightHint:   heightHint,
+	}, nil
+}
+
+// ConfirmCommitment publishes the anchor transaction of the pending
+// commitment and returns the commitment once the transaction has confirmed.
+//
+// NOTE: This is part of the universe.ChainCommitter interface.
+func (u *UniverseCommitter) ConfirmCommitment(ctx context.Context,
+	pending *universe.PendingCommitment) (*universe.Commitment, error) {
+
+	// Publishing a transaction that's already in the mempool or in the
+	// chain isn't an error, so this is safe to do when resuming a
+	// commitment.
+	err := u.cfg.ChainBridge.PublishTransaction(ctx, pending.AnchorTx)
+	switch {
+	case err != nil && ctx.Err() != nil:
+		return nil, ctx.Err()
+
+	// Any other failure, such as losing the connection to the backend,
+	// might be temporary. We'll keep the inputs locked, so the same
+	// transaction can be published again on the next attempt.
+	case err != nil && !isTxRejected(err):
+		return nil, fmt.Errorf("unable to publish commitment tx: %w",
+			err)
+
+	case err != nil:
+		releaseErr := u.ReleaseCommitmentTx(ctx, pending)
+		if releaseErr != nil {
+			log.Warnf("Unable to release commitment tx inputs: %v",
+				releaseErr)
+		}
+
+		return nil, fmt.Errorf("%w: %v",
+			universe.ErrCommitmentTxRejected, err)
+	}
+
+	log.Infof("Published universe root commitment tx %v",
+		pending.AnchorTx.TxHash())
+
+	outputKey, err := universe.RootCommitmentOutputKey(
+		pending.InternalKey, pending.UniverseRoot,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll import the output key into the backing wallet, so it knows
+	// about the de minimis amt sats of the anchor output.
+	_, err = u.cfg.Wallet.ImportTaprootOutput(ctx, outputKey)
+	if err != nil && !strings.Contains(err.Error(), "already exists") {
+		return nil, fmt.Errorf("unable to import key: %w", err)
+	}
+
+	return u.waitForCommitment(ctx, pending)
+}
+
+// isTxRejected returns true if the given error of publishing a transaction
+// means that the transaction will never be accepted, because it either
+// conflicts with another transaction or doesn't pay enough fees.
+func isTxRejected(err error) bool {
+	// The error might have been returned over RPC, so we can only match on
+	// the error message.
+	errStr := err.Error()
+
+	return strings.Contains(errStr, lnwallet.ErrDoubleSpend.Error()) ||
+		strings.Contains(errStr, lnwallet.ErrMempoolFee.Error())
+}
+
+// ReleaseCommitmentTx releases the inputs of an anchor transaction that was
+// never published.
+//
+// NOTE: This is part of the universe.ChainCommitter interface.
+func (u *UniverseCommitter) ReleaseCommitmentTx(ctx context.Context,
+	pending *universe.PendingCommitment) error {
+
+	for _, txIn := range pending.AnchorTx.TxIn {
+		op := txIn.PreviousOutPoint
+		if err := u.cfg.Wallet.UnlockInput(ctx, op); err != nil {
+			return fmt.Errorf("unable to unlock input %v: %w", op,
+				err)
+		}
+	}
+
+	return nil
+}
+
+// signAnchorTx funds and signs a transaction that pays to the given pkScript.
+// The final transaction and the index of the anchor output are returned.
+func (u *UniverseCommitter) signAnchorTx(ctx context.Context,
+	pkScript []byte) (*wire.MsgTx, uint32, error) {
+
+	txTemplate := wire.NewMsgTx(2)
+	txTemplate.AddTxOut(&wire.TxOut{
+		PkScript: pkScript,
+		Value:    int64(GenesisAmtSats),
+	})
+	anchorPkt, err := psbt.NewFromUnsignedTx(txTemplate)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to make psbt packet: %w",
+			err)
+	}
+
+	feeRate, err := u.cfg.ChainBridge.EstimateFee(
+		ctx, UniverseCommitConfTarget,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to estimate fee: %w", err)
+	}
+
+	fundedPkt, err := u.cfg.Wallet.FundPsbt(ctx, anchorPkt, 1, feeRate)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to fund psbt: %w", err)
+	}
+
+	// If anything goes wrong before the transaction is signed, we'll
+	// release the inputs the wallet locked for us.
+	signed := false
+	defer func() {
+		if signed {
+			return
+		}
+
+		for _, op := range fundedPkt.LockedUTXOs {
+			err := u.cfg.Wallet.UnlockInput(ctx, op)
+			if err != nil {
+				log.Warnf("Unable to unlock input %v: %v", op,
+					err)
+			}
+		}
+	}()
+
+	signedPkt, err := u.cfg.Wallet.SignAndFinalizePsbt(
+		ctx, fundedPkt.Pkt,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	anchorTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	// The wallet may have added a change output in front of ours, so
+	// we'll need to look up the anchor output again.
+	outputIndex := -1
+	for i, txOut := range anchorTx.TxOut {
+		if bytes.Equal(txOut.PkScript, pkScript) {
+			outputIndex = i
+			break
+		}
+	}
+	if outputIndex < 0 {
+		return nil, 0, fmt.Errorf("anchor output not found in funded " +
+			"transaction")
+	}
+	signed = true
+
+	return anchorTx, uint32(outputIndex), nil
+}
+
+// waitForCommitment waits for the anchor transaction of the pending
+// commitment to confirm, then builds the commitment from the block it
+// confirmed in.
+func (u *UniverseCommitter) waitForCommitment(ctx context.Context,
+	pending *universe.PendingCommitment) (*universe.Commitment, error) {
+
+	anchorTx := pending.AnchorTx
+	txHash := anchorTx.TxHash()
+	pkScript := anchorTx.TxOut[pending.OutputIndex].PkScript
+	confNtfn, errChan, err := u.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		ctx, &txHash, pkScript, 1, pending.HeightHint, true, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register for commitment tx "+
+			"conf: %w", err)
+	}
+
+	var confEvent *chainntnfs.TxConfirmation
+	select {
+	case confEvent = <-confNtfn.Confirmed:
+
+	case err := <-errChan:
+		return nil, fmt.Errorf("error getting confirmation: %w", err)
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if confEvent == nil || confEvent.Block == nil {
+		return nil, fmt.Errorf("got empty confirmation event for " +
+			"commitment tx")
+	}
+
+	merkleProof, err := proof.NewTxMerkleProof(
+		confEvent.Block.Transactions, int(confEvent.TxIndex),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create merkle proof: %w", err)
+	}
+
+	return &universe.Commitment{
+		BlockHeight:  confEvent.BlockHeight,
+		BlockHeader:  confEvent.Block.Header,
+		MerkleProof:  merkleProof,
+		AnchorTx:     anchorTx,
+		OutputIndex:  pending.OutputIndex,
+		InternalKey:  pending.InternalKey,
+		UniverseRoot: pending.UniverseRoot,
+	}, nil
+}
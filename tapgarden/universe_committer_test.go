@@ -0,0 +1,120 @@
+package tapgarden
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/stretchr/testify/require"
+)
+
+// publishErrChainBridge is a mock chain bridge that fails to publish any
+// transaction with the given error.
+type publishErrChainBridge struct {
+	*MockChainBridge
+
+	err error
+}
+
+func (p *publishErrChainBridge) PublishTransaction(context.Context,
+	*wire.MsgTx) error {
+
+	return p.err
+}
+
+// unlockRecordingWallet is a mock wallet that records the unlocked inputs.
+type unlockRecordingWallet struct {
+	*MockWalletAnchor
+
+	unlocked []wire.OutPoint
+}
+
+func (u *unlockRecordingWallet) UnlockInput(_ context.Context,
+	op wire.OutPoint) error {
+
+	u.unlocked = append(u.unlocked, op)
+	return nil
+}
+
+// TestConfirmCommitmentPublishErr tests that the inputs of a commitment
+// transaction are only released if the transaction was definitively rejected.
+func TestConfirmCommitmentPublishErr(t *testing.T) {
+	t.Parallel()
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	anchorTx.AddTxOut(&wire.TxOut{
+		Value:    int64(GenesisAmtSats),
+		PkScript: test.RandBytes(34),
+	})
+
+	pending := &universe.PendingCommitment{
+		AnchorTx:     anchorTx,
+		InternalKey:  test.RandPubKey(t),
+		UniverseRoot: mssmt.EmptyTree[0],
+	}
+
+	testCases := []struct {
+		name        string
+		publishErr  error
+		rejected    bool
+		numUnlocked int
+	}{{
+		name:       "backend unavailable",
+		publishErr: fmt.Errorf("rpc error: connection refused"),
+	}, {
+		name: "double spend",
+		publishErr: fmt.Errorf("rpc error: %v",
+			lnwallet.ErrDoubleSpend),
+		rejected:    true,
+		numUnlocked: 1,
+	}, {
+		name: "fee too low",
+		publishErr: fmt.Errorf("%w: min relay fee not met",
+			lnwallet.ErrMempoolFee),
+		rejected:    true,
+		numUnlocked: 1,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			wallet := &unlockRecordingWallet{
+				MockWalletAnchor: NewMockWalletAnchor(),
+			}
+			chainBridge := &publishErrChainBridge{
+				MockChainBridge: NewMockChainBridge(),
+				err:             testCase.publishErr,
+			}
+			committer := NewUniverseCommitter(
+				UniverseCommitterConfig{
+					Wallet:      wallet,
+					ChainBridge: chainBridge,
+				},
+			)
+
+			_, err := committer.ConfirmCommitment(
+				context.Background(), pending,
+			)
+			require.ErrorContains(
+				t, err, testCase.publishErr.Error(),
+			)
+			rejected := errors.Is(
+				err, universe.ErrCommitmentTxRejected,
+			)
+			require.Equal(t, testCase.rejected, rejected)
+			require.Len(t, wallet.unlocked, testCase.numUnlocked)
+		})
+	}
+}
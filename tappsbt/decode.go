@@ -93,6 +93,7 @@ func NewFromPsbt(packet *psbt.Packet) (*VPacket, error) {
 	vPkt := &VPacket{
 		Version:     version,
 		ChainParams: chainParams,
+		LockTime:    packet.UnsignedTx.LockTime,
 		Inputs:      make([]*VInput, len(packet.Inputs)),
 		Outputs:     make([]*VOutput, len(packet.Outputs)),
 	}
@@ -104,6 +105,7 @@ func NewFromPsbt(packet *psbt.Packet) (*VPacket, error) {
 			return nil, fmt.Errorf("error decoding virtual input "+
 				"%d: %w", idx, err)
 		}
+		vIn.Sequence = packet.UnsignedTx.TxIn[idx].Sequence
 
 		vPkt.Inputs[idx] = vIn
 	}
@@ -169,6 +171,16 @@ func (i *VInput) decode(pIn psbt.PInput) error {
 	}, {
 		key:     PsbtKeyTypeInputTapAssetProof,
 		decoder: tlvDecoder(&i.proof, tlv.DVarBytes),
+	}, {
+		key: PsbtKeyTypeInputTapScriptWitnessStack,
+		decoder: tlvDecoder(
+			&i.ScriptWitnessStack, asset.TxWitnessDecoder,
+		),
+	}, {
+		key: PsbtKeyTypeInputTapScriptWitnessSigDepth,
+		decoder: tlvDecoder(
+			&i.ScriptWitnessSigDepth, tlv.DUint32,
+		),
 	}}
 
 	for idx := range mapping {
@@ -283,6 +295,12 @@ func (o *VOutput) decode(pOut psbt.POutput, txOut *wire.TxOut) error {
 				&o.AssetVersion, vOutputAssetVersionDecoder,
 			),
 		},
+		{
+			key: PsbtKeyTypeOutputTapScriptVersion,
+			decoder: tlvDecoder(
+				&o.ScriptVersion, asset.ScriptVersionDecoder,
+			),
+		},
 	}
 
 	for idx := range mapping {
@@ -46,9 +46,10 @@ type encoderMapping struct {
 // error if the encoding fails.
 func (p *VPacket) EncodeAsPsbt() (*psbt.Packet, error) {
 	unsignedTx := &wire.MsgTx{
-		Version: 2,
-		TxIn:    make([]*wire.TxIn, len(p.Inputs)),
-		TxOut:   make([]*wire.TxOut, len(p.Outputs)),
+		Version:  2,
+		TxIn:     make([]*wire.TxIn, len(p.Inputs)),
+		TxOut:    make([]*wire.TxOut, len(p.Outputs)),
+		LockTime: p.LockTime,
 	}
 	packet := &psbt.Packet{
 		UnsignedTx: unsignedTx,
@@ -77,7 +78,9 @@ func (p *VPacket) EncodeAsPsbt() (*psbt.Packet, error) {
 				idx, err)
 		}
 
-		unsignedTx.TxIn[idx] = &wire.TxIn{}
+		unsignedTx.TxIn[idx] = &wire.TxIn{
+			Sequence: p.Inputs[idx].Sequence,
+		}
 		packet.Inputs[idx] = pIn
 	}
 
@@ -183,6 +186,14 @@ func (i *VInput) encode() (psbt.PInput, error) {
 			key:     PsbtKeyTypeInputTapAssetProof,
 			encoder: tlvEncoder(&i.proof, tlv.EVarBytes),
 		},
+		{
+			key:     PsbtKeyTypeInputTapScriptWitnessStack,
+			encoder: witnessStackEncoder(i.ScriptWitnessStack),
+		},
+		{
+			key:     PsbtKeyTypeInputTapScriptWitnessSigDepth,
+			encoder: uint32Encoder(i.ScriptWitnessSigDepth),
+		},
 	}
 
 	for idx := range mapping {
@@ -282,6 +293,10 @@ func (o *VOutput) encode(coinType uint32) (psbt.POutput, *wire.TxOut, error) {
 				&o.AssetVersion, vOutputAssetVersionEncoder,
 			),
 		},
+		{
+			key:     PsbtKeyTypeOutputTapScriptVersion,
+			encoder: scriptVersionEncoder(o.ScriptVersion),
+		},
 	}
 
 	for idx := range mapping {
@@ -347,6 +362,41 @@ func assetEncoder(a *asset.Asset) encoderFunc {
 	return tlvEncoder(a, asset.LeafEncoder)
 }
 
+// witnessStackEncoder is an encoder that does nothing if the given witness
+// stack is empty.
+func witnessStackEncoder(witness wire.TxWitness) encoderFunc {
+	if len(witness) == 0 {
+		return func([]byte) ([]*customPsbtField, error) {
+			return nil, nil
+		}
+	}
+
+	return tlvEncoder(&witness, asset.TxWitnessEncoder)
+}
+
+// uint32Encoder is an encoder that does nothing if the given value is zero.
+func uint32Encoder(val uint32) encoderFunc {
+	if val == 0 {
+		return func([]byte) ([]*customPsbtField, error) {
+			return nil, nil
+		}
+	}
+
+	return tlvEncoder(&val, tlv.EUint32)
+}
+
+// scriptVersionEncoder is an encoder that does nothing if the given script
+// version is the initial script version.
+func scriptVersionEncoder(version asset.ScriptVersion) encoderFunc {
+	if version == asset.ScriptV0 {
+		return func([]byte) ([]*customPsbtField, error) {
+			return nil, nil
+		}
+	}
+
+	return tlvEncoder(&version, asset.ScriptVersionEncoder)
+}
+
 // booleanEncoder returns a function that encodes the given boolean value as a
 // byte slice.
 func booleanEncoder(val bool) encoderFunc {
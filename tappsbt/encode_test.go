@@ -1,8 +1,11 @@
 package tappsbt
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,3 +22,59 @@ func TestEncodeAsPsbt(t *testing.T) {
 
 	require.NotEmpty(t, b64)
 }
+
+// TestEncodeScriptWitnessStack tests that the additional script path witness
+// stack elements of an input survive an encoding round trip.
+func TestEncodeScriptWitnessStack(t *testing.T) {
+	t.Parallel()
+
+	pkg := RandPacket(t)
+	pkg.Inputs[0].ScriptWitnessStack = wire.TxWitness{
+		[]byte("preimage"), {}, []byte("more data"),
+	}
+	pkg.Inputs[0].ScriptWitnessSigDepth = 2
+
+	var buf bytes.Buffer
+	require.NoError(t, pkg.Serialize(&buf))
+
+	decoded, err := NewFromRawBytes(&buf, false)
+	require.NoError(t, err)
+
+	require.Equal(
+		t, pkg.Inputs[0].ScriptWitnessStack,
+		decoded.Inputs[0].ScriptWitnessStack,
+	)
+	require.EqualValues(t, 2, decoded.Inputs[0].ScriptWitnessSigDepth)
+	require.Empty(t, decoded.Inputs[1].ScriptWitnessStack)
+	require.Zero(t, decoded.Inputs[1].ScriptWitnessSigDepth)
+}
+
+// TestEncodeLockTimes tests that the lock time of a packet, the sequence of its
+// inputs and the script version of its outputs survive an encoding round trip.
+func TestEncodeLockTimes(t *testing.T) {
+	t.Parallel()
+
+	pkg := RandPacket(t)
+	pkg.LockTime = 800_000
+	pkg.Inputs[0].Sequence = 144
+	pkg.Inputs[1].Sequence = 144
+	pkg.Outputs[0].ScriptVersion = asset.ScriptV1
+
+	var buf bytes.Buffer
+	require.NoError(t, pkg.Serialize(&buf))
+
+	decoded, err := NewFromRawBytes(&buf, false)
+	require.NoError(t, err)
+
+	require.Equal(t, pkg.LockTime, decoded.LockTime)
+	require.Equal(t, pkg.Inputs[0].Sequence, decoded.Inputs[0].Sequence)
+	require.Equal(t, pkg.Inputs[1].Sequence, decoded.Inputs[1].Sequence)
+	require.Equal(
+		t, pkg.Outputs[0].ScriptVersion,
+		decoded.Outputs[0].ScriptVersion,
+	)
+	require.Equal(
+		t, pkg.Outputs[1].ScriptVersion,
+		decoded.Outputs[1].ScriptVersion,
+	)
+}
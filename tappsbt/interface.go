@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/address"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
@@ -40,6 +41,8 @@ var (
 	PsbtKeyTypeInputTapAnchorTapscriptSibling             = []byte{0x78}
 	PsbtKeyTypeInputTapAsset                              = []byte{0x79}
 	PsbtKeyTypeInputTapAssetProof                         = []byte{0x7a}
+	PsbtKeyTypeInputTapScriptWitnessStack                 = []byte{0x7b}
+	PsbtKeyTypeInputTapScriptWitnessSigDepth              = []byte{0x7c}
 
 	PsbtKeyTypeOutputTapType                               = []byte{0x70}
 	PsbtKeyTypeOutputTapIsInteractive                      = []byte{0x71}
@@ -51,6 +54,7 @@ var (
 	PsbtKeyTypeOutputTapSplitAsset                         = []byte{0x77}
 	PsbtKeyTypeOutputTapAnchorTapscriptSibling             = []byte{0x78}
 	PsbtKeyTypeOutputAssetVersion                          = []byte{0x79}
+	PsbtKeyTypeOutputTapScriptVersion                      = []byte{0x7a}
 )
 
 // The following keys are used as custom fields on the BTC level anchor
@@ -153,6 +157,14 @@ type VPacket struct {
 	// unused but can be used to signal a new version of the virtual PSBT
 	// format in the future.
 	Version uint8
+
+	// LockTime is the absolute lock time of the virtual transaction. It is
+	// committed to in all new output assets and can be enforced in asset
+	// scripts with OP_CHECKLOCKTIMEVERIFY. The value is interpreted the
+	// same way as a Bitcoin transaction's lock time (block height if below
+	// 500 million, UNIX timestamp otherwise). This is encoded as the lock
+	// time of the PSBT's unsigned transaction.
+	LockTime uint32
 }
 
 // SetInputAsset sets the input asset that is being spent.
@@ -309,6 +321,32 @@ type VInput struct {
 	// input struct for the signing to work correctly.
 	asset *asset.Asset
 
+	// Sequence is the relative lock time (BIP-68 encoded sequence) of the
+	// input. It can be enforced in asset scripts with
+	// OP_CHECKSEQUENCEVERIFY. Because an asset only commits to a single
+	// relative lock time, all inputs of a virtual packet must use the same
+	// sequence. This is encoded as the sequence of the corresponding input
+	// of the PSBT's unsigned transaction.
+	Sequence uint32
+
+	// ScriptWitnessStack is an optional list of additional witness stack
+	// elements (for example hash pre-images) that are required to satisfy
+	// the leaf script when spending the input's script key through the
+	// script path. The elements are placed before the leaf script and
+	// control block, with the signature (if the leaf requires one) being
+	// inserted at the position defined by ScriptWitnessSigDepth.
+	ScriptWitnessStack wire.TxWitness
+
+	// ScriptWitnessSigDepth is the number of elements of the
+	// ScriptWitnessStack that are placed above the signature on the witness
+	// stack. The default of zero places the signature on top of the stack,
+	// directly below the leaf script. A leaf that expects its data above
+	// the signature (for example a hash lock followed by OP_CHECKSIG) or
+	// that checks multiple signatures (for example a 2-of-2
+	// OP_CHECKSIGADD leaf where the other party's signature is part of the
+	// stack) can use this to place the signature in the correct slot.
+	ScriptWitnessSigDepth uint32
+
 	// proof is the proof blob that proves the asset being spent was
 	// committed to in the anchor transaction above. This cannot be of type
 	// proof.Proof directly because that would cause a circular dependency.
@@ -326,6 +364,35 @@ func (i *VInput) Proof() []byte {
 	return i.proof
 }
 
+// SetScriptSpendPath instructs the signer to spend the input's script key
+// through the script path by revealing the given tapscript leaf and control
+// block. If signLeaf is true, the leaf is expected to require a Schnorr
+// signature of the script key's raw (internal) key, which will then be created
+// by the signer and placed below the top sigDepth elements of the given extra
+// witness stack. Otherwise, the witness is assembled from the given extra
+// witness stack elements only.
+func (i *VInput) SetScriptSpendPath(leaf txscript.TapLeaf, controlBlock []byte,
+	signLeaf bool, witnessStack wire.TxWitness, sigDepth uint32) {
+
+	i.TaprootLeafScript = []*psbt.TaprootTapLeafScript{{
+		ControlBlock: fn.CopySlice(controlBlock),
+		Script:       fn.CopySlice(leaf.Script),
+		LeafVersion:  leaf.LeafVersion,
+	}}
+	i.ScriptWitnessStack = witnessStack
+	i.ScriptWitnessSigDepth = sigDepth
+
+	leafHash := leaf.TapHash()
+	for idx := range i.TaprootBip32Derivation {
+		derivation := i.TaprootBip32Derivation[idx]
+
+		derivation.LeafHashes = make([][]byte, 0)
+		if signLeaf {
+			derivation.LeafHashes = [][]byte{leafHash[:]}
+		}
+	}
+}
+
 // serializeScriptKey serializes the input asset's script key as the PSBT
 // derivation information on the virtual input.
 func (i *VInput) serializeScriptKey(key asset.ScriptKey, coinType uint32) {
@@ -478,6 +545,12 @@ type VOutput struct {
 	// create.
 	AssetVersion asset.Version
 
+	// ScriptVersion is the script version of the asset that this output
+	// should create. Only assets with script version asset.ScriptV1 can
+	// later be spent with a lock time or relative lock time that is
+	// enforced by asset scripts.
+	ScriptVersion asset.ScriptVersion
+
 	// Type indicates what type of output this is, which has an influence on
 	// whether the asset is set or what witness type is expected to be
 	// generated for the asset.
@@ -535,11 +608,12 @@ type VOutput struct {
 // in for cases in which the asset is not yet set on the output.
 func (o *VOutput) SplitLocator(assetID asset.ID) commitment.SplitLocator {
 	return commitment.SplitLocator{
-		OutputIndex:  o.AnchorOutputIndex,
-		AssetID:      assetID,
-		ScriptKey:    asset.ToSerialized(o.ScriptKey.PubKey),
-		Amount:       o.Amount,
-		AssetVersion: o.AssetVersion,
+		OutputIndex:   o.AnchorOutputIndex,
+		AssetID:       assetID,
+		ScriptKey:     asset.ToSerialized(o.ScriptKey.PubKey),
+		Amount:        o.Amount,
+		AssetVersion:  o.AssetVersion,
+		ScriptVersion: o.ScriptVersion,
 	}
 }
 
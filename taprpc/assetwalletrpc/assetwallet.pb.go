@@ -31,6 +31,9 @@ type FundVirtualPsbtRequest struct {
 	//	*FundVirtualPsbtRequest_Psbt
 	//	*FundVirtualPsbtRequest_Raw
 	Template isFundVirtualPsbtRequest_Template `protobuf_oneof:"template"`
+	// The strategy used to select the asset inputs. If not set, the daemon's
+	// default strategy is used.
+	CoinSelectStrategy taprpc.CoinSelectStrategy `protobuf:"varint,3,opt,name=coin_select_strategy,json=coinSelectStrategy,proto3,enum=taprpc.CoinSelectStrategy" json:"coin_select_strategy,omitempty"`
 }
 
 func (x *FundVirtualPsbtRequest) Reset() {
@@ -86,6 +89,13 @@ func (x *FundVirtualPsbtRequest) GetRaw() *TxTemplate {
 	return nil
 }
 
+func (x *FundVirtualPsbtRequest) GetCoinSelectStrategy() taprpc.CoinSelectStrategy {
+	if x != nil {
+		return x.CoinSelectStrategy
+	}
+	return taprpc.CoinSelectStrategy(0)
+}
+
 type isFundVirtualPsbtRequest_Template interface {
 	isFundVirtualPsbtRequest_Template()
 }
@@ -117,6 +127,10 @@ type FundVirtualPsbtResponse struct {
 	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,json=fundedPsbt,proto3" json:"funded_psbt,omitempty"`
 	// The index of the added change output or -1 if no change was left over.
 	ChangeOutputIndex int32 `protobuf:"varint,2,opt,name=change_output_index,json=changeOutputIndex,proto3" json:"change_output_index,omitempty"`
+	// The not yet signed virtual transactions that re-anchor the passive assets
+	// of the inputs of the funded PSBT. These only need to be signed when
+	// signing externally, in watch-only mode.
+	PassiveAssetPsbts [][]byte `protobuf:"bytes,3,rep,name=passive_asset_psbts,json=passiveAssetPsbts,proto3" json:"passive_asset_psbts,omitempty"`
 }
 
 func (x *FundVirtualPsbtResponse) Reset() {
@@ -165,6 +179,13 @@ func (x *FundVirtualPsbtResponse) GetChangeOutputIndex() int32 {
 	return 0
 }
 
+func (x *FundVirtualPsbtResponse) GetPassiveAssetPsbts() [][]byte {
+	if x != nil {
+		return x.PassiveAssetPsbts
+	}
+	return nil
+}
+
 type TxTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -361,6 +382,9 @@ type SignVirtualPsbtRequest struct {
 	// contain all required inputs, outputs, UTXO data and custom fields required
 	// to identify the signing key.
 	FundedPsbt []byte `protobuf:"bytes,1,opt,name=funded_psbt,json=fundedPsbt,proto3" json:"funded_psbt,omitempty"`
+	// An optional list of instructions for inputs that should be spent through
+	// the script path of their script key instead of the key path.
+	ScriptSpendPaths []*ScriptSpendPath `protobuf:"bytes,2,rep,name=script_spend_paths,json=scriptSpendPaths,proto3" json:"script_spend_paths,omitempty"`
 }
 
 func (x *SignVirtualPsbtRequest) Reset() {
@@ -402,19 +426,27 @@ func (x *SignVirtualPsbtRequest) GetFundedPsbt() []byte {
 	return nil
 }
 
-type SignVirtualPsbtResponse struct {
+func (x *SignVirtualPsbtRequest) GetScriptSpendPaths() []*ScriptSpendPath {
+	if x != nil {
+		return x.ScriptSpendPaths
+	}
+	return nil
+}
+
+type TapLeaf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The signed virtual transaction in PSBT format.
-	SignedPsbt []byte `protobuf:"bytes,1,opt,name=signed_psbt,json=signedPsbt,proto3" json:"signed_psbt,omitempty"`
-	// The indices of signed inputs.
-	SignedInputs []uint32 `protobuf:"varint,2,rep,packed,name=signed_inputs,json=signedInputs,proto3" json:"signed_inputs,omitempty"`
+	// The leaf version of the script. If set to zero, the BIP-0342 base leaf
+	// version (0xc0) is used.
+	LeafVersion uint32 `protobuf:"varint,1,opt,name=leaf_version,json=leafVersion,proto3" json:"leaf_version,omitempty"`
+	// The script of the tapscript leaf.
+	Script []byte `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
 }
 
-func (x *SignVirtualPsbtResponse) Reset() {
-	*x = SignVirtualPsbtResponse{}
+func (x *TapLeaf) Reset() {
+	*x = TapLeaf{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -422,13 +454,13 @@ func (x *SignVirtualPsbtResponse) Reset() {
 	}
 }
 
-func (x *SignVirtualPsbtResponse) String() string {
+func (x *TapLeaf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignVirtualPsbtResponse) ProtoMessage() {}
+func (*TapLeaf) ProtoMessage() {}
 
-func (x *SignVirtualPsbtResponse) ProtoReflect() protoreflect.Message {
+func (x *TapLeaf) ProtoReflect() protoreflect.Message {
 	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -440,37 +472,41 @@ func (x *SignVirtualPsbtResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignVirtualPsbtResponse.ProtoReflect.Descriptor instead.
-func (*SignVirtualPsbtResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TapLeaf.ProtoReflect.Descriptor instead.
+func (*TapLeaf) Descriptor() ([]byte, []int) {
 	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *SignVirtualPsbtResponse) GetSignedPsbt() []byte {
+func (x *TapLeaf) GetLeafVersion() uint32 {
 	if x != nil {
-		return x.SignedPsbt
+		return x.LeafVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *SignVirtualPsbtResponse) GetSignedInputs() []uint32 {
+func (x *TapLeaf) GetScript() []byte {
 	if x != nil {
-		return x.SignedInputs
+		return x.Script
 	}
 	return nil
 }
 
-type AnchorVirtualPsbtsRequest struct {
+type TapLeafSpendInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The list of virtual transactions that should be merged and committed to in
-	// the BTC level anchor transaction.
-	VirtualPsbts [][]byte `protobuf:"bytes,1,rep,name=virtual_psbts,json=virtualPsbts,proto3" json:"virtual_psbts,omitempty"`
+	// The tapscript leaf.
+	Leaf *TapLeaf `protobuf:"bytes,1,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// The hash of the tapscript leaf.
+	LeafHash []byte `protobuf:"bytes,2,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	// The serialized control block that proves the inclusion of the leaf in the
+	// tapscript tree that the script key commits to.
+	ControlBlock []byte `protobuf:"bytes,3,opt,name=control_block,json=controlBlock,proto3" json:"control_block,omitempty"`
 }
 
-func (x *AnchorVirtualPsbtsRequest) Reset() {
-	*x = AnchorVirtualPsbtsRequest{}
+func (x *TapLeafSpendInfo) Reset() {
+	*x = TapLeafSpendInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -478,13 +514,13 @@ func (x *AnchorVirtualPsbtsRequest) Reset() {
 	}
 }
 
-func (x *AnchorVirtualPsbtsRequest) String() string {
+func (x *TapLeafSpendInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnchorVirtualPsbtsRequest) ProtoMessage() {}
+func (*TapLeafSpendInfo) ProtoMessage() {}
 
-func (x *AnchorVirtualPsbtsRequest) ProtoReflect() protoreflect.Message {
+func (x *TapLeafSpendInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -496,28 +532,64 @@ func (x *AnchorVirtualPsbtsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnchorVirtualPsbtsRequest.ProtoReflect.Descriptor instead.
-func (*AnchorVirtualPsbtsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TapLeafSpendInfo.ProtoReflect.Descriptor instead.
+func (*TapLeafSpendInfo) Descriptor() ([]byte, []int) {
 	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *AnchorVirtualPsbtsRequest) GetVirtualPsbts() [][]byte {
+func (x *TapLeafSpendInfo) GetLeaf() *TapLeaf {
 	if x != nil {
-		return x.VirtualPsbts
+		return x.Leaf
 	}
 	return nil
 }
 
-type NextInternalKeyRequest struct {
+func (x *TapLeafSpendInfo) GetLeafHash() []byte {
+	if x != nil {
+		return x.LeafHash
+	}
+	return nil
+}
+
+func (x *TapLeafSpendInfo) GetControlBlock() []byte {
+	if x != nil {
+		return x.ControlBlock
+	}
+	return nil
+}
+
+type ScriptSpendPath struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyFamily uint32 `protobuf:"varint,1,opt,name=key_family,json=keyFamily,proto3" json:"key_family,omitempty"`
-}
-
-func (x *NextInternalKeyRequest) Reset() {
-	*x = NextInternalKeyRequest{}
+	// The index of the virtual input that should be spent.
+	InputIndex uint32 `protobuf:"varint,1,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+	// The tapscript leaf that should be revealed to spend the input.
+	Leaf *TapLeaf `protobuf:"bytes,2,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// The serialized control block that proves the inclusion of the leaf in the
+	// tapscript tree that the input's script key commits to.
+	ControlBlock []byte `protobuf:"bytes,3,opt,name=control_block,json=controlBlock,proto3" json:"control_block,omitempty"`
+	// If true, the leaf requires a signature of the script key's internal key,
+	// which is then created by the wallet. Otherwise, the witness is assembled
+	// from the additional witness stack elements only.
+	SignLeaf bool `protobuf:"varint,4,opt,name=sign_leaf,json=signLeaf,proto3" json:"sign_leaf,omitempty"`
+	// Additional witness stack elements (for example hash pre-images or
+	// signatures of other parties) that are required to satisfy the leaf script.
+	// These are placed before the leaf script and control block, with the
+	// wallet's signature (if sign_leaf is true) inserted at the position defined
+	// by sig_depth.
+	WitnessStack [][]byte `protobuf:"bytes,5,rep,name=witness_stack,json=witnessStack,proto3" json:"witness_stack,omitempty"`
+	// The number of witness_stack elements that are placed above the wallet's
+	// signature. The default of zero places the signature on top of the stack,
+	// directly below the leaf script. For example, a leaf of the form
+	// "OP_SHA256 <hash> OP_EQUALVERIFY <key> OP_CHECKSIG" requires the pre-image
+	// to be above the signature, which is achieved with a sig_depth of 1.
+	SigDepth uint32 `protobuf:"varint,6,opt,name=sig_depth,json=sigDepth,proto3" json:"sig_depth,omitempty"`
+}
+
+func (x *ScriptSpendPath) Reset() {
+	*x = ScriptSpendPath{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -525,13 +597,13 @@ func (x *NextInternalKeyRequest) Reset() {
 	}
 }
 
-func (x *NextInternalKeyRequest) String() string {
+func (x *ScriptSpendPath) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextInternalKeyRequest) ProtoMessage() {}
+func (*ScriptSpendPath) ProtoMessage() {}
 
-func (x *NextInternalKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *ScriptSpendPath) ProtoReflect() protoreflect.Message {
 	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,28 +615,66 @@ func (x *NextInternalKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextInternalKeyRequest.ProtoReflect.Descriptor instead.
-func (*NextInternalKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScriptSpendPath.ProtoReflect.Descriptor instead.
+func (*ScriptSpendPath) Descriptor() ([]byte, []int) {
 	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *NextInternalKeyRequest) GetKeyFamily() uint32 {
+func (x *ScriptSpendPath) GetInputIndex() uint32 {
 	if x != nil {
-		return x.KeyFamily
+		return x.InputIndex
 	}
 	return 0
 }
 
-type NextInternalKeyResponse struct {
+func (x *ScriptSpendPath) GetLeaf() *TapLeaf {
+	if x != nil {
+		return x.Leaf
+	}
+	return nil
+}
+
+func (x *ScriptSpendPath) GetControlBlock() []byte {
+	if x != nil {
+		return x.ControlBlock
+	}
+	return nil
+}
+
+func (x *ScriptSpendPath) GetSignLeaf() bool {
+	if x != nil {
+		return x.SignLeaf
+	}
+	return false
+}
+
+func (x *ScriptSpendPath) GetWitnessStack() [][]byte {
+	if x != nil {
+		return x.WitnessStack
+	}
+	return nil
+}
+
+func (x *ScriptSpendPath) GetSigDepth() uint32 {
+	if x != nil {
+		return x.SigDepth
+	}
+	return 0
+}
+
+type SignVirtualPsbtResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InternalKey *taprpc.KeyDescriptor `protobuf:"bytes,1,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// The signed virtual transaction in PSBT format.
+	SignedPsbt []byte `protobuf:"bytes,1,opt,name=signed_psbt,json=signedPsbt,proto3" json:"signed_psbt,omitempty"`
+	// The indices of signed inputs.
+	SignedInputs []uint32 `protobuf:"varint,2,rep,packed,name=signed_inputs,json=signedInputs,proto3" json:"signed_inputs,omitempty"`
 }
 
-func (x *NextInternalKeyResponse) Reset() {
-	*x = NextInternalKeyResponse{}
+func (x *SignVirtualPsbtResponse) Reset() {
+	*x = SignVirtualPsbtResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -572,13 +682,13 @@ func (x *NextInternalKeyResponse) Reset() {
 	}
 }
 
-func (x *NextInternalKeyResponse) String() string {
+func (x *SignVirtualPsbtResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextInternalKeyResponse) ProtoMessage() {}
+func (*SignVirtualPsbtResponse) ProtoMessage() {}
 
-func (x *NextInternalKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *SignVirtualPsbtResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -590,28 +700,37 @@ func (x *NextInternalKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextInternalKeyResponse.ProtoReflect.Descriptor instead.
-func (*NextInternalKeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignVirtualPsbtResponse.ProtoReflect.Descriptor instead.
+func (*SignVirtualPsbtResponse) Descriptor() ([]byte, []int) {
 	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *NextInternalKeyResponse) GetInternalKey() *taprpc.KeyDescriptor {
+func (x *SignVirtualPsbtResponse) GetSignedPsbt() []byte {
 	if x != nil {
-		return x.InternalKey
+		return x.SignedPsbt
 	}
 	return nil
 }
 
-type NextScriptKeyRequest struct {
+func (x *SignVirtualPsbtResponse) GetSignedInputs() []uint32 {
+	if x != nil {
+		return x.SignedInputs
+	}
+	return nil
+}
+
+type AnchorVirtualPsbtsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KeyFamily uint32 `protobuf:"varint,1,opt,name=key_family,json=keyFamily,proto3" json:"key_family,omitempty"`
+	// The list of virtual transactions that should be merged and committed to in
+	// the BTC level anchor transaction.
+	VirtualPsbts [][]byte `protobuf:"bytes,1,rep,name=virtual_psbts,json=virtualPsbts,proto3" json:"virtual_psbts,omitempty"`
 }
 
-func (x *NextScriptKeyRequest) Reset() {
-	*x = NextScriptKeyRequest{}
+func (x *AnchorVirtualPsbtsRequest) Reset() {
+	*x = AnchorVirtualPsbtsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -619,13 +738,13 @@ func (x *NextScriptKeyRequest) Reset() {
 	}
 }
 
-func (x *NextScriptKeyRequest) String() string {
+func (x *AnchorVirtualPsbtsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextScriptKeyRequest) ProtoMessage() {}
+func (*AnchorVirtualPsbtsRequest) ProtoMessage() {}
 
-func (x *NextScriptKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *AnchorVirtualPsbtsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -637,28 +756,36 @@ func (x *NextScriptKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextScriptKeyRequest.ProtoReflect.Descriptor instead.
-func (*NextScriptKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AnchorVirtualPsbtsRequest.ProtoReflect.Descriptor instead.
+func (*AnchorVirtualPsbtsRequest) Descriptor() ([]byte, []int) {
 	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *NextScriptKeyRequest) GetKeyFamily() uint32 {
+func (x *AnchorVirtualPsbtsRequest) GetVirtualPsbts() [][]byte {
 	if x != nil {
-		return x.KeyFamily
+		return x.VirtualPsbts
 	}
-	return 0
+	return nil
 }
 
-type NextScriptKeyResponse struct {
+type CommitVirtualPsbtsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ScriptKey *taprpc.ScriptKey `protobuf:"bytes,1,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The list of signed virtual transactions that should be committed to in the
+	// BTC level anchor transaction.
+	VirtualPsbts [][]byte `protobuf:"bytes,1,rep,name=virtual_psbts,json=virtualPsbts,proto3" json:"virtual_psbts,omitempty"`
+	// The list of signed virtual transactions that re-anchor the passive assets
+	// of the inputs of the virtual transactions.
+	PassiveAssetPsbts [][]byte `protobuf:"bytes,2,rep,name=passive_asset_psbts,json=passiveAssetPsbts,proto3" json:"passive_asset_psbts,omitempty"`
+	// The optional fee rate to use for the anchor transaction, in sat/kw. If not
+	// set, the fee rate is estimated.
+	FeeRate uint32 `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
 }
 
-func (x *NextScriptKeyResponse) Reset() {
-	*x = NextScriptKeyResponse{}
+func (x *CommitVirtualPsbtsRequest) Reset() {
+	*x = CommitVirtualPsbtsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -666,13 +793,13 @@ func (x *NextScriptKeyResponse) Reset() {
 	}
 }
 
-func (x *NextScriptKeyResponse) String() string {
+func (x *CommitVirtualPsbtsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextScriptKeyResponse) ProtoMessage() {}
+func (*CommitVirtualPsbtsRequest) ProtoMessage() {}
 
-func (x *NextScriptKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *CommitVirtualPsbtsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -684,29 +811,46 @@ func (x *NextScriptKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextScriptKeyResponse.ProtoReflect.Descriptor instead.
-func (*NextScriptKeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitVirtualPsbtsRequest.ProtoReflect.Descriptor instead.
+func (*CommitVirtualPsbtsRequest) Descriptor() ([]byte, []int) {
 	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *NextScriptKeyResponse) GetScriptKey() *taprpc.ScriptKey {
+func (x *CommitVirtualPsbtsRequest) GetVirtualPsbts() [][]byte {
 	if x != nil {
-		return x.ScriptKey
+		return x.VirtualPsbts
 	}
 	return nil
 }
 
-type ProveAssetOwnershipRequest struct {
+func (x *CommitVirtualPsbtsRequest) GetPassiveAssetPsbts() [][]byte {
+	if x != nil {
+		return x.PassiveAssetPsbts
+	}
+	return nil
+}
+
+func (x *CommitVirtualPsbtsRequest) GetFeeRate() uint32 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+type CommitVirtualPsbtsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetId   []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The funded but not yet signed anchor transaction, in PSBT format.
+	AnchorPsbt []byte `protobuf:"bytes,1,opt,name=anchor_psbt,json=anchorPsbt,proto3" json:"anchor_psbt,omitempty"`
+	// The index of the change output of the anchor transaction or -1 if no change
+	// was left over.
+	ChangeOutputIndex int32 `protobuf:"varint,2,opt,name=change_output_index,json=changeOutputIndex,proto3" json:"change_output_index,omitempty"`
 }
 
-func (x *ProveAssetOwnershipRequest) Reset() {
-	*x = ProveAssetOwnershipRequest{}
+func (x *CommitVirtualPsbtsResponse) Reset() {
+	*x = CommitVirtualPsbtsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -714,13 +858,13 @@ func (x *ProveAssetOwnershipRequest) Reset() {
 	}
 }
 
-func (x *ProveAssetOwnershipRequest) String() string {
+func (x *CommitVirtualPsbtsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProveAssetOwnershipRequest) ProtoMessage() {}
+func (*CommitVirtualPsbtsResponse) ProtoMessage() {}
 
-func (x *ProveAssetOwnershipRequest) ProtoReflect() protoreflect.Message {
+func (x *CommitVirtualPsbtsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -732,35 +876,44 @@ func (x *ProveAssetOwnershipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProveAssetOwnershipRequest.ProtoReflect.Descriptor instead.
-func (*ProveAssetOwnershipRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommitVirtualPsbtsResponse.ProtoReflect.Descriptor instead.
+func (*CommitVirtualPsbtsResponse) Descriptor() ([]byte, []int) {
 	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ProveAssetOwnershipRequest) GetAssetId() []byte {
+func (x *CommitVirtualPsbtsResponse) GetAnchorPsbt() []byte {
 	if x != nil {
-		return x.AssetId
+		return x.AnchorPsbt
 	}
 	return nil
 }
 
-func (x *ProveAssetOwnershipRequest) GetScriptKey() []byte {
+func (x *CommitVirtualPsbtsResponse) GetChangeOutputIndex() int32 {
 	if x != nil {
-		return x.ScriptKey
+		return x.ChangeOutputIndex
 	}
-	return nil
+	return 0
 }
 
-type ProveAssetOwnershipResponse struct {
+type PublishAndLogTransferRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProofWithWitness []byte `protobuf:"bytes,1,opt,name=proof_with_witness,json=proofWithWitness,proto3" json:"proof_with_witness,omitempty"`
+	// The fully signed anchor transaction, in PSBT format.
+	AnchorPsbt []byte `protobuf:"bytes,1,opt,name=anchor_psbt,json=anchorPsbt,proto3" json:"anchor_psbt,omitempty"`
+	// The list of signed virtual transactions the anchor transaction commits to.
+	VirtualPsbts [][]byte `protobuf:"bytes,2,rep,name=virtual_psbts,json=virtualPsbts,proto3" json:"virtual_psbts,omitempty"`
+	// The list of signed virtual transactions that re-anchor the passive assets
+	// of the inputs of the virtual transactions.
+	PassiveAssetPsbts [][]byte `protobuf:"bytes,3,rep,name=passive_asset_psbts,json=passiveAssetPsbts,proto3" json:"passive_asset_psbts,omitempty"`
+	// The index of the change output of the anchor transaction, as returned by
+	// CommitVirtualPsbts, or -1 if no change was left over.
+	ChangeOutputIndex int32 `protobuf:"varint,4,opt,name=change_output_index,json=changeOutputIndex,proto3" json:"change_output_index,omitempty"`
 }
 
-func (x *ProveAssetOwnershipResponse) Reset() {
-	*x = ProveAssetOwnershipResponse{}
+func (x *PublishAndLogTransferRequest) Reset() {
+	*x = PublishAndLogTransferRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -768,13 +921,13 @@ func (x *ProveAssetOwnershipResponse) Reset() {
 	}
 }
 
-func (x *ProveAssetOwnershipResponse) String() string {
+func (x *PublishAndLogTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProveAssetOwnershipResponse) ProtoMessage() {}
+func (*PublishAndLogTransferRequest) ProtoMessage() {}
 
-func (x *ProveAssetOwnershipResponse) ProtoReflect() protoreflect.Message {
+func (x *PublishAndLogTransferRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -786,28 +939,49 @@ func (x *ProveAssetOwnershipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProveAssetOwnershipResponse.ProtoReflect.Descriptor instead.
-func (*ProveAssetOwnershipResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishAndLogTransferRequest.ProtoReflect.Descriptor instead.
+func (*PublishAndLogTransferRequest) Descriptor() ([]byte, []int) {
 	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ProveAssetOwnershipResponse) GetProofWithWitness() []byte {
+func (x *PublishAndLogTransferRequest) GetAnchorPsbt() []byte {
 	if x != nil {
-		return x.ProofWithWitness
+		return x.AnchorPsbt
 	}
 	return nil
 }
 
-type VerifyAssetOwnershipRequest struct {
+func (x *PublishAndLogTransferRequest) GetVirtualPsbts() [][]byte {
+	if x != nil {
+		return x.VirtualPsbts
+	}
+	return nil
+}
+
+func (x *PublishAndLogTransferRequest) GetPassiveAssetPsbts() [][]byte {
+	if x != nil {
+		return x.PassiveAssetPsbts
+	}
+	return nil
+}
+
+func (x *PublishAndLogTransferRequest) GetChangeOutputIndex() int32 {
+	if x != nil {
+		return x.ChangeOutputIndex
+	}
+	return 0
+}
+
+type NextInternalKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProofWithWitness []byte `protobuf:"bytes,1,opt,name=proof_with_witness,json=proofWithWitness,proto3" json:"proof_with_witness,omitempty"`
+	KeyFamily uint32 `protobuf:"varint,1,opt,name=key_family,json=keyFamily,proto3" json:"key_family,omitempty"`
 }
 
-func (x *VerifyAssetOwnershipRequest) Reset() {
-	*x = VerifyAssetOwnershipRequest{}
+func (x *NextInternalKeyRequest) Reset() {
+	*x = NextInternalKeyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -815,13 +989,13 @@ func (x *VerifyAssetOwnershipRequest) Reset() {
 	}
 }
 
-func (x *VerifyAssetOwnershipRequest) String() string {
+func (x *NextInternalKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyAssetOwnershipRequest) ProtoMessage() {}
+func (*NextInternalKeyRequest) ProtoMessage() {}
 
-func (x *VerifyAssetOwnershipRequest) ProtoReflect() protoreflect.Message {
+func (x *NextInternalKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -833,28 +1007,28 @@ func (x *VerifyAssetOwnershipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyAssetOwnershipRequest.ProtoReflect.Descriptor instead.
-func (*VerifyAssetOwnershipRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NextInternalKeyRequest.ProtoReflect.Descriptor instead.
+func (*NextInternalKeyRequest) Descriptor() ([]byte, []int) {
 	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *VerifyAssetOwnershipRequest) GetProofWithWitness() []byte {
+func (x *NextInternalKeyRequest) GetKeyFamily() uint32 {
 	if x != nil {
-		return x.ProofWithWitness
+		return x.KeyFamily
 	}
-	return nil
+	return 0
 }
 
-type VerifyAssetOwnershipResponse struct {
+type NextInternalKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ValidProof bool `protobuf:"varint,1,opt,name=valid_proof,json=validProof,proto3" json:"valid_proof,omitempty"`
+	InternalKey *taprpc.KeyDescriptor `protobuf:"bytes,1,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
 }
 
-func (x *VerifyAssetOwnershipResponse) Reset() {
-	*x = VerifyAssetOwnershipResponse{}
+func (x *NextInternalKeyResponse) Reset() {
+	*x = NextInternalKeyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -862,14 +1036,1259 @@ func (x *VerifyAssetOwnershipResponse) Reset() {
 	}
 }
 
-func (x *VerifyAssetOwnershipResponse) String() string {
+func (x *NextInternalKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyAssetOwnershipResponse) ProtoMessage() {}
+func (*NextInternalKeyResponse) ProtoMessage() {}
+
+func (x *NextInternalKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextInternalKeyResponse.ProtoReflect.Descriptor instead.
+func (*NextInternalKeyResponse) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NextInternalKeyResponse) GetInternalKey() *taprpc.KeyDescriptor {
+	if x != nil {
+		return x.InternalKey
+	}
+	return nil
+}
+
+type NextScriptKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyFamily uint32 `protobuf:"varint,1,opt,name=key_family,json=keyFamily,proto3" json:"key_family,omitempty"`
+	// An optional list of tapscript leaves the script key should commit to. If
+	// set, the script key is tweaked with the root of the tapscript tree
+	// assembled from the leaves and can then also be spent through the script
+	// path. Otherwise, a BIP-0086 script key is derived.
+	TapscriptLeaves []*TapLeaf `protobuf:"bytes,2,rep,name=tapscript_leaves,json=tapscriptLeaves,proto3" json:"tapscript_leaves,omitempty"`
+}
+
+func (x *NextScriptKeyRequest) Reset() {
+	*x = NextScriptKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextScriptKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextScriptKeyRequest) ProtoMessage() {}
+
+func (x *NextScriptKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextScriptKeyRequest.ProtoReflect.Descriptor instead.
+func (*NextScriptKeyRequest) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *NextScriptKeyRequest) GetKeyFamily() uint32 {
+	if x != nil {
+		return x.KeyFamily
+	}
+	return 0
+}
+
+func (x *NextScriptKeyRequest) GetTapscriptLeaves() []*TapLeaf {
+	if x != nil {
+		return x.TapscriptLeaves
+	}
+	return nil
+}
+
+type NextScriptKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScriptKey *taprpc.ScriptKey `protobuf:"bytes,1,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The spend information for each of the tapscript leaves in the same order
+	// as they were specified in the request.
+	LeafSpendInfo []*TapLeafSpendInfo `protobuf:"bytes,2,rep,name=leaf_spend_info,json=leafSpendInfo,proto3" json:"leaf_spend_info,omitempty"`
+}
+
+func (x *NextScriptKeyResponse) Reset() {
+	*x = NextScriptKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NextScriptKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextScriptKeyResponse) ProtoMessage() {}
+
+func (x *NextScriptKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextScriptKeyResponse.ProtoReflect.Descriptor instead.
+func (*NextScriptKeyResponse) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *NextScriptKeyResponse) GetScriptKey() *taprpc.ScriptKey {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *NextScriptKeyResponse) GetLeafSpendInfo() []*TapLeafSpendInfo {
+	if x != nil {
+		return x.LeafSpendInfo
+	}
+	return nil
+}
+
+type CreateMuSig2SessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The key locator of the local key that is part of the aggregate key.
+	LocalKeyLoc *taprpc.KeyLocator `protobuf:"bytes,1,opt,name=local_key_loc,json=localKeyLoc,proto3" json:"local_key_loc,omitempty"`
+	// The 33-byte compressed public keys of all signers, including the local
+	// key. The order of the keys doesn't matter, as they are sorted before being
+	// aggregated.
+	SignerPubKeys [][]byte `protobuf:"bytes,2,rep,name=signer_pub_keys,json=signerPubKeys,proto3" json:"signer_pub_keys,omitempty"`
+	// The optional 32-byte tapscript root the aggregate script key should commit
+	// to. If empty, a BIP-0086 tweak is applied to the aggregate key.
+	TapscriptRoot []byte `protobuf:"bytes,3,opt,name=tapscript_root,json=tapscriptRoot,proto3" json:"tapscript_root,omitempty"`
+	// The optional 66-byte public nonces of the other signers, if already known.
+	OtherSignerPublicNonces [][]byte `protobuf:"bytes,4,rep,name=other_signer_public_nonces,json=otherSignerPublicNonces,proto3" json:"other_signer_public_nonces,omitempty"`
+}
+
+func (x *CreateMuSig2SessionRequest) Reset() {
+	*x = CreateMuSig2SessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMuSig2SessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMuSig2SessionRequest) ProtoMessage() {}
+
+func (x *CreateMuSig2SessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMuSig2SessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateMuSig2SessionRequest) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateMuSig2SessionRequest) GetLocalKeyLoc() *taprpc.KeyLocator {
+	if x != nil {
+		return x.LocalKeyLoc
+	}
+	return nil
+}
+
+func (x *CreateMuSig2SessionRequest) GetSignerPubKeys() [][]byte {
+	if x != nil {
+		return x.SignerPubKeys
+	}
+	return nil
+}
+
+func (x *CreateMuSig2SessionRequest) GetTapscriptRoot() []byte {
+	if x != nil {
+		return x.TapscriptRoot
+	}
+	return nil
+}
+
+func (x *CreateMuSig2SessionRequest) GetOtherSignerPublicNonces() [][]byte {
+	if x != nil {
+		return x.OtherSignerPublicNonces
+	}
+	return nil
+}
+
+type CreateMuSig2SessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique ID of the session.
+	SessionId []byte `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The 66-byte local public nonce that must be shared with all signers.
+	LocalPublicNonce []byte `protobuf:"bytes,2,opt,name=local_public_nonce,json=localPublicNonce,proto3" json:"local_public_nonce,omitempty"`
+	// The aggregate script key of all signers. The internal key of the script
+	// key is the untweaked MuSig2 combined key.
+	ScriptKey *taprpc.ScriptKey `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// Whether the public nonces of all signers are known.
+	HaveAllNonces bool `protobuf:"varint,4,opt,name=have_all_nonces,json=haveAllNonces,proto3" json:"have_all_nonces,omitempty"`
+}
+
+func (x *CreateMuSig2SessionResponse) Reset() {
+	*x = CreateMuSig2SessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateMuSig2SessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMuSig2SessionResponse) ProtoMessage() {}
+
+func (x *CreateMuSig2SessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMuSig2SessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateMuSig2SessionResponse) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateMuSig2SessionResponse) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *CreateMuSig2SessionResponse) GetLocalPublicNonce() []byte {
+	if x != nil {
+		return x.LocalPublicNonce
+	}
+	return nil
+}
+
+func (x *CreateMuSig2SessionResponse) GetScriptKey() *taprpc.ScriptKey {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+func (x *CreateMuSig2SessionResponse) GetHaveAllNonces() bool {
+	if x != nil {
+		return x.HaveAllNonces
+	}
+	return false
+}
+
+type RegisterMuSig2NoncesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique ID of the session.
+	SessionId []byte `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The 66-byte public nonces of other signers.
+	OtherSignerPublicNonces [][]byte `protobuf:"bytes,2,rep,name=other_signer_public_nonces,json=otherSignerPublicNonces,proto3" json:"other_signer_public_nonces,omitempty"`
+}
+
+func (x *RegisterMuSig2NoncesRequest) Reset() {
+	*x = RegisterMuSig2NoncesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterMuSig2NoncesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterMuSig2NoncesRequest) ProtoMessage() {}
+
+func (x *RegisterMuSig2NoncesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterMuSig2NoncesRequest.ProtoReflect.Descriptor instead.
+func (*RegisterMuSig2NoncesRequest) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RegisterMuSig2NoncesRequest) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *RegisterMuSig2NoncesRequest) GetOtherSignerPublicNonces() [][]byte {
+	if x != nil {
+		return x.OtherSignerPublicNonces
+	}
+	return nil
+}
+
+type RegisterMuSig2NoncesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the public nonces of all signers are known.
+	HaveAllNonces bool `protobuf:"varint,1,opt,name=have_all_nonces,json=haveAllNonces,proto3" json:"have_all_nonces,omitempty"`
+}
+
+func (x *RegisterMuSig2NoncesResponse) Reset() {
+	*x = RegisterMuSig2NoncesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterMuSig2NoncesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterMuSig2NoncesResponse) ProtoMessage() {}
+
+func (x *RegisterMuSig2NoncesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterMuSig2NoncesResponse.ProtoReflect.Descriptor instead.
+func (*RegisterMuSig2NoncesResponse) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RegisterMuSig2NoncesResponse) GetHaveAllNonces() bool {
+	if x != nil {
+		return x.HaveAllNonces
+	}
+	return false
+}
+
+type SignMuSig2VirtualPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique ID of the session.
+	SessionId []byte `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The funded PSBT of the virtual transaction that contains the input that
+	// should be signed.
+	FundedPsbt []byte `protobuf:"bytes,2,opt,name=funded_psbt,json=fundedPsbt,proto3" json:"funded_psbt,omitempty"`
+	// The index of the virtual input that should be signed.
+	InputIndex uint32 `protobuf:"varint,3,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+	// If true, the session is removed after creating the partial signature. This
+	// should be set by all signers that don't combine the final signature.
+	Cleanup bool `protobuf:"varint,4,opt,name=cleanup,proto3" json:"cleanup,omitempty"`
+}
+
+func (x *SignMuSig2VirtualPsbtRequest) Reset() {
+	*x = SignMuSig2VirtualPsbtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignMuSig2VirtualPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignMuSig2VirtualPsbtRequest) ProtoMessage() {}
+
+func (x *SignMuSig2VirtualPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignMuSig2VirtualPsbtRequest.ProtoReflect.Descriptor instead.
+func (*SignMuSig2VirtualPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SignMuSig2VirtualPsbtRequest) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *SignMuSig2VirtualPsbtRequest) GetFundedPsbt() []byte {
+	if x != nil {
+		return x.FundedPsbt
+	}
+	return nil
+}
+
+func (x *SignMuSig2VirtualPsbtRequest) GetInputIndex() uint32 {
+	if x != nil {
+		return x.InputIndex
+	}
+	return 0
+}
+
+func (x *SignMuSig2VirtualPsbtRequest) GetCleanup() bool {
+	if x != nil {
+		return x.Cleanup
+	}
+	return false
+}
+
+type SignMuSig2VirtualPsbtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The local partial MuSig2 signature for the input.
+	PartialSignature []byte `protobuf:"bytes,1,opt,name=partial_signature,json=partialSignature,proto3" json:"partial_signature,omitempty"`
+}
+
+func (x *SignMuSig2VirtualPsbtResponse) Reset() {
+	*x = SignMuSig2VirtualPsbtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignMuSig2VirtualPsbtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignMuSig2VirtualPsbtResponse) ProtoMessage() {}
+
+func (x *SignMuSig2VirtualPsbtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignMuSig2VirtualPsbtResponse.ProtoReflect.Descriptor instead.
+func (*SignMuSig2VirtualPsbtResponse) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SignMuSig2VirtualPsbtResponse) GetPartialSignature() []byte {
+	if x != nil {
+		return x.PartialSignature
+	}
+	return nil
+}
+
+type CombineMuSig2VirtualPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique ID of the session.
+	SessionId []byte `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The funded PSBT of the virtual transaction that contains the input that
+	// should be signed. This must be the same virtual transaction the partial
+	// signatures were created for.
+	FundedPsbt []byte `protobuf:"bytes,2,opt,name=funded_psbt,json=fundedPsbt,proto3" json:"funded_psbt,omitempty"`
+	// The index of the virtual input that should be signed.
+	InputIndex uint32 `protobuf:"varint,3,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+	// The partial MuSig2 signatures of all other signers.
+	OtherPartialSignatures [][]byte `protobuf:"bytes,4,rep,name=other_partial_signatures,json=otherPartialSignatures,proto3" json:"other_partial_signatures,omitempty"`
+}
+
+func (x *CombineMuSig2VirtualPsbtRequest) Reset() {
+	*x = CombineMuSig2VirtualPsbtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CombineMuSig2VirtualPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CombineMuSig2VirtualPsbtRequest) ProtoMessage() {}
+
+func (x *CombineMuSig2VirtualPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CombineMuSig2VirtualPsbtRequest.ProtoReflect.Descriptor instead.
+func (*CombineMuSig2VirtualPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CombineMuSig2VirtualPsbtRequest) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *CombineMuSig2VirtualPsbtRequest) GetFundedPsbt() []byte {
+	if x != nil {
+		return x.FundedPsbt
+	}
+	return nil
+}
+
+func (x *CombineMuSig2VirtualPsbtRequest) GetInputIndex() uint32 {
+	if x != nil {
+		return x.InputIndex
+	}
+	return 0
+}
+
+func (x *CombineMuSig2VirtualPsbtRequest) GetOtherPartialSignatures() [][]byte {
+	if x != nil {
+		return x.OtherPartialSignatures
+	}
+	return nil
+}
+
+type SwapBtcOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The on-chain address the output pays to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The amount in satoshis the output pays.
+	AmountSat uint64 `protobuf:"varint,2,opt,name=amount_sat,json=amountSat,proto3" json:"amount_sat,omitempty"`
+	// The 32-byte x-only BIP-0086 internal key of the output. This is required
+	// for P2TR addresses, so the asset proofs can show that the output doesn't
+	// commit to any assets.
+	InternalKey []byte `protobuf:"bytes,3,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+}
+
+func (x *SwapBtcOutput) Reset() {
+	*x = SwapBtcOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapBtcOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapBtcOutput) ProtoMessage() {}
+
+func (x *SwapBtcOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapBtcOutput.ProtoReflect.Descriptor instead.
+func (*SwapBtcOutput) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SwapBtcOutput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SwapBtcOutput) GetAmountSat() uint64 {
+	if x != nil {
+		return x.AmountSat
+	}
+	return 0
+}
+
+func (x *SwapBtcOutput) GetInternalKey() []byte {
+	if x != nil {
+		return x.InternalKey
+	}
+	return nil
+}
+
+type SwapTerms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The signed virtual transaction of the local party. This can be empty if
+	// the local party only pays BTC in the swap.
+	LocalVirtualPsbt []byte `protobuf:"bytes,1,opt,name=local_virtual_psbt,json=localVirtualPsbt,proto3" json:"local_virtual_psbt,omitempty"`
+	// The signed virtual transactions of the counterparty.
+	RemoteVirtualPsbts [][]byte `protobuf:"bytes,2,rep,name=remote_virtual_psbts,json=remoteVirtualPsbts,proto3" json:"remote_virtual_psbts,omitempty"`
+	// The full proof files of all the inputs of the counterparty's virtual
+	// transactions, in the order of the virtual transactions and their inputs.
+	// These are used to verify the provenance of the assets received.
+	RemoteInputProofs [][]byte `protobuf:"bytes,3,rep,name=remote_input_proofs,json=remoteInputProofs,proto3" json:"remote_input_proofs,omitempty"`
+	// The Taproot Asset addresses the local party gave to the counterparty.
+	// Each address must be paid by one of the counterparty's virtual
+	// transactions.
+	ExpectedAddrs []string `protobuf:"bytes,4,rep,name=expected_addrs,json=expectedAddrs,proto3" json:"expected_addrs,omitempty"`
+	// The plain BTC outputs the local party expects the anchor transaction to
+	// pay.
+	ExpectedBtcOutputs []*SwapBtcOutput `protobuf:"bytes,5,rep,name=expected_btc_outputs,json=expectedBtcOutputs,proto3" json:"expected_btc_outputs,omitempty"`
+	// The output the BTC of the anchor inputs of the local virtual transaction
+	// is returned to. This must be an output controlled by the local party and
+	// is required when signing the anchor transaction created by the
+	// counterparty. The amount is ignored, the output must pay at least the
+	// value of the local anchor inputs.
+	ChangeOutput *SwapBtcOutput `protobuf:"bytes,6,opt,name=change_output,json=changeOutput,proto3" json:"change_output,omitempty"`
+	// The output the BTC of the anchor inputs of the counterparty's virtual
+	// transactions is returned to, as given by the counterparty. This is
+	// required when creating the anchor transaction if the counterparty gives
+	// any assets. The amount is ignored, the output pays the value of the
+	// counterparty's anchor inputs.
+	RemoteChangeOutput *SwapBtcOutput `protobuf:"bytes,7,opt,name=remote_change_output,json=remoteChangeOutput,proto3" json:"remote_change_output,omitempty"`
+}
+
+func (x *SwapTerms) Reset() {
+	*x = SwapTerms{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapTerms) ProtoMessage() {}
+
+func (x *SwapTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapTerms.ProtoReflect.Descriptor instead.
+func (*SwapTerms) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SwapTerms) GetLocalVirtualPsbt() []byte {
+	if x != nil {
+		return x.LocalVirtualPsbt
+	}
+	return nil
+}
+
+func (x *SwapTerms) GetRemoteVirtualPsbts() [][]byte {
+	if x != nil {
+		return x.RemoteVirtualPsbts
+	}
+	return nil
+}
+
+func (x *SwapTerms) GetRemoteInputProofs() [][]byte {
+	if x != nil {
+		return x.RemoteInputProofs
+	}
+	return nil
+}
+
+func (x *SwapTerms) GetExpectedAddrs() []string {
+	if x != nil {
+		return x.ExpectedAddrs
+	}
+	return nil
+}
+
+func (x *SwapTerms) GetExpectedBtcOutputs() []*SwapBtcOutput {
+	if x != nil {
+		return x.ExpectedBtcOutputs
+	}
+	return nil
+}
+
+func (x *SwapTerms) GetChangeOutput() *SwapBtcOutput {
+	if x != nil {
+		return x.ChangeOutput
+	}
+	return nil
+}
+
+func (x *SwapTerms) GetRemoteChangeOutput() *SwapBtcOutput {
+	if x != nil {
+		return x.RemoteChangeOutput
+	}
+	return nil
+}
+
+type CreateSwapPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The terms of the swap from the point of view of the local party.
+	Terms *SwapTerms `protobuf:"bytes,1,opt,name=terms,proto3" json:"terms,omitempty"`
+	// The plain BTC outputs the local party pays to the counterparty, funded by
+	// the local wallet.
+	BtcOutputs []*SwapBtcOutput `protobuf:"bytes,2,rep,name=btc_outputs,json=btcOutputs,proto3" json:"btc_outputs,omitempty"`
+	// The optional fee rate to use for the anchor transaction, in sat/kw. If not
+	// set, the fee rate is estimated.
+	FeeRate uint32 `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+}
+
+func (x *CreateSwapPsbtRequest) Reset() {
+	*x = CreateSwapPsbtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSwapPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSwapPsbtRequest) ProtoMessage() {}
+
+func (x *CreateSwapPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSwapPsbtRequest.ProtoReflect.Descriptor instead.
+func (*CreateSwapPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateSwapPsbtRequest) GetTerms() *SwapTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *CreateSwapPsbtRequest) GetBtcOutputs() []*SwapBtcOutput {
+	if x != nil {
+		return x.BtcOutputs
+	}
+	return nil
+}
+
+func (x *CreateSwapPsbtRequest) GetFeeRate() uint32 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+type SignSwapPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The terms of the swap from the point of view of the local party.
+	Terms *SwapTerms `protobuf:"bytes,1,opt,name=terms,proto3" json:"terms,omitempty"`
+	// The anchor transaction of the swap as created by the counterparty.
+	AnchorPsbt []byte `protobuf:"bytes,2,opt,name=anchor_psbt,json=anchorPsbt,proto3" json:"anchor_psbt,omitempty"`
+}
+
+func (x *SignSwapPsbtRequest) Reset() {
+	*x = SignSwapPsbtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignSwapPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignSwapPsbtRequest) ProtoMessage() {}
+
+func (x *SignSwapPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignSwapPsbtRequest.ProtoReflect.Descriptor instead.
+func (*SignSwapPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SignSwapPsbtRequest) GetTerms() *SwapTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *SignSwapPsbtRequest) GetAnchorPsbt() []byte {
+	if x != nil {
+		return x.AnchorPsbt
+	}
+	return nil
+}
+
+type SwapPsbtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The (partially) signed anchor transaction of the swap.
+	AnchorPsbt []byte `protobuf:"bytes,1,opt,name=anchor_psbt,json=anchorPsbt,proto3" json:"anchor_psbt,omitempty"`
+}
+
+func (x *SwapPsbtResponse) Reset() {
+	*x = SwapPsbtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapPsbtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapPsbtResponse) ProtoMessage() {}
+
+func (x *SwapPsbtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapPsbtResponse.ProtoReflect.Descriptor instead.
+func (*SwapPsbtResponse) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SwapPsbtResponse) GetAnchorPsbt() []byte {
+	if x != nil {
+		return x.AnchorPsbt
+	}
+	return nil
+}
+
+type PublishSwapPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The terms of the swap from the point of view of the local party.
+	Terms *SwapTerms `protobuf:"bytes,1,opt,name=terms,proto3" json:"terms,omitempty"`
+	// The fully signed anchor transaction of the swap.
+	AnchorPsbt []byte `protobuf:"bytes,2,opt,name=anchor_psbt,json=anchorPsbt,proto3" json:"anchor_psbt,omitempty"`
+}
+
+func (x *PublishSwapPsbtRequest) Reset() {
+	*x = PublishSwapPsbtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishSwapPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishSwapPsbtRequest) ProtoMessage() {}
+
+func (x *PublishSwapPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishSwapPsbtRequest.ProtoReflect.Descriptor instead.
+func (*PublishSwapPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PublishSwapPsbtRequest) GetTerms() *SwapTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *PublishSwapPsbtRequest) GetAnchorPsbt() []byte {
+	if x != nil {
+		return x.AnchorPsbt
+	}
+	return nil
+}
+
+type PublishSwapPsbtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The final anchor transaction that was broadcast.
+	FinalTx []byte `protobuf:"bytes,1,opt,name=final_tx,json=finalTx,proto3" json:"final_tx,omitempty"`
+	// The transfer of the local virtual transaction, if the local party gave
+	// any assets in the swap.
+	Transfer *taprpc.AssetTransfer `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (x *PublishSwapPsbtResponse) Reset() {
+	*x = PublishSwapPsbtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishSwapPsbtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishSwapPsbtResponse) ProtoMessage() {}
+
+func (x *PublishSwapPsbtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishSwapPsbtResponse.ProtoReflect.Descriptor instead.
+func (*PublishSwapPsbtResponse) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PublishSwapPsbtResponse) GetFinalTx() []byte {
+	if x != nil {
+		return x.FinalTx
+	}
+	return nil
+}
+
+func (x *PublishSwapPsbtResponse) GetTransfer() *taprpc.AssetTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type ProveAssetOwnershipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetId   []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	ScriptKey []byte `protobuf:"bytes,2,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+}
+
+func (x *ProveAssetOwnershipRequest) Reset() {
+	*x = ProveAssetOwnershipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProveAssetOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProveAssetOwnershipRequest) ProtoMessage() {}
+
+func (x *ProveAssetOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProveAssetOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*ProveAssetOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProveAssetOwnershipRequest) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *ProveAssetOwnershipRequest) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
+	}
+	return nil
+}
+
+type ProveAssetOwnershipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProofWithWitness []byte `protobuf:"bytes,1,opt,name=proof_with_witness,json=proofWithWitness,proto3" json:"proof_with_witness,omitempty"`
+}
+
+func (x *ProveAssetOwnershipResponse) Reset() {
+	*x = ProveAssetOwnershipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProveAssetOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProveAssetOwnershipResponse) ProtoMessage() {}
+
+func (x *ProveAssetOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProveAssetOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*ProveAssetOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ProveAssetOwnershipResponse) GetProofWithWitness() []byte {
+	if x != nil {
+		return x.ProofWithWitness
+	}
+	return nil
+}
+
+type VerifyAssetOwnershipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProofWithWitness []byte `protobuf:"bytes,1,opt,name=proof_with_witness,json=proofWithWitness,proto3" json:"proof_with_witness,omitempty"`
+}
+
+func (x *VerifyAssetOwnershipRequest) Reset() {
+	*x = VerifyAssetOwnershipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyAssetOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAssetOwnershipRequest) ProtoMessage() {}
+
+func (x *VerifyAssetOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAssetOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAssetOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *VerifyAssetOwnershipRequest) GetProofWithWitness() []byte {
+	if x != nil {
+		return x.ProofWithWitness
+	}
+	return nil
+}
+
+type VerifyAssetOwnershipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ValidProof bool `protobuf:"varint,1,opt,name=valid_proof,json=validProof,proto3" json:"valid_proof,omitempty"`
+}
+
+func (x *VerifyAssetOwnershipResponse) Reset() {
+	*x = VerifyAssetOwnershipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyAssetOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAssetOwnershipResponse) ProtoMessage() {}
 
 func (x *VerifyAssetOwnershipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[15]
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,7 +2301,7 @@ func (x *VerifyAssetOwnershipResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyAssetOwnershipResponse.ProtoReflect.Descriptor instead.
 func (*VerifyAssetOwnershipResponse) Descriptor() ([]byte, []int) {
-	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{15}
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *VerifyAssetOwnershipResponse) GetValidProof() bool {
@@ -904,7 +2323,7 @@ type RemoveUTXOLeaseRequest struct {
 func (x *RemoveUTXOLeaseRequest) Reset() {
 	*x = RemoveUTXOLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[16]
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -917,7 +2336,7 @@ func (x *RemoveUTXOLeaseRequest) String() string {
 func (*RemoveUTXOLeaseRequest) ProtoMessage() {}
 
 func (x *RemoveUTXOLeaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[16]
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,7 +2349,7 @@ func (x *RemoveUTXOLeaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveUTXOLeaseRequest.ProtoReflect.Descriptor instead.
 func (*RemoveUTXOLeaseRequest) Descriptor() ([]byte, []int) {
-	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{16}
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *RemoveUTXOLeaseRequest) GetOutpoint() *OutPoint {
@@ -949,7 +2368,7 @@ type RemoveUTXOLeaseResponse struct {
 func (x *RemoveUTXOLeaseResponse) Reset() {
 	*x = RemoveUTXOLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[17]
+		mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -962,7 +2381,7 @@ func (x *RemoveUTXOLeaseResponse) String() string {
 func (*RemoveUTXOLeaseResponse) ProtoMessage() {}
 
 func (x *RemoveUTXOLeaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[17]
+	mi := &file_assetwalletrpc_assetwallet_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +2394,7 @@ func (x *RemoveUTXOLeaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveUTXOLeaseResponse.ProtoReflect.Descriptor instead.
 func (*RemoveUTXOLeaseResponse) Descriptor() ([]byte, []int) {
-	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{17}
+	return file_assetwalletrpc_assetwallet_proto_rawDescGZIP(), []int{37}
 }
 
 var File_assetwalletrpc_assetwallet_proto protoreflect.FileDescriptor
@@ -985,20 +2404,28 @@ var file_assetwalletrpc_assetwallet_proto_rawDesc = []byte{
 	0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72,
 	0x70, 0x63, 0x1a, 0x13, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6a, 0x0a, 0x16, 0x46, 0x75, 0x6e, 0x64, 0x56,
-	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x73, 0x62, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48,
-	0x00, 0x52, 0x04, 0x70, 0x73, 0x62, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x78, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x48, 0x00, 0x52, 0x03, 0x72, 0x61, 0x77, 0x42, 0x0a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x22, 0x6a, 0x0a, 0x17, 0x46, 0x75, 0x6e, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x01, 0x0a, 0x16, 0x46, 0x75, 0x6e, 0x64,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x70, 0x73, 0x62, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x04, 0x70, 0x73, 0x62, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x78, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x03, 0x72, 0x61, 0x77, 0x12, 0x4c, 0x0a, 0x14, 0x63, 0x6f, 0x69, 0x6e,
+	0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x52, 0x12, 0x63, 0x6f, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x17, 0x46, 0x75, 0x6e, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75,
 	0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
 	0x0a, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x50, 0x73, 0x62, 0x74, 0x12,
 	0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
 	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x61, 0x6e, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x2e, 0x0a, 0x13, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x70, 0x73, 0x62, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x61,
+	0x73, 0x73, 0x69, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x73, 0x22,
 	0xc7, 0x01, 0x0a, 0x0a, 0x54, 0x78, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2e,
 	0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
 	0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e,
@@ -1022,11 +2449,43 @@ var file_assetwalletrpc_assetwallet_proto_rawDesc = []byte{
 	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70,
 	0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x39, 0x0a, 0x16, 0x53,
-	0x69, 0x67, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f,
-	0x70, 0x73, 0x62, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x66, 0x75, 0x6e, 0x64,
-	0x65, 0x64, 0x50, 0x73, 0x62, 0x74, 0x22, 0x5f, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e, 0x56, 0x69,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x88, 0x01, 0x0a, 0x16,
+	0x53, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x66, 0x75, 0x6e,
+	0x64, 0x65, 0x64, 0x50, 0x73, 0x62, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x10, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x70, 0x65, 0x6e,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x44, 0x0a, 0x07, 0x54, 0x61, 0x70, 0x4c, 0x65, 0x61,
+	0x66, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x66, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x81, 0x01, 0x0a,
+	0x10, 0x54, 0x61, 0x70, 0x4c, 0x65, 0x61, 0x66, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x54, 0x61, 0x70, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0xe3, 0x01, 0x0a, 0x0f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x70, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x04, 0x6c, 0x65,
+	0x61, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x5f,
+	0x6c, 0x65, 0x61, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e,
+	0x4c, 0x65, 0x61, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x77, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x69,
+	0x67, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0x5f, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e, 0x56, 0x69,
 	0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x73, 0x62, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x50, 0x73,
@@ -1036,106 +2495,335 @@ var file_assetwalletrpc_assetwallet_proto_rawDesc = []byte{
 	0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f,
 	0x70, 0x73, 0x62, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x76, 0x69, 0x72,
-	0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x16, 0x4e, 0x65, 0x78,
-	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x19, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c,
+	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x73,
+	0x62, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x61, 0x73, 0x73, 0x69,
+	0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x6d, 0x0a, 0x1a, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f,
+	0x70, 0x73, 0x62, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x50, 0x73, 0x62, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xc4, 0x01, 0x0a, 0x1c, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x50, 0x73, 0x62, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0c, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x70,
+	0x73, 0x62, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11, 0x70, 0x61, 0x73, 0x73,
+	0x69, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x37, 0x0a,
+	0x16, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x53, 0x0a, 0x17, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0b,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x22, 0x79, 0x0a, 0x14, 0x4e,
+	0x65, 0x78, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x46, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x22, 0x53, 0x0a, 0x17, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
-	0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x22, 0x35, 0x0a, 0x14, 0x4e, 0x65, 0x78, 0x74, 0x53,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x49,
-	0x0a, 0x15, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x09,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x56, 0x0a, 0x1a, 0x50, 0x72, 0x6f,
-	0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65,
-	0x79, 0x22, 0x4b, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x77,
-	0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x57, 0x69, 0x74, 0x68, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x4b,
-	0x0a, 0x1b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
-	0x12, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x77, 0x69, 0x74, 0x6e,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x57, 0x69, 0x74, 0x68, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x3f, 0x0a, 0x1c, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
-	0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x4e, 0x0a, 0x16,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x54, 0x58, 0x4f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x19, 0x0a, 0x17,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x54, 0x58, 0x4f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xba, 0x06, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x62, 0x0a, 0x0f, 0x46, 0x75, 0x6e, 0x64, 0x56,
-	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x12, 0x26, 0x2e, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x75, 0x6e, 0x64,
-	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50,
-	0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x53,
-	0x69, 0x67, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x12, 0x26,
-	0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x72, 0x74,
-	0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5a, 0x0a, 0x12, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
-	0x50, 0x73, 0x62, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x56, 0x69, 0x72,
-	0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x4e,
-	0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x26,
+	0x6c, 0x79, 0x12, 0x42, 0x0a, 0x10, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61,
+	0x70, 0x4c, 0x65, 0x61, 0x66, 0x52, 0x0f, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x4e, 0x65, 0x78, 0x74, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x48, 0x0a, 0x0f, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x70,
+	0x4c, 0x65, 0x61, 0x66, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x6c,
+	0x65, 0x61, 0x66, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xe0, 0x01, 0x0a,
+	0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0d, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79,
+	0x4c, 0x6f, 0x63, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x17, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22,
+	0xc4, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x0a,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x26,
+	0x0a, 0x0f, 0x68, 0x61, 0x76, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x61, 0x76, 0x65, 0x41, 0x6c, 0x6c,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x17, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x73, 0x22, 0x46, 0x0a, 0x1c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x75, 0x53,
+	0x69, 0x67, 0x32, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x61, 0x76, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x61, 0x76, 0x65,
+	0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x1c, 0x53, 0x69,
+	0x67, 0x6e, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50,
+	0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6e,
+	0x64, 0x65, 0x64, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x50, 0x73, 0x62, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6c,
+	0x65, 0x61, 0x6e, 0x75, 0x70, 0x22, 0x4c, 0x0a, 0x1d, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x75, 0x53,
+	0x69, 0x67, 0x32, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x1f, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x4d,
+	0x75, 0x53, 0x69, 0x67, 0x32, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x66, 0x75, 0x6e,
+	0x64, 0x65, 0x64, 0x50, 0x73, 0x62, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x16, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x22, 0x6b, 0x0a, 0x0d, 0x53, 0x77, 0x61, 0x70, 0x42, 0x74, 0x63, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x22,
+	0xa8, 0x03, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x70,
+	0x73, 0x62, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x70, 0x73,
+	0x62, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x74, 0x63, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x42, 0x74, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x74, 0x63, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x42, 0x74, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x4f, 0x0a, 0x14, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x42, 0x74, 0x63,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x15, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x05,
+	0x74, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x62, 0x74, 0x63, 0x5f, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x42, 0x74, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0a, 0x62, 0x74, 0x63, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x22, 0x67, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x50, 0x73, 0x62, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x73, 0x62, 0x74, 0x22, 0x33, 0x0a, 0x10, 0x53, 0x77, 0x61,
+	0x70, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x73, 0x62, 0x74, 0x22, 0x6a,
+	0x0a, 0x16, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x77, 0x61, 0x70, 0x50, 0x73, 0x62,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x73, 0x62, 0x74, 0x22, 0x67, 0x0a, 0x17, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x77, 0x61, 0x70, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x78,
+	0x12, 0x31, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x22, 0x56, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x4b, 0x0a, 0x1b, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x57, 0x69, 0x74,
+	0x68, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x4b, 0x0a, 0x1b, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x57, 0x69, 0x74, 0x68, 0x57, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x3f, 0x0a, 0x1c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x4e, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x55, 0x54, 0x58, 0x4f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x34, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x75, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6f, 0x75,
+	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x55, 0x54, 0x58, 0x4f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0xee, 0x0d, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x12, 0x62, 0x0a, 0x0f, 0x46, 0x75, 0x6e, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x50, 0x73, 0x62, 0x74, 0x12, 0x26, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x75,
+	0x6e, 0x64, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x12, 0x26, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x12, 0x41, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x12,
+	0x29, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73,
+	0x62, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x60, 0x0a, 0x15, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x6e, 0x64,
+	0x4c, 0x6f, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2a, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2b,
 	0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5c, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79,
-	0x12, 0x24, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4b, 0x65, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a,
-	0x13, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x73, 0x68, 0x69, 0x70, 0x12, 0x2a, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x55, 0x54, 0x58, 0x4f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x55, 0x54, 0x58, 0x4f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x54, 0x58, 0x4f,
+	0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x53, 0x69,
+	0x67, 0x32, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x2b, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a,
-	0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2b, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a,
+	0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x4e,
+	0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d,
+	0x75, 0x53, 0x69, 0x67, 0x32, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x62, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x54, 0x58, 0x4f, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x54, 0x58, 0x4f, 0x4c,
-	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x55, 0x54, 0x58, 0x4f, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73,
-	0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f,
-	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x75, 0x53, 0x69,
+	0x67, 0x32, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x74, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4d,
+	0x75, 0x53, 0x69, 0x67, 0x32, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x75, 0x53,
+	0x69, 0x67, 0x32, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e,
+	0x65, 0x4d, 0x75, 0x53, 0x69, 0x67, 0x32, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73,
+	0x62, 0x74, 0x12, 0x2f, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x4d, 0x75, 0x53, 0x69, 0x67,
+	0x32, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x50, 0x73, 0x62, 0x74, 0x12, 0x25,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x50, 0x73, 0x62, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x53,
+	0x77, 0x61, 0x70, 0x50, 0x73, 0x62, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x77, 0x61,
+	0x70, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x77, 0x61, 0x70, 0x50, 0x73, 0x62,
+	0x74, 0x12, 0x26, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x77, 0x61, 0x70, 0x50, 0x73,
+	0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x53, 0x77, 0x61, 0x70, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1150,60 +2838,117 @@ func file_assetwalletrpc_assetwallet_proto_rawDescGZIP() []byte {
 	return file_assetwalletrpc_assetwallet_proto_rawDescData
 }
 
-var file_assetwalletrpc_assetwallet_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_assetwalletrpc_assetwallet_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_assetwalletrpc_assetwallet_proto_goTypes = []interface{}{
-	(*FundVirtualPsbtRequest)(nil),       // 0: assetwalletrpc.FundVirtualPsbtRequest
-	(*FundVirtualPsbtResponse)(nil),      // 1: assetwalletrpc.FundVirtualPsbtResponse
-	(*TxTemplate)(nil),                   // 2: assetwalletrpc.TxTemplate
-	(*PrevId)(nil),                       // 3: assetwalletrpc.PrevId
-	(*OutPoint)(nil),                     // 4: assetwalletrpc.OutPoint
-	(*SignVirtualPsbtRequest)(nil),       // 5: assetwalletrpc.SignVirtualPsbtRequest
-	(*SignVirtualPsbtResponse)(nil),      // 6: assetwalletrpc.SignVirtualPsbtResponse
-	(*AnchorVirtualPsbtsRequest)(nil),    // 7: assetwalletrpc.AnchorVirtualPsbtsRequest
-	(*NextInternalKeyRequest)(nil),       // 8: assetwalletrpc.NextInternalKeyRequest
-	(*NextInternalKeyResponse)(nil),      // 9: assetwalletrpc.NextInternalKeyResponse
-	(*NextScriptKeyRequest)(nil),         // 10: assetwalletrpc.NextScriptKeyRequest
-	(*NextScriptKeyResponse)(nil),        // 11: assetwalletrpc.NextScriptKeyResponse
-	(*ProveAssetOwnershipRequest)(nil),   // 12: assetwalletrpc.ProveAssetOwnershipRequest
-	(*ProveAssetOwnershipResponse)(nil),  // 13: assetwalletrpc.ProveAssetOwnershipResponse
-	(*VerifyAssetOwnershipRequest)(nil),  // 14: assetwalletrpc.VerifyAssetOwnershipRequest
-	(*VerifyAssetOwnershipResponse)(nil), // 15: assetwalletrpc.VerifyAssetOwnershipResponse
-	(*RemoveUTXOLeaseRequest)(nil),       // 16: assetwalletrpc.RemoveUTXOLeaseRequest
-	(*RemoveUTXOLeaseResponse)(nil),      // 17: assetwalletrpc.RemoveUTXOLeaseResponse
-	nil,                                  // 18: assetwalletrpc.TxTemplate.RecipientsEntry
-	(*taprpc.KeyDescriptor)(nil),         // 19: taprpc.KeyDescriptor
-	(*taprpc.ScriptKey)(nil),             // 20: taprpc.ScriptKey
-	(*taprpc.SendAssetResponse)(nil),     // 21: taprpc.SendAssetResponse
+	(*FundVirtualPsbtRequest)(nil),          // 0: assetwalletrpc.FundVirtualPsbtRequest
+	(*FundVirtualPsbtResponse)(nil),         // 1: assetwalletrpc.FundVirtualPsbtResponse
+	(*TxTemplate)(nil),                      // 2: assetwalletrpc.TxTemplate
+	(*PrevId)(nil),                          // 3: assetwalletrpc.PrevId
+	(*OutPoint)(nil),                        // 4: assetwalletrpc.OutPoint
+	(*SignVirtualPsbtRequest)(nil),          // 5: assetwalletrpc.SignVirtualPsbtRequest
+	(*TapLeaf)(nil),                         // 6: assetwalletrpc.TapLeaf
+	(*TapLeafSpendInfo)(nil),                // 7: assetwalletrpc.TapLeafSpendInfo
+	(*ScriptSpendPath)(nil),                 // 8: assetwalletrpc.ScriptSpendPath
+	(*SignVirtualPsbtResponse)(nil),         // 9: assetwalletrpc.SignVirtualPsbtResponse
+	(*AnchorVirtualPsbtsRequest)(nil),       // 10: assetwalletrpc.AnchorVirtualPsbtsRequest
+	(*CommitVirtualPsbtsRequest)(nil),       // 11: assetwalletrpc.CommitVirtualPsbtsRequest
+	(*CommitVirtualPsbtsResponse)(nil),      // 12: assetwalletrpc.CommitVirtualPsbtsResponse
+	(*PublishAndLogTransferRequest)(nil),    // 13: assetwalletrpc.PublishAndLogTransferRequest
+	(*NextInternalKeyRequest)(nil),          // 14: assetwalletrpc.NextInternalKeyRequest
+	(*NextInternalKeyResponse)(nil),         // 15: assetwalletrpc.NextInternalKeyResponse
+	(*NextScriptKeyRequest)(nil),            // 16: assetwalletrpc.NextScriptKeyRequest
+	(*NextScriptKeyResponse)(nil),           // 17: assetwalletrpc.NextScriptKeyResponse
+	(*CreateMuSig2SessionRequest)(nil),      // 18: assetwalletrpc.CreateMuSig2SessionRequest
+	(*CreateMuSig2SessionResponse)(nil),     // 19: assetwalletrpc.CreateMuSig2SessionResponse
+	(*RegisterMuSig2NoncesRequest)(nil),     // 20: assetwalletrpc.RegisterMuSig2NoncesRequest
+	(*RegisterMuSig2NoncesResponse)(nil),    // 21: assetwalletrpc.RegisterMuSig2NoncesResponse
+	(*SignMuSig2VirtualPsbtRequest)(nil),    // 22: assetwalletrpc.SignMuSig2VirtualPsbtRequest
+	(*SignMuSig2VirtualPsbtResponse)(nil),   // 23: assetwalletrpc.SignMuSig2VirtualPsbtResponse
+	(*CombineMuSig2VirtualPsbtRequest)(nil), // 24: assetwalletrpc.CombineMuSig2VirtualPsbtRequest
+	(*SwapBtcOutput)(nil),                   // 25: assetwalletrpc.SwapBtcOutput
+	(*SwapTerms)(nil),                       // 26: assetwalletrpc.SwapTerms
+	(*CreateSwapPsbtRequest)(nil),           // 27: assetwalletrpc.CreateSwapPsbtRequest
+	(*SignSwapPsbtRequest)(nil),             // 28: assetwalletrpc.SignSwapPsbtRequest
+	(*SwapPsbtResponse)(nil),                // 29: assetwalletrpc.SwapPsbtResponse
+	(*PublishSwapPsbtRequest)(nil),          // 30: assetwalletrpc.PublishSwapPsbtRequest
+	(*PublishSwapPsbtResponse)(nil),         // 31: assetwalletrpc.PublishSwapPsbtResponse
+	(*ProveAssetOwnershipRequest)(nil),      // 32: assetwalletrpc.ProveAssetOwnershipRequest
+	(*ProveAssetOwnershipResponse)(nil),     // 33: assetwalletrpc.ProveAssetOwnershipResponse
+	(*VerifyAssetOwnershipRequest)(nil),     // 34: assetwalletrpc.VerifyAssetOwnershipRequest
+	(*VerifyAssetOwnershipResponse)(nil),    // 35: assetwalletrpc.VerifyAssetOwnershipResponse
+	(*RemoveUTXOLeaseRequest)(nil),          // 36: assetwalletrpc.RemoveUTXOLeaseRequest
+	(*RemoveUTXOLeaseResponse)(nil),         // 37: assetwalletrpc.RemoveUTXOLeaseResponse
+	nil,                                     // 38: assetwalletrpc.TxTemplate.RecipientsEntry
+	(taprpc.CoinSelectStrategy)(0),          // 39: taprpc.CoinSelectStrategy
+	(*taprpc.KeyDescriptor)(nil),            // 40: taprpc.KeyDescriptor
+	(*taprpc.ScriptKey)(nil),                // 41: taprpc.ScriptKey
+	(*taprpc.KeyLocator)(nil),               // 42: taprpc.KeyLocator
+	(*taprpc.AssetTransfer)(nil),            // 43: taprpc.AssetTransfer
+	(*taprpc.SendAssetResponse)(nil),        // 44: taprpc.SendAssetResponse
 }
 var file_assetwalletrpc_assetwallet_proto_depIdxs = []int32{
 	2,  // 0: assetwalletrpc.FundVirtualPsbtRequest.raw:type_name -> assetwalletrpc.TxTemplate
-	3,  // 1: assetwalletrpc.TxTemplate.inputs:type_name -> assetwalletrpc.PrevId
-	18, // 2: assetwalletrpc.TxTemplate.recipients:type_name -> assetwalletrpc.TxTemplate.RecipientsEntry
-	4,  // 3: assetwalletrpc.PrevId.outpoint:type_name -> assetwalletrpc.OutPoint
-	19, // 4: assetwalletrpc.NextInternalKeyResponse.internal_key:type_name -> taprpc.KeyDescriptor
-	20, // 5: assetwalletrpc.NextScriptKeyResponse.script_key:type_name -> taprpc.ScriptKey
-	4,  // 6: assetwalletrpc.RemoveUTXOLeaseRequest.outpoint:type_name -> assetwalletrpc.OutPoint
-	0,  // 7: assetwalletrpc.AssetWallet.FundVirtualPsbt:input_type -> assetwalletrpc.FundVirtualPsbtRequest
-	5,  // 8: assetwalletrpc.AssetWallet.SignVirtualPsbt:input_type -> assetwalletrpc.SignVirtualPsbtRequest
-	7,  // 9: assetwalletrpc.AssetWallet.AnchorVirtualPsbts:input_type -> assetwalletrpc.AnchorVirtualPsbtsRequest
-	8,  // 10: assetwalletrpc.AssetWallet.NextInternalKey:input_type -> assetwalletrpc.NextInternalKeyRequest
-	10, // 11: assetwalletrpc.AssetWallet.NextScriptKey:input_type -> assetwalletrpc.NextScriptKeyRequest
-	12, // 12: assetwalletrpc.AssetWallet.ProveAssetOwnership:input_type -> assetwalletrpc.ProveAssetOwnershipRequest
-	14, // 13: assetwalletrpc.AssetWallet.VerifyAssetOwnership:input_type -> assetwalletrpc.VerifyAssetOwnershipRequest
-	16, // 14: assetwalletrpc.AssetWallet.RemoveUTXOLease:input_type -> assetwalletrpc.RemoveUTXOLeaseRequest
-	1,  // 15: assetwalletrpc.AssetWallet.FundVirtualPsbt:output_type -> assetwalletrpc.FundVirtualPsbtResponse
-	6,  // 16: assetwalletrpc.AssetWallet.SignVirtualPsbt:output_type -> assetwalletrpc.SignVirtualPsbtResponse
-	21, // 17: assetwalletrpc.AssetWallet.AnchorVirtualPsbts:output_type -> taprpc.SendAssetResponse
-	9,  // 18: assetwalletrpc.AssetWallet.NextInternalKey:output_type -> assetwalletrpc.NextInternalKeyResponse
-	11, // 19: assetwalletrpc.AssetWallet.NextScriptKey:output_type -> assetwalletrpc.NextScriptKeyResponse
-	13, // 20: assetwalletrpc.AssetWallet.ProveAssetOwnership:output_type -> assetwalletrpc.ProveAssetOwnershipResponse
-	15, // 21: assetwalletrpc.AssetWallet.VerifyAssetOwnership:output_type -> assetwalletrpc.VerifyAssetOwnershipResponse
-	17, // 22: assetwalletrpc.AssetWallet.RemoveUTXOLease:output_type -> assetwalletrpc.RemoveUTXOLeaseResponse
-	15, // [15:23] is the sub-list for method output_type
-	7,  // [7:15] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	39, // 1: assetwalletrpc.FundVirtualPsbtRequest.coin_select_strategy:type_name -> taprpc.CoinSelectStrategy
+	3,  // 2: assetwalletrpc.TxTemplate.inputs:type_name -> assetwalletrpc.PrevId
+	38, // 3: assetwalletrpc.TxTemplate.recipients:type_name -> assetwalletrpc.TxTemplate.RecipientsEntry
+	4,  // 4: assetwalletrpc.PrevId.outpoint:type_name -> assetwalletrpc.OutPoint
+	8,  // 5: assetwalletrpc.SignVirtualPsbtRequest.script_spend_paths:type_name -> assetwalletrpc.ScriptSpendPath
+	6,  // 6: assetwalletrpc.TapLeafSpendInfo.leaf:type_name -> assetwalletrpc.TapLeaf
+	6,  // 7: assetwalletrpc.ScriptSpendPath.leaf:type_name -> assetwalletrpc.TapLeaf
+	40, // 8: assetwalletrpc.NextInternalKeyResponse.internal_key:type_name -> taprpc.KeyDescriptor
+	6,  // 9: assetwalletrpc.NextScriptKeyRequest.tapscript_leaves:type_name -> assetwalletrpc.TapLeaf
+	41, // 10: assetwalletrpc.NextScriptKeyResponse.script_key:type_name -> taprpc.ScriptKey
+	7,  // 11: assetwalletrpc.NextScriptKeyResponse.leaf_spend_info:type_name -> assetwalletrpc.TapLeafSpendInfo
+	42, // 12: assetwalletrpc.CreateMuSig2SessionRequest.local_key_loc:type_name -> taprpc.KeyLocator
+	41, // 13: assetwalletrpc.CreateMuSig2SessionResponse.script_key:type_name -> taprpc.ScriptKey
+	25, // 14: assetwalletrpc.SwapTerms.expected_btc_outputs:type_name -> assetwalletrpc.SwapBtcOutput
+	25, // 15: assetwalletrpc.SwapTerms.change_output:type_name -> assetwalletrpc.SwapBtcOutput
+	25, // 16: assetwalletrpc.SwapTerms.remote_change_output:type_name -> assetwalletrpc.SwapBtcOutput
+	26, // 17: assetwalletrpc.CreateSwapPsbtRequest.terms:type_name -> assetwalletrpc.SwapTerms
+	25, // 18: assetwalletrpc.CreateSwapPsbtRequest.btc_outputs:type_name -> assetwalletrpc.SwapBtcOutput
+	26, // 19: assetwalletrpc.SignSwapPsbtRequest.terms:type_name -> assetwalletrpc.SwapTerms
+	26, // 20: assetwalletrpc.PublishSwapPsbtRequest.terms:type_name -> assetwalletrpc.SwapTerms
+	43, // 21: assetwalletrpc.PublishSwapPsbtResponse.transfer:type_name -> taprpc.AssetTransfer
+	4,  // 22: assetwalletrpc.RemoveUTXOLeaseRequest.outpoint:type_name -> assetwalletrpc.OutPoint
+	0,  // 23: assetwalletrpc.AssetWallet.FundVirtualPsbt:input_type -> assetwalletrpc.FundVirtualPsbtRequest
+	5,  // 24: assetwalletrpc.AssetWallet.SignVirtualPsbt:input_type -> assetwalletrpc.SignVirtualPsbtRequest
+	10, // 25: assetwalletrpc.AssetWallet.AnchorVirtualPsbts:input_type -> assetwalletrpc.AnchorVirtualPsbtsRequest
+	11, // 26: assetwalletrpc.AssetWallet.CommitVirtualPsbts:input_type -> assetwalletrpc.CommitVirtualPsbtsRequest
+	13, // 27: assetwalletrpc.AssetWallet.PublishAndLogTransfer:input_type -> assetwalletrpc.PublishAndLogTransferRequest
+	14, // 28: assetwalletrpc.AssetWallet.NextInternalKey:input_type -> assetwalletrpc.NextInternalKeyRequest
+	16, // 29: assetwalletrpc.AssetWallet.NextScriptKey:input_type -> assetwalletrpc.NextScriptKeyRequest
+	32, // 30: assetwalletrpc.AssetWallet.ProveAssetOwnership:input_type -> assetwalletrpc.ProveAssetOwnershipRequest
+	34, // 31: assetwalletrpc.AssetWallet.VerifyAssetOwnership:input_type -> assetwalletrpc.VerifyAssetOwnershipRequest
+	36, // 32: assetwalletrpc.AssetWallet.RemoveUTXOLease:input_type -> assetwalletrpc.RemoveUTXOLeaseRequest
+	18, // 33: assetwalletrpc.AssetWallet.CreateMuSig2Session:input_type -> assetwalletrpc.CreateMuSig2SessionRequest
+	20, // 34: assetwalletrpc.AssetWallet.RegisterMuSig2Nonces:input_type -> assetwalletrpc.RegisterMuSig2NoncesRequest
+	22, // 35: assetwalletrpc.AssetWallet.SignMuSig2VirtualPsbt:input_type -> assetwalletrpc.SignMuSig2VirtualPsbtRequest
+	24, // 36: assetwalletrpc.AssetWallet.CombineMuSig2VirtualPsbt:input_type -> assetwalletrpc.CombineMuSig2VirtualPsbtRequest
+	27, // 37: assetwalletrpc.AssetWallet.CreateSwapPsbt:input_type -> assetwalletrpc.CreateSwapPsbtRequest
+	28, // 38: assetwalletrpc.AssetWallet.SignSwapPsbt:input_type -> assetwalletrpc.SignSwapPsbtRequest
+	30, // 39: assetwalletrpc.AssetWallet.PublishSwapPsbt:input_type -> assetwalletrpc.PublishSwapPsbtRequest
+	1,  // 40: assetwalletrpc.AssetWallet.FundVirtualPsbt:output_type -> assetwalletrpc.FundVirtualPsbtResponse
+	9,  // 41: assetwalletrpc.AssetWallet.SignVirtualPsbt:output_type -> assetwalletrpc.SignVirtualPsbtResponse
+	44, // 42: assetwalletrpc.AssetWallet.AnchorVirtualPsbts:output_type -> taprpc.SendAssetResponse
+	12, // 43: assetwalletrpc.AssetWallet.CommitVirtualPsbts:output_type -> assetwalletrpc.CommitVirtualPsbtsResponse
+	44, // 44: assetwalletrpc.AssetWallet.PublishAndLogTransfer:output_type -> taprpc.SendAssetResponse
+	15, // 45: assetwalletrpc.AssetWallet.NextInternalKey:output_type -> assetwalletrpc.NextInternalKeyResponse
+	17, // 46: assetwalletrpc.AssetWallet.NextScriptKey:output_type -> assetwalletrpc.NextScriptKeyResponse
+	33, // 47: assetwalletrpc.AssetWallet.ProveAssetOwnership:output_type -> assetwalletrpc.ProveAssetOwnershipResponse
+	35, // 48: assetwalletrpc.AssetWallet.VerifyAssetOwnership:output_type -> assetwalletrpc.VerifyAssetOwnershipResponse
+	37, // 49: assetwalletrpc.AssetWallet.RemoveUTXOLease:output_type -> assetwalletrpc.RemoveUTXOLeaseResponse
+	19, // 50: assetwalletrpc.AssetWallet.CreateMuSig2Session:output_type -> assetwalletrpc.CreateMuSig2SessionResponse
+	21, // 51: assetwalletrpc.AssetWallet.RegisterMuSig2Nonces:output_type -> assetwalletrpc.RegisterMuSig2NoncesResponse
+	23, // 52: assetwalletrpc.AssetWallet.SignMuSig2VirtualPsbt:output_type -> assetwalletrpc.SignMuSig2VirtualPsbtResponse
+	9,  // 53: assetwalletrpc.AssetWallet.CombineMuSig2VirtualPsbt:output_type -> assetwalletrpc.SignVirtualPsbtResponse
+	29, // 54: assetwalletrpc.AssetWallet.CreateSwapPsbt:output_type -> assetwalletrpc.SwapPsbtResponse
+	29, // 55: assetwalletrpc.AssetWallet.SignSwapPsbt:output_type -> assetwalletrpc.SwapPsbtResponse
+	31, // 56: assetwalletrpc.AssetWallet.PublishSwapPsbt:output_type -> assetwalletrpc.PublishSwapPsbtResponse
+	40, // [40:57] is the sub-list for method output_type
+	23, // [23:40] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_assetwalletrpc_assetwallet_proto_init() }
@@ -1285,7 +3030,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignVirtualPsbtResponse); i {
+			switch v := v.(*TapLeaf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1297,7 +3042,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AnchorVirtualPsbtsRequest); i {
+			switch v := v.(*TapLeafSpendInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1309,7 +3054,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NextInternalKeyRequest); i {
+			switch v := v.(*ScriptSpendPath); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1321,7 +3066,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NextInternalKeyResponse); i {
+			switch v := v.(*SignVirtualPsbtResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1333,7 +3078,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NextScriptKeyRequest); i {
+			switch v := v.(*AnchorVirtualPsbtsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1345,7 +3090,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NextScriptKeyResponse); i {
+			switch v := v.(*CommitVirtualPsbtsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1357,7 +3102,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveAssetOwnershipRequest); i {
+			switch v := v.(*CommitVirtualPsbtsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1369,7 +3114,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProveAssetOwnershipResponse); i {
+			switch v := v.(*PublishAndLogTransferRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1381,7 +3126,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyAssetOwnershipRequest); i {
+			switch v := v.(*NextInternalKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1393,7 +3138,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyAssetOwnershipResponse); i {
+			switch v := v.(*NextInternalKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1405,7 +3150,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveUTXOLeaseRequest); i {
+			switch v := v.(*NextScriptKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1417,6 +3162,246 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			}
 		}
 		file_assetwalletrpc_assetwallet_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NextScriptKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMuSig2SessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateMuSig2SessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterMuSig2NoncesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterMuSig2NoncesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignMuSig2VirtualPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignMuSig2VirtualPsbtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombineMuSig2VirtualPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapBtcOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapTerms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSwapPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignSwapPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapPsbtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishSwapPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishSwapPsbtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveAssetOwnershipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveAssetOwnershipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyAssetOwnershipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyAssetOwnershipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveUTXOLeaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_assetwalletrpc_assetwallet_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveUTXOLeaseResponse); i {
 			case 0:
 				return &v.state
@@ -1439,7 +3424,7 @@ func file_assetwalletrpc_assetwallet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_assetwalletrpc_assetwallet_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
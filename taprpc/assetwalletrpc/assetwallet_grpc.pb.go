@@ -31,6 +31,17 @@ type AssetWalletClient interface {
 	// TODO(guggero): Actually implement accepting and merging multiple
 	// transactions.
 	AnchorVirtualPsbts(ctx context.Context, in *AnchorVirtualPsbtsRequest, opts ...grpc.CallOption) (*taprpc.SendAssetResponse, error)
+	// CommitVirtualPsbts creates and funds the BTC level anchor transaction that
+	// commits to the given signed virtual transactions (both active and passive),
+	// without signing it. This is used in watch-only mode, where the anchor
+	// transaction is signed by an external signer and then published with
+	// PublishAndLogTransfer.
+	CommitVirtualPsbts(ctx context.Context, in *CommitVirtualPsbtsRequest, opts ...grpc.CallOption) (*CommitVirtualPsbtsResponse, error)
+	// PublishAndLogTransfer verifies that the given externally signed anchor
+	// transaction commits to the given signed virtual transactions, then
+	// finalizes and broadcasts it and logs the transfer. The proofs of the
+	// transfer are created once the anchor transaction confirms.
+	PublishAndLogTransfer(ctx context.Context, in *PublishAndLogTransferRequest, opts ...grpc.CallOption) (*taprpc.SendAssetResponse, error)
 	// NextInternalKey derives the next internal key for the given key family and
 	// stores it as an internal key in the database to make sure it is identified
 	// as a local key later on when importing proofs. While an internal key can
@@ -53,6 +64,46 @@ type AssetWalletClient interface {
 	// RemoveUTXOLease removes the lease/lock/reservation of the given managed
 	// UTXO.
 	RemoveUTXOLease(ctx context.Context, in *RemoveUTXOLeaseRequest, opts ...grpc.CallOption) (*RemoveUTXOLeaseResponse, error)
+	// CreateMuSig2Session creates a new MuSig2 signing session for the aggregate
+	// script key of the given signers, one of which must be a key of the local
+	// wallet. The returned aggregate script key can be used to receive assets
+	// that can then only be spent by all signers together. A session can only be
+	// used to sign a single virtual input.
+	CreateMuSig2Session(ctx context.Context, in *CreateMuSig2SessionRequest, opts ...grpc.CallOption) (*CreateMuSig2SessionResponse, error)
+	// RegisterMuSig2Nonces registers the public nonces of the other signers with
+	// a MuSig2 signing session.
+	RegisterMuSig2Nonces(ctx context.Context, in *RegisterMuSig2NoncesRequest, opts ...grpc.CallOption) (*RegisterMuSig2NoncesResponse, error)
+	// SignMuSig2VirtualPsbt creates the local partial MuSig2 signature for an
+	// input of a funded virtual transaction that is locked to the aggregate
+	// script key of the session. All public nonces must be known to the session.
+	SignMuSig2VirtualPsbt(ctx context.Context, in *SignMuSig2VirtualPsbtRequest, opts ...grpc.CallOption) (*SignMuSig2VirtualPsbtResponse, error)
+	// CombineMuSig2VirtualPsbt combines the local partial MuSig2 signature with
+	// the partial signatures of all other signers into the witness of an input
+	// of a funded virtual transaction. All other inputs of the virtual
+	// transaction are signed by the local wallet, as with SignVirtualPsbt, so
+	// they must be locked to local keys. A virtual transaction with more than one
+	// MuSig2 input is therefore rejected.
+	CombineMuSig2VirtualPsbt(ctx context.Context, in *CombineMuSig2VirtualPsbtRequest, opts ...grpc.CallOption) (*SignVirtualPsbtResponse, error)
+	// tapcli: `swaps create`
+	// CreateSwapPsbt creates the BTC level anchor transaction of an atomic swap
+	// that commits to the signed virtual transactions of both swap parties. The
+	// virtual transactions of the counterparty are verified against the expected
+	// addresses first. The anchor transaction is funded by the local wallet,
+	// which pays the chain fees and any BTC outputs. All inputs except the ones
+	// spent by the counterparty are signed.
+	CreateSwapPsbt(ctx context.Context, in *CreateSwapPsbtRequest, opts ...grpc.CallOption) (*SwapPsbtResponse, error)
+	// tapcli: `swaps sign`
+	// SignSwapPsbt verifies the anchor transaction of an atomic swap created by
+	// the counterparty and, if it commits to all virtual transactions and pays
+	// the expected addresses and BTC outputs, signs the anchor inputs of the
+	// local virtual transaction.
+	SignSwapPsbt(ctx context.Context, in *SignSwapPsbtRequest, opts ...grpc.CallOption) (*SwapPsbtResponse, error)
+	// tapcli: `swaps finalize`
+	// PublishSwapPsbt verifies and finalizes the fully signed anchor transaction
+	// of an atomic swap and broadcasts it. The transfer of the local virtual
+	// transaction (if any) is logged and its proofs are created once the anchor
+	// transaction confirms.
+	PublishSwapPsbt(ctx context.Context, in *PublishSwapPsbtRequest, opts ...grpc.CallOption) (*PublishSwapPsbtResponse, error)
 }
 
 type assetWalletClient struct {
@@ -90,6 +141,24 @@ func (c *assetWalletClient) AnchorVirtualPsbts(ctx context.Context, in *AnchorVi
 	return out, nil
 }
 
+func (c *assetWalletClient) CommitVirtualPsbts(ctx context.Context, in *CommitVirtualPsbtsRequest, opts ...grpc.CallOption) (*CommitVirtualPsbtsResponse, error) {
+	out := new(CommitVirtualPsbtsResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/CommitVirtualPsbts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) PublishAndLogTransfer(ctx context.Context, in *PublishAndLogTransferRequest, opts ...grpc.CallOption) (*taprpc.SendAssetResponse, error) {
+	out := new(taprpc.SendAssetResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/PublishAndLogTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *assetWalletClient) NextInternalKey(ctx context.Context, in *NextInternalKeyRequest, opts ...grpc.CallOption) (*NextInternalKeyResponse, error) {
 	out := new(NextInternalKeyResponse)
 	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/NextInternalKey", in, out, opts...)
@@ -135,6 +204,69 @@ func (c *assetWalletClient) RemoveUTXOLease(ctx context.Context, in *RemoveUTXOL
 	return out, nil
 }
 
+func (c *assetWalletClient) CreateMuSig2Session(ctx context.Context, in *CreateMuSig2SessionRequest, opts ...grpc.CallOption) (*CreateMuSig2SessionResponse, error) {
+	out := new(CreateMuSig2SessionResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/CreateMuSig2Session", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) RegisterMuSig2Nonces(ctx context.Context, in *RegisterMuSig2NoncesRequest, opts ...grpc.CallOption) (*RegisterMuSig2NoncesResponse, error) {
+	out := new(RegisterMuSig2NoncesResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/RegisterMuSig2Nonces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) SignMuSig2VirtualPsbt(ctx context.Context, in *SignMuSig2VirtualPsbtRequest, opts ...grpc.CallOption) (*SignMuSig2VirtualPsbtResponse, error) {
+	out := new(SignMuSig2VirtualPsbtResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/SignMuSig2VirtualPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) CombineMuSig2VirtualPsbt(ctx context.Context, in *CombineMuSig2VirtualPsbtRequest, opts ...grpc.CallOption) (*SignVirtualPsbtResponse, error) {
+	out := new(SignVirtualPsbtResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/CombineMuSig2VirtualPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) CreateSwapPsbt(ctx context.Context, in *CreateSwapPsbtRequest, opts ...grpc.CallOption) (*SwapPsbtResponse, error) {
+	out := new(SwapPsbtResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/CreateSwapPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) SignSwapPsbt(ctx context.Context, in *SignSwapPsbtRequest, opts ...grpc.CallOption) (*SwapPsbtResponse, error) {
+	out := new(SwapPsbtResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/SignSwapPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetWalletClient) PublishSwapPsbt(ctx context.Context, in *PublishSwapPsbtRequest, opts ...grpc.CallOption) (*PublishSwapPsbtResponse, error) {
+	out := new(PublishSwapPsbtResponse)
+	err := c.cc.Invoke(ctx, "/assetwalletrpc.AssetWallet/PublishSwapPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssetWalletServer is the server API for AssetWallet service.
 // All implementations must embed UnimplementedAssetWalletServer
 // for forward compatibility
@@ -151,6 +283,17 @@ type AssetWalletServer interface {
 	// TODO(guggero): Actually implement accepting and merging multiple
 	// transactions.
 	AnchorVirtualPsbts(context.Context, *AnchorVirtualPsbtsRequest) (*taprpc.SendAssetResponse, error)
+	// CommitVirtualPsbts creates and funds the BTC level anchor transaction that
+	// commits to the given signed virtual transactions (both active and passive),
+	// without signing it. This is used in watch-only mode, where the anchor
+	// transaction is signed by an external signer and then published with
+	// PublishAndLogTransfer.
+	CommitVirtualPsbts(context.Context, *CommitVirtualPsbtsRequest) (*CommitVirtualPsbtsResponse, error)
+	// PublishAndLogTransfer verifies that the given externally signed anchor
+	// transaction commits to the given signed virtual transactions, then
+	// finalizes and broadcasts it and logs the transfer. The proofs of the
+	// transfer are created once the anchor transaction confirms.
+	PublishAndLogTransfer(context.Context, *PublishAndLogTransferRequest) (*taprpc.SendAssetResponse, error)
 	// NextInternalKey derives the next internal key for the given key family and
 	// stores it as an internal key in the database to make sure it is identified
 	// as a local key later on when importing proofs. While an internal key can
@@ -173,6 +316,46 @@ type AssetWalletServer interface {
 	// RemoveUTXOLease removes the lease/lock/reservation of the given managed
 	// UTXO.
 	RemoveUTXOLease(context.Context, *RemoveUTXOLeaseRequest) (*RemoveUTXOLeaseResponse, error)
+	// CreateMuSig2Session creates a new MuSig2 signing session for the aggregate
+	// script key of the given signers, one of which must be a key of the local
+	// wallet. The returned aggregate script key can be used to receive assets
+	// that can then only be spent by all signers together. A session can only be
+	// used to sign a single virtual input.
+	CreateMuSig2Session(context.Context, *CreateMuSig2SessionRequest) (*CreateMuSig2SessionResponse, error)
+	// RegisterMuSig2Nonces registers the public nonces of the other signers with
+	// a MuSig2 signing session.
+	RegisterMuSig2Nonces(context.Context, *RegisterMuSig2NoncesRequest) (*RegisterMuSig2NoncesResponse, error)
+	// SignMuSig2VirtualPsbt creates the local partial MuSig2 signature for an
+	// input of a funded virtual transaction that is locked to the aggregate
+	// script key of the session. All public nonces must be known to the session.
+	SignMuSig2VirtualPsbt(context.Context, *SignMuSig2VirtualPsbtRequest) (*SignMuSig2VirtualPsbtResponse, error)
+	// CombineMuSig2VirtualPsbt combines the local partial MuSig2 signature with
+	// the partial signatures of all other signers into the witness of an input
+	// of a funded virtual transaction. All other inputs of the virtual
+	// transaction are signed by the local wallet, as with SignVirtualPsbt, so
+	// they must be locked to local keys. A virtual transaction with more than one
+	// MuSig2 input is therefore rejected.
+	CombineMuSig2VirtualPsbt(context.Context, *CombineMuSig2VirtualPsbtRequest) (*SignVirtualPsbtResponse, error)
+	// tapcli: `swaps create`
+	// CreateSwapPsbt creates the BTC level anchor transaction of an atomic swap
+	// that commits to the signed virtual transactions of both swap parties. The
+	// virtual transactions of the counterparty are verified against the expected
+	// addresses first. The anchor transaction is funded by the local wallet,
+	// which pays the chain fees and any BTC outputs. All inputs except the ones
+	// spent by the counterparty are signed.
+	CreateSwapPsbt(context.Context, *CreateSwapPsbtRequest) (*SwapPsbtResponse, error)
+	// tapcli: `swaps sign`
+	// SignSwapPsbt verifies the anchor transaction of an atomic swap created by
+	// the counterparty and, if it commits to all virtual transactions and pays
+	// the expected addresses and BTC outputs, signs the anchor inputs of the
+	// local virtual transaction.
+	SignSwapPsbt(context.Context, *SignSwapPsbtRequest) (*SwapPsbtResponse, error)
+	// tapcli: `swaps finalize`
+	// PublishSwapPsbt verifies and finalizes the fully signed anchor transaction
+	// of an atomic swap and broadcasts it. The transfer of the local virtual
+	// transaction (if any) is logged and its proofs are created once the anchor
+	// transaction confirms.
+	PublishSwapPsbt(context.Context, *PublishSwapPsbtRequest) (*PublishSwapPsbtResponse, error)
 	mustEmbedUnimplementedAssetWalletServer()
 }
 
@@ -189,6 +372,12 @@ func (UnimplementedAssetWalletServer) SignVirtualPsbt(context.Context, *SignVirt
 func (UnimplementedAssetWalletServer) AnchorVirtualPsbts(context.Context, *AnchorVirtualPsbtsRequest) (*taprpc.SendAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnchorVirtualPsbts not implemented")
 }
+func (UnimplementedAssetWalletServer) CommitVirtualPsbts(context.Context, *CommitVirtualPsbtsRequest) (*CommitVirtualPsbtsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitVirtualPsbts not implemented")
+}
+func (UnimplementedAssetWalletServer) PublishAndLogTransfer(context.Context, *PublishAndLogTransferRequest) (*taprpc.SendAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishAndLogTransfer not implemented")
+}
 func (UnimplementedAssetWalletServer) NextInternalKey(context.Context, *NextInternalKeyRequest) (*NextInternalKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextInternalKey not implemented")
 }
@@ -204,6 +393,27 @@ func (UnimplementedAssetWalletServer) VerifyAssetOwnership(context.Context, *Ver
 func (UnimplementedAssetWalletServer) RemoveUTXOLease(context.Context, *RemoveUTXOLeaseRequest) (*RemoveUTXOLeaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUTXOLease not implemented")
 }
+func (UnimplementedAssetWalletServer) CreateMuSig2Session(context.Context, *CreateMuSig2SessionRequest) (*CreateMuSig2SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMuSig2Session not implemented")
+}
+func (UnimplementedAssetWalletServer) RegisterMuSig2Nonces(context.Context, *RegisterMuSig2NoncesRequest) (*RegisterMuSig2NoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterMuSig2Nonces not implemented")
+}
+func (UnimplementedAssetWalletServer) SignMuSig2VirtualPsbt(context.Context, *SignMuSig2VirtualPsbtRequest) (*SignMuSig2VirtualPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignMuSig2VirtualPsbt not implemented")
+}
+func (UnimplementedAssetWalletServer) CombineMuSig2VirtualPsbt(context.Context, *CombineMuSig2VirtualPsbtRequest) (*SignVirtualPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CombineMuSig2VirtualPsbt not implemented")
+}
+func (UnimplementedAssetWalletServer) CreateSwapPsbt(context.Context, *CreateSwapPsbtRequest) (*SwapPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSwapPsbt not implemented")
+}
+func (UnimplementedAssetWalletServer) SignSwapPsbt(context.Context, *SignSwapPsbtRequest) (*SwapPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSwapPsbt not implemented")
+}
+func (UnimplementedAssetWalletServer) PublishSwapPsbt(context.Context, *PublishSwapPsbtRequest) (*PublishSwapPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishSwapPsbt not implemented")
+}
 func (UnimplementedAssetWalletServer) mustEmbedUnimplementedAssetWalletServer() {}
 
 // UnsafeAssetWalletServer may be embedded to opt out of forward compatibility for this service.
@@ -271,6 +481,42 @@ func _AssetWallet_AnchorVirtualPsbts_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetWallet_CommitVirtualPsbts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitVirtualPsbtsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).CommitVirtualPsbts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/CommitVirtualPsbts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).CommitVirtualPsbts(ctx, req.(*CommitVirtualPsbtsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_PublishAndLogTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishAndLogTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).PublishAndLogTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/PublishAndLogTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).PublishAndLogTransfer(ctx, req.(*PublishAndLogTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AssetWallet_NextInternalKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NextInternalKeyRequest)
 	if err := dec(in); err != nil {
@@ -361,6 +607,132 @@ func _AssetWallet_RemoveUTXOLease_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetWallet_CreateMuSig2Session_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMuSig2SessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).CreateMuSig2Session(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/CreateMuSig2Session",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).CreateMuSig2Session(ctx, req.(*CreateMuSig2SessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_RegisterMuSig2Nonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterMuSig2NoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).RegisterMuSig2Nonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/RegisterMuSig2Nonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).RegisterMuSig2Nonces(ctx, req.(*RegisterMuSig2NoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_SignMuSig2VirtualPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMuSig2VirtualPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).SignMuSig2VirtualPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/SignMuSig2VirtualPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).SignMuSig2VirtualPsbt(ctx, req.(*SignMuSig2VirtualPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_CombineMuSig2VirtualPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombineMuSig2VirtualPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).CombineMuSig2VirtualPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/CombineMuSig2VirtualPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).CombineMuSig2VirtualPsbt(ctx, req.(*CombineMuSig2VirtualPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_CreateSwapPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSwapPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).CreateSwapPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/CreateSwapPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).CreateSwapPsbt(ctx, req.(*CreateSwapPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_SignSwapPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSwapPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).SignSwapPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/SignSwapPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).SignSwapPsbt(ctx, req.(*SignSwapPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetWallet_PublishSwapPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishSwapPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetWalletServer).PublishSwapPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/assetwalletrpc.AssetWallet/PublishSwapPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetWalletServer).PublishSwapPsbt(ctx, req.(*PublishSwapPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssetWallet_ServiceDesc is the grpc.ServiceDesc for AssetWallet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -380,6 +752,14 @@ var AssetWallet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnchorVirtualPsbts",
 			Handler:    _AssetWallet_AnchorVirtualPsbts_Handler,
 		},
+		{
+			MethodName: "CommitVirtualPsbts",
+			Handler:    _AssetWallet_CommitVirtualPsbts_Handler,
+		},
+		{
+			MethodName: "PublishAndLogTransfer",
+			Handler:    _AssetWallet_PublishAndLogTransfer_Handler,
+		},
 		{
 			MethodName: "NextInternalKey",
 			Handler:    _AssetWallet_NextInternalKey_Handler,
@@ -400,6 +780,34 @@ var AssetWallet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveUTXOLease",
 			Handler:    _AssetWallet_RemoveUTXOLease_Handler,
 		},
+		{
+			MethodName: "CreateMuSig2Session",
+			Handler:    _AssetWallet_CreateMuSig2Session_Handler,
+		},
+		{
+			MethodName: "RegisterMuSig2Nonces",
+			Handler:    _AssetWallet_RegisterMuSig2Nonces_Handler,
+		},
+		{
+			MethodName: "SignMuSig2VirtualPsbt",
+			Handler:    _AssetWallet_SignMuSig2VirtualPsbt_Handler,
+		},
+		{
+			MethodName: "CombineMuSig2VirtualPsbt",
+			Handler:    _AssetWallet_CombineMuSig2VirtualPsbt_Handler,
+		},
+		{
+			MethodName: "CreateSwapPsbt",
+			Handler:    _AssetWallet_CreateSwapPsbt_Handler,
+		},
+		{
+			MethodName: "SignSwapPsbt",
+			Handler:    _AssetWallet_SignSwapPsbt_Handler,
+		},
+		{
+			MethodName: "PublishSwapPsbt",
+			Handler:    _AssetWallet_PublishSwapPsbt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "assetwalletrpc/assetwallet.proto",
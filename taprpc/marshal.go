@@ -3,6 +3,8 @@ package taprpc
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -50,6 +52,63 @@ func MarshalAssetVersion(version asset.Version) (AssetVersion, error) {
 	}
 }
 
+// FormatDecimalAmount formats an amount in asset units as a decimal number with
+// the given number of decimal places. An amount of 1234 with a decimal display
+// of 2 is formatted as "12.34" for example.
+func FormatDecimalAmount(amount uint64, decimalDisplay uint32) string {
+	digits := strconv.FormatUint(amount, 10)
+	if decimalDisplay == 0 {
+		return digits
+	}
+
+	// Pad the amount with leading zeros so there's at least one digit
+	// before the decimal point.
+	numDecimals := int(decimalDisplay)
+	if len(digits) <= numDecimals {
+		digits = strings.Repeat("0", numDecimals-len(digits)+1) + digits
+	}
+
+	intPart := digits[:len(digits)-numDecimals]
+	return intPart + "." + digits[len(digits)-numDecimals:]
+}
+
+// ParseDecimalAmount parses a decimal number with at most the given number of
+// decimal places into an amount in asset units. It is the inverse of
+// FormatDecimalAmount.
+func ParseDecimalAmount(amount string, decimalDisplay uint32) (uint64, error) {
+	intPart, fracPart, hasPoint := strings.Cut(amount, ".")
+
+	switch {
+	case intPart == "" || (hasPoint && fracPart == ""):
+		return 0, fmt.Errorf("invalid decimal amount: %q", amount)
+
+	case len(fracPart) > int(decimalDisplay):
+		return 0, fmt.Errorf("amount %q has more than %d decimal "+
+			"places", amount, decimalDisplay)
+	}
+
+	// Only digits are allowed, which also rules out signs that would
+	// otherwise be accepted when parsing the amount below.
+	digits := intPart + fracPart
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid decimal amount: %q",
+				amount)
+		}
+	}
+
+	numPadding := int(decimalDisplay) - len(fracPart)
+	digits += strings.Repeat("0", numPadding)
+
+	units, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid decimal amount %q: %w", amount,
+			err)
+	}
+
+	return units, nil
+}
+
 // MarshalAsset converts an asset to its rpc representation.
 func MarshalAsset(ctx context.Context, a *asset.Asset,
 	isSpent, withWitness bool,
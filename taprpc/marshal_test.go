@@ -0,0 +1,70 @@
+package taprpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDecimalAmount tests that amounts are formatted as decimal numbers and
+// parsed back into asset units.
+func TestDecimalAmount(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		amount         uint64
+		decimalDisplay uint32
+		formatted      string
+	}{
+		{amount: 0, decimalDisplay: 0, formatted: "0"},
+		{amount: 1234, decimalDisplay: 0, formatted: "1234"},
+		{amount: 1234, decimalDisplay: 2, formatted: "12.34"},
+		{amount: 1234, decimalDisplay: 4, formatted: "0.1234"},
+		{amount: 5, decimalDisplay: 3, formatted: "0.005"},
+		{amount: 1_000_000, decimalDisplay: 6, formatted: "1.000000"},
+		{amount: 0, decimalDisplay: 2, formatted: "0.00"},
+		{
+			amount:         18_446_744_073_709_551_615,
+			decimalDisplay: 12,
+			formatted:      "18446744.073709551615",
+		},
+	}
+	for _, tc := range testCases {
+		formatted := FormatDecimalAmount(tc.amount, tc.decimalDisplay)
+		require.Equal(t, tc.formatted, formatted)
+
+		amount, err := ParseDecimalAmount(formatted, tc.decimalDisplay)
+		require.NoError(t, err)
+		require.Equal(t, tc.amount, amount)
+	}
+
+	// Fewer decimal places than the decimal display are padded.
+	amount, err := ParseDecimalAmount("12.3", 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 1230, amount)
+
+	amount, err = ParseDecimalAmount("12", 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 1200, amount)
+
+	invalidAmounts := []struct {
+		amount         string
+		decimalDisplay uint32
+	}{
+		{amount: "", decimalDisplay: 2},
+		{amount: "1.", decimalDisplay: 2},
+		{amount: ".1", decimalDisplay: 2},
+		{amount: "1.234", decimalDisplay: 2},
+		{amount: "1.5", decimalDisplay: 0},
+		{amount: "-1", decimalDisplay: 2},
+		{amount: "+1", decimalDisplay: 2},
+		{amount: "1.2.3", decimalDisplay: 2},
+		{amount: "1e3", decimalDisplay: 2},
+		{amount: "18446744073709551616", decimalDisplay: 0},
+		{amount: "184467440737095517", decimalDisplay: 2},
+	}
+	for _, tc := range invalidAmounts {
+		_, err := ParseDecimalAmount(tc.amount, tc.decimalDisplay)
+		require.Error(t, err, tc.amount)
+	}
+}
@@ -110,6 +110,20 @@ type MintAsset struct {
 	GroupAnchor string `protobuf:"bytes,6,opt,name=group_anchor,json=groupAnchor,proto3" json:"group_anchor,omitempty"`
 	// The version of asset to mint.
 	AssetVersion taprpc.AssetVersion `protobuf:"varint,7,opt,name=asset_version,json=assetVersion,proto3,enum=taprpc.AssetVersion" json:"asset_version,omitempty"`
+	// The optional raw group key of the asset, if that key is held outside of the
+	// daemon, for example in an HSM. Can be set to create a new asset group with
+	// emission enabled, or together with group_key to issue into an existing
+	// group with that raw key. The group witness of the asset must then be
+	// provided with AddGroupWitnesses before the batch is committed.
+	ExternalGroupKey []byte `protobuf:"bytes,8,opt,name=external_group_key,json=externalGroupKey,proto3" json:"external_group_key,omitempty"`
+	// The optional root of the tapscript tree the new asset group created with
+	// an external group key commits to.
+	GroupTapscriptRoot []byte `protobuf:"bytes,9,opt,name=group_tapscript_root,json=groupTapscriptRoot,proto3" json:"group_tapscript_root,omitempty"`
+	// The optional number of decimal places amounts of the asset should be
+	// displayed with. The value is committed to in the JSON meta of the asset.
+	// If no asset meta is set, a JSON meta with just this value is created. If
+	// a JSON asset meta is set, its decimal display value must match.
+	DecimalDisplay uint32 `protobuf:"varint,10,opt,name=decimal_display,json=decimalDisplay,proto3" json:"decimal_display,omitempty"`
 }
 
 func (x *MintAsset) Reset() {
@@ -193,6 +207,27 @@ func (x *MintAsset) GetAssetVersion() taprpc.AssetVersion {
 	return taprpc.AssetVersion(0)
 }
 
+func (x *MintAsset) GetExternalGroupKey() []byte {
+	if x != nil {
+		return x.ExternalGroupKey
+	}
+	return nil
+}
+
+func (x *MintAsset) GetGroupTapscriptRoot() []byte {
+	if x != nil {
+		return x.GroupTapscriptRoot
+	}
+	return nil
+}
+
+func (x *MintAsset) GetDecimalDisplay() uint32 {
+	if x != nil {
+		return x.DecimalDisplay
+	}
+	return 0
+}
+
 type MintAssetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -207,6 +242,10 @@ type MintAssetRequest struct {
 	// response. This is mainly to avoid a lot of data being transmitted and
 	// possibly printed on the command line in the case of a very large batch.
 	ShortResponse bool `protobuf:"varint,3,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+	// The optional name of the pending batch the asset should be added to. The
+	// batch is created if no pending batch with that name exists. If empty, the
+	// asset is added to the default pending batch.
+	BatchName string `protobuf:"bytes,4,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
 }
 
 func (x *MintAssetRequest) Reset() {
@@ -262,6 +301,13 @@ func (x *MintAssetRequest) GetShortResponse() bool {
 	return false
 }
 
+func (x *MintAssetRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
 type MintAssetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -326,6 +372,22 @@ type MintingBatch struct {
 	State BatchState `protobuf:"varint,3,opt,name=state,proto3,enum=mintrpc.BatchState" json:"state,omitempty"`
 	// The assets that are part of the batch.
 	Assets []*MintAsset `protobuf:"bytes,4,rep,name=assets,proto3" json:"assets,omitempty"`
+	// The minting transaction of the batch as a PSBT. Only populated if the batch
+	// has been committed. For an externally funded batch, this is the unsigned
+	// PSBT that needs to be signed by the external wallet.
+	BatchPsbt []byte `protobuf:"bytes,5,opt,name=batch_psbt,json=batchPsbt,proto3" json:"batch_psbt,omitempty"`
+	// The block height at which the pending batch is scheduled to be finalized,
+	// zero if the batch isn't scheduled by height.
+	FinalizeHeight uint32 `protobuf:"varint,6,opt,name=finalize_height,json=finalizeHeight,proto3" json:"finalize_height,omitempty"`
+	// The unix timestamp in seconds after which the pending batch is scheduled to
+	// be finalized, zero if the batch isn't scheduled by time.
+	FinalizeTime int64 `protobuf:"varint,7,opt,name=finalize_time,json=finalizeTime,proto3" json:"finalize_time,omitempty"`
+	// The name of the batch, empty for the default pending batch.
+	BatchName string `protobuf:"bytes,8,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The group witnesses of the assets in the batch that must be signed with an
+	// external group key. Only populated while the frozen batch is waiting for
+	// them to be added with AddGroupWitnesses.
+	UnsignedGroupWitnesses []*UnsignedGroupWitness `protobuf:"bytes,9,rep,name=unsigned_group_witnesses,json=unsignedGroupWitnesses,proto3" json:"unsigned_group_witnesses,omitempty"`
 }
 
 func (x *MintingBatch) Reset() {
@@ -388,6 +450,162 @@ func (x *MintingBatch) GetAssets() []*MintAsset {
 	return nil
 }
 
+func (x *MintingBatch) GetBatchPsbt() []byte {
+	if x != nil {
+		return x.BatchPsbt
+	}
+	return nil
+}
+
+func (x *MintingBatch) GetFinalizeHeight() uint32 {
+	if x != nil {
+		return x.FinalizeHeight
+	}
+	return 0
+}
+
+func (x *MintingBatch) GetFinalizeTime() int64 {
+	if x != nil {
+		return x.FinalizeTime
+	}
+	return 0
+}
+
+func (x *MintingBatch) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *MintingBatch) GetUnsignedGroupWitnesses() []*UnsignedGroupWitness {
+	if x != nil {
+		return x.UnsignedGroupWitnesses
+	}
+	return nil
+}
+
+type UnsignedGroupWitness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the asset the group witness is for.
+	AssetName string `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// The ID of the asset the group witness is for.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The raw group key held externally.
+	RawGroupKey []byte `protobuf:"bytes,3,opt,name=raw_group_key,json=rawGroupKey,proto3" json:"raw_group_key,omitempty"`
+	// The tweaked group key of the asset group.
+	TweakedGroupKey []byte `protobuf:"bytes,4,opt,name=tweaked_group_key,json=tweakedGroupKey,proto3" json:"tweaked_group_key,omitempty"`
+	// The tweak applied to the raw group key before the tapscript tweak, which is
+	// the ID of the asset that created the group.
+	SingleTweak []byte `protobuf:"bytes,5,opt,name=single_tweak,json=singleTweak,proto3" json:"single_tweak,omitempty"`
+	// The optional root of the tapscript tree of the group key.
+	TapscriptRoot []byte `protobuf:"bytes,6,opt,name=tapscript_root,json=tapscriptRoot,proto3" json:"tapscript_root,omitempty"`
+	// The serialized virtual transaction to sign.
+	VirtualTx []byte `protobuf:"bytes,7,opt,name=virtual_tx,json=virtualTx,proto3" json:"virtual_tx,omitempty"`
+	// The value of the output spent by the virtual transaction.
+	PrevOutValue int64 `protobuf:"varint,8,opt,name=prev_out_value,json=prevOutValue,proto3" json:"prev_out_value,omitempty"`
+	// The script of the output spent by the virtual transaction.
+	PrevOutScript []byte `protobuf:"bytes,9,opt,name=prev_out_script,json=prevOutScript,proto3" json:"prev_out_script,omitempty"`
+}
+
+func (x *UnsignedGroupWitness) Reset() {
+	*x = UnsignedGroupWitness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsignedGroupWitness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsignedGroupWitness) ProtoMessage() {}
+
+func (x *UnsignedGroupWitness) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsignedGroupWitness.ProtoReflect.Descriptor instead.
+func (*UnsignedGroupWitness) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnsignedGroupWitness) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *UnsignedGroupWitness) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *UnsignedGroupWitness) GetRawGroupKey() []byte {
+	if x != nil {
+		return x.RawGroupKey
+	}
+	return nil
+}
+
+func (x *UnsignedGroupWitness) GetTweakedGroupKey() []byte {
+	if x != nil {
+		return x.TweakedGroupKey
+	}
+	return nil
+}
+
+func (x *UnsignedGroupWitness) GetSingleTweak() []byte {
+	if x != nil {
+		return x.SingleTweak
+	}
+	return nil
+}
+
+func (x *UnsignedGroupWitness) GetTapscriptRoot() []byte {
+	if x != nil {
+		return x.TapscriptRoot
+	}
+	return nil
+}
+
+func (x *UnsignedGroupWitness) GetVirtualTx() []byte {
+	if x != nil {
+		return x.VirtualTx
+	}
+	return nil
+}
+
+func (x *UnsignedGroupWitness) GetPrevOutValue() int64 {
+	if x != nil {
+		return x.PrevOutValue
+	}
+	return 0
+}
+
+func (x *UnsignedGroupWitness) GetPrevOutScript() []byte {
+	if x != nil {
+		return x.PrevOutScript
+	}
+	return nil
+}
+
 type FinalizeBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -399,12 +617,40 @@ type FinalizeBatchRequest struct {
 	ShortResponse bool `protobuf:"varint,1,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
 	// The optional fee rate to use for the minting transaction, in sat/kw.
 	FeeRate uint32 `protobuf:"varint,2,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// The optional confirmation target used to estimate the fee rate of the
+	// minting transaction. Can't be set together with fee_rate.
+	ConfTarget uint32 `protobuf:"varint,3,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	// The optional list of wallet UTXOs (txid:vout) used to fund the minting
+	// transaction. If set, the first one becomes the genesis point of all assets
+	// in the batch and no other inputs are added.
+	GenesisInputs []string `protobuf:"bytes,4,rep,name=genesis_inputs,json=genesisInputs,proto3" json:"genesis_inputs,omitempty"`
+	// The optional minting transaction funded by an external wallet, as a PSBT.
+	// It must contain a genesis output of 1000 sats paying to the dummy script
+	// OP_1 OP_PUSHBYTES_32 <32 zero bytes>, and at most one change output. The
+	// UTXO information of all inputs must be included. If set, the batch is
+	// neither funded nor signed by the backing lnd wallet, so none of the other
+	// funding options can be set. The returned batch then contains the unsigned
+	// PSBT with the asset commitment output, which needs to be signed externally
+	// and published with PublishSignedBatch.
+	ExternalGenesisPsbt []byte `protobuf:"bytes,5,opt,name=external_genesis_psbt,json=externalGenesisPsbt,proto3" json:"external_genesis_psbt,omitempty"`
+	// The optional name of the pending batch to finalize. If empty, the default
+	// pending batch is finalized.
+	BatchName string `protobuf:"bytes,6,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// If true, the batch isn't finalized. Instead, the minting transaction is
+	// funded and the assets are created without anything being signed, broadcast
+	// or stored, and a preview of the batch is returned. The batch stays pending,
+	// and the wallet inputs locked to fund the minting transaction are unlocked
+	// again. The preview is only meant to check the seedlings and estimate the
+	// fee: the finalized batch uses other script keys and group keys, and unless
+	// genesis_inputs are set, another genesis point and therefore other asset
+	// IDs.
+	DryRun bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (x *FinalizeBatchRequest) Reset() {
 	*x = FinalizeBatchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[4]
+		mi := &file_mintrpc_mint_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -417,7 +663,7 @@ func (x *FinalizeBatchRequest) String() string {
 func (*FinalizeBatchRequest) ProtoMessage() {}
 
 func (x *FinalizeBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[4]
+	mi := &file_mintrpc_mint_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,7 +676,7 @@ func (x *FinalizeBatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeBatchRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeBatchRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{4}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FinalizeBatchRequest) GetShortResponse() bool {
@@ -447,19 +693,56 @@ func (x *FinalizeBatchRequest) GetFeeRate() uint32 {
 	return 0
 }
 
+func (x *FinalizeBatchRequest) GetConfTarget() uint32 {
+	if x != nil {
+		return x.ConfTarget
+	}
+	return 0
+}
+
+func (x *FinalizeBatchRequest) GetGenesisInputs() []string {
+	if x != nil {
+		return x.GenesisInputs
+	}
+	return nil
+}
+
+func (x *FinalizeBatchRequest) GetExternalGenesisPsbt() []byte {
+	if x != nil {
+		return x.ExternalGenesisPsbt
+	}
+	return nil
+}
+
+func (x *FinalizeBatchRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *FinalizeBatchRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 type FinalizeBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The finalized batch.
+	// The finalized batch, or the pending batch for a dry run.
 	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	// The preview of the batch. Only populated for a dry run.
+	Preview *BatchPreview `protobuf:"bytes,2,opt,name=preview,proto3" json:"preview,omitempty"`
 }
 
 func (x *FinalizeBatchResponse) Reset() {
 	*x = FinalizeBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[5]
+		mi := &file_mintrpc_mint_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -472,7 +755,7 @@ func (x *FinalizeBatchResponse) String() string {
 func (*FinalizeBatchResponse) ProtoMessage() {}
 
 func (x *FinalizeBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[5]
+	mi := &file_mintrpc_mint_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,7 +768,7 @@ func (x *FinalizeBatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeBatchResponse.ProtoReflect.Descriptor instead.
 func (*FinalizeBatchResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{5}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *FinalizeBatchResponse) GetBatch() *MintingBatch {
@@ -495,29 +778,56 @@ func (x *FinalizeBatchResponse) GetBatch() *MintingBatch {
 	return nil
 }
 
-type CancelBatchRequest struct {
+func (x *FinalizeBatchResponse) GetPreview() *BatchPreview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+type BatchPreview struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *CancelBatchRequest) Reset() {
-	*x = CancelBatchRequest{}
+	// The assets the batch would create.
+	Assets []*PreviewAsset `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	// The root hash of the Taproot Asset commitment of the batch. Not populated
+	// if some group witnesses must be signed externally, as the commitment also
+	// commits to the group witnesses.
+	TapCommitmentRoot []byte `protobuf:"bytes,2,opt,name=tap_commitment_root,json=tapCommitmentRoot,proto3" json:"tap_commitment_root,omitempty"`
+	// The script of the output of the minting transaction that commits to the
+	// assets. Only populated if tap_commitment_root is populated.
+	AnchorOutputScript []byte `protobuf:"bytes,3,opt,name=anchor_output_script,json=anchorOutputScript,proto3" json:"anchor_output_script,omitempty"`
+	// The index of the output that commits to the assets.
+	AnchorOutputIndex uint32 `protobuf:"varint,4,opt,name=anchor_output_index,json=anchorOutputIndex,proto3" json:"anchor_output_index,omitempty"`
+	// The estimated fee of the minting transaction, in sats.
+	ChainFees int64 `protobuf:"varint,5,opt,name=chain_fees,json=chainFees,proto3" json:"chain_fees,omitempty"`
+	// The funded but unsigned minting transaction, as a PSBT.
+	GenesisPsbt []byte `protobuf:"bytes,6,opt,name=genesis_psbt,json=genesisPsbt,proto3" json:"genesis_psbt,omitempty"`
+	// The group witnesses the batch needs to be signed with an external group
+	// key. These can't be signed for the finalized batch, which commits to other
+	// script keys.
+	UnsignedGroupWitnesses []*UnsignedGroupWitness `protobuf:"bytes,7,rep,name=unsigned_group_witnesses,json=unsignedGroupWitnesses,proto3" json:"unsigned_group_witnesses,omitempty"`
+}
+
+func (x *BatchPreview) Reset() {
+	*x = BatchPreview{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[6]
+		mi := &file_mintrpc_mint_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelBatchRequest) String() string {
+func (x *BatchPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBatchRequest) ProtoMessage() {}
+func (*BatchPreview) ProtoMessage() {}
 
-func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[6]
+func (x *BatchPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,75 +838,82 @@ func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBatchRequest.ProtoReflect.Descriptor instead.
-func (*CancelBatchRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BatchPreview.ProtoReflect.Descriptor instead.
+func (*BatchPreview) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{7}
 }
 
-type CancelBatchResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// The internal public key of the batch.
-	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+func (x *BatchPreview) GetAssets() []*PreviewAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
 }
 
-func (x *CancelBatchResponse) Reset() {
-	*x = CancelBatchResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *BatchPreview) GetTapCommitmentRoot() []byte {
+	if x != nil {
+		return x.TapCommitmentRoot
 	}
+	return nil
 }
 
-func (x *CancelBatchResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BatchPreview) GetAnchorOutputScript() []byte {
+	if x != nil {
+		return x.AnchorOutputScript
+	}
+	return nil
 }
 
-func (*CancelBatchResponse) ProtoMessage() {}
+func (x *BatchPreview) GetAnchorOutputIndex() uint32 {
+	if x != nil {
+		return x.AnchorOutputIndex
+	}
+	return 0
+}
 
-func (x *CancelBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *BatchPreview) GetChainFees() int64 {
+	if x != nil {
+		return x.ChainFees
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CancelBatchResponse.ProtoReflect.Descriptor instead.
-func (*CancelBatchResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{7}
+func (x *BatchPreview) GetGenesisPsbt() []byte {
+	if x != nil {
+		return x.GenesisPsbt
+	}
+	return nil
 }
 
-func (x *CancelBatchResponse) GetBatchKey() []byte {
+func (x *BatchPreview) GetUnsignedGroupWitnesses() []*UnsignedGroupWitness {
 	if x != nil {
-		return x.BatchKey
+		return x.UnsignedGroupWitnesses
 	}
 	return nil
 }
 
-type ListBatchRequest struct {
+type PreviewAsset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The optional batch key of the batch to list.
-	//
-	// Types that are assignable to Filter:
-	//
-	//	*ListBatchRequest_BatchKey
-	//	*ListBatchRequest_BatchKeyStr
-	Filter isListBatchRequest_Filter `protobuf_oneof:"filter"`
-}
-
-func (x *ListBatchRequest) Reset() {
-	*x = ListBatchRequest{}
+	// The name of the asset.
+	AssetName string `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// The ID of the asset.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The script key of the asset.
+	ScriptKey []byte `protobuf:"bytes,3,opt,name=script_key,json=scriptKey,proto3" json:"script_key,omitempty"`
+	// The tweaked group key of the asset, if it's grouped.
+	TweakedGroupKey []byte `protobuf:"bytes,4,opt,name=tweaked_group_key,json=tweakedGroupKey,proto3" json:"tweaked_group_key,omitempty"`
+	// The draft genesis proof file of the asset. As the minting transaction isn't
+	// confirmed, the proof is anchored in a placeholder block that only contains
+	// the unsigned minting transaction, so it can't be verified against the
+	// chain. Only populated if tap_commitment_root is populated.
+	DraftGenesisProof []byte `protobuf:"bytes,5,opt,name=draft_genesis_proof,json=draftGenesisProof,proto3" json:"draft_genesis_proof,omitempty"`
+}
+
+func (x *PreviewAsset) Reset() {
+	*x = PreviewAsset{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mintrpc_mint_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,13 +921,13 @@ func (x *ListBatchRequest) Reset() {
 	}
 }
 
-func (x *ListBatchRequest) String() string {
+func (x *PreviewAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBatchRequest) ProtoMessage() {}
+func (*PreviewAsset) ProtoMessage() {}
 
-func (x *ListBatchRequest) ProtoReflect() protoreflect.Message {
+func (x *PreviewAsset) ProtoReflect() protoreflect.Message {
 	mi := &file_mintrpc_mint_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,77 +939,1555 @@ func (x *ListBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBatchRequest.ProtoReflect.Descriptor instead.
-func (*ListBatchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewAsset.ProtoReflect.Descriptor instead.
+func (*PreviewAsset) Descriptor() ([]byte, []int) {
 	return file_mintrpc_mint_proto_rawDescGZIP(), []int{8}
 }
 
-func (m *ListBatchRequest) GetFilter() isListBatchRequest_Filter {
-	if m != nil {
-		return m.Filter
+func (x *PreviewAsset) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
 	}
-	return nil
+	return ""
 }
 
-func (x *ListBatchRequest) GetBatchKey() []byte {
-	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKey); ok {
-		return x.BatchKey
+func (x *PreviewAsset) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
 	}
 	return nil
 }
 
-func (x *ListBatchRequest) GetBatchKeyStr() string {
-	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKeyStr); ok {
-		return x.BatchKeyStr
+func (x *PreviewAsset) GetScriptKey() []byte {
+	if x != nil {
+		return x.ScriptKey
 	}
-	return ""
+	return nil
+}
+
+func (x *PreviewAsset) GetTweakedGroupKey() []byte {
+	if x != nil {
+		return x.TweakedGroupKey
+	}
+	return nil
+}
+
+func (x *PreviewAsset) GetDraftGenesisProof() []byte {
+	if x != nil {
+		return x.DraftGenesisProof
+	}
+	return nil
+}
+
+type CancelBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The optional name of the batch to cancel. If empty, the default pending
+	// batch is cancelled.
+	BatchName string `protobuf:"bytes,1,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+}
+
+func (x *CancelBatchRequest) Reset() {
+	*x = CancelBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBatchRequest) ProtoMessage() {}
+
+func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBatchRequest.ProtoReflect.Descriptor instead.
+func (*CancelBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelBatchRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+type CancelBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The internal public key of the batch.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+}
+
+func (x *CancelBatchResponse) Reset() {
+	*x = CancelBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBatchResponse) ProtoMessage() {}
+
+func (x *CancelBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBatchResponse.ProtoReflect.Descriptor instead.
+func (*CancelBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelBatchResponse) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+type ListBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The optional batch key of the batch to list.
+	//
+	// Types that are assignable to Filter:
+	//
+	//	*ListBatchRequest_BatchKey
+	//	*ListBatchRequest_BatchKeyStr
+	Filter isListBatchRequest_Filter `protobuf_oneof:"filter"`
+}
+
+func (x *ListBatchRequest) Reset() {
+	*x = ListBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchRequest) ProtoMessage() {}
+
+func (x *ListBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchRequest.ProtoReflect.Descriptor instead.
+func (*ListBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *ListBatchRequest) GetFilter() isListBatchRequest_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (x *ListBatchRequest) GetBatchKey() []byte {
+	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKey); ok {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *ListBatchRequest) GetBatchKeyStr() string {
+	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKeyStr); ok {
+		return x.BatchKeyStr
+	}
+	return ""
 }
 
 type isListBatchRequest_Filter interface {
 	isListBatchRequest_Filter()
 }
 
-type ListBatchRequest_BatchKey struct {
-	// The optional batch key of the batch to list, specified as raw bytes
-	// (gRPC only).
+type ListBatchRequest_BatchKey struct {
+	// The optional batch key of the batch to list, specified as raw bytes
+	// (gRPC only).
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
+}
+
+type ListBatchRequest_BatchKeyStr struct {
+	// The optional batch key of the batch to list, specified as a hex
+	// encoded string (use this for REST).
+	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3,oneof"`
+}
+
+func (*ListBatchRequest_BatchKey) isListBatchRequest_Filter() {}
+
+func (*ListBatchRequest_BatchKeyStr) isListBatchRequest_Filter() {}
+
+type ListBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Batches []*MintingBatch `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+	// The active recurring mints. Only populated if no batch key filter is set.
+	RecurringMints []*RecurringMint `protobuf:"bytes,2,rep,name=recurring_mints,json=recurringMints,proto3" json:"recurring_mints,omitempty"`
+}
+
+func (x *ListBatchResponse) Reset() {
+	*x = ListBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchResponse) ProtoMessage() {}
+
+func (x *ListBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchResponse.ProtoReflect.Descriptor instead.
+func (*ListBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListBatchResponse) GetBatches() []*MintingBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+func (x *ListBatchResponse) GetRecurringMints() []*RecurringMint {
+	if x != nil {
+		return x.RecurringMints
+	}
+	return nil
+}
+
+type BumpBatchFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batch key of the batch to bump the fee of.
+	//
+	// Types that are assignable to Batch:
+	//
+	//	*BumpBatchFeeRequest_BatchKey
+	//	*BumpBatchFeeRequest_BatchKeyStr
+	Batch isBumpBatchFeeRequest_Batch `protobuf_oneof:"batch"`
+	// The fee rate to use for the replacement minting transaction, in sat/kw.
+	FeeRate uint32 `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// If true, then the assets in the batch won't be returned in the response.
+	ShortResponse bool `protobuf:"varint,4,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+}
+
+func (x *BumpBatchFeeRequest) Reset() {
+	*x = BumpBatchFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpBatchFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpBatchFeeRequest) ProtoMessage() {}
+
+func (x *BumpBatchFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpBatchFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpBatchFeeRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *BumpBatchFeeRequest) GetBatch() isBumpBatchFeeRequest_Batch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (x *BumpBatchFeeRequest) GetBatchKey() []byte {
+	if x, ok := x.GetBatch().(*BumpBatchFeeRequest_BatchKey); ok {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *BumpBatchFeeRequest) GetBatchKeyStr() string {
+	if x, ok := x.GetBatch().(*BumpBatchFeeRequest_BatchKeyStr); ok {
+		return x.BatchKeyStr
+	}
+	return ""
+}
+
+func (x *BumpBatchFeeRequest) GetFeeRate() uint32 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *BumpBatchFeeRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+type isBumpBatchFeeRequest_Batch interface {
+	isBumpBatchFeeRequest_Batch()
+}
+
+type BumpBatchFeeRequest_BatchKey struct {
+	// The batch key of the batch, specified as raw bytes (gRPC only).
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
+}
+
+type BumpBatchFeeRequest_BatchKeyStr struct {
+	// The batch key of the batch, specified as a hex encoded string (use
+	// this for REST).
+	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3,oneof"`
+}
+
+func (*BumpBatchFeeRequest_BatchKey) isBumpBatchFeeRequest_Batch() {}
+
+func (*BumpBatchFeeRequest_BatchKeyStr) isBumpBatchFeeRequest_Batch() {}
+
+type BumpBatchFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batch with the replacement genesis transaction.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *BumpBatchFeeResponse) Reset() {
+	*x = BumpBatchFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpBatchFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpBatchFeeResponse) ProtoMessage() {}
+
+func (x *BumpBatchFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpBatchFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpBatchFeeResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BumpBatchFeeResponse) GetBatch() *MintingBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type PublishSignedBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batch key of the batch to publish the signed minting transaction of.
+	//
+	// Types that are assignable to Batch:
+	//
+	//	*PublishSignedBatchRequest_BatchKey
+	//	*PublishSignedBatchRequest_BatchKeyStr
+	Batch isPublishSignedBatchRequest_Batch `protobuf_oneof:"batch"`
+	// The externally signed minting transaction of the batch, as a PSBT.
+	SignedPsbt []byte `protobuf:"bytes,3,opt,name=signed_psbt,json=signedPsbt,proto3" json:"signed_psbt,omitempty"`
+	// If true, then the assets in the batch won't be returned in the response.
+	ShortResponse bool `protobuf:"varint,4,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+}
+
+func (x *PublishSignedBatchRequest) Reset() {
+	*x = PublishSignedBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishSignedBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishSignedBatchRequest) ProtoMessage() {}
+
+func (x *PublishSignedBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishSignedBatchRequest.ProtoReflect.Descriptor instead.
+func (*PublishSignedBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{15}
+}
+
+func (m *PublishSignedBatchRequest) GetBatch() isPublishSignedBatchRequest_Batch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (x *PublishSignedBatchRequest) GetBatchKey() []byte {
+	if x, ok := x.GetBatch().(*PublishSignedBatchRequest_BatchKey); ok {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *PublishSignedBatchRequest) GetBatchKeyStr() string {
+	if x, ok := x.GetBatch().(*PublishSignedBatchRequest_BatchKeyStr); ok {
+		return x.BatchKeyStr
+	}
+	return ""
+}
+
+func (x *PublishSignedBatchRequest) GetSignedPsbt() []byte {
+	if x != nil {
+		return x.SignedPsbt
+	}
+	return nil
+}
+
+func (x *PublishSignedBatchRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+type isPublishSignedBatchRequest_Batch interface {
+	isPublishSignedBatchRequest_Batch()
+}
+
+type PublishSignedBatchRequest_BatchKey struct {
+	// The batch key of the batch, specified as raw bytes (gRPC only).
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
+}
+
+type PublishSignedBatchRequest_BatchKeyStr struct {
+	// The batch key of the batch, specified as a hex encoded string (use
+	// this for REST).
+	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3,oneof"`
+}
+
+func (*PublishSignedBatchRequest_BatchKey) isPublishSignedBatchRequest_Batch() {}
+
+func (*PublishSignedBatchRequest_BatchKeyStr) isPublishSignedBatchRequest_Batch() {}
+
+type PublishSignedBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batch with the broadcast minting transaction.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *PublishSignedBatchResponse) Reset() {
+	*x = PublishSignedBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishSignedBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishSignedBatchResponse) ProtoMessage() {}
+
+func (x *PublishSignedBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishSignedBatchResponse.ProtoReflect.Descriptor instead.
+func (*PublishSignedBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PublishSignedBatchResponse) GetBatch() *MintingBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type ScheduleBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The block height at which the pending batch should be finalized. Can't be
+	// combined with finalize_time.
+	FinalizeHeight uint32 `protobuf:"varint,1,opt,name=finalize_height,json=finalizeHeight,proto3" json:"finalize_height,omitempty"`
+	// The unix timestamp in seconds after which the pending batch should be
+	// finalized. Can't be combined with finalize_height.
+	FinalizeTime int64 `protobuf:"varint,2,opt,name=finalize_time,json=finalizeTime,proto3" json:"finalize_time,omitempty"`
+	// If true, then the assets in the batch won't be returned in the response.
+	ShortResponse bool `protobuf:"varint,3,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+	// The optional name of the pending batch to schedule. If empty, the default
+	// pending batch is scheduled.
+	BatchName string `protobuf:"bytes,4,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+}
+
+func (x *ScheduleBatchRequest) Reset() {
+	*x = ScheduleBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleBatchRequest) ProtoMessage() {}
+
+func (x *ScheduleBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleBatchRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ScheduleBatchRequest) GetFinalizeHeight() uint32 {
+	if x != nil {
+		return x.FinalizeHeight
+	}
+	return 0
+}
+
+func (x *ScheduleBatchRequest) GetFinalizeTime() int64 {
+	if x != nil {
+		return x.FinalizeTime
+	}
+	return 0
+}
+
+func (x *ScheduleBatchRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+func (x *ScheduleBatchRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+type ScheduleBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The scheduled pending batch.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *ScheduleBatchResponse) Reset() {
+	*x = ScheduleBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleBatchResponse) ProtoMessage() {}
+
+func (x *ScheduleBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleBatchResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ScheduleBatchResponse) GetBatch() *MintingBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type RecurringMint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the recurring mint.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name of the asset minted in each epoch.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of units minted in each epoch.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The tweaked group key of the group the new units are minted into.
+	GroupKey []byte `protobuf:"bytes,4,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The length of an epoch in blocks, zero for time based epochs.
+	IntervalBlocks uint32 `protobuf:"varint,5,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// The length of an epoch in seconds, zero for block based epochs.
+	IntervalSeconds uint64 `protobuf:"varint,6,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// The block height at which the next units are minted, for block based
+	// epochs.
+	NextHeight uint32 `protobuf:"varint,7,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// The unix timestamp in seconds after which the next units are minted, for
+	// time based epochs.
+	NextTime int64 `protobuf:"varint,8,opt,name=next_time,json=nextTime,proto3" json:"next_time,omitempty"`
+	// The total number of epochs to mint new units in, zero if the recurring
+	// mint repeats indefinitely.
+	MaxEpochs uint32 `protobuf:"varint,9,opt,name=max_epochs,json=maxEpochs,proto3" json:"max_epochs,omitempty"`
+	// The number of epochs new units were minted in so far.
+	EpochsMinted uint32 `protobuf:"varint,10,opt,name=epochs_minted,json=epochsMinted,proto3" json:"epochs_minted,omitempty"`
+}
+
+func (x *RecurringMint) Reset() {
+	*x = RecurringMint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecurringMint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringMint) ProtoMessage() {}
+
+func (x *RecurringMint) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringMint.ProtoReflect.Descriptor instead.
+func (*RecurringMint) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RecurringMint) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RecurringMint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecurringMint) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecurringMint) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *RecurringMint) GetIntervalBlocks() uint32 {
+	if x != nil {
+		return x.IntervalBlocks
+	}
+	return 0
+}
+
+func (x *RecurringMint) GetIntervalSeconds() uint64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *RecurringMint) GetNextHeight() uint32 {
+	if x != nil {
+		return x.NextHeight
+	}
+	return 0
+}
+
+func (x *RecurringMint) GetNextTime() int64 {
+	if x != nil {
+		return x.NextTime
+	}
+	return 0
+}
+
+func (x *RecurringMint) GetMaxEpochs() uint32 {
+	if x != nil {
+		return x.MaxEpochs
+	}
+	return 0
+}
+
+func (x *RecurringMint) GetEpochsMinted() uint32 {
+	if x != nil {
+		return x.EpochsMinted
+	}
+	return 0
+}
+
+type AddRecurringMintRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the asset minted in each epoch.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of units minted in each epoch. Must be 1 if the group is a
+	// group of collectibles.
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The tweaked group key of the group the new units are minted into. The
+	// daemon must be able to sign with the group key.
+	GroupKey []byte `protobuf:"bytes,3,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The length of an epoch in blocks. Exactly one of interval_blocks and
+	// interval_seconds must be set.
+	IntervalBlocks uint32 `protobuf:"varint,4,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// The length of an epoch in seconds.
+	IntervalSeconds uint64 `protobuf:"varint,5,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// The block height at which units are minted for the first time. Defaults
+	// to the end of the first epoch. Only used for block based epochs.
+	StartHeight uint32 `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// The unix timestamp in seconds after which units are minted for the first
+	// time. Defaults to the end of the first epoch. Only used for time based
+	// epochs.
+	StartTime int64 `protobuf:"varint,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// The total number of epochs to mint new units in. If zero, the recurring
+	// mint repeats until it is cancelled.
+	MaxEpochs uint32 `protobuf:"varint,8,opt,name=max_epochs,json=maxEpochs,proto3" json:"max_epochs,omitempty"`
+}
+
+func (x *AddRecurringMintRequest) Reset() {
+	*x = AddRecurringMintRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRecurringMintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRecurringMintRequest) ProtoMessage() {}
+
+func (x *AddRecurringMintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRecurringMintRequest.ProtoReflect.Descriptor instead.
+func (*AddRecurringMintRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddRecurringMintRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddRecurringMintRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddRecurringMintRequest) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *AddRecurringMintRequest) GetIntervalBlocks() uint32 {
+	if x != nil {
+		return x.IntervalBlocks
+	}
+	return 0
+}
+
+func (x *AddRecurringMintRequest) GetIntervalSeconds() uint64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *AddRecurringMintRequest) GetStartHeight() uint32 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *AddRecurringMintRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *AddRecurringMintRequest) GetMaxEpochs() uint32 {
+	if x != nil {
+		return x.MaxEpochs
+	}
+	return 0
+}
+
+type AddRecurringMintResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new recurring mint.
+	RecurringMint *RecurringMint `protobuf:"bytes,1,opt,name=recurring_mint,json=recurringMint,proto3" json:"recurring_mint,omitempty"`
+}
+
+func (x *AddRecurringMintResponse) Reset() {
+	*x = AddRecurringMintResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddRecurringMintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRecurringMintResponse) ProtoMessage() {}
+
+func (x *AddRecurringMintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRecurringMintResponse.ProtoReflect.Descriptor instead.
+func (*AddRecurringMintResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddRecurringMintResponse) GetRecurringMint() *RecurringMint {
+	if x != nil {
+		return x.RecurringMint
+	}
+	return nil
+}
+
+type CancelRecurringMintRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the recurring mint to cancel.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelRecurringMintRequest) Reset() {
+	*x = CancelRecurringMintRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRecurringMintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRecurringMintRequest) ProtoMessage() {}
+
+func (x *CancelRecurringMintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRecurringMintRequest.ProtoReflect.Descriptor instead.
+func (*CancelRecurringMintRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CancelRecurringMintRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelRecurringMintResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelRecurringMintResponse) Reset() {
+	*x = CancelRecurringMintResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRecurringMintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRecurringMintResponse) ProtoMessage() {}
+
+func (x *CancelRecurringMintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRecurringMintResponse.ProtoReflect.Descriptor instead.
+func (*CancelRecurringMintResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{23}
+}
+
+type UpdateSeedlingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the pending batch the asset is in. If empty, the asset is
+	// updated in the default pending batch.
+	BatchName string `protobuf:"bytes,1,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The name of the asset to update.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The new amount of the asset. Zero leaves the amount unchanged.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The new metadata of the asset. If not set, the meta is left unchanged.
+	AssetMeta *taprpc.AssetMeta `protobuf:"bytes,4,opt,name=asset_meta,json=assetMeta,proto3" json:"asset_meta,omitempty"`
+	// The name of the new group anchor of the asset. If empty, the group anchor
+	// is left unchanged.
+	GroupAnchor string `protobuf:"bytes,5,opt,name=group_anchor,json=groupAnchor,proto3" json:"group_anchor,omitempty"`
+	// If true, the group anchor is removed from the asset. Can't be combined with
+	// group_anchor.
+	RemoveGroupAnchor bool `protobuf:"varint,6,opt,name=remove_group_anchor,json=removeGroupAnchor,proto3" json:"remove_group_anchor,omitempty"`
+	// If true, then the assets in the batch won't be returned in the response.
+	ShortResponse bool `protobuf:"varint,7,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+}
+
+func (x *UpdateSeedlingRequest) Reset() {
+	*x = UpdateSeedlingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSeedlingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeedlingRequest) ProtoMessage() {}
+
+func (x *UpdateSeedlingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeedlingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSeedlingRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateSeedlingRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *UpdateSeedlingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSeedlingRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateSeedlingRequest) GetAssetMeta() *taprpc.AssetMeta {
+	if x != nil {
+		return x.AssetMeta
+	}
+	return nil
+}
+
+func (x *UpdateSeedlingRequest) GetGroupAnchor() string {
+	if x != nil {
+		return x.GroupAnchor
+	}
+	return ""
+}
+
+func (x *UpdateSeedlingRequest) GetRemoveGroupAnchor() bool {
+	if x != nil {
+		return x.RemoveGroupAnchor
+	}
+	return false
+}
+
+func (x *UpdateSeedlingRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+type UpdateSeedlingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pending batch with the updated asset.
+	PendingBatch *MintingBatch `protobuf:"bytes,1,opt,name=pending_batch,json=pendingBatch,proto3" json:"pending_batch,omitempty"`
+}
+
+func (x *UpdateSeedlingResponse) Reset() {
+	*x = UpdateSeedlingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSeedlingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeedlingResponse) ProtoMessage() {}
+
+func (x *UpdateSeedlingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeedlingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSeedlingResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateSeedlingResponse) GetPendingBatch() *MintingBatch {
+	if x != nil {
+		return x.PendingBatch
+	}
+	return nil
+}
+
+type RemoveSeedlingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the pending batch the asset is in. If empty, the asset is
+	// removed from the default pending batch.
+	BatchName string `protobuf:"bytes,1,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The name of the asset to remove.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// If true, then the assets in the batch won't be returned in the response.
+	ShortResponse bool `protobuf:"varint,3,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+}
+
+func (x *RemoveSeedlingRequest) Reset() {
+	*x = RemoveSeedlingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSeedlingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSeedlingRequest) ProtoMessage() {}
+
+func (x *RemoveSeedlingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSeedlingRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSeedlingRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RemoveSeedlingRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *RemoveSeedlingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemoveSeedlingRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+type RemoveSeedlingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pending batch the asset was removed from.
+	PendingBatch *MintingBatch `protobuf:"bytes,1,opt,name=pending_batch,json=pendingBatch,proto3" json:"pending_batch,omitempty"`
+}
+
+func (x *RemoveSeedlingResponse) Reset() {
+	*x = RemoveSeedlingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSeedlingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSeedlingResponse) ProtoMessage() {}
+
+func (x *RemoveSeedlingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSeedlingResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSeedlingResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveSeedlingResponse) GetPendingBatch() *MintingBatch {
+	if x != nil {
+		return x.PendingBatch
+	}
+	return nil
+}
+
+type GroupWitness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the asset the group witness is for.
+	AssetName string `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// The witness stack that spends the virtual transaction of the asset.
+	Witness [][]byte `protobuf:"bytes,2,rep,name=witness,proto3" json:"witness,omitempty"`
+}
+
+func (x *GroupWitness) Reset() {
+	*x = GroupWitness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupWitness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupWitness) ProtoMessage() {}
+
+func (x *GroupWitness) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupWitness.ProtoReflect.Descriptor instead.
+func (*GroupWitness) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GroupWitness) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *GroupWitness) GetWitness() [][]byte {
+	if x != nil {
+		return x.Witness
+	}
+	return nil
+}
+
+type AddGroupWitnessesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batch key of the batch to add the group witnesses to.
+	//
+	// Types that are assignable to Batch:
+	//
+	//	*AddGroupWitnessesRequest_BatchKey
+	//	*AddGroupWitnessesRequest_BatchKeyStr
+	Batch isAddGroupWitnessesRequest_Batch `protobuf_oneof:"batch"`
+	// The signed group witnesses of all assets that need one.
+	GroupWitnesses []*GroupWitness `protobuf:"bytes,3,rep,name=group_witnesses,json=groupWitnesses,proto3" json:"group_witnesses,omitempty"`
+	// If true, then the assets in the batch won't be returned in the response.
+	ShortResponse bool `protobuf:"varint,4,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+}
+
+func (x *AddGroupWitnessesRequest) Reset() {
+	*x = AddGroupWitnessesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddGroupWitnessesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupWitnessesRequest) ProtoMessage() {}
+
+func (x *AddGroupWitnessesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupWitnessesRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupWitnessesRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{29}
+}
+
+func (m *AddGroupWitnessesRequest) GetBatch() isAddGroupWitnessesRequest_Batch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (x *AddGroupWitnessesRequest) GetBatchKey() []byte {
+	if x, ok := x.GetBatch().(*AddGroupWitnessesRequest_BatchKey); ok {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *AddGroupWitnessesRequest) GetBatchKeyStr() string {
+	if x, ok := x.GetBatch().(*AddGroupWitnessesRequest_BatchKeyStr); ok {
+		return x.BatchKeyStr
+	}
+	return ""
+}
+
+func (x *AddGroupWitnessesRequest) GetGroupWitnesses() []*GroupWitness {
+	if x != nil {
+		return x.GroupWitnesses
+	}
+	return nil
+}
+
+func (x *AddGroupWitnessesRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+type isAddGroupWitnessesRequest_Batch interface {
+	isAddGroupWitnessesRequest_Batch()
+}
+
+type AddGroupWitnessesRequest_BatchKey struct {
+	// The batch key of the batch, specified as raw bytes (gRPC only).
 	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
 }
 
-type ListBatchRequest_BatchKeyStr struct {
-	// The optional batch key of the batch to list, specified as a hex
-	// encoded string (use this for REST).
+type AddGroupWitnessesRequest_BatchKeyStr struct {
+	// The batch key of the batch, specified as a hex encoded string (use
+	// this for REST).
 	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3,oneof"`
 }
 
-func (*ListBatchRequest_BatchKey) isListBatchRequest_Filter() {}
+func (*AddGroupWitnessesRequest_BatchKey) isAddGroupWitnessesRequest_Batch() {}
 
-func (*ListBatchRequest_BatchKeyStr) isListBatchRequest_Filter() {}
+func (*AddGroupWitnessesRequest_BatchKeyStr) isAddGroupWitnessesRequest_Batch() {}
 
-type ListBatchResponse struct {
+type AddGroupWitnessesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Batches []*MintingBatch `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+	// The batch with the signed group witnesses added.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
-func (x *ListBatchResponse) Reset() {
-	*x = ListBatchResponse{}
+func (x *AddGroupWitnessesResponse) Reset() {
+	*x = AddGroupWitnessesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[9]
+		mi := &file_mintrpc_mint_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBatchResponse) String() string {
+func (x *AddGroupWitnessesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBatchResponse) ProtoMessage() {}
+func (*AddGroupWitnessesResponse) ProtoMessage() {}
 
-func (x *ListBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[9]
+func (x *AddGroupWitnessesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,14 +2498,14 @@ func (x *ListBatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBatchResponse.ProtoReflect.Descriptor instead.
-func (*ListBatchResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AddGroupWitnessesResponse.ProtoReflect.Descriptor instead.
+func (*AddGroupWitnessesResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListBatchResponse) GetBatches() []*MintingBatch {
+func (x *AddGroupWitnessesResponse) GetBatch() *MintingBatch {
 	if x != nil {
-		return x.Batches
+		return x.Batch
 	}
 	return nil
 }
@@ -721,7 +2516,7 @@ var file_mintrpc_mint_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x1a, 0x13, 0x74,
 	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x96, 0x02, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x74, 0x6f, 0x22, 0x9f, 0x03, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
 	0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73,
 	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79,
@@ -738,96 +2533,393 @@ var file_mintrpc_mint_proto_rawDesc = []byte{
 	0x12, 0x39, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
 	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63,
 	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x10,
-	0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x28, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x4d, 0x69,
-	0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3a, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
-	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x70,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0xa1, 0x01, 0x0a, 0x0c,
-	0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69,
-	0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x22,
-	0x58, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x44, 0x0a, 0x15, 0x46, 0x69, 0x6e,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74,
-	0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22,
-	0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x61, 0x0a, 0x10, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x53,
-	0x74, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x11,
+	0x73, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61,
+	0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x44, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x22, 0x86, 0x03, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x78, 0x69, 0x64,
+	0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x50, 0x73, 0x62, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x18, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x52, 0x16, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xd7, 0x02, 0x0a,
+	0x14, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x22, 0x0a, 0x0d, 0x72, 0x61, 0x77, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x54, 0x77, 0x65,
+	0x61, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x61, 0x70, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x78, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76,
+	0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x4f, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x4f, 0x75, 0x74,
+	0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x8c, 0x02, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x73,
+	0x62, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x75, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2f, 0x0a, 0x07, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0xea, 0x02, 0x0a,
+	0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2d, 0x0a,
+	0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x74, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x74, 0x61, 0x70, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x30, 0x0a, 0x14,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61, 0x6e, 0x63,
+	0x68, 0x6f, 0x72, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x73, 0x62, 0x74,
+	0x12, 0x57, 0x0a, 0x18, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x16, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x0c, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
+	0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12,
+	0x2e, 0x0a, 0x13, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22,
+	0x33, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x61, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x53, 0x74,
+	0x72, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x85, 0x01, 0x0a, 0x11,
 	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x2f, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e,
 	0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x65, 0x73, 0x2a, 0x88, 0x02, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41,
-	0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x44, 0x4e, 0x49, 0x4e,
-	0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x42,
-	0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49,
-	0x54, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10,
-	0x04, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15,
-	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x41,
-	0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x42, 0x41, 0x54, 0x43, 0x48,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x4c, 0x49, 0x4e, 0x47, 0x5f,
-	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c, 0x42,
-	0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x52, 0x4f, 0x55,
-	0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x08, 0x32, 0xaa, 0x02,
-	0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69,
-	0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x46, 0x69,
-	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x6d, 0x69,
-	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x6e,
-	0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69,
-	0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e,
-	0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d,
+	0x69, 0x6e, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69,
+	0x6e, 0x74, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x13, 0x42, 0x75, 0x6d, 0x70, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x43, 0x0a, 0x14, 0x42,
+	0x75, 0x6d, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x22, 0xb1, 0x01, 0x0a, 0x19, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a,
+	0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79,
+	0x53, 0x74, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x73,
+	0x62, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x50, 0x73, 0x62, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x22, 0x49, 0x0a, 0x1a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0xaa, 0x01, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x15,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x22, 0xbe, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x4d, 0x69, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x4d, 0x69, 0x6e,
+	0x74, 0x65, 0x64, 0x22, 0x97, 0x02, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0x59, 0x0a,
+	0x18, 0x41, 0x64, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x72, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12,
+	0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x71, 0x0a, 0x15,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x54, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x47, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x22, 0xcf,
+	0x01, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72,
+	0x12, 0x3e, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x22, 0x48, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2a, 0x88, 0x02, 0x0a, 0x0a, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x50, 0x45, 0x44, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45,
+	0x4e, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19,
+	0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x52,
+	0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d,
+	0x45, 0x44, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06, 0x12,
+	0x22, 0x0a, 0x1e, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53,
+	0x45, 0x45, 0x44, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x53, 0x50, 0x52, 0x4f, 0x55, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x4c, 0x45, 0x44, 0x10, 0x08, 0x32, 0xe3, 0x07, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x42,
+	0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x42, 0x75, 0x6d, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46,
+	0x65, 0x65, 0x12, 0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d,
+	0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5d, 0x0a, 0x12, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e,
+	0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57,
+	0x0a, 0x10, 0x41, 0x64, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69,
+	0x6e, 0x74, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x23,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x65, 0x64,
+	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x65, 0x64,
+	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1e,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5a, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e,
+	0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -843,46 +2935,97 @@ func file_mintrpc_mint_proto_rawDescGZIP() []byte {
 }
 
 var file_mintrpc_mint_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mintrpc_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_mintrpc_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_mintrpc_mint_proto_goTypes = []interface{}{
-	(BatchState)(0),               // 0: mintrpc.BatchState
-	(*MintAsset)(nil),             // 1: mintrpc.MintAsset
-	(*MintAssetRequest)(nil),      // 2: mintrpc.MintAssetRequest
-	(*MintAssetResponse)(nil),     // 3: mintrpc.MintAssetResponse
-	(*MintingBatch)(nil),          // 4: mintrpc.MintingBatch
-	(*FinalizeBatchRequest)(nil),  // 5: mintrpc.FinalizeBatchRequest
-	(*FinalizeBatchResponse)(nil), // 6: mintrpc.FinalizeBatchResponse
-	(*CancelBatchRequest)(nil),    // 7: mintrpc.CancelBatchRequest
-	(*CancelBatchResponse)(nil),   // 8: mintrpc.CancelBatchResponse
-	(*ListBatchRequest)(nil),      // 9: mintrpc.ListBatchRequest
-	(*ListBatchResponse)(nil),     // 10: mintrpc.ListBatchResponse
-	(taprpc.AssetType)(0),         // 11: taprpc.AssetType
-	(*taprpc.AssetMeta)(nil),      // 12: taprpc.AssetMeta
-	(taprpc.AssetVersion)(0),      // 13: taprpc.AssetVersion
+	(BatchState)(0),                     // 0: mintrpc.BatchState
+	(*MintAsset)(nil),                   // 1: mintrpc.MintAsset
+	(*MintAssetRequest)(nil),            // 2: mintrpc.MintAssetRequest
+	(*MintAssetResponse)(nil),           // 3: mintrpc.MintAssetResponse
+	(*MintingBatch)(nil),                // 4: mintrpc.MintingBatch
+	(*UnsignedGroupWitness)(nil),        // 5: mintrpc.UnsignedGroupWitness
+	(*FinalizeBatchRequest)(nil),        // 6: mintrpc.FinalizeBatchRequest
+	(*FinalizeBatchResponse)(nil),       // 7: mintrpc.FinalizeBatchResponse
+	(*BatchPreview)(nil),                // 8: mintrpc.BatchPreview
+	(*PreviewAsset)(nil),                // 9: mintrpc.PreviewAsset
+	(*CancelBatchRequest)(nil),          // 10: mintrpc.CancelBatchRequest
+	(*CancelBatchResponse)(nil),         // 11: mintrpc.CancelBatchResponse
+	(*ListBatchRequest)(nil),            // 12: mintrpc.ListBatchRequest
+	(*ListBatchResponse)(nil),           // 13: mintrpc.ListBatchResponse
+	(*BumpBatchFeeRequest)(nil),         // 14: mintrpc.BumpBatchFeeRequest
+	(*BumpBatchFeeResponse)(nil),        // 15: mintrpc.BumpBatchFeeResponse
+	(*PublishSignedBatchRequest)(nil),   // 16: mintrpc.PublishSignedBatchRequest
+	(*PublishSignedBatchResponse)(nil),  // 17: mintrpc.PublishSignedBatchResponse
+	(*ScheduleBatchRequest)(nil),        // 18: mintrpc.ScheduleBatchRequest
+	(*ScheduleBatchResponse)(nil),       // 19: mintrpc.ScheduleBatchResponse
+	(*RecurringMint)(nil),               // 20: mintrpc.RecurringMint
+	(*AddRecurringMintRequest)(nil),     // 21: mintrpc.AddRecurringMintRequest
+	(*AddRecurringMintResponse)(nil),    // 22: mintrpc.AddRecurringMintResponse
+	(*CancelRecurringMintRequest)(nil),  // 23: mintrpc.CancelRecurringMintRequest
+	(*CancelRecurringMintResponse)(nil), // 24: mintrpc.CancelRecurringMintResponse
+	(*UpdateSeedlingRequest)(nil),       // 25: mintrpc.UpdateSeedlingRequest
+	(*UpdateSeedlingResponse)(nil),      // 26: mintrpc.UpdateSeedlingResponse
+	(*RemoveSeedlingRequest)(nil),       // 27: mintrpc.RemoveSeedlingRequest
+	(*RemoveSeedlingResponse)(nil),      // 28: mintrpc.RemoveSeedlingResponse
+	(*GroupWitness)(nil),                // 29: mintrpc.GroupWitness
+	(*AddGroupWitnessesRequest)(nil),    // 30: mintrpc.AddGroupWitnessesRequest
+	(*AddGroupWitnessesResponse)(nil),   // 31: mintrpc.AddGroupWitnessesResponse
+	(taprpc.AssetType)(0),               // 32: taprpc.AssetType
+	(*taprpc.AssetMeta)(nil),            // 33: taprpc.AssetMeta
+	(taprpc.AssetVersion)(0),            // 34: taprpc.AssetVersion
 }
 var file_mintrpc_mint_proto_depIdxs = []int32{
-	11, // 0: mintrpc.MintAsset.asset_type:type_name -> taprpc.AssetType
-	12, // 1: mintrpc.MintAsset.asset_meta:type_name -> taprpc.AssetMeta
-	13, // 2: mintrpc.MintAsset.asset_version:type_name -> taprpc.AssetVersion
+	32, // 0: mintrpc.MintAsset.asset_type:type_name -> taprpc.AssetType
+	33, // 1: mintrpc.MintAsset.asset_meta:type_name -> taprpc.AssetMeta
+	34, // 2: mintrpc.MintAsset.asset_version:type_name -> taprpc.AssetVersion
 	1,  // 3: mintrpc.MintAssetRequest.asset:type_name -> mintrpc.MintAsset
 	4,  // 4: mintrpc.MintAssetResponse.pending_batch:type_name -> mintrpc.MintingBatch
 	0,  // 5: mintrpc.MintingBatch.state:type_name -> mintrpc.BatchState
 	1,  // 6: mintrpc.MintingBatch.assets:type_name -> mintrpc.MintAsset
-	4,  // 7: mintrpc.FinalizeBatchResponse.batch:type_name -> mintrpc.MintingBatch
-	4,  // 8: mintrpc.ListBatchResponse.batches:type_name -> mintrpc.MintingBatch
-	2,  // 9: mintrpc.Mint.MintAsset:input_type -> mintrpc.MintAssetRequest
-	5,  // 10: mintrpc.Mint.FinalizeBatch:input_type -> mintrpc.FinalizeBatchRequest
-	7,  // 11: mintrpc.Mint.CancelBatch:input_type -> mintrpc.CancelBatchRequest
-	9,  // 12: mintrpc.Mint.ListBatches:input_type -> mintrpc.ListBatchRequest
-	3,  // 13: mintrpc.Mint.MintAsset:output_type -> mintrpc.MintAssetResponse
-	6,  // 14: mintrpc.Mint.FinalizeBatch:output_type -> mintrpc.FinalizeBatchResponse
-	8,  // 15: mintrpc.Mint.CancelBatch:output_type -> mintrpc.CancelBatchResponse
-	10, // 16: mintrpc.Mint.ListBatches:output_type -> mintrpc.ListBatchResponse
-	13, // [13:17] is the sub-list for method output_type
-	9,  // [9:13] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	5,  // 7: mintrpc.MintingBatch.unsigned_group_witnesses:type_name -> mintrpc.UnsignedGroupWitness
+	4,  // 8: mintrpc.FinalizeBatchResponse.batch:type_name -> mintrpc.MintingBatch
+	8,  // 9: mintrpc.FinalizeBatchResponse.preview:type_name -> mintrpc.BatchPreview
+	9,  // 10: mintrpc.BatchPreview.assets:type_name -> mintrpc.PreviewAsset
+	5,  // 11: mintrpc.BatchPreview.unsigned_group_witnesses:type_name -> mintrpc.UnsignedGroupWitness
+	4,  // 12: mintrpc.ListBatchResponse.batches:type_name -> mintrpc.MintingBatch
+	20, // 13: mintrpc.ListBatchResponse.recurring_mints:type_name -> mintrpc.RecurringMint
+	4,  // 14: mintrpc.BumpBatchFeeResponse.batch:type_name -> mintrpc.MintingBatch
+	4,  // 15: mintrpc.PublishSignedBatchResponse.batch:type_name -> mintrpc.MintingBatch
+	4,  // 16: mintrpc.ScheduleBatchResponse.batch:type_name -> mintrpc.MintingBatch
+	20, // 17: mintrpc.AddRecurringMintResponse.recurring_mint:type_name -> mintrpc.RecurringMint
+	33, // 18: mintrpc.UpdateSeedlingRequest.asset_meta:type_name -> taprpc.AssetMeta
+	4,  // 19: mintrpc.UpdateSeedlingResponse.pending_batch:type_name -> mintrpc.MintingBatch
+	4,  // 20: mintrpc.RemoveSeedlingResponse.pending_batch:type_name -> mintrpc.MintingBatch
+	29, // 21: mintrpc.AddGroupWitnessesRequest.group_witnesses:type_name -> mintrpc.GroupWitness
+	4,  // 22: mintrpc.AddGroupWitnessesResponse.batch:type_name -> mintrpc.MintingBatch
+	2,  // 23: mintrpc.Mint.MintAsset:input_type -> mintrpc.MintAssetRequest
+	6,  // 24: mintrpc.Mint.FinalizeBatch:input_type -> mintrpc.FinalizeBatchRequest
+	10, // 25: mintrpc.Mint.CancelBatch:input_type -> mintrpc.CancelBatchRequest
+	12, // 26: mintrpc.Mint.ListBatches:input_type -> mintrpc.ListBatchRequest
+	14, // 27: mintrpc.Mint.BumpBatchFee:input_type -> mintrpc.BumpBatchFeeRequest
+	16, // 28: mintrpc.Mint.PublishSignedBatch:input_type -> mintrpc.PublishSignedBatchRequest
+	18, // 29: mintrpc.Mint.ScheduleBatch:input_type -> mintrpc.ScheduleBatchRequest
+	21, // 30: mintrpc.Mint.AddRecurringMint:input_type -> mintrpc.AddRecurringMintRequest
+	23, // 31: mintrpc.Mint.CancelRecurringMint:input_type -> mintrpc.CancelRecurringMintRequest
+	25, // 32: mintrpc.Mint.UpdateSeedling:input_type -> mintrpc.UpdateSeedlingRequest
+	27, // 33: mintrpc.Mint.RemoveSeedling:input_type -> mintrpc.RemoveSeedlingRequest
+	30, // 34: mintrpc.Mint.AddGroupWitnesses:input_type -> mintrpc.AddGroupWitnessesRequest
+	3,  // 35: mintrpc.Mint.MintAsset:output_type -> mintrpc.MintAssetResponse
+	7,  // 36: mintrpc.Mint.FinalizeBatch:output_type -> mintrpc.FinalizeBatchResponse
+	11, // 37: mintrpc.Mint.CancelBatch:output_type -> mintrpc.CancelBatchResponse
+	13, // 38: mintrpc.Mint.ListBatches:output_type -> mintrpc.ListBatchResponse
+	15, // 39: mintrpc.Mint.BumpBatchFee:output_type -> mintrpc.BumpBatchFeeResponse
+	17, // 40: mintrpc.Mint.PublishSignedBatch:output_type -> mintrpc.PublishSignedBatchResponse
+	19, // 41: mintrpc.Mint.ScheduleBatch:output_type -> mintrpc.ScheduleBatchResponse
+	22, // 42: mintrpc.Mint.AddRecurringMint:output_type -> mintrpc.AddRecurringMintResponse
+	24, // 43: mintrpc.Mint.CancelRecurringMint:output_type -> mintrpc.CancelRecurringMintResponse
+	26, // 44: mintrpc.Mint.UpdateSeedling:output_type -> mintrpc.UpdateSeedlingResponse
+	28, // 45: mintrpc.Mint.RemoveSeedling:output_type -> mintrpc.RemoveSeedlingResponse
+	31, // 46: mintrpc.Mint.AddGroupWitnesses:output_type -> mintrpc.AddGroupWitnessesResponse
+	35, // [35:47] is the sub-list for method output_type
+	23, // [23:35] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_mintrpc_mint_proto_init() }
@@ -940,7 +3083,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinalizeBatchRequest); i {
+			switch v := v.(*UnsignedGroupWitness); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -952,7 +3095,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinalizeBatchResponse); i {
+			switch v := v.(*FinalizeBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -964,7 +3107,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBatchRequest); i {
+			switch v := v.(*FinalizeBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -976,7 +3119,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBatchResponse); i {
+			switch v := v.(*BatchPreview); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -988,7 +3131,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBatchRequest); i {
+			switch v := v.(*PreviewAsset); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1000,6 +3143,42 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListBatchResponse); i {
 			case 0:
 				return &v.state
@@ -1011,18 +3190,246 @@ func file_mintrpc_mint_proto_init() {
 				return nil
 			}
 		}
+		file_mintrpc_mint_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpBatchFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpBatchFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishSignedBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishSignedBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecurringMint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRecurringMintRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddRecurringMintResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelRecurringMintRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelRecurringMintResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSeedlingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSeedlingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSeedlingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSeedlingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupWitness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGroupWitnessesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGroupWitnessesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_mintrpc_mint_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_mintrpc_mint_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*ListBatchRequest_BatchKey)(nil),
 		(*ListBatchRequest_BatchKeyStr)(nil),
 	}
+	file_mintrpc_mint_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*BumpBatchFeeRequest_BatchKey)(nil),
+		(*BumpBatchFeeRequest_BatchKeyStr)(nil),
+	}
+	file_mintrpc_mint_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*PublishSignedBatchRequest_BatchKey)(nil),
+		(*PublishSignedBatchRequest_BatchKeyStr)(nil),
+	}
+	file_mintrpc_mint_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*AddGroupWitnessesRequest_BatchKey)(nil),
+		(*AddGroupWitnessesRequest_BatchKeyStr)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mintrpc_mint_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,6 +35,47 @@ type MintClient interface {
 	// ListBatches lists the set of batches submitted to the daemon, including
 	// pending and cancelled batches.
 	ListBatches(ctx context.Context, in *ListBatchRequest, opts ...grpc.CallOption) (*ListBatchResponse, error)
+	// tapcli: `assets mint bumpfee`
+	// BumpBatchFee will attempt to replace the broadcast but unconfirmed genesis
+	// transaction of a batch with a transaction that pays a higher fee rate. The
+	// replacement spends the same genesis input, so the IDs of the assets in the
+	// batch stay the same.
+	BumpBatchFee(ctx context.Context, in *BumpBatchFeeRequest, opts ...grpc.CallOption) (*BumpBatchFeeResponse, error)
+	// tapcli: `assets mint publish`
+	// PublishSignedBatch publishes the signed minting transaction of a batch that
+	// was finalized with an externally funded minting transaction. The signed
+	// transaction must spend the same genesis input and create the same asset
+	// commitment output as the unsigned one returned by FinalizeBatch.
+	PublishSignedBatch(ctx context.Context, in *PublishSignedBatchRequest, opts ...grpc.CallOption) (*PublishSignedBatchResponse, error)
+	// tapcli: `assets mint schedule`
+	// ScheduleBatch schedules the current pending batch to be finalized once the
+	// given block height or time is reached. A request without either removes
+	// the schedule from the pending batch.
+	ScheduleBatch(ctx context.Context, in *ScheduleBatchRequest, opts ...grpc.CallOption) (*ScheduleBatchResponse, error)
+	// tapcli: `assets mint recurring add`
+	// AddRecurringMint adds a recurring mint that mints new units of an existing
+	// asset group once per epoch. An epoch is either a number of blocks or a
+	// duration. At the end of each epoch, the new units are added to the pending
+	// batch, which is then finalized. Recurring mints are listed by ListBatches.
+	AddRecurringMint(ctx context.Context, in *AddRecurringMintRequest, opts ...grpc.CallOption) (*AddRecurringMintResponse, error)
+	// tapcli: `assets mint recurring cancel`
+	// CancelRecurringMint cancels a recurring mint, so no further units are
+	// minted for it.
+	CancelRecurringMint(ctx context.Context, in *CancelRecurringMintRequest, opts ...grpc.CallOption) (*CancelRecurringMintResponse, error)
+	// tapcli: `assets mint update`
+	// UpdateSeedling changes the amount, meta or group anchor of an asset in a
+	// pending batch, before the batch is frozen.
+	UpdateSeedling(ctx context.Context, in *UpdateSeedlingRequest, opts ...grpc.CallOption) (*UpdateSeedlingResponse, error)
+	// tapcli: `assets mint remove`
+	// RemoveSeedling removes an asset from a pending batch, before the batch is
+	// frozen.
+	RemoveSeedling(ctx context.Context, in *RemoveSeedlingRequest, opts ...grpc.CallOption) (*RemoveSeedlingResponse, error)
+	// tapcli: `assets mint witness`
+	// AddGroupWitnesses adds the externally signed group witnesses of the assets
+	// in a frozen batch that were minted with an external group key. The batch
+	// returned by FinalizeBatch contains the unsigned virtual transactions to
+	// sign. Once all witnesses are verified, the minting of the batch continues.
+	AddGroupWitnesses(ctx context.Context, in *AddGroupWitnessesRequest, opts ...grpc.CallOption) (*AddGroupWitnessesResponse, error)
 }
 
 type mintClient struct {
@@ -81,6 +122,78 @@ func (c *mintClient) ListBatches(ctx context.Context, in *ListBatchRequest, opts
 	return out, nil
 }
 
+func (c *mintClient) BumpBatchFee(ctx context.Context, in *BumpBatchFeeRequest, opts ...grpc.CallOption) (*BumpBatchFeeResponse, error) {
+	out := new(BumpBatchFeeResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/BumpBatchFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) PublishSignedBatch(ctx context.Context, in *PublishSignedBatchRequest, opts ...grpc.CallOption) (*PublishSignedBatchResponse, error) {
+	out := new(PublishSignedBatchResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/PublishSignedBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) ScheduleBatch(ctx context.Context, in *ScheduleBatchRequest, opts ...grpc.CallOption) (*ScheduleBatchResponse, error) {
+	out := new(ScheduleBatchResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ScheduleBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) AddRecurringMint(ctx context.Context, in *AddRecurringMintRequest, opts ...grpc.CallOption) (*AddRecurringMintResponse, error) {
+	out := new(AddRecurringMintResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/AddRecurringMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) CancelRecurringMint(ctx context.Context, in *CancelRecurringMintRequest, opts ...grpc.CallOption) (*CancelRecurringMintResponse, error) {
+	out := new(CancelRecurringMintResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/CancelRecurringMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) UpdateSeedling(ctx context.Context, in *UpdateSeedlingRequest, opts ...grpc.CallOption) (*UpdateSeedlingResponse, error) {
+	out := new(UpdateSeedlingResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/UpdateSeedling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) RemoveSeedling(ctx context.Context, in *RemoveSeedlingRequest, opts ...grpc.CallOption) (*RemoveSeedlingResponse, error) {
+	out := new(RemoveSeedlingResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/RemoveSeedling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) AddGroupWitnesses(ctx context.Context, in *AddGroupWitnessesRequest, opts ...grpc.CallOption) (*AddGroupWitnessesResponse, error) {
+	out := new(AddGroupWitnessesResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/AddGroupWitnesses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MintServer is the server API for Mint service.
 // All implementations must embed UnimplementedMintServer
 // for forward compatibility
@@ -102,6 +215,47 @@ type MintServer interface {
 	// ListBatches lists the set of batches submitted to the daemon, including
 	// pending and cancelled batches.
 	ListBatches(context.Context, *ListBatchRequest) (*ListBatchResponse, error)
+	// tapcli: `assets mint bumpfee`
+	// BumpBatchFee will attempt to replace the broadcast but unconfirmed genesis
+	// transaction of a batch with a transaction that pays a higher fee rate. The
+	// replacement spends the same genesis input, so the IDs of the assets in the
+	// batch stay the same.
+	BumpBatchFee(context.Context, *BumpBatchFeeRequest) (*BumpBatchFeeResponse, error)
+	// tapcli: `assets mint publish`
+	// PublishSignedBatch publishes the signed minting transaction of a batch that
+	// was finalized with an externally funded minting transaction. The signed
+	// transaction must spend the same genesis input and create the same asset
+	// commitment output as the unsigned one returned by FinalizeBatch.
+	PublishSignedBatch(context.Context, *PublishSignedBatchRequest) (*PublishSignedBatchResponse, error)
+	// tapcli: `assets mint schedule`
+	// ScheduleBatch schedules the current pending batch to be finalized once the
+	// given block height or time is reached. A request without either removes
+	// the schedule from the pending batch.
+	ScheduleBatch(context.Context, *ScheduleBatchRequest) (*ScheduleBatchResponse, error)
+	// tapcli: `assets mint recurring add`
+	// AddRecurringMint adds a recurring mint that mints new units of an existing
+	// asset group once per epoch. An epoch is either a number of blocks or a
+	// duration. At the end of each epoch, the new units are added to the pending
+	// batch, which is then finalized. Recurring mints are listed by ListBatches.
+	AddRecurringMint(context.Context, *AddRecurringMintRequest) (*AddRecurringMintResponse, error)
+	// tapcli: `assets mint recurring cancel`
+	// CancelRecurringMint cancels a recurring mint, so no further units are
+	// minted for it.
+	CancelRecurringMint(context.Context, *CancelRecurringMintRequest) (*CancelRecurringMintResponse, error)
+	// tapcli: `assets mint update`
+	// UpdateSeedling changes the amount, meta or group anchor of an asset in a
+	// pending batch, before the batch is frozen.
+	UpdateSeedling(context.Context, *UpdateSeedlingRequest) (*UpdateSeedlingResponse, error)
+	// tapcli: `assets mint remove`
+	// RemoveSeedling removes an asset from a pending batch, before the batch is
+	// frozen.
+	RemoveSeedling(context.Context, *RemoveSeedlingRequest) (*RemoveSeedlingResponse, error)
+	// tapcli: `assets mint witness`
+	// AddGroupWitnesses adds the externally signed group witnesses of the assets
+	// in a frozen batch that were minted with an external group key. The batch
+	// returned by FinalizeBatch contains the unsigned virtual transactions to
+	// sign. Once all witnesses are verified, the minting of the batch continues.
+	AddGroupWitnesses(context.Context, *AddGroupWitnessesRequest) (*AddGroupWitnessesResponse, error)
 	mustEmbedUnimplementedMintServer()
 }
 
@@ -121,6 +275,30 @@ func (UnimplementedMintServer) CancelBatch(context.Context, *CancelBatchRequest)
 func (UnimplementedMintServer) ListBatches(context.Context, *ListBatchRequest) (*ListBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBatches not implemented")
 }
+func (UnimplementedMintServer) BumpBatchFee(context.Context, *BumpBatchFeeRequest) (*BumpBatchFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpBatchFee not implemented")
+}
+func (UnimplementedMintServer) PublishSignedBatch(context.Context, *PublishSignedBatchRequest) (*PublishSignedBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishSignedBatch not implemented")
+}
+func (UnimplementedMintServer) ScheduleBatch(context.Context, *ScheduleBatchRequest) (*ScheduleBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleBatch not implemented")
+}
+func (UnimplementedMintServer) AddRecurringMint(context.Context, *AddRecurringMintRequest) (*AddRecurringMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRecurringMint not implemented")
+}
+func (UnimplementedMintServer) CancelRecurringMint(context.Context, *CancelRecurringMintRequest) (*CancelRecurringMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringMint not implemented")
+}
+func (UnimplementedMintServer) UpdateSeedling(context.Context, *UpdateSeedlingRequest) (*UpdateSeedlingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeedling not implemented")
+}
+func (UnimplementedMintServer) RemoveSeedling(context.Context, *RemoveSeedlingRequest) (*RemoveSeedlingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSeedling not implemented")
+}
+func (UnimplementedMintServer) AddGroupWitnesses(context.Context, *AddGroupWitnessesRequest) (*AddGroupWitnessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupWitnesses not implemented")
+}
 func (UnimplementedMintServer) mustEmbedUnimplementedMintServer() {}
 
 // UnsafeMintServer may be embedded to opt out of forward compatibility for this service.
@@ -206,6 +384,150 @@ func _Mint_ListBatches_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mint_BumpBatchFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpBatchFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).BumpBatchFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/BumpBatchFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).BumpBatchFee(ctx, req.(*BumpBatchFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_PublishSignedBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishSignedBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).PublishSignedBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/PublishSignedBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).PublishSignedBatch(ctx, req.(*PublishSignedBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_ScheduleBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).ScheduleBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/ScheduleBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).ScheduleBatch(ctx, req.(*ScheduleBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_AddRecurringMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRecurringMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).AddRecurringMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/AddRecurringMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).AddRecurringMint(ctx, req.(*AddRecurringMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_CancelRecurringMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRecurringMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).CancelRecurringMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/CancelRecurringMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).CancelRecurringMint(ctx, req.(*CancelRecurringMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_UpdateSeedling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeedlingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).UpdateSeedling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/UpdateSeedling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).UpdateSeedling(ctx, req.(*UpdateSeedlingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_RemoveSeedling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSeedlingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).RemoveSeedling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/RemoveSeedling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).RemoveSeedling(ctx, req.(*RemoveSeedlingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_AddGroupWitnesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupWitnessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).AddGroupWitnesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/AddGroupWitnesses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).AddGroupWitnesses(ctx, req.(*AddGroupWitnessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Mint_ServiceDesc is the grpc.ServiceDesc for Mint service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +551,38 @@ var Mint_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBatches",
 			Handler:    _Mint_ListBatches_Handler,
 		},
+		{
+			MethodName: "BumpBatchFee",
+			Handler:    _Mint_BumpBatchFee_Handler,
+		},
+		{
+			MethodName: "PublishSignedBatch",
+			Handler:    _Mint_PublishSignedBatch_Handler,
+		},
+		{
+			MethodName: "ScheduleBatch",
+			Handler:    _Mint_ScheduleBatch_Handler,
+		},
+		{
+			MethodName: "AddRecurringMint",
+			Handler:    _Mint_AddRecurringMint_Handler,
+		},
+		{
+			MethodName: "CancelRecurringMint",
+			Handler:    _Mint_CancelRecurringMint_Handler,
+		},
+		{
+			MethodName: "UpdateSeedling",
+			Handler:    _Mint_UpdateSeedling_Handler,
+		},
+		{
+			MethodName: "RemoveSeedling",
+			Handler:    _Mint_RemoveSeedling_Handler,
+		},
+		{
+			MethodName: "AddGroupWitnesses",
+			Handler:    _Mint_AddGroupWitnesses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mintrpc/mint.proto",
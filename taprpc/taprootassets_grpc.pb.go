@@ -74,9 +74,10 @@ type TaprootAssetsClient interface {
 	ExportProof(ctx context.Context, in *ExportProofRequest, opts ...grpc.CallOption) (*ProofFile, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
-	// to complete an asset send. The method returns information w.r.t the on chain
-	// send, as well as the proof file information the receiver needs to fully
-	// receive the asset.
+	// to complete an asset send. The addresses may be for different assets, in
+	// which case all of them are paid in a single on chain transaction. The
+	// method returns information w.r.t the on chain send, as well as the proof
+	// file information the receiver needs to fully receive the asset.
 	SendAsset(ctx context.Context, in *SendAssetRequest, opts ...grpc.CallOption) (*SendAssetResponse, error)
 	// tapcli: `assets burn`
 	// BurnAsset burns the given number of units of a given asset by sending them
@@ -85,6 +86,24 @@ type TaprootAssetsClient interface {
 	// burning is such a destructive and non-reversible operation, some specific
 	// values need to be set in the request to avoid accidental burns.
 	BurnAsset(ctx context.Context, in *BurnAssetRequest, opts ...grpc.CallOption) (*BurnAssetResponse, error)
+	// tapcli: `assets bumpfee`
+	// BumpTransferFee bumps the on-chain fee of a pending asset transfer whose
+	// anchor transaction hasn't confirmed yet. The anchor transaction is either
+	// replaced by a transaction paying a higher fee (RBF) or its BTC change output
+	// is spent by a child transaction paying for both (CPFP).
+	BumpTransferFee(ctx context.Context, in *BumpTransferFeeRequest, opts ...grpc.CallOption) (*BumpTransferFeeResponse, error)
+	// tapcli: `assets abandon`
+	// AbandonTransfer abandons a pending asset transfer whose anchor transaction
+	// hasn't confirmed yet. The BTC inputs of the anchor transaction are double
+	// spent back to the wallet (or only released if requested), the transfer is
+	// removed and the input assets become spendable again.
+	AbandonTransfer(ctx context.Context, in *AbandonTransferRequest, opts ...grpc.CallOption) (*AbandonTransferResponse, error)
+	// tapcli: `assets consolidate`
+	// ConsolidateAssets merges many asset UTXOs of the same asset into a single
+	// output to the node itself, smallest UTXOs first. Any passive assets of the
+	// spent anchor outputs are carried over. The consolidation is recorded as a
+	// regular asset transfer.
+	ConsolidateAssets(ctx context.Context, in *ConsolidateAssetsRequest, opts ...grpc.CallOption) (*ConsolidateAssetsResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
@@ -251,6 +270,33 @@ func (c *taprootAssetsClient) BurnAsset(ctx context.Context, in *BurnAssetReques
 	return out, nil
 }
 
+func (c *taprootAssetsClient) BumpTransferFee(ctx context.Context, in *BumpTransferFeeRequest, opts ...grpc.CallOption) (*BumpTransferFeeResponse, error) {
+	out := new(BumpTransferFeeResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/BumpTransferFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) AbandonTransfer(ctx context.Context, in *AbandonTransferRequest, opts ...grpc.CallOption) (*AbandonTransferResponse, error) {
+	out := new(AbandonTransferResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/AbandonTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taprootAssetsClient) ConsolidateAssets(ctx context.Context, in *ConsolidateAssetsRequest, opts ...grpc.CallOption) (*ConsolidateAssetsResponse, error) {
+	out := new(ConsolidateAssetsResponse)
+	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/ConsolidateAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taprootAssetsClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
 	out := new(GetInfoResponse)
 	err := c.cc.Invoke(ctx, "/taprpc.TaprootAssets/GetInfo", in, out, opts...)
@@ -393,9 +439,10 @@ type TaprootAssetsServer interface {
 	ExportProof(context.Context, *ExportProofRequest) (*ProofFile, error)
 	// tapcli: `assets send`
 	// SendAsset uses one or multiple passed Taproot Asset address(es) to attempt
-	// to complete an asset send. The method returns information w.r.t the on chain
-	// send, as well as the proof file information the receiver needs to fully
-	// receive the asset.
+	// to complete an asset send. The addresses may be for different assets, in
+	// which case all of them are paid in a single on chain transaction. The
+	// method returns information w.r.t the on chain send, as well as the proof
+	// file information the receiver needs to fully receive the asset.
 	SendAsset(context.Context, *SendAssetRequest) (*SendAssetResponse, error)
 	// tapcli: `assets burn`
 	// BurnAsset burns the given number of units of a given asset by sending them
@@ -404,6 +451,24 @@ type TaprootAssetsServer interface {
 	// burning is such a destructive and non-reversible operation, some specific
 	// values need to be set in the request to avoid accidental burns.
 	BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error)
+	// tapcli: `assets bumpfee`
+	// BumpTransferFee bumps the on-chain fee of a pending asset transfer whose
+	// anchor transaction hasn't confirmed yet. The anchor transaction is either
+	// replaced by a transaction paying a higher fee (RBF) or its BTC change output
+	// is spent by a child transaction paying for both (CPFP).
+	BumpTransferFee(context.Context, *BumpTransferFeeRequest) (*BumpTransferFeeResponse, error)
+	// tapcli: `assets abandon`
+	// AbandonTransfer abandons a pending asset transfer whose anchor transaction
+	// hasn't confirmed yet. The BTC inputs of the anchor transaction are double
+	// spent back to the wallet (or only released if requested), the transfer is
+	// removed and the input assets become spendable again.
+	AbandonTransfer(context.Context, *AbandonTransferRequest) (*AbandonTransferResponse, error)
+	// tapcli: `assets consolidate`
+	// ConsolidateAssets merges many asset UTXOs of the same asset into a single
+	// output to the node itself, smallest UTXOs first. Any passive assets of the
+	// spent anchor outputs are carried over. The consolidation is recorded as a
+	// regular asset transfer.
+	ConsolidateAssets(context.Context, *ConsolidateAssetsRequest) (*ConsolidateAssetsResponse, error)
 	// tapcli: `getinfo`
 	// GetInfo returns the information for the node.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
@@ -471,6 +536,15 @@ func (UnimplementedTaprootAssetsServer) SendAsset(context.Context, *SendAssetReq
 func (UnimplementedTaprootAssetsServer) BurnAsset(context.Context, *BurnAssetRequest) (*BurnAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnAsset not implemented")
 }
+func (UnimplementedTaprootAssetsServer) BumpTransferFee(context.Context, *BumpTransferFeeRequest) (*BumpTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpTransferFee not implemented")
+}
+func (UnimplementedTaprootAssetsServer) AbandonTransfer(context.Context, *AbandonTransferRequest) (*AbandonTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbandonTransfer not implemented")
+}
+func (UnimplementedTaprootAssetsServer) ConsolidateAssets(context.Context, *ConsolidateAssetsRequest) (*ConsolidateAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateAssets not implemented")
+}
 func (UnimplementedTaprootAssetsServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
@@ -784,6 +858,60 @@ func _TaprootAssets_BurnAsset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaprootAssets_BumpTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpTransferFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).BumpTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/BumpTransferFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).BumpTransferFee(ctx, req.(*BumpTransferFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_AbandonTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).AbandonTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/AbandonTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).AbandonTransfer(ctx, req.(*AbandonTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaprootAssets_ConsolidateAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaprootAssetsServer).ConsolidateAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/taprpc.TaprootAssets/ConsolidateAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaprootAssetsServer).ConsolidateAssets(ctx, req.(*ConsolidateAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaprootAssets_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
@@ -933,6 +1061,18 @@ var TaprootAssets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BurnAsset",
 			Handler:    _TaprootAssets_BurnAsset_Handler,
 		},
+		{
+			MethodName: "BumpTransferFee",
+			Handler:    _TaprootAssets_BumpTransferFee_Handler,
+		},
+		{
+			MethodName: "AbandonTransfer",
+			Handler:    _TaprootAssets_AbandonTransfer_Handler,
+		},
+		{
+			MethodName: "ConsolidateAssets",
+			Handler:    _TaprootAssets_ConsolidateAssets_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _TaprootAssets_GetInfo_Handler,
@@ -38,6 +38,14 @@ type UniverseClient interface {
 	// the asset within the Taproot Asset commitment for the given asset_id or
 	// group_key.
 	AssetLeafKeys(ctx context.Context, in *AssetLeafKeysRequest, opts ...grpc.CallOption) (*AssetLeafKeyResponse, error)
+	// tapcli: `universe branch`
+	// QueryBranch queries for the node at the given path of the Universe MS-SMT
+	// tree for a given asset_id or group_key. The path is identified by a depth
+	// and a key, where the first depth bits of the key select the branch to walk
+	// down from the root. If the sub-tree below the node only contains a single
+	// leaf, then the key of that leaf is returned as well. This can be used to
+	// efficiently find the set of leaves that differ between two universes.
+	QueryBranch(ctx context.Context, in *BranchQuery, opts ...grpc.CallOption) (*BranchResponse, error)
 	// tapcli: `universe leaves`
 	// AssetLeaves queries for the set of asset leaves (the values in the Universe
 	// MS-SMT tree) for a given asset_id or group_key. These represents either
@@ -59,6 +67,22 @@ type UniverseClient interface {
 	// inserted into the database, with a new Universe root returned for the
 	// updated asset_id/group_key.
 	InsertProof(ctx context.Context, in *AssetProof, opts ...grpc.CallOption) (*AssetProofResponse, error)
+	// tapcli: `universe proofs announce`
+	// AnnounceProof announces a new issuance or transfer proof to the Universe
+	// server. If the proof isn't known yet, it's inserted into the Universe tree
+	// specified by the UniverseKey like with InsertProof. If the announcement
+	// has any hops left, the server then relays it to the members of its own
+	// federation. This is used by Universe servers to gossip new proofs across
+	// the federation.
+	AnnounceProof(ctx context.Context, in *ProofAnnouncement, opts ...grpc.CallOption) (*ProofAnnouncementResponse, error)
+	// tapcli: `universe proofs committed`
+	// QueryCommittedProof queries for the issuance proof of an asset based on
+	// its UniverseKey, along with the on-chain commitment of the issuance
+	// multiverse root the proof is part of. The commitment is the latest one that
+	// was confirmed at or below the given block height, or the latest commitment
+	// overall if no height is given. An error is returned if the multiverse
+	// changed since that commitment.
+	QueryCommittedProof(ctx context.Context, in *CommittedProofQuery, opts ...grpc.CallOption) (*CommittedProofResponse, error)
 	// tapcli: `universe info`
 	// Info returns a set of information about the current state of the Universe.
 	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
@@ -98,6 +122,29 @@ type UniverseClient interface {
 	// QueryEvents returns the number of sync and proof events for a given time
 	// period, grouped by day.
 	QueryEvents(ctx context.Context, in *QueryEventsRequest, opts ...grpc.CallOption) (*QueryEventsResponse, error)
+	// tapcli: `universe stats subscribe`
+	// SubscribeUniverseEvents registers a subscription to the stream of new
+	// leaves, root changes and sync events of the local Universe. The events can
+	// optionally be filtered by asset ID, group key and proof type. A client that
+	// reconnects can pass the cursor of the last event it received to resume the
+	// stream without missing any events.
+	SubscribeUniverseEvents(ctx context.Context, in *SubscribeUniverseEventsRequest, opts ...grpc.CallOption) (Universe_SubscribeUniverseEventsClient, error)
+	// tapcli: `universe export`
+	// ExportSnapshot exports a self-verifying snapshot of either only the set of
+	// specified universes, or all universes if none are specified. The snapshot
+	// of each universe contains all of its leaves, their proofs and the MS-SMT
+	// root they commit to. It is split into chunks of consecutive leaves, and
+	// each message of the stream carries a single chunk.
+	ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (Universe_ExportSnapshotClient, error)
+	// tapcli: `universe import`
+	// ImportSnapshot receives all chunks of the snapshot of a single universe,
+	// in order. The leaves of each chunk are verified and staged on disk as
+	// they're received. Once the last chunk was received, the leaves of the
+	// snapshot are verified against the MS-SMT root embedded in the snapshot,
+	// then all leaves that aren't yet known are inserted into the local
+	// Universe. This can be used to bootstrap a new Universe server much faster
+	// than syncing with a federation server.
+	ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (Universe_ImportSnapshotClient, error)
 	// SetFederationSyncConfig sets the configuration of the universe federation
 	// sync.
 	SetFederationSyncConfig(ctx context.Context, in *SetFederationSyncConfigRequest, opts ...grpc.CallOption) (*SetFederationSyncConfigResponse, error)
@@ -150,6 +197,15 @@ func (c *universeClient) AssetLeafKeys(ctx context.Context, in *AssetLeafKeysReq
 	return out, nil
 }
 
+func (c *universeClient) QueryBranch(ctx context.Context, in *BranchQuery, opts ...grpc.CallOption) (*BranchResponse, error) {
+	out := new(BranchResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) AssetLeaves(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AssetLeafResponse, error) {
 	out := new(AssetLeafResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/AssetLeaves", in, out, opts...)
@@ -177,6 +233,24 @@ func (c *universeClient) InsertProof(ctx context.Context, in *AssetProof, opts .
 	return out, nil
 }
 
+func (c *universeClient) AnnounceProof(ctx context.Context, in *ProofAnnouncement, opts ...grpc.CallOption) (*ProofAnnouncementResponse, error) {
+	out := new(ProofAnnouncementResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/AnnounceProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) QueryCommittedProof(ctx context.Context, in *CommittedProofQuery, opts ...grpc.CallOption) (*CommittedProofResponse, error) {
+	out := new(CommittedProofResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryCommittedProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
 	out := new(InfoResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/Info", in, out, opts...)
@@ -249,6 +323,104 @@ func (c *universeClient) QueryEvents(ctx context.Context, in *QueryEventsRequest
 	return out, nil
 }
 
+func (c *universeClient) SubscribeUniverseEvents(ctx context.Context, in *SubscribeUniverseEventsRequest, opts ...grpc.CallOption) (Universe_SubscribeUniverseEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[0], "/universerpc.Universe/SubscribeUniverseEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeSubscribeUniverseEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_SubscribeUniverseEventsClient interface {
+	Recv() (*UniverseEvent, error)
+	grpc.ClientStream
+}
+
+type universeSubscribeUniverseEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeSubscribeUniverseEventsClient) Recv() (*UniverseEvent, error) {
+	m := new(UniverseEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *universeClient) ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (Universe_ExportSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[1], "/universerpc.Universe/ExportSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeExportSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_ExportSnapshotClient interface {
+	Recv() (*UniverseSnapshot, error)
+	grpc.ClientStream
+}
+
+type universeExportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeExportSnapshotClient) Recv() (*UniverseSnapshot, error) {
+	m := new(UniverseSnapshot)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *universeClient) ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (Universe_ImportSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[2], "/universerpc.Universe/ImportSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeImportSnapshotClient{stream}
+	return x, nil
+}
+
+type Universe_ImportSnapshotClient interface {
+	Send(*ImportSnapshotRequest) error
+	CloseAndRecv() (*ImportSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type universeImportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeImportSnapshotClient) Send(m *ImportSnapshotRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *universeImportSnapshotClient) CloseAndRecv() (*ImportSnapshotResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *universeClient) SetFederationSyncConfig(ctx context.Context, in *SetFederationSyncConfigRequest, opts ...grpc.CallOption) (*SetFederationSyncConfigResponse, error) {
 	out := new(SetFederationSyncConfigResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/SetFederationSyncConfig", in, out, opts...)
@@ -291,6 +463,14 @@ type UniverseServer interface {
 	// the asset within the Taproot Asset commitment for the given asset_id or
 	// group_key.
 	AssetLeafKeys(context.Context, *AssetLeafKeysRequest) (*AssetLeafKeyResponse, error)
+	// tapcli: `universe branch`
+	// QueryBranch queries for the node at the given path of the Universe MS-SMT
+	// tree for a given asset_id or group_key. The path is identified by a depth
+	// and a key, where the first depth bits of the key select the branch to walk
+	// down from the root. If the sub-tree below the node only contains a single
+	// leaf, then the key of that leaf is returned as well. This can be used to
+	// efficiently find the set of leaves that differ between two universes.
+	QueryBranch(context.Context, *BranchQuery) (*BranchResponse, error)
 	// tapcli: `universe leaves`
 	// AssetLeaves queries for the set of asset leaves (the values in the Universe
 	// MS-SMT tree) for a given asset_id or group_key. These represents either
@@ -312,6 +492,22 @@ type UniverseServer interface {
 	// inserted into the database, with a new Universe root returned for the
 	// updated asset_id/group_key.
 	InsertProof(context.Context, *AssetProof) (*AssetProofResponse, error)
+	// tapcli: `universe proofs announce`
+	// AnnounceProof announces a new issuance or transfer proof to the Universe
+	// server. If the proof isn't known yet, it's inserted into the Universe tree
+	// specified by the UniverseKey like with InsertProof. If the announcement
+	// has any hops left, the server then relays it to the members of its own
+	// federation. This is used by Universe servers to gossip new proofs across
+	// the federation.
+	AnnounceProof(context.Context, *ProofAnnouncement) (*ProofAnnouncementResponse, error)
+	// tapcli: `universe proofs committed`
+	// QueryCommittedProof queries for the issuance proof of an asset based on
+	// its UniverseKey, along with the on-chain commitment of the issuance
+	// multiverse root the proof is part of. The commitment is the latest one that
+	// was confirmed at or below the given block height, or the latest commitment
+	// overall if no height is given. An error is returned if the multiverse
+	// changed since that commitment.
+	QueryCommittedProof(context.Context, *CommittedProofQuery) (*CommittedProofResponse, error)
 	// tapcli: `universe info`
 	// Info returns a set of information about the current state of the Universe.
 	Info(context.Context, *InfoRequest) (*InfoResponse, error)
@@ -351,6 +547,29 @@ type UniverseServer interface {
 	// QueryEvents returns the number of sync and proof events for a given time
 	// period, grouped by day.
 	QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error)
+	// tapcli: `universe stats subscribe`
+	// SubscribeUniverseEvents registers a subscription to the stream of new
+	// leaves, root changes and sync events of the local Universe. The events can
+	// optionally be filtered by asset ID, group key and proof type. A client that
+	// reconnects can pass the cursor of the last event it received to resume the
+	// stream without missing any events.
+	SubscribeUniverseEvents(*SubscribeUniverseEventsRequest, Universe_SubscribeUniverseEventsServer) error
+	// tapcli: `universe export`
+	// ExportSnapshot exports a self-verifying snapshot of either only the set of
+	// specified universes, or all universes if none are specified. The snapshot
+	// of each universe contains all of its leaves, their proofs and the MS-SMT
+	// root they commit to. It is split into chunks of consecutive leaves, and
+	// each message of the stream carries a single chunk.
+	ExportSnapshot(*ExportSnapshotRequest, Universe_ExportSnapshotServer) error
+	// tapcli: `universe import`
+	// ImportSnapshot receives all chunks of the snapshot of a single universe,
+	// in order. The leaves of each chunk are verified and staged on disk as
+	// they're received. Once the last chunk was received, the leaves of the
+	// snapshot are verified against the MS-SMT root embedded in the snapshot,
+	// then all leaves that aren't yet known are inserted into the local
+	// Universe. This can be used to bootstrap a new Universe server much faster
+	// than syncing with a federation server.
+	ImportSnapshot(Universe_ImportSnapshotServer) error
 	// SetFederationSyncConfig sets the configuration of the universe federation
 	// sync.
 	SetFederationSyncConfig(context.Context, *SetFederationSyncConfigRequest) (*SetFederationSyncConfigResponse, error)
@@ -376,6 +595,9 @@ func (UnimplementedUniverseServer) DeleteAssetRoot(context.Context, *DeleteRootQ
 func (UnimplementedUniverseServer) AssetLeafKeys(context.Context, *AssetLeafKeysRequest) (*AssetLeafKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetLeafKeys not implemented")
 }
+func (UnimplementedUniverseServer) QueryBranch(context.Context, *BranchQuery) (*BranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBranch not implemented")
+}
 func (UnimplementedUniverseServer) AssetLeaves(context.Context, *ID) (*AssetLeafResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetLeaves not implemented")
 }
@@ -385,6 +607,12 @@ func (UnimplementedUniverseServer) QueryProof(context.Context, *UniverseKey) (*A
 func (UnimplementedUniverseServer) InsertProof(context.Context, *AssetProof) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertProof not implemented")
 }
+func (UnimplementedUniverseServer) AnnounceProof(context.Context, *ProofAnnouncement) (*ProofAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceProof not implemented")
+}
+func (UnimplementedUniverseServer) QueryCommittedProof(context.Context, *CommittedProofQuery) (*CommittedProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCommittedProof not implemented")
+}
 func (UnimplementedUniverseServer) Info(context.Context, *InfoRequest) (*InfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
 }
@@ -409,6 +637,15 @@ func (UnimplementedUniverseServer) QueryAssetStats(context.Context, *AssetStatsQ
 func (UnimplementedUniverseServer) QueryEvents(context.Context, *QueryEventsRequest) (*QueryEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryEvents not implemented")
 }
+func (UnimplementedUniverseServer) SubscribeUniverseEvents(*SubscribeUniverseEventsRequest, Universe_SubscribeUniverseEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeUniverseEvents not implemented")
+}
+func (UnimplementedUniverseServer) ExportSnapshot(*ExportSnapshotRequest, Universe_ExportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSnapshot not implemented")
+}
+func (UnimplementedUniverseServer) ImportSnapshot(Universe_ImportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSnapshot not implemented")
+}
 func (UnimplementedUniverseServer) SetFederationSyncConfig(context.Context, *SetFederationSyncConfigRequest) (*SetFederationSyncConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetFederationSyncConfig not implemented")
 }
@@ -500,6 +737,24 @@ func _Universe_AssetLeafKeys_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_QueryBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BranchQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryBranch(ctx, req.(*BranchQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_AssetLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID)
 	if err := dec(in); err != nil {
@@ -554,6 +809,42 @@ func _Universe_InsertProof_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_AnnounceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProofAnnouncement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).AnnounceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/AnnounceProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).AnnounceProof(ctx, req.(*ProofAnnouncement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryCommittedProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommittedProofQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryCommittedProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryCommittedProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryCommittedProof(ctx, req.(*CommittedProofQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InfoRequest)
 	if err := dec(in); err != nil {
@@ -698,6 +989,74 @@ func _Universe_QueryEvents_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SubscribeUniverseEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeUniverseEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).SubscribeUniverseEvents(m, &universeSubscribeUniverseEventsServer{stream})
+}
+
+type Universe_SubscribeUniverseEventsServer interface {
+	Send(*UniverseEvent) error
+	grpc.ServerStream
+}
+
+type universeSubscribeUniverseEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeSubscribeUniverseEventsServer) Send(m *UniverseEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Universe_ExportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).ExportSnapshot(m, &universeExportSnapshotServer{stream})
+}
+
+type Universe_ExportSnapshotServer interface {
+	Send(*UniverseSnapshot) error
+	grpc.ServerStream
+}
+
+type universeExportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeExportSnapshotServer) Send(m *UniverseSnapshot) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Universe_ImportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UniverseServer).ImportSnapshot(&universeImportSnapshotServer{stream})
+}
+
+type Universe_ImportSnapshotServer interface {
+	SendAndClose(*ImportSnapshotResponse) error
+	Recv() (*ImportSnapshotRequest, error)
+	grpc.ServerStream
+}
+
+type universeImportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeImportSnapshotServer) SendAndClose(m *ImportSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *universeImportSnapshotServer) Recv() (*ImportSnapshotRequest, error) {
+	m := new(ImportSnapshotRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Universe_SetFederationSyncConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetFederationSyncConfigRequest)
 	if err := dec(in); err != nil {
@@ -757,6 +1116,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AssetLeafKeys",
 			Handler:    _Universe_AssetLeafKeys_Handler,
 		},
+		{
+			MethodName: "QueryBranch",
+			Handler:    _Universe_QueryBranch_Handler,
+		},
 		{
 			MethodName: "AssetLeaves",
 			Handler:    _Universe_AssetLeaves_Handler,
@@ -769,6 +1132,14 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InsertProof",
 			Handler:    _Universe_InsertProof_Handler,
 		},
+		{
+			MethodName: "AnnounceProof",
+			Handler:    _Universe_AnnounceProof_Handler,
+		},
+		{
+			MethodName: "QueryCommittedProof",
+			Handler:    _Universe_QueryCommittedProof_Handler,
+		},
 		{
 			MethodName: "Info",
 			Handler:    _Universe_Info_Handler,
@@ -810,6 +1181,22 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Universe_QueryFederationSyncConfig_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeUniverseEvents",
+			Handler:       _Universe_SubscribeUniverseEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportSnapshot",
+			Handler:       _Universe_ExportSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportSnapshot",
+			Handler:       _Universe_ImportSnapshot_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "universerpc/universe.proto",
 }
@@ -104,6 +104,21 @@ var (
 	ErrInvalidAnchorInfo = errors.New(
 		"send: invalid anchor output info",
 	)
+
+	// ErrInconsistentSequence is returned when the inputs of a virtual
+	// transaction don't all use the same sequence. An asset can only
+	// commit to a single relative lock time, so all inputs must agree.
+	ErrInconsistentSequence = errors.New(
+		"send: all inputs must use the same sequence",
+	)
+
+	// ErrLockTimeScriptVersion is returned when a virtual transaction
+	// specifies a lock time or sequence but not all of its inputs have
+	// script version 1. Only those inputs commit to the lock times of the
+	// new assets.
+	ErrLockTimeScriptVersion = errors.New(
+		"send: lock times require inputs with script version 1",
+	)
 )
 
 var (
@@ -311,6 +326,30 @@ func PrepareOutputAssets(ctx context.Context, vPkt *tappsbt.VPacket) error {
 		return fmt.Errorf("no inputs specified in virtual packet")
 	}
 
+	// The new assets commit to the lock time of the packet and the
+	// relative lock time of the inputs. Since there is only a single
+	// relative lock time per asset, all inputs need to use the same one.
+	var (
+		lockTime         = uint64(vPkt.LockTime)
+		relativeLockTime = uint64(inputs[0].Sequence)
+	)
+	for idx := range inputs {
+		if uint64(inputs[idx].Sequence) != relativeLockTime {
+			return ErrInconsistentSequence
+		}
+	}
+
+	// Only the witnesses of ScriptV1 inputs commit to the lock times of
+	// the new assets, so we can't set any if there are other inputs.
+	if lockTime != 0 || relativeLockTime != 0 {
+		for idx := range inputs {
+			scriptVersion := inputs[idx].Asset().ScriptVersion
+			if scriptVersion != asset.ScriptV1 {
+				return ErrLockTimeScriptVersion
+			}
+		}
+	}
+
 	var (
 		totalInputAmount uint64
 
@@ -521,6 +560,8 @@ func PrepareOutputAssets(ctx context.Context, vPkt *tappsbt.VPacket) error {
 		// this is now an external asset.
 		vOut.Asset = input.Asset().Copy()
 		vOut.Asset.ScriptKey = vOut.ScriptKey
+		vOut.Asset.LockTime = lockTime
+		vOut.Asset.RelativeLockTime = relativeLockTime
 
 		// Record the PrevID of the input asset in a Witness for the new
 		// asset. This Witness still needs a valid signature for the new
@@ -535,6 +576,7 @@ func PrepareOutputAssets(ctx context.Context, vPkt *tappsbt.VPacket) error {
 
 		// Adjust the version for the requested send type.
 		vOut.Asset.Version = vOut.AssetVersion
+		vOut.Asset.ScriptVersion = vOut.ScriptVersion
 
 		// We are done, since we don't need to create a split
 		// commitment.
@@ -547,10 +589,17 @@ func PrepareOutputAssets(ctx context.Context, vPkt *tappsbt.VPacket) error {
 		rootLocator   *commitment.SplitLocator
 		splitLocators []*commitment.SplitLocator
 	)
+	splitLocator := func(vOut *tappsbt.VOutput) commitment.SplitLocator {
+		locator := vOut.SplitLocator(assetID)
+		locator.LockTime = lockTime
+		locator.RelativeLockTime = relativeLockTime
+
+		return locator
+	}
 	for idx := range outputs {
 		vOut := outputs[idx]
 
-		locator := outputs[idx].SplitLocator(assetID)
+		locator := splitLocator(vOut)
 		if vOut.Type.IsSplitRoot() {
 			rootLocator = &locator
 			continue
@@ -569,7 +618,7 @@ func PrepareOutputAssets(ctx context.Context, vPkt *tappsbt.VPacket) error {
 	// Assign each of the split assets to their respective outputs.
 	for idx := range outputs {
 		vOut := outputs[idx]
-		locator := outputs[idx].SplitLocator(assetID)
+		locator := splitLocator(vOut)
 
 		splitAsset, ok := splitCommitment.SplitAssets[locator]
 		if !ok {
@@ -593,38 +642,35 @@ func PrepareOutputAssets(ctx context.Context, vPkt *tappsbt.VPacket) error {
 	return nil
 }
 
-// SignVirtualTransaction updates the new asset (the root asset located at the
-// change output in case of a non-interactive or partial amount send or the
-// full asset in case of an interactive full amount send) by creating a
-// signature over the asset transfer, verifying the transfer with the Taproot
-// Asset VM, and attaching that signature to the new Asset.
-func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
-	validator TxValidator) error {
-
-	inputs := vPkt.Inputs
-	outputs := vPkt.Outputs
+// virtualPacketTx returns the new asset that receives the witnesses of the
+// given virtual packet (the root asset located at the change output in case of
+// a non-interactive or partial amount send or the full asset in case of an
+// interactive full amount send), the set of input assets and the Taproot Asset
+// virtual transaction representing the asset transfer.
+func virtualPacketTx(vPkt *tappsbt.VPacket) (*asset.Asset,
+	commitment.InputSet, *wire.MsgTx, error) {
 
 	// If this is a split transfer, it means that the asset to be signed is
 	// the root asset, which is located at the change output.
 	isSplit, err := vPkt.HasSplitCommitment()
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
 	// Identify new output asset. For splits, the new asset that receives
 	// the signature is the one with the split root set to true.
-	newAsset := outputs[0].Asset
+	newAsset := vPkt.Outputs[0].Asset
 	if isSplit {
 		splitOut, err := vPkt.SplitRootOutput()
 		if err != nil {
-			return fmt.Errorf("no split root output found for "+
-				"split transaction: %w", err)
+			return nil, nil, nil, fmt.Errorf("no split root output "+
+				"found for split transaction: %w", err)
 		}
 		newAsset = splitOut.Asset
 	}
 
 	// Construct input set from all input assets.
-	prevAssets := make(commitment.InputSet, len(inputs))
+	prevAssets := make(commitment.InputSet, len(vPkt.Inputs))
 	for idx := range vPkt.Inputs {
 		input := vPkt.Inputs[idx]
 		prevAssets[input.PrevID] = input.Asset()
@@ -633,6 +679,96 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
 	// Create a Taproot Asset virtual transaction representing the asset
 	// transfer.
 	virtualTx, _, err := VirtualTx(newAsset, prevAssets)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return newAsset, prevAssets, virtualTx, nil
+}
+
+// InputKeySpendPacketSigHash returns the key spend path signature hash of the
+// input with the given index of the virtual transaction represented by the
+// given packet. The output assets of the packet must already be prepared. This
+// can be used to create signatures outside of the Signer interface, for
+// example with a MuSig2 signing session for an aggregate script key.
+func InputKeySpendPacketSigHash(vPkt *tappsbt.VPacket, idx int) ([]byte,
+	error) {
+
+	if idx < 0 || idx >= len(vPkt.Inputs) {
+		return nil, fmt.Errorf("invalid input index %d", idx)
+	}
+
+	newAsset, _, virtualTx, err := virtualPacketTx(vPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	vIn := vPkt.Inputs[idx]
+	return InputKeySpendSigHash(
+		virtualTx, vIn.Asset(), newAsset, uint32(idx), vIn.SighashType,
+	)
+}
+
+// InputScriptSpendPacketSigHash returns the script spend path signature hash of
+// the input with the given index of the virtual transaction represented by the
+// given packet for the given tapscript leaf. The output assets of the packet
+// must already be prepared. This can be used by other parties to create their
+// signatures for leaves that require more than one signature, which are then
+// added to the input's additional script witness stack.
+func InputScriptSpendPacketSigHash(vPkt *tappsbt.VPacket, idx int,
+	leaf txscript.TapLeaf) ([]byte, error) {
+
+	if idx < 0 || idx >= len(vPkt.Inputs) {
+		return nil, fmt.Errorf("invalid input index %d", idx)
+	}
+
+	newAsset, _, virtualTx, err := virtualPacketTx(vPkt)
+	if err != nil {
+		return nil, err
+	}
+
+	vIn := vPkt.Inputs[idx]
+	return InputScriptSpendSigHash(
+		virtualTx, vIn.Asset(), newAsset, uint32(idx), vIn.SighashType,
+		&leaf,
+	)
+}
+
+// SignVirtualTransaction updates the new asset (the root asset located at the
+// change output in case of a non-interactive or partial amount send or the
+// full asset in case of an interactive full amount send) by creating a
+// signature over the asset transfer, verifying the transfer with the Taproot
+// Asset VM, and attaching that signature to the new Asset.
+func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
+	validator TxValidator) error {
+
+	return SignVirtualTransactionWithWitnesses(vPkt, nil, signer, validator)
+}
+
+// SignVirtualTransactionWithWitnesses works like SignVirtualTransaction but
+// uses the given externally created witnesses (for example a combined MuSig2
+// signature) for the inputs with the corresponding index instead of creating
+// a signature with the signer.
+func SignVirtualTransactionWithWitnesses(vPkt *tappsbt.VPacket,
+	witnesses map[int]wire.TxWitness, signer Signer,
+	validator TxValidator) error {
+
+	inputs := vPkt.Inputs
+	outputs := vPkt.Outputs
+
+	for idx := range witnesses {
+		if idx < 0 || idx >= len(inputs) {
+			return fmt.Errorf("witness for invalid input index %d",
+				idx)
+		}
+	}
+
+	isSplit, err := vPkt.HasSplitCommitment()
+	if err != nil {
+		return err
+	}
+
+	newAsset, prevAssets, virtualTx, err := virtualPacketTx(vPkt)
 	if err != nil {
 		return err
 	}
@@ -640,12 +776,20 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
 	for idx := range inputs {
 		input := inputs[idx]
 
+		// If the witness for this input was created externally, we
+		// just attach it to the new asset.
+		if witness, ok := witnesses[idx]; ok {
+			newAsset.PrevWitnesses[idx].TxWitness = witness
+			continue
+		}
+
 		// For each input asset leaf, we need to produce a witness.
 		// Update the input of the virtual TX, generate a witness, and
 		// attach it to the copy of the new Asset.
 		virtualTxCopy := virtualTx.Copy()
 		inputSpecificVirtualTx := asset.VirtualTxWithInput(
-			virtualTxCopy, input.Asset(), uint32(idx), nil,
+			virtualTxCopy, input.Asset(), newAsset, uint32(idx),
+			nil,
 		)
 
 		// Sign the virtual transaction based on the input script
@@ -661,6 +805,70 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
 		newAsset.PrevWitnesses[idx].TxWitness = newWitness
 	}
 
+	err = validateVirtualPacket(
+		vPkt, newAsset, prevAssets, isSplit, validator,
+	)
+	if err != nil {
+		return err
+	}
+
+	// If the transfer contains no asset splits, there are no split assets
+	// to update with the signed root asset.
+	if !isSplit {
+		return nil
+	}
+
+	// Update each split asset to store the root asset with the witness
+	// attached, so the receiver can verify inclusion of the root asset.
+	for idx := range outputs {
+		splitAsset := outputs[idx].Asset
+
+		// The output that houses the root asset in case of a split has
+		// a special field for the split asset. That asset is no longer
+		// needed (and isn't committed to anywhere), but in order for it
+		// to be validated externally, we still want to include it and
+		// therefore also want to update it with the signed root asset.
+		if outputs[idx].Type.IsSplitRoot() {
+			splitAsset = outputs[idx].SplitAsset
+		}
+
+		splitCommitment := splitAsset.PrevWitnesses[0].SplitCommitment
+		splitCommitment.RootAsset = *newAsset.Copy()
+	}
+
+	return nil
+}
+
+// VerifyVirtualTransaction validates the transfer of an already signed virtual
+// packet with the Taproot Asset VM, without creating any new witnesses. This
+// can be used to verify virtual packets that were signed by another party.
+func VerifyVirtualTransaction(vPkt *tappsbt.VPacket,
+	validator TxValidator) error {
+
+	isSplit, err := vPkt.HasSplitCommitment()
+	if err != nil {
+		return err
+	}
+
+	newAsset, prevAssets, _, err := virtualPacketTx(vPkt)
+	if err != nil {
+		return err
+	}
+
+	return validateVirtualPacket(
+		vPkt, newAsset, prevAssets, isSplit, validator,
+	)
+}
+
+// validateVirtualPacket validates the transfer of the given new asset (with
+// its witnesses attached) and all split outputs of the virtual packet with the
+// Taproot Asset VM.
+func validateVirtualPacket(vPkt *tappsbt.VPacket, newAsset *asset.Asset,
+	prevAssets commitment.InputSet, isSplit bool,
+	validator TxValidator) error {
+
+	outputs := vPkt.Outputs
+
 	// Create an instance of the Taproot Asset VM and validate the transfer.
 	verifySpend := func(splitAssets []*commitment.SplitAsset) error {
 		newAssetCopy := newAsset.Copy()
@@ -668,7 +876,7 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
 	}
 
 	// If the transfer contains no asset splits, we only need to validate
-	// the new asset with its witness attached, then we can exit early.
+	// the new asset with its witness attached.
 	if !isSplit {
 		return verifySpend(nil)
 	}
@@ -692,29 +900,8 @@ func SignVirtualTransaction(vPkt *tappsbt.VPacket, signer Signer,
 			splitAssets[idx].Asset = *outputs[idx].SplitAsset
 		}
 	}
-	if err := verifySpend(splitAssets); err != nil {
-		return err
-	}
-
-	// Update each split asset to store the root asset with the witness
-	// attached, so the receiver can verify inclusion of the root asset.
-	for idx := range outputs {
-		splitAsset := outputs[idx].Asset
-
-		// The output that houses the root asset in case of a split has
-		// a special field for the split asset. That asset is no longer
-		// needed (and isn't committed to anywhere), but in order for it
-		// to be validated externally, we still want to include it and
-		// therefore also want to update it with the signed root asset.
-		if outputs[idx].Type.IsSplitRoot() {
-			splitAsset = outputs[idx].SplitAsset
-		}
-
-		splitCommitment := splitAsset.PrevWitnesses[0].SplitCommitment
-		splitCommitment.RootAsset = *newAsset.Copy()
-	}
 
-	return nil
+	return verifySpend(splitAssets)
 }
 
 // CreateOutputCommitments creates the final set of Taproot asset commitments
@@ -10,6 +10,7 @@ import (
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -308,9 +309,12 @@ func createGenesisProof(t *testing.T, state *spendData) {
 	)
 	require.NoError(t, err)
 
+	// The scenario assets commit to a lock time, so the genesis proof
+	// needs to be anchored in a block that satisfies it.
 	asset2GenesisProof := proof.Proof{
 		PrevOut:       state.asset2GenesisTx.TxIn[0].PreviousOutPoint,
 		BlockHeader:   *blockHeader,
+		BlockHeight:   100,
 		AnchorTx:      state.asset2GenesisTx,
 		TxMerkleProof: *txMerkleProof,
 		Asset:         state.asset2,
@@ -1037,6 +1041,611 @@ var signVirtualTransactionTestCases = []testCase{{
 	err: nil,
 }}
 
+// TestSignVirtualTransactionScriptPath tests that an asset locked to a script
+// key that commits to a tapscript tree can be spent through the script path,
+// both with and without a signature.
+func TestSignVirtualTransactionScriptPath(t *testing.T) {
+	t.Parallel()
+
+	preimage := []byte("hash locks are cool")
+
+	testCases := []struct {
+		name         string
+		leafIdx      int
+		signLeaf     bool
+		witnessStack wire.TxWitness
+		valid        bool
+	}{{
+		name:         "hash lock with valid preimage",
+		leafIdx:      0,
+		witnessStack: wire.TxWitness{preimage},
+		valid:        true,
+	}, {
+		name:         "hash lock with invalid preimage",
+		leafIdx:      0,
+		witnessStack: wire.TxWitness{[]byte("wrong preimage")},
+		valid:        false,
+	}, {
+		name:     "signature leaf",
+		leafIdx:  1,
+		signLeaf: true,
+		valid:    true,
+	}, {
+		name:     "signature leaf without signature",
+		leafIdx:  1,
+		signLeaf: false,
+		valid:    false,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			state := initSpendScenario(t)
+
+			// We lock the input asset to a script key that commits
+			// to a hash lock and a signature leaf.
+			leaves := []txscript.TapLeaf{
+				test.ScriptHashLock(t, preimage),
+				test.ScriptSchnorrSig(t, &state.spenderPubKey),
+			}
+			tree := txscript.AssembleTaprootScriptTree(leaves...)
+			rootHash := tree.RootNode.TapHash()
+			scriptKey := asset.NewScriptKeyTapscript(
+				state.spenderDescriptor, rootHash[:],
+			)
+
+			state.asset2.ScriptKey = scriptKey
+			state.asset2PrevID.ScriptKey = asset.ToSerialized(
+				scriptKey.PubKey,
+			)
+			state.asset2InputAssets = commitment.InputSet{
+				state.asset2PrevID: &state.asset2,
+			}
+
+			pkt := createPacket(
+				state.address1, state.asset2PrevID, state,
+				state.asset2InputAssets, false,
+			)
+			err := tapscript.PrepareOutputAssets(
+				context.Background(), pkt,
+			)
+			require.NoError(t, err)
+
+			leafProof := tree.LeafMerkleProofs[testCase.leafIdx]
+			controlBlock := leafProof.ToControlBlock(
+				&state.spenderPubKey,
+			)
+			controlBlockBytes, err := controlBlock.ToBytes()
+			require.NoError(t, err)
+
+			pkt.Inputs[0].SetScriptSpendPath(
+				leaves[testCase.leafIdx], controlBlockBytes,
+				testCase.signLeaf, testCase.witnessStack, 0,
+			)
+
+			err = tapscript.SignVirtualTransaction(
+				pkt, state.signer, state.validator,
+			)
+			if testCase.valid {
+				require.NoError(t, err)
+				return
+			}
+
+			var vmErr vm.Error
+			require.ErrorAs(t, err, &vmErr)
+			require.Equal(
+				t, vm.ErrInvalidTransferWitness, vmErr.Kind,
+			)
+		})
+	}
+}
+
+// TestSignVirtualTransactionScriptPathSigDepth tests that the signature of a
+// script path spend can be placed inside the additional witness stack, which
+// is required for leaves that expect data above the signature or that check
+// multiple signatures.
+func TestSignVirtualTransactionScriptPathSigDepth(t *testing.T) {
+	t.Parallel()
+
+	preimage := []byte("hash locks are cool")
+	preimageHash := sha256.Sum256(preimage)
+	otherPrivKey := test.RandPrivKey(t)
+
+	testCases := []struct {
+		name     string
+		leafIdx  int
+		preimage bool
+		otherSig bool
+		sigDepth uint32
+		valid    bool
+		err      string
+	}{{
+		name:     "hash lock and signature",
+		leafIdx:  0,
+		preimage: true,
+		sigDepth: 1,
+		valid:    true,
+	}, {
+		name:     "hash lock and signature in wrong slot",
+		leafIdx:  0,
+		preimage: true,
+		sigDepth: 0,
+		valid:    false,
+	}, {
+		name:     "2-of-2 signing second key",
+		leafIdx:  1,
+		otherSig: true,
+		sigDepth: 1,
+		valid:    true,
+	}, {
+		name:     "2-of-2 signing second key in wrong slot",
+		leafIdx:  1,
+		otherSig: true,
+		sigDepth: 0,
+		valid:    false,
+	}, {
+		name:     "2-of-2 signing first key",
+		leafIdx:  2,
+		otherSig: true,
+		sigDepth: 0,
+		valid:    true,
+	}, {
+		name:     "signature depth exceeds stack",
+		leafIdx:  2,
+		otherSig: true,
+		sigDepth: 2,
+		err:      "exceeds witness stack size",
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			state := initSpendScenario(t)
+
+			spenderKey := schnorr.SerializePubKey(
+				&state.spenderPubKey,
+			)
+			otherKey := schnorr.SerializePubKey(
+				otherPrivKey.PubKey(),
+			)
+
+			// The first leaf requires a pre-image above the
+			// signature, the other two are 2-of-2 multisig leaves
+			// that check the other party's signature first and
+			// last respectively.
+			hashLockSigScript, err := txscript.NewScriptBuilder().
+				AddOp(txscript.OP_SHA256).
+				AddData(preimageHash[:]).
+				AddOp(txscript.OP_EQUALVERIFY).
+				AddData(spenderKey).
+				AddOp(txscript.OP_CHECKSIG).
+				Script()
+			require.NoError(t, err)
+			multiSigScript := func(key1, key2 []byte) []byte {
+				script, err := txscript.NewScriptBuilder().
+					AddData(key1).
+					AddOp(txscript.OP_CHECKSIG).
+					AddData(key2).
+					AddOp(txscript.OP_CHECKSIGADD).
+					AddInt64(2).
+					AddOp(txscript.OP_NUMEQUAL).
+					Script()
+				require.NoError(t, err)
+
+				return script
+			}
+
+			leaves := []txscript.TapLeaf{
+				txscript.NewBaseTapLeaf(hashLockSigScript),
+				txscript.NewBaseTapLeaf(
+					multiSigScript(otherKey, spenderKey),
+				),
+				txscript.NewBaseTapLeaf(
+					multiSigScript(spenderKey, otherKey),
+				),
+			}
+			tree := txscript.AssembleTaprootScriptTree(leaves...)
+			rootHash := tree.RootNode.TapHash()
+			scriptKey := asset.NewScriptKeyTapscript(
+				state.spenderDescriptor, rootHash[:],
+			)
+
+			state.asset2.ScriptKey = scriptKey
+			state.asset2PrevID.ScriptKey = asset.ToSerialized(
+				scriptKey.PubKey,
+			)
+			state.asset2InputAssets = commitment.InputSet{
+				state.asset2PrevID: &state.asset2,
+			}
+
+			pkt := createPacket(
+				state.address1, state.asset2PrevID, state,
+				state.asset2InputAssets, false,
+			)
+			err = tapscript.PrepareOutputAssets(
+				context.Background(), pkt,
+			)
+			require.NoError(t, err)
+
+			leaf := leaves[testCase.leafIdx]
+			leafProof := tree.LeafMerkleProofs[testCase.leafIdx]
+			controlBlock := leafProof.ToControlBlock(
+				&state.spenderPubKey,
+			)
+			controlBlockBytes, err := controlBlock.ToBytes()
+			require.NoError(t, err)
+
+			var witnessStack wire.TxWitness
+			if testCase.preimage {
+				witnessStack = append(witnessStack, preimage)
+			}
+
+			// The other party signs the same script spend sighash
+			// with their key.
+			if testCase.otherSig {
+				sigHash, err := tapscript.
+					InputScriptSpendPacketSigHash(
+						pkt, 0, leaf,
+					)
+				require.NoError(t, err)
+
+				sig, err := schnorr.Sign(otherPrivKey, sigHash)
+				require.NoError(t, err)
+
+				witnessStack = append(
+					witnessStack, sig.Serialize(),
+				)
+			}
+
+			pkt.Inputs[0].SetScriptSpendPath(
+				leaf, controlBlockBytes, true, witnessStack,
+				testCase.sigDepth,
+			)
+
+			err = tapscript.SignVirtualTransaction(
+				pkt, state.signer, state.validator,
+			)
+			switch {
+			case testCase.err != "":
+				require.ErrorContains(t, err, testCase.err)
+
+			case testCase.valid:
+				require.NoError(t, err)
+
+			default:
+				var vmErr vm.Error
+				require.ErrorAs(t, err, &vmErr)
+				require.Equal(
+					t, vm.ErrInvalidTransferWitness,
+					vmErr.Kind,
+				)
+			}
+		})
+	}
+}
+
+// TestSignVirtualTransactionLockTimes tests that the lock time of a virtual
+// packet and the sequence of its ScriptV1 inputs are committed to in the
+// virtual transaction and can be enforced by asset scripts.
+func TestSignVirtualTransactionLockTimes(t *testing.T) {
+	t.Parallel()
+
+	const (
+		cltvHeight = 1000
+		csvBlocks  = 6
+	)
+
+	testCases := []struct {
+		name          string
+		scriptVersion asset.ScriptVersion
+		leafIdx       int
+		lockTime      uint32
+		sequence      uint32
+		valid         bool
+		prepareErr    error
+	}{{
+		name:          "lock time reached",
+		scriptVersion: asset.ScriptV1,
+		leafIdx:       0,
+		lockTime:      cltvHeight,
+		valid:         true,
+	}, {
+		name:          "lock time script v0 input",
+		scriptVersion: asset.ScriptV0,
+		leafIdx:       0,
+		lockTime:      cltvHeight,
+		prepareErr:    tapscript.ErrLockTimeScriptVersion,
+	}, {
+		name:          "lock time not reached",
+		scriptVersion: asset.ScriptV1,
+		leafIdx:       0,
+		lockTime:      cltvHeight - 1,
+		valid:         false,
+	}, {
+		name:          "no lock time",
+		scriptVersion: asset.ScriptV1,
+		leafIdx:       0,
+		valid:         false,
+	}, {
+		name:          "relative lock time reached",
+		scriptVersion: asset.ScriptV1,
+		leafIdx:       1,
+		sequence:      csvBlocks,
+		valid:         true,
+	}, {
+		name:          "relative lock time not reached",
+		scriptVersion: asset.ScriptV1,
+		leafIdx:       1,
+		sequence:      csvBlocks - 1,
+		valid:         false,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			state := initSpendScenario(t)
+
+			cltvScript, err := txscript.NewScriptBuilder().
+				AddInt64(cltvHeight).
+				AddOp(txscript.OP_CHECKLOCKTIMEVERIFY).
+				Script()
+			require.NoError(t, err)
+			csvScript, err := txscript.NewScriptBuilder().
+				AddInt64(csvBlocks).
+				AddOp(txscript.OP_CHECKSEQUENCEVERIFY).
+				Script()
+			require.NoError(t, err)
+
+			leaves := []txscript.TapLeaf{
+				txscript.NewBaseTapLeaf(cltvScript),
+				txscript.NewBaseTapLeaf(csvScript),
+			}
+			tree := txscript.AssembleTaprootScriptTree(leaves...)
+			rootHash := tree.RootNode.TapHash()
+			scriptKey := asset.NewScriptKeyTapscript(
+				state.spenderDescriptor, rootHash[:],
+			)
+
+			state.asset2.ScriptKey = scriptKey
+			state.asset2.ScriptVersion = testCase.scriptVersion
+			state.asset2PrevID.ScriptKey = asset.ToSerialized(
+				scriptKey.PubKey,
+			)
+			state.asset2InputAssets = commitment.InputSet{
+				state.asset2PrevID: &state.asset2,
+			}
+
+			pkt := createPacket(
+				state.address1, state.asset2PrevID, state,
+				state.asset2InputAssets, false,
+			)
+			pkt.LockTime = testCase.lockTime
+			pkt.Inputs[0].Sequence = testCase.sequence
+			for _, vOut := range pkt.Outputs {
+				vOut.ScriptVersion = asset.ScriptV1
+			}
+
+			err = tapscript.PrepareOutputAssets(
+				context.Background(), pkt,
+			)
+			if testCase.prepareErr != nil {
+				require.ErrorIs(t, err, testCase.prepareErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// All new assets must commit to the lock times and use
+			// the requested script version.
+			for _, vOut := range pkt.Outputs {
+				require.Equal(
+					t, asset.ScriptV1,
+					vOut.Asset.ScriptVersion,
+				)
+				require.EqualValues(
+					t, testCase.lockTime,
+					vOut.Asset.LockTime,
+				)
+				require.EqualValues(
+					t, testCase.sequence,
+					vOut.Asset.RelativeLockTime,
+				)
+			}
+
+			leafProof := tree.LeafMerkleProofs[testCase.leafIdx]
+			controlBlock := leafProof.ToControlBlock(
+				&state.spenderPubKey,
+			)
+			controlBlockBytes, err := controlBlock.ToBytes()
+			require.NoError(t, err)
+
+			pkt.Inputs[0].SetScriptSpendPath(
+				leaves[testCase.leafIdx], controlBlockBytes,
+				false, nil, 0,
+			)
+
+			err = tapscript.SignVirtualTransaction(
+				pkt, state.signer, state.validator,
+			)
+			if testCase.valid {
+				require.NoError(t, err)
+				return
+			}
+
+			var vmErr vm.Error
+			require.ErrorAs(t, err, &vmErr)
+			require.Equal(
+				t, vm.ErrInvalidTransferWitness, vmErr.Kind,
+			)
+		})
+	}
+}
+
+// TestSignVirtualTransactionMuSig2 tests that an input locked to a MuSig2
+// aggregate script key can be spent with a signature that was created by all
+// signers over the packet's signature hash.
+func TestSignVirtualTransactionMuSig2(t *testing.T) {
+	t.Parallel()
+
+	state := initSpendScenario(t)
+
+	// We lock the input asset to the BIP-0086 tweaked aggregate key of
+	// two signers.
+	privKey1, privKey2 := test.RandPrivKey(t), test.RandPrivKey(t)
+	signers := []*btcec.PublicKey{privKey1.PubKey(), privKey2.PubKey()}
+	aggKey, _, _, err := musig2.AggregateKeys(
+		signers, true, musig2.WithBIP86KeyTweak(),
+	)
+	require.NoError(t, err)
+
+	scriptKey := asset.ScriptKey{
+		PubKey: aggKey.FinalKey,
+		TweakedScriptKey: &asset.TweakedScriptKey{
+			RawKey: keychain.KeyDescriptor{
+				PubKey: aggKey.PreTweakedKey,
+			},
+		},
+	}
+	state.asset2.ScriptKey = scriptKey
+	state.asset2PrevID.ScriptKey = asset.ToSerialized(scriptKey.PubKey)
+	state.asset2InputAssets = commitment.InputSet{
+		state.asset2PrevID: &state.asset2,
+	}
+
+	pkt := createPacket(
+		state.address1, state.asset2PrevID, state,
+		state.asset2InputAssets, false,
+	)
+	err = tapscript.PrepareOutputAssets(context.Background(), pkt)
+	require.NoError(t, err)
+
+	sigHash, err := tapscript.InputKeySpendPacketSigHash(pkt, 0)
+	require.NoError(t, err)
+
+	var digest [32]byte
+	copy(digest[:], sigHash)
+
+	// Both signers create a session and exchange their nonces.
+	newSession := func(privKey *btcec.PrivateKey) *musig2.Session {
+		muSigCtx, err := musig2.NewContext(
+			privKey, true, musig2.WithKnownSigners(signers),
+			musig2.WithBip86TweakCtx(),
+		)
+		require.NoError(t, err)
+
+		session, err := muSigCtx.NewSession()
+		require.NoError(t, err)
+
+		return session
+	}
+	session1, session2 := newSession(privKey1), newSession(privKey2)
+
+	_, err = session1.RegisterPubNonce(session2.PublicNonce())
+	require.NoError(t, err)
+	_, err = session2.RegisterPubNonce(session1.PublicNonce())
+	require.NoError(t, err)
+
+	// A witness that isn't signed by all signers is rejected by the VM.
+	_, err = session1.Sign(digest)
+	require.NoError(t, err)
+	partialSig2, err := session2.Sign(digest)
+	require.NoError(t, err)
+
+	invalidSig, err := schnorr.Sign(privKey1, sigHash)
+	require.NoError(t, err)
+
+	invalidPkt := createPacket(
+		state.address1, state.asset2PrevID, state,
+		state.asset2InputAssets, false,
+	)
+	err = tapscript.PrepareOutputAssets(context.Background(), invalidPkt)
+	require.NoError(t, err)
+
+	err = tapscript.SignVirtualTransactionWithWitnesses(
+		invalidPkt, map[int]wire.TxWitness{
+			0: {invalidSig.Serialize()},
+		}, state.signer, state.validator,
+	)
+	var vmErr vm.Error
+	require.ErrorAs(t, err, &vmErr)
+	require.Equal(t, vm.ErrInvalidTransferWitness, vmErr.Kind)
+
+	// The combined signature of both signers is a valid witness.
+	haveAllSigs, err := session1.CombineSig(partialSig2)
+	require.NoError(t, err)
+	require.True(t, haveAllSigs)
+
+	finalSig := session1.FinalSig()
+	require.True(t, finalSig.Verify(sigHash, scriptKey.PubKey))
+
+	err = tapscript.SignVirtualTransactionWithWitnesses(
+		pkt, map[int]wire.TxWitness{
+			0: {finalSig.Serialize()},
+		}, state.signer, state.validator,
+	)
+	require.NoError(t, err)
+
+	// A witness for a non-existent input is rejected.
+	err = tapscript.SignVirtualTransactionWithWitnesses(
+		pkt, map[int]wire.TxWitness{
+			1: {finalSig.Serialize()},
+		}, state.signer, state.validator,
+	)
+	require.ErrorContains(t, err, "invalid input index")
+}
+
+// TestVerifyVirtualTransaction tests that already signed virtual packets can
+// be validated without access to the signing keys.
+func TestVerifyVirtualTransaction(t *testing.T) {
+	t.Parallel()
+
+	state := initSpendScenario(t)
+
+	pkt := createPacket(
+		state.address1, state.asset2PrevID, state,
+		state.asset2InputAssets, false,
+	)
+	err := tapscript.PrepareOutputAssets(context.Background(), pkt)
+	require.NoError(t, err)
+
+	// A packet without any witnesses is rejected.
+	err = tapscript.VerifyVirtualTransaction(pkt, state.validator)
+	require.Error(t, err)
+
+	err = tapscript.SignVirtualTransaction(
+		pkt, state.signer, state.validator,
+	)
+	require.NoError(t, err)
+
+	err = tapscript.VerifyVirtualTransaction(pkt, state.validator)
+	require.NoError(t, err)
+
+	// Replacing the witness with a signature by a different key must
+	// result in a VM error.
+	sigHash, err := tapscript.InputKeySpendPacketSigHash(pkt, 0)
+	require.NoError(t, err)
+
+	invalidSig, err := schnorr.Sign(test.RandPrivKey(t), sigHash)
+	require.NoError(t, err)
+
+	for _, vOut := range pkt.Outputs {
+		if vOut.Asset.HasSplitCommitmentWitness() {
+			continue
+		}
+
+		vOut.Asset.PrevWitnesses[0].TxWitness = wire.TxWitness{
+			invalidSig.Serialize(),
+		}
+	}
+
+	err = tapscript.VerifyVirtualTransaction(pkt, state.validator)
+	var vmErr vm.Error
+	require.ErrorAs(t, err, &vmErr)
+	require.Equal(t, vm.ErrInvalidTransferWitness, vmErr.Kind)
+}
+
 // TestCreateOutputCommitments tests edge cases around creating TapCommitments
 // to represent an asset transfer.
 func TestCreateOutputCommitments(t *testing.T) {
@@ -1669,6 +2278,7 @@ func createProofParams(t *testing.T, genesisTxIn wire.TxIn, state spendData,
 				Header:       *blockHeader,
 				Transactions: []*wire.MsgTx{spendTx},
 			},
+			BlockHeight:      101,
 			Tx:               spendTx,
 			TxIndex:          0,
 			OutputIndex:      0,
@@ -1691,6 +2301,7 @@ func createProofParams(t *testing.T, genesisTxIn wire.TxIn, state spendData,
 				Header:       *blockHeader,
 				Transactions: []*wire.MsgTx{spendTx},
 			},
+			BlockHeight:      101,
 			Tx:               spendTx,
 			TxIndex:          0,
 			OutputIndex:      1,
@@ -1761,20 +2372,20 @@ func TestProofVerify(t *testing.T) {
 	// Create a proof for each receiver and verify it.
 	senderBlob, _, err := proof.AppendTransition(
 		genesisProofBlob, &proofParams[0], proof.MockHeaderVerifier,
-		proof.MockGroupVerifier,
+		proof.MockMedianTimeSource, proof.MockGroupVerifier,
 	)
 	require.NoError(t, err)
 	senderFile := proof.NewEmptyFile(proof.V0)
 	require.NoError(t, senderFile.Decode(bytes.NewReader(senderBlob)))
 	_, err = senderFile.Verify(
 		context.TODO(), proof.MockHeaderVerifier,
-		proof.MockGroupVerifier,
+		proof.MockMedianTimeSource, proof.MockGroupVerifier,
 	)
 	require.NoError(t, err)
 
 	receiverBlob, _, err := proof.AppendTransition(
 		genesisProofBlob, &proofParams[1], proof.MockHeaderVerifier,
-		proof.MockGroupVerifier,
+		proof.MockMedianTimeSource, proof.MockGroupVerifier,
 	)
 	require.NoError(t, err)
 	receiverFile, err := proof.NewFile(proof.V0)
@@ -1782,7 +2393,7 @@ func TestProofVerify(t *testing.T) {
 	require.NoError(t, receiverFile.Decode(bytes.NewReader(receiverBlob)))
 	_, err = receiverFile.Verify(
 		context.TODO(), proof.MockHeaderVerifier,
-		proof.MockGroupVerifier,
+		proof.MockMedianTimeSource, proof.MockGroupVerifier,
 	)
 	require.NoError(t, err)
 }
@@ -1834,7 +2445,7 @@ func TestProofVerifyFullValueSplit(t *testing.T) {
 	// Create a proof for each receiver and verify it.
 	senderBlob, _, err := proof.AppendTransition(
 		genesisProofBlob, &proofParams[0], proof.MockHeaderVerifier,
-		proof.MockGroupVerifier,
+		proof.MockMedianTimeSource, proof.MockGroupVerifier,
 	)
 	require.NoError(t, err)
 	senderFile, err := proof.NewFile(proof.V0)
@@ -1842,20 +2453,20 @@ func TestProofVerifyFullValueSplit(t *testing.T) {
 	require.NoError(t, senderFile.Decode(bytes.NewReader(senderBlob)))
 	_, err = senderFile.Verify(
 		context.TODO(), proof.MockHeaderVerifier,
-		proof.MockGroupVerifier,
+		proof.MockMedianTimeSource, proof.MockGroupVerifier,
 	)
 	require.NoError(t, err)
 
 	receiverBlob, _, err := proof.AppendTransition(
 		genesisProofBlob, &proofParams[1], proof.MockHeaderVerifier,
-		proof.MockGroupVerifier,
+		proof.MockMedianTimeSource, proof.MockGroupVerifier,
 	)
 	require.NoError(t, err)
 	receiverFile := proof.NewEmptyFile(proof.V0)
 	require.NoError(t, receiverFile.Decode(bytes.NewReader(receiverBlob)))
 	_, err = receiverFile.Verify(
 		context.TODO(), proof.MockHeaderVerifier,
-		proof.MockGroupVerifier,
+		proof.MockMedianTimeSource, proof.MockGroupVerifier,
 	)
 	require.NoError(t, err)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/commitment"
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/tappsbt"
 	"github.com/lightningnetwork/lnd/input"
@@ -225,7 +226,7 @@ func VirtualTx(newAsset *asset.Asset, prevAssets commitment.InputSet) (
 // Taproot Asset virtual TX.
 func InputAssetPrevOut(prevAsset asset.Asset) (*wire.TxOut, error) {
 	switch prevAsset.ScriptVersion {
-	case asset.ScriptV0:
+	case asset.ScriptV0, asset.ScriptV1:
 		pkScript, err := PayToTaprootScript(prevAsset.ScriptKey.PubKey)
 		if err != nil {
 			return nil, err
@@ -258,10 +259,13 @@ func InputPrevOutFetcher(prevAsset asset.Asset) (*txscript.CannedPrevOutputFetch
 // InputKeySpendSigHash returns the signature hash of a virtual transaction for
 // a specific Taproot Asset input that can be spent through the key path. This
 // is the message over which signatures are generated over.
-func InputKeySpendSigHash(virtualTx *wire.MsgTx, input *asset.Asset,
-	idx uint32, sigHashType txscript.SigHashType) ([]byte, error) {
+func InputKeySpendSigHash(virtualTx *wire.MsgTx, input,
+	newAsset *asset.Asset, idx uint32,
+	sigHashType txscript.SigHashType) ([]byte, error) {
 
-	virtualTxCopy := asset.VirtualTxWithInput(virtualTx, input, idx, nil)
+	virtualTxCopy := asset.VirtualTxWithInput(
+		virtualTx, input, newAsset, idx, nil,
+	)
 	prevOutFetcher, err := InputPrevOutFetcher(*input)
 	if err != nil {
 		return nil, err
@@ -276,11 +280,13 @@ func InputKeySpendSigHash(virtualTx *wire.MsgTx, input *asset.Asset,
 // InputScriptSpendSigHash returns the signature hash of a virtual transaction
 // for a specific Taproot Asset input that can be spent through the script path.
 // This is the message over which signatures are generated over.
-func InputScriptSpendSigHash(virtualTx *wire.MsgTx, input *asset.Asset,
-	idx uint32, sigHashType txscript.SigHashType,
+func InputScriptSpendSigHash(virtualTx *wire.MsgTx, input,
+	newAsset *asset.Asset, idx uint32, sigHashType txscript.SigHashType,
 	tapLeaf *txscript.TapLeaf) ([]byte, error) {
 
-	virtualTxCopy := asset.VirtualTxWithInput(virtualTx, input, idx, nil)
+	virtualTxCopy := asset.VirtualTxWithInput(
+		virtualTx, input, newAsset, idx, nil,
+	)
 	prevOutFetcher, err := InputPrevOutFetcher(*input)
 	if err != nil {
 		return nil, err
@@ -298,6 +304,14 @@ func InputScriptSpendSigHash(virtualTx *wire.MsgTx, input *asset.Asset,
 func CreateTaprootSignature(vIn *tappsbt.VInput, virtualTx *wire.MsgTx,
 	idx int, txSigner Signer) (wire.TxWitness, error) {
 
+	// A script path spend of a leaf that doesn't require a signature (for
+	// example a hash lock) can be satisfied by revealing the leaf script
+	// and the additional witness stack elements, so there's nothing to
+	// sign.
+	if isUnsignedScriptSpend(vIn) {
+		return scriptSpendWitness(vIn, nil)
+	}
+
 	// Before we even attempt to sign anything, we need to make sure all the
 	// input information we require is present.
 	if len(vIn.TaprootBip32Derivation) == 0 {
@@ -399,18 +413,77 @@ func CreateTaprootSignature(vIn *tappsbt.VInput, virtualTx *wire.MsgTx,
 		return nil, err
 	}
 
-	witness := wire.TxWitness{sig.Serialize()}
+	sigBytes := sig.Serialize()
 	if vIn.SighashType != txscript.SigHashDefault {
-		witness[0] = append(witness[0], byte(vIn.SighashType))
+		sigBytes = append(sigBytes, byte(vIn.SighashType))
 	}
 
-	// If this was a script spend, we also have to add the script itself and
-	// the control block to the witness, otherwise the verifier will reject
-	// the generated witness.
+	// If this was a script spend, we also have to add any additional stack
+	// elements, the script itself and the control block to the witness,
+	// otherwise the verifier will reject the generated witness.
 	if spendDesc.SignMethod == input.TaprootScriptSpendSignMethod {
-		witness = append(witness, spendDesc.WitnessScript)
-		witness = append(witness, vIn.TaprootLeafScript[0].ControlBlock)
+		return scriptSpendWitness(vIn, sigBytes)
+	}
+
+	return wire.TxWitness{sigBytes}, nil
+}
+
+// isUnsignedScriptSpend returns true if the given virtual input should be spent
+// through the script path with a leaf that doesn't require a signature from the
+// script key's internal key. This is signaled by a leaf script being present
+// without the corresponding leaf hash in the Taproot BIP-0032 derivation.
+func isUnsignedScriptSpend(vIn *tappsbt.VInput) bool {
+	if len(vIn.TaprootLeafScript) != 1 {
+		return false
+	}
+
+	for _, derivation := range vIn.TaprootBip32Derivation {
+		if len(derivation.LeafHashes) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// scriptSpendWitness assembles the full script path spend witness for the
+// given virtual input. The additional witness stack elements of the input are
+// placed first, with the signature (if present) inserted below the top
+// ScriptWitnessSigDepth elements, followed by the leaf script and the control
+// block.
+func scriptSpendWitness(vIn *tappsbt.VInput, sig []byte) (wire.TxWitness,
+	error) {
+
+	leafScript := vIn.TaprootLeafScript[0]
+	stack := vIn.ScriptWitnessStack
+	sigDepth := int(vIn.ScriptWitnessSigDepth)
+
+	switch {
+	case sig == nil && sigDepth != 0:
+		return nil, fmt.Errorf("signature depth %d set for script "+
+			"spend without signature", sigDepth)
+
+	case sigDepth > len(stack):
+		return nil, fmt.Errorf("signature depth %d exceeds witness "+
+			"stack size %d", sigDepth, len(stack))
 	}
 
+	sigIdx := len(stack) - sigDepth
+	witness := make(wire.TxWitness, 0, len(stack)+3)
+	for idx, element := range stack {
+		if idx == sigIdx && sig != nil {
+			witness = append(witness, sig)
+		}
+		witness = append(witness, fn.CopySlice(element))
+	}
+
+	// A signature at depth zero goes on top of the additional elements.
+	if sigDepth == 0 && sig != nil {
+		witness = append(witness, sig)
+	}
+
+	witness = append(witness, fn.CopySlice(leafScript.Script))
+	witness = append(witness, fn.CopySlice(leafScript.ControlBlock))
+
 	return witness, nil
 }
@@ -30,10 +30,10 @@ type FederationConfig struct {
 	// periodically.
 	UniverseSyncer Syncer
 
-	// NewRemoteRegistrar is a function that returns a new register instance
-	// to the target remote Universe. This'll be used to optimistically push
-	// out new updates to Universe servers.
-	NewRemoteRegistrar func(ServerAddr) (Registrar, error)
+	// Gossiper is used to announce new updates to the Universe servers,
+	// which relay them to the rest of the federation. It's also used to
+	// discover new Universe servers through the federation.
+	Gossiper *Gossiper
 
 	// LocalRegistrar is the local register. This'll be used to add new
 	// leaves (minting events) to our local server before pushing them out
@@ -220,52 +220,19 @@ func (f *FederationEnvoy) syncServerState(ctx context.Context,
 }
 
 // pushProofToFederation attempts to push out a new proof to the current
-// federation in parallel.
+// federation in parallel. The federation members relay the proof to their own
+// federation members in turn.
 func (f *FederationEnvoy) pushProofToFederation(uniID Identifier, key LeafKey,
 	leaf *Leaf) {
 
-	// Fetch all universe servers in our federation.
-	fedServers, err := f.tryFetchServers()
-	if err != nil || len(fedServers) == 0 {
-		return
-	}
-
-	log.Infof("Pushing new proof to %v federation members, proof_key=%v",
-		len(fedServers), spew.Sdump(key))
+	log.Infof("Pushing new proof to federation, proof_key=%v",
+		spew.Sdump(key))
 
 	ctx, cancel := f.WithCtxQuitNoTimeout()
 	defer cancel()
 
-	// To push a new proof out, we'll attempt to dial to the remote
-	// registrar, then will attempt to push the new proof directly to the
-	// register.
-	pushNewProof := func(ctx context.Context, addr ServerAddr) error {
-		remoteUniverseServer, err := f.cfg.NewRemoteRegistrar(addr)
-		if err != nil {
-			log.Warnf("cannot push proof unable to connect "+
-				"to remote server(%v): %v", addr.HostStr(),
-				err)
-			return nil
-		}
-
-		_, err = remoteUniverseServer.UpsertProofLeaf(
-			ctx, uniID, key, leaf,
-		)
-		if err != nil {
-			log.Warnf("cannot push proof to remote "+
-				"server(%v): %v", addr.HostStr(), err)
-		}
-		return nil
-	}
-
-	// To conclude, we'll attempt to push the new proof to all the universe
-	// servers in parallel.
-	err = fn.ParSlice(ctx, fedServers, pushNewProof)
-	if err != nil {
-		// TODO(roasbeef): retry in the background until successful?
-		log.Errorf("unable to push proof to federation: %v", err)
-		return
-	}
+	// TODO(roasbeef): retry in the background until successful?
+	f.cfg.Gossiper.Announce(ctx, uniID, key, leaf)
 }
 
 // syncer is the main goroutine that's responsible for interacting with the
@@ -440,6 +407,43 @@ func (f *FederationEnvoy) AddServer(addrs ...ServerAddr) error {
 	return f.SyncServers(addrs)
 }
 
+// DiscoverServers discovers new servers through the federation of the given
+// servers, then adds them to our own federation. The set of newly added
+// servers is returned.
+func (f *FederationEnvoy) DiscoverServers(
+	addrs ...ServerAddr) ([]ServerAddr, error) {
+
+	ctx, cancel := f.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	discovered, err := f.cfg.Gossiper.DiscoverServers(ctx, addrs)
+	if err != nil {
+		return nil, err
+	}
+
+	// As with the static federation members, we'll only add the servers
+	// we can actually connect to.
+	discovered = fn.Filter(discovered, func(a ServerAddr) bool {
+		if err := f.cfg.ServerChecker(a); err != nil {
+			log.Debugf("Not adding discovered server to "+
+				"federation: %v", err)
+
+			return false
+		}
+
+		return true
+	})
+	if len(discovered) == 0 {
+		return nil, nil
+	}
+
+	if err := f.AddServer(discovered...); err != nil {
+		return nil, err
+	}
+
+	return discovered, nil
+}
+
 // QuerySyncConfigs returns the current sync configs for the federation.
 func (f *FederationEnvoy) QuerySyncConfigs(
 	ctx context.Context) (*SyncConfigs, error) {
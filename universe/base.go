@@ -9,9 +9,11 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
 )
 
@@ -27,6 +29,10 @@ type ArchiveConfig struct {
 	// genesis proof.
 	HeaderVerifier proof.HeaderVerifier
 
+	// MedianTimeSource is used to look up the median time past of a block
+	// to verify time based lock times of a proof.
+	MedianTimeSource proof.MedianTimeSource
+
 	// GroupVerifier is used to verify the validity of the group key for a
 	// genesis proof.
 	GroupVerifier proof.GroupVerifier
@@ -39,6 +45,11 @@ type ArchiveConfig struct {
 	// external/internal queries to the base universe instance.
 	UniverseStats Telemetry
 
+	// Events is used to notify subscribers about the new leaf and root
+	// events logged by the multiverse. This is optional, subscribers
+	// aren't notified if it isn't set.
+	Events *EventNotifier
+
 	// TODO(roasbeef): query re genesis asset known?
 
 	// TODO(roasbeef): load all at once, or lazy load dynamic?
@@ -245,9 +256,28 @@ func (a *Archive) UpsertProofLeaf(ctx context.Context, id Identifier,
 		}
 	}()
 
+	// Subscribers are notified about the new leaf, and the new root of
+	// the universe it was inserted into.
+	a.notifyLoggedEvents(ctx)
+
 	return issuanceProof, nil
 }
 
+// notifyLoggedEvents notifies subscribers about the events that were logged
+// by the multiverse together with the inserted leaves, if an event notifier is
+// configured. The events are already persisted at this point, so if this
+// fails, they're delivered with the next notification instead.
+func (a *Archive) notifyLoggedEvents(ctx context.Context) {
+	if a.cfg.Events == nil {
+		return
+	}
+
+	if err := a.cfg.Events.NotifyLoggedEvents(ctx); err != nil {
+		log.Warnf("Unable to notify universe event subscribers: %v",
+			err)
+	}
+}
+
 // verifyIssuanceProof verifies the passed minting leaf is a valid issuance
 // proof, returning the asset snapshot if so.
 func (a *Archive) verifyIssuanceProof(ctx context.Context, id Identifier,
@@ -256,7 +286,7 @@ func (a *Archive) verifyIssuanceProof(ctx context.Context, id Identifier,
 
 	assetSnapshot, err := newProof.Verify(
 		ctx, prevAssetSnapshot, a.cfg.HeaderVerifier,
-		a.cfg.GroupVerifier,
+		a.cfg.MedianTimeSource, a.cfg.GroupVerifier,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to verify proof: %v", err)
@@ -292,13 +322,13 @@ func (a *Archive) verifyIssuanceProof(ctx context.Context, id Identifier,
 	return assetSnapshot, nil
 }
 
-// extractBatchDeps constructs map from leaf key to asset in a batch. This is
+// extractBatchDeps constructs map from leaf key to leaf in a batch. This is
 // useful for when we're validating an asset state transition in a batch, and
 // the input asset it depends on is created in the batch.
-func extractBatchDeps(batch []*Item) map[UniverseKey]*asset.Asset {
-	batchDeps := make(map[UniverseKey]*asset.Asset)
+func extractBatchDeps(batch []*Item) map[UniverseKey]*Leaf {
+	batchDeps := make(map[UniverseKey]*Leaf)
 	for _, item := range batch {
-		batchDeps[item.Key.UniverseKey()] = item.Leaf.Asset
+		batchDeps[item.Key.UniverseKey()] = item.Leaf
 	}
 
 	return batchDeps
@@ -445,6 +475,8 @@ func (a *Archive) UpsertProofLeafBatch(ctx context.Context,
 		}
 	}()
 
+	a.notifyLoggedEvents(ctx)
+
 	return nil
 }
 
@@ -455,7 +487,7 @@ type UniverseKey [32]byte
 // proof. If the proof is a genesis proof, then nil is returned.
 func (a *Archive) getPrevAssetSnapshot(ctx context.Context,
 	uniID Identifier, newAsset *asset.Asset,
-	batchAssets map[UniverseKey]*asset.Asset) (*proof.AssetSnapshot, error) {
+	batchLeaves map[UniverseKey]*Leaf) (*proof.AssetSnapshot, error) {
 
 	// If this is a genesis proof, then there is no previous asset (and
 	// therefore no previous asset snapshot).
@@ -491,19 +523,16 @@ func (a *Archive) getPrevAssetSnapshot(ctx context.Context,
 	// First, we'll check if the prev asset that we need is already amongst
 	// the batch we have, if so then we won't have it in our universe tree
 	// yet, so we'll return it directly.
-	if batchAssets != nil {
+	if batchLeaves != nil {
 		newScriptKey := newAsset.ScriptKey.PubKey.SerializeCompressed()
 
-		inputAsset, ok := batchAssets[prevLeafKey.UniverseKey()]
+		inputLeaf, ok := batchLeaves[prevLeafKey.UniverseKey()]
 		if ok {
 			log.Debugf("script_key=%x spends item in batch, "+
 				"universe_key=%x using batch input",
 				newScriptKey, prevLeafKey.UniverseKey())
 
-			return &proof.AssetSnapshot{
-				Asset:    inputAsset,
-				OutPoint: prevID.OutPoint,
-			}, nil
+			return prevAssetSnapshot(inputLeaf, prevID.OutPoint)
 		}
 	}
 
@@ -530,17 +559,31 @@ func (a *Archive) getPrevAssetSnapshot(ctx context.Context,
 			newAsset.ScriptKey.PubKey.SerializeCompressed())
 	}
 
-	prevAsset := prevProofs[0].Leaf.Asset
-
 	// TODO(roasbeef): need more than one snapshot, for inputs
 
-	// Construct minimal asset snapshot for previous asset.
-	// This is a minimal the proof verification result for the
-	// previous (input) asset. We know that it was already verified
-	// as it was present in the multiverse/universe archive.
+	return prevAssetSnapshot(prevProofs[0].Leaf, prevID.OutPoint)
+}
+
+// prevAssetSnapshot constructs a minimal asset snapshot for the previous
+// (input) asset of a proof from its universe leaf. This is a minimal proof
+// verification result for the previous asset. We know that it was already
+// verified as it was present in the multiverse/universe archive or is verified
+// as part of the same batch. The anchor block height is extracted from the
+// leaf's proof, as it is required to verify relative lock times.
+func prevAssetSnapshot(leaf *Leaf,
+	outPoint wire.OutPoint) (*proof.AssetSnapshot, error) {
+
+	var prevProof proof.Proof
+	err := prevProof.Decode(bytes.NewReader(leaf.RawProof))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode previous proof: %w",
+			err)
+	}
+
 	return &proof.AssetSnapshot{
-		Asset:    prevAsset,
-		OutPoint: prevID.OutPoint,
+		Asset:             leaf.Asset,
+		OutPoint:          outPoint,
+		AnchorBlockHeight: prevProof.BlockHeight,
 	}, nil
 }
 
@@ -572,6 +615,17 @@ func (a *Archive) UniverseLeafKeys(ctx context.Context,
 	return a.cfg.Multiverse.UniverseLeafKeys(ctx, q)
 }
 
+// UniverseBranch returns the branch at the given path of the MS-SMT of the
+// specified universe.
+func (a *Archive) UniverseBranch(ctx context.Context, id Identifier,
+	path mssmt.NodePath) (*Branch, error) {
+
+	log.Tracef("Retrieving branch for Universe: id=%v, depth=%v",
+		id.StringForLog(), path.Depth)
+
+	return a.cfg.Multiverse.UniverseBranch(ctx, id, path)
+}
+
 // MintingLeaves returns the set of minting leaves known for the specified base
 // universe.
 func (a *Archive) MintingLeaves(ctx context.Context,
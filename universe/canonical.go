@@ -0,0 +1,418 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+// CanonicalConfig is the config that the CanonicalUniverse uses to anchor the
+// multiverse root in the chain.
+type CanonicalConfig struct {
+	// Multiverse is the multiverse that holds the issuance proofs whose
+	// root is committed to in the chain.
+	Multiverse MultiverseArchive
+
+	// ChainCommitter is used to anchor new multiverse roots in the chain.
+	ChainCommitter ChainCommitter
+
+	// CommitmentStore is used to persist the chain commitments.
+	CommitmentStore CommitmentStore
+
+	// CommitInterval is the period that we'll use to anchor the latest
+	// multiverse root in the chain. A new commitment is only created if
+	// the root changed since the last commitment.
+	CommitInterval time.Duration
+}
+
+// CanonicalUniverse periodically anchors the root of the issuance multiverse
+// in the chain, and allows callers to query issuance proofs that are tied to
+// one of these chain commitments.
+type CanonicalUniverse struct {
+	cfg CanonicalConfig
+
+	*fn.ContextGuard
+
+	startOnce sync.Once
+
+	stopOnce sync.Once
+
+	// commitMtx makes sure we only create a single pending commitment at
+	// a time.
+	commitMtx sync.Mutex
+}
+
+// A compile-time check to ensure that CanonicalUniverse meets the Canonical
+// interface.
+var _ Canonical = (*CanonicalUniverse)(nil)
+
+// NewCanonicalUniverse creates a new canonical universe from the passed
+// config.
+func NewCanonicalUniverse(cfg CanonicalConfig) *CanonicalUniverse {
+	return &CanonicalUniverse{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start launches the goroutine that periodically commits the multiverse root.
+func (c *CanonicalUniverse) Start() error {
+	c.startOnce.Do(func() {
+		log.Infof("Starting CanonicalUniverse")
+
+		c.Wg.Add(1)
+		go c.committer()
+	})
+
+	return nil
+}
+
+// Stop signals the canonical universe to shut down.
+func (c *CanonicalUniverse) Stop() error {
+	c.stopOnce.Do(func() {
+		log.Infof("Stopping CanonicalUniverse")
+
+		close(c.Quit)
+
+		c.Wg.Wait()
+
+		log.Infof("Stopped CanonicalUniverse")
+	})
+
+	return nil
+}
+
+// committer is the main goroutine that anchors the multiverse root in the
+// chain once every commit interval.
+func (c *CanonicalUniverse) committer() {
+	defer c.Wg.Done()
+
+	// If we were shut down while waiting for a commitment to confirm,
+	// we'll resume waiting for it before creating any new ones.
+	ctx, cancel := c.WithCtxQuit()
+	_, err := c.cfg.CommitmentStore.PendingCommitment(ctx)
+	cancel()
+	switch {
+	case err == nil:
+		if !c.commit() {
+			return
+		}
+
+	case !errors.Is(err, ErrNoCommitment):
+		log.Errorf("Unable to fetch pending commitment: %v", err)
+	}
+
+	commitTicker := time.NewTicker(c.cfg.CommitInterval)
+	defer commitTicker.Stop()
+
+	for {
+		select {
+		case <-commitTicker.C:
+			if !c.commit() {
+				return
+			}
+
+		case <-c.Quit:
+			return
+		}
+	}
+}
+
+// commit updates the chain commitment and logs any error. False is returned
+// if we're shutting down.
+func (c *CanonicalUniverse) commit() bool {
+	// Committing blocks until the anchor transaction has confirmed, so we
+	// don't use a timeout here.
+	ctx, cancel := c.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	_, err := c.UpdateChainCommitment(ctx)
+	switch {
+	case err == nil:
+
+	// If we're shutting down, we'll stop waiting for the commitment, which
+	// isn't an error. As the commitment is still pending, we'll resume
+	// waiting for it on the next start.
+	case errors.Is(err, context.Canceled):
+		return false
+
+	// We'll just try again at the next interval, as we might for example
+	// be short on funds.
+	case !errors.Is(err, ErrNoCommitment):
+		log.Warnf("Unable to commit multiverse root: %v", err)
+	}
+
+	return true
+}
+
+// issuanceRoot returns the current root of the issuance multiverse.
+func (c *CanonicalUniverse) issuanceRoot(
+	ctx context.Context) (mssmt.Node, error) {
+
+	root, err := c.cfg.Multiverse.RootNode(ctx, ProofTypeIssuance)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch multiverse root: %w",
+			err)
+	}
+
+	return root.Node, nil
+}
+
+// Query returns a fully proved response for the target issuance leaf, tied to
+// the chain commitment that was the latest one at the given block height. If
+// the height is zero, then the latest commitment is used. The proof is served
+// from the multiverse snapshot that was stored with the commitment, so it
+// stays valid after the multiverse changed.
+//
+// NOTE: ErrRootNotCommitted is returned if the leaf wasn't part of the
+// multiverse at the time of the selected commitment.
+//
+// NOTE: This is part of the Canonical interface.
+func (c *CanonicalUniverse) Query(ctx context.Context, id Identifier,
+	key LeafKey, height uint32) (*CommittedIssuanceProof, error) {
+
+	if id.ProofType != ProofTypeIssuance {
+		return nil, fmt.Errorf("only issuance proofs are committed " +
+			"to in the chain")
+	}
+
+	if key.ScriptKey == nil {
+		return nil, fmt.Errorf("script key must be specified")
+	}
+
+	var (
+		commitment *Commitment
+		err        error
+	)
+	switch {
+	case height == 0:
+		commitment, err = c.cfg.CommitmentStore.LatestCommitment(ctx)
+
+	default:
+		commitment, err = c.cfg.CommitmentStore.CommitmentAtHeight(
+			ctx, height,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	notCommittedErr := fmt.Errorf("%w: commitment at height %d",
+		ErrRootNotCommitted, commitment.BlockHeight)
+
+	issuanceProof, err := c.cfg.CommitmentStore.FetchCommittedProof(
+		ctx, commitment.UniverseRoot, id, key,
+	)
+	switch {
+	case errors.Is(err, ErrNoUniverseProofFound):
+		return nil, notCommittedErr
+
+	case err != nil:
+		return nil, err
+	}
+
+	// The snapshot only holds the inclusion proofs, so we'll fetch the
+	// leaf itself from the multiverse.
+	proofs, err := c.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(proofs) == 0 {
+		return nil, ErrNoUniverseProofFound
+	}
+
+	issuanceProof.Leaf = proofs[0].Leaf
+	issuanceProof.LeafKey = proofs[0].LeafKey
+
+	// If the leaf was replaced since the commitment, then the current
+	// leaf isn't the one that was committed to.
+	committedRoot := commitment.UniverseRoot
+	if !issuanceProof.VerifyRoot(issuanceProof.UniverseRoot) ||
+		!issuanceProof.VerifyMultiverseRoot(id, committedRoot) {
+
+		return nil, notCommittedErr
+	}
+
+	return &CommittedIssuanceProof{
+		ID:                id,
+		ChainProof:        commitment,
+		TaprootAssetProof: issuanceProof,
+	}, nil
+}
+
+// LatestCommitment returns the latest chain commitment.
+//
+// NOTE: This is part of the Canonical interface.
+func (c *CanonicalUniverse) LatestCommitment(
+	ctx context.Context) (*Commitment, error) {
+
+	return c.cfg.CommitmentStore.LatestCommitment(ctx)
+}
+
+// UpdateChainCommitment anchors the current multiverse root in the chain, if
+// it isn't already committed to by the latest commitment. If the root is
+// already committed to, then the latest commitment is returned. If there's a
+// commitment waiting for its anchor transaction to confirm, then we'll wait
+// for that one instead of creating a new one.
+//
+// NOTE: This is part of the Canonical interface.
+func (c *CanonicalUniverse) UpdateChainCommitment(
+	ctx context.Context) (*Commitment, error) {
+
+	pending, latest, err := c.pendingCommitment(ctx)
+	switch {
+	case err != nil:
+		return nil, err
+
+	case latest != nil:
+		return latest, nil
+	}
+
+	return c.confirmCommitment(ctx, pending)
+}
+
+// pendingCommitment returns the commitment that is waiting for its anchor
+// transaction to confirm. If there is none, then a new one is created and
+// stored for the current multiverse root, unless the root is already committed
+// to, in which case the latest commitment is returned instead.
+func (c *CanonicalUniverse) pendingCommitment(
+	ctx context.Context) (*PendingCommitment, *Commitment, error) {
+
+	c.commitMtx.Lock()
+	defer c.commitMtx.Unlock()
+
+	pending, err := c.cfg.CommitmentStore.PendingCommitment(ctx)
+	switch {
+	case err == nil:
+		log.Infof("Resuming pending multiverse root commitment in "+
+			"tx %v", pending.AnchorTx.TxHash())
+
+		return pending, nil, nil
+
+	case !errors.Is(err, ErrNoCommitment):
+		return nil, nil, fmt.Errorf("unable to fetch pending "+
+			"commitment: %w", err)
+	}
+
+	latest, err := c.cfg.CommitmentStore.LatestCommitment(ctx)
+	switch {
+	case errors.Is(err, ErrNoCommitment):
+		latest = nil
+
+	case err != nil:
+		return nil, nil, err
+	}
+
+	// Taking a snapshot of the multiverse is expensive, so we'll first
+	// check whether the root changed at all.
+	root, err := c.issuanceRoot(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	isCommitted := func(root mssmt.Node) bool {
+		return latest != nil &&
+			mssmt.IsEqualNode(latest.UniverseRoot, root)
+	}
+	if isCommitted(root) {
+		log.Debugf("Multiverse root already committed at height %d",
+			latest.BlockHeight)
+
+		return nil, latest, nil
+	}
+
+	snapshot, err := c.cfg.CommitmentStore.SnapshotMultiverse(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to snapshot multiverse: %w",
+			err)
+	}
+
+	// The multiverse might have changed since we fetched the root, so
+	// we'll commit to the root of the snapshot.
+	root = snapshot.Root
+	switch {
+	case isCommitted(root):
+		return nil, latest, nil
+
+	// There's nothing to commit to if the multiverse is still empty.
+	case mssmt.IsEqualNode(root, mssmt.EmptyTree[0]):
+		return nil, nil, ErrNoCommitment
+	}
+
+	rootHash := root.NodeHash()
+	log.Infof("Committing multiverse root (hash=%v, sum=%d) in the chain",
+		rootHash, root.NodeSum())
+
+	pending, err = c.cfg.ChainCommitter.NewCommitmentTx(ctx, root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// We'll store the commitment before publishing its anchor
+	// transaction, so we never lose track of a published transaction.
+	err = c.cfg.CommitmentStore.InsertPendingCommitment(
+		ctx, pending, snapshot,
+	)
+	if err != nil {
+		releaseErr := c.cfg.ChainCommitter.ReleaseCommitmentTx(
+			ctx, pending,
+		)
+		if releaseErr != nil {
+			log.Warnf("Unable to release commitment tx inputs: %v",
+				releaseErr)
+		}
+
+		return nil, nil, fmt.Errorf("unable to store pending "+
+			"commitment: %w", err)
+	}
+
+	return pending, nil, nil
+}
+
+// confirmCommitment publishes the anchor transaction of the pending
+// commitment, waits for it to confirm, then stores the confirmed commitment.
+// This may block for a long time, so it must not be called while holding the
+// commitMtx.
+func (c *CanonicalUniverse) confirmCommitment(ctx context.Context,
+	pending *PendingCommitment) (*Commitment, error) {
+
+	commitment, err := c.cfg.ChainCommitter.ConfirmCommitment(ctx, pending)
+	switch {
+	// The anchor transaction will never confirm, so we'll abandon the
+	// commitment. A new one will be created on the next attempt.
+	case errors.Is(err, ErrCommitmentTxRejected):
+		log.Warnf("Abandoning multiverse root commitment in tx %v: %v",
+			pending.AnchorTx.TxHash(), err)
+
+		abandonErr := c.cfg.CommitmentStore.AbandonPendingCommitment(
+			ctx, pending,
+		)
+		if abandonErr != nil {
+			return nil, fmt.Errorf("unable to abandon commitment: "+
+				"%w", abandonErr)
+		}
+
+		return nil, err
+
+	case err != nil:
+		return nil, err
+	}
+
+	err = c.cfg.CommitmentStore.InsertCommitment(ctx, commitment)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store commitment: %w", err)
+	}
+
+	log.Infof("Multiverse root committed at height %d in tx %v",
+		commitment.BlockHeight, commitment.AnchorTx.TxHash())
+
+	return commitment, nil
+}
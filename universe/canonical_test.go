@@ -0,0 +1,745 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// commitTimeout is the time we wait for a commitment to be confirmed.
+const commitTimeout = 5 * time.Second
+
+// mockMultiverse is a MultiverseArchive that holds a single issuance universe.
+// The leaves of earlier versions of the universe are kept, so they can still
+// be looked up after the universe changed.
+type mockMultiverse struct {
+	MultiverseArchive
+
+	proof *Proof
+
+	leaves map[[32]byte]*Leaf
+}
+
+// setProof replaces the universe with the one of the given issuance proof.
+func (m *mockMultiverse) setProof(proof *Proof) {
+	if m.leaves == nil {
+		m.leaves = make(map[[32]byte]*Leaf)
+	}
+
+	m.proof = proof
+	m.leaves[proof.LeafKey.UniverseKey()] = proof.Leaf
+}
+
+func (m *mockMultiverse) RootNode(_ context.Context,
+	proofType ProofType) (*MultiverseRoot, error) {
+
+	return &MultiverseRoot{
+		ProofType: proofType,
+		Node:      m.proof.MultiverseRoot,
+	}, nil
+}
+
+func (m *mockMultiverse) FetchProofLeaf(_ context.Context, _ Identifier,
+	key LeafKey) ([]*Proof, error) {
+
+	leaf, ok := m.leaves[key.UniverseKey()]
+	if !ok {
+		return nil, nil
+	}
+
+	proof := *m.proof
+	proof.Leaf = leaf
+	proof.LeafKey = key
+
+	return []*Proof{&proof}, nil
+}
+
+// mockChainCommitter is a ChainCommitter that confirms each anchor
+// transaction in a new block.
+type mockChainCommitter struct {
+	sync.Mutex
+
+	height  uint32
+	commits int
+
+	// confirmErr is returned by the next call to ConfirmCommitment.
+	confirmErr error
+
+	// confirmGate, if set, blocks ConfirmCommitment until it's closed.
+	confirmGate chan struct{}
+
+	// waiting is the number of callers blocked on the confirmGate.
+	waiting int
+
+	releases int
+}
+
+func (m *mockChainCommitter) NewCommitmentTx(_ context.Context,
+	root mssmt.Node) (*PendingCommitment, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	internalKey := test.RandPubKey(nil)
+	pkScript, err := RootCommitmentPkScript(internalKey, root)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorTx := wire.NewMsgTx(2)
+	anchorTx.AddTxIn(&wire.TxIn{})
+	anchorTx.AddTxOut(&wire.TxOut{Value: 1_000, PkScript: pkScript})
+
+	m.commits++
+
+	return &PendingCommitment{
+		AnchorTx:     anchorTx,
+		OutputIndex:  0,
+		InternalKey:  internalKey,
+		UniverseRoot: root,
+		HeightHint:   m.height,
+	}, nil
+}
+
+func (m *mockChainCommitter) ConfirmCommitment(ctx context.Context,
+	pending *PendingCommitment) (*Commitment, error) {
+
+	m.Lock()
+	confirmGate := m.confirmGate
+	if confirmGate != nil {
+		m.waiting++
+	}
+	m.Unlock()
+
+	if confirmGate != nil {
+		select {
+		case <-confirmGate:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.confirmErr != nil {
+		err := m.confirmErr
+		m.confirmErr = nil
+
+		return nil, err
+	}
+
+	// The anchor transaction is confirmed in a block next to a few other
+	// transactions.
+	txs := []*wire.MsgTx{pending.AnchorTx}
+	for i := 0; i < 3; i++ {
+		tx := wire.NewMsgTx(2)
+		tx.AddTxOut(&wire.TxOut{
+			Value:    int64(i),
+			PkScript: test.RandBytes(34),
+		})
+		txs = append([]*wire.MsgTx{tx}, txs...)
+	}
+
+	merkleProof, err := proof.NewTxMerkleProof(txs, len(txs)-1)
+	if err != nil {
+		return nil, err
+	}
+
+	btcTxs := make([]*btcutil.Tx, len(txs))
+	for i, tx := range txs {
+		btcTxs[i] = btcutil.NewTx(tx)
+	}
+
+	// Waiting for the same commitment more than once should return the
+	// same block.
+	if pending.HeightHint == m.height {
+		m.height += 10
+	}
+
+	return &Commitment{
+		BlockHeight: m.height,
+		BlockHeader: wire.BlockHeader{
+			MerkleRoot: blockchain.CalcMerkleRoot(btcTxs, false),
+		},
+		MerkleProof:  merkleProof,
+		AnchorTx:     pending.AnchorTx,
+		OutputIndex:  pending.OutputIndex,
+		InternalKey:  pending.InternalKey,
+		UniverseRoot: pending.UniverseRoot,
+	}, nil
+}
+
+func (m *mockChainCommitter) ReleaseCommitmentTx(context.Context,
+	*PendingCommitment) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.releases++
+	return nil
+}
+
+// mockCommitmentStore is an in-memory CommitmentStore that snapshots the
+// universe of the given multiverse.
+type mockCommitmentStore struct {
+	sync.Mutex
+
+	id Identifier
+
+	multiverse *mockMultiverse
+
+	commitments []*Commitment
+
+	pending *PendingCommitment
+
+	snapshots map[mssmt.NodeHash]*Proof
+}
+
+func (m *mockCommitmentStore) SnapshotMultiverse(
+	context.Context) (*CommitmentSnapshot, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.snapshots == nil {
+		m.snapshots = make(map[mssmt.NodeHash]*Proof)
+	}
+
+	p := m.multiverse.proof
+	m.snapshots[p.MultiverseRoot.NodeHash()] = p
+
+	return &CommitmentSnapshot{
+		Root: p.MultiverseRoot,
+	}, nil
+}
+
+func (m *mockCommitmentStore) InsertPendingCommitment(_ context.Context,
+	pending *PendingCommitment, snapshot *CommitmentSnapshot) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.snapshots[snapshot.Root.NodeHash()]; !ok {
+		return fmt.Errorf("unknown snapshot")
+	}
+
+	m.pending = pending
+
+	return nil
+}
+
+func (m *mockCommitmentStore) PendingCommitment(
+	context.Context) (*PendingCommitment, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.pending == nil {
+		return nil, ErrNoCommitment
+	}
+
+	return m.pending, nil
+}
+
+func (m *mockCommitmentStore) AbandonPendingCommitment(context.Context,
+	*PendingCommitment) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.pending = nil
+	return nil
+}
+
+func (m *mockCommitmentStore) InsertCommitment(_ context.Context,
+	commitment *Commitment) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.pending = nil
+	for _, c := range m.commitments {
+		if c.InternalKey.IsEqual(commitment.InternalKey) {
+			return nil
+		}
+	}
+
+	m.commitments = append(m.commitments, commitment)
+	return nil
+}
+
+func (m *mockCommitmentStore) LatestCommitment(
+	ctx context.Context) (*Commitment, error) {
+
+	return m.CommitmentAtHeight(ctx, ^uint32(0))
+}
+
+func (m *mockCommitmentStore) CommitmentAtHeight(_ context.Context,
+	height uint32) (*Commitment, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	var latest *Commitment
+	for _, commitment := range m.commitments {
+		if commitment.BlockHeight <= height {
+			latest = commitment
+		}
+	}
+	if latest == nil {
+		return nil, ErrNoCommitment
+	}
+
+	return latest, nil
+}
+
+func (m *mockCommitmentStore) FetchCommittedProof(_ context.Context,
+	multiverseRoot mssmt.Node, id Identifier,
+	key LeafKey) (*Proof, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	p, ok := m.snapshots[multiverseRoot.NodeHash()]
+	if !ok || id.String() != m.id.String() ||
+		key.UniverseKey() != p.LeafKey.UniverseKey() {
+
+		return nil, ErrNoUniverseProofFound
+	}
+
+	return &Proof{
+		LeafKey:                  key,
+		UniverseRoot:             p.UniverseRoot,
+		UniverseInclusionProof:   p.UniverseInclusionProof,
+		MultiverseRoot:           multiverseRoot,
+		MultiverseInclusionProof: p.MultiverseInclusionProof,
+	}, nil
+}
+
+// randIssuanceProof creates an issuance proof of a new leaf in the given
+// universe, along with its universe and multiverse inclusion proofs.
+func randIssuanceProof(t *testing.T, id Identifier) *Proof {
+	ctx := context.Background()
+
+	genesisAsset := randGenesisAsset(t)
+	scriptKey := asset.RandScriptKey(t)
+	leafKey := LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: &scriptKey,
+	}
+	leaf := &Leaf{
+		Asset:    &genesisAsset,
+		RawProof: test.RandBytes(100),
+		Amt:      genesisAsset.Amount,
+	}
+
+	universeTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	_, err := universeTree.Insert(
+		ctx, leafKey.UniverseKey(), leaf.SmtLeafNode(),
+	)
+	require.NoError(t, err)
+
+	universeRoot, err := universeTree.Root(ctx)
+	require.NoError(t, err)
+	universeProof, err := universeTree.MerkleProof(
+		ctx, leafKey.UniverseKey(),
+	)
+	require.NoError(t, err)
+
+	// The multiverse holds the root of the universe next to the roots of
+	// a few other universes.
+	multiverseTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	universeRootHash := universeRoot.NodeHash()
+	_, err = multiverseTree.Insert(
+		ctx, id.Bytes(), mssmt.NewLeafNode(universeRootHash[:], 1),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		var otherKey [32]byte
+		test.RandRead(t, otherKey[:])
+
+		_, err = multiverseTree.Insert(
+			ctx, otherKey, mssmt.NewLeafNode(test.RandBytes(32), 1),
+		)
+		require.NoError(t, err)
+	}
+
+	multiverseRoot, err := multiverseTree.Root(ctx)
+	require.NoError(t, err)
+	multiverseProof, err := multiverseTree.MerkleProof(ctx, id.Bytes())
+	require.NoError(t, err)
+
+	return &Proof{
+		Leaf:                     leaf,
+		LeafKey:                  leafKey,
+		UniverseRoot:             universeRoot,
+		UniverseInclusionProof:   universeProof,
+		MultiverseRoot:           multiverseRoot,
+		MultiverseInclusionProof: multiverseProof,
+	}
+}
+
+// TestCanonicalUniverse tests that the multiverse root is only committed to
+// once it changes, and that issuance proofs can be queried and verified
+// against the commitments, even after the multiverse changed.
+func TestCanonicalUniverse(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var id Identifier
+	test.RandRead(t, id.AssetID[:])
+	id.ProofType = ProofTypeIssuance
+
+	multiverse := &mockMultiverse{}
+	multiverse.setProof(randIssuanceProof(t, id))
+
+	committer := &mockChainCommitter{height: 100}
+	store := &mockCommitmentStore{
+		id:         id,
+		multiverse: multiverse,
+	}
+
+	canonical := NewCanonicalUniverse(CanonicalConfig{
+		Multiverse:      multiverse,
+		ChainCommitter:  committer,
+		CommitmentStore: store,
+	})
+
+	// There's no commitment yet, so we can't query for a proof.
+	leafKey := multiverse.proof.LeafKey
+	_, err := canonical.Query(ctx, id, leafKey, 0)
+	require.ErrorIs(t, err, ErrNoCommitment)
+
+	// Committing the root should create a new commitment, while doing so
+	// again without any change to the root should return the same one.
+	commitment, err := canonical.UpdateChainCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(110), commitment.BlockHeight)
+
+	sameCommitment, err := canonical.UpdateChainCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(t, commitment, sameCommitment)
+	require.Equal(t, 1, committer.commits)
+
+	latest, err := canonical.LatestCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(t, commitment, latest)
+
+	// The proof should now be tied to the commitment, both when querying
+	// for the latest commitment and at a later height.
+	headerVerifier := func(wire.BlockHeader, uint32) error {
+		return nil
+	}
+	for _, height := range []uint32{0, 110, 500} {
+		committedProof, err := canonical.Query(
+			ctx, id, leafKey, height,
+		)
+		require.NoError(t, err)
+		require.Equal(t, commitment, committedProof.ChainProof)
+		require.NoError(t, committedProof.Verify(headerVerifier))
+	}
+
+	// There's no commitment before the first one confirmed.
+	_, err = canonical.Query(ctx, id, leafKey, 109)
+	require.ErrorIs(t, err, ErrNoCommitment)
+
+	// Only issuance proofs are committed to.
+	transferID := id
+	transferID.ProofType = ProofTypeTransfer
+	_, err = canonical.Query(ctx, transferID, leafKey, 0)
+	require.Error(t, err)
+
+	// An invalid block header should fail the verification.
+	committedProof, err := canonical.Query(ctx, id, leafKey, 0)
+	require.NoError(t, err)
+	badHeaderVerifier := func(wire.BlockHeader, uint32) error {
+		return fmt.Errorf("unknown block")
+	}
+	require.ErrorContains(
+		t, committedProof.Verify(badHeaderVerifier), "unknown block",
+	)
+
+	// The proof of another universe shouldn't verify against the
+	// commitment.
+	otherID := id
+	test.RandRead(t, otherID.AssetID[:])
+	committedProof.ID = otherID
+	require.ErrorContains(
+		t, committedProof.Verify(headerVerifier), "multiverse",
+	)
+
+	// Once the multiverse changes, the old leaf is still served from the
+	// snapshot of the old commitment, while the new leaf isn't committed
+	// to until a new commitment is made.
+	oldLeafKey := leafKey
+	multiverse.setProof(randIssuanceProof(t, id))
+	leafKey = multiverse.proof.LeafKey
+
+	committedProof, err = canonical.Query(ctx, id, oldLeafKey, 0)
+	require.NoError(t, err)
+	require.Equal(t, commitment, committedProof.ChainProof)
+	require.NoError(t, committedProof.Verify(headerVerifier))
+
+	_, err = canonical.Query(ctx, id, leafKey, 0)
+	require.ErrorIs(t, err, ErrRootNotCommitted)
+
+	newCommitment, err := canonical.UpdateChainCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(120), newCommitment.BlockHeight)
+	require.Equal(t, 2, committer.commits)
+
+	committedProof, err = canonical.Query(ctx, id, leafKey, 0)
+	require.NoError(t, err)
+	require.NoError(t, committedProof.Verify(headerVerifier))
+
+	// The new leaf can't be tied to the older commitment, but the old
+	// leaf still can.
+	_, err = canonical.Query(ctx, id, leafKey, 115)
+	require.ErrorIs(t, err, ErrRootNotCommitted)
+
+	committedProof, err = canonical.Query(ctx, id, oldLeafKey, 115)
+	require.NoError(t, err)
+	require.Equal(t, commitment, committedProof.ChainProof)
+	require.NoError(t, committedProof.Verify(headerVerifier))
+
+	// If the leaf was replaced since the commitment, then it no longer
+	// matches the snapshot.
+	multiverse.leaves[oldLeafKey.UniverseKey()] = multiverse.proof.Leaf
+	_, err = canonical.Query(ctx, id, oldLeafKey, 115)
+	require.ErrorIs(t, err, ErrRootNotCommitted)
+}
+
+// TestCanonicalUniversePendingCommitment tests that a commitment is stored
+// before its anchor transaction is published, so waiting for it is resumed
+// instead of publishing a second transaction, and that a rejected anchor
+// transaction is abandoned.
+func TestCanonicalUniversePendingCommitment(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var id Identifier
+	test.RandRead(t, id.AssetID[:])
+	id.ProofType = ProofTypeIssuance
+
+	multiverse := &mockMultiverse{}
+	multiverse.setProof(randIssuanceProof(t, id))
+
+	committer := &mockChainCommitter{height: 100}
+	store := &mockCommitmentStore{
+		id:         id,
+		multiverse: multiverse,
+	}
+	cfg := CanonicalConfig{
+		Multiverse:      multiverse,
+		ChainCommitter:  committer,
+		CommitmentStore: store,
+	}
+
+	// If we stop waiting for the confirmation, for example because we're
+	// shutting down, the commitment should stay pending.
+	committer.confirmErr = context.Canceled
+	_, err := NewCanonicalUniverse(cfg).UpdateChainCommitment(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	pending, err := store.PendingCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, committer.commits)
+
+	// After a restart, we should resume waiting for the same anchor
+	// transaction instead of creating a new one.
+	commitment, err := NewCanonicalUniverse(cfg).UpdateChainCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, committer.commits)
+	require.Equal(t, pending.AnchorTx, commitment.AnchorTx)
+
+	_, err = store.PendingCommitment(ctx)
+	require.ErrorIs(t, err, ErrNoCommitment)
+
+	// The lock must not be held while waiting for the confirmation, so
+	// concurrent callers wait for the same pending commitment.
+	multiverse.setProof(randIssuanceProof(t, id))
+
+	confirmGate := make(chan struct{})
+	committer.Lock()
+	committer.confirmGate = confirmGate
+	committer.Unlock()
+
+	canonical := NewCanonicalUniverse(cfg)
+
+	const numCallers = 3
+	results := make(chan *Commitment, numCallers)
+	errs := make(chan error, numCallers)
+	for i := 0; i < numCallers; i++ {
+		go func() {
+			commitment, err := canonical.UpdateChainCommitment(ctx)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			results <- commitment
+		}()
+	}
+
+	// Each caller should get past the lock and wait for the confirmation
+	// of the same pending commitment.
+	require.Eventually(t, func() bool {
+		committer.Lock()
+		defer committer.Unlock()
+
+		return committer.waiting == numCallers
+	}, commitTimeout, 10*time.Millisecond)
+
+	close(confirmGate)
+
+	var confirmed []*Commitment
+	for i := 0; i < numCallers; i++ {
+		select {
+		case commitment := <-results:
+			confirmed = append(confirmed, commitment)
+
+		case err := <-errs:
+			t.Fatalf("unable to commit: %v", err)
+
+		case <-time.After(commitTimeout):
+			t.Fatalf("timeout waiting for commitment")
+		}
+	}
+
+	require.Equal(t, 2, committer.commits)
+	for _, commitment := range confirmed {
+		require.Equal(t, confirmed[0].AnchorTx, commitment.AnchorTx)
+	}
+	require.Len(t, store.commitments, 2)
+
+	// A rejected anchor transaction should be abandoned, so the next
+	// attempt creates a new one.
+	multiverse.setProof(randIssuanceProof(t, id))
+
+	committer.confirmErr = fmt.Errorf("%w: double spend",
+		ErrCommitmentTxRejected)
+	_, err = canonical.UpdateChainCommitment(ctx)
+	require.ErrorIs(t, err, ErrCommitmentTxRejected)
+	require.Equal(t, 3, committer.commits)
+
+	_, err = store.PendingCommitment(ctx)
+	require.ErrorIs(t, err, ErrNoCommitment)
+
+	_, err = canonical.UpdateChainCommitment(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, committer.commits)
+	require.Len(t, store.commitments, 3)
+}
+
+// newTestCommitment creates a new confirmed commitment of the given root with
+// the mock chain committer.
+func newTestCommitment(t *testing.T, committer *mockChainCommitter,
+	root mssmt.Node) *Commitment {
+
+	ctx := context.Background()
+
+	pending, err := committer.NewCommitmentTx(ctx, root)
+	require.NoError(t, err)
+
+	commitment, err := committer.ConfirmCommitment(ctx, pending)
+	require.NoError(t, err)
+
+	return commitment
+}
+
+// TestCommitmentVerify tests that a chain commitment only verifies if the
+// anchor transaction commits to the universe root and is part of the block.
+func TestCommitmentVerify(t *testing.T) {
+	t.Parallel()
+
+	root := mssmt.NewComputedBranch(mssmt.NodeHash(test.RandHash()), 7)
+	committer := &mockChainCommitter{}
+
+	headerVerifier := func(wire.BlockHeader, uint32) error {
+		return nil
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(c *Commitment)
+		err    string
+	}{
+		{
+			name:   "valid commitment",
+			modify: func(*Commitment) {},
+		},
+		{
+			name: "different root",
+			modify: func(c *Commitment) {
+				c.UniverseRoot = mssmt.NewComputedBranch(
+					c.UniverseRoot.NodeHash(), 8,
+				)
+			},
+			err: "doesn't commit to universe root",
+		},
+		{
+			name: "different internal key",
+			modify: func(c *Commitment) {
+				c.InternalKey = test.RandPubKey(t)
+			},
+			err: "doesn't commit to universe root",
+		},
+		{
+			name: "invalid output index",
+			modify: func(c *Commitment) {
+				c.OutputIndex = 1
+			},
+			err: "out of range",
+		},
+		{
+			name: "tx not in block",
+			modify: func(c *Commitment) {
+				c.BlockHeader.MerkleRoot = test.RandHash()
+			},
+			err: "invalid merkle proof",
+		},
+		{
+			name: "missing internal key",
+			modify: func(c *Commitment) {
+				c.InternalKey = (*btcec.PublicKey)(nil)
+			},
+			err: "missing internal key",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			commitment := newTestCommitment(t, committer, root)
+
+			testCase.modify(commitment)
+
+			err := commitment.Verify(headerVerifier)
+			if testCase.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, testCase.err)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package universe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+// RootCommitmentLeaf returns the tapscript leaf that commits to the given
+// multiverse root. The leaf script is an unspendable OP_RETURN script that
+// pushes the root hash followed by the big-endian root sum, so the output that
+// commits to the root can only be spent through the key path.
+func RootCommitmentLeaf(root mssmt.Node) (txscript.TapLeaf, error) {
+	rootHash := root.NodeHash()
+
+	var rootSum [8]byte
+	binary.BigEndian.PutUint64(rootSum[:], root.NodeSum())
+
+	script, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_RETURN).
+		AddData(rootHash[:]).
+		AddData(rootSum[:]).
+		Script()
+	if err != nil {
+		return txscript.TapLeaf{}, err
+	}
+
+	return txscript.NewBaseTapLeaf(script), nil
+}
+
+// RootCommitmentOutputKey returns the taproot output key that commits to the
+// given multiverse root, using the given internal key.
+func RootCommitmentOutputKey(internalKey *btcec.PublicKey,
+	root mssmt.Node) (*btcec.PublicKey, error) {
+
+	leaf, err := RootCommitmentLeaf(root)
+	if err != nil {
+		return nil, err
+	}
+
+	tapHash := leaf.TapHash()
+	return txscript.ComputeTaprootOutputKey(internalKey, tapHash[:]), nil
+}
+
+// RootCommitmentPkScript returns the pkScript of the taproot output that
+// commits to the given multiverse root, using the given internal key.
+func RootCommitmentPkScript(internalKey *btcec.PublicKey,
+	root mssmt.Node) ([]byte, error) {
+
+	outputKey, err := RootCommitmentOutputKey(internalKey, root)
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.PayToTaprootScript(outputKey)
+}
+
+// Verify checks that the commitment is valid: the anchor transaction must
+// commit to the universe root in the given output, and it must be included in
+// the block with the given header. The header itself is checked against the
+// chain with the given header verifier.
+func (c *Commitment) Verify(headerVerifier proof.HeaderVerifier) error {
+	switch {
+	case c.AnchorTx == nil:
+		return fmt.Errorf("missing anchor transaction")
+
+	case c.MerkleProof == nil:
+		return fmt.Errorf("missing merkle proof")
+
+	case c.InternalKey == nil:
+		return fmt.Errorf("missing internal key")
+
+	case c.UniverseRoot == nil:
+		return fmt.Errorf("missing universe root")
+
+	case int(c.OutputIndex) >= len(c.AnchorTx.TxOut):
+		return fmt.Errorf("output index %d out of range",
+			c.OutputIndex)
+	}
+
+	pkScript, err := RootCommitmentPkScript(c.InternalKey, c.UniverseRoot)
+	if err != nil {
+		return err
+	}
+
+	anchorOut := c.AnchorTx.TxOut[c.OutputIndex]
+	if !bytes.Equal(anchorOut.PkScript, pkScript) {
+		return fmt.Errorf("anchor output doesn't commit to universe " +
+			"root")
+	}
+
+	if !c.MerkleProof.Verify(c.AnchorTx, c.BlockHeader.MerkleRoot) {
+		return fmt.Errorf("invalid merkle proof for anchor " +
+			"transaction")
+	}
+
+	err = headerVerifier(c.BlockHeader, c.BlockHeight)
+	if err != nil {
+		return fmt.Errorf("invalid block header: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyMultiverseRoot verifies that the multiverse inclusion proof of the
+// universe root matches the specified multiverse root.
+func (i *Proof) VerifyMultiverseRoot(id Identifier,
+	expectedRoot mssmt.Node) bool {
+
+	if i.MultiverseInclusionProof == nil || i.UniverseRoot == nil {
+		return false
+	}
+
+	// The multiverse leaf of a universe commits to the universe root
+	// hash. For issuance trees, the sum is the number of universes rather
+	// than the number of issuance leaves.
+	universeRootHash := i.UniverseRoot.NodeHash()
+	leafSum := i.UniverseRoot.NodeSum()
+	if id.ProofType == ProofTypeIssuance {
+		leafSum = 1
+	}
+	leafNode := mssmt.NewLeafNode(universeRootHash[:], leafSum)
+
+	reconstructedRoot := i.MultiverseInclusionProof.Root(
+		id.Bytes(), leafNode,
+	)
+
+	return mssmt.IsEqualNode(i.MultiverseRoot, expectedRoot) &&
+		mssmt.IsEqualNode(reconstructedRoot, expectedRoot)
+}
+
+// Verify checks that the issuance proof is part of the multiverse root that
+// was committed to in the chain.
+func (c *CommittedIssuanceProof) Verify(
+	headerVerifier proof.HeaderVerifier) error {
+
+	switch {
+	case c.ChainProof == nil:
+		return fmt.Errorf("missing chain proof")
+
+	case c.TaprootAssetProof == nil:
+		return fmt.Errorf("missing issuance proof")
+	}
+
+	if err := c.ChainProof.Verify(headerVerifier); err != nil {
+		return fmt.Errorf("invalid chain proof: %w", err)
+	}
+
+	assetProof := c.TaprootAssetProof
+	if !assetProof.VerifyRoot(assetProof.UniverseRoot) {
+		return fmt.Errorf("invalid universe inclusion proof")
+	}
+
+	if !assetProof.VerifyMultiverseRoot(c.ID, c.ChainProof.UniverseRoot) {
+		return fmt.Errorf("invalid multiverse inclusion proof")
+	}
+
+	return nil
+}
@@ -0,0 +1,562 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+const (
+	// DefaultEventRetention is the default amount of time events are kept
+	// in the event log before they're pruned.
+	DefaultEventRetention = 30 * 24 * time.Hour
+
+	// DefaultMaxEventReplay is the default maximum number of logged events
+	// that are delivered to a new subscriber.
+	DefaultMaxEventReplay = 10_000
+
+	// eventPruneInterval is the interval at which the event log is pruned.
+	eventPruneInterval = time.Hour
+)
+
+var (
+	// ErrEventReplayTooLarge is returned if a subscriber asks for more
+	// logged events than can be replayed.
+	ErrEventReplayTooLarge = errors.New("too many universe events to " +
+		"replay")
+
+	// ErrCursorPruned is returned if a subscriber asks for the events
+	// after a cursor, but some of them were already pruned from the event
+	// log.
+	ErrCursorPruned = errors.New("universe events after cursor were " +
+		"pruned")
+)
+
+// EventType is the type of a Universe event.
+type EventType uint8
+
+const (
+	// EventTypeNewLeaf is emitted when a new issuance or transfer leaf was
+	// inserted into a universe.
+	EventTypeNewLeaf EventType = iota + 1
+
+	// EventTypeNewRoot is emitted when the root of a universe changed.
+	EventTypeNewRoot
+
+	// EventTypeSync is emitted when a universe was synced with a remote
+	// Universe server, and new leaves were fetched from it.
+	EventTypeSync
+)
+
+// String returns a human-readable string representation of the event type.
+func (t EventType) String() string {
+	switch t {
+	case EventTypeNewLeaf:
+		return "new_leaf"
+	case EventTypeNewRoot:
+		return "new_root"
+	case EventTypeSync:
+		return "sync"
+	}
+
+	return fmt.Sprintf("unknown(%v)", int(t))
+}
+
+// ParseStrEventType returns the event type corresponding to the given string.
+func ParseStrEventType(typeStr string) (EventType, error) {
+	switch typeStr {
+	case "new_leaf":
+		return EventTypeNewLeaf, nil
+	case "new_root":
+		return EventTypeNewRoot, nil
+	case "sync":
+		return EventTypeSync, nil
+	default:
+		return 0, fmt.Errorf("unknown event type: %v", typeStr)
+	}
+}
+
+// Event is a change to the local Universe that subscribers are notified
+// about.
+type Event struct {
+	// Cursor is the position of the event in the event log. Cursors are
+	// assigned in the order events are committed to the log, so a
+	// subscriber can resume from the last event it received.
+	Cursor uint64
+
+	// Type is the type of the event.
+	Type EventType
+
+	// ID is the identifier of the universe the event relates to.
+	ID Identifier
+
+	// LeafKey is the key of the new leaf. This is only set for new leaf
+	// events.
+	LeafKey *LeafKey
+
+	// LeafAssetID is the asset ID of the new leaf. This is only set for
+	// new leaf events, and differs between the leaves of a grouped asset
+	// universe.
+	LeafAssetID *asset.ID
+
+	// Root is the root of the universe after the event. This isn't set
+	// for new leaf events.
+	Root mssmt.Node
+
+	// ServerHost is the host of the remote Universe server that was synced
+	// with. This is only set for sync events.
+	ServerHost string
+
+	// Timestamp is the time the event was logged at.
+	Timestamp time.Time
+}
+
+// EventFilter is used to only select the events of a subset of universes.
+// Unset fields match all events.
+type EventFilter struct {
+	// AssetID selects the events of the universe of the asset, and the new
+	// leaf events of the asset within a grouped asset universe.
+	AssetID *asset.ID
+
+	// GroupKey selects the events of the universe of the asset group.
+	GroupKey *btcec.PublicKey
+
+	// ProofType selects the events of the universes of the proof type.
+	ProofType ProofType
+}
+
+// Matches returns true if the passed event is selected by the filter.
+func (f *EventFilter) Matches(event *Event) bool {
+	if f.ProofType != ProofTypeUnspecified &&
+		f.ProofType != event.ID.ProofType {
+
+		return false
+	}
+
+	if f.GroupKey != nil {
+		if event.ID.GroupKey == nil {
+			return false
+		}
+
+		if !bytes.Equal(
+			schnorr.SerializePubKey(f.GroupKey),
+			schnorr.SerializePubKey(event.ID.GroupKey),
+		) {
+
+			return false
+		}
+	}
+
+	if f.AssetID != nil {
+		leafMatches := event.LeafAssetID != nil &&
+			*event.LeafAssetID == *f.AssetID
+		uniMatches := event.ID.GroupKey == nil &&
+			event.ID.AssetID == *f.AssetID
+
+		if !leafMatches && !uniMatches {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewLeafEvent creates a new leaf event for the passed leaf.
+func NewLeafEvent(id Identifier, key LeafKey, leaf *Leaf) *Event {
+	event := &Event{
+		Type:    EventTypeNewLeaf,
+		ID:      id,
+		LeafKey: &key,
+	}
+	if leaf.Asset != nil {
+		leafAssetID := leaf.Asset.ID()
+		event.LeafAssetID = &leafAssetID
+	}
+
+	return event
+}
+
+// NewRootEvent creates a new root event for the passed universe root.
+func NewRootEvent(id Identifier, root mssmt.Node) *Event {
+	return &Event{
+		Type: EventTypeNewRoot,
+		ID:   id,
+		Root: root,
+	}
+}
+
+// EventLog is a persistent log of Universe events.
+type EventLog interface {
+	// LogEvents appends the passed events to the log, setting their cursor
+	// and timestamp.
+	LogEvents(ctx context.Context, events ...*Event) error
+
+	// FetchEvents returns up to limit events that were logged after the
+	// event with the given cursor, in the order they were logged.
+	FetchEvents(ctx context.Context, cursor uint64,
+		limit int) ([]*Event, error)
+
+	// LatestCursor returns the cursor of the last logged event, or zero if
+	// no events were logged yet.
+	LatestCursor(ctx context.Context) (uint64, error)
+
+	// FirstCursor returns the cursor of the oldest event in the log. If
+	// the log is empty, this is the cursor the next event will be logged
+	// with.
+	FirstCursor(ctx context.Context) (uint64, error)
+
+	// PruneEvents removes all events that were logged before the given
+	// time.
+	PruneEvents(ctx context.Context, before time.Time) error
+}
+
+// EventNotifierConfig is the config for the event notifier.
+type EventNotifierConfig struct {
+	// EventLog is used to persist events, so subscribers can resume from
+	// the last event they received.
+	EventLog EventLog
+
+	// Retention is the amount of time events are kept in the event log
+	// before they're pruned. Pruning is disabled if this is zero.
+	Retention time.Duration
+
+	// MaxReplay is the maximum number of logged events that are delivered
+	// to a new subscriber, after applying its filter. Subscribing with a
+	// cursor that lies further back is rejected. The number of replayed
+	// events isn't limited if this is zero.
+	MaxReplay uint64
+}
+
+// EventNotifier notifies subscribers about the changes to the local Universe
+// that were persisted in the event log. Subscribers can ask for the events
+// logged since a given cursor, which are delivered before any new events.
+type EventNotifier struct {
+	cfg EventNotifierConfig
+
+	*fn.ContextGuard
+
+	startOnce sync.Once
+
+	stopOnce sync.Once
+
+	// eventMtx serializes distributing events with registering new
+	// subscribers, so a subscriber doesn't miss or get duplicate events
+	// between its backlog and the live events.
+	eventMtx sync.Mutex
+
+	// lastCursor is the cursor of the last event that was distributed to
+	// the subscribers.
+	//
+	// NOTE: This must only be accessed while holding the eventMtx.
+	lastCursor uint64
+
+	// eventDistributor is used to distribute new events to subscribers.
+	eventDistributor *fn.EventDistributor[*Event]
+}
+
+// NewEventNotifier creates a new event notifier from the passed config.
+func NewEventNotifier(cfg EventNotifierConfig) *EventNotifier {
+	return &EventNotifier{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+		eventDistributor: fn.NewEventDistributor[*Event](),
+	}
+}
+
+// Start starts the event notifier. Only the events logged after the notifier
+// was started are distributed to live subscribers, older events can only be
+// replayed from the event log.
+func (n *EventNotifier) Start() error {
+	var startErr error
+	n.startOnce.Do(func() {
+		log.Infof("Starting Universe event notifier")
+
+		ctx, cancel := n.WithCtxQuit()
+		defer cancel()
+
+		cursor, err := n.cfg.EventLog.LatestCursor(ctx)
+		if err != nil {
+			startErr = fmt.Errorf("unable to fetch latest "+
+				"universe event cursor: %w", err)
+			return
+		}
+
+		n.eventMtx.Lock()
+		n.lastCursor = cursor
+		n.eventMtx.Unlock()
+
+		if n.cfg.Retention > 0 {
+			n.Wg.Add(1)
+			go n.pruneEvents()
+		}
+	})
+
+	return startErr
+}
+
+// Stop signals the event notifier to shut down and waits for the event log
+// pruning to finish.
+func (n *EventNotifier) Stop() error {
+	n.stopOnce.Do(func() {
+		log.Infof("Stopping Universe event notifier")
+
+		close(n.Quit)
+
+		n.Wg.Wait()
+	})
+
+	return nil
+}
+
+// pruneEvents periodically removes the events that are older than the
+// retention period from the event log.
+//
+// NOTE: This MUST be run as a goroutine.
+func (n *EventNotifier) pruneEvents() {
+	defer n.Wg.Done()
+
+	ticker := time.NewTicker(eventPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := n.WithCtxQuit()
+		before := time.Now().Add(-n.cfg.Retention)
+		err := n.cfg.EventLog.PruneEvents(ctx, before)
+		cancel()
+		if err != nil {
+			log.Warnf("Unable to prune universe event log: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-n.Quit:
+			return
+		}
+	}
+}
+
+// LogEvents appends the passed events to the event log and notifies all
+// subscribers about them before returning.
+func (n *EventNotifier) LogEvents(ctx context.Context, events ...*Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	err := n.cfg.EventLog.LogEvents(ctx, events...)
+	if err != nil {
+		return fmt.Errorf("unable to log universe events: %w", err)
+	}
+
+	return n.NotifyLoggedEvents(ctx)
+}
+
+// NotifyLoggedEvents notifies all subscribers about the events that were
+// logged since the last notification, in the order they were logged. This
+// should be called after events were written to the event log directly, for
+// example in the same database transaction as the change they describe.
+func (n *EventNotifier) NotifyLoggedEvents(ctx context.Context) error {
+	n.eventMtx.Lock()
+	defer n.eventMtx.Unlock()
+
+	for {
+		events, err := n.cfg.EventLog.FetchEvents(
+			ctx, n.lastCursor, MaxPageSize,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch universe events: "+
+				"%w", err)
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+
+		n.eventDistributor.NotifySubscribers(events...)
+		n.lastCursor = events[len(events)-1].Cursor
+
+		if len(events) < MaxPageSize {
+			return nil
+		}
+	}
+}
+
+// RegisterSubscriber adds a new subscriber for receiving events. The
+// deliverExisting boolean indicates whether already logged events should be
+// sent to the NewItemCreated channel when the subscription is started. The
+// deliverFrom cursor specifies the last event the subscriber already knows
+// about, only events logged after it are delivered. If deliverFrom is zero
+// then all logged events will be delivered. Events that are older than the
+// retention period were pruned from the log and can't be delivered anymore,
+// so ErrCursorPruned is returned if any of them would have to be delivered.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (n *EventNotifier) RegisterSubscriber(receiver *fn.EventReceiver[*Event],
+	deliverExisting bool, deliverFrom uint64) error {
+
+	return n.RegisterFilteredSubscriber(
+		receiver, nil, deliverExisting, deliverFrom,
+	)
+}
+
+// RegisterFilteredSubscriber adds a new subscriber for receiving events, like
+// RegisterSubscriber. Only the already logged events that are selected by the
+// filter are delivered and count towards the maximum number of replayed
+// events. New events are delivered unfiltered, so the subscriber still needs
+// to apply the filter to them.
+func (n *EventNotifier) RegisterFilteredSubscriber(
+	receiver *fn.EventReceiver[*Event], filter *EventFilter,
+	deliverExisting bool, deliverFrom uint64) error {
+
+	// No delivery of existing events requested, we only need to register
+	// the subscriber for new events.
+	if !deliverExisting {
+		n.eventMtx.Lock()
+		defer n.eventMtx.Unlock()
+
+		n.eventDistributor.RegisterSubscriber(receiver)
+		return nil
+	}
+
+	ctx, cancel := n.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	// If events after the cursor were pruned, the subscriber would
+	// silently miss them, so we refuse to resume from it.
+	if deliverFrom > 0 {
+		firstCursor, err := n.cfg.EventLog.FirstCursor(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to fetch first universe "+
+				"event cursor: %w", err)
+		}
+
+		if deliverFrom+1 < firstCursor {
+			return fmt.Errorf("%w: cursor %d, oldest event in "+
+				"the log has cursor %d", ErrCursorPruned,
+				deliverFrom, firstCursor)
+		}
+	}
+
+	// The backlog is buffered in the receiver's queue, so we stop
+	// replaying once more events than allowed were delivered.
+	replay := &eventReplay{
+		receiver: receiver,
+		filter:   filter,
+	}
+
+	// We deliver the bulk of the backlog without holding the event mutex,
+	// up to the last event that was distributed to live subscribers when
+	// we started.
+	n.eventMtx.Lock()
+	head := n.lastCursor
+	n.eventMtx.Unlock()
+
+	cursor, err := n.deliverEvents(ctx, replay, deliverFrom, head)
+	if err != nil {
+		return err
+	}
+
+	// Only the events distributed in the meantime remain to be delivered,
+	// which we do while holding the event mutex. No new events can be
+	// distributed until the subscriber is registered, so it receives all
+	// events after the ones we delivered.
+	n.eventMtx.Lock()
+	defer n.eventMtx.Unlock()
+
+	_, err = n.deliverEvents(ctx, replay, cursor, n.lastCursor)
+	if err != nil {
+		return err
+	}
+
+	n.eventDistributor.RegisterSubscriber(receiver)
+
+	return nil
+}
+
+// eventReplay tracks the logged events that are delivered to a new
+// subscriber.
+type eventReplay struct {
+	// receiver is the subscriber the events are delivered to.
+	receiver *fn.EventReceiver[*Event]
+
+	// filter selects the events that are delivered. All events are
+	// delivered if this is nil.
+	filter *EventFilter
+
+	// numEvents is the number of events that were delivered so far.
+	numEvents uint64
+}
+
+// deliverEvents sends the logged events after the from cursor up to and
+// including the to cursor that are selected by the filter of the replay to
+// its receiver, returning the cursor of the last event that was looked at. An
+// error is returned once more events than allowed were replayed.
+func (n *EventNotifier) deliverEvents(ctx context.Context, replay *eventReplay,
+	from, to uint64) (uint64, error) {
+
+	cursor := from
+	for cursor < to {
+		events, err := n.cfg.EventLog.FetchEvents(
+			ctx, cursor, MaxPageSize,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("unable to fetch universe "+
+				"events: %w", err)
+		}
+
+		for _, event := range events {
+			if event.Cursor > to {
+				return cursor, nil
+			}
+
+			cursor = event.Cursor
+			if replay.filter != nil &&
+				!replay.filter.Matches(event) {
+
+				continue
+			}
+
+			replay.numEvents++
+			if n.cfg.MaxReplay > 0 &&
+				replay.numEvents > n.cfg.MaxReplay {
+
+				return 0, fmt.Errorf("%w: more than %d "+
+					"events to replay",
+					ErrEventReplayTooLarge, n.cfg.MaxReplay)
+			}
+
+			replay.receiver.NewItemCreated.ChanIn() <- event
+		}
+
+		if len(events) < MaxPageSize {
+			break
+		}
+	}
+
+	return cursor, nil
+}
+
+// RemoveSubscriber removes the given subscriber and also stops it from
+// processing events.
+//
+// NOTE: This is part of the fn.EventPublisher interface.
+func (n *EventNotifier) RemoveSubscriber(
+	subscriber *fn.EventReceiver[*Event]) error {
+
+	return n.eventDistributor.RemoveSubscriber(subscriber)
+}
+
+// A compile time check to ensure EventNotifier meets the fn.EventPublisher
+// interface.
+var _ fn.EventPublisher[*Event, uint64] = (*EventNotifier)(nil)
@@ -0,0 +1,459 @@
+package universe
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// eventTimeout is the time we wait for an event to be delivered.
+	eventTimeout = 5 * time.Second
+)
+
+// mockEventLog is an in-memory EventLog.
+type mockEventLog struct {
+	sync.Mutex
+
+	events []*Event
+
+	numLogged uint64
+}
+
+func (m *mockEventLog) LogEvents(_ context.Context, events ...*Event) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, event := range events {
+		m.numLogged++
+		event.Cursor = m.numLogged
+		event.Timestamp = time.Now()
+		m.events = append(m.events, event)
+	}
+
+	return nil
+}
+
+func (m *mockEventLog) FetchEvents(_ context.Context, cursor uint64,
+	limit int) ([]*Event, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return m.eventsAfter(cursor, limit), nil
+}
+
+func (m *mockEventLog) eventsAfter(cursor uint64, limit int) []*Event {
+	var events []*Event
+	for _, event := range m.events {
+		if event.Cursor <= cursor {
+			continue
+		}
+
+		events = append(events, event)
+		if len(events) == limit {
+			break
+		}
+	}
+
+	return events
+}
+
+func (m *mockEventLog) LatestCursor(context.Context) (uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.numLogged, nil
+}
+
+func (m *mockEventLog) FirstCursor(context.Context) (uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	if len(m.events) == 0 {
+		return m.numLogged + 1, nil
+	}
+
+	return m.events[0].Cursor, nil
+}
+
+// numEvents returns the number of events in the log.
+func (m *mockEventLog) numEvents() int {
+	m.Lock()
+	defer m.Unlock()
+
+	return len(m.events)
+}
+
+func (m *mockEventLog) PruneEvents(_ context.Context, before time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+
+	events := m.events[:0]
+	for _, event := range m.events {
+		if !event.Timestamp.Before(before) {
+			events = append(events, event)
+		}
+	}
+	m.events = events
+
+	return nil
+}
+
+// randLeafEvents creates the given number of random new leaf events.
+func randLeafEvents(t *testing.T, num int) []*Event {
+	events := make([]*Event, num)
+	for i := range events {
+		var id Identifier
+		test.RandRead(t, id.AssetID[:])
+		id.ProofType = ProofTypeIssuance
+
+		events[i] = &Event{
+			Type: EventTypeNewLeaf,
+			ID:   id,
+			LeafKey: &LeafKey{
+				OutPoint: test.RandOp(t),
+			},
+			LeafAssetID: &id.AssetID,
+		}
+	}
+
+	return events
+}
+
+// receiveEvents reads the given number of events from the receiver and
+// returns their cursors.
+func receiveEvents(t *testing.T, receiver *fn.EventReceiver[*Event],
+	num int) []uint64 {
+
+	cursors := make([]uint64, 0, num)
+	for i := 0; i < num; i++ {
+		event, err := fn.RecvOrTimeout(
+			receiver.NewItemCreated.ChanOut(), eventTimeout,
+		)
+		require.NoError(t, err, "event %d", i)
+
+		cursors = append(cursors, (*event).Cursor)
+	}
+
+	return cursors
+}
+
+// assertNoEvents asserts that the receiver has no pending events.
+func assertNoEvents(t *testing.T, receiver *fn.EventReceiver[*Event]) {
+	select {
+	case event := <-receiver.NewItemCreated.ChanOut():
+		t.Fatalf("unexpected event: %v", event.Cursor)
+
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// cursorRange returns the cursors from start to end, inclusive.
+func cursorRange(start, end uint64) []uint64 {
+	var cursors []uint64
+	for cursor := start; cursor <= end; cursor++ {
+		cursors = append(cursors, cursor)
+	}
+
+	return cursors
+}
+
+// TestEventNotifier tests that subscribers receive the logged events after
+// their resume cursor, followed by new events, without missing any or
+// receiving duplicates.
+func TestEventNotifier(t *testing.T) {
+	t.Parallel()
+
+	notifier := NewEventNotifier(EventNotifierConfig{
+		EventLog: &mockEventLog{},
+	})
+	require.NoError(t, notifier.Start())
+	t.Cleanup(func() {
+		require.NoError(t, notifier.Stop())
+	})
+
+	// We'll first log more events than fit into a single page, so the
+	// backlog needs to be fetched in multiple pages.
+	numBacklog := MaxPageSize + 10
+	ctx := context.Background()
+	backlog := randLeafEvents(t, numBacklog)
+	require.NoError(t, notifier.LogEvents(ctx, backlog...))
+
+	// A subscriber that doesn't ask for existing events should only
+	// receive new events.
+	liveSub := fn.NewEventReceiver[*Event](fn.DefaultQueueSize)
+	require.NoError(t, notifier.RegisterSubscriber(liveSub, false, 0))
+
+	// A subscriber resuming from a cursor should only receive the events
+	// after it, while a subscriber with a zero cursor gets all events.
+	resumeSub := fn.NewEventReceiver[*Event](fn.DefaultQueueSize)
+	require.NoError(t, notifier.RegisterSubscriber(resumeSub, true, 100))
+
+	allSub := fn.NewEventReceiver[*Event](fn.DefaultQueueSize)
+	require.NoError(t, notifier.RegisterSubscriber(allSub, true, 0))
+
+	require.Equal(
+		t, cursorRange(101, uint64(numBacklog)),
+		receiveEvents(t, resumeSub, numBacklog-100),
+	)
+	require.Equal(
+		t, cursorRange(1, uint64(numBacklog)),
+		receiveEvents(t, allSub, numBacklog),
+	)
+	assertNoEvents(t, liveSub)
+
+	// New events are delivered to all subscribers in order.
+	require.NoError(t, notifier.LogEvents(ctx, randLeafEvents(t, 3)...))
+
+	newCursors := cursorRange(uint64(numBacklog)+1, uint64(numBacklog)+3)
+	for _, sub := range []*fn.EventReceiver[*Event]{
+		liveSub, resumeSub, allSub,
+	} {
+		require.Equal(t, newCursors, receiveEvents(t, sub, 3))
+		assertNoEvents(t, sub)
+	}
+
+	// Once removed, a subscriber no longer receives any events.
+	require.NoError(t, notifier.RemoveSubscriber(liveSub))
+	require.Error(t, notifier.RemoveSubscriber(liveSub))
+
+	require.NoError(t, notifier.LogEvents(ctx, randLeafEvents(t, 1)...))
+	require.Equal(
+		t, []uint64{uint64(numBacklog) + 4},
+		receiveEvents(t, allSub, 1),
+	)
+}
+
+// TestEventNotifierLoggedEvents tests that events written to the event log
+// directly are delivered once the notifier is told about them, and that the
+// number of replayed events is capped.
+func TestEventNotifierLoggedEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	eventLog := &mockEventLog{}
+
+	// Events logged before the notifier is started are only available
+	// through a replay.
+	require.NoError(t, eventLog.LogEvents(ctx, randLeafEvents(t, 5)...))
+
+	notifier := NewEventNotifier(EventNotifierConfig{
+		EventLog:  eventLog,
+		MaxReplay: 4,
+	})
+	require.NoError(t, notifier.Start())
+	t.Cleanup(func() {
+		require.NoError(t, notifier.Stop())
+	})
+
+	liveSub := fn.NewEventReceiver[*Event](fn.DefaultQueueSize)
+	require.NoError(t, notifier.RegisterSubscriber(liveSub, false, 0))
+
+	require.NoError(t, notifier.NotifyLoggedEvents(ctx))
+	assertNoEvents(t, liveSub)
+
+	// Events that are written to the log directly, for example in the
+	// same transaction as a new leaf, are delivered once the notifier is
+	// told about them.
+	require.NoError(t, eventLog.LogEvents(ctx, randLeafEvents(t, 2)...))
+	assertNoEvents(t, liveSub)
+
+	require.NoError(t, notifier.NotifyLoggedEvents(ctx))
+	require.Equal(t, cursorRange(6, 7), receiveEvents(t, liveSub, 2))
+	assertNoEvents(t, liveSub)
+
+	// Replaying more events than allowed is rejected.
+	allSub := fn.NewEventReceiver[*Event](fn.DefaultQueueSize)
+	err := notifier.RegisterSubscriber(allSub, true, 0)
+	require.ErrorIs(t, err, ErrEventReplayTooLarge)
+
+	// The limit only applies to the events that match the filter of the
+	// subscriber.
+	filter := &EventFilter{
+		AssetID: &eventLog.events[1].ID.AssetID,
+	}
+	filteredSub := fn.NewEventReceiver[*Event](fn.DefaultQueueSize)
+	require.NoError(t, notifier.RegisterFilteredSubscriber(
+		filteredSub, filter, true, 0,
+	))
+	require.Equal(t, []uint64{2}, receiveEvents(t, filteredSub, 1))
+	assertNoEvents(t, filteredSub)
+
+	// A subscriber that is close enough to the head of the log receives
+	// the backlog, followed by new events.
+	resumeSub := fn.NewEventReceiver[*Event](fn.DefaultQueueSize)
+	require.NoError(t, notifier.RegisterSubscriber(resumeSub, true, 3))
+	require.Equal(t, cursorRange(4, 7), receiveEvents(t, resumeSub, 4))
+
+	require.NoError(t, notifier.LogEvents(ctx, randLeafEvents(t, 1)...))
+	require.Equal(t, []uint64{8}, receiveEvents(t, resumeSub, 1))
+	require.Equal(t, []uint64{8}, receiveEvents(t, liveSub, 1))
+}
+
+// TestEventNotifierPrune tests that the notifier prunes the events that are
+// older than the retention period from the event log.
+func TestEventNotifierPrune(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	eventLog := &mockEventLog{}
+
+	require.NoError(t, eventLog.LogEvents(ctx, randLeafEvents(t, 3)...))
+
+	eventLog.Lock()
+	eventLog.events[0].Timestamp = time.Now().Add(-2 * time.Hour)
+	eventLog.Unlock()
+
+	notifier := NewEventNotifier(EventNotifierConfig{
+		EventLog:  eventLog,
+		Retention: time.Hour,
+	})
+	require.NoError(t, notifier.Start())
+	t.Cleanup(func() {
+		require.NoError(t, notifier.Stop())
+	})
+
+	// The event log is pruned when the notifier starts, which only
+	// removes the old event.
+	require.Eventually(t, func() bool {
+		return eventLog.numEvents() == 2
+	}, eventTimeout, 10*time.Millisecond)
+
+	sub := fn.NewEventReceiver[*Event](fn.DefaultQueueSize)
+	require.NoError(t, notifier.RegisterSubscriber(sub, true, 0))
+	require.Equal(t, cursorRange(2, 3), receiveEvents(t, sub, 2))
+	assertNoEvents(t, sub)
+
+	// A subscriber that already received the pruned event can resume.
+	resumeSub := fn.NewEventReceiver[*Event](fn.DefaultQueueSize)
+	require.NoError(t, notifier.RegisterSubscriber(resumeSub, true, 1))
+	require.Equal(t, cursorRange(2, 3), receiveEvents(t, resumeSub, 2))
+
+	// Once all events are pruned, a subscriber that knows about all of
+	// them can still resume, but one that would miss some is rejected.
+	eventLog.Lock()
+	eventLog.events = nil
+	eventLog.Unlock()
+
+	require.NoError(t, notifier.RegisterSubscriber(
+		fn.NewEventReceiver[*Event](fn.DefaultQueueSize), true, 3,
+	))
+	err := notifier.RegisterSubscriber(
+		fn.NewEventReceiver[*Event](fn.DefaultQueueSize), true, 2,
+	)
+	require.ErrorIs(t, err, ErrCursorPruned)
+}
+
+// TestEventFilter tests that the event filter selects the events of the
+// expected universes.
+func TestEventFilter(t *testing.T) {
+	t.Parallel()
+
+	var assetID, otherAssetID asset.ID
+	test.RandRead(t, assetID[:])
+	test.RandRead(t, otherAssetID[:])
+
+	groupKey := test.RandPubKey(t)
+
+	assetEvent := &Event{
+		Type: EventTypeNewRoot,
+		ID: Identifier{
+			AssetID:   assetID,
+			ProofType: ProofTypeIssuance,
+		},
+	}
+	groupLeafEvent := &Event{
+		Type: EventTypeNewLeaf,
+		ID: Identifier{
+			GroupKey:  groupKey,
+			ProofType: ProofTypeTransfer,
+		},
+		LeafAssetID: &assetID,
+	}
+	groupRootEvent := &Event{
+		Type: EventTypeNewRoot,
+		ID: Identifier{
+			GroupKey:  groupKey,
+			ProofType: ProofTypeTransfer,
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		filter  EventFilter
+		matches []bool
+	}{
+		{
+			name:    "empty filter",
+			filter:  EventFilter{},
+			matches: []bool{true, true, true},
+		},
+		{
+			name: "asset ID",
+			filter: EventFilter{
+				AssetID: &assetID,
+			},
+			matches: []bool{true, true, false},
+		},
+		{
+			name: "other asset ID",
+			filter: EventFilter{
+				AssetID: &otherAssetID,
+			},
+			matches: []bool{false, false, false},
+		},
+		{
+			name: "group key",
+			filter: EventFilter{
+				GroupKey: groupKey,
+			},
+			matches: []bool{false, true, true},
+		},
+		{
+			name: "other group key",
+			filter: EventFilter{
+				GroupKey: test.RandPubKey(t),
+			},
+			matches: []bool{false, false, false},
+		},
+		{
+			name: "proof type",
+			filter: EventFilter{
+				ProofType: ProofTypeIssuance,
+			},
+			matches: []bool{true, false, false},
+		},
+		{
+			name: "group key and proof type",
+			filter: EventFilter{
+				GroupKey:  groupKey,
+				ProofType: ProofTypeIssuance,
+			},
+			matches: []bool{false, false, false},
+		},
+	}
+
+	events := []*Event{assetEvent, groupLeafEvent, groupRootEvent}
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			for i, event := range events {
+				require.Equal(
+					t, testCase.matches[i],
+					testCase.filter.Matches(event),
+					"event %d", i,
+				)
+			}
+		})
+	}
+}
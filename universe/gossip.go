@@ -0,0 +1,433 @@
+package universe
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lightninglabs/neutrino/cache/lru"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultGossipHops is the default number of times a new proof is
+	// relayed between federation members after it was first announced.
+	DefaultGossipHops = 3
+
+	// DefaultDiscoveryDepth is the default number of federation hops we'll
+	// follow when discovering new federation members.
+	DefaultDiscoveryDepth = 2
+
+	// numSeenAnnouncements is the number of proof announcements we'll
+	// remember to de-duplicate announcements relayed by several peers.
+	numSeenAnnouncements = 50_000
+
+	// maxDiscoveredServers is the maximum number of new servers we'll
+	// discover in a single discovery round.
+	maxDiscoveredServers = 100
+)
+
+// announcementID uniquely identifies a proof announcement, independent of the
+// number of hops it has left.
+type announcementID [32]byte
+
+// newAnnouncementID derives the ID of the given proof announcement from the
+// universe it targets, its leaf key and the leaf itself.
+func newAnnouncementID(announcement *ProofAnnouncement) announcementID {
+	idBytes := announcement.ID.Bytes()
+	leafKey := announcement.Key.UniverseKey()
+	leafHash := announcement.Leaf.SmtLeafNode().NodeHash()
+
+	h := sha256.New()
+	_, _ = h.Write([]byte{byte(announcement.ID.ProofType)})
+	_, _ = h.Write(idBytes[:])
+	_, _ = h.Write(leafKey[:])
+	_, _ = h.Write(leafHash[:])
+
+	var id announcementID
+	copy(id[:], h.Sum(nil))
+
+	return id
+}
+
+// seenAnnouncement is the value of an entry in the cache of seen
+// announcements.
+type seenAnnouncement struct{}
+
+// Size just returns 1 as we're limiting based on the total number of
+// announcements.
+func (s *seenAnnouncement) Size() (uint64, error) {
+	return 1, nil
+}
+
+// GossipConfig is the config that the Gossiper uses to announce new proofs to
+// the federation and to relay the announcements of other servers.
+type GossipConfig struct {
+	// LocalRegistrar is used to insert announced proofs into the local
+	// Universe before relaying them.
+	LocalRegistrar Registrar
+
+	// FederationDB is used to fetch the set of federation members that
+	// announcements are sent to.
+	FederationDB FederationLog
+
+	// NewRemoteGossipPeer is a function that returns a new gossip peer for
+	// the target remote Universe.
+	NewRemoteGossipPeer func(ServerAddr) (GossipPeer, error)
+
+	// NewRemoteRegistrar is a function that returns a new registrar for
+	// the target remote Universe. This is used to push new proofs directly
+	// to federation members that don't support proof announcements.
+	NewRemoteRegistrar func(ServerAddr) (Registrar, error)
+
+	// MaxHops is the number of times a new proof is relayed after it was
+	// first announced. Announcements that claim more hops left are capped
+	// to this value.
+	MaxHops uint32
+
+	// DiscoveryDepth is the number of federation hops we'll follow when
+	// discovering new federation members.
+	DiscoveryDepth uint32
+
+	// RelayRate is the maximum number of relayed announcements per second
+	// that are sent to a single federation member. Announcements of our
+	// own proofs aren't rate limited.
+	RelayRate rate.Limit
+
+	// RelayBurst is the burst budget of relayed announcements that are
+	// sent to a single federation member.
+	RelayBurst int
+}
+
+// Gossiper announces new proofs to the members of the federation, and relays
+// the announcements it receives from other servers. Announcements are
+// de-duplicated and only relayed for a limited number of hops, so a new proof
+// reaches the whole federation without waiting for the next sync. The relayed
+// announcements sent to each federation member are rate limited, the periodic
+// sync will catch up on any announcement we drop. Our own proofs are always
+// announced to every federation member.
+type Gossiper struct {
+	cfg GossipConfig
+
+	*fn.ContextGuard
+
+	startOnce sync.Once
+
+	stopOnce sync.Once
+
+	// seenMtx guards the check and insertion of seen announcements.
+	seenMtx sync.Mutex
+
+	// seen is the set of announcements we've already handled.
+	seen *lru.Cache[announcementID, *seenAnnouncement]
+
+	// limiterMtx guards relayLimiters.
+	limiterMtx sync.Mutex
+
+	// relayLimiters are the rate limiters of the announcements we send,
+	// keyed by the host of the federation member.
+	relayLimiters map[string]*rate.Limiter
+}
+
+// NewGossiper creates a new gossiper from the passed config.
+func NewGossiper(cfg GossipConfig) *Gossiper {
+	return &Gossiper{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+		seen: lru.NewCache[announcementID, *seenAnnouncement](
+			numSeenAnnouncements,
+		),
+		relayLimiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// Start starts the gossiper.
+func (g *Gossiper) Start() error {
+	g.startOnce.Do(func() {
+		log.Infof("Starting Gossiper")
+	})
+
+	return nil
+}
+
+// Stop signals the gossiper to shut down and waits for any pending relays to
+// finish.
+func (g *Gossiper) Stop() error {
+	g.stopOnce.Do(func() {
+		log.Infof("Stopping Gossiper")
+
+		close(g.Quit)
+
+		g.Wg.Wait()
+
+		log.Infof("Stopped Gossiper")
+	})
+
+	return nil
+}
+
+// markSeen marks the given announcement as seen. False is returned if the
+// announcement was already seen before.
+func (g *Gossiper) markSeen(id announcementID) bool {
+	g.seenMtx.Lock()
+	defer g.seenMtx.Unlock()
+
+	if _, err := g.seen.Get(id); err == nil {
+		return false
+	}
+
+	_, _ = g.seen.Put(id, &seenAnnouncement{})
+
+	return true
+}
+
+// relayLimiter returns the rate limiter for announcements sent to the given
+// federation member.
+func (g *Gossiper) relayLimiter(addr ServerAddr) *rate.Limiter {
+	g.limiterMtx.Lock()
+	defer g.limiterMtx.Unlock()
+
+	host := addr.HostStr()
+	limiter, ok := g.relayLimiters[host]
+	if !ok {
+		limiter = rate.NewLimiter(g.cfg.RelayRate, g.cfg.RelayBurst)
+		g.relayLimiters[host] = limiter
+	}
+
+	return limiter
+}
+
+// Announce announces a new proof that was inserted into the local Universe
+// to all members of the federation.
+func (g *Gossiper) Announce(ctx context.Context, id Identifier, key LeafKey,
+	leaf *Leaf) {
+
+	announcement := &ProofAnnouncement{
+		ID:       id,
+		Key:      key,
+		Leaf:     leaf,
+		HopsLeft: g.cfg.MaxHops,
+	}
+
+	// We mark our own announcement as seen, so we don't insert it again
+	// once it's relayed back to us.
+	g.markSeen(newAnnouncementID(announcement))
+
+	g.relay(ctx, announcement, true)
+}
+
+// HandleAnnouncement handles a proof announcement from another server. If the
+// announcement is new, then the proof is inserted into the local Universe and
+// relayed to the federation in the background, if it has any hops left. True
+// is returned if the announcement was already known.
+func (g *Gossiper) HandleAnnouncement(ctx context.Context,
+	announcement *ProofAnnouncement) (bool, error) {
+
+	if announcement.Leaf == nil {
+		return false, fmt.Errorf("announcement is missing the proof " +
+			"leaf")
+	}
+
+	announcementID := newAnnouncementID(announcement)
+	if !g.markSeen(announcementID) {
+		log.Tracef("Ignoring known proof announcement, "+
+			"universe_id=%v", announcement.ID.StringForLog())
+
+		return true, nil
+	}
+
+	_, err := g.cfg.LocalRegistrar.UpsertProofLeaf(
+		ctx, announcement.ID, announcement.Key, announcement.Leaf,
+	)
+	if err != nil {
+		// Inserting the proof might have failed temporarily, so we'll
+		// forget about the announcement to allow it to be retried.
+		g.seen.Delete(announcementID)
+
+		return false, fmt.Errorf("unable to insert announced proof: "+
+			"%w", err)
+	}
+
+	// We won't relay an announcement further than we'd relay our own.
+	hopsLeft := announcement.HopsLeft
+	if hopsLeft > g.cfg.MaxHops {
+		hopsLeft = g.cfg.MaxHops
+	}
+	if hopsLeft == 0 {
+		return false, nil
+	}
+
+	relayed := *announcement
+	relayed.HopsLeft = hopsLeft - 1
+
+	g.Wg.Add(1)
+	go func() {
+		defer g.Wg.Done()
+
+		ctx, cancel := g.WithCtxQuitNoTimeout()
+		defer cancel()
+
+		g.relay(ctx, &relayed, false)
+	}()
+
+	return false, nil
+}
+
+// relay sends the given announcement to all members of the federation in
+// parallel. Only announcements that aren't of our own proofs are rate limited.
+func (g *Gossiper) relay(ctx context.Context, announcement *ProofAnnouncement,
+	local bool) {
+	fedServers, err := g.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		log.Warnf("Unable to fetch set of universe servers: %v", err)
+		return
+	}
+	if len(fedServers) == 0 {
+		return
+	}
+
+	log.Infof("Announcing new proof to %v federation members, "+
+		"universe_id=%v, hops_left=%v", len(fedServers),
+		announcement.ID.StringForLog(), announcement.HopsLeft)
+
+	sendAnnouncement := func(ctx context.Context, addr ServerAddr) error {
+		g.sendAnnouncement(ctx, addr, announcement, local)
+		return nil
+	}
+
+	err = fn.ParSlice(ctx, fedServers, sendAnnouncement)
+	if err != nil {
+		log.Errorf("Unable to announce proof to federation: %v", err)
+	}
+}
+
+// sendAnnouncement sends the given announcement to a single federation
+// member. If the member doesn't support announcements, then the proof is
+// inserted directly instead. Announcements of our own proofs are never
+// dropped, as no other server would announce them for us.
+func (g *Gossiper) sendAnnouncement(ctx context.Context, addr ServerAddr,
+	announcement *ProofAnnouncement, local bool) {
+
+	if !local && !g.relayLimiter(addr).Allow() {
+		log.Debugf("Not announcing proof to rate limited server(%v)",
+			addr.HostStr())
+		return
+	}
+
+	peer, err := g.cfg.NewRemoteGossipPeer(addr)
+	if err != nil {
+		log.Warnf("Cannot announce proof, unable to connect to "+
+			"remote server(%v): %v", addr.HostStr(), err)
+		return
+	}
+
+	known, err := peer.AnnounceProof(ctx, announcement)
+	switch {
+	// Older servers don't support announcements, so we'll push the proof
+	// to them directly. They won't relay the proof any further.
+	case errors.Is(err, ErrGossipUnsupported):
+		g.pushProof(ctx, addr, announcement)
+
+	case err != nil:
+		log.Warnf("Cannot announce proof to remote server(%v): %v",
+			addr.HostStr(), err)
+
+	case known:
+		log.Tracef("Proof already known to remote server(%v)",
+			addr.HostStr())
+	}
+}
+
+// pushProof inserts the proof of the given announcement directly into the
+// Universe of the given federation member.
+func (g *Gossiper) pushProof(ctx context.Context, addr ServerAddr,
+	announcement *ProofAnnouncement) {
+
+	registrar, err := g.cfg.NewRemoteRegistrar(addr)
+	if err != nil {
+		log.Warnf("Cannot push proof, unable to connect to remote "+
+			"server(%v): %v", addr.HostStr(), err)
+		return
+	}
+
+	_, err = registrar.UpsertProofLeaf(
+		ctx, announcement.ID, announcement.Key, announcement.Leaf,
+	)
+	if err != nil {
+		log.Warnf("Cannot push proof to remote server(%v): %v",
+			addr.HostStr(), err)
+	}
+}
+
+// DiscoverServers discovers the federation members of the given servers, and
+// in turn their federation members, up to the configured discovery depth.
+// Only servers that aren't part of our own federation or the given set of
+// servers are returned.
+func (g *Gossiper) DiscoverServers(ctx context.Context,
+	addrs []ServerAddr) ([]ServerAddr, error) {
+
+	fedServers, err := g.cfg.FederationDB.UniverseServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch set of universe "+
+			"servers: %w", err)
+	}
+
+	knownHosts := make(map[string]struct{})
+	for _, addr := range append(fedServers, addrs...) {
+		knownHosts[addr.HostStr()] = struct{}{}
+	}
+
+	var (
+		discovered []ServerAddr
+		frontier   = addrs
+	)
+	for depth := uint32(0); depth < g.cfg.DiscoveryDepth; depth++ {
+		var nextFrontier []ServerAddr
+		for _, addr := range frontier {
+			peer, err := g.cfg.NewRemoteGossipPeer(addr)
+			if err != nil {
+				log.Debugf("Unable to connect to remote "+
+					"server(%v): %v", addr.HostStr(), err)
+				continue
+			}
+
+			peerServers, err := peer.FederationServers(ctx)
+			if err != nil {
+				log.Debugf("Unable to fetch federation of "+
+					"remote server(%v): %v", addr.HostStr(),
+					err)
+				continue
+			}
+
+			for _, peerServer := range peerServers {
+				host := peerServer.HostStr()
+				if _, ok := knownHosts[host]; ok {
+					continue
+				}
+				knownHosts[host] = struct{}{}
+
+				// The ID of the server is only meaningful to
+				// the remote server, so we drop it.
+				newAddr := NewServerAddrFromStr(host)
+				discovered = append(discovered, newAddr)
+				nextFrontier = append(nextFrontier, newAddr)
+
+				if len(discovered) >= maxDiscoveredServers {
+					return discovered, nil
+				}
+			}
+		}
+
+		frontier = nextFrontier
+	}
+
+	log.Infof("Discovered %v new federation members", len(discovered))
+
+	return discovered, nil
+}
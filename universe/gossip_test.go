@@ -0,0 +1,378 @@
+package universe
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// mockRegistrar is a Registrar that counts the insertions of each leaf.
+type mockRegistrar struct {
+	sync.Mutex
+
+	inserts map[[32]byte]int
+}
+
+func newMockRegistrar() *mockRegistrar {
+	return &mockRegistrar{
+		inserts: make(map[[32]byte]int),
+	}
+}
+
+func (m *mockRegistrar) UpsertProofLeaf(_ context.Context, _ Identifier,
+	key LeafKey, _ *Leaf) (*Proof, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.inserts[key.UniverseKey()]++
+
+	return &Proof{}, nil
+}
+
+func (m *mockRegistrar) numInserts(key LeafKey) int {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.inserts[key.UniverseKey()]
+}
+
+// mockFederationLog is a FederationLog with a static set of servers.
+type mockFederationLog struct {
+	FederationLog
+
+	servers []ServerAddr
+}
+
+func (m *mockFederationLog) UniverseServers(
+	context.Context) ([]ServerAddr, error) {
+
+	return m.servers, nil
+}
+
+// mockGossipNode is a single Universe server in a mock gossip network.
+type mockGossipNode struct {
+	gossiper *Gossiper
+
+	registrar *mockRegistrar
+
+	federation *mockFederationLog
+
+	// noGossip indicates the node doesn't support proof announcements.
+	noGossip bool
+}
+
+// mockGossipNetwork is a network of Universe servers that gossip proofs
+// through in-memory gossip peers.
+type mockGossipNetwork struct {
+	nodes map[string]*mockGossipNode
+
+	// order is the order the nodes were added to the network in.
+	order []string
+}
+
+// mockGossipPeer is a GossipPeer that directly calls into the gossiper of a
+// node in the mock network.
+type mockGossipPeer struct {
+	node *mockGossipNode
+}
+
+func (m *mockGossipPeer) AnnounceProof(ctx context.Context,
+	announcement *ProofAnnouncement) (bool, error) {
+
+	if m.node.noGossip {
+		return false, ErrGossipUnsupported
+	}
+
+	// The announcement is serialized over the wire, so the receiving node
+	// gets its own copy.
+	received := *announcement
+	return m.node.gossiper.HandleAnnouncement(ctx, &received)
+}
+
+func (m *mockGossipPeer) FederationServers(
+	context.Context) ([]ServerAddr, error) {
+
+	return m.node.federation.servers, nil
+}
+
+// newMockGossipNetwork creates a network of the given nodes, where each node
+// has the given federation members.
+func newMockGossipNetwork(t *testing.T, maxHops uint32, relayBurst int,
+	federations map[string][]string, order []string) *mockGossipNetwork {
+
+	network := &mockGossipNetwork{
+		nodes: make(map[string]*mockGossipNode),
+		order: order,
+	}
+
+	for _, host := range order {
+		node := &mockGossipNode{
+			registrar:  newMockRegistrar(),
+			federation: &mockFederationLog{},
+		}
+		for _, member := range federations[host] {
+			node.federation.servers = append(
+				node.federation.servers,
+				NewServerAddrFromStr(member),
+			)
+		}
+
+		node.gossiper = NewGossiper(GossipConfig{
+			LocalRegistrar: node.registrar,
+			FederationDB:   node.federation,
+			NewRemoteGossipPeer: func(
+				addr ServerAddr) (GossipPeer, error) {
+
+				return &mockGossipPeer{
+					node: network.nodes[addr.HostStr()],
+				}, nil
+			},
+			NewRemoteRegistrar: func(
+				addr ServerAddr) (Registrar, error) {
+
+				return network.nodes[addr.HostStr()].registrar,
+					nil
+			},
+			MaxHops:        maxHops,
+			DiscoveryDepth: DefaultDiscoveryDepth,
+			RelayRate:      rate.Limit(1),
+			RelayBurst:     relayBurst,
+		})
+		require.NoError(t, node.gossiper.Start())
+
+		network.nodes[host] = node
+	}
+
+	t.Cleanup(func() {
+		for _, node := range network.nodes {
+			require.NoError(t, node.gossiper.Stop())
+		}
+	})
+
+	return network
+}
+
+// waitForRelays waits until all relays in the network have finished. As
+// relays are only started when an announcement is first seen, waiting for
+// the nodes in the order they were added is enough for a chain of nodes.
+func (m *mockGossipNetwork) waitForRelays() {
+	for _, host := range m.order {
+		m.nodes[host].gossiper.Wg.Wait()
+	}
+}
+
+// randAnnouncedLeaf creates a random issuance leaf to announce.
+func randAnnouncedLeaf(t *testing.T) (Identifier, LeafKey, *Leaf) {
+	genesisAsset := randGenesisAsset(t)
+	scriptKey := asset.RandScriptKey(t)
+
+	id := Identifier{
+		AssetID:   genesisAsset.ID(),
+		ProofType: ProofTypeIssuance,
+	}
+	leafKey := LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: &scriptKey,
+	}
+	leaf := &Leaf{
+		Asset:    &genesisAsset,
+		RawProof: test.RandBytes(100),
+		Amt:      genesisAsset.Amount,
+	}
+
+	return id, leafKey, leaf
+}
+
+// TestGossiperRelay tests that announcements are relayed along a chain of
+// federation members up to the maximum number of hops, and that each node
+// only inserts an announced proof once.
+func TestGossiperRelay(t *testing.T) {
+	t.Parallel()
+
+	// We'll create a chain of nodes, where each node is federated with
+	// its neighbours.
+	order := []string{"a", "b", "c", "d", "e"}
+	federations := map[string][]string{
+		"a": {"b"},
+		"b": {"a", "c"},
+		"c": {"b", "d"},
+		"d": {"c", "e"},
+		"e": {"d"},
+	}
+	network := newMockGossipNetwork(t, 2, 100, federations, order)
+
+	ctx := context.Background()
+	id, leafKey, leaf := randAnnouncedLeaf(t)
+	network.nodes["a"].gossiper.Announce(ctx, id, leafKey, leaf)
+	network.waitForRelays()
+
+	// The proof is relayed twice after it was first pushed to b, so it
+	// should reach d but not e. The announcing node only pushes the proof
+	// out and never inserts it itself.
+	expectedInserts := map[string]int{
+		"a": 0,
+		"b": 1,
+		"c": 1,
+		"d": 1,
+		"e": 0,
+	}
+	for host, numInserts := range expectedInserts {
+		require.Equal(
+			t, numInserts,
+			network.nodes[host].registrar.numInserts(leafKey),
+			"node %v", host,
+		)
+	}
+
+	// Announcing the same proof again from another node shouldn't result
+	// in any new inserts, as every node that got it already knows it.
+	network.nodes["b"].gossiper.Announce(ctx, id, leafKey, leaf)
+	network.waitForRelays()
+
+	for host, numInserts := range expectedInserts {
+		require.Equal(
+			t, numInserts,
+			network.nodes[host].registrar.numInserts(leafKey),
+			"node %v", host,
+		)
+	}
+
+	// An announcement that claims more hops left than a node is
+	// configured for is capped.
+	id, leafKey, leaf = randAnnouncedLeaf(t)
+	known, err := network.nodes["a"].gossiper.HandleAnnouncement(
+		ctx, &ProofAnnouncement{
+			ID:       id,
+			Key:      leafKey,
+			Leaf:     leaf,
+			HopsLeft: 100,
+		},
+	)
+	require.NoError(t, err)
+	require.False(t, known)
+	network.waitForRelays()
+
+	expectedInserts = map[string]int{
+		"a": 1,
+		"b": 1,
+		"c": 1,
+		"d": 0,
+		"e": 0,
+	}
+	for host, numInserts := range expectedInserts {
+		require.Equal(
+			t, numInserts,
+			network.nodes[host].registrar.numInserts(leafKey),
+			"node %v", host,
+		)
+	}
+
+	// Handling the same announcement again should report it as known.
+	known, err = network.nodes["c"].gossiper.HandleAnnouncement(
+		ctx, &ProofAnnouncement{
+			ID:   id,
+			Key:  leafKey,
+			Leaf: leaf,
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, known)
+}
+
+// TestGossiperFallbackAndRateLimit tests that proofs are pushed directly to
+// federation members that don't support announcements, and that only the
+// relayed announcements sent to a single member are rate limited.
+func TestGossiperFallbackAndRateLimit(t *testing.T) {
+	t.Parallel()
+
+	order := []string{"a", "b", "c"}
+	federations := map[string][]string{
+		"a": {"b", "c"},
+		"b": {"a"},
+		"c": {"a"},
+	}
+	network := newMockGossipNetwork(t, 2, 1, federations, order)
+	network.nodes["c"].noGossip = true
+
+	// Our own proofs should reach both nodes, even though c doesn't
+	// support announcements. They aren't rate limited, so more proofs
+	// than the burst budget are all announced.
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		id, leafKey, leaf := randAnnouncedLeaf(t)
+		network.nodes["a"].gossiper.Announce(ctx, id, leafKey, leaf)
+		network.waitForRelays()
+
+		require.Equal(
+			t, 1, network.nodes["b"].registrar.numInserts(leafKey),
+		)
+		require.Equal(
+			t, 1, network.nodes["c"].registrar.numInserts(leafKey),
+		)
+	}
+
+	// relayAnnouncement lets node a handle the announcement of a new
+	// proof, which it relays to its federation members.
+	relayAnnouncement := func() LeafKey {
+		id, leafKey, leaf := randAnnouncedLeaf(t)
+		_, err := network.nodes["a"].gossiper.HandleAnnouncement(
+			ctx, &ProofAnnouncement{
+				ID:       id,
+				Key:      leafKey,
+				Leaf:     leaf,
+				HopsLeft: 1,
+			},
+		)
+		require.NoError(t, err)
+		network.waitForRelays()
+
+		return leafKey
+	}
+
+	// The first relayed proof uses up the burst budget of each node.
+	leafKey := relayAnnouncement()
+	require.Equal(t, 1, network.nodes["b"].registrar.numInserts(leafKey))
+	require.Equal(t, 1, network.nodes["c"].registrar.numInserts(leafKey))
+
+	// So the next proof relayed right away shouldn't be sent.
+	leafKey = relayAnnouncement()
+	require.Zero(t, network.nodes["b"].registrar.numInserts(leafKey))
+	require.Zero(t, network.nodes["c"].registrar.numInserts(leafKey))
+}
+
+// TestGossiperDiscoverServers tests that servers are discovered transitively
+// through the federation, up to the configured depth.
+func TestGossiperDiscoverServers(t *testing.T) {
+	t.Parallel()
+
+	order := []string{"a", "b", "c", "d", "e"}
+	federations := map[string][]string{
+		"a": {},
+		"b": {"a", "c"},
+		"c": {"b", "d"},
+		"d": {"c", "e"},
+		"e": {"d"},
+	}
+	network := newMockGossipNetwork(t, 2, 100, federations, order)
+
+	// Node a adds b to its federation, and discovers b's federation
+	// members, then their federation members. Node e is three hops away
+	// and won't be discovered. Node a discovers itself, which the
+	// federation envoy filters out when checking the servers.
+	discovered, err := network.nodes["a"].gossiper.DiscoverServers(
+		context.Background(), []ServerAddr{NewServerAddrFromStr("b")},
+	)
+	require.NoError(t, err)
+
+	hosts := make([]string, 0, len(discovered))
+	for _, addr := range discovered {
+		hosts = append(hosts, addr.HostStr())
+	}
+	require.ElementsMatch(t, []string{"a", "c", "d"}, hosts)
+}
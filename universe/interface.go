@@ -32,6 +32,27 @@ var (
 	// ErrNoUniverseProofFound is returned when a user attempts to look up
 	// a key in the universe that actually points to the empty leaf.
 	ErrNoUniverseProofFound = fmt.Errorf("no universe proof found")
+
+	// ErrNoCommitment is returned when no on chain commitment of the
+	// multiverse root is found.
+	ErrNoCommitment = fmt.Errorf("no universe commitment found")
+
+	// ErrRootNotCommitted is returned when a proof is queried against a
+	// chain commitment whose multiverse root doesn't include the proof.
+	ErrRootNotCommitted = fmt.Errorf("multiverse root not committed")
+
+	// ErrCommitmentTxRejected is returned when the anchor transaction of
+	// a pending chain commitment can't be published.
+	ErrCommitmentTxRejected = fmt.Errorf("commitment transaction rejected")
+
+	// ErrBranchQueryUnsupported is returned when a remote Universe doesn't
+	// support querying the branches of its universe trees.
+	ErrBranchQueryUnsupported = fmt.Errorf("universe branch queries not " +
+		"supported")
+
+	// ErrGossipUnsupported is returned when a remote Universe doesn't
+	// support proof announcements.
+	ErrGossipUnsupported = fmt.Errorf("proof announcements not supported")
 )
 
 const (
@@ -286,13 +307,17 @@ type MultiverseArchive interface {
 	RootNodes(ctx context.Context, q RootNodesQuery) ([]Root, error)
 
 	// UpsertProofLeaf upserts a proof leaf within the multiverse tree and
-	// the universe tree that corresponds to the given key.
+	// the universe tree that corresponds to the given key. The new leaf
+	// and new root events are appended to the event log atomically with
+	// the leaf.
 	UpsertProofLeaf(ctx context.Context, id Identifier, key LeafKey,
 		leaf *Leaf,
 		metaReveal *proof.MetaReveal) (*Proof, error)
 
 	// UpsertProofLeafBatch upserts a proof leaf batch within the multiverse
-	// tree and the universe tree that corresponds to the given key(s).
+	// tree and the universe tree that corresponds to the given key(s). The
+	// new leaf and new root events are appended to the event log
+	// atomically with the leaves.
 	UpsertProofLeafBatch(ctx context.Context, items []*Item) error
 
 	// FetchProofLeaf returns a proof leaf for the target key. If the key
@@ -313,6 +338,15 @@ type MultiverseArchive interface {
 	// universe.
 	UniverseLeafKeys(ctx context.Context,
 		q UniverseLeafKeysQuery) ([]LeafKey, error)
+
+	// UniverseBranch returns the branch at the given path of the MS-SMT of
+	// the given universe.
+	UniverseBranch(ctx context.Context, id Identifier,
+		path mssmt.NodePath) (*Branch, error)
+
+	// RootNode returns the root multiverse node for the given proof type.
+	RootNode(ctx context.Context,
+		proofType ProofType) (*MultiverseRoot, error)
 }
 
 // Registrar is an interface that allows a caller to upsert a proof leaf in a
@@ -519,6 +553,36 @@ type DiffEngine interface {
 	// of diff
 	FetchProofLeaf(ctx context.Context, id Identifier,
 		key LeafKey) ([]*Proof, error)
+
+	// UniverseBranch returns the branch at the given path of the MS-SMT of
+	// the given universe. This allows a syncer to bisect the trees of two
+	// universes to find the leaves that differ, instead of comparing all
+	// their leaf keys.
+	//
+	// NOTE: ErrBranchQueryUnsupported is returned if the universe doesn't
+	// support branch queries.
+	UniverseBranch(ctx context.Context, id Identifier,
+		path mssmt.NodePath) (*Branch, error)
+}
+
+// Branch is the subtree at a given path of the MS-SMT of a universe.
+type Branch struct {
+	// Path is the path from the root of the universe tree to the node of
+	// the branch.
+	Path mssmt.NodePath
+
+	// Node is the node at the path, committing to all the leaves of the
+	// branch.
+	Node mssmt.Node
+
+	// LeafKey is the key of the only leaf of the branch. If the branch is
+	// empty or has more than one leaf, this is nil.
+	LeafKey *LeafKey
+}
+
+// IsEmpty returns true if the branch doesn't contain any leaves.
+func (b *Branch) IsEmpty() bool {
+	return mssmt.IsEqualNode(b.Node, mssmt.EmptyTree[b.Path.Depth])
 }
 
 // Commitment is an on chain universe commitment. This includes the merkle
@@ -535,6 +599,17 @@ type Commitment struct {
 	// anchor output was included.
 	MerkleProof *proof.TxMerkleProof
 
+	// AnchorTx is the transaction that anchors the universe root.
+	AnchorTx *wire.MsgTx
+
+	// OutputIndex is the index of the output of the anchor transaction
+	// that commits to the universe root.
+	OutputIndex uint32
+
+	// InternalKey is the internal key of the taproot output that commits
+	// to the universe root.
+	InternalKey *btcec.PublicKey
+
 	// UniverseRoot is the full Universe root for this commitment.
 	UniverseRoot mssmt.Node
 }
@@ -543,6 +618,9 @@ type Commitment struct {
 // commitment with an issuance proof. This allows remote callers to verify that
 // their responses re actually committed to within the chain.
 type CommittedIssuanceProof struct {
+	// ID is the identifier of the universe the issuance proof belongs to.
+	ID Identifier
+
 	// ChainProof is the on chain proof that shows the Universe root has
 	// been stamped in the chain.
 	ChainProof *Commitment
@@ -551,29 +629,131 @@ type CommittedIssuanceProof struct {
 	TaprootAssetProof *Proof
 }
 
-// ChainCommitter is used to commit a Universe backend in the chain.
+// PendingCommitment is a chain commitment of the multiverse root whose anchor
+// transaction has been signed, but hasn't confirmed yet.
+type PendingCommitment struct {
+	// AnchorTx is the signed transaction that anchors the universe root.
+	AnchorTx *wire.MsgTx
+
+	// OutputIndex is the index of the output of the anchor transaction
+	// that commits to the universe root.
+	OutputIndex uint32
+
+	// InternalKey is the internal key of the taproot output that commits
+	// to the universe root.
+	InternalKey *btcec.PublicKey
+
+	// UniverseRoot is the full Universe root for this commitment.
+	UniverseRoot mssmt.Node
+
+	// HeightHint is the block height at which the anchor transaction was
+	// created. The transaction can't confirm below this height.
+	HeightHint uint32
+}
+
+// CommitmentSnapshot is a snapshot of the issuance multiverse. It's stored
+// along with each chain commitment, so issuance proofs can still be tied to
+// the commitment after the multiverse changed.
+type CommitmentSnapshot struct {
+	// Root is the root of the issuance multiverse.
+	Root mssmt.Node
+}
+
+// ChainCommitter is used to commit a Universe root in the chain.
 type ChainCommitter interface {
-	// CommitUniverse takes a Universe and returns a new commitment to that
-	// Universe in the main chain.
-	CommitUniverse(universe BaseBackend) (*Commitment, error)
+	// NewCommitmentTx creates and signs a new transaction that anchors
+	// the given multiverse root, without publishing it.
+	NewCommitmentTx(ctx context.Context,
+		root mssmt.Node) (*PendingCommitment, error)
+
+	// ConfirmCommitment publishes the anchor transaction of the pending
+	// commitment and returns the commitment once the transaction has
+	// confirmed. It's safe to call this more than once for the same
+	// commitment.
+	//
+	// NOTE: ErrCommitmentTxRejected is returned if the transaction will
+	// never be accepted, for example because it's a double spend, in
+	// which case its inputs are released. Any other error leaves the
+	// commitment intact, so it can be confirmed on the next attempt.
+	ConfirmCommitment(ctx context.Context,
+		pending *PendingCommitment) (*Commitment, error)
+
+	// ReleaseCommitmentTx releases the inputs of an anchor transaction
+	// that was never published.
+	ReleaseCommitmentTx(ctx context.Context,
+		pending *PendingCommitment) error
+}
+
+// CommitmentStore is used to persist the on chain commitments of the
+// multiverse root.
+type CommitmentStore interface {
+	// SnapshotMultiverse takes and stores a snapshot of the current
+	// issuance multiverse, so a commitment of its root can be inserted.
+	// A snapshot that isn't used by any commitment is eventually removed.
+	SnapshotMultiverse(ctx context.Context) (*CommitmentSnapshot, error)
+
+	// InsertPendingCommitment stores a new commitment whose anchor
+	// transaction hasn't confirmed yet. The given snapshot of the
+	// multiverse it commits to must have been taken by the store.
+	InsertPendingCommitment(ctx context.Context,
+		pending *PendingCommitment, snapshot *CommitmentSnapshot) error
+
+	// PendingCommitment returns the commitment that is waiting for its
+	// anchor transaction to confirm.
+	//
+	// NOTE: ErrNoCommitment is returned if there's no pending commitment.
+	PendingCommitment(ctx context.Context) (*PendingCommitment, error)
+
+	// AbandonPendingCommitment removes a pending commitment whose anchor
+	// transaction will never confirm, along with its snapshot unless it's
+	// used by another commitment.
+	AbandonPendingCommitment(ctx context.Context,
+		pending *PendingCommitment) error
+
+	// InsertCommitment stores a new confirmed chain commitment, replacing
+	// the pending commitment with the same anchor output. Inserting the
+	// same commitment more than once is a no-op.
+	InsertCommitment(ctx context.Context, commitment *Commitment) error
+
+	// LatestCommitment returns the chain commitment with the greatest
+	// block height.
+	//
+	// NOTE: ErrNoCommitment is returned if there are no commitments.
+	LatestCommitment(ctx context.Context) (*Commitment, error)
+
+	// CommitmentAtHeight returns the latest chain commitment that was
+	// confirmed at or below the given block height.
+	//
+	// NOTE: ErrNoCommitment is returned if there is no such commitment.
+	CommitmentAtHeight(ctx context.Context,
+		height uint32) (*Commitment, error)
+
+	// FetchCommittedProof returns the universe and multiverse inclusion
+	// proofs of the given issuance leaf, as of the snapshot of the given
+	// committed multiverse root. The Leaf of the returned proof isn't set.
+	//
+	// NOTE: ErrNoUniverseProofFound is returned if the leaf isn't part of
+	// the snapshot.
+	FetchCommittedProof(ctx context.Context, multiverseRoot mssmt.Node,
+		id Identifier, key LeafKey) (*Proof, error)
 }
 
 // Canonical is an interface that allows a caller to query for the latest
 // canonical Universe information related to an asset.
-//
-// TODO(roasbeef): sync methods too, divide into read/write?
 type Canonical interface {
-	BaseBackend
-
-	// Query returns a fully proved response for the target base key.
-	Query(context.Context, LeafKey) (*CommittedIssuanceProof, error)
+	// Query returns a fully proved response for the target issuance leaf,
+	// tied to the chain commitment that was the latest one at the given
+	// block height. If the height is zero, then the latest commitment is
+	// used.
+	Query(ctx context.Context, id Identifier, key LeafKey,
+		height uint32) (*CommittedIssuanceProof, error)
 
 	// LatestCommitment returns the latest chain commitment.
-	LatestCommitment() (*Commitment, error)
+	LatestCommitment(ctx context.Context) (*Commitment, error)
 
-	// UpdateChainCommitment takes in a series of chain commitments and
-	// updates the commitment on chain.
-	UpdateChainCommitment(chainCommits ...ChainCommitter) (*Commitment, error)
+	// UpdateChainCommitment anchors the current multiverse root in the
+	// chain, if it isn't already committed to by the latest commitment.
+	UpdateChainCommitment(ctx context.Context) (*Commitment, error)
 }
 
 // FederationLog is used to keep track of the set Universe servers that
@@ -594,6 +774,37 @@ type FederationLog interface {
 	LogNewSyncs(ctx context.Context, addrs ...ServerAddr) error
 }
 
+// ProofAnnouncement is a gossip message that announces a new proof leaf to
+// the members of the federation.
+type ProofAnnouncement struct {
+	// ID identifies the Universe tree the new leaf belongs to.
+	ID Identifier
+
+	// Key is the leaf key of the new leaf.
+	Key LeafKey
+
+	// Leaf is the new leaf, which includes the proof itself.
+	Leaf *Leaf
+
+	// HopsLeft is the number of times the announcement may still be
+	// relayed by the receiving server to its own federation members.
+	HopsLeft uint32
+}
+
+// GossipPeer is a remote Universe server that takes part in the gossip of new
+// proofs across the federation.
+type GossipPeer interface {
+	// AnnounceProof announces a new proof leaf to the remote server. True
+	// is returned if the remote server already knew about the
+	// announcement.
+	AnnounceProof(ctx context.Context,
+		announcement *ProofAnnouncement) (bool, error)
+
+	// FederationServers returns the set of servers in the federation of
+	// the remote server.
+	FederationServers(ctx context.Context) ([]ServerAddr, error)
+}
+
 // ProofType is an enum that describes the type of proof which can be stored in
 // a given universe.
 type ProofType uint8
@@ -0,0 +1,876 @@
+package universe
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// SnapshotPrefixMagicBytes are the magic bytes that prefix a universe
+	// snapshot file.
+	SnapshotPrefixMagicBytes = [4]byte{'T', 'A', 'P', 'U'}
+
+	// ErrSnapshotInvalid is the error that's returned when a universe
+	// snapshot is invalid.
+	ErrSnapshotInvalid = errors.New("universe snapshot is invalid")
+)
+
+// SnapshotVersion denotes the versioning scheme for universe snapshot files.
+type SnapshotVersion uint32
+
+const (
+	// SnapshotV0 is the first version of the universe snapshot file.
+	SnapshotV0 SnapshotVersion = 0
+
+	// SnapshotMaxRecordSizeBytes is the maximum size of a single record of
+	// a snapshot file, which holds one chunk of the snapshot of a
+	// universe. This matches the maximum size of an RPC message, as each
+	// chunk is sent in a single message.
+	SnapshotMaxRecordSizeBytes = 200 * 1024 * 1024
+
+	// SnapshotChunkSizeBytes is the size at which the leaves of a universe
+	// snapshot are split into chunks. A chunk is only larger than this if
+	// it holds a single leaf with a larger proof.
+	SnapshotChunkSizeBytes = 4 * 1024 * 1024
+)
+
+// SnapshotLeaf is a single leaf of a universe snapshot.
+type SnapshotLeaf struct {
+	// Key is the key the leaf is stored at.
+	Key LeafKey
+
+	// Leaf is the proof leaf stored at the key.
+	Leaf *Leaf
+}
+
+// encodedSize returns the number of bytes the leaf takes up in an encoded
+// snapshot chunk.
+func (l *SnapshotLeaf) encodedSize() int {
+	numProofBytes := uint64(len(l.Leaf.RawProof))
+
+	return chainhash.HashSize + 4 + btcec.PubKeyBytesLenCompressed +
+		int(tlv.VarIntSize(numProofBytes)) + int(numProofBytes)
+}
+
+// SnapshotChunk is a consecutive range of the leaves of a universe snapshot.
+// The snapshots of large universes are split into several chunks, so each of
+// them fits into a single RPC message. Every chunk carries the root of its
+// universe, which the leaves are verified against once all chunks of the
+// universe were received.
+type SnapshotChunk struct {
+	// ID is the identifier of the universe.
+	ID Identifier
+
+	// Root is the root of the universe the snapshot was taken at.
+	Root mssmt.Node
+
+	// NumLeaves is the total number of leaves of the universe snapshot.
+	NumLeaves uint64
+
+	// Offset is the index of the first leaf of the chunk within all
+	// leaves of the universe snapshot.
+	Offset uint64
+
+	// Leaves are the leaves of the chunk, in the order they were inserted
+	// into the universe.
+	Leaves []*SnapshotLeaf
+}
+
+// IsLast returns true if the chunk holds the last leaves of its universe.
+func (c *SnapshotChunk) IsLast() bool {
+	return c.Offset+uint64(len(c.Leaves)) == c.NumLeaves
+}
+
+// Encode encodes the snapshot chunk into the passed writer.
+func (c *SnapshotChunk) Encode(w io.Writer) error {
+	var tlvBuf [8]byte
+
+	err := binary.Write(w, binary.BigEndian, uint8(c.ID.ProofType))
+	if err != nil {
+		return err
+	}
+
+	// The universe is either identified by a group key, or an asset ID.
+	// We use the length of the identifier to tell them apart.
+	uniID := c.ID.AssetID[:]
+	if c.ID.GroupKey != nil {
+		uniID = c.ID.GroupKey.SerializeCompressed()
+	}
+	if err := tlv.WriteVarInt(w, uint64(len(uniID)), &tlvBuf); err != nil {
+		return err
+	}
+	if _, err := w.Write(uniID); err != nil {
+		return err
+	}
+
+	rootHash := c.Root.NodeHash()
+	if _, err := w.Write(rootHash[:]); err != nil {
+		return err
+	}
+	err = binary.Write(w, binary.BigEndian, c.Root.NodeSum())
+	if err != nil {
+		return err
+	}
+
+	err = tlv.WriteVarInt(w, c.NumLeaves, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	err = tlv.WriteVarInt(w, c.Offset, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	err = tlv.WriteVarInt(w, uint64(len(c.Leaves)), &tlvBuf)
+	if err != nil {
+		return err
+	}
+	for _, leaf := range c.Leaves {
+		err := wire.WriteOutPoint(w, 0, 0, &leaf.Key.OutPoint)
+		if err != nil {
+			return err
+		}
+
+		scriptKey := leaf.Key.ScriptKey.PubKey.SerializeCompressed()
+		if _, err := w.Write(scriptKey); err != nil {
+			return err
+		}
+
+		rawProof := leaf.Leaf.RawProof
+		err = tlv.WriteVarInt(w, uint64(len(rawProof)), &tlvBuf)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(rawProof); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode decodes a snapshot chunk from the passed reader. The proofs of the
+// leaves are decoded to populate the leaves, but the chunk isn't verified.
+func (c *SnapshotChunk) Decode(r io.Reader) error {
+	var tlvBuf [8]byte
+
+	var proofType uint8
+	if err := binary.Read(r, binary.BigEndian, &proofType); err != nil {
+		return err
+	}
+	c.ID = Identifier{
+		ProofType: ProofType(proofType),
+	}
+
+	uniIDLen, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	switch uniIDLen {
+	case sha256.Size:
+		if _, err := io.ReadFull(r, c.ID.AssetID[:]); err != nil {
+			return err
+		}
+
+	case btcec.PubKeyBytesLenCompressed:
+		var groupKey [btcec.PubKeyBytesLenCompressed]byte
+		if _, err := io.ReadFull(r, groupKey[:]); err != nil {
+			return err
+		}
+
+		c.ID.GroupKey, err = btcec.ParsePubKey(groupKey[:])
+		if err != nil {
+			return fmt.Errorf("%w: invalid group key: %v",
+				ErrSnapshotInvalid, err)
+		}
+
+	default:
+		return fmt.Errorf("%w: invalid universe identifier length: %v",
+			ErrSnapshotInvalid, uniIDLen)
+	}
+
+	var (
+		rootHash mssmt.NodeHash
+		rootSum  uint64
+	)
+	if _, err := io.ReadFull(r, rootHash[:]); err != nil {
+		return err
+	}
+	if err := binary.Read(r, binary.BigEndian, &rootSum); err != nil {
+		return err
+	}
+	c.Root = mssmt.NewComputedBranch(rootHash, rootSum)
+
+	c.NumLeaves, err = tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	c.Offset, err = tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	numChunkLeaves, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return err
+	}
+	if c.Offset > c.NumLeaves || numChunkLeaves > c.NumLeaves-c.Offset {
+		return fmt.Errorf("%w: chunk leaves out of range",
+			ErrSnapshotInvalid)
+	}
+
+	// We don't allocate the leaves up front, as the number of leaves
+	// isn't verified yet. The size of a chunk is capped instead.
+	c.Leaves = nil
+	for i := uint64(0); i < numChunkLeaves; i++ {
+		var outPoint wire.OutPoint
+		if _, err := io.ReadFull(r, outPoint.Hash[:]); err != nil {
+			return err
+		}
+		err := binary.Read(r, binary.LittleEndian, &outPoint.Index)
+		if err != nil {
+			return err
+		}
+
+		var scriptKeyBytes [btcec.PubKeyBytesLenCompressed]byte
+		if _, err := io.ReadFull(r, scriptKeyBytes[:]); err != nil {
+			return err
+		}
+		scriptPubKey, err := btcec.ParsePubKey(scriptKeyBytes[:])
+		if err != nil {
+			return fmt.Errorf("%w: invalid script key: %v",
+				ErrSnapshotInvalid, err)
+		}
+		scriptKey := asset.NewScriptKey(scriptPubKey)
+
+		numProofBytes, err := tlv.ReadVarInt(r, &tlvBuf)
+		if err != nil {
+			return err
+		}
+		if numProofBytes > proof.FileMaxProofSizeBytes {
+			return fmt.Errorf("%w: proof in snapshot too large",
+				ErrSnapshotInvalid)
+		}
+
+		rawProof := make([]byte, numProofBytes)
+		if _, err := io.ReadFull(r, rawProof); err != nil {
+			return err
+		}
+
+		leaf, err := decodeSnapshotLeaf(rawProof)
+		if err != nil {
+			return fmt.Errorf("%w: unable to decode proof: %v",
+				ErrSnapshotInvalid, err)
+		}
+
+		c.Leaves = append(c.Leaves, &SnapshotLeaf{
+			Key: LeafKey{
+				OutPoint:  outPoint,
+				ScriptKey: &scriptKey,
+			},
+			Leaf: leaf,
+		})
+	}
+
+	return nil
+}
+
+// decodeSnapshotLeaf decodes the proof leaf of a snapshot from its raw proof.
+func decodeSnapshotLeaf(rawProof []byte) (*Leaf, error) {
+	var leafProof proof.Proof
+	if err := leafProof.Decode(bytes.NewReader(rawProof)); err != nil {
+		return nil, err
+	}
+
+	return &Leaf{
+		GenesisWithGroup: GenesisWithGroup{
+			Genesis:  leafProof.Asset.Genesis,
+			GroupKey: leafProof.Asset.GroupKey,
+		},
+		RawProof: rawProof,
+		Asset:    &leafProof.Asset,
+		Amt:      leafProof.Asset.Amount,
+	}, nil
+}
+
+// verifySnapshotLeaf checks that the leaf of a snapshot belongs to the
+// universe with the given identifier.
+func verifySnapshotLeaf(id Identifier, leaf *SnapshotLeaf) error {
+	leafAsset := leaf.Leaf.Asset
+
+	err := ValidateProofUniverseType(leafAsset, id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSnapshotInvalid, err)
+	}
+
+	switch {
+	case id.GroupKey != nil:
+		if leafAsset.GroupKey == nil || !bytes.Equal(
+			schnorr.SerializePubKey(id.GroupKey),
+			schnorr.SerializePubKey(
+				&leafAsset.GroupKey.GroupPubKey,
+			),
+		) {
+
+			return fmt.Errorf("%w: leaf asset %v not in asset "+
+				"group", ErrSnapshotInvalid, leafAsset.ID())
+		}
+
+	case leafAsset.ID() != id.AssetID:
+		return fmt.Errorf("%w: leaf asset %v not in universe",
+			ErrSnapshotInvalid, leafAsset.ID())
+	}
+
+	return nil
+}
+
+// WriteSnapshotHeader writes the header of a snapshot file to the passed
+// writer. The header is followed by the records of the universe snapshot
+// chunks.
+func WriteSnapshotHeader(w io.Writer) error {
+	if _, err := w.Write(SnapshotPrefixMagicBytes[:]); err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, uint32(SnapshotV0))
+}
+
+// ReadSnapshotHeader reads the header of a snapshot file from the passed
+// reader, and makes sure the version of the file is known.
+func ReadSnapshotHeader(r io.Reader) error {
+	var prefixMagicBytes [len(SnapshotPrefixMagicBytes)]byte
+	if _, err := io.ReadFull(r, prefixMagicBytes[:]); err != nil {
+		return err
+	}
+
+	if prefixMagicBytes != SnapshotPrefixMagicBytes {
+		return fmt.Errorf("invalid prefix magic bytes, expected %s, "+
+			"got %s", string(SnapshotPrefixMagicBytes[:]),
+			string(prefixMagicBytes[:]))
+	}
+
+	var version uint32
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return err
+	}
+	if SnapshotVersion(version) != SnapshotV0 {
+		return fmt.Errorf("unknown universe snapshot version: %v",
+			version)
+	}
+
+	return nil
+}
+
+// WriteSnapshotRecord writes a single encoded universe snapshot chunk to a
+// snapshot file.
+func WriteSnapshotRecord(w io.Writer, record []byte) error {
+	var tlvBuf [8]byte
+	err := tlv.WriteVarInt(w, uint64(len(record)), &tlvBuf)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(record)
+	return err
+}
+
+// ReadSnapshotRecord reads the next encoded universe snapshot chunk from a
+// snapshot file. io.EOF is returned once all records were read.
+func ReadSnapshotRecord(r io.Reader) ([]byte, error) {
+	var tlvBuf [8]byte
+	recordLen, err := tlv.ReadVarInt(r, &tlvBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	if recordLen > SnapshotMaxRecordSizeBytes {
+		return nil, fmt.Errorf("%w: universe snapshot chunk too large",
+			ErrSnapshotInvalid)
+	}
+
+	record := make([]byte, recordLen)
+	if _, err := io.ReadFull(r, record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// SnapshotSource is used to read the leaves of a local universe for a
+// snapshot.
+type SnapshotSource interface {
+	// ReadUniverseSnapshot reads the root and all leaves of the universe
+	// with the given identifier from a single consistent view of the
+	// universe. The start callback is called with the root and the total
+	// number of leaves first, then the page callback is called with
+	// consecutive pages of at most pageSize leaves, in the order they
+	// were inserted. ErrNoUniverseRoot is returned if the universe
+	// doesn't exist.
+	//
+	// NOTE: The start callback is called again if the read needs to be
+	// retried.
+	ReadUniverseSnapshot(ctx context.Context, id Identifier, pageSize int,
+		start func(root mssmt.Node, numLeaves uint64) error,
+		page func(leaves []*SnapshotLeaf) error) error
+}
+
+// SnapshotConfig is the config for the snapshotter.
+type SnapshotConfig struct {
+	// LocalSnapshots is used to read the leaves of the local universes
+	// that are exported.
+	LocalSnapshots SnapshotSource
+
+	// LocalDiffEngine is used to read the roots and leaf keys of the
+	// local universes.
+	LocalDiffEngine DiffEngine
+
+	// LocalRegistrar is used to insert the leaves of an imported snapshot
+	// into the local universes.
+	LocalRegistrar BatchRegistrar
+
+	// BatchSize is the number of leaves to read or insert in a single
+	// batch.
+	BatchSize int
+
+	// StagingDir is the directory the chunks of a snapshot are staged in
+	// while it's being imported. The default directory for temporary
+	// files is used if this is empty.
+	StagingDir string
+}
+
+// Snapshotter exports snapshots of the local universes, and imports the
+// snapshots taken by other Universe servers. This allows a new Universe
+// server to bootstrap its state much faster than syncing leaf by leaf.
+type Snapshotter struct {
+	cfg SnapshotConfig
+}
+
+// NewSnapshotter creates a new snapshotter from the passed config.
+func NewSnapshotter(cfg SnapshotConfig) *Snapshotter {
+	return &Snapshotter{
+		cfg: cfg,
+	}
+}
+
+// batchSize returns the number of leaves to read or insert in a single batch.
+func (s *Snapshotter) batchSize() int {
+	if s.cfg.BatchSize <= 0 {
+		return MaxPageSize
+	}
+
+	return s.cfg.BatchSize
+}
+
+// UniverseIDs returns the identifiers of all local universes, with the
+// issuance universes first. Importing snapshots in this order makes sure the
+// issuance proofs are known before the transfer proofs that spend them.
+func (s *Snapshotter) UniverseIDs(ctx context.Context) ([]Identifier,
+	error) {
+
+	roots, err := fetchAllRoots(ctx, s.cfg.LocalDiffEngine)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]Identifier, len(roots))
+	for i, root := range roots {
+		ids[i] = root.ID
+	}
+
+	sort.SliceStable(ids, func(i, j int) bool {
+		return ids[i].ProofType == ProofTypeIssuance &&
+			ids[j].ProofType != ProofTypeIssuance
+	})
+
+	return ids, nil
+}
+
+// ExportUniverse exports a snapshot of the local universe with the given
+// identifier. The leaves are read page by page from a single consistent view
+// of the universe, so they always match its root. They are split into chunks
+// whose encoded leaves don't exceed the given size, unless a chunk only holds
+// a single leaf. Each chunk is passed to the send callback as soon as it's
+// full, so the snapshot is never held in memory as a whole. A universe
+// without any leaves results in a single empty chunk, so its root is still
+// exported.
+func (s *Snapshotter) ExportUniverse(ctx context.Context, id Identifier,
+	maxChunkSize int, send func(*SnapshotChunk) error) error {
+
+	var (
+		chunk     *SnapshotChunk
+		chunkSize int
+		numSent   int
+	)
+	start := func(root mssmt.Node, numLeaves uint64) error {
+		// We can only start over if the read is retried before any of
+		// the chunks were sent.
+		if numSent > 0 {
+			return fmt.Errorf("universe read restarted after %d "+
+				"chunks were sent", numSent)
+		}
+
+		chunk = &SnapshotChunk{
+			ID:        id,
+			Root:      root,
+			NumLeaves: numLeaves,
+		}
+		chunkSize = 0
+
+		return nil
+	}
+	page := func(leaves []*SnapshotLeaf) error {
+		for _, leaf := range leaves {
+			leafSize := leaf.encodedSize()
+			if len(chunk.Leaves) == 0 ||
+				chunkSize+leafSize <= maxChunkSize {
+
+				chunk.Leaves = append(chunk.Leaves, leaf)
+				chunkSize += leafSize
+
+				continue
+			}
+
+			if err := send(chunk); err != nil {
+				return err
+			}
+			numSent++
+
+			chunk = &SnapshotChunk{
+				ID:        id,
+				Root:      chunk.Root,
+				NumLeaves: chunk.NumLeaves,
+				Offset: chunk.Offset +
+					uint64(len(chunk.Leaves)),
+				Leaves: []*SnapshotLeaf{leaf},
+			}
+			chunkSize = leafSize
+		}
+
+		return nil
+	}
+
+	err := s.cfg.LocalSnapshots.ReadUniverseSnapshot(
+		ctx, id, s.batchSize(), start, page,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to read universe: %w", err)
+	}
+
+	// As all leaves were read from the same view of the universe, the
+	// last chunk must end with the last leaf of the universe.
+	if !chunk.IsLast() {
+		return fmt.Errorf("read %d of %d universe leaves",
+			chunk.Offset+uint64(len(chunk.Leaves)), chunk.NumLeaves)
+	}
+
+	return send(chunk)
+}
+
+// NewImport starts the import of the snapshot of a single universe. The
+// import must be closed once it's done.
+func (s *Snapshotter) NewImport() *SnapshotImport {
+	return &SnapshotImport{
+		cfg:       &s.cfg,
+		batchSize: s.batchSize(),
+	}
+}
+
+// SnapshotImport is the import of the snapshot of a single universe, which is
+// received chunk by chunk. The leaves of each chunk are verified and inserted
+// into an in-memory tree that only holds the hashes of the leaves, while the
+// chunks themselves are staged in a temporary file. Only once the root of all
+// leaves matches the root of the snapshot, the staged leaves are inserted
+// into the local universe.
+type SnapshotImport struct {
+	cfg *SnapshotConfig
+
+	batchSize int
+
+	// id is the identifier of the universe, and root the root all leaves
+	// of the snapshot are verified against. Both are set by the first
+	// chunk.
+	id   Identifier
+	root mssmt.Node
+
+	// numLeaves is the total number of leaves of the snapshot, and
+	// numAdded the number of leaves of all chunks added so far.
+	numLeaves uint64
+	numAdded  uint64
+
+	// numProofBytes is the total size of the proofs of all chunks added
+	// so far.
+	numProofBytes uint64
+
+	// tree is the tree of the leaves added so far.
+	tree mssmt.Tree
+
+	// staged is the temporary file the chunks are staged in.
+	staged *os.File
+	w      *bufio.Writer
+
+	complete bool
+}
+
+// ID returns the identifier of the imported universe.
+func (i *SnapshotImport) ID() Identifier {
+	return i.id
+}
+
+// Root returns the root of the imported universe.
+func (i *SnapshotImport) Root() mssmt.Node {
+	return i.root
+}
+
+// NumLeaves returns the total number of leaves of the imported universe.
+func (i *SnapshotImport) NumLeaves() uint64 {
+	return i.numLeaves
+}
+
+// AddChunk verifies the leaves of the next chunk of the snapshot and stages
+// them for the import. The chunks must be added in order, and must all carry
+// the same universe and root. True is returned once the last chunk was added
+// and all leaves were verified against the root of the snapshot.
+func (i *SnapshotImport) AddChunk(ctx context.Context,
+	chunk *SnapshotChunk) (bool, error) {
+
+	if i.complete {
+		return false, fmt.Errorf("%w: chunk after last chunk of "+
+			"universe", ErrSnapshotInvalid)
+	}
+
+	if i.root == nil {
+		staged, err := os.CreateTemp(
+			i.cfg.StagingDir, "universe-snapshot-*",
+		)
+		if err != nil {
+			return false, fmt.Errorf("unable to create staging "+
+				"file: %w", err)
+		}
+
+		i.id = chunk.ID
+		i.root = chunk.Root
+		i.numLeaves = chunk.NumLeaves
+		i.tree = mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+		i.staged = staged
+		i.w = bufio.NewWriter(staged)
+	}
+
+	switch {
+	case chunk.ID.String() != i.id.String():
+		return false, fmt.Errorf("%w: chunk of universe %v, expected "+
+			"%v", ErrSnapshotInvalid, chunk.ID.StringForLog(),
+			i.id.StringForLog())
+
+	case !mssmt.IsEqualNode(chunk.Root, i.root):
+		return false, fmt.Errorf("%w: chunk of universe root %x, "+
+			"expected %x", ErrSnapshotInvalid,
+			chunk.Root.NodeHash(), i.root.NodeHash())
+
+	case chunk.NumLeaves != i.numLeaves:
+		return false, fmt.Errorf("%w: chunk of snapshot with %d "+
+			"leaves, expected %d", ErrSnapshotInvalid,
+			chunk.NumLeaves, i.numLeaves)
+
+	case chunk.Offset != i.numAdded:
+		return false, fmt.Errorf("%w: chunk starts at leaf %d, "+
+			"expected %d", ErrSnapshotInvalid, chunk.Offset,
+			i.numAdded)
+
+	// Only the last chunk of an empty universe has no leaves, so the
+	// number of chunks is bounded by the number of leaves.
+	case len(chunk.Leaves) == 0 && !chunk.IsLast():
+		return false, fmt.Errorf("%w: chunk without leaves",
+			ErrSnapshotInvalid)
+	}
+
+	// A proof can't be larger than the maximum proof size, which caps the
+	// total size of all leaves of the snapshot.
+	maxProofBytes := uint64(math.MaxUint64)
+	if i.numLeaves <= math.MaxUint64/proof.FileMaxProofSizeBytes {
+		maxProofBytes = i.numLeaves * proof.FileMaxProofSizeBytes
+	}
+
+	for _, leaf := range chunk.Leaves {
+		if err := verifySnapshotLeaf(i.id, leaf); err != nil {
+			return false, err
+		}
+
+		i.numProofBytes += uint64(len(leaf.Leaf.RawProof))
+		if i.numProofBytes > maxProofBytes {
+			return false, fmt.Errorf("%w: snapshot leaves too "+
+				"large", ErrSnapshotInvalid)
+		}
+
+		// The tree only needs the hash of each leaf to compute the
+		// root, so we don't keep the proofs in memory.
+		leafNode := leaf.Leaf.SmtLeafNode()
+		_, err := i.tree.Insert(
+			ctx, leaf.Key.UniverseKey(), mssmt.NewComputedLeafNode(
+				leafNode.NodeHash(), leafNode.NodeSum(),
+			),
+		)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	var record bytes.Buffer
+	if err := chunk.Encode(&record); err != nil {
+		return false, err
+	}
+	if err := WriteSnapshotRecord(i.w, record.Bytes()); err != nil {
+		return false, fmt.Errorf("unable to stage snapshot chunk: %w",
+			err)
+	}
+
+	i.numAdded += uint64(len(chunk.Leaves))
+	if !chunk.IsLast() {
+		return false, nil
+	}
+
+	// With all leaves added, we can verify them against the root of the
+	// snapshot, once.
+	root, err := i.tree.Root(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !mssmt.IsEqualNode(root, i.root) {
+		return false, fmt.Errorf("%w: leaves don't match universe "+
+			"root %x", ErrSnapshotInvalid, i.root.NodeHash())
+	}
+
+	i.complete = true
+	i.tree = nil
+
+	return true, nil
+}
+
+// Commit inserts the staged leaves that aren't known yet into the local
+// universe, once all chunks of the snapshot were added and verified. The
+// number of newly inserted leaves is returned.
+func (i *SnapshotImport) Commit(ctx context.Context) (int, error) {
+	if !i.complete {
+		return 0, fmt.Errorf("%w: universe snapshot incomplete",
+			ErrSnapshotInvalid)
+	}
+
+	localKeys, err := fetchAllLeafKeys(ctx, i.cfg.LocalDiffEngine, i.id)
+	if err != nil {
+		return 0, err
+	}
+
+	knownKeys := make(map[UniverseKey]struct{}, len(localKeys))
+	for _, key := range localKeys {
+		knownKeys[key.UniverseKey()] = struct{}{}
+	}
+
+	if err := i.w.Flush(); err != nil {
+		return 0, err
+	}
+	if _, err := i.staged.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	log.Infof("Importing universe snapshot %v with %d leaves",
+		i.id.StringForLog(), i.numLeaves)
+
+	// The leaves are inserted in the order of the snapshot, so any proof
+	// within the universe that's spent by a later one is inserted first.
+	var (
+		r        = bufio.NewReader(i.staged)
+		newItems []*Item
+		numNew   int
+	)
+	insertItems := func() error {
+		if len(newItems) == 0 {
+			return nil
+		}
+
+		err := i.cfg.LocalRegistrar.UpsertProofLeafBatch(
+			ctx, newItems,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert snapshot leaves: "+
+				"%w", err)
+		}
+
+		numNew += len(newItems)
+		newItems = nil
+
+		return nil
+	}
+	for {
+		record, err := ReadSnapshotRecord(r)
+		switch {
+		case errors.Is(err, io.EOF):
+			if err := insertItems(); err != nil {
+				return 0, err
+			}
+
+			log.Infof("Imported %d new leaves of universe "+
+				"snapshot %v", numNew, i.id.StringForLog())
+
+			return numNew, nil
+
+		case err != nil:
+			return 0, fmt.Errorf("unable to read staged snapshot "+
+				"chunk: %w", err)
+		}
+
+		var chunk SnapshotChunk
+		err = chunk.Decode(bytes.NewReader(record))
+		if err != nil {
+			return 0, fmt.Errorf("unable to decode staged "+
+				"snapshot chunk: %w", err)
+		}
+
+		for _, leaf := range chunk.Leaves {
+			if _, ok := knownKeys[leaf.Key.UniverseKey()]; ok {
+				continue
+			}
+
+			newItems = append(newItems, &Item{
+				ID:   i.id,
+				Key:  leaf.Key,
+				Leaf: leaf.Leaf,
+			})
+			if len(newItems) < i.batchSize {
+				continue
+			}
+
+			if err := insertItems(); err != nil {
+				return 0, err
+			}
+		}
+	}
+}
+
+// Close removes the staged chunks of the import.
+func (i *SnapshotImport) Close() error {
+	if i.staged == nil {
+		return nil
+	}
+
+	closeErr := i.staged.Close()
+	if err := os.Remove(i.staged.Name()); err != nil {
+		return err
+	}
+
+	return closeErr
+}
@@ -0,0 +1,593 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSnapshotUniverse is an in-memory Universe that can be used to export
+// and import snapshots.
+type mockSnapshotUniverse struct {
+	DiffEngine
+
+	trees  map[Identifier]*mssmt.CompactedTree
+	keys   map[Identifier][]LeafKey
+	leaves map[UniverseKey]*Leaf
+
+	numInserts int
+}
+
+func newMockSnapshotUniverse() *mockSnapshotUniverse {
+	return &mockSnapshotUniverse{
+		trees:  make(map[Identifier]*mssmt.CompactedTree),
+		keys:   make(map[Identifier][]LeafKey),
+		leaves: make(map[UniverseKey]*Leaf),
+	}
+}
+
+// uniKey returns the key of the universe in the maps of the mock. We clear the
+// group key pointer, so universes are only compared by their bytes.
+func uniKey(id Identifier) Identifier {
+	uniID := Identifier{ProofType: id.ProofType}
+	uniID.AssetID = id.Bytes()
+
+	return uniID
+}
+
+func (m *mockSnapshotUniverse) UpsertProofLeaf(ctx context.Context,
+	id Identifier, key LeafKey, leaf *Leaf) (*Proof, error) {
+
+	tree, ok := m.trees[uniKey(id)]
+	if !ok {
+		tree = mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+		m.trees[uniKey(id)] = tree
+	}
+
+	_, err := tree.Insert(ctx, key.UniverseKey(), leaf.SmtLeafNode())
+	if err != nil {
+		return nil, err
+	}
+
+	m.keys[uniKey(id)] = append(m.keys[uniKey(id)], key)
+	m.leaves[key.UniverseKey()] = leaf
+	m.numInserts++
+
+	return &Proof{Leaf: leaf, LeafKey: key}, nil
+}
+
+func (m *mockSnapshotUniverse) UpsertProofLeafBatch(ctx context.Context,
+	items []*Item) error {
+
+	for _, item := range items {
+		_, err := m.UpsertProofLeaf(ctx, item.ID, item.Key, item.Leaf)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *mockSnapshotUniverse) RootNode(ctx context.Context,
+	id Identifier) (Root, error) {
+
+	tree, ok := m.trees[uniKey(id)]
+	if !ok {
+		return Root{}, ErrNoUniverseRoot
+	}
+
+	root, err := tree.Root(ctx)
+	if err != nil {
+		return Root{}, err
+	}
+
+	return Root{
+		ID:   id,
+		Node: root,
+	}, nil
+}
+
+func (m *mockSnapshotUniverse) UniverseLeafKeys(_ context.Context,
+	q UniverseLeafKeysQuery) ([]LeafKey, error) {
+
+	keys := m.keys[uniKey(q.Id)]
+	if int(q.Offset) >= len(keys) {
+		return nil, nil
+	}
+
+	keys = keys[q.Offset:]
+	if len(keys) > int(q.Limit) {
+		keys = keys[:q.Limit]
+	}
+
+	return keys, nil
+}
+
+func (m *mockSnapshotUniverse) FetchProofLeaf(_ context.Context,
+	_ Identifier, key LeafKey) ([]*Proof, error) {
+
+	leaf, ok := m.leaves[key.UniverseKey()]
+	if !ok {
+		return nil, ErrNoUniverseProofFound
+	}
+
+	return []*Proof{{Leaf: leaf, LeafKey: key}}, nil
+}
+
+func (m *mockSnapshotUniverse) ReadUniverseSnapshot(ctx context.Context,
+	id Identifier, pageSize int,
+	start func(root mssmt.Node, numLeaves uint64) error,
+	page func(leaves []*SnapshotLeaf) error) error {
+
+	root, err := m.RootNode(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	keys := m.keys[uniKey(id)]
+	if err := start(root.Node, uint64(len(keys))); err != nil {
+		return err
+	}
+
+	for len(keys) > 0 {
+		numPageLeaves := min(pageSize, len(keys))
+
+		leaves := make([]*SnapshotLeaf, numPageLeaves)
+		for i, key := range keys[:numPageLeaves] {
+			leaves[i] = &SnapshotLeaf{
+				Key:  key,
+				Leaf: m.leaves[key.UniverseKey()],
+			}
+		}
+
+		if err := page(leaves); err != nil {
+			return err
+		}
+
+		keys = keys[numPageLeaves:]
+	}
+
+	return nil
+}
+
+// randSnapshotLeaf creates a random issuance leaf with an encoded proof of an
+// asset with the given genesis within the given universe.
+func randSnapshotLeaf(t *testing.T, id Identifier,
+	genesis asset.Genesis) (LeafKey, *Leaf) {
+
+	leafAsset := randGenesisAsset(t)
+	leafAsset.Genesis = genesis
+
+	// Ungrouped genesis assets don't have a group witness.
+	leafAsset.GroupKey = nil
+	leafAsset.PrevWitnesses = []asset.Witness{{
+		PrevID: &asset.ZeroPrevID,
+	}}
+	if id.GroupKey != nil {
+		leafAsset.GroupKey = &asset.GroupKey{
+			GroupPubKey: *id.GroupKey,
+		}
+		leafAsset.PrevWitnesses[0].TxWitness = [][]byte{
+			test.RandBytes(64),
+		}
+	}
+
+	leafProof := &proof.Proof{
+		AnchorTx: wire.MsgTx{
+			Version: 2,
+			TxIn: []*wire.TxIn{{
+				Witness: [][]byte{[]byte("foo")},
+			}},
+		},
+		Asset: leafAsset,
+		InclusionProof: proof.TaprootProof{
+			InternalKey: test.RandPubKey(t),
+		},
+	}
+
+	var proofBuf bytes.Buffer
+	require.NoError(t, leafProof.Encode(&proofBuf))
+
+	leaf, err := decodeSnapshotLeaf(proofBuf.Bytes())
+	require.NoError(t, err)
+
+	scriptKey := asset.NewScriptKey(test.RandPubKey(t))
+	leafKey := LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: &scriptKey,
+	}
+
+	return leafKey, leaf
+}
+
+// addRandLeaves adds the given number of random leaves to the universe with
+// the given identifier. For asset universes, the asset ID of the returned
+// identifier is set to the random genesis of the leaves.
+func (m *mockSnapshotUniverse) addRandLeaves(t *testing.T, id Identifier,
+	numLeaves int) Identifier {
+
+	genesis := asset.RandGenesis(t, asset.Normal)
+	if id.GroupKey == nil {
+		id.AssetID = genesis.ID()
+	}
+
+	for i := 0; i < numLeaves; i++ {
+		// The leaves of a group universe each have their own genesis.
+		if id.GroupKey != nil {
+			genesis = asset.RandGenesis(t, asset.Normal)
+		}
+
+		leafKey, leaf := randSnapshotLeaf(t, id, genesis)
+		_, err := m.UpsertProofLeaf(
+			context.Background(), id, leafKey, leaf,
+		)
+		require.NoError(t, err)
+	}
+
+	return id
+}
+
+// exportChunks exports the snapshot of the universe with the given identifier
+// and returns its chunks.
+func exportChunks(t *testing.T, snapshotter *Snapshotter, id Identifier,
+	maxChunkSize int) []*SnapshotChunk {
+
+	var chunks []*SnapshotChunk
+	err := snapshotter.ExportUniverse(
+		context.Background(), id, maxChunkSize,
+		func(chunk *SnapshotChunk) error {
+			chunks = append(chunks, chunk)
+			return nil
+		},
+	)
+	require.NoError(t, err)
+
+	return chunks
+}
+
+// TestUniverseSnapshotEncodeDecode tests that a universe snapshot survives an
+// encoding round trip of its chunks, and that tampered snapshots don't
+// verify.
+func TestUniverseSnapshotEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	source := newMockSnapshotUniverse()
+	groupID := source.addRandLeaves(t, Identifier{
+		GroupKey:  test.RandPubKey(t),
+		ProofType: ProofTypeIssuance,
+	}, 5)
+
+	snapshotter := NewSnapshotter(SnapshotConfig{
+		LocalSnapshots:  source,
+		LocalDiffEngine: newMockSnapshotUniverse(),
+		BatchSize:       2,
+		StagingDir:      t.TempDir(),
+	})
+
+	// A chunk size of a single byte results in one leaf per chunk.
+	chunks := exportChunks(t, snapshotter, groupID, 1)
+	require.Len(t, chunks, 5)
+
+	sourceRoot, err := source.RootNode(ctx, groupID)
+	require.NoError(t, err)
+
+	decoded := snapshotter.NewImport()
+	defer func() {
+		require.NoError(t, decoded.Close())
+	}()
+
+	var (
+		complete bool
+		records  [][]byte
+	)
+	for i, chunk := range chunks {
+		require.False(t, complete)
+		require.EqualValues(t, i, chunk.Offset)
+		require.EqualValues(t, 5, chunk.NumLeaves)
+		require.True(t, mssmt.IsEqualNode(sourceRoot, chunk.Root))
+
+		var buf bytes.Buffer
+		require.NoError(t, chunk.Encode(&buf))
+		records = append(records, buf.Bytes())
+
+		var decodedChunk SnapshotChunk
+		err := decodedChunk.Decode(bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+
+		require.Len(t, decodedChunk.Leaves, 1)
+		require.Equal(
+			t, chunk.Leaves[0].Key.UniverseKey(),
+			decodedChunk.Leaves[0].Key.UniverseKey(),
+		)
+		require.Equal(
+			t, chunk.Leaves[0].Leaf.RawProof,
+			decodedChunk.Leaves[0].Leaf.RawProof,
+		)
+
+		complete, err = decoded.AddChunk(ctx, &decodedChunk)
+		require.NoError(t, err)
+	}
+	require.True(t, complete)
+	decodedID := decoded.ID()
+	require.Equal(t, groupID.String(), decodedID.String())
+	require.True(t, mssmt.IsEqualNode(sourceRoot, decoded.Root()))
+	require.EqualValues(t, 5, decoded.NumLeaves())
+
+	// No chunks can be added after the last one.
+	_, err = decoded.AddChunk(ctx, chunks[0])
+	require.ErrorIs(t, err, ErrSnapshotInvalid)
+
+	// All leaves fit into a single chunk with the default chunk size.
+	singleChunk := exportChunks(
+		t, snapshotter, groupID, SnapshotChunkSizeBytes,
+	)
+	require.Len(t, singleChunk, 1)
+	require.True(t, singleChunk[0].IsLast())
+
+	// addChunks adds the given chunks to a new import, and returns the
+	// error of the first chunk that can't be added.
+	addChunks := func(chunks ...*SnapshotChunk) error {
+		snapshotImport := snapshotter.NewImport()
+		defer func() {
+			require.NoError(t, snapshotImport.Close())
+		}()
+
+		for _, chunk := range chunks {
+			_, err := snapshotImport.AddChunk(ctx, chunk)
+			if err != nil {
+				return err
+			}
+		}
+
+		// An incomplete import can't be committed.
+		_, err := snapshotImport.Commit(ctx)
+		require.ErrorIs(t, err, ErrSnapshotInvalid)
+
+		return nil
+	}
+
+	// The chunks of a universe must be added in order.
+	require.ErrorIs(t, addChunks(chunks[1]), ErrSnapshotInvalid)
+
+	// Chunks of another root can't be mixed into the snapshot either.
+	otherRoot := *chunks[1]
+	otherRoot.Root = mssmt.NewComputedBranch(mssmt.NodeHash{1}, 1)
+	require.ErrorIs(
+		t, addChunks(chunks[0], &otherRoot), ErrSnapshotInvalid,
+	)
+
+	// Only the last chunk may be empty, so the number of chunks is bounded
+	// by the number of leaves.
+	emptyChunk := *chunks[1]
+	emptyChunk.Leaves = nil
+	require.ErrorIs(
+		t, addChunks(chunks[0], &emptyChunk), ErrSnapshotInvalid,
+	)
+
+	// A snapshot with a replaced leaf doesn't match its root.
+	otherGroupKey, otherGroupLeaf := randSnapshotLeaf(
+		t, groupID, asset.RandGenesis(t, asset.Normal),
+	)
+	replacedLeaf := *chunks[4]
+	replacedLeaf.Leaves = []*SnapshotLeaf{{
+		Key:  otherGroupKey,
+		Leaf: otherGroupLeaf,
+	}}
+	require.ErrorIs(
+		t, addChunks(
+			chunks[0], chunks[1], chunks[2], chunks[3],
+			&replacedLeaf,
+		), ErrSnapshotInvalid,
+	)
+
+	// A leaf of another asset group is rejected as soon as its chunk is
+	// added.
+	otherKey, otherLeaf := randSnapshotLeaf(t, Identifier{
+		GroupKey: test.RandPubKey(t),
+	}, asset.RandGenesis(t, asset.Normal))
+	foreignLeaf := *chunks[1]
+	foreignLeaf.Leaves = []*SnapshotLeaf{{
+		Key:  otherKey,
+		Leaf: otherLeaf,
+	}}
+	require.ErrorIs(
+		t, addChunks(chunks[0], &foreignLeaf), ErrSnapshotInvalid,
+	)
+
+	// A chunk with a truncated proof can't be decoded.
+	var truncatedChunk SnapshotChunk
+	truncated := records[0][:len(records[0])-10]
+	err = truncatedChunk.Decode(bytes.NewReader(truncated))
+	require.Error(t, err)
+}
+
+// TestSnapshotterExportImport tests that the universes exported to a snapshot
+// file can be imported into another Universe, and that only new leaves are
+// inserted.
+func TestSnapshotterExportImport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	source := newMockSnapshotUniverse()
+	assetID := source.addRandLeaves(t, Identifier{
+		ProofType: ProofTypeIssuance,
+	}, 3)
+	groupID := source.addRandLeaves(t, Identifier{
+		GroupKey:  test.RandPubKey(t),
+		ProofType: ProofTypeIssuance,
+	}, 7)
+
+	exporter := NewSnapshotter(SnapshotConfig{
+		LocalSnapshots: source,
+		BatchSize:      2,
+	})
+
+	// We'll write a snapshot file of both universes.
+	var file bytes.Buffer
+	require.NoError(t, WriteSnapshotHeader(&file))
+	for _, id := range []Identifier{assetID, groupID} {
+		// Each leaf is written as a separate chunk, so the universes
+		// are spread over multiple records.
+		for _, chunk := range exportChunks(t, exporter, id, 1) {
+			var record bytes.Buffer
+			require.NoError(t, chunk.Encode(&record))
+			require.NoError(
+				t, WriteSnapshotRecord(&file, record.Bytes()),
+			)
+		}
+	}
+
+	// The target Universe already knows about one of the leaves of the
+	// group universe, so that one shouldn't be inserted again.
+	target := newMockSnapshotUniverse()
+	knownKey := source.keys[uniKey(groupID)][2]
+	_, err := target.UpsertProofLeaf(
+		ctx, groupID, knownKey, source.leaves[knownKey.UniverseKey()],
+	)
+	require.NoError(t, err)
+	target.numInserts = 0
+
+	stagingDir := t.TempDir()
+	importer := NewSnapshotter(SnapshotConfig{
+		LocalDiffEngine: target,
+		LocalRegistrar:  target,
+		BatchSize:       2,
+		StagingDir:      stagingDir,
+	})
+
+	importFile := func() []int {
+		r := bytes.NewReader(file.Bytes())
+		require.NoError(t, ReadSnapshotHeader(r))
+
+		var (
+			numNew         []int
+			snapshotImport = importer.NewImport()
+		)
+		for {
+			record, err := ReadSnapshotRecord(r)
+			if errors.Is(err, io.EOF) {
+				require.Nil(t, snapshotImport.Root())
+				return numNew
+			}
+			require.NoError(t, err)
+
+			var chunk SnapshotChunk
+			err = chunk.Decode(bytes.NewReader(record))
+			require.NoError(t, err)
+
+			complete, err := snapshotImport.AddChunk(ctx, &chunk)
+			require.NoError(t, err)
+			if !complete {
+				continue
+			}
+
+			n, err := snapshotImport.Commit(ctx)
+			require.NoError(t, err)
+			require.NoError(t, snapshotImport.Close())
+
+			numNew = append(numNew, n)
+			snapshotImport = importer.NewImport()
+		}
+	}
+
+	require.Equal(t, []int{3, 6}, importFile())
+	require.Equal(t, 9, target.numInserts)
+
+	// The staged chunks are removed once the imports are closed.
+	stagedFiles, err := os.ReadDir(stagingDir)
+	require.NoError(t, err)
+	require.Empty(t, stagedFiles)
+
+	// Both universes should now have the same root as the source.
+	for _, id := range []Identifier{assetID, groupID} {
+		sourceRoot, err := source.RootNode(ctx, id)
+		require.NoError(t, err)
+		targetRoot, err := target.RootNode(ctx, id)
+		require.NoError(t, err)
+
+		require.True(t, mssmt.IsEqualNode(sourceRoot, targetRoot))
+	}
+
+	// Importing the same file again shouldn't insert anything.
+	require.Equal(t, []int{0, 0}, importFile())
+	require.Equal(t, 9, target.numInserts)
+
+	// A file with an unknown version is rejected.
+	badFile := append([]byte{}, file.Bytes()...)
+	badFile[len(SnapshotPrefixMagicBytes)+3] = 1
+	require.ErrorContains(
+		t, ReadSnapshotHeader(bytes.NewReader(badFile)),
+		"unknown universe snapshot version",
+	)
+}
+
+// TestSnapshotterUniverseIDs tests that the issuance universes are returned
+// before the transfer universes.
+func TestSnapshotterUniverseIDs(t *testing.T) {
+	t.Parallel()
+
+	groupKey := test.RandPubKey(t)
+	roots := []Root{
+		{ID: Identifier{ProofType: ProofTypeTransfer}},
+		{ID: Identifier{ProofType: ProofTypeIssuance}},
+		{ID: Identifier{
+			GroupKey:  groupKey,
+			ProofType: ProofTypeTransfer,
+		}},
+		{ID: Identifier{
+			GroupKey:  groupKey,
+			ProofType: ProofTypeIssuance,
+		}},
+	}
+
+	snapshotter := NewSnapshotter(SnapshotConfig{
+		LocalDiffEngine: &mockRootsDiffEngine{roots: roots},
+	})
+
+	ids, err := snapshotter.UniverseIDs(context.Background())
+	require.NoError(t, err)
+
+	proofTypes := make([]ProofType, len(ids))
+	groupKeys := make([]*btcec.PublicKey, len(ids))
+	for i, id := range ids {
+		proofTypes[i] = id.ProofType
+		groupKeys[i] = id.GroupKey
+	}
+	require.Equal(t, []ProofType{
+		ProofTypeIssuance, ProofTypeIssuance,
+		ProofTypeTransfer, ProofTypeTransfer,
+	}, proofTypes)
+	require.Equal(t, []*btcec.PublicKey{
+		nil, groupKey, nil, groupKey,
+	}, groupKeys)
+}
+
+// mockRootsDiffEngine is a DiffEngine that returns a static set of roots.
+type mockRootsDiffEngine struct {
+	DiffEngine
+
+	roots []Root
+}
+
+func (m *mockRootsDiffEngine) RootNodes(_ context.Context,
+	q RootNodesQuery) ([]Root, error) {
+
+	if int(q.Offset) >= len(m.roots) {
+		return nil, nil
+	}
+
+	return m.roots[q.Offset:], nil
+}
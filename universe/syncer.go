@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"sync"
 	"sync/atomic"
 
 	"github.com/davecgh/go-spew/spew"
@@ -17,12 +18,22 @@ import (
 
 const (
 	defaultPageSize = int32(MaxPageSize)
+
+	// DefaultMaxBisectQueries is the default maximum number of branch
+	// queries sent to the remote Universe while bisecting the tree of a
+	// single universe. Every missing leaf costs several round trips, so
+	// for a large divergence it's cheaper to page through all leaf keys.
+	DefaultMaxBisectQueries = 2 * MaxPageSize
 )
 
 var (
 	// ErrUnsupportedSync is returned when a syncer is asked to async in a
 	// way that it does not support.
 	ErrUnsupportedSync = fmt.Errorf("unsupported sync type")
+
+	// errBisectQueryLimit is returned when the bisection of a universe
+	// tree exceeds the maximum number of branch queries.
+	errBisectQueryLimit = errors.New("bisect query limit reached")
 )
 
 // SimpleSyncCfg contains all the configuration needed to create a new
@@ -43,10 +54,24 @@ type SimpleSyncCfg struct {
 
 	// SyncBatchSize is the number of items to sync in a single batch.
 	SyncBatchSize int
+
+	// MaxBisectQueries is the maximum number of branch queries sent to the
+	// remote Universe while bisecting the tree of a single universe. If
+	// the trees diverge too much to stay below it, all leaf keys are
+	// compared instead. If zero, DefaultMaxBisectQueries is used.
+	MaxBisectQueries int
+
+	// Events is used to notify subscribers about the universes that were
+	// synced. This is optional, no events are emitted if it isn't set.
+	Events *EventNotifier
 }
 
 // SimpleSyncer is a simple implementation of the Syncer interface. It's based
-// on a set difference operation between the local and remote Universe.
+// on a bisection of the trees of the local and remote Universe, which finds the
+// set of leaves the local Universe is missing by only walking the diverging
+// branches. If the local Universe doesn't have a universe yet, the remote
+// Universe doesn't support branch queries or the trees diverge too much, then a
+// set difference of all the leaf keys is used instead.
 type SimpleSyncer struct {
 	cfg SimpleSyncCfg
 
@@ -64,8 +89,8 @@ func NewSimpleSyncer(cfg SimpleSyncCfg) *SimpleSyncer {
 }
 
 // executeSync attempts to sync the local Universe with the remote diff engine.
-// A simple approach where a set difference is used to find the set of assets
-// that need to be synced is used.
+// The trees of both universes are bisected to find the set of assets that need
+// to be synced.
 func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 	syncType SyncType, syncConfigs SyncConfigs,
 	idsToSync []Identifier) ([]AssetSyncDiff, error) {
@@ -123,7 +148,7 @@ func (s *SimpleSyncer) executeSync(ctx context.Context, diffEngine DiffEngine,
 	case globalInsertEnabled:
 		log.Infof("Fetching all roots for remote Universe server...")
 
-		targetRoots, err = s.fetchAllRoots(ctx, diffEngine)
+		targetRoots, err = fetchAllRoots(ctx, diffEngine)
 		if err != nil {
 			return nil, err
 		}
@@ -219,10 +244,11 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 	// First, we'll compare the remote root against the local root.
 	uniID := remoteRoot.ID
 	localRoot, err := s.cfg.LocalDiffEngine.RootNode(ctx, uniID)
+	localEmpty := errors.Is(err, ErrNoUniverseRoot)
 	switch {
 	// If we don't have this root, then we don't have anything to compare
 	// to, so we'll proceed as normal.
-	case errors.Is(err, ErrNoUniverseRoot):
+	case localEmpty:
 		// TODO(roasbeef): abstraction leak, error should be in
 		// universe package
 
@@ -241,27 +267,14 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 		uniID.String())
 
 	// Otherwise, we'll need to perform a diff operation to find the set of
-	// keys we need to fetch. We'll start by fetching the set of keys from
-	// both the local and remote Universe.
-	var (
-		remoteUniKeys []LeafKey
-		localUniKeys  []LeafKey
+	// keys we need to fetch.
+	keysToFetch, err := s.diffLeafKeys(
+		ctx, diffEngine, uniID, remoteRoot.Node, localEmpty,
 	)
-
-	remoteUniKeys, err = s.fetchAllLeafKeys(ctx, diffEngine, uniID)
-	if err != nil {
-		return err
-	}
-
-	localUniKeys, err = s.fetchAllLeafKeys(ctx, s.cfg.LocalDiffEngine, uniID)
 	if err != nil {
 		return err
 	}
 
-	// With the set of keys fetched, we can now find the set of keys that
-	// need to be synced.
-	keysToFetch := fn.SetDiff(remoteUniKeys, localUniKeys)
-
 	log.Infof("UniverseRoot(%v): diff_size=%v", uniID.String(),
 		len(keysToFetch))
 	log.Tracef("UniverseRoot(%v): diff_size=%v, diff=%v", uniID.String(),
@@ -395,6 +408,246 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 	return nil
 }
 
+// diffLeafKeys returns the set of leaf keys the remote Universe has for the
+// given universe that the local Universe doesn't have. If the local Universe
+// doesn't have the universe at all, then all remote leaf keys are returned.
+// Otherwise, if the remote Universe supports branch queries, then only the
+// branches of the two universe trees that diverge are walked. We fall back to
+// fetching and comparing all the leaf keys of both universes if the remote
+// Universe doesn't support branch queries or the trees diverge too much. All
+// remote branches are verified against the given remote root.
+func (s *SimpleSyncer) diffLeafKeys(ctx context.Context,
+	diffEngine DiffEngine, uniID Identifier, remoteRoot mssmt.Node,
+	localEmpty bool) ([]LeafKey, error) {
+
+	// If we don't have any leaves of this universe yet, then bisecting
+	// would only walk the whole remote tree one branch at a time.
+	if localEmpty {
+		return fetchAllLeafKeys(ctx, diffEngine, uniID)
+	}
+
+	var (
+		keysMtx     sync.Mutex
+		keysToFetch []LeafKey
+		numQueries  atomic.Int32
+	)
+	addMissingKey := func(key LeafKey) {
+		keysMtx.Lock()
+		defer keysMtx.Unlock()
+
+		keysToFetch = append(keysToFetch, key)
+	}
+
+	err := s.bisectTree(
+		ctx, diffEngine, uniID, remoteRoot, &numQueries, addMissingKey,
+	)
+	switch {
+	case err == nil:
+		return keysToFetch, nil
+
+	case errors.Is(err, ErrBranchQueryUnsupported):
+		log.Debugf("Remote Universe doesn't support branch queries, "+
+			"comparing all leaf keys of UniverseRoot(%v)",
+			uniID.String())
+
+	case errors.Is(err, errBisectQueryLimit):
+		log.Debugf("UniverseRoot(%v) diverges in too many branches, "+
+			"comparing all leaf keys", uniID.String())
+
+	default:
+		return nil, err
+	}
+
+	// We can't bisect the trees, so we'll fetch the set of keys from both
+	// the local and remote Universe.
+	remoteUniKeys, err := fetchAllLeafKeys(ctx, diffEngine, uniID)
+	if err != nil {
+		return nil, err
+	}
+
+	localUniKeys, err := fetchAllLeafKeys(
+		ctx, s.cfg.LocalDiffEngine, uniID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// With the set of keys fetched, we can now find the set of keys that
+	// need to be synced.
+	return fn.SetDiff(remoteUniKeys, localUniKeys), nil
+}
+
+// bisectTree fetches the root branch of the remote tree of the given universe,
+// makes sure it matches the remote root we were given, and then bisects the
+// remote and local trees starting from there.
+func (s *SimpleSyncer) bisectTree(ctx context.Context, diffEngine DiffEngine,
+	uniID Identifier, remoteRoot mssmt.Node, numQueries *atomic.Int32,
+	addMissingKey func(LeafKey)) error {
+
+	rootBranch, err := s.fetchRemoteBranch(
+		ctx, diffEngine, uniID, mssmt.NodePath{}, numQueries,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !mssmt.IsEqualNode(rootBranch.Node, remoteRoot) {
+		return fmt.Errorf("remote root branch doesn't match remote " +
+			"universe root")
+	}
+
+	return s.bisectBranch(
+		ctx, diffEngine, uniID, rootBranch, numQueries, addMissingKey,
+	)
+}
+
+// fetchRemoteBranch fetches the branch at the given path from the remote
+// Universe. The number of branch queries sent to the remote Universe is
+// tracked in numQueries, errBisectQueryLimit is returned once it exceeds the
+// configured maximum.
+func (s *SimpleSyncer) fetchRemoteBranch(ctx context.Context,
+	diffEngine DiffEngine, uniID Identifier, path mssmt.NodePath,
+	numQueries *atomic.Int32) (*Branch, error) {
+
+	maxQueries := s.cfg.MaxBisectQueries
+	if maxQueries == 0 {
+		maxQueries = DefaultMaxBisectQueries
+	}
+	if int(numQueries.Add(1)) > maxQueries {
+		return nil, errBisectQueryLimit
+	}
+
+	return diffEngine.UniverseBranch(ctx, uniID, path)
+}
+
+// bisectBranch compares the given remote branch, which must already be
+// verified against the remote root, with the local branch at the same path of
+// the tree of the given universe, and passes the keys of the remote leaves
+// below the path that the local Universe is missing to addMissingKey. If the
+// branches differ and the remote branch has more than one leaf, then both
+// remote child branches are fetched, verified against the remote branch and
+// compared in turn.
+func (s *SimpleSyncer) bisectBranch(ctx context.Context,
+	diffEngine DiffEngine, uniID Identifier, remoteBranch *Branch,
+	numQueries *atomic.Int32, addMissingKey func(LeafKey)) error {
+
+	path := remoteBranch.Path
+
+	// If the remote branch is empty, then there's nothing we can fetch
+	// below this path.
+	if remoteBranch.IsEmpty() {
+		return nil
+	}
+
+	// The remote Universe must not make us fetch leaves that aren't part
+	// of the branch we asked for.
+	remoteKey := remoteBranch.LeafKey
+	if remoteKey != nil && !path.Contains(remoteKey.UniverseKey()) {
+		return fmt.Errorf("remote leaf key %x is not part of branch "+
+			"at depth %d", remoteKey.UniverseKey(), path.Depth)
+	}
+
+	localBranch, err := s.cfg.LocalDiffEngine.UniverseBranch(
+		ctx, uniID, path,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch local branch: %w", err)
+	}
+
+	// If both branches commit to the same leaves, then we're done here.
+	if mssmt.IsEqualNode(remoteBranch.Node, localBranch.Node) {
+		return nil
+	}
+
+	// If the remote branch only has a single leaf, then we'll only need to
+	// check whether we already have that leaf.
+	if remoteKey != nil {
+		haveLeaf, err := s.hasLeaf(ctx, uniID, localBranch, *remoteKey)
+		if err != nil {
+			return err
+		}
+
+		if !haveLeaf {
+			addMissingKey(*remoteKey)
+		}
+
+		return nil
+	}
+
+	// A non-empty branch at the bottom of the tree is a single leaf, so
+	// the remote Universe should have given us its key.
+	if path.Depth == mssmt.MaxTreeLevels {
+		return fmt.Errorf("remote branch at depth %d is missing its "+
+			"leaf key", path.Depth)
+	}
+
+	// Otherwise, the branches diverge somewhere below this path, so we'll
+	// compare both children. Before we descend, we make sure the remote
+	// child branches actually hash to the branch we already trust, as the
+	// remote Universe could otherwise hide leaves from us.
+	left, err := s.fetchRemoteBranch(
+		ctx, diffEngine, uniID, path.Child(0), numQueries,
+	)
+	if err != nil {
+		return err
+	}
+	right, err := s.fetchRemoteBranch(
+		ctx, diffEngine, uniID, path.Child(1), numQueries,
+	)
+	if err != nil {
+		return err
+	}
+
+	parent := mssmt.NewBranch(left.Node, right.Node)
+	if !mssmt.IsEqualNode(parent, remoteBranch.Node) {
+		return fmt.Errorf("remote branches at depth %d don't match "+
+			"their parent branch", path.Depth+1)
+	}
+
+	children := []*Branch{left, right}
+	return fn.ParSlice(
+		ctx, children, func(ctx context.Context, child *Branch) error {
+			return s.bisectBranch(
+				ctx, diffEngine, uniID, child, numQueries,
+				addMissingKey,
+			)
+		},
+	)
+}
+
+// hasLeaf returns true if the local Universe already has a leaf with the given
+// key in the given local branch.
+func (s *SimpleSyncer) hasLeaf(ctx context.Context, uniID Identifier,
+	localBranch *Branch, key LeafKey) (bool, error) {
+
+	switch {
+	case localBranch.IsEmpty():
+		return false, nil
+
+	case localBranch.LeafKey != nil:
+		return localBranch.LeafKey.UniverseKey() == key.UniverseKey(),
+			nil
+	}
+
+	// The local branch has several leaves, so we'll look up the leaf
+	// directly.
+	leafPath, err := mssmt.NewNodePath(
+		mssmt.MaxTreeLevels, key.UniverseKey(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	leafBranch, err := s.cfg.LocalDiffEngine.UniverseBranch(
+		ctx, uniID, leafPath,
+	)
+	if err != nil {
+		return false, fmt.Errorf("unable to fetch local leaf: %w", err)
+	}
+
+	return !leafBranch.IsEmpty(), nil
+}
+
 // batchStreamNewItems streams the set of new items to the local registrar in
 // batches and returns the new leaf proofs.
 func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
@@ -464,13 +717,36 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 
 	// With the engine created, we can now sync the local Universe with the
 	// remote instance.
-	return s.executeSync(ctx, diffEngine, syncType, syncConfigs, idsToSync)
+	diffs, err := s.executeSync(
+		ctx, diffEngine, syncType, syncConfigs, idsToSync,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.cfg.Events != nil {
+		events := fn.Map(diffs, func(diff AssetSyncDiff) *Event {
+			return &Event{
+				Type:       EventTypeSync,
+				ID:         diff.NewUniverseRoot.ID,
+				Root:       diff.NewUniverseRoot.Node,
+				ServerHost: host.HostStr(),
+			}
+		})
+		err := s.cfg.Events.LogEvents(ctx, events...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to log sync events: "+
+				"%w", err)
+		}
+	}
+
+	return diffs, nil
 }
 
-// fetchAllRoots fetches all the roots from the remote Universe. This function
+// fetchAllRoots fetches all the roots from the given Universe. This function
 // is used in order to isolate any logic related to the specifics of how we
 // fetch the data from the universe server.
-func (s *SimpleSyncer) fetchAllRoots(ctx context.Context, diffEngine DiffEngine) ([]Root, error) {
+func fetchAllRoots(ctx context.Context, diffEngine DiffEngine) ([]Root, error) {
 	offset := int32(0)
 	pageSize := defaultPageSize
 	roots := make([]Root, 0)
@@ -502,10 +778,10 @@ func (s *SimpleSyncer) fetchAllRoots(ctx context.Context, diffEngine DiffEngine)
 	return roots, nil
 }
 
-// fetchAllLeafKeys fetches all the leaf keys from the remote Universe. This
+// fetchAllLeafKeys fetches all the leaf keys from the given Universe. This
 // function is used in order to isolate any logic related to the specifics of
 // how we fetch the data from the universe server.
-func (s *SimpleSyncer) fetchAllLeafKeys(ctx context.Context,
+func fetchAllLeafKeys(ctx context.Context,
 	diffEngine DiffEngine, uniID Identifier) ([]LeafKey, error) {
 
 	// Initialize the offset to be used for the pages.
@@ -0,0 +1,323 @@
+package universe
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDiffEngine is an in-memory DiffEngine that holds the tree of a single
+// universe.
+type mockDiffEngine struct {
+	tree     *mssmt.CompactedTree
+	leafKeys map[[32]byte]LeafKey
+
+	noBranchQueries bool
+
+	// wrongLeafKeys makes the engine return the key of a leaf outside of
+	// the branch for every single leaf branch.
+	wrongLeafKeys bool
+
+	// hiddenKey makes the engine claim that every branch below the root
+	// that contains the key is empty.
+	hiddenKey *[32]byte
+
+	numBranchQueries atomic.Int32
+}
+
+func newMockDiffEngine() *mockDiffEngine {
+	return &mockDiffEngine{
+		tree:     mssmt.NewCompactedTree(mssmt.NewDefaultStore()),
+		leafKeys: make(map[[32]byte]LeafKey),
+	}
+}
+
+func (m *mockDiffEngine) addLeaf(t *testing.T, key LeafKey) {
+	t.Helper()
+
+	leaf := mssmt.NewLeafNode(test.RandBytes(32), 1)
+
+	smtKey := key.UniverseKey()
+	_, err := m.tree.Insert(context.Background(), smtKey, leaf)
+	require.NoError(t, err)
+
+	m.leafKeys[smtKey] = key
+}
+
+func (m *mockDiffEngine) RootNode(ctx context.Context,
+	id Identifier) (Root, error) {
+
+	root, err := m.tree.Root(ctx)
+	if err != nil {
+		return Root{}, err
+	}
+
+	return Root{
+		ID:   id,
+		Node: root,
+	}, nil
+}
+
+func (m *mockDiffEngine) RootNodes(context.Context,
+	RootNodesQuery) ([]Root, error) {
+
+	return nil, nil
+}
+
+func (m *mockDiffEngine) UniverseLeafKeys(_ context.Context,
+	q UniverseLeafKeysQuery) ([]LeafKey, error) {
+
+	var keys []LeafKey
+	for _, key := range m.leafKeys {
+		keys = append(keys, key)
+	}
+
+	if int(q.Offset) >= len(keys) {
+		return nil, nil
+	}
+
+	return keys[q.Offset:], nil
+}
+
+func (m *mockDiffEngine) FetchProofLeaf(context.Context, Identifier,
+	LeafKey) ([]*Proof, error) {
+
+	return nil, nil
+}
+
+func (m *mockDiffEngine) UniverseBranch(ctx context.Context, _ Identifier,
+	path mssmt.NodePath) (*Branch, error) {
+
+	m.numBranchQueries.Add(1)
+
+	if m.noBranchQueries {
+		return nil, ErrBranchQueryUnsupported
+	}
+
+	if m.hiddenKey != nil && path.Depth > 0 &&
+		path.Contains(*m.hiddenKey) {
+
+		return &Branch{
+			Path: path,
+			Node: mssmt.EmptyTree[path.Depth],
+		}, nil
+	}
+
+	node, err := m.tree.NodeAtPath(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := &Branch{
+		Path: path,
+		Node: node,
+	}
+	if leaf, ok := node.(*mssmt.CompactedLeafNode); ok {
+		leafKey := m.leafKeys[leaf.Key()]
+		for smtKey, otherKey := range m.leafKeys {
+			if m.wrongLeafKeys && !path.Contains(smtKey) {
+				leafKey = otherKey
+				break
+			}
+		}
+		branch.LeafKey = &leafKey
+	}
+
+	return branch, nil
+}
+
+// rootNode returns the root node of the tree of the engine.
+func (m *mockDiffEngine) rootNode(t *testing.T) mssmt.Node {
+	t.Helper()
+
+	root, err := m.tree.Root(context.Background())
+	require.NoError(t, err)
+
+	return root
+}
+
+// randLeafKey returns a random leaf key.
+func randLeafKey(t *testing.T) LeafKey {
+	scriptKey := asset.RandScriptKey(t)
+
+	return LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: &scriptKey,
+	}
+}
+
+// TestSyncerDiffLeafKeys tests that the syncer finds the set of leaves the
+// local universe is missing, both by bisecting the universe trees and by
+// falling back to comparing all leaf keys.
+func TestSyncerDiffLeafKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		noBranchQueries  bool
+		localEmpty       bool
+		maxBisectQueries int
+		maxRemoteQueries int32
+	}{
+		{
+			name:             "bisection",
+			maxRemoteQueries: DefaultMaxBisectQueries,
+		},
+		{
+			name:             "leaf key diff fallback",
+			noBranchQueries:  true,
+			maxRemoteQueries: 1,
+		},
+		{
+			name:             "bisect query limit fallback",
+			maxBisectQueries: 4,
+			maxRemoteQueries: 4,
+		},
+		{
+			name:             "empty local universe",
+			localEmpty:       true,
+			maxRemoteQueries: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			local := newMockDiffEngine()
+			remote := newMockDiffEngine()
+			remote.noBranchQueries = testCase.noBranchQueries
+
+			// Both universes share a set of leaves, and each of
+			// them also has leaves the other one doesn't have. If
+			// the local universe is empty, all remote leaves are
+			// missing.
+			const numShared, numRemote, numLocal = 20, 7, 5
+			missingKeys := make(map[[32]byte]struct{})
+			for i := 0; i < numShared; i++ {
+				key := randLeafKey(t)
+				remote.addLeaf(t, key)
+
+				if testCase.localEmpty {
+					missingKeys[key.UniverseKey()] =
+						struct{}{}
+
+					continue
+				}
+				local.addLeaf(t, key)
+			}
+
+			for i := 0; i < numRemote; i++ {
+				key := randLeafKey(t)
+				remote.addLeaf(t, key)
+
+				missingKeys[key.UniverseKey()] = struct{}{}
+			}
+			if !testCase.localEmpty {
+				for i := 0; i < numLocal; i++ {
+					local.addLeaf(t, randLeafKey(t))
+				}
+			}
+
+			syncer := NewSimpleSyncer(SimpleSyncCfg{
+				LocalDiffEngine:  local,
+				MaxBisectQueries: testCase.maxBisectQueries,
+			})
+
+			keysToFetch, err := syncer.diffLeafKeys(
+				ctx, remote, Identifier{}, remote.rootNode(t),
+				testCase.localEmpty,
+			)
+			require.NoError(t, err)
+			require.Len(t, keysToFetch, len(missingKeys))
+
+			for _, key := range keysToFetch {
+				require.Contains(
+					t, missingKeys, key.UniverseKey(),
+				)
+			}
+
+			require.LessOrEqual(
+				t, remote.numBranchQueries.Load(),
+				testCase.maxRemoteQueries,
+			)
+		})
+	}
+}
+
+// TestSyncerBisectWrongLeafKey tests that the syncer refuses a remote leaf key
+// that isn't part of the branch it was returned for.
+func TestSyncerBisectWrongLeafKey(t *testing.T) {
+	t.Parallel()
+
+	local := newMockDiffEngine()
+	remote := newMockDiffEngine()
+	remote.wrongLeafKeys = true
+
+	local.addLeaf(t, randLeafKey(t))
+	for i := 0; i < 5; i++ {
+		remote.addLeaf(t, randLeafKey(t))
+	}
+
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: local,
+	})
+
+	_, err := syncer.diffLeafKeys(
+		context.Background(), remote, Identifier{}, remote.rootNode(t),
+		false,
+	)
+	require.ErrorContains(t, err, "is not part of branch")
+}
+
+// TestSyncerBisectHiddenLeaf tests that the syncer refuses remote branches
+// that don't hash to the remote root, so the remote universe can't hide leaves
+// from it.
+func TestSyncerBisectHiddenLeaf(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	local := newMockDiffEngine()
+	remote := newMockDiffEngine()
+
+	sharedKey := randLeafKey(t)
+	local.addLeaf(t, sharedKey)
+	remote.addLeaf(t, sharedKey)
+
+	var hiddenKey LeafKey
+	for i := 0; i < 5; i++ {
+		hiddenKey = randLeafKey(t)
+		remote.addLeaf(t, hiddenKey)
+	}
+
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: local,
+	})
+
+	// A root branch that doesn't match the remote root is refused right
+	// away.
+	_, err := syncer.diffLeafKeys(
+		ctx, remote, Identifier{}, local.rootNode(t), false,
+	)
+	require.ErrorContains(t, err, "doesn't match remote universe root")
+
+	// Child branches that claim one of the remote leaves doesn't exist no
+	// longer hash to their parent branch.
+	universeKey := hiddenKey.UniverseKey()
+	remote.hiddenKey = &universeKey
+
+	_, err = syncer.diffLeafKeys(
+		ctx, remote, Identifier{}, remote.rootNode(t), false,
+	)
+	require.ErrorContains(t, err, "don't match their parent branch")
+}
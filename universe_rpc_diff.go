@@ -5,11 +5,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // RpcUniverseDiff is an implementation of the universe.DiffEngine interface
@@ -154,6 +157,51 @@ func (r *RpcUniverseDiff) UniverseLeafKeys(ctx context.Context,
 	return keys, nil
 }
 
+// UniverseBranch returns the branch at the given path of the MS-SMT of the
+// given universe.
+func (r *RpcUniverseDiff) UniverseBranch(ctx context.Context,
+	id universe.Identifier, path mssmt.NodePath) (*universe.Branch, error) {
+
+	uniID, err := MarshalUniID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.conn.QueryBranch(ctx, &unirpc.BranchQuery{
+		Id:    uniID,
+		Depth: uint32(path.Depth),
+		Key:   fn.ByteSlice(path.Key),
+	})
+
+	// Older universe servers don't know about branch queries, in which
+	// case the caller needs to fall back to comparing all leaf keys.
+	if status.Code(err) == codes.Unimplemented {
+		return nil, universe.ErrBranchQueryUnsupported
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Node == nil {
+		return nil, fmt.Errorf("missing branch node")
+	}
+
+	branch := &universe.Branch{
+		Path: path,
+		Node: unmarshalMerkleSumNode(resp.Node),
+	}
+	if resp.LeafKey != nil {
+		leafKey, err := unmarshalLeafKey(resp.LeafKey)
+		if err != nil {
+			return nil, err
+		}
+
+		branch.LeafKey = &leafKey
+	}
+
+	return branch, nil
+}
+
 // FetchProofLeaf attempts to fetch a proof leaf for the target leaf key
 // and given a universe identifier (assetID/groupKey).
 //
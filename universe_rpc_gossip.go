@@ -0,0 +1,96 @@
+package taprootassets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RpcUniverseGossipPeer is an implementation of the universe.GossipPeer
+// interface that uses an RPC connection to target Universe.
+type RpcUniverseGossipPeer struct {
+	conn unirpc.UniverseClient
+}
+
+// NewRpcUniverseGossipPeer creates a new RpcUniverseGossipPeer instance that
+// dials out to the target remote universe server address.
+func NewRpcUniverseGossipPeer(
+	serverAddr universe.ServerAddr) (universe.GossipPeer, error) {
+
+	conn, err := ConnectUniverse(serverAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to universe RPC "+
+			"server: %w", err)
+	}
+
+	return &RpcUniverseGossipPeer{
+		conn: conn,
+	}, nil
+}
+
+// AnnounceProof announces a new proof leaf to the remote server. True is
+// returned if the remote server already knew about the announcement.
+//
+// NOTE: This is part of the universe.GossipPeer interface.
+func (r *RpcUniverseGossipPeer) AnnounceProof(ctx context.Context,
+	announcement *universe.ProofAnnouncement) (bool, error) {
+
+	uniID, err := MarshalUniID(announcement.ID)
+	if err != nil {
+		return false, err
+	}
+
+	assetLeaf, err := marshalAssetLeaf(ctx, nil, announcement.Leaf)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := r.conn.AnnounceProof(ctx, &unirpc.ProofAnnouncement{
+		Proof: &unirpc.AssetProof{
+			Key: &unirpc.UniverseKey{
+				Id:      uniID,
+				LeafKey: marshalLeafKey(announcement.Key),
+			},
+			AssetLeaf: assetLeaf,
+		},
+		HopsLeft: announcement.HopsLeft,
+	})
+
+	// Servers that don't know about proof announcements yet will return
+	// an unimplemented error, which we map to the error the gossiper
+	// expects, so it can push the proof directly instead.
+	if status.Code(err) == codes.Unimplemented {
+		return false, universe.ErrGossipUnsupported
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return resp.AlreadyKnown, nil
+}
+
+// FederationServers returns the set of servers in the federation of the
+// remote server.
+//
+// NOTE: This is part of the universe.GossipPeer interface.
+func (r *RpcUniverseGossipPeer) FederationServers(
+	ctx context.Context) ([]universe.ServerAddr, error) {
+
+	resp, err := r.conn.ListFederationServers(
+		ctx, &unirpc.ListFederationServersRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return fn.Map(resp.Servers, unmarshalUniverseServer), nil
+}
+
+// A compile time interface to ensure that RpcUniverseGossipPeer implements
+// the universe.GossipPeer interface.
+var _ universe.GossipPeer = (*RpcUniverseGossipPeer)(nil)
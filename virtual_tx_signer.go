@@ -7,6 +7,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapscript"
 )
 
@@ -52,3 +53,27 @@ func (l *LndRpcVirtualTxSigner) SignVirtualTx(signDesc *lndclient.SignDescriptor
 var _ tapscript.Signer = (*LndRpcVirtualTxSigner)(nil)
 
 var _ asset.GenesisSigner = (*LndRpcVirtualTxSigner)(nil)
+
+// WatchOnlyVirtualTxSigner is an implementation of the tapscript.Signer
+// interface that never holds any keys. Any signing request fails with
+// tapfreighter.ErrWatchOnly, as virtual transactions are signed by an external
+// signer when running in watch-only mode.
+type WatchOnlyVirtualTxSigner struct{}
+
+// NewWatchOnlyVirtualTxSigner returns a new watch-only tx signer instance.
+func NewWatchOnlyVirtualTxSigner() *WatchOnlyVirtualTxSigner {
+	return &WatchOnlyVirtualTxSigner{}
+}
+
+// SignVirtualTx always returns tapfreighter.ErrWatchOnly.
+func (w *WatchOnlyVirtualTxSigner) SignVirtualTx(*lndclient.SignDescriptor,
+	*wire.MsgTx, *wire.TxOut) (*schnorr.Signature, error) {
+
+	return nil, tapfreighter.ErrWatchOnly
+}
+
+// Compile time assertions to ensure WatchOnlyVirtualTxSigner meets the
+// tapscript.Signer and asset.GenesisSigner interfaces.
+var _ tapscript.Signer = (*WatchOnlyVirtualTxSigner)(nil)
+
+var _ asset.GenesisSigner = (*WatchOnlyVirtualTxSigner)(nil)
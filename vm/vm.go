@@ -191,8 +191,12 @@ func (vm *Engine) validateSplit(splitAsset *commitment.SplitAsset) error {
 func (vm *Engine) validateWitnessV0(virtualTx *wire.MsgTx, inputIdx uint32,
 	witness *asset.Witness, prevAsset *asset.Asset) error {
 
-	// We only support version 0 scripts atm.
-	if prevAsset.ScriptVersion != asset.ScriptV0 {
+	// Version 1 scripts are validated the same way as version 0 scripts,
+	// they only differ in the lock times the virtual transaction commits
+	// to.
+	switch prevAsset.ScriptVersion {
+	case asset.ScriptV0, asset.ScriptV1:
+	default:
 		return ErrInvalidScriptVersion
 	}
 
@@ -242,7 +246,7 @@ func (vm *Engine) validateWitnessV0(virtualTx *wire.MsgTx, inputIdx uint32,
 	// Update the virtual transaction input with details for the specific
 	// Taproot Asset input and proceed to validate its witness.
 	virtualTxCopy := asset.VirtualTxWithInput(
-		virtualTx, prevAsset, inputIdx, witness.TxWitness,
+		virtualTx, prevAsset, vm.newAsset, inputIdx, witness.TxWitness,
 	)
 
 	sigHashes := txscript.NewTxSigHashes(virtualTxCopy, prevOutFetcher)
@@ -289,7 +293,7 @@ func (vm *Engine) validateStateTransition(virtualTx *wire.MsgTx) error {
 		}
 
 		switch prevAsset.ScriptVersion {
-		case asset.ScriptV0:
+		case asset.ScriptV0, asset.ScriptV1:
 			err := vm.validateWitnessV0(
 				virtualTx, uint32(i), &witness, prevAsset,
 			)
@@ -60,15 +60,16 @@ func randAsset(t *testing.T, assetType asset.Type,
 }
 
 func genTaprootKeySpend(t *testing.T, privKey btcec.PrivateKey,
-	virtualTx *wire.MsgTx, input *asset.Asset, idx uint32) wire.TxWitness {
+	virtualTx *wire.MsgTx, input, newAsset *asset.Asset,
+	idx uint32) wire.TxWitness {
 
 	t.Helper()
 
 	virtualTxCopy := asset.VirtualTxWithInput(
-		virtualTx, input, idx, nil,
+		virtualTx, input, newAsset, idx, nil,
 	)
 	sigHash, err := tapscript.InputKeySpendSigHash(
-		virtualTxCopy, input, idx, txscript.SigHashDefault,
+		virtualTxCopy, input, newAsset, idx, txscript.SigHashDefault,
 	)
 	require.NoError(t, err)
 
@@ -80,7 +81,7 @@ func genTaprootKeySpend(t *testing.T, privKey btcec.PrivateKey,
 }
 
 func genTaprootScriptSpend(t *testing.T, privKey btcec.PrivateKey,
-	virtualTx *wire.MsgTx, input *asset.Asset, idx uint32,
+	virtualTx *wire.MsgTx, input, newAsset *asset.Asset, idx uint32,
 	sigHashType txscript.SigHashType, controlBlock *txscript.ControlBlock,
 	tapLeaf *txscript.TapLeaf, scriptWitness []byte) wire.TxWitness {
 
@@ -91,10 +92,10 @@ func genTaprootScriptSpend(t *testing.T, privKey btcec.PrivateKey,
 
 	if scriptWitness == nil {
 		virtualTxCopy := asset.VirtualTxWithInput(
-			virtualTx, input, idx, nil,
+			virtualTx, input, newAsset, idx, nil,
 		)
 		sigHash, err := tapscript.InputScriptSpendSigHash(
-			virtualTxCopy, input, idx, sigHashType, tapLeaf,
+			virtualTxCopy, input, newAsset, idx, sigHashType, tapLeaf,
 		)
 		require.NoError(t, err)
 
@@ -195,7 +196,7 @@ func collectibleStateTransition(t *testing.T) (*asset.Asset,
 	virtualTx, _, err := tapscript.VirtualTx(newAsset, inputs)
 	require.NoError(t, err)
 	newWitness := genTaprootKeySpend(
-		t, *privKey, virtualTx, genesisAsset, 0,
+		t, *privKey, virtualTx, genesisAsset, newAsset, 0,
 	)
 	require.NoError(t, err)
 	newAsset.PrevWitnesses[0].TxWitness = newWitness
@@ -261,7 +262,7 @@ func normalStateTransition(t *testing.T) (*asset.Asset, commitment.SplitSet,
 	virtualTx, _, err := tapscript.VirtualTx(newAsset, inputs)
 	require.NoError(t, err)
 	newWitness := genTaprootKeySpend(
-		t, *privKey1, virtualTx, genesisAsset1, 0,
+		t, *privKey1, virtualTx, genesisAsset1, newAsset, 0,
 	)
 	require.NoError(t, err)
 	newAsset.PrevWitnesses[0].TxWitness = newWitness
@@ -271,7 +272,7 @@ func normalStateTransition(t *testing.T) (*asset.Asset, commitment.SplitSet,
 	controlBlock := leafProof.ToControlBlock(privKey2.PubKey())
 
 	newAsset.PrevWitnesses[1].TxWitness = genTaprootScriptSpend(
-		t, *privKey2, virtualTx, genesisAsset2, 1,
+		t, *privKey2, virtualTx, genesisAsset2, newAsset, 1,
 		txscript.SigHashDefault, &controlBlock, &tapLeaf, nil,
 	)
 
@@ -321,7 +322,8 @@ func splitStateTransition(t *testing.T) (*asset.Asset, commitment.SplitSet,
 	)
 	require.NoError(t, err)
 	newWitness := genTaprootKeySpend(
-		t, *privKey, virtualTx, genesisAsset, 0,
+		t, *privKey, virtualTx, genesisAsset,
+		splitCommitment.RootAsset, 0,
 	)
 	require.NoError(t, err)
 	splitCommitment.RootAsset.PrevWitnesses[0].TxWitness = newWitness
@@ -381,7 +383,8 @@ func splitFullValueStateTransition(validRootLocator,
 		)
 		require.NoError(t, err)
 		newWitness := genTaprootKeySpend(
-			t, *privKey, virtualTx, genesisAsset, 0,
+			t, *privKey, virtualTx, genesisAsset,
+			splitCommitment.RootAsset, 0,
 		)
 		require.NoError(t, err)
 		splitCommitment.RootAsset.PrevWitnesses[0].TxWitness = newWitness
@@ -429,7 +432,8 @@ func splitCollectibleStateTransition(validRoot bool) stateTransitionFunc {
 		)
 		require.NoError(t, err)
 		newWitness := genTaprootKeySpend(
-			t, *privKey, virtualTx, genesisAsset, 0,
+			t, *privKey, virtualTx, genesisAsset,
+			splitCommitment.RootAsset, 0,
 		)
 		require.NoError(t, err)
 		splitCommitment.RootAsset.PrevWitnesses[0].TxWitness = newWitness
@@ -532,7 +536,8 @@ func scriptTreeSpendStateTransition(t *testing.T, useHashLock,
 		)
 		require.NoError(t, err)
 		newWitness := genTaprootScriptSpend(
-			t, *scriptPrivKey, virtualTx, genesisAsset, 0,
+			t, *scriptPrivKey, virtualTx, genesisAsset,
+			splitCommitment.RootAsset, 0,
 			sigHashType, testTapScript.ControlBlock, usedLeaf,
 			scriptWitness,
 		)
@@ -757,6 +762,126 @@ func TestVM(t *testing.T) {
 	test.WriteTestVectors(t, errorTestVectorName, errorVectors)
 }
 
+// TestVMScriptVersionLockTimes tests that the virtual transaction of a
+// ScriptV1 input commits to the relative lock time of the new asset, while a
+// ScriptV0 input keeps committing to its own relative lock time.
+func TestVMScriptVersionLockTimes(t *testing.T) {
+	t.Parallel()
+
+	const csv = 6
+
+	testCases := []struct {
+		name             string
+		scriptVersion    asset.ScriptVersion
+		inputLockTime    uint64
+		newAssetLockTime uint64
+		valid            bool
+	}{{
+		name:          "script v0 input lock time",
+		scriptVersion: asset.ScriptV0,
+		inputLockTime: csv,
+		valid:         true,
+	}, {
+		name:             "script v0 new asset lock time",
+		scriptVersion:    asset.ScriptV0,
+		newAssetLockTime: csv,
+	}, {
+		name:             "script v1 new asset lock time",
+		scriptVersion:    asset.ScriptV1,
+		newAssetLockTime: csv,
+		valid:            true,
+	}, {
+		name:          "script v1 input lock time",
+		scriptVersion: asset.ScriptV1,
+		inputLockTime: csv,
+	}}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			privKey := test.RandPrivKey(t)
+			leafScript, err := txscript.NewScriptBuilder().
+				AddData(schnorr.SerializePubKey(
+					privKey.PubKey(),
+				)).
+				AddOp(txscript.OP_CHECKSIGVERIFY).
+				AddInt64(csv).
+				AddOp(txscript.OP_CHECKSEQUENCEVERIFY).
+				Script()
+			require.NoError(t, err)
+			tapLeaf := txscript.NewBaseTapLeaf(leafScript)
+			tapTree := txscript.AssembleTaprootScriptTree(tapLeaf)
+			tapTreeRoot := tapTree.RootNode.TapHash()
+			scriptKey := txscript.ComputeTaprootOutputKey(
+				privKey.PubKey(), tapTreeRoot[:],
+			)
+
+			inputAsset := randAsset(t, asset.Normal, scriptKey)
+			inputAsset.ScriptVersion = testCase.scriptVersion
+			inputAsset.RelativeLockTime = testCase.inputLockTime
+
+			prevID := &asset.PrevID{
+				OutPoint: wire.OutPoint{},
+				ID:       inputAsset.Genesis.ID(),
+				ScriptKey: asset.ToSerialized(
+					inputAsset.ScriptKey.PubKey,
+				),
+			}
+
+			newAsset := inputAsset.Copy()
+			newAsset.ScriptKey = asset.NewScriptKey(
+				test.RandPubKey(t),
+			)
+			newAsset.ScriptVersion = asset.ScriptV0
+			newAsset.RelativeLockTime = testCase.newAssetLockTime
+			newAsset.PrevWitnesses = []asset.Witness{{
+				PrevID: prevID,
+			}}
+
+			inputs := commitment.InputSet{*prevID: inputAsset}
+			virtualTx, _, err := tapscript.VirtualTx(
+				newAsset, inputs,
+			)
+			require.NoError(t, err)
+
+			leafIdx := tapTree.LeafProofIndex[tapLeaf.TapHash()]
+			leafProof := tapTree.LeafMerkleProofs[leafIdx]
+			controlBlock := leafProof.ToControlBlock(
+				privKey.PubKey(),
+			)
+			newAsset.PrevWitnesses[0].TxWitness =
+				genTaprootScriptSpend(
+					t, *privKey, virtualTx, inputAsset,
+					newAsset, 0, txscript.SigHashDefault,
+					&controlBlock, &tapLeaf, nil,
+				)
+
+			vm, err := New(newAsset, nil, inputs)
+			require.NoError(t, err)
+
+			err = vm.Execute()
+			if testCase.valid {
+				require.NoError(t, err)
+				return
+			}
+
+			var vmErr Error
+			require.ErrorAs(t, err, &vmErr)
+			require.Equal(t, ErrInvalidTransferWitness, vmErr.Kind)
+
+			var scriptErr txscript.Error
+			require.ErrorAs(t, err, &scriptErr)
+			require.Equal(
+				t, txscript.ErrUnsatisfiedLockTime,
+				scriptErr.ErrorCode,
+			)
+		})
+	}
+}
+
 // verifyTestCase verifies the test case by creating a new virtual machine
 // and executing it.
 func verifyTestCase(t testing.TB, expectedErr error, compareErrString bool,
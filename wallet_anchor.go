@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
@@ -12,6 +13,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/wtxmgr"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/tapfreighter"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
@@ -40,6 +42,17 @@ const (
 	defaultChangeType = walletrpc.ChangeAddressType_CHANGE_ADDRESS_TYPE_P2TR
 )
 
+// lndInternalLockID is the lock ID lnd uses for UTXO leases it acquires itself,
+// for example when funding a PSBT. It is the SHA256 hash of the string
+// "lnd-internal-lock-id" and mirrors walletrpc.LndInternalLockID, which is only
+// available with the walletrpc build tag.
+var lndInternalLockID = wtxmgr.LockID{
+	0xed, 0xe1, 0x9a, 0x92, 0xed, 0x32, 0x1a, 0x47,
+	0x05, 0xf8, 0xa1, 0xcc, 0xcc, 0x1d, 0x4f, 0x61,
+	0x82, 0x54, 0x5d, 0x4b, 0xb4, 0xfa, 0xe0, 0x8b,
+	0xd5, 0x93, 0x78, 0x31, 0xb7, 0xe3, 0x8f, 0x98,
+}
+
 // FundPsbt attaches enough inputs to the target PSBT packet for it to be
 // valid.
 func (l *LndRpcWalletAnchor) FundPsbt(ctx context.Context, packet *psbt.Packet,
@@ -60,8 +73,9 @@ func (l *LndRpcWalletAnchor) FundPsbt(ctx context.Context, packet *psbt.Packet,
 			Fees: &walletrpc.FundPsbtRequest_SatPerVbyte{
 				SatPerVbyte: uint64(feeRate.FeePerKVByte()) / 1000,
 			},
-			MinConfs:   int32(minConfs),
-			ChangeType: defaultChangeType,
+			MinConfs:         int32(minConfs),
+			SpendUnconfirmed: minConfs == 0,
+			ChangeType:       defaultChangeType,
 		},
 	)
 	if err != nil {
@@ -130,9 +144,26 @@ func (l *LndRpcWalletAnchor) ImportTaprootOutput(ctx context.Context,
 	return addr, nil
 }
 
-// UnlockInput unlocks the set of target inputs after a batch is abandoned.
-func (l *LndRpcWalletAnchor) UnlockInput(ctx context.Context) error {
-	return nil
+// UnlockInput unlocks the given input that was locked by the wallet when
+// funding a PSBT, e.g. after a batch or transfer is abandoned.
+func (l *LndRpcWalletAnchor) UnlockInput(ctx context.Context,
+	op wire.OutPoint) error {
+
+	// Inputs selected by FundPsbt are leased with lnd's internal lock ID.
+	return l.lnd.WalletKit.ReleaseOutput(ctx, lndInternalLockID, op)
+}
+
+// LeaseInput leases the given input that was locked by the wallet when funding
+// a PSBT for the given duration, extending any existing lease.
+func (l *LndRpcWalletAnchor) LeaseInput(ctx context.Context, op wire.OutPoint,
+	leaseTime time.Duration) error {
+
+	// We use lnd's internal lock ID, so the lease can still be released
+	// with UnlockInput.
+	_, err := l.lnd.WalletKit.LeaseOutput(
+		ctx, lndInternalLockID, op, leaseTime,
+	)
+	return err
 }
 
 // ListUnspentImportScripts lists all UTXOs of the imported Taproot scripts.
@@ -174,3 +205,40 @@ func (l *LndRpcWalletAnchor) ListTransactions(ctx context.Context, startHeight,
 var _ tapgarden.WalletAnchor = (*LndRpcWalletAnchor)(nil)
 
 var _ tapfreighter.WalletAnchor = (*LndRpcWalletAnchor)(nil)
+
+// WatchOnlyWalletAnchor is a wallet anchor that uses the backing lnd node for
+// everything but signing. Any request to sign an anchor transaction fails with
+// tapfreighter.ErrWatchOnly, as those are signed by an external signer.
+type WatchOnlyWalletAnchor struct {
+	*LndRpcWalletAnchor
+}
+
+// NewWatchOnlyWalletAnchor returns a new watch-only wallet anchor instance
+// using the passed lnd node.
+func NewWatchOnlyWalletAnchor(
+	lnd *lndclient.LndServices) *WatchOnlyWalletAnchor {
+
+	return &WatchOnlyWalletAnchor{
+		LndRpcWalletAnchor: NewLndRpcWalletAnchor(lnd),
+	}
+}
+
+// SignPsbt always returns tapfreighter.ErrWatchOnly.
+func (w *WatchOnlyWalletAnchor) SignPsbt(context.Context,
+	*psbt.Packet) (*psbt.Packet, error) {
+
+	return nil, tapfreighter.ErrWatchOnly
+}
+
+// SignAndFinalizePsbt always returns tapfreighter.ErrWatchOnly.
+func (w *WatchOnlyWalletAnchor) SignAndFinalizePsbt(context.Context,
+	*psbt.Packet) (*psbt.Packet, error) {
+
+	return nil, tapfreighter.ErrWatchOnly
+}
+
+// A compile time assertion to ensure WatchOnlyWalletAnchor meets the
+// tapgarden.WalletAnchor interface.
+var _ tapgarden.WalletAnchor = (*WatchOnlyWalletAnchor)(nil)
+
+var _ tapfreighter.WalletAnchor = (*WatchOnlyWalletAnchor)(nil)